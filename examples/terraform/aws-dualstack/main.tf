@@ -266,6 +266,78 @@ resource "aws_key_pair" "deployer" {
 }
 
 ##################################### IAM ######################################
+locals {
+  # minimal set of permissions required by the AWS cloud-controller-manager,
+  # see https://cloud-provider-aws.sigs.k8s.io/prerequisites/
+  ccm_iam_actions = [
+    "autoscaling:DescribeAutoScalingGroups",
+    "autoscaling:DescribeLaunchConfigurations",
+    "autoscaling:DescribeTags",
+    "ec2:DescribeInstances",
+    "ec2:DescribeRegions",
+    "ec2:DescribeRouteTables",
+    "ec2:DescribeSecurityGroups",
+    "ec2:DescribeSubnets",
+    "ec2:DescribeVolumes",
+    "ec2:DescribeAvailabilityZones",
+    "ec2:DescribeVpcs",
+    "ec2:CreateSecurityGroup",
+    "ec2:CreateTags",
+    "ec2:CreateVolume",
+    "ec2:ModifyInstanceAttribute",
+    "ec2:ModifyVolume",
+    "ec2:AttachVolume",
+    "ec2:AuthorizeSecurityGroupIngress",
+    "ec2:CreateRoute",
+    "ec2:DeleteRoute",
+    "ec2:DeleteSecurityGroup",
+    "ec2:DeleteVolume",
+    "ec2:DetachVolume",
+    "ec2:RevokeSecurityGroupIngress",
+    "elasticloadbalancing:AddTags",
+    "elasticloadbalancing:AttachLoadBalancerToSubnets",
+    "elasticloadbalancing:ApplySecurityGroupsToLoadBalancer",
+    "elasticloadbalancing:CreateLoadBalancer",
+    "elasticloadbalancing:CreateLoadBalancerPolicy",
+    "elasticloadbalancing:CreateLoadBalancerListeners",
+    "elasticloadbalancing:ConfigureHealthCheck",
+    "elasticloadbalancing:DeleteLoadBalancer",
+    "elasticloadbalancing:DeleteLoadBalancerListeners",
+    "elasticloadbalancing:DescribeLoadBalancers",
+    "elasticloadbalancing:DescribeLoadBalancerAttributes",
+    "elasticloadbalancing:DetachLoadBalancerFromSubnets",
+    "elasticloadbalancing:DeregisterInstancesFromLoadBalancer",
+    "elasticloadbalancing:ModifyLoadBalancerAttributes",
+    "elasticloadbalancing:RegisterInstancesWithLoadBalancer",
+    "elasticloadbalancing:SetLoadBalancerPoliciesForBackendServer",
+    "elasticloadbalancing:CreateListener",
+    "elasticloadbalancing:CreateTargetGroup",
+    "elasticloadbalancing:DeleteListener",
+    "elasticloadbalancing:DeleteTargetGroup",
+    "elasticloadbalancing:DescribeListeners",
+    "elasticloadbalancing:DescribeLoadBalancerPolicies",
+    "elasticloadbalancing:DescribeTargetGroups",
+    "elasticloadbalancing:DescribeTargetHealth",
+    "elasticloadbalancing:ModifyListener",
+    "elasticloadbalancing:ModifyTargetGroup",
+    "elasticloadbalancing:RegisterTargets",
+    "elasticloadbalancing:DeregisterTargets",
+    "elasticloadbalancing:SetLoadBalancerPoliciesOfListener",
+    "iam:CreateServiceLinkedRole",
+    "kms:DescribeKey",
+  ]
+
+  # permissions required by the AWS EBS CSI driver on top of the CCM ones
+  ebs_csi_iam_actions = [
+    "ec2:CreateSnapshot",
+    "ec2:DeleteSnapshot",
+    "ec2:DeleteTags",
+    "ec2:DescribeSnapshots",
+    "ec2:DescribeVolumesModifications",
+    "ec2:EnableFastSnapshotRestores",
+  ]
+}
+
 resource "aws_iam_role" "role" {
   name = "${var.cluster_name}-host"
 
@@ -297,12 +369,12 @@ resource "aws_iam_role_policy" "policy" {
     Statement = [
       {
         Effect   = "Allow",
-        Action   = ["ec2:*"],
+        Action   = local.ccm_iam_actions,
         Resource = ["*"]
       },
       {
         Effect   = "Allow",
-        Action   = ["elasticloadbalancing:*"],
+        Action   = local.ebs_csi_iam_actions,
         Resource = ["*"]
       }
     ]
@@ -322,6 +394,12 @@ resource "aws_instance" "control_plane" {
   subnet_id              = local.subnets[data.aws_availability_zones.available.names[count.index]]
   ebs_optimized          = true
 
+  metadata_options {
+    http_endpoint               = "enabled"
+    http_tokens                 = "required"
+    http_put_response_hop_limit = 2
+  }
+
   root_block_device {
     volume_type = "gp2"
     volume_size = var.control_plane_volume_size
@@ -344,6 +422,12 @@ resource "aws_instance" "static_workers1" {
   subnet_id              = local.subnets[data.aws_availability_zones.available.names[count.index % length(data.aws_availability_zones.available.names)]]
   ebs_optimized          = true
 
+  metadata_options {
+    http_endpoint               = "enabled"
+    http_tokens                 = "required"
+    http_put_response_hop_limit = 2
+  }
+
   root_block_device {
     volume_type = "gp2"
     volume_size = 50
@@ -366,6 +450,12 @@ resource "aws_instance" "bastion" {
   subnet_id                   = local.subnets[local.zoneA]
   associate_public_ip_address = true
 
+  metadata_options {
+    http_endpoint               = "enabled"
+    http_tokens                 = "required"
+    http_put_response_hop_limit = 2
+  }
+
   root_block_device {
     volume_type = "gp2"
     volume_size = 100
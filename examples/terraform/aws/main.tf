@@ -68,7 +68,7 @@ data "aws_ami" "ami" {
 
   filter {
     name   = "architecture"
-    values = ["x86_64"]
+    values = [var.arch]
   }
 }
 
@@ -241,6 +241,78 @@ resource "aws_key_pair" "deployer" {
 }
 
 ##################################### IAM ######################################
+locals {
+  # minimal set of permissions required by the AWS cloud-controller-manager,
+  # see https://cloud-provider-aws.sigs.k8s.io/prerequisites/
+  ccm_iam_actions = [
+    "autoscaling:DescribeAutoScalingGroups",
+    "autoscaling:DescribeLaunchConfigurations",
+    "autoscaling:DescribeTags",
+    "ec2:DescribeInstances",
+    "ec2:DescribeRegions",
+    "ec2:DescribeRouteTables",
+    "ec2:DescribeSecurityGroups",
+    "ec2:DescribeSubnets",
+    "ec2:DescribeVolumes",
+    "ec2:DescribeAvailabilityZones",
+    "ec2:DescribeVpcs",
+    "ec2:CreateSecurityGroup",
+    "ec2:CreateTags",
+    "ec2:CreateVolume",
+    "ec2:ModifyInstanceAttribute",
+    "ec2:ModifyVolume",
+    "ec2:AttachVolume",
+    "ec2:AuthorizeSecurityGroupIngress",
+    "ec2:CreateRoute",
+    "ec2:DeleteRoute",
+    "ec2:DeleteSecurityGroup",
+    "ec2:DeleteVolume",
+    "ec2:DetachVolume",
+    "ec2:RevokeSecurityGroupIngress",
+    "elasticloadbalancing:AddTags",
+    "elasticloadbalancing:AttachLoadBalancerToSubnets",
+    "elasticloadbalancing:ApplySecurityGroupsToLoadBalancer",
+    "elasticloadbalancing:CreateLoadBalancer",
+    "elasticloadbalancing:CreateLoadBalancerPolicy",
+    "elasticloadbalancing:CreateLoadBalancerListeners",
+    "elasticloadbalancing:ConfigureHealthCheck",
+    "elasticloadbalancing:DeleteLoadBalancer",
+    "elasticloadbalancing:DeleteLoadBalancerListeners",
+    "elasticloadbalancing:DescribeLoadBalancers",
+    "elasticloadbalancing:DescribeLoadBalancerAttributes",
+    "elasticloadbalancing:DetachLoadBalancerFromSubnets",
+    "elasticloadbalancing:DeregisterInstancesFromLoadBalancer",
+    "elasticloadbalancing:ModifyLoadBalancerAttributes",
+    "elasticloadbalancing:RegisterInstancesWithLoadBalancer",
+    "elasticloadbalancing:SetLoadBalancerPoliciesForBackendServer",
+    "elasticloadbalancing:CreateListener",
+    "elasticloadbalancing:CreateTargetGroup",
+    "elasticloadbalancing:DeleteListener",
+    "elasticloadbalancing:DeleteTargetGroup",
+    "elasticloadbalancing:DescribeListeners",
+    "elasticloadbalancing:DescribeLoadBalancerPolicies",
+    "elasticloadbalancing:DescribeTargetGroups",
+    "elasticloadbalancing:DescribeTargetHealth",
+    "elasticloadbalancing:ModifyListener",
+    "elasticloadbalancing:ModifyTargetGroup",
+    "elasticloadbalancing:RegisterTargets",
+    "elasticloadbalancing:DeregisterTargets",
+    "elasticloadbalancing:SetLoadBalancerPoliciesOfListener",
+    "iam:CreateServiceLinkedRole",
+    "kms:DescribeKey",
+  ]
+
+  # permissions required by the AWS EBS CSI driver on top of the CCM ones
+  ebs_csi_iam_actions = [
+    "ec2:CreateSnapshot",
+    "ec2:DeleteSnapshot",
+    "ec2:DeleteTags",
+    "ec2:DescribeSnapshots",
+    "ec2:DescribeVolumesModifications",
+    "ec2:EnableFastSnapshotRestores",
+  ]
+}
+
 resource "aws_iam_role" "role" {
   name = "${var.cluster_name}-host"
 
@@ -272,12 +344,12 @@ resource "aws_iam_role_policy" "policy" {
     Statement = [
       {
         Effect   = "Allow",
-        Action   = ["ec2:*"],
+        Action   = local.ccm_iam_actions,
         Resource = ["*"]
       },
       {
         Effect   = "Allow",
-        Action   = ["elasticloadbalancing:*"],
+        Action   = local.ebs_csi_iam_actions,
         Resource = ["*"]
       }
     ]
@@ -286,6 +358,33 @@ resource "aws_iam_role_policy" "policy" {
 
 ############################ CONTROL PLANE INSTANCES ###########################
 
+resource "aws_placement_group" "control_plane" {
+  count = var.control_plane_spread_placement_group ? 1 : 0
+
+  name     = "${var.cluster_name}-cp"
+  strategy = "spread"
+}
+
+resource "aws_ec2_capacity_reservation" "workers" {
+  for_each = var.worker_capacity_reservation_instance_count > 0 ? toset([local.zoneA, local.zoneB, local.zoneC]) : toset([])
+
+  instance_type     = var.worker_type
+  instance_platform = "Linux/UNIX"
+  availability_zone = each.key
+  instance_count    = var.worker_capacity_reservation_instance_count
+
+  # worker instances created by machine-controller use the reservation
+  # automatically because they match its instance type and availability zone.
+  # machine-controller can't target a reservation, see the README for the
+  # known limitations.
+  instance_match_criteria = "open"
+
+  tags = tomap({
+    "Name"                   = "${var.cluster_name}-workers-${each.key}",
+    (local.kube_cluster_tag) = "shared",
+  })
+}
+
 resource "aws_instance" "control_plane" {
   count                  = var.control_plane_vm_count
   instance_type          = var.control_plane_type
@@ -296,6 +395,14 @@ resource "aws_instance" "control_plane" {
   availability_zone      = data.aws_availability_zones.available.names[count.index]
   subnet_id              = local.subnets[data.aws_availability_zones.available.names[count.index]]
   ebs_optimized          = true
+  placement_group        = var.control_plane_spread_placement_group ? aws_placement_group.control_plane[0].id : null
+  tenancy                = var.control_plane_tenancy
+
+  metadata_options {
+    http_endpoint               = "enabled"
+    http_tokens                 = "required"
+    http_put_response_hop_limit = 2
+  }
 
   root_block_device {
     volume_type = "gp2"
@@ -319,6 +426,12 @@ resource "aws_instance" "static_workers1" {
   subnet_id              = local.subnets[data.aws_availability_zones.available.names[count.index % length(data.aws_availability_zones.available.names)]]
   ebs_optimized          = true
 
+  metadata_options {
+    http_endpoint               = "enabled"
+    http_tokens                 = "required"
+    http_put_response_hop_limit = 2
+  }
+
   root_block_device {
     volume_type = "gp2"
     volume_size = 50
@@ -341,6 +454,12 @@ resource "aws_instance" "bastion" {
   subnet_id                   = local.subnets[local.zoneA]
   associate_public_ip_address = true
 
+  metadata_options {
+    http_endpoint               = "enabled"
+    http_tokens                 = "required"
+    http_put_response_hop_limit = 2
+  }
+
   root_block_device {
     volume_type = "gp2"
     volume_size = 100
@@ -18,6 +18,7 @@ output "kubeone_api" {
   description = "kube-apiserver LB endpoint"
 
   value = {
+    version                     = "v1"
     endpoint                    = local.kubeapi_endpoint
     apiserver_alternative_names = var.apiserver_alternative_names
   }
@@ -36,6 +37,7 @@ output "kubeone_hosts" {
       cloud_provider       = "aws"
       private_address      = aws_instance.control_plane.*.private_ip
       hostnames            = aws_instance.control_plane.*.private_dns
+      zones                = aws_instance.control_plane.*.availability_zone
       operating_system     = var.os
       ssh_agent_socket     = var.ssh_agent_socket
       ssh_port             = var.ssh_port
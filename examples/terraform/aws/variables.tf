@@ -172,6 +172,17 @@ variable "os" {
   type    = string
 }
 
+variable "arch" {
+  description = "CPU architecture of the control plane, static worker and bastion instances, one of: x86_64, arm64"
+  default     = "x86_64"
+  type        = string
+
+  validation {
+    condition     = contains(["x86_64", "arm64"], var.arch)
+    error_message = "Value of arch should be one of: x86_64, arm64."
+  }
+}
+
 variable "ami" {
   description = "AMI ID, use it to fixate control-plane AMI in order to avoid force-recreation it at later times"
   default     = ""
@@ -189,7 +200,7 @@ variable "ami_filters" {
   default = {
     ubuntu = {
       owners       = ["099720109477"] # Canonical
-      image_name   = ["ubuntu/images/hvm-ssd/ubuntu-jammy-22.04-amd64-server-*"]
+      image_name   = ["ubuntu/images/hvm-ssd/ubuntu-jammy-22.04-*-server-*"]
       ssh_username = "ubuntu"
       worker_os    = "ubuntu"
     }
@@ -224,7 +235,7 @@ variable "ami_filters" {
 
     amzn = {
       owners       = ["137112412989"] # Amazon
-      image_name   = ["amzn2-ami-hvm-2.0.*-x86_64-gp2"]
+      image_name   = ["amzn2-ami-hvm-2.0.*-gp2"]
       ssh_username = "ec2-user"
       worker_os    = "amzn2"
     }
@@ -287,6 +298,29 @@ variable "control_plane_vm_count" {
   type        = number
 }
 
+variable "control_plane_spread_placement_group" {
+  description = "place control plane instances in a spread placement group, so that each instance runs on distinct hardware"
+  default     = false
+  type        = bool
+}
+
+variable "control_plane_tenancy" {
+  description = "tenancy of control plane instances, one of: default, dedicated, host"
+  default     = "default"
+  type        = string
+
+  validation {
+    condition     = contains(["default", "dedicated", "host"], var.control_plane_tenancy)
+    error_message = "Value of control_plane_tenancy should be one of: default, dedicated, host."
+  }
+}
+
+variable "worker_capacity_reservation_instance_count" {
+  description = "number of worker instances to reserve capacity for in each availability zone used by MachineDeployments, 0 to disable"
+  default     = 0
+  type        = number
+}
+
 variable "provisioning_utility" {
   description = "provisioning utility to be used for Flatcar worker nodes"
   default     = ""
@@ -25,6 +25,11 @@ locals {
   nic_address_pool_association_count = local.loadbalancer_count > 0 ? var.control_plane_vm_count : 0
   worker_os                          = var.worker_os == "" ? var.image_references[var.os].worker_os : var.worker_os
   ssh_username                       = var.ssh_username == "" ? var.image_references[var.os].ssh_username : var.ssh_username
+  zonal                              = length(var.availability_zones) > 0
+  ip_sku                             = local.zonal ? "Standard" : var.ip_sku
+  ip_allocation_method               = local.ip_sku == "Standard" ? "Static" : "Dynamic"
+
+  worker_pools = local.zonal ? [for zone in var.availability_zones : { name = "${var.cluster_name}-pool-az${zone}", zones = [zone] }] : [{ name = "${var.cluster_name}-pool1", zones = null }]
 
   cluster_autoscaler_min_replicas = var.cluster_autoscaler_min_replicas > 0 ? var.cluster_autoscaler_min_replicas : var.initial_machinedeployment_replicas
   cluster_autoscaler_max_replicas = var.cluster_autoscaler_max_replicas > 0 ? var.cluster_autoscaler_max_replicas : var.initial_machinedeployment_replicas
@@ -44,6 +49,8 @@ resource "azurerm_resource_group" "rg" {
 }
 
 resource "azurerm_availability_set" "avset" {
+  count = local.zonal ? 0 : 1
+
   name                         = "${var.cluster_name}-avset"
   location                     = var.location
   resource_group_name          = azurerm_resource_group.rg.name
@@ -58,6 +65,8 @@ resource "azurerm_availability_set" "avset" {
 }
 
 resource "azurerm_availability_set" "avset_workers" {
+  count = local.zonal ? 0 : 1
+
   name                         = "${var.cluster_name}-avset-workers"
   location                     = var.location
   resource_group_name          = azurerm_resource_group.rg.name
@@ -120,6 +129,19 @@ resource "azurerm_network_security_group" "sg" {
     destination_address_prefix = "*"
   }
 
+  security_rule {
+    name                       = "KubeAPI"
+    description                = "Allow inbound kube-apiserver"
+    priority                   = 1002
+    direction                  = "Inbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "6443"
+    source_address_prefix      = "*"
+    destination_address_prefix = "*"
+  }
+
   security_rule {
     name                       = "NodePorts"
     description                = "Allow inbound NodePorts"
@@ -144,8 +166,8 @@ resource "azurerm_public_ip" "lbip" {
   name                = "${var.cluster_name}-lbip"
   location            = var.location
   resource_group_name = azurerm_resource_group.rg.name
-  allocation_method   = "Dynamic"
-  sku                 = var.ip_sku
+  allocation_method   = local.ip_allocation_method
+  sku                 = local.ip_sku
 
   tags = {
     environment = "kubeone"
@@ -159,8 +181,8 @@ resource "azurerm_public_ip" "control_plane" {
   name                = "${var.cluster_name}-cp-${count.index}"
   location            = var.location
   resource_group_name = azurerm_resource_group.rg.name
-  allocation_method   = "Dynamic"
-  sku                 = var.ip_sku
+  allocation_method   = local.ip_allocation_method
+  sku                 = local.ip_sku
 
   tags = {
     environment = "kubeone"
@@ -174,6 +196,7 @@ resource "azurerm_lb" "lb" {
   resource_group_name = azurerm_resource_group.rg.name
   name                = "kubernetes"
   location            = var.location
+  sku                 = local.ip_sku
 
   frontend_ip_configuration {
     name                 = "KubeApi"
@@ -243,18 +266,36 @@ resource "azurerm_network_interface_backend_address_pool_association" "control_p
   backend_address_pool_id = azurerm_lb_backend_address_pool.backend_pool.0.id
 }
 
+# Standard SKU public IPs are closed to inbound traffic unless it's allowed by
+# a network security group
+resource "azurerm_network_interface_security_group_association" "control_plane" {
+  count = local.ip_sku == "Standard" ? var.control_plane_vm_count : 0
+
+  network_interface_id      = azurerm_network_interface.control_plane[count.index].id
+  network_security_group_id = azurerm_network_security_group.sg.id
+}
+
 resource "azurerm_virtual_machine" "control_plane" {
   count = var.control_plane_vm_count
 
   name                             = "${var.cluster_name}-cp-${count.index}"
   location                         = var.location
   resource_group_name              = azurerm_resource_group.rg.name
-  availability_set_id              = azurerm_availability_set.avset.id
+  availability_set_id              = local.zonal ? null : azurerm_availability_set.avset[0].id
+  zones                            = local.zonal ? [element(var.availability_zones, count.index)] : null
   vm_size                          = var.control_plane_vm_size
   network_interface_ids            = [element(azurerm_network_interface.control_plane.*.id, count.index)]
   delete_os_disk_on_termination    = true
   delete_data_disks_on_termination = true
 
+  dynamic "identity" {
+    for_each = var.managed_identity ? [1] : []
+
+    content {
+      type = "SystemAssigned"
+    }
+  }
+
   dynamic "plan" {
     for_each = var.image_references[var.os].plan
 
@@ -304,6 +345,14 @@ resource "azurerm_virtual_machine" "control_plane" {
   }
 }
 
+resource "azurerm_role_assignment" "control_plane" {
+  count = var.managed_identity ? var.control_plane_vm_count : 0
+
+  scope                = azurerm_resource_group.rg.id
+  role_definition_name = "Contributor"
+  principal_id         = azurerm_virtual_machine.control_plane[count.index].identity[0].principal_id
+}
+
 # Hack to ensure we get access to public ip in first attempt
 resource "time_sleep" "wait_30_seconds" {
   depends_on      = [azurerm_virtual_machine.control_plane]
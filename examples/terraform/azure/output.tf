@@ -18,6 +18,7 @@ output "kubeone_api" {
   description = "kube-apiserver LB endpoint"
 
   value = {
+    version                     = "v1"
     endpoint                    = local.kubeapi_endpoint
     apiserver_alternative_names = var.apiserver_alternative_names
   }
@@ -50,7 +51,8 @@ output "kubeone_workers" {
   value = {
     # following outputs will be parsed by kubeone and automatically merged into
     # corresponding (by name) worker definition
-    "${var.cluster_name}-pool1" = {
+    # with availability zones, a MachineDeployment is created for each zone
+    for pool in local.worker_pools : pool.name => {
       replicas = var.initial_machinedeployment_replicas
       providerSpec = {
         annotations = {
@@ -87,23 +89,21 @@ output "kubeone_workers" {
           location      = var.location
           resourceGroup = azurerm_resource_group.rg.name
           # vnetResourceGroup     = ""
-          vmSize          = var.worker_vm_size
-          vnetName        = azurerm_virtual_network.vpc.name
-          subnetName      = azurerm_subnet.subnet.name
-          loadBalancerSku = var.ip_sku
-          routeTableName  = azurerm_route_table.rt.name
-          availabilitySet = azurerm_availability_set.avset_workers.name
-          # assignAvailabilitySet = true/false
-          securityGroupName = azurerm_network_security_group.sg.name
-          assignPublicIP    = true
-          imageReference    = var.os != "rhel" ? var.image_references[var.os].image : null
-          imagePlan         = length(var.image_references[var.os].plan) > 0 && var.os != "rhel" ? var.image_references[var.os].plan[0] : null
+          vmSize                = var.worker_vm_size
+          vnetName              = azurerm_virtual_network.vpc.name
+          subnetName            = azurerm_subnet.subnet.name
+          loadBalancerSku       = local.ip_sku
+          routeTableName        = azurerm_route_table.rt.name
+          availabilitySet       = local.zonal ? null : azurerm_availability_set.avset_workers[0].name
+          assignAvailabilitySet = local.zonal ? false : null
+          securityGroupName     = azurerm_network_security_group.sg.name
+          assignPublicIP        = true
+          imageReference        = var.os != "rhel" ? var.image_references[var.os].image : null
+          imagePlan             = length(var.image_references[var.os].plan) > 0 && var.os != "rhel" ? var.image_references[var.os].plan[0] : null
           # Zones (optional)
           # Represents Availability Zones is a high-availability offering
           # that protects your applications and data from datacenter failures.
-          # zones = {
-          #   "1"
-          # }
+          zones = pool.zones
           # Custom Image ID (optional)
           # imageID = ""
           # Size of the operating system disk (optional)
@@ -106,9 +106,21 @@ variable "disable_kubeapi_loadbalancer" {
 
 # Provider specific settings
 
+variable "managed_identity" {
+  description = "Assign a system-assigned managed identity with the Contributor role on the resource group to the control plane VMs"
+  default     = false
+  type        = bool
+}
+
 variable "ip_sku" {
   default     = "Basic"
-  description = "SKU to use for IP addresses"
+  description = "SKU to use for IP addresses and the load balancer, Standard is always used with availability zones"
+}
+
+variable "availability_zones" {
+  default     = []
+  description = "availability zones to spread the control plane VMs and the worker MachineDeployments across, e.g. [\"1\", \"2\", \"3\"]. If empty, availability sets are used instead"
+  type        = list(string)
 }
 
 variable "location" {
@@ -29,6 +29,12 @@ locals {
   cluster_autoscaler_max_replicas = var.cluster_autoscaler_max_replicas > 0 ? var.cluster_autoscaler_max_replicas : var.initial_machinedeployment_replicas
 }
 
+# machine-controller creates worker droplets in the default VPC of the
+# region, so the control plane droplets are created in the same VPC
+data "digitalocean_vpc" "default" {
+  region = var.region
+}
+
 resource "digitalocean_tag" "kube_cluster_tag" {
   name = local.kube_cluster_tag
 }
@@ -47,12 +53,12 @@ resource "digitalocean_droplet" "control_plane" {
     "kubeone",
   ]
 
-  image              = local.control_plane_droplet_image
-  region             = var.region
-  size               = var.control_plane_size
-  private_networking = true
-  monitoring         = false
-  ipv6               = false
+  image      = local.control_plane_droplet_image
+  region     = var.region
+  size       = var.control_plane_size
+  vpc_uuid   = data.digitalocean_vpc.default.id
+  monitoring = false
+  ipv6       = false
 
   ssh_keys = [
     digitalocean_ssh_key.deployer.id,
@@ -62,8 +68,9 @@ resource "digitalocean_droplet" "control_plane" {
 resource "digitalocean_loadbalancer" "control_plane" {
   count = local.loadbalancer_count
 
-  name   = "${var.cluster_name}-lb"
-  region = var.region
+  name     = "${var.cluster_name}-lb"
+  region   = var.region
+  vpc_uuid = data.digitalocean_vpc.default.id
 
   forwarding_rule {
     entry_port     = 6443
@@ -18,6 +18,7 @@ output "kubeone_api" {
   description = "kube-apiserver LB endpoint"
 
   value = {
+    version                     = "v1"
     endpoint                    = local.kubeapi_endpoint
     apiserver_alternative_names = var.apiserver_alternative_names
   }
@@ -30,6 +31,8 @@ output "kubeone_hosts" {
     control_plane = {
       cluster_name         = var.cluster_name
       cloud_provider       = "digitalocean"
+      network_id           = data.digitalocean_vpc.default.id
+      vpc_ip_range         = data.digitalocean_vpc.default.ip_range
       private_address      = digitalocean_droplet.control_plane.*.ipv4_address_private
       public_address       = digitalocean_droplet.control_plane.*.ipv4_address
       ssh_agent_socket     = var.ssh_agent_socket
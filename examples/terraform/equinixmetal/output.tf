@@ -18,6 +18,7 @@ output "kubeone_api" {
   description = "kube-apiserver LB endpoint"
 
   value = {
+    version                     = "v1"
     endpoint                    = metal_device.lb.access_public_ipv4
     apiserver_alternative_names = var.apiserver_alternative_names
   }
@@ -24,6 +24,7 @@ locals {
   zone_first         = data.google_compute_zones.available.names[0]
   kubeapi_endpoint   = var.disable_kubeapi_loadbalancer ? google_compute_instance.control_plane.0.network_interface.0.network_ip : google_compute_address.lb_ip.0.address
   loadbalancer_count = var.disable_kubeapi_loadbalancer ? 0 : 1
+  network_project    = var.network_project != "" ? var.network_project : var.project
 
   cluster_autoscaler_min_replicas = var.cluster_autoscaler_min_replicas > 0 ? var.cluster_autoscaler_min_replicas : var.initial_machinedeployment_replicas
   cluster_autoscaler_max_replicas = var.cluster_autoscaler_max_replicas > 0 ? var.cluster_autoscaler_max_replicas : var.initial_machinedeployment_replicas
@@ -38,17 +39,20 @@ data "google_compute_image" "control_plane_image" {
 }
 
 data "google_compute_network" "network" {
-  name = "default"
+  name    = var.network_name
+  project = local.network_project
 }
 
 data "google_compute_subnetwork" "subnet" {
-  name   = "default"
-  region = var.region
+  name    = var.subnetwork_name
+  region  = var.region
+  project = local.network_project
 }
 
 resource "google_compute_firewall" "common" {
   name    = "${var.cluster_name}-common"
   network = data.google_compute_network.network.self_link
+  project = local.network_project
 
   allow {
     protocol = "tcp"
@@ -63,6 +67,7 @@ resource "google_compute_firewall" "common" {
 resource "google_compute_firewall" "control_plane" {
   name    = "${var.cluster_name}-control-plane"
   network = data.google_compute_network.network.self_link
+  project = local.network_project
 
   allow {
     protocol = "tcp"
@@ -77,6 +82,7 @@ resource "google_compute_firewall" "control_plane" {
 resource "google_compute_firewall" "internal" {
   name    = "${var.cluster_name}-internal"
   network = data.google_compute_network.network.self_link
+  project = local.network_project
 
   allow {
     protocol = "tcp"
@@ -100,6 +106,7 @@ resource "google_compute_firewall" "internal" {
 resource "google_compute_firewall" "nodeports" {
   name    = "${var.cluster_name}-nodeports"
   network = data.google_compute_network.network.self_link
+  project = local.network_project
 
   allow {
     protocol = "tcp"
@@ -106,6 +106,24 @@ variable "region" {
   type        = string
 }
 
+variable "network_project" {
+  default     = ""
+  description = "Shared VPC host project of the network, defaults to the project variable"
+  type        = string
+}
+
+variable "network_name" {
+  default     = "default"
+  description = "Name of the network to be used"
+  type        = string
+}
+
+variable "subnetwork_name" {
+  default     = "default"
+  description = "Name of the subnetwork in the region to be used"
+  type        = string
+}
+
 variable "control_plane_target_pool_members_count" {
   default = 3
   type    = number
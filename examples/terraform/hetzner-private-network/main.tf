@@ -0,0 +1,252 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+provider "hcloud" {}
+
+locals {
+  kubeapi_endpoint   = var.disable_kubeapi_loadbalancer ? local.control_plane_private_ips[0] : hcloud_load_balancer.load_balancer.0.ipv4
+  loadbalancer_count = var.disable_kubeapi_loadbalancer ? 0 : 1
+  image              = var.image == "" ? var.image_references[var.os].image_name : var.image
+  worker_os          = var.worker_os == "" ? var.image_references[var.os].worker_os : var.worker_os
+  ssh_username       = var.ssh_username == "" ? var.image_references[var.os].ssh_username : var.ssh_username
+
+  # the first IP of the network is the gateway of the Hetzner private network,
+  # the NAT gateway (bastion) routes the traffic of private servers to the Internet
+  network_gateway_ip        = cidrhost(var.ip_range, 1)
+  bastion_private_ip        = cidrhost(var.ip_range, 2)
+  control_plane_private_ips = [for i in range(var.control_plane_vm_count) : cidrhost(var.ip_range, 10 + i)]
+
+  cluster_autoscaler_min_replicas = var.cluster_autoscaler_min_replicas > 0 ? var.cluster_autoscaler_min_replicas : var.initial_machinedeployment_replicas
+  cluster_autoscaler_max_replicas = var.cluster_autoscaler_max_replicas > 0 ? var.cluster_autoscaler_max_replicas : var.initial_machinedeployment_replicas
+}
+
+resource "hcloud_ssh_key" "kubeone" {
+  name       = "kubeone-${var.cluster_name}"
+  public_key = file(var.ssh_public_key_file)
+}
+
+resource "hcloud_network" "net" {
+  name     = var.cluster_name
+  ip_range = var.ip_range
+}
+
+resource "hcloud_network_subnet" "kubeone" {
+  network_id   = hcloud_network.net.id
+  type         = "cloud"
+  network_zone = var.network_zone
+  ip_range     = var.ip_range
+}
+
+resource "hcloud_network_route" "nat" {
+  network_id  = hcloud_network.net.id
+  destination = "0.0.0.0/0"
+  gateway     = local.bastion_private_ip
+
+  depends_on = [
+    hcloud_server.bastion,
+  ]
+}
+
+resource "hcloud_firewall" "bastion" {
+  name = "${var.cluster_name}-bastion-fw"
+
+  labels = {
+    "kubeone_cluster_name" = var.cluster_name
+  }
+
+  apply_to {
+    label_selector = "kubeone_cluster_name=${var.cluster_name},role=bastion"
+  }
+
+  rule {
+    description = "allow ICMP"
+    direction   = "in"
+    protocol    = "icmp"
+    source_ips = [
+      "0.0.0.0/0",
+    ]
+  }
+
+  rule {
+    description = "allow SSH from any"
+    direction   = "in"
+    protocol    = "tcp"
+    port        = "22"
+    source_ips = [
+      "0.0.0.0/0",
+    ]
+  }
+}
+
+resource "hcloud_firewall" "cluster" {
+  name = "${var.cluster_name}-fw"
+
+  labels = {
+    "kubeone_cluster_name" = var.cluster_name
+  }
+
+  # only MachineDeployment workers have public interfaces, control plane nodes
+  # are reachable only over the private network
+  apply_to {
+    label_selector = "kubeone_cluster_name=${var.cluster_name},role!=bastion"
+  }
+
+  rule {
+    description = "allow ICMP"
+    direction   = "in"
+    protocol    = "icmp"
+    source_ips = [
+      "0.0.0.0/0",
+    ]
+  }
+
+  rule {
+    description = "allow all TCP inside cluster"
+    direction   = "in"
+    protocol    = "tcp"
+    port        = "any"
+    source_ips = [
+      var.ip_range,
+    ]
+  }
+
+  rule {
+    description = "allow all UDP inside cluster"
+    direction   = "in"
+    protocol    = "udp"
+    port        = "any"
+    source_ips = [
+      var.ip_range,
+    ]
+  }
+}
+
+resource "hcloud_placement_group" "control_plane" {
+  name = var.cluster_name
+  type = "spread"
+
+  labels = {
+    "kubeone_cluster_name" = var.cluster_name
+  }
+}
+
+resource "hcloud_server" "bastion" {
+  name        = "${var.cluster_name}-bastion"
+  server_type = var.bastion_type
+  image       = local.image
+  location    = var.datacenter
+
+  ssh_keys = [
+    hcloud_ssh_key.kubeone.id,
+  ]
+
+  user_data = templatefile("./nat-gateway.tftpl", {
+    ip_range = var.ip_range
+  })
+
+  network {
+    network_id = hcloud_network.net.id
+    ip         = local.bastion_private_ip
+  }
+
+  labels = {
+    "kubeone_cluster_name" = var.cluster_name
+    "role"                 = "bastion"
+  }
+
+  depends_on = [
+    hcloud_network_subnet.kubeone,
+  ]
+}
+
+resource "hcloud_server" "control_plane" {
+  count              = var.control_plane_vm_count
+  name               = "${var.cluster_name}-control-plane-${count.index + 1}"
+  server_type        = var.control_plane_type
+  image              = local.image
+  location           = var.datacenter
+  placement_group_id = hcloud_placement_group.control_plane.id
+
+  ssh_keys = [
+    hcloud_ssh_key.kubeone.id,
+  ]
+
+  user_data = templatefile("./private-node.tftpl", {
+    network_gateway_ip = local.network_gateway_ip
+  })
+
+  public_net {
+    ipv4_enabled = false
+    ipv6_enabled = false
+  }
+
+  network {
+    network_id = hcloud_network.net.id
+    ip         = local.control_plane_private_ips[count.index]
+  }
+
+  labels = {
+    "kubeone_cluster_name" = var.cluster_name
+    "role"                 = "api"
+  }
+
+  depends_on = [
+    hcloud_network_subnet.kubeone,
+    hcloud_network_route.nat,
+  ]
+}
+
+resource "hcloud_load_balancer_network" "load_balancer" {
+  count = local.loadbalancer_count
+
+  load_balancer_id = hcloud_load_balancer.load_balancer.0.id
+  subnet_id        = hcloud_network_subnet.kubeone.id
+}
+
+resource "hcloud_load_balancer" "load_balancer" {
+  count = local.loadbalancer_count
+
+  name               = "${var.cluster_name}-lb"
+  load_balancer_type = var.lb_type
+  location           = var.datacenter
+
+  labels = {
+    "kubeone_cluster_name" = var.cluster_name
+    "role"                 = "lb"
+  }
+}
+
+resource "hcloud_load_balancer_target" "load_balancer_target" {
+  count = local.loadbalancer_count
+
+  type             = "label_selector"
+  load_balancer_id = hcloud_load_balancer.load_balancer.0.id
+  label_selector   = "kubeone_cluster_name=${var.cluster_name},role=api"
+  use_private_ip   = true
+  depends_on = [
+    hcloud_server.control_plane,
+    hcloud_load_balancer_network.load_balancer
+  ]
+}
+
+resource "hcloud_load_balancer_service" "load_balancer_service" {
+  count = local.loadbalancer_count
+
+  load_balancer_id = hcloud_load_balancer.load_balancer.0.id
+  protocol         = "tcp"
+  listen_port      = 6443
+  destination_port = 6443
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+output "kubeone_api" {
+  description = "kube-apiserver LB endpoint"
+
+  value = {
+    version                     = "v1"
+    endpoint                    = local.kubeapi_endpoint
+    apiserver_alternative_names = var.apiserver_alternative_names
+  }
+}
+
+output "ssh_commands" {
+  value = formatlist("ssh -J ${local.ssh_username}@${hcloud_server.bastion.ipv4_address} ${local.ssh_username}@%s", local.control_plane_private_ips)
+}
+
+output "kubeone_hosts" {
+  description = "Control plane endpoints to SSH to"
+
+  value = {
+    control_plane = {
+      hostnames            = hcloud_server.control_plane.*.name
+      cluster_name         = var.cluster_name
+      cloud_provider       = "hetzner"
+      private_address      = local.control_plane_private_ips
+      network_id           = hcloud_network.net.id
+      ssh_agent_socket     = var.ssh_agent_socket
+      ssh_port             = var.ssh_port
+      ssh_private_key_file = var.ssh_private_key_file
+      ssh_user             = local.ssh_username
+      ssh_hosts_keys       = var.ssh_hosts_keys
+      bastion              = hcloud_server.bastion.ipv4_address
+      bastion_port         = var.bastion_port
+      bastion_user         = local.ssh_username
+      bastion_host_key     = var.bastion_host_key
+    }
+  }
+}
+
+output "kubeone_workers" {
+  description = "Workers definitions, that will be transformed into MachineDeployment object"
+
+  value = {
+    # following outputs will be parsed by kubeone and automatically merged into
+    # corresponding (by name) worker definition
+    "${var.cluster_name}-pool1" = {
+      replicas = var.initial_machinedeployment_replicas
+      providerSpec = {
+        annotations = {
+          "k8c.io/operating-system-profile"                           = var.initial_machinedeployment_operating_system_profile
+          "cluster.k8s.io/cluster-api-autoscaler-node-group-min-size" = tostring(local.cluster_autoscaler_min_replicas)
+          "cluster.k8s.io/cluster-api-autoscaler-node-group-max-size" = tostring(local.cluster_autoscaler_max_replicas)
+        }
+        sshPublicKeys   = [file(var.ssh_public_key_file)]
+        operatingSystem = local.worker_os
+        operatingSystemSpec = {
+          distUpgradeOnBoot = false
+        }
+        # nodeAnnotations are applied on resulting Node objects
+        # nodeAnnotations = {
+        #   "key" = "value"
+        # }
+        # machineObjectAnnotations are applied on resulting Machine objects
+        # uncomment to following to set those kubelet parameters. More into at:
+        # https://kubernetes.io/docs/tasks/administer-cluster/reserve-compute-resources/
+        # machineObjectAnnotations = {
+        #   "v1.kubelet-config.machine-controller.kubermatic.io/SystemReserved" = "cpu=200m,memory=200Mi"
+        #   "v1.kubelet-config.machine-controller.kubermatic.io/KubeReserved"   = "cpu=200m,memory=300Mi"
+        #   "v1.kubelet-config.machine-controller.kubermatic.io/EvictionHard"   = ""
+        #   "v1.kubelet-config.machine-controller.kubermatic.io/MaxPods"        = "110"
+        # }
+        cloudProviderSpec = {
+          # provider specific fields:
+          # see example under `cloudProviderSpec` section at:
+          # https://github.com/kubermatic/machine-controller/blob/main/examples/hetzner-machinedeployment.yaml
+          serverType = var.worker_type
+          location   = var.datacenter
+          image      = local.image
+          networks = [
+            hcloud_network.net.id
+          ]
+          # Datacenter (optional)
+          # datacenter = ""
+          labels = {
+            "kubeone_cluster_name"        = var.cluster_name
+            "${var.cluster_name}-workers" = "pool1"
+          }
+        }
+      }
+    }
+  }
+}
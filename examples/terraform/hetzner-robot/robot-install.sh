@@ -0,0 +1,94 @@
+#!/usr/bin/env bash
+
+# Copyright 2023 The KubeOne Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# This script prepares a Hetzner Robot (dedicated) server to be used as a
+# KubeOne static worker. If INSTALL_OS is true, the server is booted into the
+# rescue system and the OS is installed using installimage. The server is then
+# connected to the vSwitch coupled with the Hetzner Cloud network.
+#
+# !!! Installing the OS wipes all data on the server drives !!!
+
+set -euo pipefail
+
+ROBOT_API="https://robot-ws.your-server.de"
+SSH_OPTS=(-o StrictHostKeyChecking=no -o UserKnownHostsFile=/dev/null -o ConnectTimeout=10 -o LogLevel=ERROR)
+
+robot() {
+  local method=$1
+  local path=$2
+  shift 2
+
+  curl --silent --show-error --fail-with-body \
+    --user "${ROBOT_USER}:${ROBOT_PASSWORD}" \
+    --request "${method}" \
+    "${ROBOT_API}${path}" "$@"
+}
+
+wait_for_ssh() {
+  for _ in $(seq 1 60); do
+    if ssh "${SSH_OPTS[@]}" "root@${SERVER_IP}" true 2> /dev/null; then
+      return 0
+    fi
+    sleep 10
+  done
+
+  echo "timed out waiting for SSH on ${SERVER_NAME} (${SERVER_IP})"
+  return 1
+}
+
+install_os() {
+  local fingerprint
+  fingerprint=$(ssh-keygen -E md5 -lf "${SSH_PUBLIC_KEY_FILE/#\~/$HOME}" | awk '{print $2}' | sed 's/^MD5://')
+
+  # the rescue system can only be accessed with SSH keys stored in Robot
+  if ! robot GET "/key/${fingerprint}" > /dev/null 2>&1; then
+    robot POST /key \
+      --data-urlencode "name=kubeone-${SERVER_NAME}" \
+      --data-urlencode "data@${SSH_PUBLIC_KEY_FILE/#\~/$HOME}" > /dev/null
+  fi
+
+  echo "Booting ${SERVER_NAME} into the rescue system..."
+  robot DELETE "/boot/${SERVER_NUMBER}/rescue" > /dev/null || true
+  robot POST "/boot/${SERVER_NUMBER}/rescue" \
+    --data-urlencode "os=linux" \
+    --data-urlencode "authorized_key[]=${fingerprint}" > /dev/null
+  robot POST "/reset/${SERVER_NUMBER}" --data-urlencode "type=hw" > /dev/null
+
+  sleep 60
+  wait_for_ssh
+
+  local raid="-r no"
+  if [[ "${DRIVES}" == *,* ]]; then
+    raid="-r yes -l 1"
+  fi
+
+  echo "Installing ${IMAGE} on ${SERVER_NAME}..."
+  # shellcheck disable=SC2029
+  ssh "${SSH_OPTS[@]}" "root@${SERVER_IP}" \
+    "/root/.oldroot/nfs/install/installimage -a -n ${SERVER_NAME} -d ${DRIVES} ${raid} -p /boot:ext3:1G,/:ext4:all -i /root/.oldroot/nfs/images/${IMAGE} -K /root/.ssh/authorized_keys && reboot"
+
+  sleep 60
+  wait_for_ssh
+}
+
+if [ "${INSTALL_OS}" = "true" ]; then
+  install_os
+fi
+
+echo "Connecting ${SERVER_NAME} to the vSwitch ${VSWITCH_ID}..."
+if ! robot GET "/vswitch/${VSWITCH_ID}" | grep -q "\"${SERVER_IP}\""; then
+  robot POST "/vswitch/${VSWITCH_ID}/server" --data-urlencode "server[]=${SERVER_IP}" > /dev/null
+fi
@@ -0,0 +1,26 @@
+#!/usr/bin/env bash
+
+# Configures the VLAN interface connecting the Robot server to the vSwitch
+# and routes the traffic to the Hetzner Cloud network over it
+
+set -euo pipefail
+
+INTERFACE=$(ip route show default | awk '{print $5; exit}')
+
+cat > /etc/netplan/60-kubeone-vswitch.yaml << EOL
+network:
+  version: 2
+  vlans:
+    vlan${VLAN_ID}:
+      id: ${VLAN_ID}
+      link: $${INTERFACE}
+      mtu: 1400
+      addresses:
+        - ${ADDRESS}
+      routes:
+        - to: ${NETWORK}
+          via: ${GATEWAY}
+EOL
+
+chmod 0600 /etc/netplan/60-kubeone-vswitch.yaml
+netplan apply
@@ -0,0 +1,215 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+provider "kubernetes" {
+  # The infrastructure cluster running KubeVirt
+  config_path    = var.infra_kubeconfig
+  config_context = var.infra_kubeconfig_context != "" ? var.infra_kubeconfig_context : null
+}
+
+locals {
+  ssh_public_key = trimspace(file(var.ssh_public_key_file))
+
+  # The KubeVirt CCM and CSI driver find the VMs of the cluster by this label
+  cluster_labels = {
+    "cluster.x-k8s.io/cluster-name" = var.cluster_name
+  }
+  control_plane_labels = merge(local.cluster_labels, {
+    "kubeone.k8c.io/role" = "control-plane"
+  })
+
+  control_plane_names = [for i in range(var.control_plane_vm_count) : "${var.cluster_name}-cp-${i + 1}"]
+  control_plane_ips   = [for vmi in data.kubernetes_resource.control_plane : vmi.object.status.interfaces[0].ipAddress]
+
+  kubeapi_endpoint = kubernetes_service_v1.kubeapi.status[0].load_balancer[0].ingress[0].ip
+  bastion_host     = kubernetes_service_v1.bastion.status[0].load_balancer[0].ingress[0].ip
+}
+
+resource "kubernetes_manifest" "control_plane" {
+  count = var.control_plane_vm_count
+
+  manifest = {
+    apiVersion = "kubevirt.io/v1"
+    kind       = "VirtualMachine"
+    metadata = {
+      name      = local.control_plane_names[count.index]
+      namespace = var.infra_namespace
+      labels    = local.control_plane_labels
+    }
+    spec = {
+      running = true
+      dataVolumeTemplates = [
+        {
+          metadata = {
+            name = "${local.control_plane_names[count.index]}-disk"
+          }
+          spec = {
+            source = {
+              http = {
+                url = var.image_url
+              }
+            }
+            storage = {
+              accessModes      = ["ReadWriteOnce"]
+              storageClassName = var.infra_storage_class_name != "" ? var.infra_storage_class_name : null
+              resources = {
+                requests = {
+                  storage = var.disk_size
+                }
+              }
+            }
+          }
+        }
+      ]
+      template = {
+        metadata = {
+          labels = local.control_plane_labels
+        }
+        spec = {
+          hostname = local.control_plane_names[count.index]
+          domain = {
+            cpu = {
+              cores = var.control_plane_cpus
+            }
+            memory = {
+              guest = var.control_plane_memory
+            }
+            devices = {
+              disks = [
+                {
+                  name = "rootdisk"
+                  disk = {
+                    bus = "virtio"
+                  }
+                },
+                {
+                  name = "cloudinit"
+                  disk = {
+                    bus = "virtio"
+                  }
+                },
+              ]
+              # The bridge binding exposes the pod IP to the guest, so that
+              # the kubelet and etcd advertise the right address
+              interfaces = [
+                {
+                  name   = "default"
+                  bridge = {}
+                }
+              ]
+            }
+          }
+          networks = [
+            {
+              name = "default"
+              pod  = {}
+            }
+          ]
+          volumes = [
+            {
+              name = "rootdisk"
+              dataVolume = {
+                name = "${local.control_plane_names[count.index]}-disk"
+              }
+            },
+            {
+              name = "cloudinit"
+              cloudInitNoCloud = {
+                userData = join("\n", ["#cloud-config", yamlencode({
+                  hostname = local.control_plane_names[count.index]
+                  users = [
+                    {
+                      name                = var.ssh_username
+                      sudo                = "ALL=(ALL) NOPASSWD:ALL"
+                      shell               = "/bin/bash"
+                      ssh_authorized_keys = [local.ssh_public_key]
+                    }
+                  ]
+                })])
+              }
+            },
+          ]
+        }
+      }
+    }
+  }
+
+  wait {
+    fields = {
+      "status.ready" = "true"
+    }
+  }
+}
+
+# The IP addresses of the VMs are reported in the status of the
+# VirtualMachineInstances once the VMs are running
+data "kubernetes_resource" "control_plane" {
+  count = var.control_plane_vm_count
+
+  api_version = "kubevirt.io/v1"
+  kind        = "VirtualMachineInstance"
+
+  metadata {
+    name      = local.control_plane_names[count.index]
+    namespace = var.infra_namespace
+  }
+
+  depends_on = [kubernetes_manifest.control_plane]
+}
+
+resource "kubernetes_service_v1" "kubeapi" {
+  metadata {
+    name      = "${var.cluster_name}-kubeapi"
+    namespace = var.infra_namespace
+    labels    = local.cluster_labels
+  }
+
+  spec {
+    type     = "LoadBalancer"
+    selector = local.control_plane_labels
+
+    port {
+      name        = "https"
+      port        = 6443
+      target_port = 6443
+    }
+  }
+}
+
+# The first control plane VM is used as the bastion host to reach the other
+# VMs on the pod network of the infrastructure cluster
+resource "kubernetes_service_v1" "bastion" {
+  metadata {
+    name      = "${var.cluster_name}-bastion"
+    namespace = var.infra_namespace
+    labels    = local.cluster_labels
+  }
+
+  spec {
+    type = "LoadBalancer"
+    selector = {
+      "vm.kubevirt.io/name" = local.control_plane_names[0]
+    }
+
+    port {
+      name        = "ssh"
+      port        = var.ssh_port
+      target_port = var.ssh_port
+    }
+  }
+
+  depends_on = [kubernetes_manifest.control_plane]
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+output "kubeone_api" {
+  description = "kube-apiserver LB endpoint"
+
+  value = {
+    version                     = "v1"
+    endpoint                    = local.kubeapi_endpoint
+    apiserver_alternative_names = var.apiserver_alternative_names
+  }
+}
+
+output "ssh_commands" {
+  value = formatlist("ssh -J ${var.ssh_username}@${local.bastion_host} ${var.ssh_username}@%s", local.control_plane_ips)
+}
+
+output "kubeone_hosts" {
+  description = "Control plane endpoints to SSH to"
+
+  value = {
+    control_plane = {
+      hostnames            = local.control_plane_names
+      cluster_name         = var.cluster_name
+      cloud_provider       = "kubevirt"
+      infra_namespace      = var.infra_namespace
+      private_address      = local.control_plane_ips
+      operating_system     = var.worker_os
+      ssh_agent_socket     = var.ssh_agent_socket
+      ssh_port             = var.ssh_port
+      ssh_private_key_file = var.ssh_private_key_file
+      ssh_user             = var.ssh_username
+      bastion              = local.bastion_host
+      bastion_port         = var.ssh_port
+      bastion_user         = var.ssh_username
+      ssh_hosts_keys       = var.ssh_hosts_keys
+      bastion_host_key     = var.bastion_host_key
+    }
+  }
+}
+
+output "kubeone_workers" {
+  description = "Workers definitions, that will be transformed into MachineDeployment object"
+
+  value = {
+    # following outputs will be parsed by kubeone and automatically merged into
+    # corresponding (by name) worker definition
+    "${var.cluster_name}-pool1" = {
+      replicas = var.initial_machinedeployment_replicas
+      providerSpec = {
+        annotations = {
+          "k8c.io/operating-system-profile" = var.initial_machinedeployment_operating_system_profile
+        }
+        sshPublicKeys   = [local.ssh_public_key]
+        operatingSystem = var.worker_os
+        operatingSystemSpec = {
+          distUpgradeOnBoot = false
+        }
+        cloudProviderSpec = {
+          # provider specific fields:
+          # see example under `cloudProviderSpec` section at:
+          # https://github.com/kubermatic/machine-controller/blob/main/examples/kubevirt-machinedeployment.yaml
+          clusterName = var.cluster_name
+          virtualMachine = {
+            template = {
+              cpus   = tostring(var.worker_cpus)
+              memory = var.worker_memory
+              primaryDisk = {
+                osImage          = var.image_url
+                size             = var.disk_size
+                storageClassName = var.infra_storage_class_name
+              }
+            }
+            dnsPolicy = "ClusterFirst"
+          }
+        }
+      }
+    }
+  }
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+variable "cluster_name" {
+  description = "Name of the cluster"
+  type        = string
+
+  validation {
+    condition     = can(regex("^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$", var.cluster_name))
+    error_message = "Value of cluster_name should be lowercase and can only contain alphanumeric characters and hyphens(-)."
+  }
+}
+
+variable "apiserver_alternative_names" {
+  description = "subject alternative names for the API Server signing cert."
+  default     = []
+  type        = list(string)
+}
+
+variable "worker_os" {
+  description = "OS of the image, used for the control plane VMs and the worker MachineDeployment"
+
+  # valid choices are:
+  # * ubuntu
+  # * rockylinux
+  # * flatcar
+  default = "ubuntu"
+  type    = string
+}
+
+variable "ssh_public_key_file" {
+  description = "SSH public key file"
+  default     = "~/.ssh/id_rsa.pub"
+  type        = string
+}
+
+variable "ssh_port" {
+  description = "SSH port to be used to provision instances"
+  default     = 22
+  type        = number
+}
+
+variable "ssh_username" {
+  description = "SSH user created by cloud-init"
+  default     = "kubeone"
+  type        = string
+}
+
+variable "ssh_private_key_file" {
+  description = "SSH private key file used to access instances"
+  default     = ""
+  type        = string
+}
+
+variable "ssh_agent_socket" {
+  description = "SSH Agent socket, default to grab from $SSH_AUTH_SOCK"
+  default     = "env:SSH_AUTH_SOCK"
+  type        = string
+}
+
+variable "ssh_hosts_keys" {
+  default     = null
+  description = "A list of SSH hosts public keys to verify"
+  type        = list(string)
+}
+
+variable "bastion_host_key" {
+  description = "Bastion SSH host public key"
+  default     = null
+  type        = string
+}
+
+# Provider specific settings
+
+variable "infra_kubeconfig" {
+  description = "Path to the kubeconfig of the infrastructure cluster running KubeVirt"
+  default     = "~/.kube/config"
+  type        = string
+}
+
+variable "infra_kubeconfig_context" {
+  description = "Context of the infrastructure cluster kubeconfig, defaults to the current context"
+  default     = ""
+  type        = string
+}
+
+variable "infra_namespace" {
+  description = "Namespace in the infrastructure cluster in which the VMs are created"
+  type        = string
+}
+
+variable "infra_storage_class_name" {
+  description = "StorageClass in the infrastructure cluster used for the VM disks, defaults to the default StorageClass"
+  default     = ""
+  type        = string
+}
+
+variable "image_url" {
+  description = "URL of the cloud-init enabled cloud image imported by CDI"
+  default     = "https://cloud-images.ubuntu.com/jammy/current/jammy-server-cloudimg-amd64.img"
+  type        = string
+}
+
+variable "disk_size" {
+  description = "Size of the root disk of the VMs"
+  default     = "20Gi"
+  type        = string
+}
+
+variable "control_plane_vm_count" {
+  description = "number of control plane VMs"
+  default     = 3
+  type        = number
+}
+
+variable "control_plane_cpus" {
+  description = "Number of CPU cores of the control plane VMs"
+  default     = 2
+  type        = number
+}
+
+variable "control_plane_memory" {
+  description = "Memory of the control plane VMs"
+  default     = "4Gi"
+  type        = string
+}
+
+variable "worker_cpus" {
+  description = "Number of CPU cores of the worker VMs"
+  default     = 2
+  type        = number
+}
+
+variable "worker_memory" {
+  description = "Memory of the worker VMs"
+  default     = "4Gi"
+  type        = string
+}
+
+variable "initial_machinedeployment_replicas" {
+  description = "Number of replicas per MachineDeployment"
+  default     = 2
+  type        = number
+}
+
+variable "initial_machinedeployment_operating_system_profile" {
+  default     = ""
+  type        = string
+  description = <<EOF
+Name of operating system profile for MachineDeployments, only applicable if operating-system-manager addon is enabled.
+If not specified, the default value will be added by machine-controller addon.
+EOF
+}
@@ -0,0 +1,239 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+provider "libvirt" {
+  uri = var.libvirt_uri
+}
+
+locals {
+  ssh_public_key = trimspace(file(var.ssh_public_key_file))
+
+  # The nodes get fixed addresses from the DHCP server of the network, the
+  # control plane nodes starting at .10 and the static workers at .20
+  control_plane_ips  = [for i in range(var.control_plane_vm_count) : cidrhost(var.network_cidr, 10 + i)]
+  static_workers_ips = [for i in range(var.static_workers_vm_count) : cidrhost(var.network_cidr, 20 + i)]
+}
+
+resource "libvirt_network" "network" {
+  name      = var.cluster_name
+  mode      = "nat"
+  domain    = "${var.cluster_name}.local"
+  addresses = [var.network_cidr]
+  autostart = true
+
+  dhcp {
+    enabled = true
+  }
+
+  dns {
+    enabled    = true
+    local_only = true
+  }
+}
+
+resource "libvirt_volume" "base" {
+  name   = "${var.cluster_name}-base.qcow2"
+  pool   = var.storage_pool
+  source = var.image_url
+  format = "qcow2"
+}
+
+resource "libvirt_volume" "control_plane" {
+  count = var.control_plane_vm_count
+
+  name           = "${var.cluster_name}-cp-${count.index + 1}.qcow2"
+  pool           = var.storage_pool
+  base_volume_id = libvirt_volume.base.id
+  size           = var.disk_size * 1024 * 1024 * 1024
+}
+
+resource "libvirt_cloudinit_disk" "control_plane" {
+  count = var.control_plane_vm_count
+
+  name = "${var.cluster_name}-cp-${count.index + 1}-cloudinit.iso"
+  pool = var.storage_pool
+  user_data = templatefile("./cloud-init.cfg.tpl", {
+    hostname       = "${var.cluster_name}-cp-${count.index + 1}"
+    ssh_username   = var.ssh_username
+    ssh_public_key = local.ssh_public_key
+  })
+}
+
+resource "libvirt_domain" "control_plane" {
+  count = var.control_plane_vm_count
+
+  name      = "${var.cluster_name}-cp-${count.index + 1}"
+  memory    = var.control_plane_memory
+  vcpu      = var.control_plane_vcpu
+  autostart = true
+  cloudinit = libvirt_cloudinit_disk.control_plane[count.index].id
+
+  cpu {
+    mode = "host-passthrough"
+  }
+
+  disk {
+    volume_id = libvirt_volume.control_plane[count.index].id
+  }
+
+  network_interface {
+    network_id     = libvirt_network.network.id
+    hostname       = "${var.cluster_name}-cp-${count.index + 1}"
+    addresses      = [local.control_plane_ips[count.index]]
+    wait_for_lease = true
+  }
+
+  # Ubuntu cloud images expect a serial console
+  console {
+    type        = "pty"
+    target_type = "serial"
+    target_port = "0"
+  }
+
+  lifecycle {
+    ignore_changes = [
+      cloudinit,
+    ]
+  }
+}
+
+resource "libvirt_volume" "static_workers1" {
+  count = var.static_workers_vm_count
+
+  name           = "${var.cluster_name}-pool1-${count.index + 1}.qcow2"
+  pool           = var.storage_pool
+  base_volume_id = libvirt_volume.base.id
+  size           = var.worker_disk * 1024 * 1024 * 1024
+}
+
+resource "libvirt_cloudinit_disk" "static_workers1" {
+  count = var.static_workers_vm_count
+
+  name = "${var.cluster_name}-pool1-${count.index + 1}-cloudinit.iso"
+  pool = var.storage_pool
+  user_data = templatefile("./cloud-init.cfg.tpl", {
+    hostname       = "${var.cluster_name}-pool1-${count.index + 1}"
+    ssh_username   = var.ssh_username
+    ssh_public_key = local.ssh_public_key
+  })
+}
+
+resource "libvirt_domain" "static_workers1" {
+  count = var.static_workers_vm_count
+
+  name      = "${var.cluster_name}-pool1-${count.index + 1}"
+  memory    = var.worker_memory
+  vcpu      = var.worker_vcpu
+  autostart = true
+  cloudinit = libvirt_cloudinit_disk.static_workers1[count.index].id
+
+  cpu {
+    mode = "host-passthrough"
+  }
+
+  disk {
+    volume_id = libvirt_volume.static_workers1[count.index].id
+  }
+
+  network_interface {
+    network_id     = libvirt_network.network.id
+    hostname       = "${var.cluster_name}-pool1-${count.index + 1}"
+    addresses      = [local.static_workers_ips[count.index]]
+    wait_for_lease = true
+  }
+
+  console {
+    type        = "pty"
+    target_type = "serial"
+    target_port = "0"
+  }
+
+  lifecycle {
+    ignore_changes = [
+      cloudinit,
+    ]
+  }
+}
+
+resource "null_resource" "keepalived_install" {
+  count = var.api_vip != "" ? var.control_plane_vm_count : 0
+
+  connection {
+    type         = "ssh"
+    user         = var.ssh_username
+    host         = local.control_plane_ips[count.index]
+    bastion_host = var.bastion_host
+    bastion_port = var.bastion_port
+    bastion_user = var.bastion_username
+  }
+
+  provisioner "remote-exec" {
+    script = "keepalived.sh"
+  }
+}
+
+resource "random_string" "keepalived_auth_pass" {
+  length  = 8
+  special = false
+}
+
+resource "null_resource" "keepalived_config" {
+  count = var.api_vip != "" ? var.control_plane_vm_count : 0
+
+  depends_on = [null_resource.keepalived_install]
+
+  triggers = {
+    cluster_instance_ids = join(",", libvirt_domain.control_plane.*.id)
+  }
+
+  connection {
+    type         = "ssh"
+    user         = var.ssh_username
+    host         = local.control_plane_ips[count.index]
+    bastion_host = var.bastion_host
+    bastion_port = var.bastion_port
+    bastion_user = var.bastion_username
+  }
+
+  provisioner "file" {
+    content = templatefile("./etc_keepalived_keepalived_conf.tpl", {
+      STATE         = count.index == 0 ? "MASTER" : "BACKUP",
+      APISERVER_VIP = var.api_vip,
+      INTERFACE     = var.vrrp_interface,
+      ROUTER_ID     = var.vrrp_router_id,
+      PRIORITY      = count.index == 0 ? "101" : "100",
+      AUTH_PASS     = random_string.keepalived_auth_pass.result
+    })
+    destination = "/tmp/keepalived.conf"
+  }
+
+  provisioner "file" {
+    content = templatefile("./etc_keepalived_check_apiserver_sh.tpl", {
+      APISERVER_VIP = var.api_vip
+    })
+    destination = "/tmp/check_apiserver.sh"
+  }
+
+  provisioner "remote-exec" {
+    inline = [
+      "sudo mkdir -p /etc/keepalived",
+      "sudo mv /tmp/keepalived.conf /etc/keepalived/keepalived.conf",
+      "sudo mv /tmp/check_apiserver.sh /etc/keepalived/check_apiserver.sh",
+      "sudo chmod +x /etc/keepalived/check_apiserver.sh",
+      "sudo systemctl restart keepalived",
+    ]
+  }
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+variable "cluster_name" {
+  description = "Name of the cluster"
+  type        = string
+
+  validation {
+    condition     = can(regex("^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$", var.cluster_name))
+    error_message = "Value of cluster_name should be lowercase and can only contain alphanumeric characters and hyphens(-)."
+  }
+}
+
+variable "apiserver_alternative_names" {
+  description = "subject alternative names for the API Server signing cert."
+  default     = []
+  type        = list(string)
+}
+
+variable "os" {
+  description = "Operating system of the cloud image"
+
+  # valid choices are:
+  # * ubuntu
+  # * rockylinux
+  default = "ubuntu"
+  type    = string
+}
+
+variable "ssh_public_key_file" {
+  description = "SSH public key file"
+  default     = "~/.ssh/id_rsa.pub"
+  type        = string
+}
+
+variable "ssh_port" {
+  description = "SSH port to be used to provision instances"
+  default     = 22
+  type        = number
+}
+
+variable "ssh_username" {
+  description = "SSH user created by cloud-init"
+  default     = "kubeone"
+  type        = string
+}
+
+variable "ssh_private_key_file" {
+  description = "SSH private key file used to access instances"
+  default     = ""
+  type        = string
+}
+
+variable "ssh_agent_socket" {
+  description = "SSH Agent socket, default to grab from $SSH_AUTH_SOCK"
+  default     = "env:SSH_AUTH_SOCK"
+  type        = string
+}
+
+variable "bastion_host" {
+  description = "ssh jumphost (bastion) hostname"
+  default     = ""
+  type        = string
+}
+
+variable "bastion_port" {
+  description = "ssh jumphost (bastion) port"
+  type        = number
+  default     = 22
+}
+
+variable "bastion_username" {
+  description = "ssh jumphost (bastion) username"
+  default     = ""
+  type        = string
+}
+
+variable "ssh_hosts_keys" {
+  default     = null
+  description = "A list of SSH hosts public keys to verify"
+  type        = list(string)
+}
+
+variable "bastion_host_key" {
+  description = "Bastion SSH host public key"
+  default     = null
+  type        = string
+}
+
+# provider specific settings
+
+variable "libvirt_uri" {
+  default     = "qemu:///system"
+  description = "libvirt connection URI, e.g. qemu+ssh://user@host/system for a remote host"
+  type        = string
+}
+
+variable "storage_pool" {
+  default     = "default"
+  description = "libvirt storage pool used for the VM disks and cloud-init drives"
+  type        = string
+}
+
+variable "image_url" {
+  default     = "https://cloud-images.ubuntu.com/jammy/current/jammy-server-cloudimg-amd64.img"
+  description = "URL or local path of the cloud-init enabled qcow2 cloud image"
+  type        = string
+}
+
+variable "network_cidr" {
+  default     = "192.168.100.0/24"
+  description = "CIDR of the NAT network created for the cluster"
+  type        = string
+}
+
+variable "disk_size" {
+  default     = 30
+  description = "disk size of each control plane node in GB"
+  type        = number
+}
+
+variable "control_plane_vm_count" {
+  default     = 3
+  description = "number of control plane VMs"
+  type        = number
+
+  validation {
+    condition     = var.control_plane_vm_count <= 10
+    error_message = "At most 10 control plane VMs are supported."
+  }
+}
+
+variable "control_plane_memory" {
+  default     = 4096
+  description = "memory size of each control plane node in MB"
+  type        = number
+}
+
+variable "control_plane_vcpu" {
+  default     = 2
+  description = "number of vCPUs of each control plane node"
+  type        = number
+}
+
+variable "static_workers_vm_count" {
+  default     = 2
+  description = "number of static worker VMs"
+  type        = number
+}
+
+variable "worker_memory" {
+  default     = 4096
+  description = "memory size of each worker node in MB"
+  type        = number
+}
+
+variable "worker_vcpu" {
+  default     = 2
+  description = "number of vCPUs of each worker node"
+  type        = number
+}
+
+variable "worker_disk" {
+  default     = 30
+  description = "disk size of each worker node in GB"
+  type        = number
+}
+
+variable "api_vip" {
+  default     = ""
+  description = "virtual IP address for Kubernetes API, must be in network_cidr and not assigned to any node"
+  type        = string
+}
+
+variable "vrrp_interface" {
+  default     = "ens3"
+  description = "network interface for API virtual IP"
+  type        = string
+}
+
+variable "vrrp_router_id" {
+  default     = 42
+  description = "vrrp router id for API virtual IP. Must be unique in used subnet"
+  type        = number
+}
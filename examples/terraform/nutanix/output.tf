@@ -18,6 +18,7 @@ output "kubeone_api" {
   description = "kube-apiserver LB endpoint"
 
   value = {
+    version                     = "v1"
     endpoint                    = nutanix_virtual_machine.lb.nic_list.0.ip_endpoint_list.0.ip
     apiserver_alternative_names = var.apiserver_alternative_names
   }
@@ -0,0 +1,248 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+locals {
+  kubeapi_endpoint   = var.disable_kubeapi_loadbalancer ? oci_core_instance.control_plane.0.private_ip : local.loadbalancer_ip
+  loadbalancer_count = var.disable_kubeapi_loadbalancer ? 0 : 1
+  loadbalancer_ip    = var.disable_kubeapi_loadbalancer ? "" : [for ip in oci_network_load_balancer_network_load_balancer.kubeapi.0.ip_addresses : ip.ip_address if ip.is_public][0]
+  image              = var.image == "" ? data.oci_core_images.image.images.0.id : var.image
+  ssh_username       = var.ssh_username == "" ? var.image_references[var.os].ssh_username : var.ssh_username
+}
+
+data "oci_identity_availability_domains" "ads" {
+  compartment_id = var.compartment_ocid
+}
+
+data "oci_core_images" "image" {
+  compartment_id           = var.compartment_ocid
+  operating_system         = var.image_references[var.os].operating_system
+  operating_system_version = var.image_references[var.os].operating_system_version
+  shape                    = var.control_plane_shape
+  sort_by                  = "TIMECREATED"
+  sort_order               = "DESC"
+}
+
+resource "oci_core_vcn" "vcn" {
+  compartment_id = var.compartment_ocid
+  cidr_blocks    = [var.vcn_cidr]
+  display_name   = var.cluster_name
+  dns_label      = replace(substr(var.cluster_name, 0, 15), "-", "")
+
+  freeform_tags = {
+    "kubeone_cluster_name" = var.cluster_name
+  }
+}
+
+resource "oci_core_internet_gateway" "gateway" {
+  compartment_id = var.compartment_ocid
+  vcn_id         = oci_core_vcn.vcn.id
+  display_name   = "${var.cluster_name}-gateway"
+}
+
+resource "oci_core_route_table" "route_table" {
+  compartment_id = var.compartment_ocid
+  vcn_id         = oci_core_vcn.vcn.id
+  display_name   = "${var.cluster_name}-route-table"
+
+  route_rules {
+    destination       = "0.0.0.0/0"
+    destination_type  = "CIDR_BLOCK"
+    network_entity_id = oci_core_internet_gateway.gateway.id
+  }
+}
+
+resource "oci_core_security_list" "cluster" {
+  compartment_id = var.compartment_ocid
+  vcn_id         = oci_core_vcn.vcn.id
+  display_name   = "${var.cluster_name}-security-list"
+
+  egress_security_rules {
+    description = "allow all egress"
+    destination = "0.0.0.0/0"
+    protocol    = "all"
+  }
+
+  ingress_security_rules {
+    description = "allow all inside cluster"
+    source      = var.vcn_cidr
+    protocol    = "all"
+  }
+
+  ingress_security_rules {
+    description = "allow ICMP from any"
+    source      = "0.0.0.0/0"
+    protocol    = "1"
+  }
+
+  ingress_security_rules {
+    description = "allow SSH from any"
+    source      = "0.0.0.0/0"
+    protocol    = "6"
+
+    tcp_options {
+      min = var.ssh_port
+      max = var.ssh_port
+    }
+  }
+
+  ingress_security_rules {
+    description = "allow kube-apiserver from any"
+    source      = "0.0.0.0/0"
+    protocol    = "6"
+
+    tcp_options {
+      min = 6443
+      max = 6443
+    }
+  }
+
+  ingress_security_rules {
+    description = "allow NodePorts from any"
+    source      = "0.0.0.0/0"
+    protocol    = "6"
+
+    tcp_options {
+      min = 30000
+      max = 32767
+    }
+  }
+}
+
+resource "oci_core_subnet" "kubeone" {
+  compartment_id    = var.compartment_ocid
+  vcn_id            = oci_core_vcn.vcn.id
+  cidr_block        = var.subnet_cidr
+  display_name      = "${var.cluster_name}-subnet"
+  dns_label         = "kubeone"
+  route_table_id    = oci_core_route_table.route_table.id
+  security_list_ids = [oci_core_security_list.cluster.id]
+}
+
+resource "oci_core_instance" "control_plane" {
+  count = var.control_plane_vm_count
+
+  compartment_id      = var.compartment_ocid
+  availability_domain = data.oci_identity_availability_domains.ads.availability_domains[count.index % length(data.oci_identity_availability_domains.ads.availability_domains)].name
+  display_name        = "${var.cluster_name}-cp-${count.index + 1}"
+  shape               = var.control_plane_shape
+
+  shape_config {
+    ocpus         = var.control_plane_ocpus
+    memory_in_gbs = var.control_plane_memory_in_gbs
+  }
+
+  create_vnic_details {
+    subnet_id        = oci_core_subnet.kubeone.id
+    assign_public_ip = true
+    hostname_label   = "${var.cluster_name}-cp-${count.index + 1}"
+  }
+
+  source_details {
+    source_type             = "image"
+    source_id               = local.image
+    boot_volume_size_in_gbs = var.disk_size
+  }
+
+  metadata = {
+    ssh_authorized_keys = file(var.ssh_public_key_file)
+  }
+
+  freeform_tags = {
+    "kubeone_cluster_name" = var.cluster_name
+    "role"                 = "api"
+  }
+}
+
+resource "oci_core_instance" "static_workers1" {
+  count = var.static_workers_count
+
+  compartment_id      = var.compartment_ocid
+  availability_domain = data.oci_identity_availability_domains.ads.availability_domains[count.index % length(data.oci_identity_availability_domains.ads.availability_domains)].name
+  display_name        = "${var.cluster_name}-pool1-${count.index + 1}"
+  shape               = var.worker_shape
+
+  shape_config {
+    ocpus         = var.worker_ocpus
+    memory_in_gbs = var.worker_memory_in_gbs
+  }
+
+  create_vnic_details {
+    subnet_id        = oci_core_subnet.kubeone.id
+    assign_public_ip = true
+    hostname_label   = "${var.cluster_name}-pool1-${count.index + 1}"
+  }
+
+  source_details {
+    source_type             = "image"
+    source_id               = local.image
+    boot_volume_size_in_gbs = var.disk_size
+  }
+
+  metadata = {
+    ssh_authorized_keys = file(var.ssh_public_key_file)
+  }
+
+  freeform_tags = {
+    "kubeone_cluster_name"        = var.cluster_name
+    "${var.cluster_name}-workers" = "pool1"
+  }
+}
+
+resource "oci_network_load_balancer_network_load_balancer" "kubeapi" {
+  count = local.loadbalancer_count
+
+  compartment_id = var.compartment_ocid
+  display_name   = "${var.cluster_name}-kubeapi"
+  subnet_id      = oci_core_subnet.kubeone.id
+  is_private     = false
+
+  freeform_tags = {
+    "kubeone_cluster_name" = var.cluster_name
+    "role"                 = "lb"
+  }
+}
+
+resource "oci_network_load_balancer_backend_set" "kubeapi" {
+  count = local.loadbalancer_count
+
+  name                     = "kubeapi"
+  network_load_balancer_id = oci_network_load_balancer_network_load_balancer.kubeapi.0.id
+  policy                   = "FIVE_TUPLE"
+
+  health_checker {
+    protocol = "TCP"
+    port     = 6443
+  }
+}
+
+resource "oci_network_load_balancer_backend" "kubeapi" {
+  count = var.disable_kubeapi_loadbalancer ? 0 : var.control_plane_vm_count
+
+  backend_set_name         = oci_network_load_balancer_backend_set.kubeapi.0.name
+  network_load_balancer_id = oci_network_load_balancer_network_load_balancer.kubeapi.0.id
+  target_id                = oci_core_instance.control_plane[count.index].id
+  port                     = 6443
+}
+
+resource "oci_network_load_balancer_listener" "kubeapi" {
+  count = local.loadbalancer_count
+
+  name                     = "kubeapi"
+  network_load_balancer_id = oci_network_load_balancer_network_load_balancer.kubeapi.0.id
+  default_backend_set_name = oci_network_load_balancer_backend_set.kubeapi.0.name
+  protocol                 = "TCP"
+  port                     = 6443
+}
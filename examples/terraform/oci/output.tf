@@ -0,0 +1,69 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+output "kubeone_api" {
+  description = "kube-apiserver LB endpoint"
+
+  value = {
+    version                     = "v1"
+    endpoint                    = local.kubeapi_endpoint
+    apiserver_alternative_names = var.apiserver_alternative_names
+  }
+}
+
+output "ssh_commands" {
+  value = formatlist("ssh ${local.ssh_username}@%s", oci_core_instance.control_plane.*.public_ip)
+}
+
+output "kubeone_hosts" {
+  description = "Control plane endpoints to SSH to"
+
+  value = {
+    control_plane = {
+      hostnames            = oci_core_instance.control_plane.*.display_name
+      cluster_name         = var.cluster_name
+      cloud_provider       = "oci"
+      private_address      = oci_core_instance.control_plane.*.private_ip
+      public_address       = oci_core_instance.control_plane.*.public_ip
+      compartment_id       = var.compartment_ocid
+      vcn_id               = oci_core_vcn.vcn.id
+      lb_subnet_ids        = [oci_core_subnet.kubeone.id]
+      ssh_agent_socket     = var.ssh_agent_socket
+      ssh_port             = var.ssh_port
+      ssh_private_key_file = var.ssh_private_key_file
+      ssh_user             = local.ssh_username
+      ssh_hosts_keys       = var.ssh_hosts_keys
+      bastion_host_key     = var.bastion_host_key
+    }
+  }
+}
+
+output "kubeone_static_workers" {
+  description = "Static worker config"
+
+  value = {
+    workers1 = {
+      private_address      = oci_core_instance.static_workers1.*.private_ip
+      public_address       = oci_core_instance.static_workers1.*.public_ip
+      hostnames            = oci_core_instance.static_workers1.*.display_name
+      operating_system     = var.os
+      ssh_agent_socket     = var.ssh_agent_socket
+      ssh_port             = var.ssh_port
+      ssh_private_key_file = var.ssh_private_key_file
+      ssh_user             = local.ssh_username
+    }
+  }
+}
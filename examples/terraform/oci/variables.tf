@@ -0,0 +1,190 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+variable "cluster_name" {
+  description = "prefix for cloud resources"
+  type        = string
+
+  validation {
+    condition     = can(regex("^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$", var.cluster_name))
+    error_message = "Value of cluster_name should be lowercase and can only contain alphanumeric characters and hyphens(-)."
+  }
+}
+
+variable "apiserver_alternative_names" {
+  description = "subject alternative names for the API Server signing cert."
+  default     = []
+  type        = list(string)
+}
+
+variable "os" {
+  description = "Operating System to use in image filtering and static workers"
+
+  # valid choices are:
+  # * ubuntu
+  # * rockylinux
+  default = "ubuntu"
+  type    = string
+}
+
+variable "ssh_public_key_file" {
+  description = "SSH public key file"
+  default     = "~/.ssh/id_rsa.pub"
+  type        = string
+}
+
+variable "ssh_port" {
+  description = "SSH port to be used to provision instances"
+  default     = 22
+  type        = number
+}
+
+variable "ssh_username" {
+  description = "SSH user, used only in output"
+  default     = ""
+  type        = string
+}
+
+variable "ssh_private_key_file" {
+  description = "SSH private key file used to access instances"
+  default     = ""
+  type        = string
+}
+
+variable "ssh_agent_socket" {
+  description = "SSH Agent socket, default to grab from $SSH_AUTH_SOCK"
+  default     = "env:SSH_AUTH_SOCK"
+  type        = string
+}
+
+variable "ssh_hosts_keys" {
+  default     = null
+  description = "A list of SSH hosts public keys to verify"
+  type        = list(string)
+}
+
+variable "bastion_host_key" {
+  description = "Bastion SSH host public key"
+  default     = null
+  type        = string
+}
+
+variable "disable_kubeapi_loadbalancer" {
+  type        = bool
+  default     = false
+  description = "E2E tests specific variable to disable usage of any loadbalancer in front of kubeapi-server"
+}
+
+# Provider specific settings
+
+variable "compartment_ocid" {
+  description = "OCID of the compartment to create resources in"
+  type        = string
+}
+
+variable "region" {
+  description = "OCI region to create resources in"
+  default     = "eu-frankfurt-1"
+  type        = string
+}
+
+variable "image_references" {
+  description = "map with images"
+  type = map(object({
+    operating_system         = string
+    operating_system_version = string
+    ssh_username             = string
+  }))
+  default = {
+    ubuntu = {
+      operating_system         = "Canonical Ubuntu"
+      operating_system_version = "22.04"
+      ssh_username             = "ubuntu"
+    }
+
+    rockylinux = {
+      operating_system         = "Rocky Linux"
+      operating_system_version = "8"
+      ssh_username             = "rocky"
+    }
+  }
+}
+
+variable "image" {
+  description = "image OCID, overrides image lookup by os"
+  default     = ""
+  type        = string
+}
+
+variable "vcn_cidr" {
+  description = "CIDR block of the VCN"
+  default     = "10.0.0.0/16"
+  type        = string
+}
+
+variable "subnet_cidr" {
+  description = "CIDR block of the subnet used by the instances and load balancers"
+  default     = "10.0.0.0/24"
+  type        = string
+}
+
+variable "control_plane_vm_count" {
+  default     = 3
+  type        = number
+  description = "Number of control plane nodes in the cluster"
+}
+
+variable "control_plane_shape" {
+  default = "VM.Standard.E4.Flex"
+  type    = string
+}
+
+variable "control_plane_ocpus" {
+  default = 2
+  type    = number
+}
+
+variable "control_plane_memory_in_gbs" {
+  default = 8
+  type    = number
+}
+
+variable "static_workers_count" {
+  default     = 2
+  type        = number
+  description = "Number of static worker nodes in the cluster"
+}
+
+variable "worker_shape" {
+  default = "VM.Standard.E4.Flex"
+  type    = string
+}
+
+variable "worker_ocpus" {
+  default = 2
+  type    = number
+}
+
+variable "worker_memory_in_gbs" {
+  default = 8
+  type    = number
+}
+
+variable "disk_size" {
+  description = "boot volume size in GB"
+  default     = 50
+  type        = number
+}
@@ -15,11 +15,12 @@ limitations under the License.
 */
 
 resource "openstack_lb_loadbalancer_v2" "kube_apiserver" {
-  name               = "${var.cluster_name}-kube-apiserver"
-  admin_state_up     = true
-  security_group_ids = [openstack_networking_secgroup_v2.securitygroup.id]
-  vip_network_id     = openstack_networking_network_v2.network.id
-  vip_subnet_id      = openstack_networking_subnet_v2.subnet.id
+  name                  = "${var.cluster_name}-kube-apiserver"
+  admin_state_up        = true
+  loadbalancer_provider = var.kubeapi_lb_provider
+  security_group_ids    = [openstack_networking_secgroup_v2.securitygroup.id]
+  vip_network_id        = openstack_networking_network_v2.network.id
+  vip_subnet_id         = openstack_networking_subnet_v2.subnet.id
 }
 
 resource "openstack_lb_pool_v2" "kube_apiservers" {
@@ -34,17 +35,22 @@ resource "openstack_lb_listener_v2" "kube_apiserver" {
   protocol        = "TCP"
   protocol_port   = 6443
   admin_state_up  = true
+  allowed_cidrs   = var.kubeapi_lb_allowed_cidrs
   default_pool_id = openstack_lb_pool_v2.kube_apiservers.id
   loadbalancer_id = openstack_lb_loadbalancer_v2.kube_apiserver.id
 }
 
 resource "openstack_lb_monitor_v2" "lb_monitor_tcp" {
-  name        = "${var.cluster_name}-kube-apiserver"
-  pool_id     = openstack_lb_pool_v2.kube_apiservers.id
-  type        = "TCP"
-  delay       = 30
-  timeout     = 10
-  max_retries = 5
+  name             = "${var.cluster_name}-kube-apiserver"
+  pool_id          = openstack_lb_pool_v2.kube_apiservers.id
+  type             = var.kubeapi_lb_monitor_type
+  delay            = var.kubeapi_lb_monitor_delay
+  timeout          = var.kubeapi_lb_monitor_timeout
+  max_retries      = var.kubeapi_lb_monitor_max_retries
+  max_retries_down = var.kubeapi_lb_monitor_max_retries_down
+  http_method      = var.kubeapi_lb_monitor_type == "HTTPS" ? "GET" : null
+  url_path         = var.kubeapi_lb_monitor_type == "HTTPS" ? var.kubeapi_lb_monitor_url_path : null
+  expected_codes   = var.kubeapi_lb_monitor_type == "HTTPS" ? "200" : null
 }
 
 resource "openstack_lb_member_v2" "kube_apiserver" {
@@ -56,10 +62,12 @@ resource "openstack_lb_member_v2" "kube_apiserver" {
 }
 
 resource "openstack_networking_floatingip_v2" "kube_apiserver" {
-  pool = var.external_network_name
+  count = var.kubeapi_lb_floating_ip_enabled ? 1 : 0
+  pool  = var.external_network_name
 }
 
 resource "openstack_networking_floatingip_associate_v2" "kube_apiserver" {
-  floating_ip = openstack_networking_floatingip_v2.kube_apiserver.address
+  count       = var.kubeapi_lb_floating_ip_enabled ? 1 : 0
+  floating_ip = openstack_networking_floatingip_v2.kube_apiserver[0].address
   port_id     = openstack_lb_loadbalancer_v2.kube_apiserver.vip_port_id
 }
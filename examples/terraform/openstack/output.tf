@@ -17,14 +17,16 @@ limitations under the License.
 locals {
   cluster_autoscaler_min_replicas = var.cluster_autoscaler_min_replicas > 0 ? var.cluster_autoscaler_min_replicas : var.initial_machinedeployment_replicas
   cluster_autoscaler_max_replicas = var.cluster_autoscaler_max_replicas > 0 ? var.cluster_autoscaler_max_replicas : var.initial_machinedeployment_replicas
+  kubeapi_endpoint                = var.kubeapi_lb_floating_ip_enabled ? openstack_networking_floatingip_v2.kube_apiserver[0].address : openstack_lb_loadbalancer_v2.kube_apiserver.vip_address
 }
 
 output "kubeone_api" {
   description = "kube-apiserver LB endpoint"
 
   value = {
-    endpoint                    = openstack_networking_floatingip_v2.kube_apiserver.address
-    apiserver_alternative_names = var.apiserver_alternative_names
+    version                     = "v1"
+    endpoint                    = local.kubeapi_endpoint
+    apiserver_alternative_names = distinct(concat(var.apiserver_alternative_names, [openstack_lb_loadbalancer_v2.kube_apiserver.vip_address]))
   }
 }
 
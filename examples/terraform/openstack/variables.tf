@@ -75,6 +75,66 @@ variable "subnet_dns_servers" {
   }
 }
 
+# Load Balancer Variables
+variable "kubeapi_lb_provider" {
+  default     = null
+  description = "Octavia provider for the kube-apiserver load balancer, e.g. amphora or ovn (default: the Octavia default provider)"
+  type        = string
+}
+
+variable "kubeapi_lb_floating_ip_enabled" {
+  default     = true
+  description = "associate a floating IP with the kube-apiserver load balancer, if disabled the load balancer VIP is used as the API endpoint"
+  type        = bool
+}
+
+variable "kubeapi_lb_allowed_cidrs" {
+  default     = []
+  description = "list of CIDRs allowed to access the kube-apiserver load balancer (default: no restrictions)"
+  type        = list(string)
+}
+
+variable "kubeapi_lb_monitor_type" {
+  default     = "TCP"
+  description = "type of the kube-apiserver load balancer health monitor, HTTPS checks the kube-apiserver readiness endpoint"
+  type        = string
+
+  validation {
+    condition     = contains(["TCP", "TLS-HELLO", "HTTPS"], var.kubeapi_lb_monitor_type)
+    error_message = "The health monitor type must be one of TCP, TLS-HELLO or HTTPS."
+  }
+}
+
+variable "kubeapi_lb_monitor_url_path" {
+  default     = "/readyz"
+  description = "URL path requested by the HTTPS kube-apiserver load balancer health monitor"
+  type        = string
+}
+
+variable "kubeapi_lb_monitor_delay" {
+  default     = 30
+  description = "interval in seconds between the kube-apiserver load balancer health checks"
+  type        = number
+}
+
+variable "kubeapi_lb_monitor_timeout" {
+  default     = 10
+  description = "timeout in seconds of the kube-apiserver load balancer health check"
+  type        = number
+}
+
+variable "kubeapi_lb_monitor_max_retries" {
+  default     = 5
+  description = "number of successful health checks before a kube-apiserver is marked as healthy"
+  type        = number
+}
+
+variable "kubeapi_lb_monitor_max_retries_down" {
+  default     = 3
+  description = "number of failed health checks before a kube-apiserver is marked as unhealthy"
+  type        = number
+}
+
 # Controlplane Variables
 variable "control_plane_vm_count" {
   description = "number of control plane instances"
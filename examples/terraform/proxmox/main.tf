@@ -0,0 +1,241 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+provider "proxmox" {
+  /*
+  See https://registry.terraform.io/providers/bpg/proxmox/latest/docs#argument-reference
+  for config options reference
+  */
+}
+
+locals {
+  ssh_public_key = trimspace(file(var.ssh_public_key_file))
+
+  # The first address reported by the QEMU guest agent belongs to the loopback
+  # interface, the second one to the first network interface
+  control_plane_ips  = [for vm in proxmox_virtual_environment_vm.control_plane : vm.ipv4_addresses[1][0]]
+  static_workers_ips = [for vm in proxmox_virtual_environment_vm.static_workers1 : vm.ipv4_addresses[1][0]]
+}
+
+resource "proxmox_virtual_environment_vm" "control_plane" {
+  count = var.control_plane_vm_count
+
+  name      = "${var.cluster_name}-cp-${count.index + 1}"
+  node_name = var.proxmox_node
+  tags      = ["kubeone", var.cluster_name]
+  on_boot   = true
+
+  clone {
+    vm_id = var.template_vm_id
+    full  = true
+  }
+
+  cpu {
+    cores = var.control_plane_cores
+    type  = "host"
+  }
+
+  memory {
+    dedicated = var.control_plane_memory
+  }
+
+  disk {
+    datastore_id = var.datastore_id
+    interface    = "scsi0"
+    size         = var.disk_size
+  }
+
+  network_device {
+    bridge  = var.network_bridge
+    vlan_id = var.network_vlan_id
+  }
+
+  # The template must have the QEMU guest agent installed, it's used to
+  # discover the IP addresses assigned to the VM
+  agent {
+    enabled = true
+  }
+
+  # cloud-init configures the hostname (from the VM name), the SSH user and
+  # keys, and the network of the VM
+  initialization {
+    datastore_id = var.cloudinit_datastore_id
+
+    ip_config {
+      ipv4 {
+        address = length(var.control_plane_ipv4_addresses) > 0 ? var.control_plane_ipv4_addresses[count.index] : "dhcp"
+        gateway = length(var.control_plane_ipv4_addresses) > 0 ? var.ipv4_gateway : null
+      }
+    }
+
+    dynamic "dns" {
+      for_each = length(var.dns_servers) > 0 ? [1] : []
+      content {
+        servers = var.dns_servers
+      }
+    }
+
+    user_account {
+      username = var.ssh_username
+      keys     = [local.ssh_public_key]
+    }
+  }
+
+  lifecycle {
+    ignore_changes = [
+      initialization,
+      tags,
+    ]
+  }
+}
+
+resource "proxmox_virtual_environment_vm" "static_workers1" {
+  count = var.static_workers_vm_count
+
+  name      = "${var.cluster_name}-pool1-${count.index + 1}"
+  node_name = var.proxmox_node
+  tags      = ["kubeone", var.cluster_name]
+  on_boot   = true
+
+  clone {
+    vm_id = var.template_vm_id
+    full  = true
+  }
+
+  cpu {
+    cores = var.worker_cores
+    type  = "host"
+  }
+
+  memory {
+    dedicated = var.worker_memory
+  }
+
+  disk {
+    datastore_id = var.datastore_id
+    interface    = "scsi0"
+    size         = var.worker_disk
+  }
+
+  network_device {
+    bridge  = var.network_bridge
+    vlan_id = var.network_vlan_id
+  }
+
+  agent {
+    enabled = true
+  }
+
+  initialization {
+    datastore_id = var.cloudinit_datastore_id
+
+    ip_config {
+      ipv4 {
+        address = length(var.static_workers_ipv4_addresses) > 0 ? var.static_workers_ipv4_addresses[count.index] : "dhcp"
+        gateway = length(var.static_workers_ipv4_addresses) > 0 ? var.ipv4_gateway : null
+      }
+    }
+
+    dynamic "dns" {
+      for_each = length(var.dns_servers) > 0 ? [1] : []
+      content {
+        servers = var.dns_servers
+      }
+    }
+
+    user_account {
+      username = var.ssh_username
+      keys     = [local.ssh_public_key]
+    }
+  }
+
+  lifecycle {
+    ignore_changes = [
+      initialization,
+      tags,
+    ]
+  }
+}
+
+resource "null_resource" "keepalived_install" {
+  count = var.api_vip != "" ? var.control_plane_vm_count : 0
+
+  connection {
+    type         = "ssh"
+    user         = var.ssh_username
+    host         = local.control_plane_ips[count.index]
+    bastion_host = var.bastion_host
+    bastion_port = var.bastion_port
+    bastion_user = var.bastion_username
+  }
+
+  provisioner "remote-exec" {
+    script = "keepalived.sh"
+  }
+}
+
+resource "random_string" "keepalived_auth_pass" {
+  length  = 8
+  special = false
+}
+
+resource "null_resource" "keepalived_config" {
+  count = var.api_vip != "" ? var.control_plane_vm_count : 0
+
+  depends_on = [null_resource.keepalived_install]
+
+  triggers = {
+    cluster_instance_ids = join(",", proxmox_virtual_environment_vm.control_plane.*.id)
+  }
+
+  connection {
+    type         = "ssh"
+    user         = var.ssh_username
+    host         = local.control_plane_ips[count.index]
+    bastion_host = var.bastion_host
+    bastion_port = var.bastion_port
+    bastion_user = var.bastion_username
+  }
+
+  provisioner "file" {
+    content = templatefile("./etc_keepalived_keepalived_conf.tpl", {
+      STATE         = count.index == 0 ? "MASTER" : "BACKUP",
+      APISERVER_VIP = var.api_vip,
+      INTERFACE     = var.vrrp_interface,
+      ROUTER_ID     = var.vrrp_router_id,
+      PRIORITY      = count.index == 0 ? "101" : "100",
+      AUTH_PASS     = random_string.keepalived_auth_pass.result
+    })
+    destination = "/tmp/keepalived.conf"
+  }
+
+  provisioner "file" {
+    content = templatefile("./etc_keepalived_check_apiserver_sh.tpl", {
+      APISERVER_VIP = var.api_vip
+    })
+    destination = "/tmp/check_apiserver.sh"
+  }
+
+  provisioner "remote-exec" {
+    inline = [
+      "sudo mkdir -p /etc/keepalived",
+      "sudo mv /tmp/keepalived.conf /etc/keepalived/keepalived.conf",
+      "sudo mv /tmp/check_apiserver.sh /etc/keepalived/check_apiserver.sh",
+      "sudo chmod +x /etc/keepalived/check_apiserver.sh",
+      "sudo systemctl restart keepalived",
+    ]
+  }
+}
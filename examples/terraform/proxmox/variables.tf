@@ -0,0 +1,227 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+variable "cluster_name" {
+  description = "Name of the cluster"
+  type        = string
+
+  validation {
+    condition     = can(regex("^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$", var.cluster_name))
+    error_message = "Value of cluster_name should be lowercase and can only contain alphanumeric characters and hyphens(-)."
+  }
+}
+
+variable "apiserver_alternative_names" {
+  description = "subject alternative names for the API Server signing cert."
+  default     = []
+  type        = list(string)
+}
+
+variable "os" {
+  description = "Operating system of the VM template"
+
+  # valid choices are:
+  # * ubuntu
+  # * rockylinux
+  default = "ubuntu"
+  type    = string
+}
+
+variable "ssh_public_key_file" {
+  description = "SSH public key file"
+  default     = "~/.ssh/id_rsa.pub"
+  type        = string
+}
+
+variable "ssh_port" {
+  description = "SSH port to be used to provision instances"
+  default     = 22
+  type        = number
+}
+
+variable "ssh_username" {
+  description = "SSH user created by cloud-init"
+  default     = "kubeone"
+  type        = string
+}
+
+variable "ssh_private_key_file" {
+  description = "SSH private key file used to access instances"
+  default     = ""
+  type        = string
+}
+
+variable "ssh_agent_socket" {
+  description = "SSH Agent socket, default to grab from $SSH_AUTH_SOCK"
+  default     = "env:SSH_AUTH_SOCK"
+  type        = string
+}
+
+variable "bastion_host" {
+  description = "ssh jumphost (bastion) hostname"
+  default     = ""
+  type        = string
+}
+
+variable "bastion_port" {
+  description = "ssh jumphost (bastion) port"
+  type        = number
+  default     = 22
+}
+
+variable "bastion_username" {
+  description = "ssh jumphost (bastion) username"
+  default     = ""
+  type        = string
+}
+
+variable "ssh_hosts_keys" {
+  default     = null
+  description = "A list of SSH hosts public keys to verify"
+  type        = list(string)
+}
+
+variable "bastion_host_key" {
+  description = "Bastion SSH host public key"
+  default     = null
+  type        = string
+}
+
+# provider specific settings
+
+variable "proxmox_node" {
+  description = "name of the Proxmox VE node on which the VMs will be created"
+  type        = string
+}
+
+variable "template_vm_id" {
+  description = "ID of the cloud-init enabled VM template to clone, the template must have the QEMU guest agent installed"
+  type        = number
+}
+
+variable "datastore_id" {
+  default     = "local-lvm"
+  description = "datastore used for the VM disks"
+  type        = string
+}
+
+variable "cloudinit_datastore_id" {
+  default     = "local-lvm"
+  description = "datastore used for the cloud-init drive"
+  type        = string
+}
+
+variable "network_bridge" {
+  default     = "vmbr0"
+  description = "network bridge the VMs are attached to"
+  type        = string
+}
+
+variable "network_vlan_id" {
+  default     = null
+  description = "VLAN ID of the VM network interfaces"
+  type        = number
+}
+
+variable "control_plane_ipv4_addresses" {
+  default     = []
+  description = "static IPv4 addresses in CIDR notation for the control plane VMs, DHCP is used if empty"
+  type        = list(string)
+}
+
+variable "static_workers_ipv4_addresses" {
+  default     = []
+  description = "static IPv4 addresses in CIDR notation for the static worker VMs, DHCP is used if empty"
+  type        = list(string)
+}
+
+variable "ipv4_gateway" {
+  default     = ""
+  description = "IPv4 gateway, required if static IPv4 addresses are used"
+  type        = string
+}
+
+variable "dns_servers" {
+  default     = []
+  description = "DNS servers configured by cloud-init, the DHCP provided servers are used if empty"
+  type        = list(string)
+}
+
+variable "disk_size" {
+  default     = 50
+  description = "disk size of each control plane node in GB"
+  type        = number
+}
+
+variable "control_plane_vm_count" {
+  default     = 3
+  description = "number of control plane VMs"
+  type        = number
+}
+
+variable "control_plane_memory" {
+  default     = 4096
+  description = "memory size of each control plane node in MB"
+  type        = number
+}
+
+variable "control_plane_cores" {
+  default     = 2
+  description = "number of CPU cores of each control plane node"
+  type        = number
+}
+
+variable "static_workers_vm_count" {
+  default     = 2
+  description = "number of static worker VMs"
+  type        = number
+}
+
+variable "worker_memory" {
+  default     = 4096
+  description = "memory size of each worker node in MB"
+  type        = number
+}
+
+variable "worker_cores" {
+  default     = 2
+  description = "number of CPU cores of each worker node"
+  type        = number
+}
+
+variable "worker_disk" {
+  default     = 50
+  description = "disk size of each worker node in GB"
+  type        = number
+}
+
+variable "api_vip" {
+  default     = ""
+  description = "virtual IP address for Kubernetes API"
+  type        = string
+}
+
+variable "vrrp_interface" {
+  default     = "ens18"
+  description = "network interface for API virtual IP"
+  type        = string
+}
+
+variable "vrrp_router_id" {
+  default     = 42
+  description = "vrrp router id for API virtual IP. Must be unique in used subnet"
+  type        = number
+}
@@ -18,6 +18,7 @@ output "kubeone_api" {
   description = "kube-apiserver LB endpoint"
 
   value = {
+    version                     = "v1"
     endpoint                    = var.api_vip != "" ? var.api_vip : vsphere_virtual_machine.control_plane[0].default_ip_address
     apiserver_alternative_names = var.apiserver_alternative_names
   }
@@ -99,6 +100,9 @@ output "kubeone_workers" {
           vmNetName      = var.network_name
           resourcePool   = var.resource_pool_name
           folder         = var.folder_name
+          # Spread the worker VMs across different ESXi hosts. Requires DRS,
+          # which is available only with the vSphere Enterprise Plus license.
+          vmAntiAffinity = var.is_vsphere_enterprise_plus_license
         }
         network = {
           ipFamily = var.ip_family
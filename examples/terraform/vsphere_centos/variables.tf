@@ -245,7 +245,7 @@ EOF
 }
 
 variable "is_vsphere_enterprise_plus_license" {
-  description = "toggle on/off based on your vsphere enterprise license"
+  description = "toggle on/off based on your vsphere enterprise license, enables VM anti-affinity for control plane and worker VMs"
   type        = bool
   default     = true
 }
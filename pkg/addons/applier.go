@@ -39,6 +39,7 @@ import (
 	"k8c.io/kubeone/pkg/templates/images"
 	"k8c.io/kubeone/pkg/templates/resources"
 
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/yaml"
 )
 
@@ -63,6 +64,10 @@ type applier struct {
 	TemplateData templateData
 	LocalFS      fs.FS
 	EmbeddedFS   fs.FS
+
+	// ImagesVerifier verifies the overridden images used by the addons
+	ImagesVerifier *cosignVerifier
+	verifiedImages sets.String
 }
 
 // TemplateData is data available in the addons render template
@@ -86,6 +91,9 @@ type templateData struct {
 	InternalImages                           *internalImages
 	Resources                                map[string]string
 	Params                                   map[string]string
+	CCMExtraFlags                            []string
+	EtcdBackups                              kubeoneapi.EtcdBackupsConfig
+	CredentialsEtcdBackups                   map[string]string
 }
 
 type registryCredentialsContainer struct {
@@ -212,9 +220,11 @@ func newAddonsApplier(s *state.State) (*applier, error) {
 		InternalImages: &internalImages{
 			pauseImage: s.PauseImage,
 			resolver:   s.Images.Get,
+			ccmOpts:    ccmImageOpts(s.Cluster.CloudProvider.CloudControllerManager),
 		},
-		Resources: resources.All(),
-		Params:    params,
+		Resources:     resources.All(),
+		Params:        params,
+		CCMExtraFlags: ccmExtraFlags(s.Cluster.CloudProvider.CloudControllerManager),
 	}
 
 	if err := csiWebhookCerts(s, &data, csiMigration, kubeCAPrivateKey, kubeCACert); err != nil {
@@ -234,7 +244,7 @@ func newAddonsApplier(s *state.State) (*applier, error) {
 			return nil, err
 		}
 
-		credsOSM, err := credentials.ProviderCredentials(s.Cluster.CloudProvider, s.CredentialsFilePath, credentials.TypeOSM)
+		credsOSM, err := credentials.WorkersCredentials(s.Cluster, s.CredentialsFilePath, credentials.TypeOSM)
 		if err != nil {
 			return nil, err
 		}
@@ -254,10 +264,49 @@ func newAddonsApplier(s *state.State) (*applier, error) {
 		data.OperatingSystemManagerCredentialsHash = osmCredsHash
 	}
 
+	// Certs for ingress-nginx admission webhook
+	if s.Cluster.IngressNginxEnabled() {
+		if err := webhookCerts(data.Certificates,
+			"IngressNginx",
+			resources.IngressNginxAdmissionName,
+			resources.IngressNginxNamespace,
+			s.Cluster.ClusterNetwork.ServiceDomainName,
+			kubeCAPrivateKey,
+			kubeCACert,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	if s.Cluster.EtcdBackupsEnabled() {
+		credsEtcdBackups, err := credentials.EtcdBackups(s.CredentialsFilePath)
+		if err != nil {
+			return nil, err
+		}
+
+		data.EtcdBackups = *s.Cluster.Backups.Etcd
+		data.CredentialsEtcdBackups = credsEtcdBackups
+	}
+
+	var imagesVerifier *cosignVerifier
+	if s.Cluster.Addons.Enabled() && s.Cluster.Addons.Verification != nil && s.Cluster.Addons.Verification.Images {
+		imagesVerifier = newCosignVerifier(s.Cluster.Addons.Verification)
+
+		// the images are overridden if they're resolved differently than
+		// without the registry and the CCM image overrides
+		defaultResolver := images.NewResolver(images.WithKubernetesVersionGetter(func() string {
+			return s.Cluster.Versions.Kubernetes
+		}))
+		data.InternalImages.defaultResolver = defaultResolver.Get
+		data.InternalImages.overridden = sets.NewString()
+	}
+
 	return &applier{
-		TemplateData: data,
-		LocalFS:      localFS,
-		EmbeddedFS:   embeddedaddons.FS,
+		TemplateData:   data,
+		LocalFS:        localFS,
+		EmbeddedFS:     embeddedaddons.FS,
+		ImagesVerifier: imagesVerifier,
+		verifiedImages: sets.NewString(),
 	}, nil
 }
 
@@ -368,7 +417,7 @@ func mcCredentialsEnvVars(s *state.State) ([]byte, error) {
 	var credsEnvVarsMC []byte
 
 	if s.Cluster.MachineController.Deploy {
-		credsMC, err := credentials.ProviderCredentials(s.Cluster.CloudProvider, s.CredentialsFilePath, credentials.TypeMC)
+		credsMC, err := credentials.WorkersCredentials(s.Cluster, s.CredentialsFilePath, credentials.TypeMC)
 		if err != nil {
 			return nil, err
 		}
@@ -384,7 +433,17 @@ func mcCredentialsEnvVars(s *state.State) ([]byte, error) {
 }
 
 func credentialsHash(s *state.State, credsType credentials.Type) (string, error) {
-	creds, err := credentials.ProviderCredentials(s.Cluster.CloudProvider, s.CredentialsFilePath, credsType)
+	var (
+		creds map[string]string
+		err   error
+	)
+
+	switch credsType {
+	case credentials.TypeMC, credentials.TypeOSM:
+		creds, err = credentials.WorkersCredentials(s.Cluster, s.CredentialsFilePath, credsType)
+	default:
+		creds, err = credentials.ProviderCredentials(s.Cluster.CloudProvider, s.CredentialsFilePath, credsType)
+	}
 	if err != nil {
 		return "", err
 	}
@@ -422,13 +481,14 @@ func addonsLocalFS(clusterAddons *kubeoneapi.Addons, manifestFilePath string) (f
 	return localFS, nil
 }
 
-// loadAndApplyAddon parses the addons manifests and runs kubectl apply.
-func (a *applier) loadAndApplyAddon(s *state.State, fsys fs.FS, addonName string) error {
+// loadAndApplyAddon parses the addons manifests and runs kubectl apply. It
+// returns the applied manifest.
+func (a *applier) loadAndApplyAddon(s *state.State, fsys fs.FS, addonName string) (string, error) {
 	s.Logger.Infof("Applying addon %s...", addonName)
 
 	manifest, err := a.getManifestsFromDirectory(s, fsys, addonName)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	if len(strings.TrimSpace(manifest)) == 0 {
@@ -436,10 +496,37 @@ func (a *applier) loadAndApplyAddon(s *state.State, fsys fs.FS, addonName string
 			s.Logger.Warnf("Addon directory %q is empty, skipping...", addonName)
 		}
 
+		return "", nil
+	}
+
+	if err = a.verifyOverriddenImages(s); err != nil {
+		return "", err
+	}
+
+	return manifest, runKubectlApply(s, manifest, addonName)
+}
+
+// verifyOverriddenImages verifies the signatures of the overridden images
+// used by the rendered addons, if the images verification is enabled
+func (a *applier) verifyOverriddenImages(s *state.State) error {
+	im := a.TemplateData.InternalImages
+	if a.ImagesVerifier == nil || im == nil {
 		return nil
 	}
 
-	return runKubectlApply(s, manifest, addonName)
+	for _, ref := range im.overridden.List() {
+		if a.verifiedImages.Has(ref) {
+			continue
+		}
+
+		s.Logger.Infof("Verifying signature of %q...", ref)
+		if err := a.ImagesVerifier.verifyImage(s.Context, ref); err != nil {
+			return err
+		}
+		a.verifiedImages.Insert(ref)
+	}
+
+	return nil
 }
 
 // loadAndApplyAddon parses the addons manifests and runs kubectl apply.
@@ -502,9 +589,31 @@ func runKubectlDelete(s *state.State, manifest string, addonName string) error {
 	})
 }
 
+// ccmImages is a set of external cloud-controller-manager images that can be
+// customized via the .cloudProvider.cloudControllerManager block
+var ccmImages = map[images.Resource]bool{
+	images.AwsCCM:          true,
+	images.AzureCCM:        true,
+	images.DigitaloceanCCM: true,
+	images.EquinixMetalCCM: true,
+	images.HetznerCCM:      true,
+	images.KubevirtCCM:     true,
+	images.NutanixCCM:      true,
+	images.OCICCM:          true,
+	images.OpenstackCCM:    true,
+	images.VsphereCCM:      true,
+}
+
 type internalImages struct {
 	pauseImage string
 	resolver   func(images.Resource, ...images.GetOpt) string
+	ccmOpts    []images.GetOpt
+
+	// defaultResolver resolves the images without the overrides. It's set
+	// only if the overridden images are verified, along with overridden
+	// holding the references of the overridden images used by the addons.
+	defaultResolver func(images.Resource, ...images.GetOpt) string
+	overridden      sets.String
 }
 
 func (im *internalImages) Get(imgName string) (string, error) {
@@ -518,5 +627,59 @@ func (im *internalImages) Get(imgName string) (string, error) {
 		return "", err
 	}
 
-	return im.resolver(res), nil
+	var opts []images.GetOpt
+	if ccmImages[res] {
+		opts = im.ccmOpts
+	}
+
+	ref := im.resolver(res, opts...)
+	if im.defaultResolver != nil && ref != im.defaultResolver(res) {
+		im.overridden.Insert(ref)
+	}
+
+	return ref, nil
+}
+
+// amd64OnlyImages returns references of the images published only for amd64
+func (im *internalImages) amd64OnlyImages() sets.String {
+	refs := sets.NewString()
+	for _, res := range images.AMD64OnlyResources() {
+		if ref, err := im.Get(res.String()); err == nil {
+			refs.Insert(ref)
+		}
+	}
+
+	return refs
+}
+
+func ccmImageOpts(ccm *kubeoneapi.CloudControllerManagerConfig) []images.GetOpt {
+	var opts []images.GetOpt
+	if ccm == nil {
+		return opts
+	}
+
+	if ccm.ImageRepository != "" {
+		opts = append(opts, images.WithRepository(ccm.ImageRepository))
+	}
+	if ccm.ImageTag != "" {
+		opts = append(opts, images.WithTag(ccm.ImageTag))
+	}
+
+	return opts
+}
+
+// ccmExtraFlags returns user provided cloud-controller-manager flags sorted by
+// name, so that the rendered manifest is stable
+func ccmExtraFlags(ccm *kubeoneapi.CloudControllerManagerConfig) []string {
+	var flags []string
+	if ccm == nil {
+		return flags
+	}
+
+	for name, value := range ccm.Flags {
+		flags = append(flags, fmt.Sprintf("--%s=%s", name, value))
+	}
+	sort.Strings(flags)
+
+	return flags
 }
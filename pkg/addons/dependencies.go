@@ -0,0 +1,89 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addons
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/fail"
+)
+
+// orderAddons sorts the addon names so every addon comes after the addons it
+// depends on. Addons without dependencies between them are sorted by name.
+// Dependencies on the addons deployed by KubeOne itself are satisfied, as
+// those addons are applied before the user addons.
+func orderAddons(names []string, configs map[string]kubeoneapi.Addon) ([]string, error) {
+	sorted := append([]string{}, names...)
+	sort.Strings(sorted)
+
+	pending := map[string]bool{}
+	for _, name := range sorted {
+		pending[name] = true
+	}
+
+	var (
+		ordered  []string
+		visiting = map[string]bool{}
+		visit    func(name string) error
+	)
+
+	visit = func(name string) error {
+		if !pending[name] {
+			return nil
+		}
+		if visiting[name] {
+			return fail.RuntimeError{
+				Op:  fmt.Sprintf("ordering %q addon", name),
+				Err: errors.New("addon dependencies form a cycle"),
+			}
+		}
+
+		visiting[name] = true
+		for _, dep := range configs[name].DependsOn {
+			_, isEmbedded := embeddedAddons[dep]
+			_, isApplied := pending[dep]
+			if !isApplied && !isEmbedded {
+				return fail.RuntimeError{
+					Op:  fmt.Sprintf("ordering %q addon", name),
+					Err: errors.Errorf("addon depends on %q addon, which is not applied", dep),
+				}
+			}
+
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		delete(visiting, name)
+
+		pending[name] = false
+		ordered = append(ordered, name)
+
+		return nil
+	}
+
+	for _, name := range sorted {
+		if err := visit(name); err != nil {
+			return nil, err
+		}
+	}
+
+	return ordered, nil
+}
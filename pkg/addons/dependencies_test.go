@@ -0,0 +1,93 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addons
+
+import (
+	"reflect"
+	"testing"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/templates/resources"
+)
+
+func Test_orderAddons(t *testing.T) {
+	tests := []struct {
+		name    string
+		names   []string
+		configs []kubeoneapi.Addon
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:  "no dependencies",
+			names: []string{"c", "a", "b"},
+			want:  []string{"a", "b", "c"},
+		},
+		{
+			name:  "dependencies",
+			names: []string{"a", "b", "c", "d"},
+			configs: []kubeoneapi.Addon{
+				{Name: "a", DependsOn: []string{"d"}},
+				{Name: "b", DependsOn: []string{"a", "c"}},
+			},
+			want: []string{"d", "a", "c", "b"},
+		},
+		{
+			name:  "dependency on the addon deployed by KubeOne",
+			names: []string{"a"},
+			configs: []kubeoneapi.Addon{
+				{Name: "a", DependsOn: []string{resources.AddonMachineController}},
+			},
+			want: []string{"a"},
+		},
+		{
+			name:  "dependency on the addon that is not applied",
+			names: []string{"a"},
+			configs: []kubeoneapi.Addon{
+				{Name: "a", DependsOn: []string{"b"}},
+			},
+			wantErr: true,
+		},
+		{
+			name:  "dependency cycle",
+			names: []string{"a", "b"},
+			configs: []kubeoneapi.Addon{
+				{Name: "a", DependsOn: []string{"b"}},
+				{Name: "b", DependsOn: []string{"a"}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			configs := map[string]kubeoneapi.Addon{}
+			for _, addon := range tt.configs {
+				configs[addon.Name] = addon
+			}
+
+			got, err := orderAddons(tt.names, configs)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("orderAddons() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("orderAddons() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
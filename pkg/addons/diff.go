@@ -0,0 +1,229 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addons
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/executor"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/state"
+)
+
+var kubectlDiffScript = heredoc.Doc(`
+	sudo KUBECONFIG=/etc/kubernetes/admin.conf \
+	kubectl diff -f - --prune -l "%s=%s"
+`)
+
+// Diff renders the addons deployed by KubeOne and the user addons, and prints
+// the changes applying them would make to the cluster, as reported by kubectl
+// diff using the server-side dry-run. The objects of the deleted addons, and
+// the objects that would be pruned using the addons inventory, are listed as
+// well. Nothing is changed in the cluster.
+//
+// The webhook certificates are generated on each render, so the Secrets with
+// them are always reported as changed.
+func Diff(s *state.State) error {
+	applier, err := newAddonsApplier(s)
+	if err != nil {
+		return err
+	}
+
+	for _, add := range collectAddons(s) {
+		if err = applier.diffAddon(s, add.name); err != nil {
+			return err
+		}
+	}
+
+	if !s.Cluster.Addons.Enabled() {
+		return nil
+	}
+
+	orderedAddons, deletedAddons, err := userAddonNames(s, applier.LocalFS)
+	if err != nil {
+		return err
+	}
+
+	for _, addonName := range deletedAddons {
+		manifest, mErr := applier.getManifestsFromDirectory(s, applier.EmbeddedFS, addonName)
+		if mErr != nil {
+			return mErr
+		}
+
+		objects, oErr := manifestObjects(manifest)
+		if oErr != nil {
+			return oErr
+		}
+
+		fmt.Printf("Addon %q will be deleted:\n", addonName)
+		for _, obj := range objects {
+			fmt.Printf("\t- %s\n", objectKey(obj))
+		}
+	}
+
+	inventory := addonsInventory{}
+
+	for _, addonName := range orderedAddons {
+		fsys, fErr := applier.addonFS(addonName)
+		if fErr != nil {
+			return fErr
+		}
+		if fsys == nil {
+			return fail.NewRuntimeError(fmt.Sprintf("diffing %q addon", addonName), "addon does not exist")
+		}
+
+		manifest, dErr := applier.diffManifest(s, fsys, addonName)
+		if dErr != nil {
+			return dErr
+		}
+		if inventory[addonName], err = manifestObjects(manifest); err != nil {
+			return err
+		}
+	}
+
+	if applier.LocalFS != nil {
+		manifest, dErr := applier.diffManifest(s, applier.LocalFS, "")
+		if dErr != nil {
+			return dErr
+		}
+		if inventory[rootAddonInventoryKey], err = manifestObjects(manifest); err != nil {
+			return err
+		}
+	}
+
+	previousInventory, err := loadAddonsInventory(s)
+	if err != nil {
+		return err
+	}
+
+	printPrunedObjects(os.Stdout, previousInventory, inventory)
+
+	return nil
+}
+
+// diffAddon prints the changes of the addon with the given name, looking it
+// up like EnsureAddonByName
+func (a *applier) diffAddon(s *state.State, addonName string) error {
+	fsys, err := a.addonFS(addonName)
+	if err != nil {
+		return err
+	}
+	if fsys == nil {
+		return fail.NewRuntimeError(fmt.Sprintf("diffing %q addon", addonName), "addon does not exist")
+	}
+
+	_, err = a.diffManifest(s, fsys, addonName)
+
+	return err
+}
+
+// diffManifest renders the addon, prints its changes and returns the rendered
+// manifest
+func (a *applier) diffManifest(s *state.State, fsys fs.FS, addonName string) (string, error) {
+	manifest, err := a.getManifestsFromDirectory(s, fsys, addonName)
+	if err != nil {
+		return "", err
+	}
+
+	if len(strings.TrimSpace(manifest)) == 0 {
+		return "", nil
+	}
+
+	title := fmt.Sprintf("Addon %q", addonName)
+	if addonName == "" {
+		title = "Addons in the root directory"
+	}
+
+	diff, err := runKubectlDiff(s, manifest, addonName)
+	if err != nil {
+		return "", err
+	}
+
+	if diff == "" {
+		fmt.Printf("%s: no changes\n", title)
+	} else {
+		fmt.Printf("%s:\n%s\n", title, diff)
+	}
+
+	return manifest, nil
+}
+
+// runKubectlDiff runs kubectl diff command on the leader and returns the diff
+func runKubectlDiff(s *state.State, manifest string, addonName string) (string, error) {
+	var diff string
+
+	err := s.RunTaskOnLeader(func(s *state.State, _ *kubeoneapi.HostConfig, conn executor.Interface) error {
+		var err error
+		diff, err = kubectlDiff(conn, manifest, addonName, s.Verbose)
+
+		return err
+	})
+
+	return diff, err
+}
+
+// kubectlDiff runs kubectl diff command over the connection and returns the
+// diff, which is empty if there are no differences
+func kubectlDiff(conn executor.Interface, manifest string, addonName string, verbose bool) (string, error) {
+	var (
+		cmd            = fmt.Sprintf(kubectlDiffScript, addonLabel, addonName)
+		stdin          = strings.NewReader(manifest)
+		stdout, stderr strings.Builder
+	)
+
+	exitCode, err := conn.POpen(cmd, stdin, &stdout, &stderr)
+	if verbose {
+		fmt.Printf("+ %s\n", cmd)
+		fmt.Printf("%s", stderr.String())
+	}
+
+	// kubectl diff exits with 1 if there are differences, and with a greater
+	// exit code if it fails
+	if err != nil && exitCode != 1 {
+		return "", fail.Runtime(fmt.Errorf("%w: %s", err, stderr.String()), "diffing %q addon", addonName)
+	}
+
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// printPrunedObjects prints the objects from the previous inventory that would
+// be pruned by the next apply
+func printPrunedObjects(w io.Writer, previous, current addonsInventory) {
+	addonNames := make([]string, 0, len(previous))
+	for addonName := range previous {
+		addonNames = append(addonNames, addonName)
+	}
+	sort.Strings(addonNames)
+
+	for _, addonName := range addonNames {
+		for _, obj := range previous[addonName] {
+			if current.has(obj) {
+				continue
+			}
+
+			fmt.Fprintf(w, "Object %s of addon %q will be pruned\n", objectKey(obj), addonName)
+		}
+	}
+}
@@ -0,0 +1,327 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addons
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/state"
+	"k8c.io/kubeone/pkg/templates/resources"
+)
+
+type fakeExecutor struct {
+	stdout   string
+	stderr   string
+	exitCode int
+	err      error
+
+	cmd   string
+	stdin string
+}
+
+func (f *fakeExecutor) Exec(string) (string, string, int, error) {
+	return "", "", 0, errors.New("not implemented")
+}
+
+func (f *fakeExecutor) POpen(cmd string, stdin io.Reader, stdout io.Writer, stderr io.Writer) (int, error) {
+	f.cmd = cmd
+
+	in, err := io.ReadAll(stdin)
+	if err != nil {
+		return 0, err
+	}
+	f.stdin = string(in)
+
+	if _, err = io.WriteString(stdout, f.stdout); err != nil {
+		return 0, err
+	}
+	if _, err = io.WriteString(stderr, f.stderr); err != nil {
+		return 0, err
+	}
+
+	return f.exitCode, f.err
+}
+
+func (f *fakeExecutor) Close() error {
+	return nil
+}
+
+func TestKubectlDiff(t *testing.T) {
+	errExit := errors.New("process exited with non-zero exit code")
+
+	tests := []struct {
+		name     string
+		conn     *fakeExecutor
+		want     string
+		wantErr  bool
+		errorMsg string
+	}{
+		{
+			name: "no differences",
+			conn: &fakeExecutor{},
+			want: "",
+		},
+		{
+			name: "differences",
+			conn: &fakeExecutor{
+				stdout:   "\n-  replicas: 1\n+  replicas: 2\n",
+				exitCode: 1,
+				err:      errExit,
+			},
+			want: "-  replicas: 1\n+  replicas: 2",
+		},
+		{
+			name: "kubectl failed",
+			conn: &fakeExecutor{
+				stderr:   "error: unable to recognize \"STDIN\"",
+				exitCode: 2,
+				err:      errExit,
+			},
+			wantErr:  true,
+			errorMsg: "unable to recognize",
+		},
+		{
+			name: "connection failed",
+			conn: &fakeExecutor{
+				exitCode: -1,
+				err:      errors.New("connection lost"),
+			},
+			wantErr:  true,
+			errorMsg: "connection lost",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := kubectlDiff(tt.conn, "kind: ConfigMap", "example", false)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("kubectlDiff() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !strings.Contains(err.Error(), tt.errorMsg) {
+				t.Errorf("kubectlDiff() error = %v, want it to contain %q", err, tt.errorMsg)
+			}
+			if got != tt.want {
+				t.Errorf("kubectlDiff() = %q, want %q", got, tt.want)
+			}
+
+			if !strings.Contains(tt.conn.cmd, `-l "`+addonLabel+`=example"`) {
+				t.Errorf("kubectl diff command %q doesn't select the addon objects", tt.conn.cmd)
+			}
+			if tt.conn.stdin != "kind: ConfigMap" {
+				t.Errorf("kubectl diff stdin = %q, want the manifest", tt.conn.stdin)
+			}
+		})
+	}
+}
+
+func TestPrintPrunedObjects(t *testing.T) {
+	deployment := inventoryObject{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "example", Name: "example"}
+	service := inventoryObject{APIVersion: "v1", Kind: "Service", Namespace: "example", Name: "example"}
+	namespace := inventoryObject{APIVersion: "v1", Kind: "Namespace", Name: "example"}
+
+	tests := []struct {
+		name     string
+		previous addonsInventory
+		current  addonsInventory
+		want     string
+	}{
+		{
+			name:     "no previous inventory",
+			previous: addonsInventory{},
+			current:  addonsInventory{"example": {deployment}},
+			want:     "",
+		},
+		{
+			name:     "nothing removed",
+			previous: addonsInventory{"example": {deployment, service}},
+			current:  addonsInventory{"example": {deployment, service}},
+			want:     "",
+		},
+		{
+			name:     "object removed from the addon",
+			previous: addonsInventory{"example": {deployment, service}},
+			current:  addonsInventory{"example": {deployment}},
+			want:     "Object v1/Service/example/example of addon \"example\" will be pruned\n",
+		},
+		{
+			name:     "object moved to another addon",
+			previous: addonsInventory{"example": {deployment, service}},
+			current:  addonsInventory{"example": {deployment}, "other": {service}},
+			want:     "",
+		},
+		{
+			name:     "addons removed",
+			previous: addonsInventory{"b": {service}, "a": {namespace, deployment}},
+			current:  addonsInventory{},
+			want: "Object v1/Namespace//example of addon \"a\" will be pruned\n" +
+				"Object apps/v1/Deployment/example/example of addon \"a\" will be pruned\n" +
+				"Object v1/Service/example/example of addon \"b\" will be pruned\n",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			var out strings.Builder
+			printPrunedObjects(&out, tt.previous, tt.current)
+
+			if out.String() != tt.want {
+				t.Errorf("printPrunedObjects() printed %q, want %q", out.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestUserAddonNames(t *testing.T) {
+	localFS := fstest.MapFS{
+		"b/manifest.yaml": {},
+		"a/manifest.yaml": {},
+		"root.yaml":       {},
+		resources.AddonMachineController + "/manifest.yaml": {},
+	}
+
+	tests := []struct {
+		name        string
+		localFS     fs.FS
+		addons      []kubeoneapi.Addon
+		wantOrdered []string
+		wantDeleted []string
+	}{
+		{
+			name:        "local addons directory",
+			localFS:     localFS,
+			wantOrdered: []string{"a", "b"},
+		},
+		{
+			name:    "addons from the manifest",
+			localFS: localFS,
+			addons: []kubeoneapi.Addon{
+				{Name: "a", DependsOn: []string{"c"}},
+				{Name: "c"},
+				{Name: "d", Delete: true},
+				{Name: resources.AddonMachineController, Delete: true},
+			},
+			wantOrdered: []string{"c", "a", "b"},
+			wantDeleted: []string{"d"},
+		},
+		{
+			name: "without local addons directory",
+			addons: []kubeoneapi.Addon{
+				{Name: "c"},
+			},
+			wantOrdered: []string{"c"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			s := &state.State{
+				Cluster: &kubeoneapi.KubeOneCluster{
+					Addons: &kubeoneapi.Addons{Addons: tt.addons},
+				},
+			}
+
+			ordered, deleted, err := userAddonNames(s, tt.localFS)
+			if err != nil {
+				t.Fatalf("userAddonNames() error = %v", err)
+			}
+			if !reflect.DeepEqual(ordered, tt.wantOrdered) {
+				t.Errorf("userAddonNames() ordered = %v, want %v", ordered, tt.wantOrdered)
+			}
+			if !reflect.DeepEqual(deleted, tt.wantDeleted) {
+				t.Errorf("userAddonNames() deleted = %v, want %v", deleted, tt.wantDeleted)
+			}
+		})
+	}
+}
+
+func TestAddonFS(t *testing.T) {
+	localFS := fstest.MapFS{
+		"a/manifest.yaml":    {},
+		"both/manifest.yaml": {},
+		"file.yaml":          {},
+	}
+	embeddedFS := fstest.MapFS{
+		"both/manifest.yaml":     {},
+		"embedded/manifest.yaml": {},
+	}
+
+	tests := []struct {
+		name      string
+		localFS   fs.FS
+		addonName string
+		want      fs.FS
+	}{
+		{
+			name:      "local addon",
+			localFS:   localFS,
+			addonName: "a",
+			want:      localFS,
+		},
+		{
+			name:      "local addon overrides embedded addon",
+			localFS:   localFS,
+			addonName: "both",
+			want:      localFS,
+		},
+		{
+			name:      "embedded addon",
+			localFS:   localFS,
+			addonName: "embedded",
+			want:      embeddedFS,
+		},
+		{
+			name:      "embedded addon without local addons directory",
+			addonName: "both",
+			want:      embeddedFS,
+		},
+		{
+			name:      "file is not an addon",
+			localFS:   localFS,
+			addonName: "file.yaml",
+		},
+		{
+			name:      "addon does not exist",
+			localFS:   localFS,
+			addonName: "missing",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			a := &applier{LocalFS: tt.localFS, EmbeddedFS: embeddedFS}
+
+			got, err := a.addonFS(tt.addonName)
+			if err != nil {
+				t.Fatalf("addonFS() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("addonFS() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -22,8 +22,14 @@ import (
 
 	"github.com/pkg/errors"
 
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
 	"k8c.io/kubeone/pkg/fail"
 	"k8c.io/kubeone/pkg/state"
+	"k8c.io/kubeone/pkg/templates/calico"
+	"k8c.io/kubeone/pkg/templates/cilium"
+	"k8c.io/kubeone/pkg/templates/gatewayapi"
+	"k8c.io/kubeone/pkg/templates/metallb"
+	"k8c.io/kubeone/pkg/templates/networkpolicy"
 	"k8c.io/kubeone/pkg/templates/resources"
 	"k8c.io/kubeone/pkg/templates/weave"
 )
@@ -39,31 +45,48 @@ const (
 // embeddedAddons is a list of addons that are embedded in the KubeOne
 // binary. Those addons are skipped when applying a user-provided addon with the same name.
 var embeddedAddons = map[string]string{
-	resources.AddonCCMAws:                 "",
-	resources.AddonCCMAzure:               "",
-	resources.AddonCCMDigitalOcean:        "",
-	resources.AddonCCMHetzner:             "",
-	resources.AddonCCMOpenStack:           "",
-	resources.AddonCCMEquinixMetal:        "",
-	resources.AddonCCMPacket:              "",
-	resources.AddonCCMVsphere:             "",
-	resources.AddonCNICanal:               "",
-	resources.AddonCNICilium:              "",
-	resources.AddonCNIWeavenet:            "",
-	resources.AddonCSIAwsEBS:              "",
-	resources.AddonCSIAzureDisk:           "",
-	resources.AddonCSIAzureFile:           "",
-	resources.AddonCSIDigitalOcean:        "",
-	resources.AddonCSIHetzner:             "",
-	resources.AddonCSIGCPComputePD:        "",
-	resources.AddonCSINutanix:             "",
-	resources.AddonCSIOpenStackCinder:     "",
-	resources.AddonCSIVMwareCloudDirector: "",
-	resources.AddonCSIVsphere:             "",
-	resources.AddonMachineController:      "",
-	resources.AddonMetricsServer:          "",
-	resources.AddonNodeLocalDNS:           "",
-	resources.AddonOperatingSystemManager: "",
+	resources.AddonBackupsEtcd:              "",
+	resources.AddonCCMAws:                   "",
+	resources.AddonCCMAzure:                 "",
+	resources.AddonCCMDigitalOcean:          "",
+	resources.AddonCCMHetzner:               "",
+	resources.AddonCCMKubevirt:              "",
+	resources.AddonCCMNutanix:               "",
+	resources.AddonCCMOCI:                   "",
+	resources.AddonCCMOpenStack:             "",
+	resources.AddonCCMEquinixMetal:          "",
+	resources.AddonCCMPacket:                "",
+	resources.AddonCCMVsphere:               "",
+	resources.AddonCNIBGP:                   "",
+	resources.AddonCNICalico:                "",
+	resources.AddonCNICanal:                 "",
+	resources.AddonCNICilium:                "",
+	resources.AddonCiliumEgressGateway:      "",
+	resources.AddonCNIWeavenet:              "",
+	resources.AddonCSIAwsEBS:                "",
+	resources.AddonCSIAzureDisk:             "",
+	resources.AddonCSIAzureFile:             "",
+	resources.AddonCSIDigitalOcean:          "",
+	resources.AddonCSIHetzner:               "",
+	resources.AddonCSIGCPComputePD:          "",
+	resources.AddonCSIKubevirt:              "",
+	resources.AddonCSINutanix:               "",
+	resources.AddonCSIOCI:                   "",
+	resources.AddonCSIOpenStackCinder:       "",
+	resources.AddonCSISnapshotController:    "",
+	resources.AddonCSIVMwareCloudDirector:   "",
+	resources.AddonCSIVsphere:               "",
+	resources.AddonDefaultDenyNetworkPolicy: "",
+	resources.AddonEtcdDefrag:               "",
+	resources.AddonGatewayAPI:               "",
+	resources.AddonIngressNginx:             "",
+	resources.AddonIstioAmbient:             "",
+	resources.AddonLocalStorage:             "",
+	resources.AddonMachineController:        "",
+	resources.AddonMetricsServer:            "",
+	resources.AddonNodeLocalDNS:             "",
+	resources.AddonNvidiaDevicePlugin:       "",
+	resources.AddonOperatingSystemManager:   "",
 }
 
 type addonAction struct {
@@ -86,42 +109,104 @@ func collectAddons(s *state.State) []addonAction {
 		})
 	}
 
-	switch {
-	case s.Cluster.ClusterNetwork.CNI.Canal != nil:
-		addonsToDeploy = append(addonsToDeploy, addonAction{
-			name: resources.AddonCNICanal,
-		})
-	case s.Cluster.ClusterNetwork.CNI.Cilium != nil:
+	// the Gateway API CRDs must exist before the CNI plugin is deployed, as
+	// the Cilium operator enables its gateway controller only on startup
+	if s.Cluster.GatewayAPIEnabled() {
 		addonsToDeploy = append(addonsToDeploy, addonAction{
-			name: resources.AddonCNICilium,
+			name: resources.AddonGatewayAPI,
 		})
-	case s.Cluster.ClusterNetwork.CNI.WeaveNet != nil:
+	}
+
+	if cni, ok := cniAddon(s); ok {
+		addonsToDeploy = append(addonsToDeploy, cni)
+	}
+
+	// the NetworkPolicies are deployed right after the CNI plugin, so that
+	// the workloads never run unrestricted in the selected namespaces
+	if s.Cluster.DefaultDenyNetworkPolicyEnabled() {
 		addonsToDeploy = append(addonsToDeploy, addonAction{
-			name: resources.AddonCNIWeavenet,
+			name: resources.AddonDefaultDenyNetworkPolicy,
 			supportFn: func() error {
-				if s.Cluster.ClusterNetwork.CNI.WeaveNet.Encrypted {
-					if err := weave.EnsureSecret(s); err != nil {
+				if cni := s.Cluster.ClusterNetwork.CNI; cni != nil && cni.Cilium != nil {
+					if err := cilium.WaitForNetworkPolicyCRD(s); err != nil {
 						return err
 					}
 				}
 
-				return nil
+				return networkpolicy.EnsureNamespaces(s)
 			},
 		})
 	}
 
+	if s.Cluster.ClusterNetwork.BGP != nil {
+		addonsToDeploy = append(addonsToDeploy, addonAction{
+			name: resources.AddonCNIBGP,
+			supportFn: func() error {
+				return ensureCNIBGP(s)
+			},
+		})
+	}
+
+	// the CiliumEgressGatewayPolicy CRD is created by the Cilium operator
+	if len(s.Cluster.ClusterNetwork.EgressGateways) > 0 {
+		addonsToDeploy = append(addonsToDeploy, addonAction{
+			name: resources.AddonCiliumEgressGateway,
+			supportFn: func() error {
+				return cilium.WaitForEgressGatewayCRD(s)
+			},
+		})
+	}
+
+	// the istio-cni plugin is chained to the CNI plugin, so it's deployed
+	// after the CNI plugin
+	if s.Cluster.IstioAmbientEnabled() {
+		addonsToDeploy = append(addonsToDeploy, addonAction{
+			name: resources.AddonIstioAmbient,
+		})
+	}
+
 	if s.Cluster.Features.NodeLocalDNS.Deploy {
 		addonsToDeploy = append(addonsToDeploy, addonAction{
 			name: resources.AddonNodeLocalDNS,
 		})
 	}
 
+	if s.Cluster.Features.NvidiaGPU != nil && s.Cluster.Features.NvidiaGPU.Enable {
+		addonsToDeploy = append(addonsToDeploy, addonAction{
+			name: resources.AddonNvidiaDevicePlugin,
+		})
+	}
+
+	if s.Cluster.EtcdBackupsEnabled() {
+		addonsToDeploy = append(addonsToDeploy, addonAction{
+			name: resources.AddonBackupsEtcd,
+		})
+	}
+
+	if s.Cluster.EtcdDefragmentation().Schedule != "" {
+		addonsToDeploy = append(addonsToDeploy, addonAction{
+			name: resources.AddonEtcdDefrag,
+		})
+	}
+
 	if s.Cluster.MachineController.Deploy {
 		addonsToDeploy = append(addonsToDeploy, addonAction{
 			name: resources.AddonMachineController,
 		})
 	}
 
+	if s.Cluster.ClusterAutoscalerEnabled() {
+		addonsToDeploy = append(addonsToDeploy, addonAction{
+			name: resources.AddonClusterAutoscaler,
+		})
+	}
+
+	if s.Cluster.UnattendedUpgradesEnabled() {
+		addonsToDeploy = append(addonsToDeploy, addonAction{
+			name: resources.AddonUnattendedUpgrades,
+		})
+	}
+
 	if s.Cluster.OperatingSystemManager.Deploy {
 		addonsToDeploy = append(addonsToDeploy, addonAction{
 			name: resources.AddonOperatingSystemManager,
@@ -129,6 +214,14 @@ func collectAddons(s *state.State) []addonAction {
 	}
 
 	if !s.Cluster.CloudProvider.DisableBundledCSIDrivers {
+		// the snapshot CRDs and the snapshot-controller are shared by the CSI
+		// drivers and deployed before them
+		if csiSnapshotsSupported(s.Cluster.CloudProvider) {
+			addonsToDeploy = append(addonsToDeploy, addonAction{
+				name: resources.AddonCSISnapshotController,
+			})
+		}
+
 		addonsToDeploy = ensureCSIAddons(s, addonsToDeploy)
 	}
 
@@ -136,9 +229,206 @@ func collectAddons(s *state.State) []addonAction {
 		addonsToDeploy = ensureCCMAddons(s, addonsToDeploy)
 	}
 
+	if s.Cluster.IngressNginxEnabled() {
+		addonsToDeploy = append(addonsToDeploy, addonAction{
+			name: resources.AddonIngressNginx,
+		})
+	}
+
+	if s.Cluster.LocalStorageEnabled() {
+		addonsToDeploy = append(addonsToDeploy, addonAction{
+			name: resources.AddonLocalStorage,
+		})
+	}
+
+	if s.Cluster.MetalLBEnabled() {
+		addonsToDeploy = append(addonsToDeploy, addonAction{
+			name: resources.AddonMetalLB,
+		})
+	}
+
+	if s.Cluster.Features.MetalLB != nil && s.Cluster.Features.MetalLB.Enable {
+		addonsToDeploy = append(addonsToDeploy, addonAction{
+			name: resources.AddonMetalLBConfig,
+			supportFn: func() error {
+				return metallb.WaitForCRDs(s)
+			},
+		})
+	}
+
 	return addonsToDeploy
 }
 
+// CNIAddonName returns the name of the addon deploying the given CNI plugin,
+// or an empty string if the CNI plugin is not deployed by KubeOne.
+func CNIAddonName(cni *kubeoneapi.CNI) string {
+	switch {
+	case cni == nil:
+		return ""
+	case cni.Canal != nil:
+		return resources.AddonCNICanal
+	case cni.Cilium != nil:
+		return resources.AddonCNICilium
+	case cni.Calico != nil:
+		return resources.AddonCNICalico
+	case cni.WeaveNet != nil:
+		return resources.AddonCNIWeavenet
+	}
+
+	return ""
+}
+
+func cniAddon(s *state.State) (addonAction, bool) {
+	cni := s.Cluster.ClusterNetwork.CNI
+
+	switch name := CNIAddonName(cni); name {
+	case resources.AddonCNICalico:
+		return addonAction{
+			name: name,
+			supportFn: func() error {
+				return calico.EnsureIPPoolsEncapsulation(s)
+			},
+		}, true
+	case resources.AddonCNICilium:
+		return addonAction{
+			name: name,
+			supportFn: func() error {
+				if cni.Cilium.Encryption == kubeoneapi.CiliumEncryptionIPsec {
+					if err := cilium.EnsureIPsecSecret(s); err != nil {
+						return err
+					}
+				}
+				if s.Cluster.CiliumGatewayAPIEnabled() {
+					return gatewayapi.WaitForCRDs(s)
+				}
+
+				return nil
+			},
+		}, true
+	case resources.AddonCNIWeavenet:
+		return addonAction{
+			name: name,
+			supportFn: func() error {
+				if cni.WeaveNet.Encrypted {
+					if err := weave.EnsureSecret(s); err != nil {
+						return err
+					}
+				}
+
+				return nil
+			},
+		}, true
+	case "":
+		return addonAction{}, false
+	default:
+		return addonAction{name: name}, true
+	}
+}
+
+// EnsureCNI deploys the CNI addon of the configured CNI plugin.
+func EnsureCNI(s *state.State) error {
+	cni, ok := cniAddon(s)
+	if !ok {
+		return nil
+	}
+
+	if s.Cluster.GatewayAPIEnabled() {
+		if err := EnsureAddonByName(s, resources.AddonGatewayAPI); err != nil {
+			return err
+		}
+	}
+
+	if cni.supportFn != nil {
+		if err := cni.supportFn(); err != nil {
+			return err
+		}
+	}
+
+	return EnsureAddonByName(s, cni.name)
+}
+
+// DeleteCNIAddon deletes the addon of the CNI plugin that was replaced by
+// another CNI plugin. The addon is rendered for the replaced CNI plugin with
+// the default settings, which is enough to delete all its objects.
+func DeleteCNIAddon(s *state.State, addonName string) error {
+	cni := &kubeoneapi.CNI{}
+
+	switch addonName {
+	case resources.AddonCNICanal:
+		cni.Canal = &kubeoneapi.CanalSpec{}
+	case resources.AddonCNICilium:
+		cni.Cilium = &kubeoneapi.CiliumSpec{}
+	case resources.AddonCNICalico:
+		cni.Calico = &kubeoneapi.CalicoSpec{}
+	case resources.AddonCNIWeavenet:
+		cni.WeaveNet = &kubeoneapi.WeaveNetSpec{}
+	default:
+		return fail.RuntimeError{
+			Op:  fmt.Sprintf("deleting %q addon", addonName),
+			Err: errors.New("not a CNI addon"),
+		}
+	}
+
+	cluster := s.Cluster.DeepCopy()
+	cluster.ClusterNetwork.CNI = cni
+
+	sCopy := s.Clone()
+	sCopy.Cluster = cluster
+
+	return DeleteAddonByName(sCopy, addonName)
+}
+
+// deleteDefaultDenyNetworkPolicy deletes the default-deny NetworkPolicies
+// after the feature is disabled. The addon is rendered for the namespaces the
+// NetworkPolicies are currently deployed to.
+func deleteDefaultDenyNetworkPolicy(s *state.State) error {
+	namespaces, err := networkpolicy.DeployedNamespaces(s)
+	if err != nil || len(namespaces) == 0 {
+		return err
+	}
+
+	cluster := s.Cluster.DeepCopy()
+	cluster.Features.DefaultDenyNetworkPolicy = &kubeoneapi.DefaultDenyNetworkPolicy{
+		Enable:     true,
+		Namespaces: namespaces,
+	}
+
+	sCopy := s.Clone()
+	sCopy.Cluster = cluster
+
+	return DeleteAddonByName(sCopy, resources.AddonDefaultDenyNetworkPolicy)
+}
+
+// ensureCNIBGP waits for the BGP CRDs of the CNI plugin and, with Calico,
+// assigns the route reflector cluster ID to the selected nodes
+func ensureCNIBGP(s *state.State) error {
+	if s.Cluster.ClusterNetwork.CNI.Cilium != nil {
+		// the CiliumBGPPeeringPolicy CRD is created by the Cilium operator
+		return cilium.WaitForBGPCRDs(s)
+	}
+
+	if err := calico.WaitForBGPCRDs(s); err != nil {
+		return err
+	}
+
+	return calico.EnsureRouteReflectors(s)
+}
+
+// deleteCNIBGP deletes the BGP resources of the CNI plugin after the BGP
+// configuration is removed
+func deleteCNIBGP(s *state.State) error {
+	switch cni := s.Cluster.ClusterNetwork.CNI; {
+	case cni == nil:
+		return nil
+	case cni.Calico != nil:
+		return calico.DeleteBGPResources(s)
+	case cni.Cilium != nil:
+		return cilium.DeleteBGPResources(s)
+	}
+
+	return nil
+}
+
 func cleanupAddons(s *state.State) error {
 	if !*s.Cluster.Features.CoreDNS.DeployPodDisruptionBudget {
 		if err := DeleteAddonByName(s, resources.AddonCoreDNSPDB); err != nil {
@@ -146,9 +436,91 @@ func cleanupAddons(s *state.State) error {
 		}
 	}
 
+	if !s.Cluster.EtcdBackupsEnabled() {
+		if err := DeleteAddonByName(s, resources.AddonBackupsEtcd); err != nil {
+			return err
+		}
+	}
+
+	if s.Cluster.EtcdDefragmentation().Schedule == "" {
+		if err := DeleteAddonByName(s, resources.AddonEtcdDefrag); err != nil {
+			return err
+		}
+	}
+
+	if !s.Cluster.ClusterAutoscalerEnabled() {
+		// the cluster-autoscaler addon can still be deployed as a user addon
+		userAddon, err := isUserAddon(s, resources.AddonClusterAutoscaler)
+		if err != nil {
+			return err
+		}
+
+		if !userAddon {
+			if err := DeleteAddonByName(s, resources.AddonClusterAutoscaler); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !s.Cluster.UnattendedUpgradesEnabled() {
+		// the unattended-upgrades addon can still be deployed as a user addon
+		userAddon, err := isUserAddon(s, resources.AddonUnattendedUpgrades)
+		if err != nil {
+			return err
+		}
+
+		if !userAddon {
+			if err := DeleteAddonByName(s, resources.AddonUnattendedUpgrades); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !s.Cluster.DefaultDenyNetworkPolicyEnabled() {
+		if err := deleteDefaultDenyNetworkPolicy(s); err != nil {
+			return err
+		}
+	}
+
+	if s.Cluster.ClusterNetwork.BGP == nil {
+		if err := deleteCNIBGP(s); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// isUserAddon returns true if the addon is applied as a user addon, i.e. if
+// it's listed in the addons or it's in the local addons directory
+func isUserAddon(s *state.State, addonName string) (bool, error) {
+	if !s.Cluster.Addons.Enabled() {
+		return false, nil
+	}
+
+	for _, addon := range s.Cluster.Addons.Addons {
+		if addon.Name == addonName {
+			return !addon.Delete, nil
+		}
+	}
+
+	localFS, err := addonsLocalFS(s.Cluster.Addons, s.ManifestFilePath)
+	if err != nil || localFS == nil {
+		return false, err
+	}
+
+	info, err := fs.Stat(localFS, addonName)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+
+		return false, fail.Runtime(err, "reading local addons directory")
+	}
+
+	return info.IsDir(), nil
+}
+
 func Ensure(s *state.State) error {
 	addonsToDeploy := collectAddons(s)
 
@@ -175,12 +547,167 @@ func EnsureUserAddons(s *state.State) error {
 	}
 
 	s.Logger.Infof("Applying user provided addons...")
-	combinedAddons := map[string]string{}
+
+	addonNames, deletedAddons, err := userAddonNames(s, applier.LocalFS)
+	if err != nil {
+		return err
+	}
+	orderedAddons := addonsInPhase(s, addonNames, "")
+
+	for _, addonName := range deletedAddons {
+		if err := applier.loadAndDeleteAddon(s, applier.EmbeddedFS, addonName); err != nil {
+			return err
+		}
+	}
+
+	addonConfigs := map[string]kubeoneapi.Addon{}
+	for _, addon := range s.Cluster.Addons.Addons {
+		addonConfigs[addon.Name] = addon
+	}
+
+	previousInventory, err := loadAddonsInventory(s)
+	if err != nil {
+		return err
+	}
+	// the objects of the user addons that are deployed by KubeOne now, e.g.
+	// after enabling the feature deploying the addon, must not be pruned
+	for _, add := range collectAddons(s) {
+		delete(previousInventory, add.name)
+	}
+	inventory := addonsInventory{}
+
+	// the addons applied in the lifecycle phases keep their objects, they're
+	// pruned only when the addon is applied in its phase or removed
+	for _, addonName := range addonNames {
+		if objects, ok := previousInventory[addonName]; ok && s.Cluster.Addons.AddonPhase(addonName) != "" {
+			inventory[addonName] = objects
+		}
+	}
+
+	for _, addonName := range orderedAddons {
+		// NB: We can't migrate StorageClass when applying the CSI driver because
+		// CSI driver is deployed only for Kubernetes 1.23+ clusters, but this
+		// issue affects older clusters as well.
+		if addonName == defaultStorageClassAddonName && s.Cluster.CloudProvider.GCE != nil {
+			if err := migrateGCEStandardStorageClass(s); err != nil {
+				return err
+			}
+		}
+		manifest, err := ensureAddonByName(s, addonName)
+		if err != nil {
+			return err
+		}
+		if inventory[addonName], err = manifestObjects(manifest); err != nil {
+			return err
+		}
+		if err := waitForAddonReadiness(s, addonConfigs[addonName]); err != nil {
+			return err
+		}
+	}
 
 	if applier.LocalFS != nil {
-		customAddons, err := fs.ReadDir(applier.LocalFS, ".")
+		s.Logger.Info("Applying addons from the root directory...")
+		manifest, err := applier.loadAndApplyAddon(s, applier.LocalFS, "")
 		if err != nil {
-			return fail.Runtime(err, "reading local addons directory")
+			return err
+		}
+		if inventory[rootAddonInventoryKey], err = manifestObjects(manifest); err != nil {
+			return err
+		}
+	}
+
+	// the objects are pruned only after all addons are applied, so the
+	// objects moved between addons are not deleted
+	if err := pruneAddonsInventory(s, previousInventory, inventory); err != nil {
+		return err
+	}
+
+	return saveAddonsInventory(s, inventory)
+}
+
+// EnsureAddonsPhase applies the user addons of the given lifecycle phase, in
+// the order of their dependencies, and waits for their readiness checks. The
+// objects removed from the applied addons are pruned.
+func EnsureAddonsPhase(s *state.State, phase kubeoneapi.AddonPhase) error {
+	applier, err := newAddonsApplier(s)
+	if err != nil {
+		return err
+	}
+
+	s.Logger.Infof("Applying %s addons...", phase)
+
+	addonNames, _, err := userAddonNames(s, applier.LocalFS)
+	if err != nil {
+		return err
+	}
+
+	addonConfigs := map[string]kubeoneapi.Addon{}
+	for _, addon := range s.Cluster.Addons.Addons {
+		addonConfigs[addon.Name] = addon
+	}
+
+	previousInventory, err := loadAddonsInventory(s)
+	if err != nil {
+		return err
+	}
+
+	// the inventory of the addons from other phases is kept as is
+	inventory := addonsInventory{}
+	for addonName, objects := range previousInventory {
+		inventory[addonName] = objects
+	}
+
+	phaseAddons := addonsInPhase(s, addonNames, phase)
+	for _, addonName := range phaseAddons {
+		manifest, err := ensureAddonByName(s, addonName)
+		if err != nil {
+			return err
+		}
+		if inventory[addonName], err = manifestObjects(manifest); err != nil {
+			return err
+		}
+		if err := waitForAddonReadiness(s, addonConfigs[addonName]); err != nil {
+			return err
+		}
+	}
+
+	phasePreviousInventory := addonsInventory{}
+	for _, addonName := range phaseAddons {
+		if objects, ok := previousInventory[addonName]; ok {
+			phasePreviousInventory[addonName] = objects
+		}
+	}
+
+	if err := pruneAddonsInventory(s, phasePreviousInventory, inventory); err != nil {
+		return err
+	}
+
+	return saveAddonsInventory(s, inventory)
+}
+
+// addonsInPhase returns the addons applied in the given lifecycle phase,
+// keeping their order
+func addonsInPhase(s *state.State, addonNames []string, phase kubeoneapi.AddonPhase) []string {
+	var names []string
+
+	for _, addonName := range addonNames {
+		if s.Cluster.Addons.AddonPhase(addonName) == phase {
+			names = append(names, addonName)
+		}
+	}
+
+	return names
+}
+
+// userAddonNames returns the names of the user addons to apply, in the order
+// they're applied, and the names of the user addons to delete
+func userAddonNames(s *state.State, localFS fs.FS) ([]string, []string, error) {
+	combinedAddons := map[string]string{}
+
+	if localFS != nil {
+		customAddons, err := fs.ReadDir(localFS, ".")
+		if err != nil {
+			return nil, nil, fail.Runtime(err, "reading local addons directory")
 		}
 
 		for _, useraddon := range customAddons {
@@ -198,15 +725,15 @@ func EnsureUserAddons(s *state.State) error {
 		}
 	}
 
+	var deletedAddons []string
+
 	for _, embeddedAddon := range s.Cluster.Addons.Addons {
 		if _, ok := embeddedAddons[embeddedAddon.Name]; ok {
 			continue
 		}
 
 		if embeddedAddon.Delete {
-			if err := applier.loadAndDeleteAddon(s, applier.EmbeddedFS, embeddedAddon.Name); err != nil {
-				return err
-			}
+			deletedAddons = append(deletedAddons, embeddedAddon.Name)
 
 			continue
 		}
@@ -216,73 +743,53 @@ func EnsureUserAddons(s *state.State) error {
 		}
 	}
 
+	addonConfigs := map[string]kubeoneapi.Addon{}
+	for _, addon := range s.Cluster.Addons.Addons {
+		addonConfigs[addon.Name] = addon
+	}
+
+	addonNames := make([]string, 0, len(combinedAddons))
 	for addonName := range combinedAddons {
-		// NB: We can't migrate StorageClass when applying the CSI driver because
-		// CSI driver is deployed only for Kubernetes 1.23+ clusters, but this
-		// issue affects older clusters as well.
-		if addonName == defaultStorageClassAddonName && s.Cluster.CloudProvider.GCE != nil {
-			if err := migrateGCEStandardStorageClass(s); err != nil {
-				return err
-			}
-		}
-		if err := EnsureAddonByName(s, addonName); err != nil {
-			return err
-		}
+		addonNames = append(addonNames, addonName)
 	}
 
-	if applier.LocalFS != nil {
-		s.Logger.Info("Applying addons from the root directory...")
-		if err := applier.loadAndApplyAddon(s, applier.LocalFS, ""); err != nil {
-			return err
-		}
+	orderedAddons, err := orderAddons(addonNames, addonConfigs)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return nil
+	return orderedAddons, deletedAddons, nil
 }
 
 // EnsureAddonByName deploys an addon by its name. If the addon is not found
 // in the addons directory, or if the addons are not enabled, it will search
 // for the embedded addons.
 func EnsureAddonByName(s *state.State, addonName string) error {
-	applier, err := newAddonsApplier(s)
-	if err != nil {
-		return err
-	}
+	_, err := ensureAddonByName(s, addonName)
 
-	if applier.LocalFS != nil {
-		addons, lErr := fs.ReadDir(applier.LocalFS, ".")
-		if lErr != nil {
-			return fail.Runtime(lErr, "reading local addons directory")
-		}
+	return err
+}
 
-		for _, a := range addons {
-			if !a.IsDir() {
-				continue
-			}
-			if a.Name() == addonName {
-				return applier.loadAndApplyAddon(s, applier.LocalFS, a.Name())
-			}
-		}
+// ensureAddonByName deploys an addon by its name like EnsureAddonByName and
+// returns the applied manifest.
+func ensureAddonByName(s *state.State, addonName string) (string, error) {
+	applier, err := newAddonsApplier(s)
+	if err != nil {
+		return "", err
 	}
 
-	addons, eErr := fs.ReadDir(applier.EmbeddedFS, ".")
-	if eErr != nil {
-		return fail.Runtime(eErr, "reading embedded addons directory")
+	fsys, err := applier.addonFS(addonName)
+	if err != nil {
+		return "", err
 	}
-
-	for _, a := range addons {
-		if !a.IsDir() {
-			continue
-		}
-		if a.Name() == addonName {
-			return applier.loadAndApplyAddon(s, applier.EmbeddedFS, a.Name())
+	if fsys == nil {
+		return "", fail.RuntimeError{
+			Op:  fmt.Sprintf("installing %q addon", addonName),
+			Err: errors.New("addon does not exist"),
 		}
 	}
 
-	return fail.RuntimeError{
-		Op:  fmt.Sprintf("installing %q addon", addonName),
-		Err: errors.New("addon does not exist"),
-	}
+	return applier.loadAndApplyAddon(s, fsys, addonName)
 }
 
 // DeleteAddonByName deletes an addon by its name. It's required to keep the
@@ -296,40 +803,49 @@ func DeleteAddonByName(s *state.State, addonName string) error {
 		return err
 	}
 
-	if applier.LocalFS != nil {
-		addons, lErr := fs.ReadDir(applier.LocalFS, ".")
+	fsys, err := applier.addonFS(addonName)
+	if err != nil {
+		return err
+	}
+	if fsys == nil {
+		return fail.RuntimeError{
+			Op:  fmt.Sprintf("installing %q addon", addonName),
+			Err: errors.New("addon does not exist"),
+		}
+	}
+
+	return applier.loadAndDeleteAddon(s, fsys, addonName)
+}
+
+// addonFS returns the filesystem with the addon directory, looking up the
+// local addons directory first and the embedded addons then. It returns nil
+// if the addon does not exist.
+func (a *applier) addonFS(addonName string) (fs.FS, error) {
+	if a.LocalFS != nil {
+		addons, lErr := fs.ReadDir(a.LocalFS, ".")
 		if lErr != nil {
-			return fail.Runtime(lErr, "reading local addons directory")
+			return nil, fail.Runtime(lErr, "reading local addons directory")
 		}
 
-		for _, a := range addons {
-			if !a.IsDir() {
-				continue
-			}
-			if a.Name() == addonName {
-				return applier.loadAndDeleteAddon(s, applier.LocalFS, a.Name())
+		for _, addon := range addons {
+			if addon.IsDir() && addon.Name() == addonName {
+				return a.LocalFS, nil
 			}
 		}
 	}
 
-	addons, eErr := fs.ReadDir(applier.EmbeddedFS, ".")
+	addons, eErr := fs.ReadDir(a.EmbeddedFS, ".")
 	if eErr != nil {
-		return fail.Runtime(eErr, "reading embedded addons directory")
+		return nil, fail.Runtime(eErr, "reading embedded addons directory")
 	}
 
-	for _, a := range addons {
-		if !a.IsDir() {
-			continue
-		}
-		if a.Name() == addonName {
-			return applier.loadAndDeleteAddon(s, applier.EmbeddedFS, a.Name())
+	for _, addon := range addons {
+		if addon.IsDir() && addon.Name() == addonName {
+			return a.EmbeddedFS, nil
 		}
 	}
 
-	return fail.RuntimeError{
-		Op:  fmt.Sprintf("installing %q addon", addonName),
-		Err: errors.New("addon does not exist"),
-	}
+	return nil, nil
 }
 
 func ensureCSIAddons(s *state.State, addonsToDeploy []addonAction) []addonAction {
@@ -382,12 +898,24 @@ func ensureCSIAddons(s *state.State, addonsToDeploy []addonAction) []addonAction
 				},
 			},
 		)
+	case s.Cluster.CloudProvider.Kubevirt != nil:
+		addonsToDeploy = append(addonsToDeploy,
+			addonAction{
+				name: resources.AddonCSIKubevirt,
+			},
+		)
 	case s.Cluster.CloudProvider.Nutanix != nil:
 		addonsToDeploy = append(addonsToDeploy,
 			addonAction{
 				name: resources.AddonCSINutanix,
 			},
 		)
+	case s.Cluster.CloudProvider.OCI != nil:
+		addonsToDeploy = append(addonsToDeploy,
+			addonAction{
+				name: resources.AddonCSIOCI,
+			},
+		)
 	case s.Cluster.CloudProvider.Openstack != nil:
 		addonsToDeploy = append(addonsToDeploy,
 			addonAction{
@@ -420,6 +948,24 @@ func ensureCSIAddons(s *state.State, addonsToDeploy []addonAction) []addonAction
 	return addonsToDeploy
 }
 
+// csiSnapshotsSupported returns true if the CSI driver deployed by KubeOne
+// for the cloud provider supports volume snapshots
+func csiSnapshotsSupported(cloudProvider kubeoneapi.CloudProviderSpec) bool {
+	switch {
+	case cloudProvider.AWS != nil,
+		cloudProvider.Azure != nil,
+		cloudProvider.DigitalOcean != nil,
+		cloudProvider.GCE != nil,
+		cloudProvider.Nutanix != nil,
+		cloudProvider.Openstack != nil:
+		return true
+	case cloudProvider.Vsphere != nil:
+		return cloudProvider.External
+	}
+
+	return false
+}
+
 func ensureCCMAddons(s *state.State, addonsToDeploy []addonAction) []addonAction {
 	switch {
 	case s.Cluster.CloudProvider.AWS != nil:
@@ -449,6 +995,24 @@ func ensureCCMAddons(s *state.State, addonsToDeploy []addonAction) []addonAction
 				},
 			},
 		)
+	case s.Cluster.CloudProvider.Kubevirt != nil:
+		addonsToDeploy = append(addonsToDeploy,
+			addonAction{
+				name: resources.AddonCCMKubevirt,
+			},
+		)
+	case s.Cluster.CloudProvider.Nutanix != nil:
+		addonsToDeploy = append(addonsToDeploy,
+			addonAction{
+				name: resources.AddonCCMNutanix,
+			},
+		)
+	case s.Cluster.CloudProvider.OCI != nil:
+		addonsToDeploy = append(addonsToDeploy,
+			addonAction{
+				name: resources.AddonCCMOCI,
+			},
+		)
 	case s.Cluster.CloudProvider.Openstack != nil:
 		addonsToDeploy = append(addonsToDeploy,
 			addonAction{
@@ -297,3 +297,27 @@ func EmbeddedAddonsOnly(addons []kubeoneapi.Addon) (bool, error) {
 
 	return true, nil
 }
+
+// warnNonAMD64Nodes warns if the cluster has non-amd64 nodes, which can't run
+// the given addon workloads because they use images published only for amd64
+func warnNonAMD64Nodes(s *state.State, addonName string, workloads []string) error {
+	if s.DynamicClient == nil {
+		return nil
+	}
+
+	nodes := corev1.NodeList{}
+	if err := s.DynamicClient.List(s.Context, &nodes); err != nil {
+		return fail.KubeClient(err, "getting %T", nodes)
+	}
+
+	for _, node := range nodes.Items {
+		if arch := node.Labels[corev1.LabelArchStable]; arch != "" && arch != "amd64" {
+			s.Logger.Warnf("Addon %q uses images available only for amd64, %v will not run on %s nodes (e.g. %s)",
+				addonName, workloads, arch, node.Name)
+
+			return nil
+		}
+	}
+
+	return nil
+}
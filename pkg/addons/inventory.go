@@ -0,0 +1,242 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addons
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"sort"
+	"strings"
+
+	"k8c.io/kubeone/pkg/clientutil"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/state"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kyaml "k8s.io/apimachinery/pkg/util/yaml"
+	dynclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// addonsInventoryName is the name of the ConfigMap holding the objects
+	// applied by each user addon
+	addonsInventoryName = "kubeone-addons-inventory"
+
+	// rootAddonInventoryKey is the inventory key of the manifests from the
+	// root of the addons directory
+	rootAddonInventoryKey = "_root"
+)
+
+// inventoryObject identifies an object applied by an addon
+type inventoryObject struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+}
+
+// addonsInventory maps the addon name to the objects applied by the addon
+type addonsInventory map[string][]inventoryObject
+
+// has returns true if the object is applied by any addon in the inventory
+func (inv addonsInventory) has(obj inventoryObject) bool {
+	for _, objs := range inv {
+		for _, o := range objs {
+			if sameObject(o, obj) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// sameObject compares the objects regardless of the API version, as it can
+// change between the applies, and regardless of the default namespace being
+// set explicitly
+func sameObject(a, b inventoryObject) bool {
+	namespace := func(obj inventoryObject) string {
+		if obj.Namespace == "" {
+			return metav1.NamespaceDefault
+		}
+
+		return obj.Namespace
+	}
+
+	return schema.FromAPIVersionAndKind(a.APIVersion, a.Kind).GroupKind() == schema.FromAPIVersionAndKind(b.APIVersion, b.Kind).GroupKind() &&
+		namespace(a) == namespace(b) &&
+		a.Name == b.Name
+}
+
+// manifestObjects returns the objects in the multi-document YAML manifest
+func manifestObjects(manifest string) ([]inventoryObject, error) {
+	var objects []inventoryObject
+
+	reader := kyaml.NewYAMLReader(bufio.NewReader(strings.NewReader(manifest)))
+	for {
+		yamlDoc, err := reader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return nil, fail.Runtime(err, "reading addon manifest")
+		}
+
+		yamlDoc = bytes.TrimSpace(yamlDoc)
+		if len(yamlDoc) == 0 {
+			continue
+		}
+
+		obj := metav1unstructured.Unstructured{}
+		if err = kyaml.Unmarshal(yamlDoc, &obj.Object); err != nil {
+			return nil, fail.Runtime(err, "unmarshalling addon manifest")
+		}
+		if len(obj.Object) == 0 {
+			continue
+		}
+
+		objects = append(objects, inventoryObject{
+			APIVersion: obj.GetAPIVersion(),
+			Kind:       obj.GetKind(),
+			Namespace:  obj.GetNamespace(),
+			Name:       obj.GetName(),
+		})
+	}
+
+	sort.Slice(objects, func(i, j int) bool {
+		return objectKey(objects[i]) < objectKey(objects[j])
+	})
+
+	return objects, nil
+}
+
+func objectKey(obj inventoryObject) string {
+	return strings.Join([]string{obj.APIVersion, obj.Kind, obj.Namespace, obj.Name}, "/")
+}
+
+// loadAddonsInventory reads the inventory of the previously applied addons
+func loadAddonsInventory(s *state.State) (addonsInventory, error) {
+	if s.DynamicClient == nil {
+		return nil, fail.NoKubeClient()
+	}
+
+	inventory := addonsInventory{}
+
+	cm := corev1.ConfigMap{}
+	key := dynclient.ObjectKey{Name: addonsInventoryName, Namespace: metav1.NamespaceSystem}
+	if err := s.DynamicClient.Get(s.Context, key, &cm); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return inventory, nil
+		}
+
+		return nil, fail.KubeClient(err, "getting %s", key)
+	}
+
+	for addonName, data := range cm.Data {
+		var objects []inventoryObject
+		if err := json.Unmarshal([]byte(data), &objects); err != nil {
+			return nil, fail.Runtime(err, "unmarshalling inventory of %q addon", addonName)
+		}
+
+		inventory[addonName] = objects
+	}
+
+	return inventory, nil
+}
+
+// saveAddonsInventory replaces the inventory of the applied addons
+func saveAddonsInventory(s *state.State, inventory addonsInventory) error {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      addonsInventoryName,
+			Namespace: metav1.NamespaceSystem,
+		},
+		Data: map[string]string{},
+	}
+
+	for addonName, objects := range inventory {
+		data, err := json.Marshal(objects)
+		if err != nil {
+			return fail.Runtime(err, "marshalling inventory of %q addon", addonName)
+		}
+
+		cm.Data[addonName] = string(data)
+	}
+
+	return clientutil.CreateOrReplace(s.Context, s.DynamicClient, cm)
+}
+
+// pruneAddonsInventory deletes the objects from the previous inventory that
+// are not applied by any addon anymore, i.e. the objects removed from an
+// addon and all objects of the removed or renamed addons.
+func pruneAddonsInventory(s *state.State, previous, current addonsInventory) error {
+	addonNames := make([]string, 0, len(previous))
+	for addonName := range previous {
+		addonNames = append(addonNames, addonName)
+	}
+	sort.Strings(addonNames)
+
+	for _, addonName := range addonNames {
+		for _, obj := range previous[addonName] {
+			if current.has(obj) {
+				continue
+			}
+
+			s.Logger.Infof("Pruning %s of addon %q...", objectKey(obj), addonName)
+			if err := deleteInventoryObject(s, obj); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func deleteInventoryObject(s *state.State, obj inventoryObject) error {
+	u := &metav1unstructured.Unstructured{}
+	u.SetGroupVersionKind(schema.FromAPIVersionAndKind(obj.APIVersion, obj.Kind))
+	u.SetNamespace(obj.Namespace)
+	u.SetName(obj.Name)
+
+	namespaced, err := s.DynamicClient.IsObjectNamespaced(u)
+	if err != nil {
+		// the kind is not served anymore, e.g. because the CRD was removed
+		// along with its objects
+		if meta.IsNoMatchError(err) {
+			return nil
+		}
+
+		return fail.KubeClient(err, "getting scope of %s", obj.Kind)
+	}
+
+	// kubectl applies the namespaced objects without namespace to the
+	// default namespace
+	if namespaced && obj.Namespace == "" {
+		u.SetNamespace(metav1.NamespaceDefault)
+	}
+
+	return clientutil.DeleteIfExists(s.Context, s.DynamicClient, u)
+}
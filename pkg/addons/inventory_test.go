@@ -0,0 +1,100 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addons
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestManifestObjects(t *testing.T) {
+	manifest := `apiVersion: v1
+kind: Namespace
+metadata:
+  name: example
+---
+# comment only
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: example
+  namespace: example
+`
+
+	got, err := manifestObjects(manifest)
+	if err != nil {
+		t.Fatalf("manifestObjects() error = %v", err)
+	}
+
+	want := []inventoryObject{
+		{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "example", Name: "example"},
+		{APIVersion: "v1", Kind: "Namespace", Name: "example"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("manifestObjects() = %v, want %v", got, want)
+	}
+}
+
+func TestAddonsInventoryHas(t *testing.T) {
+	inventory := addonsInventory{
+		"example": {
+			{APIVersion: "policy/v1", Kind: "PodDisruptionBudget", Name: "example"},
+		},
+	}
+
+	tests := []struct {
+		name string
+		obj  inventoryObject
+		want bool
+	}{
+		{
+			name: "same object",
+			obj:  inventoryObject{APIVersion: "policy/v1", Kind: "PodDisruptionBudget", Name: "example"},
+			want: true,
+		},
+		{
+			name: "different API version",
+			obj:  inventoryObject{APIVersion: "policy/v1beta1", Kind: "PodDisruptionBudget", Name: "example"},
+			want: true,
+		},
+		{
+			name: "explicit default namespace",
+			obj:  inventoryObject{APIVersion: "policy/v1", Kind: "PodDisruptionBudget", Namespace: "default", Name: "example"},
+			want: true,
+		},
+		{
+			name: "different namespace",
+			obj:  inventoryObject{APIVersion: "policy/v1", Kind: "PodDisruptionBudget", Namespace: "kube-system", Name: "example"},
+			want: false,
+		},
+		{
+			name: "different group",
+			obj:  inventoryObject{APIVersion: "example.com/v1", Kind: "PodDisruptionBudget", Name: "example"},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if got := inventory.has(tt.obj); got != tt.want {
+				t.Errorf("addonsInventory.has() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
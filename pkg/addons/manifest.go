@@ -25,6 +25,7 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -33,7 +34,9 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
 	"k8c.io/kubeone/pkg/certificate/cabundle"
+	"k8c.io/kubeone/pkg/credentials"
 	"k8c.io/kubeone/pkg/fail"
 	"k8c.io/kubeone/pkg/pointer"
 	"k8c.io/kubeone/pkg/state"
@@ -42,6 +45,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -94,6 +98,29 @@ func (a *applier) getManifestsFromDirectory(s *state.State, fsys fs.FS, addonNam
 		}
 	}
 
+	if workerProviders := s.Cluster.WorkerCloudProviders(); len(workerProviders) > 0 && !disableTemplating {
+		// The CCM and CSI drivers of the cluster cloud provider must not run on the nodes of other cloud providers
+		if sets.NewString(resources.CloudAddons()...).Has(addonName) {
+			if err = excludeWorkerCloudProviderNodes(manifests, workerProviders); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	if !disableTemplating && a.TemplateData.InternalImages != nil {
+		// Images published only for amd64 can't run on nodes with other architectures
+		var pinned []string
+		if pinned, err = requireAMD64Nodes(manifests, a.TemplateData.InternalImages.amd64OnlyImages()); err != nil {
+			return "", err
+		}
+
+		if len(pinned) > 0 {
+			if err = warnNonAMD64Nodes(s, addonName, pinned); err != nil {
+				return "", err
+			}
+		}
+	}
+
 	rawManifests, err := ensureAddonsLabelsOnResources(manifests, addonName)
 	if err != nil {
 		return "", err
@@ -123,7 +150,7 @@ func (a *applier) loadAddonsManifests(
 
 	for _, file := range files {
 		filePath := filepath.Join(addonName, file.Name())
-		if file.IsDir() {
+		if file.IsDir() || file.Name() == paramsSchemaFileName {
 			continue
 		}
 
@@ -317,6 +344,13 @@ func txtFuncMap(overwriteRegistry string) template.FuncMap {
 	funcs["caBundleVolume"] = caBundleVolumeTemplateFunc
 	funcs["caBundleVolumeMount"] = caBundleVolumeMountTemplateFunc
 	funcs["EquinixMetalSecret"] = equinixMetalSecretTemplateFunc
+	funcs["IngressNginxConfig"] = ingressNginxConfigTemplateFunc
+	funcs["IngressNginxServiceAnnotations"] = ingressNginxServiceAnnotationsTemplateFunc
+	funcs["KubevirtCloudConfig"] = kubevirtCloudConfigTemplateFunc
+	funcs["MetricsServerResources"] = metricsServerResourcesTemplateFunc
+	funcs["NutanixCCMConfig"] = nutanixCCMConfigTemplateFunc
+	funcs["NutanixCCMCredentials"] = nutanixCCMCredentialsTemplateFunc
+	funcs["OCICloudConfig"] = ociCloudConfigTemplateFunc
 	funcs["vSphereCSIWebhookConfig"] = vSphereCSIWebhookConfigTemplateFunc
 
 	return funcs
@@ -353,20 +387,300 @@ func caBundleVolumeMountTemplateFunc() (string, error) {
 	return string(buf), err
 }
 
-func equinixMetalSecretTemplateFunc(apiKey, projectID string) (string, error) {
+// metricsServerResourcesTemplateFunc renders the compute resources of the
+// metrics-server container, falling back to the default requests and limits
+// if the resources are not configured
+func metricsServerResourcesTemplateFunc(res *corev1.ResourceRequirements) (string, error) {
+	if res == nil {
+		res = &corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("100m"),
+				corev1.ResourceMemory: resource.MustParse("200Mi"),
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("1"),
+				corev1.ResourceMemory: resource.MustParse("512Mi"),
+			},
+		}
+	}
+
+	buf, err := yaml.Marshal(res)
+
+	return string(buf), err
+}
+
+// ingressNginxConfigTemplateFunc renders the data of the ingress-nginx
+// ConfigMap, enabling the PROXY protocol if it's enabled on the load balancer
+func ingressNginxConfigTemplateFunc(in *kubeoneapi.IngressNginx) (string, error) {
+	config := map[string]string{
+		"allow-snippet-annotations": "false",
+	}
+	if in.ProxyProtocol != nil && *in.ProxyProtocol {
+		config["use-proxy-protocol"] = "true"
+	}
+	for k, v := range in.Config {
+		config[k] = v
+	}
+
+	buf, err := yaml.Marshal(config)
+
+	return string(buf), err
+}
+
+// ingressNginxServiceAnnotationsTemplateFunc renders the annotations of the
+// ingress-nginx controller Service, configuring the load balancer of the
+// cloud provider. The configured annotations override the provider ones.
+func ingressNginxServiceAnnotationsTemplateFunc(cluster *kubeoneapi.KubeOneCluster) (string, error) {
+	in := cluster.Features.IngressNginx
+	proxyProtocol := in.ProxyProtocol != nil && *in.ProxyProtocol
+	lbName := cluster.Name + "-ingress-nginx"
+
+	annotations := map[string]string{}
+	if in.ServiceType == corev1.ServiceTypeLoadBalancer {
+		switch {
+		case cluster.CloudProvider.AWS != nil:
+			annotations["service.beta.kubernetes.io/aws-load-balancer-type"] = "nlb"
+			annotations["service.beta.kubernetes.io/aws-load-balancer-backend-protocol"] = "tcp"
+			annotations["service.beta.kubernetes.io/aws-load-balancer-cross-zone-load-balancing-enabled"] = "true"
+			if proxyProtocol {
+				annotations["service.beta.kubernetes.io/aws-load-balancer-proxy-protocol"] = "*"
+			}
+		case cluster.CloudProvider.DigitalOcean != nil:
+			annotations["service.beta.kubernetes.io/do-loadbalancer-name"] = lbName
+			if proxyProtocol {
+				annotations["service.beta.kubernetes.io/do-loadbalancer-enable-proxy-protocol"] = "true"
+			}
+		case cluster.CloudProvider.Hetzner != nil:
+			annotations["load-balancer.hetzner.cloud/name"] = lbName
+			if cluster.CloudProvider.Hetzner.NetworkID != "" {
+				annotations["load-balancer.hetzner.cloud/use-private-ip"] = "true"
+			}
+			if proxyProtocol {
+				annotations["load-balancer.hetzner.cloud/uses-proxyprotocol"] = "true"
+			}
+		}
+	}
+	for k, v := range in.ServiceAnnotations {
+		annotations[k] = v
+	}
+
+	buf, err := yaml.Marshal(annotations)
+
+	return string(buf), err
+}
+
+// metalLBLoadBalancerSetting configures the Equinix Metal CCM to manage
+// MetalLB using CRDs in the metallb-system namespace
+const metalLBLoadBalancerSetting = "metallb:///metallb-system?crdConfiguration=true"
+
+func equinixMetalSecretTemplateFunc(apiKey, projectID string, lb *kubeoneapi.EquinixMetalLoadBalancerSpec) (string, error) {
 	equinixMetalSecret := struct {
-		APIKey    string `json:"apiKey"`
-		ProjectID string `json:"projectID"`
+		APIKey       string `json:"apiKey"`
+		ProjectID    string `json:"projectID"`
+		LoadBalancer string `json:"loadbalancer,omitempty"`
+		LocalASN     int    `json:"localASN,omitempty"`
 	}{
 		APIKey:    apiKey,
 		ProjectID: projectID,
 	}
 
+	if lb != nil {
+		equinixMetalSecret.LoadBalancer = metalLBLoadBalancerSetting
+		equinixMetalSecret.LocalASN = lb.LocalASN
+	}
+
 	buf, err := json.Marshal(equinixMetalSecret)
 
 	return string(buf), err
 }
 
+func nutanixCCMConfigTemplateFunc(endpoint, port, insecure string) (string, error) {
+	type credentialRef struct {
+		Kind      string `json:"kind"`
+		Name      string `json:"name"`
+		Namespace string `json:"namespace"`
+	}
+
+	type prismEndpoint struct {
+		Address       string        `json:"address"`
+		Port          int           `json:"port"`
+		Insecure      bool          `json:"insecure"`
+		CredentialRef credentialRef `json:"credentialRef"`
+	}
+
+	type topologyDiscovery struct {
+		Type string `json:"type"`
+	}
+
+	nutanixConfig := struct {
+		PrismCentral         prismEndpoint     `json:"prismCentral"`
+		EnableCustomLabeling bool              `json:"enableCustomLabeling"`
+		TopologyDiscovery    topologyDiscovery `json:"topologyDiscovery"`
+	}{
+		PrismCentral: prismEndpoint{
+			Address: endpoint,
+			Port:    9440,
+			CredentialRef: credentialRef{
+				Kind:      "secret",
+				Name:      "nutanix-creds",
+				Namespace: "kube-system",
+			},
+		},
+		TopologyDiscovery: topologyDiscovery{
+			Type: "Prism",
+		},
+	}
+
+	if port != "" {
+		p, err := strconv.Atoi(port)
+		if err != nil {
+			return "", errors.Wrap(err, "parsing nutanix port")
+		}
+		nutanixConfig.PrismCentral.Port = p
+	}
+
+	if insecure != "" {
+		insecureBool, err := strconv.ParseBool(insecure)
+		if err != nil {
+			return "", errors.Wrap(err, "parsing nutanix insecure flag")
+		}
+		nutanixConfig.PrismCentral.Insecure = insecureBool
+	}
+
+	buf, err := json.Marshal(nutanixConfig)
+
+	return string(buf), err
+}
+
+func nutanixCCMCredentialsTemplateFunc(username, password string) (string, error) {
+	type basicAuth struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+	}
+
+	type credentials struct {
+		Type string `json:"type"`
+		Data struct {
+			PrismCentral basicAuth `json:"prismCentral"`
+		} `json:"data"`
+	}
+
+	creds := credentials{Type: "basic_auth"}
+	creds.Data.PrismCentral = basicAuth{
+		Username: username,
+		Password: password,
+	}
+
+	buf, err := json.Marshal([]credentials{creds})
+
+	return string(buf), err
+}
+
+// kubevirtInfraLabels returns the labels that identify the
+// virtual machines of the cluster in the infrastructure cluster
+func kubevirtInfraLabels(clusterName string) map[string]string {
+	return map[string]string{
+		"cluster.x-k8s.io/cluster-name": clusterName,
+	}
+}
+
+// kubevirtCloudConfigTemplateFunc renders the KubeVirt CCM configuration file
+// with the kubeconfig of the infrastructure cluster
+func kubevirtCloudConfigTemplateFunc(kubevirt *kubeoneapi.KubevirtSpec, clusterName string, creds map[string]string) (string, error) {
+	if kubevirt == nil {
+		return "", errors.New("kubevirt cloud provider is not configured")
+	}
+
+	type kubevirtLoadBalancer struct {
+		Enabled              bool `json:"enabled"`
+		CreationPollInterval int  `json:"creationPollInterval"`
+	}
+
+	type kubevirtInstancesV2 struct {
+		Enabled              bool `json:"enabled"`
+		ZoneAndRegionEnabled bool `json:"zoneAndRegionEnabled"`
+	}
+
+	kubevirtConfig := struct {
+		Kubeconfig   string               `json:"kubeconfig"`
+		Namespace    string               `json:"namespace"`
+		InfraLabels  map[string]string    `json:"infraLabels"`
+		LoadBalancer kubevirtLoadBalancer `json:"loadBalancer"`
+		InstancesV2  kubevirtInstancesV2  `json:"instancesV2"`
+	}{
+		Kubeconfig:  creds[credentials.KubevirtKubeconfig],
+		Namespace:   kubevirt.InfraNamespace,
+		InfraLabels: kubevirtInfraLabels(clusterName),
+		LoadBalancer: kubevirtLoadBalancer{
+			Enabled:              true,
+			CreationPollInterval: 5,
+		},
+		InstancesV2: kubevirtInstancesV2{
+			Enabled: true,
+		},
+	}
+
+	buf, err := yaml.Marshal(kubevirtConfig)
+
+	return string(buf), err
+}
+
+// ociCloudConfigTemplateFunc renders the configuration file shared by the OCI
+// CCM and the OCI block volume CSI driver
+func ociCloudConfigTemplateFunc(oci *kubeoneapi.OCISpec, creds map[string]string) (string, error) {
+	if oci == nil {
+		return "", errors.New("oci cloud provider is not configured")
+	}
+
+	type ociAuth struct {
+		Region      string `json:"region"`
+		Tenancy     string `json:"tenancy"`
+		User        string `json:"user"`
+		Key         string `json:"key"`
+		Passphrase  string `json:"passphrase,omitempty"`
+		Fingerprint string `json:"fingerprint"`
+	}
+
+	type ociLoadBalancer struct {
+		Subnet1                    string `json:"subnet1,omitempty"`
+		Subnet2                    string `json:"subnet2,omitempty"`
+		SecurityListManagementMode string `json:"securityListManagementMode"`
+	}
+
+	ociConfig := struct {
+		UseInstancePrincipals bool             `json:"useInstancePrincipals"`
+		Auth                  ociAuth          `json:"auth"`
+		Compartment           string           `json:"compartment"`
+		VCN                   string           `json:"vcn"`
+		LoadBalancer          *ociLoadBalancer `json:"loadBalancer,omitempty"`
+	}{
+		Auth: ociAuth{
+			Region:      creds[credentials.OCIRegion],
+			Tenancy:     creds[credentials.OCITenancyOCID],
+			User:        creds[credentials.OCIUserOCID],
+			Key:         creds[credentials.OCIPrivateKey],
+			Passphrase:  creds[credentials.OCIPrivateKeyPassphrase],
+			Fingerprint: creds[credentials.OCIFingerprint],
+		},
+		Compartment: oci.CompartmentID,
+		VCN:         oci.VCNID,
+	}
+
+	if len(oci.LoadBalancerSubnetIDs) > 0 {
+		ociConfig.LoadBalancer = &ociLoadBalancer{
+			Subnet1:                    oci.LoadBalancerSubnetIDs[0],
+			SecurityListManagementMode: "None",
+		}
+		if len(oci.LoadBalancerSubnetIDs) > 1 {
+			ociConfig.LoadBalancer.Subnet2 = oci.LoadBalancerSubnetIDs[1]
+		}
+	}
+
+	buf, err := yaml.Marshal(ociConfig)
+
+	return string(buf), err
+}
+
 func vSphereCSIWebhookConfigTemplateFunc() (string, error) {
 	cfg := vsphereCSIWebhookConfigWrapper{
 		WebHookConfig: vsphereCSIWebhookConfig{
@@ -457,6 +771,112 @@ func addSecretCSIVolume(docs []runtime.RawExtension, secretProviderClassName str
 	return nil
 }
 
+func excludeWorkerCloudProviderNodes(docs []runtime.RawExtension, workerProviders []string) error {
+	requirement := corev1.NodeSelectorRequirement{
+		Key:      kubeoneapi.WorkerCloudProviderLabel,
+		Operator: corev1.NodeSelectorOpNotIn,
+		Values:   workerProviders,
+	}
+
+	_, err := mutateWorkloads(docs, func(podSpec corev1.PodSpec) (corev1.PodSpec, bool) {
+		return addNodeAffinityRequirement(podSpec, requirement), true
+	})
+
+	return err
+}
+
+// requireAMD64Nodes requires the workloads using any of the given amd64-only
+// images to be scheduled on amd64 nodes. It returns names of the mutated
+// workloads.
+func requireAMD64Nodes(docs []runtime.RawExtension, amd64OnlyImages sets.String) ([]string, error) {
+	requirement := corev1.NodeSelectorRequirement{
+		Key:      corev1.LabelArchStable,
+		Operator: corev1.NodeSelectorOpIn,
+		Values:   []string{"amd64"},
+	}
+
+	return mutateWorkloads(docs, func(podSpec corev1.PodSpec) (corev1.PodSpec, bool) {
+		containers := append(append([]corev1.Container{}, podSpec.InitContainers...), podSpec.Containers...)
+		for _, container := range containers {
+			if amd64OnlyImages.Has(container.Image) {
+				return addNodeAffinityRequirement(podSpec, requirement), true
+			}
+		}
+
+		return podSpec, false
+	})
+}
+
+// mutateWorkloads runs the mutator on the pod template of every Deployment,
+// StatefulSet and DaemonSet. It returns names of the workloads for which the
+// mutator reported a change.
+func mutateWorkloads(docs []runtime.RawExtension, mutator func(corev1.PodSpec) (corev1.PodSpec, bool)) ([]string, error) {
+	var mutated []string
+
+	for i := range docs {
+		ubject := metav1unstructured.Unstructured{}
+		_, _, err := metav1unstructured.UnstructuredJSONScheme.Decode(docs[i].Raw, nil, &ubject)
+		if err != nil {
+			return nil, err
+		}
+
+		changed := false
+		switch ubject.GroupVersionKind().GroupKind() {
+		case appsv1.SchemeGroupVersion.WithKind("Deployment").GroupKind():
+			var obj appsv1.Deployment
+			err = repackObject(&obj, &docs[i], func() {
+				obj.Spec.Template.Spec, changed = mutator(obj.Spec.Template.Spec)
+			})
+		case appsv1.SchemeGroupVersion.WithKind("StatefulSet").GroupKind():
+			var obj appsv1.StatefulSet
+			err = repackObject(&obj, &docs[i], func() {
+				obj.Spec.Template.Spec, changed = mutator(obj.Spec.Template.Spec)
+			})
+		case appsv1.SchemeGroupVersion.WithKind("DaemonSet").GroupKind():
+			var obj appsv1.DaemonSet
+			err = repackObject(&obj, &docs[i], func() {
+				obj.Spec.Template.Spec, changed = mutator(obj.Spec.Template.Spec)
+			})
+		}
+
+		if err != nil {
+			return nil, err
+		}
+		if changed {
+			mutated = append(mutated, fmt.Sprintf("%s/%s", ubject.GetKind(), ubject.GetName()))
+		}
+	}
+
+	return mutated, nil
+}
+
+// addNodeAffinityRequirement requires the pods to be scheduled on nodes matching the requirement.
+// Node selector terms are ORed, so the requirement is added to each of the existing terms.
+func addNodeAffinityRequirement(podSpec corev1.PodSpec, requirement corev1.NodeSelectorRequirement) corev1.PodSpec {
+	if podSpec.Affinity == nil {
+		podSpec.Affinity = &corev1.Affinity{}
+	}
+	if podSpec.Affinity.NodeAffinity == nil {
+		podSpec.Affinity.NodeAffinity = &corev1.NodeAffinity{}
+	}
+
+	nodeAffinity := podSpec.Affinity.NodeAffinity
+	if nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &corev1.NodeSelector{}
+	}
+
+	nodeSelector := nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	if len(nodeSelector.NodeSelectorTerms) == 0 {
+		nodeSelector.NodeSelectorTerms = []corev1.NodeSelectorTerm{{}}
+	}
+
+	for i := range nodeSelector.NodeSelectorTerms {
+		nodeSelector.NodeSelectorTerms[i].MatchExpressions = append(nodeSelector.NodeSelectorTerms[i].MatchExpressions, requirement)
+	}
+
+	return podSpec
+}
+
 func repackObject(kubeobject runtime.Object, obj *runtime.RawExtension, mutator func()) error {
 	if err := yaml.Unmarshal(obj.Raw, kubeobject); err != nil {
 		return err
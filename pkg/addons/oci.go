@@ -0,0 +1,144 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addons
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/distribution/reference"
+	"github.com/sirupsen/logrus"
+	orascontent "oras.land/oras-go/pkg/content"
+	"oras.land/oras-go/pkg/oras"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/fail"
+)
+
+// PullOCIAddons pulls the OCI artifact referenced by the addons path to a
+// temporary directory and points the addons path to it. It's no-op if the
+// addons path doesn't reference an OCI artifact.
+//
+// Every layer of the artifact annotated with a title is written to the
+// directory under that title, so the artifact is expected to be pushed with
+// one layer per addon directory, e.g.
+// `oras push registry.example.com/addons/monitoring:1.2.3 prometheus/ grafana/`.
+func PullOCIAddons(ctx context.Context, logger logrus.FieldLogger, cluster *kubeoneapi.KubeOneCluster) error {
+	if !cluster.Addons.Enabled() {
+		return nil
+	}
+
+	ref, ok := cluster.Addons.OCIReference()
+	if !ok {
+		return nil
+	}
+
+	named, err := reference.ParseNamed(ref)
+	if err != nil {
+		return fail.Config(err, "parsing addons OCI reference")
+	}
+
+	username, password, err := ociRegistryCredentials(cluster.ContainerRuntime.Containerd, reference.Domain(named))
+	if err != nil {
+		return err
+	}
+
+	registry, err := orascontent.NewRegistry(orascontent.RegistryOptions{
+		Username: username,
+		Password: password,
+	})
+	if err != nil {
+		return fail.Runtime(err, "creating OCI registry client")
+	}
+
+	addonsDir, err := os.MkdirTemp("", "kubeone-addons-")
+	if err != nil {
+		return fail.Runtime(err, "creating addons directory")
+	}
+
+	store := orascontent.NewFile(addonsDir)
+	defer store.Close()
+
+	logger.Infof("Pulling addons from %q...", ref)
+
+	desc, err := oras.Copy(ctx, registry, ref, store, "")
+	if err != nil {
+		return fail.Runtime(err, "pulling addons from %q", ref)
+	}
+
+	// the registry client already verifies the pulled content against the
+	// digests from the manifest, this ensures the manifest itself is the
+	// pinned one
+	if digested, ok := named.(reference.Digested); ok && desc.Digest != digested.Digest() {
+		return fail.NewRuntimeError("verifying addons", "expected digest %s for %q, got %s", digested.Digest(), ref, desc.Digest)
+	}
+
+	logger.Infof("Pulled addons from %q with digest %s", ref, desc.Digest)
+
+	if verifier := newCosignVerifier(cluster.Addons.Verification); verifier != nil {
+		// the signature of the pulled digest is verified, so the tag can't be
+		// moved to another artifact in the meantime
+		signedRef := named.Name() + "@" + desc.Digest.String()
+
+		logger.Infof("Verifying signature of %q...", signedRef)
+		if err = verifier.verifyImage(ctx, signedRef); err != nil {
+			return err
+		}
+	}
+
+	cluster.Addons.Path = addonsDir
+
+	return nil
+}
+
+// ociRegistryCredentials returns the credentials configured for the registry
+// in the containerd configuration. Empty credentials make the registry client
+// fall back to the Docker credentials file.
+func ociRegistryCredentials(containerdConfig *kubeoneapi.ContainerRuntimeContainerd, registry string) (string, string, error) {
+	if containerdConfig == nil {
+		return "", "", nil
+	}
+
+	regConfig, ok := containerdConfig.Registries[registry]
+	if !ok || regConfig.Auth == nil {
+		return "", "", nil
+	}
+
+	auth := regConfig.Auth
+	if auth.Username != "" || auth.Password != "" {
+		return auth.Username, auth.Password, nil
+	}
+
+	if auth.Auth != "" {
+		decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+		if err != nil {
+			return "", "", fail.Config(err, fmt.Sprintf("decoding %q registry credentials", registry))
+		}
+
+		username, password, ok := strings.Cut(string(decoded), ":")
+		if !ok {
+			return "", "", fail.NewConfigError("decoding registry credentials", "%q registry auth must be in the base64 encoded username:password format", registry)
+		}
+
+		return username, password, nil
+	}
+
+	return "", "", nil
+}
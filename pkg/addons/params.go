@@ -0,0 +1,226 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addons
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	embeddedaddons "k8c.io/kubeone/addons"
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/fail"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	openapierrors "k8s.io/kube-openapi/pkg/validation/errors"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+	"k8s.io/kube-openapi/pkg/validation/strfmt"
+	"k8s.io/kube-openapi/pkg/validation/validate"
+	"sigs.k8s.io/yaml"
+)
+
+// paramsSchemaFileName is the name of the file in the addon directory with
+// the JSON schema (OpenAPI v3 flavor) of the addon params. It's not applied
+// as a manifest.
+const paramsSchemaFileName = "params.schema.json"
+
+// ValidateParams validates the params of every addon that has the params
+// schema in its directory. The addon params are validated merged with the
+// global params, as they're passed to the addon templates. The params are
+// strings, so the params with the integer, number and boolean type in the
+// schema are converted to that type before the validation.
+func ValidateParams(cluster *kubeoneapi.KubeOneCluster, manifestFilePath string) error {
+	if !cluster.Addons.Enabled() {
+		return nil
+	}
+
+	localFS, err := addonsLocalFS(cluster.Addons, manifestFilePath)
+	if err != nil {
+		return err
+	}
+
+	fldPath := field.NewPath("addons")
+	allErrs := field.ErrorList{}
+	listed := map[string]bool{}
+
+	for i, addon := range cluster.Addons.Addons {
+		listed[addon.Name] = true
+		if addon.Delete {
+			continue
+		}
+
+		errs, err := validateAddonParams(localFS, cluster.Addons.GlobalParams, addon, fldPath.Child("addons").Index(i).Child("params"))
+		if err != nil {
+			return err
+		}
+		allErrs = append(allErrs, errs...)
+	}
+
+	// the addons that are not listed in the configuration are applied from
+	// the addons directory with the global params only
+	if localFS != nil {
+		entries, err := fs.ReadDir(localFS, ".")
+		if err != nil {
+			return fail.Runtime(err, "reading local addons directory")
+		}
+
+		for _, entry := range entries {
+			if !entry.IsDir() || listed[entry.Name()] {
+				continue
+			}
+
+			addon := kubeoneapi.Addon{Name: entry.Name()}
+			errs, err := validateAddonParams(localFS, cluster.Addons.GlobalParams, addon, fldPath.Child("globalParams"))
+			if err != nil {
+				return err
+			}
+			allErrs = append(allErrs, errs...)
+		}
+	}
+
+	return fail.ConfigValidation(allErrs.ToAggregate())
+}
+
+func validateAddonParams(localFS fs.FS, globalParams map[string]string, addon kubeoneapi.Addon, fldPath *field.Path) (field.ErrorList, error) {
+	schema, err := addonParamsSchema(localFS, addon.Name)
+	if err != nil || schema == nil {
+		return nil, err
+	}
+
+	validator := validate.NewSchemaValidator(schema, nil, "", strfmt.Default)
+
+	params := map[string]string{}
+	for k, v := range globalParams {
+		params[k] = v
+	}
+	for k, v := range addon.Params {
+		params[k] = v
+	}
+
+	allErrs := field.ErrorList{}
+	values := map[string]interface{}{}
+
+	for k, v := range params {
+		if strings.HasPrefix(v, ParamsEnvPrefix) {
+			envName := strings.TrimPrefix(v, ParamsEnvPrefix)
+			env, ok := os.LookupEnv(envName)
+			if !ok {
+				allErrs = append(allErrs, field.Invalid(fldPath.Key(k), v, fmt.Sprintf("environment variable %q not found", envName)))
+
+				continue
+			}
+			v = env
+		}
+
+		values[k] = paramValue(v, schema.Properties[k].Type)
+	}
+
+	for _, err := range validator.Validate(values).Errors {
+		errPath := fldPath
+		detail := fmt.Sprintf("%s (%q addon)", err.Error(), addon.Name)
+
+		var verr *openapierrors.Validation
+		if !errors.As(err, &verr) {
+			allErrs = append(allErrs, field.Invalid(errPath, "", detail))
+
+			continue
+		}
+
+		key := strings.TrimPrefix(verr.Name, ".")
+		if key != "" {
+			errPath = fldPath.Key(key)
+		}
+
+		// the errors are prefixed with "<name> in body", which is already
+		// part of the field path
+		detail = fmt.Sprintf("%s (%q addon)", strings.TrimPrefix(err.Error(), verr.Name+" in body "), addon.Name)
+
+		if verr.Code() == openapierrors.RequiredFailCode {
+			allErrs = append(allErrs, field.Required(errPath, fmt.Sprintf("required by %q addon", addon.Name)))
+		} else {
+			allErrs = append(allErrs, field.Invalid(errPath, params[key], detail))
+		}
+	}
+
+	sort.SliceStable(allErrs, func(i, j int) bool {
+		return allErrs[i].Field < allErrs[j].Field
+	})
+
+	return allErrs, nil
+}
+
+// addonParamsSchema returns the params schema of the addon from the local
+// addons directory, or from the embedded addons if the addon is not in the
+// local directory. It returns nil if the addon has no params schema.
+func addonParamsSchema(localFS fs.FS, addonName string) (*spec.Schema, error) {
+	// the addon in the local directory overrides the embedded addon along
+	// with its params schema
+	var fsys fs.FS = embeddedaddons.FS
+	if localFS != nil {
+		if _, dirErr := fs.Stat(localFS, addonName); dirErr == nil {
+			fsys = localFS
+		}
+	}
+
+	data, err := fs.ReadFile(fsys, path.Join(addonName, paramsSchemaFileName))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fail.Runtime(err, "reading params schema of %q addon", addonName)
+	}
+
+	data, err = yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, fail.Config(err, fmt.Sprintf("parsing params schema of %q addon", addonName))
+	}
+
+	schema := spec.Schema{}
+	if err = json.Unmarshal(data, &schema); err != nil {
+		return nil, fail.Config(err, fmt.Sprintf("unmarshalling params schema of %q addon", addonName))
+	}
+
+	return &schema, nil
+}
+
+// paramValue converts the param to the type from the schema. The param is
+// left as a string if it can't be converted, so the validation reports the
+// wrong type.
+func paramValue(value string, schemaType spec.StringOrArray) interface{} {
+	switch {
+	case schemaType.Contains("integer"):
+		if i, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return i
+		}
+	case schemaType.Contains("number"):
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			return f
+		}
+	case schemaType.Contains("boolean"):
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b
+		}
+	}
+
+	return value
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addons
+
+import (
+	"testing"
+	"testing/fstest"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const testParamsSchema = `{
+  "type": "object",
+  "required": ["host"],
+  "properties": {
+    "host": {"type": "string", "minLength": 1},
+    "replicas": {"type": "integer", "minimum": 1},
+    "debug": {"type": "boolean"},
+    "mode": {"type": "string", "enum": ["fast", "safe"]}
+  }
+}`
+
+func TestValidateAddonParams(t *testing.T) {
+	localFS := fstest.MapFS{
+		"example/" + paramsSchemaFileName: &fstest.MapFile{Data: []byte(testParamsSchema)},
+		"example/deployment.yaml":         &fstest.MapFile{},
+		"no-schema/deployment.yaml":       &fstest.MapFile{},
+	}
+
+	tests := []struct {
+		name          string
+		addon         kubeoneapi.Addon
+		globalParams  map[string]string
+		expectedError bool
+	}{
+		{
+			name: "valid params",
+			addon: kubeoneapi.Addon{
+				Name:   "example",
+				Params: map[string]string{"host": "example.com", "replicas": "2", "debug": "true", "mode": "safe"},
+			},
+			expectedError: false,
+		},
+		{
+			name: "required param set in global params",
+			addon: kubeoneapi.Addon{
+				Name: "example",
+			},
+			globalParams:  map[string]string{"host": "example.com"},
+			expectedError: false,
+		},
+		{
+			name: "missing required param",
+			addon: kubeoneapi.Addon{
+				Name:   "example",
+				Params: map[string]string{"replicas": "2"},
+			},
+			expectedError: true,
+		},
+		{
+			name: "mis-typed param",
+			addon: kubeoneapi.Addon{
+				Name:   "example",
+				Params: map[string]string{"host": "example.com", "replicas": "two"},
+			},
+			expectedError: true,
+		},
+		{
+			name: "param out of range",
+			addon: kubeoneapi.Addon{
+				Name:   "example",
+				Params: map[string]string{"host": "example.com", "replicas": "0"},
+			},
+			expectedError: true,
+		},
+		{
+			name: "param not in enum",
+			addon: kubeoneapi.Addon{
+				Name:   "example",
+				Params: map[string]string{"host": "example.com", "mode": "unsafe"},
+			},
+			expectedError: true,
+		},
+		{
+			name: "embedded addon schema",
+			addon: kubeoneapi.Addon{
+				Name:   "backups-restic",
+				Params: map[string]string{"resticPassword": "secret", "backend": "azure", "keepDaily": "7"},
+			},
+			expectedError: false,
+		},
+		{
+			name: "invalid params of embedded addon",
+			addon: kubeoneapi.Addon{
+				Name:   "backups-restic",
+				Params: map[string]string{"resticPassword": "secret", "backend": "swift"},
+			},
+			expectedError: true,
+		},
+		{
+			name: "addon without schema",
+			addon: kubeoneapi.Addon{
+				Name:   "no-schema",
+				Params: map[string]string{"replicas": "two"},
+			},
+			expectedError: false,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			errs, err := validateAddonParams(localFS, tc.globalParams, tc.addon, field.NewPath("params"))
+			if err != nil {
+				t.Fatalf("validateAddonParams() error = %v", err)
+			}
+			if (len(errs) == 0) == tc.expectedError {
+				t.Errorf("test case failed: expected %v, but got %v", tc.expectedError, errs)
+			}
+		})
+	}
+}
+
+func TestAddonParamsSchemaLocalOverride(t *testing.T) {
+	localFS := fstest.MapFS{
+		"backups-restic/backups-restic.yaml": &fstest.MapFile{},
+	}
+
+	schema, err := addonParamsSchema(localFS, "backups-restic")
+	if err != nil {
+		t.Fatalf("addonParamsSchema() error = %v", err)
+	}
+	if schema != nil {
+		t.Errorf("expected no schema for the local addon overriding the embedded addon, got %v", schema)
+	}
+
+	schema, err = addonParamsSchema(nil, "backups-restic")
+	if err != nil {
+		t.Fatalf("addonParamsSchema() error = %v", err)
+	}
+	if schema == nil {
+		t.Errorf("expected the schema of the embedded addon")
+	}
+}
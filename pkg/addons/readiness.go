@@ -0,0 +1,135 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addons
+
+import (
+	"context"
+	"time"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/clientutil"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/state"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	dynclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	addonReadinessPollInterval = 5 * time.Second
+	addonReadinessTimeout      = 5 * time.Minute
+)
+
+// waitForAddonReadiness waits for all objects from the addon readiness checks
+// to become ready
+func waitForAddonReadiness(s *state.State, addon kubeoneapi.Addon) error {
+	if len(addon.ReadinessChecks) == 0 {
+		return nil
+	}
+
+	if s.DynamicClient == nil {
+		return fail.NoKubeClient()
+	}
+
+	s.Logger.Infof("Waiting for addon %q to become ready...", addon.Name)
+
+	for _, check := range addon.ReadinessChecks {
+		err := wait.PollUntilContextTimeout(s.Context, addonReadinessPollInterval, addonReadinessTimeout, true, func(ctx context.Context) (bool, error) {
+			return addonObjectReady(ctx, s.DynamicClient, check)
+		})
+		if err != nil {
+			return fail.Runtime(err, "waiting for %s %q of addon %q to become ready", check.Kind, check.Name, addon.Name)
+		}
+	}
+
+	return nil
+}
+
+func addonObjectReady(ctx context.Context, client dynclient.Client, check kubeoneapi.AddonReadinessCheck) (bool, error) {
+	key := dynclient.ObjectKey{Name: check.Name, Namespace: check.Namespace}
+
+	switch check.Kind {
+	case kubeoneapi.AddonReadinessCheckKindDeployment:
+		deploy := appsv1.Deployment{}
+		if err := client.Get(ctx, key, &deploy); err != nil {
+			return false, err
+		}
+
+		if deploy.Status.ObservedGeneration < deploy.Generation {
+			return false, nil
+		}
+
+		for _, cond := range deploy.Status.Conditions {
+			if cond.Type == appsv1.DeploymentAvailable && cond.Status == corev1.ConditionTrue {
+				return deploy.Spec.Replicas == nil || deploy.Status.UpdatedReplicas == *deploy.Spec.Replicas, nil
+			}
+		}
+
+		return false, nil
+	case kubeoneapi.AddonReadinessCheckKindStatefulSet:
+		sts := appsv1.StatefulSet{}
+		if err := client.Get(ctx, key, &sts); err != nil {
+			return false, err
+		}
+
+		replicas := int32(1)
+		if sts.Spec.Replicas != nil {
+			replicas = *sts.Spec.Replicas
+		}
+
+		return sts.Status.ObservedGeneration >= sts.Generation &&
+			sts.Status.ReadyReplicas == replicas &&
+			sts.Status.UpdatedReplicas == replicas, nil
+	case kubeoneapi.AddonReadinessCheckKindDaemonSet:
+		ds := appsv1.DaemonSet{}
+		if err := client.Get(ctx, key, &ds); err != nil {
+			return false, err
+		}
+
+		return ds.Status.ObservedGeneration >= ds.Generation &&
+			ds.Status.NumberReady == ds.Status.DesiredNumberScheduled &&
+			ds.Status.UpdatedNumberScheduled == ds.Status.DesiredNumberScheduled, nil
+	case kubeoneapi.AddonReadinessCheckKindJob:
+		job := batchv1.Job{}
+		if err := client.Get(ctx, key, &job); err != nil {
+			return false, err
+		}
+
+		for _, cond := range job.Status.Conditions {
+			if cond.Status != corev1.ConditionTrue {
+				continue
+			}
+
+			switch cond.Type {
+			case batchv1.JobComplete:
+				return true, nil
+			case batchv1.JobFailed:
+				// the failed Job is not retried, so there's no point in waiting
+				return false, fail.NewRuntimeError("waiting for job", "job %s/%s failed: %s", check.Namespace, check.Name, cond.Message)
+			}
+		}
+
+		return false, nil
+	case kubeoneapi.AddonReadinessCheckKindCustomResourceDefinition:
+		return clientutil.CRDsReadyCondition(ctx, client, []string{check.Name})()
+	}
+
+	return false, fail.NewConfigError("addon readiness check", "unsupported kind %q", check.Kind)
+}
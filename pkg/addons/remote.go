@@ -0,0 +1,365 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addons
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/fail"
+)
+
+const (
+	gitBinary = "git"
+
+	// maxAddonsTarballFileSize limits the size of the files extracted from
+	// the addons tarball
+	maxAddonsTarballFileSize = 64 << 20
+)
+
+// FetchRemoteAddons fetches the addons directory from the remote addons source
+// to the local cache and points the addons path to it. It's no-op if the
+// addons source is not set.
+//
+// The git repositories are cached by their URL and updated to the configured
+// ref on every run. The tarballs are cached by their checksum, so they're
+// downloaded only once.
+func FetchRemoteAddons(ctx context.Context, logger logrus.FieldLogger, cluster *kubeoneapi.KubeOneCluster) error {
+	if !cluster.Addons.Enabled() || cluster.Addons.Source == nil {
+		return nil
+	}
+
+	userCacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return fail.Runtime(err, "getting cache directory")
+	}
+	cacheDir := filepath.Join(userCacheDir, "kubeone", "addons")
+
+	var sourceDir, sourcePath string
+
+	switch src := cluster.Addons.Source; {
+	case src.Git != nil:
+		sourceDir, err = fetchGitAddons(ctx, logger, src.Git, filepath.Join(cacheDir, "git"))
+		sourcePath = src.Git.Path
+	case src.HTTP != nil:
+		verifier := newCosignVerifier(cluster.Addons.Verification)
+		sourceDir, err = fetchHTTPAddons(ctx, logger, http.DefaultClient, src.HTTP, verifier, filepath.Join(cacheDir, "http"))
+		sourcePath = src.HTTP.Path
+	default:
+		return fail.NewConfigError("fetching addons", "addons source is empty")
+	}
+	if err != nil {
+		return err
+	}
+
+	addonsDir := filepath.Join(sourceDir, sourcePath)
+	info, err := os.Stat(addonsDir)
+	if err != nil {
+		return fail.Runtime(err, "checking fetched addons directory")
+	}
+	if !info.IsDir() {
+		return fail.NewConfigError("fetching addons", "%q is not a directory in the addons source", sourcePath)
+	}
+
+	cluster.Addons.Path = addonsDir
+
+	return nil
+}
+
+// fetchGitAddons fetches the ref of the git repository to the cache and
+// checks it out. The repository is fetched with depth 1, so only the checked
+// out commit is stored.
+func fetchGitAddons(ctx context.Context, logger logrus.FieldLogger, src *kubeoneapi.AddonsGitSource, cacheDir string) (string, error) {
+	// git would parse the URL or the ref starting with a dash as an option
+	if strings.HasPrefix(src.URL, "-") {
+		return "", fail.NewConfigError("fetching git addons", "repository URL %q can't start with a dash", src.URL)
+	}
+	if strings.HasPrefix(src.Ref, "-") {
+		return "", fail.NewConfigError("fetching git addons", "ref %q can't start with a dash", src.Ref)
+	}
+
+	urlHash := sha256.Sum256([]byte(src.URL))
+	repoDir := filepath.Join(cacheDir, hex.EncodeToString(urlHash[:])[:16])
+
+	if _, err := os.Stat(filepath.Join(repoDir, ".git")); err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return "", fail.Runtime(err, "checking cached addons repository")
+		}
+
+		if err = os.MkdirAll(repoDir, 0o700); err != nil {
+			return "", fail.Runtime(err, "creating addons cache directory")
+		}
+		if _, err = runGit(ctx, repoDir, "init", "--quiet"); err != nil {
+			return "", err
+		}
+		if _, err = runGit(ctx, repoDir, "remote", "add", "--", "origin", src.URL); err != nil {
+			return "", err
+		}
+	}
+
+	ref := src.Ref
+	if ref == "" {
+		ref = "HEAD"
+	}
+
+	logger.Infof("Fetching addons from %q at %q...", src.URL, ref)
+
+	// the remote URL is updated in case the cached repository was cloned
+	// before the URL with the same hash was configured, e.g. after the
+	// cache directory was copied
+	if _, err := runGit(ctx, repoDir, "remote", "set-url", "--", "origin", src.URL); err != nil {
+		return "", err
+	}
+	if _, err := runGit(ctx, repoDir, "fetch", "--quiet", "--depth", "1", "--force", "origin", ref); err != nil {
+		return "", err
+	}
+	if _, err := runGit(ctx, repoDir, "checkout", "--quiet", "--force", "--detach", "FETCH_HEAD"); err != nil {
+		return "", err
+	}
+	if _, err := runGit(ctx, repoDir, "clean", "--quiet", "-ffdx"); err != nil {
+		return "", err
+	}
+
+	commit, err := runGit(ctx, repoDir, "rev-parse", "HEAD")
+	if err != nil {
+		return "", err
+	}
+
+	logger.Infof("Fetched addons from %q at commit %s", src.URL, strings.TrimSpace(commit))
+
+	return repoDir, nil
+}
+
+func runGit(ctx context.Context, dir string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, gitBinary, append([]string{"-C", dir}, args...)...)
+	// fail instead of waiting for the credentials on the terminal
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", fail.Runtime(fmt.Errorf("%w: %s", err, strings.TrimSpace(string(out))), "running git %s", args[0])
+	}
+
+	return string(out), nil
+}
+
+// fetchHTTPAddons downloads the tarball to the cache, verifies its checksum
+// and extracts it. The tarball already in the cache is not downloaded again.
+// If the verifier is set, the signature of the tarball is verified on every
+// run, before the addons are used.
+func fetchHTTPAddons(ctx context.Context, logger logrus.FieldLogger, client *http.Client, src *kubeoneapi.AddonsHTTPSource, verifier *cosignVerifier, cacheDir string) (string, error) {
+	checksum := strings.ToLower(src.SHA256)
+	tarballPath := filepath.Join(cacheDir, checksum+".tar.gz")
+	addonsDir := filepath.Join(cacheDir, checksum)
+
+	if err := os.MkdirAll(cacheDir, 0o700); err != nil {
+		return "", fail.Runtime(err, "creating addons cache directory")
+	}
+
+	if _, err := os.Stat(tarballPath); err == nil {
+		logger.Infof("Using cached addons from %q", src.URL)
+	} else if err = downloadAddonsTarball(ctx, logger, client, src, tarballPath); err != nil {
+		return "", err
+	}
+
+	if verifier != nil {
+		if err := verifyAddonsTarball(ctx, logger, client, src, verifier, tarballPath); err != nil {
+			return "", err
+		}
+	}
+
+	if _, err := os.Stat(addonsDir); err == nil {
+		return addonsDir, nil
+	}
+
+	tarball, err := os.Open(tarballPath)
+	if err != nil {
+		return "", fail.Runtime(err, "reading downloaded addons")
+	}
+	defer tarball.Close()
+
+	// the tarball is extracted to the temporary directory first, so the
+	// partially extracted tarball is never used from the cache
+	extractDir, err := os.MkdirTemp(cacheDir, "extract-")
+	if err != nil {
+		return "", fail.Runtime(err, "creating addons directory")
+	}
+	defer os.RemoveAll(extractDir)
+
+	if err = extractTarGz(tarball, extractDir); err != nil {
+		return "", err
+	}
+
+	if err = os.Rename(extractDir, addonsDir); err != nil {
+		return "", fail.Runtime(err, "moving addons to the cache")
+	}
+
+	return addonsDir, nil
+}
+
+// downloadAddonsTarball downloads the tarball and moves it to the given path
+// after its checksum is verified
+func downloadAddonsTarball(ctx context.Context, logger logrus.FieldLogger, client *http.Client, src *kubeoneapi.AddonsHTTPSource, tarballPath string) error {
+	logger.Infof("Downloading addons from %q...", src.URL)
+
+	tarball, err := os.CreateTemp(filepath.Dir(tarballPath), "download-")
+	if err != nil {
+		return fail.Runtime(err, "creating addons download file")
+	}
+	defer os.Remove(tarball.Name())
+	defer tarball.Close()
+
+	hash := sha256.New()
+	if err = httpDownload(ctx, client, src.URL, io.MultiWriter(tarball, hash)); err != nil {
+		return err
+	}
+
+	checksum := strings.ToLower(src.SHA256)
+	if got := hex.EncodeToString(hash.Sum(nil)); got != checksum {
+		return fail.NewRuntimeError("verifying addons", "expected checksum %s for %q, got %s", checksum, src.URL, got)
+	}
+
+	if err = os.Rename(tarball.Name(), tarballPath); err != nil {
+		return fail.Runtime(err, "moving addons to the cache")
+	}
+
+	return nil
+}
+
+// verifyAddonsTarball downloads the cosign bundle of the tarball and verifies
+// the tarball signature
+func verifyAddonsTarball(ctx context.Context, logger logrus.FieldLogger, client *http.Client, src *kubeoneapi.AddonsHTTPSource, verifier *cosignVerifier, tarballPath string) error {
+	bundleURL := src.BundleURL
+	if bundleURL == "" {
+		bundleURL = src.URL + ".bundle"
+	}
+
+	bundle, err := os.CreateTemp(filepath.Dir(tarballPath), "bundle-")
+	if err != nil {
+		return fail.Runtime(err, "creating cosign bundle file")
+	}
+	defer os.Remove(bundle.Name())
+	defer bundle.Close()
+
+	if err = httpDownload(ctx, client, bundleURL, bundle); err != nil {
+		return err
+	}
+
+	logger.Infof("Verifying signature of addons from %q...", src.URL)
+
+	return verifier.verifyBlob(ctx, tarballPath, bundle.Name())
+}
+
+func httpDownload(ctx context.Context, client *http.Client, url string, w io.Writer) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fail.Config(err, "creating download request")
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fail.Runtime(err, "downloading %q", url)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fail.NewRuntimeError("downloading", "unexpected status %q for %q", resp.Status, url)
+	}
+
+	if _, err = io.Copy(w, resp.Body); err != nil {
+		return fail.Runtime(err, "downloading %q", url)
+	}
+
+	return nil
+}
+
+// extractTarGz extracts the directories and regular files from the gzipped
+// tarball. Other entries, such as symlinks, are skipped, and the entries
+// pointing outside of the directory are rejected.
+func extractTarGz(r io.Reader, dir string) error {
+	gzr, err := gzip.NewReader(r)
+	if err != nil {
+		return fail.Runtime(err, "reading addons tarball")
+	}
+	defer gzr.Close()
+
+	tr := tar.NewReader(gzr)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fail.Runtime(err, "reading addons tarball")
+		}
+
+		name := filepath.FromSlash(strings.TrimPrefix(hdr.Name, "./"))
+		if name == "" || name == "." {
+			continue
+		}
+		if !filepath.IsLocal(name) {
+			return fail.NewRuntimeError("extracting addons tarball", "entry %q points outside of the addons directory", hdr.Name)
+		}
+		target := filepath.Join(dir, name)
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err = os.MkdirAll(target, 0o700); err != nil {
+				return fail.Runtime(err, "extracting addons tarball")
+			}
+		case tar.TypeReg:
+			if hdr.Size > maxAddonsTarballFileSize {
+				return fail.NewRuntimeError("extracting addons tarball", "file %q is larger than %d bytes", hdr.Name, maxAddonsTarballFileSize)
+			}
+			if err = extractTarFile(tr, target, hdr.Size); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func extractTarFile(r io.Reader, target string, size int64) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0o700); err != nil {
+		return fail.Runtime(err, "extracting addons tarball")
+	}
+
+	f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return fail.Runtime(err, "extracting addons tarball")
+	}
+	defer f.Close()
+
+	if _, err = io.CopyN(f, r, size); err != nil {
+		return fail.Runtime(err, "extracting addons tarball")
+	}
+
+	return nil
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addons
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+)
+
+func testTarGz(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	gzw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gzw)
+
+	for name, content := range files {
+		hdr := &tar.Header{
+			Name:     name,
+			Mode:     0o644,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gzw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+func Test_fetchHTTPAddons(t *testing.T) {
+	tarball := testTarGz(t, map[string]string{
+		"addons/monitoring/prometheus.yaml": "kind: ConfigMap",
+	})
+	checksum := sha256.Sum256(tarball)
+
+	requests := 0
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		_, _ = w.Write(tarball)
+	}))
+	defer srv.Close()
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	cacheDir := t.TempDir()
+	src := &kubeoneapi.AddonsHTTPSource{
+		URL:    srv.URL + "/addons.tar.gz",
+		SHA256: hex.EncodeToString(checksum[:]),
+	}
+
+	for i := 0; i < 2; i++ {
+		dir, err := fetchHTTPAddons(context.Background(), logger, srv.Client(), src, nil, cacheDir)
+		if err != nil {
+			t.Fatalf("fetchHTTPAddons() error = %v", err)
+		}
+
+		content, err := os.ReadFile(filepath.Join(dir, "addons", "monitoring", "prometheus.yaml"))
+		if err != nil {
+			t.Fatalf("reading extracted addon: %v", err)
+		}
+		if string(content) != "kind: ConfigMap" {
+			t.Errorf("extracted addon = %q, want %q", content, "kind: ConfigMap")
+		}
+	}
+
+	if requests != 1 {
+		t.Errorf("tarball downloaded %d times, want once", requests)
+	}
+
+	src.SHA256 = hex.EncodeToString(make([]byte, sha256.Size))
+	if _, err := fetchHTTPAddons(context.Background(), logger, srv.Client(), src, nil, cacheDir); err == nil {
+		t.Error("fetchHTTPAddons() with wrong checksum succeeded")
+	}
+}
+
+func Test_fetchGitAddonsDashArguments(t *testing.T) {
+	tests := []struct {
+		name string
+		src  *kubeoneapi.AddonsGitSource
+	}{
+		{
+			name: "URL starting with a dash",
+			src:  &kubeoneapi.AddonsGitSource{URL: "--upload-pack=touch /tmp/pwned"},
+		},
+		{
+			name: "ref starting with a dash",
+			src: &kubeoneapi.AddonsGitSource{
+				URL: "https://github.com/example/addons.git",
+				Ref: "--upload-pack=touch /tmp/pwned",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			cacheDir := t.TempDir()
+
+			if _, err := fetchGitAddons(context.Background(), logrus.New(), tt.src, cacheDir); err == nil {
+				t.Fatal("fetchGitAddons() expected an error")
+			}
+
+			// the arguments are rejected before git is run
+			entries, err := os.ReadDir(cacheDir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(entries) != 0 {
+				t.Errorf("fetchGitAddons() created %d cache entries, want none", len(entries))
+			}
+		})
+	}
+}
+
+func Test_extractTarGz(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		wantErr bool
+	}{
+		{
+			name: "files in directories",
+			files: map[string]string{
+				"./monitoring/prometheus.yaml": "",
+				"logging/loki.yaml":            "",
+			},
+		},
+		{
+			name: "file outside of the directory",
+			files: map[string]string{
+				"../escaped.yaml": "",
+			},
+			wantErr: true,
+		},
+		{
+			name: "absolute path",
+			files: map[string]string{
+				"/etc/escaped.yaml": "",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+
+			err := extractTarGz(bytes.NewReader(testTarGz(t, tt.files)), dir)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("extractTarGz() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addons
+
+import (
+	"fmt"
+	"io/fs"
+
+	"github.com/Masterminds/semver/v3"
+
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/removedapis"
+	"k8c.io/kubeone/pkg/state"
+)
+
+// RemovedAPIs renders the addons deployed by KubeOne and the user addons, and
+// returns the objects using the APIs removed in the Kubernetes releases after
+// from, up to the Kubernetes version of the cluster. Such addons would fail to
+// apply once the cluster is upgraded.
+func RemovedAPIs(s *state.State, from *semver.Version) ([]removedapis.Finding, error) {
+	to, err := semver.NewVersion(s.Cluster.Versions.Kubernetes)
+	if err != nil {
+		return nil, fail.ConfigValidation(err)
+	}
+
+	applier, err := newAddonsApplier(s)
+	if err != nil {
+		return nil, err
+	}
+
+	var findings []removedapis.Finding
+
+	scan := func(fsys fs.FS, addonName string) error {
+		manifest, mErr := applier.getManifestsFromDirectory(s, fsys, addonName)
+		if mErr != nil {
+			return mErr
+		}
+
+		objects, oErr := manifestObjects(manifest)
+		if oErr != nil {
+			return oErr
+		}
+
+		source := fmt.Sprintf("addon %q", addonName)
+		if addonName == "" {
+			source = "addons in the root directory"
+		}
+
+		for _, obj := range objects {
+			if api, ok := removedapis.Find(obj.APIVersion, obj.Kind, from, to); ok {
+				findings = append(findings, removedapis.Finding{
+					RemovedAPI: api,
+					Namespace:  obj.Namespace,
+					Name:       obj.Name,
+					Source:     source,
+				})
+			}
+		}
+
+		return nil
+	}
+
+	addonNames := []string{}
+	for _, add := range collectAddons(s) {
+		addonNames = append(addonNames, add.name)
+	}
+
+	if s.Cluster.Addons.Enabled() {
+		orderedAddons, _, uErr := userAddonNames(s, applier.LocalFS)
+		if uErr != nil {
+			return nil, uErr
+		}
+		addonNames = append(addonNames, orderedAddons...)
+	}
+
+	for _, addonName := range addonNames {
+		fsys, fErr := applier.addonFS(addonName)
+		if fErr != nil {
+			return nil, fErr
+		}
+		if fsys == nil {
+			return nil, fail.NewRuntimeError(fmt.Sprintf("scanning %q addon", addonName), "addon does not exist")
+		}
+
+		if err = scan(fsys, addonName); err != nil {
+			return nil, err
+		}
+	}
+
+	if s.Cluster.Addons.Enabled() && applier.LocalFS != nil {
+		if err = scan(applier.LocalFS, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	return findings, nil
+}
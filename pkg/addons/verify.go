@@ -0,0 +1,86 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addons
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/fail"
+)
+
+const cosignBinary = "cosign"
+
+// cosignVerifier verifies the signatures using the cosign binary
+type cosignVerifier struct {
+	verification *kubeoneapi.AddonsVerification
+}
+
+func newCosignVerifier(verification *kubeoneapi.AddonsVerification) *cosignVerifier {
+	if verification == nil {
+		return nil
+	}
+
+	return &cosignVerifier{verification: verification}
+}
+
+// verifyImage verifies the signature of the image or OCI artifact
+func (v *cosignVerifier) verifyImage(ctx context.Context, ref string) error {
+	return v.run(ctx, "verify", ref)
+}
+
+// verifyBlob verifies the signature of the file using the cosign bundle
+func (v *cosignVerifier) verifyBlob(ctx context.Context, path, bundlePath string) error {
+	return v.run(ctx, "verify-blob", "--bundle", bundlePath, path)
+}
+
+func (v *cosignVerifier) run(ctx context.Context, args ...string) error {
+	cmdArgs := []string{args[0]}
+
+	if v.verification.PublicKey != "" {
+		// cosign reads the public key only from the file or the KMS
+		keyFile, err := os.CreateTemp("", "kubeone-cosign-*.pub")
+		if err != nil {
+			return fail.Runtime(err, "creating cosign public key file")
+		}
+		defer os.Remove(keyFile.Name())
+		defer keyFile.Close()
+
+		if _, err = keyFile.WriteString(v.verification.PublicKey); err != nil {
+			return fail.Runtime(err, "writing cosign public key file")
+		}
+
+		cmdArgs = append(cmdArgs, "--key", keyFile.Name())
+	} else {
+		cmdArgs = append(cmdArgs,
+			"--certificate-identity", v.verification.CertificateIdentity,
+			"--certificate-oidc-issuer", v.verification.CertificateOIDCIssuer,
+		)
+	}
+	cmdArgs = append(cmdArgs, args[1:]...)
+
+	out, err := exec.CommandContext(ctx, cosignBinary, cmdArgs...).CombinedOutput()
+	if err != nil {
+		return fail.Runtime(fmt.Errorf("%w: %s", err, strings.TrimSpace(string(out))), "verifying signature of %q", args[len(args)-1])
+	}
+
+	return nil
+}
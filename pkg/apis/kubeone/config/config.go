@@ -17,11 +17,12 @@ limitations under the License.
 package config
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
-	"os/exec"
 	"reflect"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -30,11 +31,15 @@ import (
 	kubeonescheme "k8c.io/kubeone/pkg/apis/kubeone/scheme"
 	kubeonev1beta1 "k8c.io/kubeone/pkg/apis/kubeone/v1beta1"
 	kubeonev1beta2 "k8c.io/kubeone/pkg/apis/kubeone/v1beta2"
+	kubeonev1beta3 "k8c.io/kubeone/pkg/apis/kubeone/v1beta3"
 	kubeonevalidation "k8c.io/kubeone/pkg/apis/kubeone/validation"
 	"k8c.io/kubeone/pkg/containerruntime"
+	"k8c.io/kubeone/pkg/credentials"
 	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/terraform"
 	terraformv1beta1 "k8c.io/kubeone/pkg/terraform/v1beta1"
 	terraformv1beta2 "k8c.io/kubeone/pkg/terraform/v1beta2"
+	terraformv1beta3 "k8c.io/kubeone/pkg/terraform/v1beta3"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/yaml"
@@ -45,11 +50,44 @@ const (
 	KubeOneClusterKind = "KubeOneCluster"
 )
 
+// defaultOpenStackCloudConfig is the cloud-config template used by the
+// OpenStack CCM and Cinder CSI driver if .cloudProvider.cloudConfig is not set
+const defaultOpenStackCloudConfig = `[Global]
+auth-url="{{ .Credentials.OS_AUTH_URL }}"
+region="{{ .Credentials.OS_REGION_NAME }}"
+{{- with .Credentials.OS_APPLICATION_CREDENTIAL_ID }}
+application-credential-id="{{ . }}"
+{{- end }}
+{{- with .Credentials.OS_APPLICATION_CREDENTIAL_SECRET }}
+application-credential-secret="{{ . }}"
+{{- end }}
+{{- with .Credentials.OS_USERNAME }}
+username="{{ . }}"
+{{- end }}
+{{- with .Credentials.OS_PASSWORD }}
+password="{{ . }}"
+{{- end }}
+{{- with .Credentials.OS_TENANT_ID }}
+tenant-id="{{ . }}"
+{{- end }}
+{{- with .Credentials.OS_TENANT_NAME }}
+tenant-name="{{ . }}"
+{{- end }}
+{{- with .Credentials.OS_DOMAIN_NAME }}
+domain-name="{{ . }}"
+{{- end }}
+
+[LoadBalancer]
+
+[BlockStorage]
+`
+
 var (
 	// AllowedAPIs contains APIs which are allowed to be used
 	AllowedAPIs = map[string]string{
 		kubeonev1beta1.SchemeGroupVersion.String(): "",
 		kubeonev1beta2.SchemeGroupVersion.String(): "",
+		kubeonev1beta3.SchemeGroupVersion.String(): "",
 	}
 
 	// DeprecatedAPIs contains APIs which are deprecated
@@ -59,15 +97,16 @@ var (
 )
 
 // LoadKubeOneCluster returns the internal representation of the KubeOneCluster object
-// parsed from the versioned KubeOneCluster manifest, Terraform output and credentials file
-func LoadKubeOneCluster(clusterCfgPath, tfOutputPath, credentialsFilePath string, logger logrus.FieldLogger) (*kubeoneapi.KubeOneCluster, error) {
+// parsed from the versioned KubeOneCluster manifest, Terraform output and credentials file.
+// If values files are provided, the manifest is rendered as a template first.
+func LoadKubeOneCluster(clusterCfgPath string, valuesFilePaths []string, tfOutputPath, credentialsFilePath string, logger logrus.FieldLogger) (*kubeoneapi.KubeOneCluster, error) {
 	if len(clusterCfgPath) == 0 {
 		return nil, fail.Runtime(fmt.Errorf("is not provided"), "cluster configuration path")
 	}
 
-	cluster, err := os.ReadFile(clusterCfgPath)
+	cluster, err := ReadManifest(clusterCfgPath, valuesFilePaths)
 	if err != nil {
-		return nil, fail.Runtime(err, "reading cluster configuration")
+		return nil, err
 	}
 
 	var tfOutput []byte
@@ -78,10 +117,13 @@ func LoadKubeOneCluster(clusterCfgPath, tfOutputPath, credentialsFilePath string
 			return nil, fail.Runtime(err, "reading terraform output from stdin")
 		}
 	case isDir(tfOutputPath):
-		cmd := exec.Command("terraform", "output", "-json")
-		cmd.Dir = tfOutputPath
-		if tfOutput, err = cmd.Output(); err != nil {
-			return nil, fail.Runtime(err, "reading terraform output")
+		tfBinary, tfErr := terraform.Detect(context.Background())
+		if tfErr != nil {
+			return nil, tfErr
+		}
+		logger.Debugf("Reading terraform output using %s %s (%s)", tfBinary.Flavor, tfBinary.Version, tfBinary.Path)
+		if tfOutput, err = tfBinary.Output(context.Background(), tfOutputPath); err != nil {
+			return nil, err
 		}
 	case len(tfOutputPath) != 0:
 		if tfOutput, err = os.ReadFile(tfOutputPath); err != nil {
@@ -91,9 +133,9 @@ func LoadKubeOneCluster(clusterCfgPath, tfOutputPath, credentialsFilePath string
 
 	var credentialsFile []byte
 	if len(credentialsFilePath) != 0 {
-		credentialsFile, err = os.ReadFile(credentialsFilePath)
+		credentialsFile, err = credentials.ReadFile(credentialsFilePath)
 		if err != nil {
-			return nil, fail.Runtime(err, "reading credentials file")
+			return nil, err
 		}
 	}
 
@@ -136,9 +178,52 @@ func BytesToKubeOneCluster(cluster, tfOutput, credentialsFile []byte, logger log
 		}
 
 		return DefaultedV1Beta2KubeOneCluster(v1beta2Cluster, tfOutput, credentialsFile, logger)
+	case kubeonev1beta3.SchemeGroupVersion.String():
+		v1beta3Cluster := kubeonev1beta3.NewKubeOneCluster()
+		if err := runtime.DecodeInto(kubeonescheme.Codecs.UniversalDecoder(), cluster, v1beta3Cluster); err != nil {
+			return nil, fail.Config(err, fmt.Sprintf("decoding %s", v1beta3Cluster.GroupVersionKind()))
+		}
+
+		return DefaultedV1Beta3KubeOneCluster(v1beta3Cluster, tfOutput, credentialsFile, logger)
+	default:
+		return nil, fail.Config(fmt.Errorf("invalid api version %q", typeMeta.APIVersion), "api version")
+	}
+}
+
+// DecodeKubeOneCluster converts the versioned KubeOneCluster manifest to the
+// internal representation, applying only the static defaults. The Terraform
+// output is not sourced and the object is not validated, so it can be used
+// before the infrastructure for the cluster is created.
+func DecodeKubeOneCluster(cluster []byte) (*kubeoneapi.KubeOneCluster, error) {
+	typeMeta := runtime.TypeMeta{}
+	if err := yaml.Unmarshal(cluster, &typeMeta); err != nil {
+		return nil, fail.Config(err, "unmarshal cluster typeMeta")
+	}
+
+	var versionedCluster runtime.Object
+	switch typeMeta.APIVersion {
+	case kubeonev1beta1.SchemeGroupVersion.String():
+		versionedCluster = kubeonev1beta1.NewKubeOneCluster()
+	case kubeonev1beta2.SchemeGroupVersion.String():
+		versionedCluster = kubeonev1beta2.NewKubeOneCluster()
+	case kubeonev1beta3.SchemeGroupVersion.String():
+		versionedCluster = kubeonev1beta3.NewKubeOneCluster()
 	default:
 		return nil, fail.Config(fmt.Errorf("invalid api version %q", typeMeta.APIVersion), "api version")
 	}
+
+	if err := runtime.DecodeInto(kubeonescheme.Codecs.UniversalDecoder(), cluster, versionedCluster); err != nil {
+		return nil, fail.Config(err, fmt.Sprintf("decoding %s", typeMeta.APIVersion))
+	}
+
+	internalCluster := &kubeoneapi.KubeOneCluster{}
+
+	kubeonescheme.Scheme.Default(versionedCluster)
+	if err := kubeonescheme.Scheme.Convert(versionedCluster, internalCluster, nil); err != nil {
+		return nil, fail.Config(err, fmt.Sprintf("converting %s to internal object", typeMeta.APIVersion))
+	}
+
+	return internalCluster, nil
 }
 
 // DefaultedV1Beta1KubeOneCluster converts a v1beta1 KubeOneCluster object to an internal representation of KubeOneCluster
@@ -227,6 +312,43 @@ func DefaultedV1Beta2KubeOneCluster(versionedCluster *kubeonev1beta2.KubeOneClus
 	return internalCluster, nil
 }
 
+// DefaultedV1Beta3KubeOneCluster converts a v1beta3 KubeOneCluster object to an internal representation of KubeOneCluster
+// object while sourcing information from Terraform output, applying default values and validating the KubeOneCluster
+// object
+func DefaultedV1Beta3KubeOneCluster(versionedCluster *kubeonev1beta3.KubeOneCluster, tfOutput, credentialsFile []byte, logger logrus.FieldLogger) (*kubeoneapi.KubeOneCluster, error) {
+	if tfOutput != nil {
+		tfConfig, err := terraformv1beta3.NewConfigFromJSON(tfOutput)
+		if err != nil {
+			return nil, err
+		}
+		if err := tfConfig.Apply(versionedCluster); err != nil {
+			return nil, err
+		}
+	}
+
+	internalCluster := &kubeoneapi.KubeOneCluster{}
+
+	kubeonescheme.Scheme.Default(versionedCluster)
+	if err := kubeonescheme.Scheme.Convert(versionedCluster, internalCluster, nil); err != nil {
+		return nil, fail.Config(err, fmt.Sprintf("converting %s to internal object", versionedCluster.GroupVersionKind()))
+	}
+
+	// Apply the dynamic defaults
+	if err := SetKubeOneClusterDynamicDefaults(internalCluster, credentialsFile); err != nil {
+		return nil, err
+	}
+
+	// Validate the configuration
+	if err := kubeonevalidation.ValidateKubeOneCluster(*internalCluster).ToAggregate(); err != nil {
+		return nil, fail.ConfigValidation(err)
+	}
+
+	// Check for deprecated fields/features for a cluster
+	checkClusterFeatures(*internalCluster, logger)
+
+	return internalCluster, nil
+}
+
 // SetKubeOneClusterDynamicDefaults sets the dynamic defaults for a given KubeOneCluster object
 func SetKubeOneClusterDynamicDefaults(cluster *kubeoneapi.KubeOneCluster, credentialsFile []byte) error {
 	// Parse the credentials file
@@ -244,6 +366,12 @@ func SetKubeOneClusterDynamicDefaults(cluster *kubeoneapi.KubeOneCluster, creden
 
 		cluster.CloudProvider.CloudConfig = cc
 	}
+	// Generate the OpenStack cloud-config from the OS_* credentials if it's
+	// not provided. The template is rendered when creating the cloud-config
+	// secret, so both user and application credentials are supported.
+	if cluster.CloudProvider.Openstack != nil && cluster.CloudProvider.CloudConfig == "" {
+		cluster.CloudProvider.CloudConfig = defaultOpenStackCloudConfig
+	}
 	// Source csi-config from the credentials file if it's present
 	if cc, ok := credentials["csiConfig"]; ok {
 		cluster.CloudProvider.CSIConfig = cc
@@ -297,10 +425,10 @@ func setRegistriesAuth(cluster *kubeoneapi.KubeOneCluster, buf string) error {
 		return fail.Config(err, "YAML unmarshal registriesAuth")
 	}
 
-	if registriesAuth.APIVersion != kubeonev1beta2.SchemeGroupVersion.String() {
+	if registriesAuth.APIVersion != kubeonev1beta2.SchemeGroupVersion.String() && registriesAuth.APIVersion != kubeonev1beta3.SchemeGroupVersion.String() {
 		return fail.ConfigError{
 			Op:  "registriesAuth apiVersion checking",
-			Err: errors.Errorf("only %q apiVersion is supported", kubeonev1beta2.SchemeGroupVersion.String()),
+			Err: errors.Errorf("only %q and %q apiVersions are supported", kubeonev1beta2.SchemeGroupVersion.String(), kubeonev1beta3.SchemeGroupVersion.String()),
 		}
 	}
 
@@ -355,4 +483,8 @@ func checkClusterFeatures(cluster kubeoneapi.KubeOneCluster, logger logrus.Field
 	if cluster.CloudProvider.Vsphere != nil && !cluster.CloudProvider.External && len(cluster.CloudProvider.CSIConfig) > 0 {
 		logger.Warnf(".cloudProvider.csiConfig is provided, but is ignored when used with the in-tree cloud provider")
 	}
+
+	if cluster.CloudProvider.GCE != nil && cluster.CloudProvider.GCE.SharedVPC != nil && !strings.Contains(cluster.CloudProvider.CloudConfig, "network-project-id") {
+		logger.Warnf(".cloudProvider.gce.sharedVPC is provided, but .cloudProvider.cloudConfig doesn't set network-project-id")
+	}
 }
@@ -18,7 +18,9 @@ package config
 
 import (
 	"reflect"
+	"strings"
 	"testing"
+	"text/template"
 
 	"github.com/MakeNowJust/heredoc/v2"
 
@@ -167,3 +169,91 @@ func Test_setRegistriesAuth(t *testing.T) {
 		})
 	}
 }
+
+func TestSetKubeOneClusterDynamicDefaultsOpenStackCloudConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		cloudConfig string
+		credentials map[string]string
+		want        string
+	}{
+		{
+			name: "application credentials",
+			credentials: map[string]string{
+				"OS_AUTH_URL":                      "https://localhost:5000",
+				"OS_REGION_NAME":                   "de",
+				"OS_APPLICATION_CREDENTIAL_ID":     "1234",
+				"OS_APPLICATION_CREDENTIAL_SECRET": "5678",
+			},
+			want: heredoc.Doc(`
+				[Global]
+				auth-url="https://localhost:5000"
+				region="de"
+				application-credential-id="1234"
+				application-credential-secret="5678"
+
+				[LoadBalancer]
+
+				[BlockStorage]
+			`),
+		},
+		{
+			name: "user credentials",
+			credentials: map[string]string{
+				"OS_AUTH_URL":    "https://localhost:5000",
+				"OS_REGION_NAME": "de",
+				"OS_USERNAME":    "user",
+				"OS_PASSWORD":    "pass",
+				"OS_TENANT_ID":   "tenant",
+				"OS_DOMAIN_NAME": "Default",
+			},
+			want: heredoc.Doc(`
+				[Global]
+				auth-url="https://localhost:5000"
+				region="de"
+				username="user"
+				password="pass"
+				tenant-id="tenant"
+				domain-name="Default"
+
+				[LoadBalancer]
+
+				[BlockStorage]
+			`),
+		},
+		{
+			name:        "custom cloud config",
+			cloudConfig: "[Global]",
+			want:        "[Global]",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &kubeoneapi.KubeOneCluster{
+				CloudProvider: kubeoneapi.CloudProviderSpec{
+					Openstack:   &kubeoneapi.OpenstackSpec{},
+					CloudConfig: tt.cloudConfig,
+				},
+			}
+			if err := SetKubeOneClusterDynamicDefaults(cluster, nil); err != nil {
+				t.Fatalf("SetKubeOneClusterDynamicDefaults() error = %v", err)
+			}
+
+			tpl, err := template.New("cloudConfig").Parse(cluster.CloudProvider.CloudConfig)
+			if err != nil {
+				t.Fatalf("parsing cloudConfig: %v", err)
+			}
+
+			var got strings.Builder
+			if err = tpl.Execute(&got, struct{ Credentials map[string]string }{Credentials: tt.credentials}); err != nil {
+				t.Fatalf("rendering cloudConfig: %v", err)
+			}
+
+			if got.String() != tt.want {
+				t.Errorf("got cloudConfig:\n%s\nwant:\n%s", got.String(), tt.want)
+			}
+		})
+	}
+}
@@ -17,30 +17,32 @@ limitations under the License.
 package config
 
 import (
+	"bytes"
 	"fmt"
-	"os"
 
 	kubeonev1beta1 "k8c.io/kubeone/pkg/apis/kubeone/v1beta1"
 	kubeonev1beta2 "k8c.io/kubeone/pkg/apis/kubeone/v1beta2"
+	kubeonev1beta3 "k8c.io/kubeone/pkg/apis/kubeone/v1beta3"
 	"k8c.io/kubeone/pkg/fail"
 	"k8c.io/kubeone/pkg/yamled"
 )
 
-// MigrateOldConfig migrates KubeOneCluster v1beta1 object to v1beta2
-func MigrateOldConfig(clusterFilePath string) (interface{}, error) {
-	oldConfig, err := loadClusterConfig(clusterFilePath)
+// MigrateOldConfig migrates KubeOneCluster v1beta1 and v1beta2 objects to v1beta3. Templated manifests are rendered
+// using the given values files before migrating, so the migrated manifest is not a template any longer.
+func MigrateOldConfig(clusterFilePath string, valuesFilePaths []string) (interface{}, error) {
+	oldConfig, err := loadClusterConfig(clusterFilePath, valuesFilePaths)
 	if err != nil {
 		return nil, fail.Runtime(err, "loading cluster config to migrate")
 	}
 
-	// Check is kubeone.io/v1beta1 config provided
+	// Check is kubeone.io/v1beta1 or kubeone.k8c.io/v1beta2 config provided
 	apiVersion, apiVersionExists := oldConfig.GetString(yamled.Path{"apiVersion"})
 	if !apiVersionExists {
 		return nil, fail.Config(fmt.Errorf("apiVersion not present in the manifest"), "checking apiVersion presence")
 	}
 
-	if apiVersion != kubeonev1beta1.SchemeGroupVersion.String() {
-		return nil, fail.Config(fmt.Errorf("migration is available only for %q API, but %q is given", kubeonev1beta1.SchemeGroupVersion.String(), apiVersion), "checking apiVersion compatibility")
+	if apiVersion != kubeonev1beta1.SchemeGroupVersion.String() && apiVersion != kubeonev1beta2.SchemeGroupVersion.String() {
+		return nil, fail.Config(fmt.Errorf("migration is available only for %q and %q APIs, but %q is given", kubeonev1beta1.SchemeGroupVersion.String(), kubeonev1beta2.SchemeGroupVersion.String(), apiVersion), "checking apiVersion compatibility")
 	}
 
 	// Ensure kind is KubeOneCluster
@@ -52,8 +54,24 @@ func MigrateOldConfig(clusterFilePath string) (interface{}, error) {
 		return nil, fail.ConfigValidation(fmt.Errorf("migration is available only for kind %q, but %q is given", KubeOneClusterKind, kind))
 	}
 
+	// The v1beta1 manifest is migrated to v1beta2 first, and then to v1beta3
+	if apiVersion == kubeonev1beta1.SchemeGroupVersion.String() {
+		if err := migrateV1Beta1ToV1Beta2(oldConfig); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := migrateV1Beta2ToV1Beta3(oldConfig); err != nil {
+		return nil, err
+	}
+
+	return oldConfig.Root(), nil
+}
+
+// migrateV1Beta1ToV1Beta2 migrates the v1beta1 KubeOneCluster manifest to v1beta2
+func migrateV1Beta1ToV1Beta2(cfg *yamled.Document) error {
 	// The APIVersion has been changed to kubeone.k8c.io/v1beta2
-	oldConfig.Set(yamled.Path{"apiVersion"}, kubeonev1beta2.SchemeGroupVersion.String())
+	cfg.Set(yamled.Path{"apiVersion"}, kubeonev1beta2.SchemeGroupVersion.String())
 
 	// AssetConfiguration API has been removed from the v1beta2 API.
 	// We are not able to automatically migrate manifests using the AssetConfiguration API
@@ -61,47 +79,87 @@ func MigrateOldConfig(clusterFilePath string) (interface{}, error) {
 	//   * EKS-D clusters -- support for EKS-D cluster has been entirely removed in KubeOne 1.4
 	//   * Problem with CoreDNS image when using overwriteRegistry -- can be mitigated by using the latest image-loader
 	//     script or by using the RegistryConfiguration API (registry mirrors)
-	_, assetConfigExists := oldConfig.Get(yamled.Path{"assetConfiguration"})
+	_, assetConfigExists := cfg.Get(yamled.Path{"assetConfiguration"})
 	if assetConfigExists {
-		return nil, fail.ConfigValidation(fmt.Errorf("the AssetConfiguration API has been removed from the v1beta2 API, please check the docs for information on how to migrate"))
+		return fail.ConfigValidation(fmt.Errorf("the AssetConfiguration API has been removed from the v1beta2 API, please check the docs for information on how to migrate"))
 	}
 
 	// Packet has been renamed to Equinix Metal and as a result of this change
 	// .cloudProvider.packet field has been renamed to .cloudProvider.equinixmetal
-	packetSpec, cloudProviderPacketExists := oldConfig.Get(yamled.Path{"cloudProvider", "packet"})
+	packetSpec, cloudProviderPacketExists := cfg.Get(yamled.Path{"cloudProvider", "packet"})
 	if cloudProviderPacketExists {
-		oldConfig.Remove(yamled.Path{"cloudProvider", "packet"})
-		oldConfig.Set(yamled.Path{"cloudProvider", "equinixmetal"}, packetSpec)
+		cfg.Remove(yamled.Path{"cloudProvider", "packet"})
+		cfg.Set(yamled.Path{"cloudProvider", "equinixmetal"}, packetSpec)
 	}
 
 	// The PodPresets feature has been removed from the v1beta2 API because Kubernetes doesn't support it starting
 	// with Kubernetes 1.20.
-	_, podPresetsExists := oldConfig.Get(yamled.Path{"features", "podPresets"})
+	_, podPresetsExists := cfg.Get(yamled.Path{"features", "podPresets"})
 	if podPresetsExists {
-		oldConfig.Remove(yamled.Path{"features", "podPresets"})
+		cfg.Remove(yamled.Path{"features", "podPresets"})
 	}
 
 	// The addons path is not defaulted to "./addons" any longer to better support embedded addons.
 	// To keep the backwards compatibility, migration will set the addons path to "./addons" if it's
 	// empty or unset. The user can remove it if it's not needed.
-	_, addonsExists := oldConfig.Get(yamled.Path{"addons"})
+	_, addonsExists := cfg.Get(yamled.Path{"addons"})
 	if addonsExists {
-		addonsPath, addonsPathExists := oldConfig.Get(yamled.Path{"addons", "path"})
+		addonsPath, addonsPathExists := cfg.Get(yamled.Path{"addons", "path"})
 		if !addonsPathExists || addonsPath == "" {
-			oldConfig.Set(yamled.Path{"addons", "path"}, "./addons")
+			cfg.Set(yamled.Path{"addons", "path"}, "./addons")
 		}
 	}
 
-	return oldConfig.Root(), nil
+	return nil
+}
+
+// migrateV1Beta2ToV1Beta3 migrates the v1beta2 KubeOneCluster manifest to v1beta3
+func migrateV1Beta2ToV1Beta3(cfg *yamled.Document) error {
+	// The APIVersion has been changed to kubeone.k8c.io/v1beta3
+	cfg.Set(yamled.Path{"apiVersion"}, kubeonev1beta3.SchemeGroupVersion.String())
+
+	// The Docker container runtime has been removed from the v1beta3 API because
+	// Kubernetes doesn't support it starting with Kubernetes 1.24.
+	_, dockerExists := cfg.Get(yamled.Path{"containerRuntime", "docker"})
+	if dockerExists {
+		return fail.ConfigValidation(fmt.Errorf("the Docker container runtime has been removed from the v1beta3 API, please use the \"kubeone migrate to-containerd\" command to migrate to containerd first"))
+	}
+
+	// The PodSecurityPolicy feature has been removed from the v1beta3 API because Kubernetes doesn't support it
+	// starting with Kubernetes 1.25.
+	pspEnabled, _ := cfg.GetBool(yamled.Path{"features", "podSecurityPolicy", "enable"})
+	if pspEnabled {
+		return fail.ConfigValidation(fmt.Errorf("the PodSecurityPolicy feature has been removed from the v1beta3 API, please disable it before migrating"))
+	}
+	cfg.Remove(yamled.Path{"features", "podSecurityPolicy"})
+
+	// MachineAnnotations has been removed from the v1beta3 API in favor of NodeAnnotations.
+	dynamicWorkers, _ := cfg.GetArray(yamled.Path{"dynamicWorkers"})
+	for i := range dynamicWorkers {
+		machineAnnotationsPath := yamled.Path{"dynamicWorkers", i, "providerSpec", "machineAnnotations"}
+		nodeAnnotationsPath := yamled.Path{"dynamicWorkers", i, "providerSpec", "nodeAnnotations"}
+
+		machineAnnotations, machineAnnotationsExists := cfg.Get(machineAnnotationsPath)
+		if !machineAnnotationsExists {
+			continue
+		}
+		if cfg.Has(nodeAnnotationsPath) {
+			return fail.ConfigValidation(fmt.Errorf("machineAnnotations has been replaced with nodeAnnotations, only one of those two can be set"))
+		}
+
+		cfg.Remove(machineAnnotationsPath)
+		cfg.Set(nodeAnnotationsPath, machineAnnotations)
+	}
+
+	return nil
 }
 
 // loadClusterConfig takes path to the Cluster Config (old API) and returns yamled.Document
-func loadClusterConfig(oldConfigPath string) (*yamled.Document, error) {
-	f, err := os.Open(oldConfigPath)
+func loadClusterConfig(oldConfigPath string, valuesFilePaths []string) (*yamled.Document, error) {
+	manifest, err := ReadManifest(oldConfigPath, valuesFilePaths)
 	if err != nil {
-		return nil, fail.Runtime(err, "open manifest file")
+		return nil, err
 	}
-	defer f.Close()
 
-	return yamled.Load(f)
+	return yamled.Load(bytes.NewReader(manifest))
 }
@@ -26,6 +26,7 @@ import (
 	yaml "gopkg.in/yaml.v2"
 
 	kubeonev1beta2 "k8c.io/kubeone/pkg/apis/kubeone/v1beta2"
+	kubeonev1beta3 "k8c.io/kubeone/pkg/apis/kubeone/v1beta3"
 	"k8c.io/kubeone/pkg/fail"
 	"k8c.io/kubeone/pkg/testhelper"
 
@@ -36,55 +37,86 @@ var update = flag.Bool("update", false, "update .golden files")
 
 func TestMigrateOldConfig(t *testing.T) {
 	testcases := []struct {
-		name string
-		err  string
+		name         string
+		inputVersion string
+		err          string
 	}{
 		{
-			name: "config-addons-1",
+			name:         "config-addons-1",
+			inputVersion: "v1beta1",
 		},
 		{
-			name: "config-addons-2",
+			name:         "config-addons-2",
+			inputVersion: "v1beta1",
 		},
 		{
-			name: "config-addons-3",
+			name:         "config-addons-3",
+			inputVersion: "v1beta1",
 		},
 		{
-			name: "config-addons-4",
+			name:         "config-addons-4",
+			inputVersion: "v1beta1",
 		},
 		{
-			name: "config-addons-5",
+			name:         "config-addons-5",
+			inputVersion: "v1beta1",
 		},
 		{
-			name: "config-addons-6",
+			name:         "config-addons-6",
+			inputVersion: "v1beta1",
 		},
 		{
-			name: "config-assetconfig",
-			err:  "the AssetConfiguration API has been removed from the v1beta2 API, please check the docs for information on how to migrate",
+			name:         "config-assetconfig",
+			inputVersion: "v1beta1",
+			err:          "the AssetConfiguration API has been removed from the v1beta2 API, please check the docs for information on how to migrate",
+		},
+		{
+			name:         "config-aws",
+			inputVersion: "v1beta1",
 		},
 		{
-			name: "config-aws",
+			// podSecurityPolicy is dropped since the feature doesn't exist in
+			// the v1beta3 API, see config-full-v1beta2.golden for the
+			// intermediate v1beta2 manifest
+			name:         "config-full",
+			inputVersion: "v1beta1",
 		},
 		{
-			name: "config-full",
+			name:         "config-packet",
+			inputVersion: "v1beta1",
 		},
 		{
-			name: "config-packet",
+			name:         "config-podpresets-1",
+			inputVersion: "v1beta1",
 		},
 		{
-			name: "config-podpresets-1",
+			name:         "config-podpresets-2",
+			inputVersion: "v1beta1",
 		},
 		{
-			name: "config-podpresets-2",
+			name:         "config-podpresets-3",
+			inputVersion: "v1beta1",
 		},
 		{
-			name: "config-podpresets-3",
+			name:         "config-machineannotations",
+			inputVersion: "v1beta2",
+		},
+		{
+			name:         "config-docker",
+			inputVersion: "v1beta2",
+			err:          "the Docker container runtime has been removed from the v1beta3 API, please use the \"kubeone migrate to-containerd\" command to migrate to containerd first",
+		},
+		{
+			name:         "config-psp",
+			inputVersion: "v1beta2",
+			err:          "the PodSecurityPolicy feature has been removed from the v1beta3 API, please disable it before migrating",
 		},
 	}
 
 	for _, tc := range testcases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			newConfigYAML, err := MigrateOldConfig(filepath.Join("testdata", tc.name+"-v1beta1.yaml"))
+			newConfigYAML, err := MigrateOldConfig(filepath.Join("testdata", tc.name+"-"+tc.inputVersion+".yaml"), nil)
 			if err != nil {
 				errMsg := err.Error()
 
@@ -107,12 +139,67 @@ func TestMigrateOldConfig(t *testing.T) {
 			}
 
 			// Validate new config by unmarshaling
-			newConfig := kubeonev1beta2.NewKubeOneCluster()
+			newConfig := kubeonev1beta3.NewKubeOneCluster()
 			err = kyaml.UnmarshalStrict(buffer.Bytes(), &newConfig)
 			if err != nil {
 				t.Errorf("failed to decode new config: %v", err)
 			}
 
+			testhelper.DiffOutput(t, tc.name+"-v1beta3.golden", buffer.String(), *update)
+		})
+	}
+}
+
+func TestMigrateV1Beta1ToV1Beta2(t *testing.T) {
+	testcases := []struct {
+		name string
+		err  string
+	}{
+		{name: "config-addons-1"},
+		{name: "config-addons-2"},
+		{name: "config-addons-3"},
+		{name: "config-addons-4"},
+		{name: "config-addons-5"},
+		{name: "config-addons-6"},
+		{
+			name: "config-assetconfig",
+			err:  "the AssetConfiguration API has been removed from the v1beta2 API, please check the docs for information on how to migrate",
+		},
+		{name: "config-aws"},
+		{name: "config-full"},
+		{name: "config-packet"},
+		{name: "config-podpresets-1"},
+		{name: "config-podpresets-2"},
+		{name: "config-podpresets-3"},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			cfg, err := loadClusterConfig(filepath.Join("testdata", tc.name+"-v1beta1.yaml"), nil)
+			if err != nil {
+				t.Fatalf("loading old config: %v", err)
+			}
+
+			if err = migrateV1Beta1ToV1Beta2(cfg); err != nil {
+				var cfgErr fail.ConfigError
+				if errors.As(err, &cfgErr) && cfgErr.Err.Error() == tc.err {
+					return
+				}
+				t.Fatalf("error converting old config: %v", err)
+			}
+
+			var buffer bytes.Buffer
+			if err = yaml.NewEncoder(&buffer).Encode(cfg.Root()); err != nil {
+				t.Errorf("unable to decode yaml: %v", err)
+			}
+
+			// Validate the intermediate v1beta2 config by unmarshaling
+			newConfig := kubeonev1beta2.NewKubeOneCluster()
+			if err = kyaml.UnmarshalStrict(buffer.Bytes(), &newConfig); err != nil {
+				t.Errorf("failed to decode new config: %v", err)
+			}
+
 			testhelper.DiffOutput(t, tc.name+"-v1beta2.golden", buffer.String(), *update)
 		})
 	}
@@ -0,0 +1,261 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"github.com/Masterminds/sprig/v3"
+
+	"k8c.io/kubeone/pkg/fail"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// manifestTemplateSuffix marks manifest files that are always rendered as
+	// templates, e.g. kubeone.tmpl.yaml or kubeone.yaml.tmpl
+	manifestTemplateSuffix = ".tmpl"
+)
+
+// ReadManifest reads the KubeOneCluster manifest from the given path. The
+// manifest is rendered as a Go template (with sprig functions) if any values
+// files are provided or if the manifest is a template file (see
+// IsManifestTemplate).
+func ReadManifest(clusterCfgPath string, valuesFilePaths []string) ([]byte, error) {
+	manifest, err := os.ReadFile(clusterCfgPath)
+	if err != nil {
+		return nil, fail.Runtime(err, "reading cluster configuration")
+	}
+
+	if len(valuesFilePaths) == 0 && !IsManifestTemplate(clusterCfgPath) {
+		return manifest, nil
+	}
+
+	values, err := LoadValues(valuesFilePaths)
+	if err != nil {
+		return nil, err
+	}
+
+	return RenderManifest(filepath.Base(clusterCfgPath), manifest, values)
+}
+
+// IsManifestTemplate returns true if the manifest file name ends with ".tmpl",
+// optionally followed by the ".yaml" or ".yml" extension
+func IsManifestTemplate(clusterCfgPath string) bool {
+	name := filepath.Base(clusterCfgPath)
+	if ext := filepath.Ext(name); ext == ".yaml" || ext == ".yml" {
+		name = strings.TrimSuffix(name, ext)
+	}
+
+	return strings.HasSuffix(name, manifestTemplateSuffix)
+}
+
+// LoadValues reads the given YAML values files and merges them in order,
+// values from the later files overriding values from the earlier ones
+func LoadValues(valuesFilePaths []string) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+
+	for _, valuesFilePath := range valuesFilePaths {
+		buf, err := os.ReadFile(valuesFilePath)
+		if err != nil {
+			return nil, fail.Runtime(err, "reading values file")
+		}
+
+		fileValues := map[string]interface{}{}
+		if err = yaml.Unmarshal(buf, &fileValues); err != nil {
+			return nil, fail.Config(err, fmt.Sprintf("unmarshal values file %q", valuesFilePath))
+		}
+
+		mergeValues(values, fileValues)
+	}
+
+	return values, nil
+}
+
+// RenderManifest renders the manifest template using the given values, which
+// are available as .Values in the template. Referencing a key that's not
+// present in values, as well as providing values that are not referenced by
+// the template, results in an error.
+func RenderManifest(name string, manifest []byte, values map[string]interface{}) ([]byte, error) {
+	tpl, err := template.New(name).
+		Funcs(sprig.TxtFuncMap()).
+		Option("missingkey=error").
+		Parse(string(manifest))
+	if err != nil {
+		return nil, fail.Config(err, "parsing manifest template")
+	}
+
+	if err = checkUnknownValues(tpl, values); err != nil {
+		return nil, err
+	}
+
+	data := map[string]interface{}{
+		"Values": values,
+	}
+
+	var buf bytes.Buffer
+	if err = tpl.Execute(&buf, data); err != nil {
+		return nil, fail.Config(err, "rendering manifest template")
+	}
+
+	return buf.Bytes(), nil
+}
+
+// mergeValues recursively merges src into dst. Nested maps are merged, while
+// all other values in src replace values in dst.
+func mergeValues(dst, src map[string]interface{}) {
+	for key, srcVal := range src {
+		srcMap, srcIsMap := srcVal.(map[string]interface{})
+		dstMap, dstIsMap := dst[key].(map[string]interface{})
+
+		if srcIsMap && dstIsMap {
+			mergeValues(dstMap, srcMap)
+
+			continue
+		}
+
+		dst[key] = srcVal
+	}
+}
+
+// checkUnknownValues returns an error if any of the values is not referenced
+// by the template. A value is considered referenced if the template
+// references the value itself, or any of its parents or children, e.g.
+// {{ .Values.x }} references all of .Values.x.a, .Values.x.b, etc.
+func checkUnknownValues(tpl *template.Template, values map[string]interface{}) error {
+	var refs [][]string
+	for _, t := range tpl.Templates() {
+		if t.Tree != nil {
+			collectValuesRefs(t.Tree.Root, &refs)
+		}
+	}
+
+	var unknown []string
+	for _, valuePath := range valuesPaths(nil, values) {
+		if !isValueReferenced(valuePath, refs) {
+			unknown = append(unknown, strings.Join(valuePath, "."))
+		}
+	}
+
+	if len(unknown) == 0 {
+		return nil
+	}
+
+	sort.Strings(unknown)
+
+	return fail.Config(fmt.Errorf("unknown keys in values files: %s", strings.Join(unknown, ", ")), "checking values files")
+}
+
+// collectValuesRefs walks the template tree and collects all .Values and
+// $.Values references, without the leading "Values" identifier
+func collectValuesRefs(node parse.Node, refs *[][]string) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			collectValuesRefs(child, refs)
+		}
+	case *parse.ActionNode:
+		collectValuesRefs(n.Pipe, refs)
+	case *parse.IfNode:
+		collectValuesRefs(&n.BranchNode, refs)
+	case *parse.RangeNode:
+		collectValuesRefs(&n.BranchNode, refs)
+	case *parse.WithNode:
+		collectValuesRefs(&n.BranchNode, refs)
+	case *parse.BranchNode:
+		collectValuesRefs(n.Pipe, refs)
+		collectValuesRefs(n.List, refs)
+		collectValuesRefs(n.ElseList, refs)
+	case *parse.TemplateNode:
+		collectValuesRefs(n.Pipe, refs)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, cmd := range n.Cmds {
+			collectValuesRefs(cmd, refs)
+		}
+	case *parse.CommandNode:
+		for _, arg := range n.Args {
+			collectValuesRefs(arg, refs)
+		}
+	case *parse.ChainNode:
+		collectValuesRefs(n.Node, refs)
+	case *parse.FieldNode:
+		if len(n.Ident) > 0 && n.Ident[0] == "Values" {
+			*refs = append(*refs, n.Ident[1:])
+		}
+	case *parse.VariableNode:
+		if len(n.Ident) > 1 && n.Ident[0] == "$" && n.Ident[1] == "Values" {
+			*refs = append(*refs, n.Ident[2:])
+		}
+	}
+}
+
+// valuesPaths returns paths to all leaf values
+func valuesPaths(prefix []string, values map[string]interface{}) [][]string {
+	var paths [][]string
+
+	for key, val := range values {
+		path := append(append([]string{}, prefix...), key)
+
+		if nested, ok := val.(map[string]interface{}); ok && len(nested) > 0 {
+			paths = append(paths, valuesPaths(path, nested)...)
+
+			continue
+		}
+
+		paths = append(paths, path)
+	}
+
+	return paths
+}
+
+func isValueReferenced(valuePath []string, refs [][]string) bool {
+	for _, ref := range refs {
+		if isPathPrefix(ref, valuePath) || isPathPrefix(valuePath, ref) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isPathPrefix(prefix, path []string) bool {
+	if len(prefix) > len(path) {
+		return false
+	}
+
+	for i := range prefix {
+		if prefix[i] != path[i] {
+			return false
+		}
+	}
+
+	return true
+}
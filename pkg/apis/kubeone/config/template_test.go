@@ -0,0 +1,176 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+)
+
+func TestReadManifest(t *testing.T) {
+	manifest := heredoc.Doc(`
+		apiVersion: kubeone.k8c.io/v1beta2
+		kind: KubeOneCluster
+		name: {{ .Values.name }}
+		versions:
+		  kubernetes: {{ .Values.kubernetes.version | quote }}
+	`)
+
+	tests := []struct {
+		name         string
+		manifestName string
+		manifest     string
+		values       []string
+		want         string
+		wantErr      bool
+	}{
+		{
+			name:         "no values not rendered",
+			manifestName: "kubeone.yaml",
+			manifest:     "name: {{ .Values.name }}\n",
+			want:         "name: {{ .Values.name }}\n",
+		},
+		{
+			name:         "single values file",
+			manifestName: "kubeone.yaml",
+			manifest:     manifest,
+			values: []string{
+				"name: prod\nkubernetes:\n  version: 1.27.4\n",
+			},
+			want: heredoc.Doc(`
+				apiVersion: kubeone.k8c.io/v1beta2
+				kind: KubeOneCluster
+				name: prod
+				versions:
+				  kubernetes: "1.27.4"
+			`),
+		},
+		{
+			name:         "values files merged in order",
+			manifestName: "kubeone.yaml",
+			manifest:     manifest,
+			values: []string{
+				"name: base\nkubernetes:\n  version: 1.26.7\n",
+				"name: prod\nkubernetes:\n  version: 1.27.4\n",
+			},
+			want: heredoc.Doc(`
+				apiVersion: kubeone.k8c.io/v1beta2
+				kind: KubeOneCluster
+				name: prod
+				versions:
+				  kubernetes: "1.27.4"
+			`),
+		},
+		{
+			name:         "nested values merged",
+			manifestName: "kubeone.yaml",
+			manifest:     "a: {{ .Values.x.a }}\nb: {{ .Values.x.b }}\n",
+			values: []string{
+				"x:\n  a: 1\n  b: 2\n",
+				"x:\n  b: 3\n",
+			},
+			want: "a: 1\nb: 3\n",
+		},
+		{
+			name:         "template manifest rendered without values",
+			manifestName: "kubeone.tmpl.yaml",
+			manifest:     `name: {{ "prod" | upper }}`,
+			want:         "name: PROD",
+		},
+		{
+			name:         "unknown key",
+			manifestName: "kubeone.yaml",
+			manifest:     manifest,
+			values: []string{
+				"name: prod\n",
+			},
+			wantErr: true,
+		},
+		{
+			name:         "unused values key",
+			manifestName: "kubeone.yaml",
+			manifest:     manifest,
+			values: []string{
+				"name: prod\nkubernetes:\n  version: 1.27.4\n  typo: true\n",
+			},
+			wantErr: true,
+		},
+		{
+			name:         "values referenced by parent key",
+			manifestName: "kubeone.yaml",
+			manifest:     "{{ with .Values.x }}a: {{ .a }}{{ end }}\nb: {{ $.Values.z }}\n",
+			values: []string{
+				"x:\n  a: 1\nz: 2\n",
+			},
+			want: "a: 1\nb: 2\n",
+		},
+		{
+			name:         "yml template manifest rendered without values",
+			manifestName: "kubeone.tmpl.yml",
+			manifest:     `name: {{ "prod" | upper }}`,
+			want:         "name: PROD",
+		},
+		{
+			name:         "tmpl in the middle of the name not rendered",
+			manifestName: "kubeone.tmpl-backup.yaml",
+			manifest:     "name: {{ .Values.name }}\n",
+			want:         "name: {{ .Values.name }}\n",
+		},
+		{
+			name:         "invalid values file",
+			manifestName: "kubeone.yaml",
+			manifest:     manifest,
+			values: []string{
+				"- not a map",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+
+			manifestPath := filepath.Join(dir, tt.manifestName)
+			if err := os.WriteFile(manifestPath, []byte(tt.manifest), 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			var valuesPaths []string
+			for i, values := range tt.values {
+				valuesPath := filepath.Join(dir, "values-"+string(rune('a'+i))+".yaml")
+				if err := os.WriteFile(valuesPath, []byte(values), 0600); err != nil {
+					t.Fatal(err)
+				}
+				valuesPaths = append(valuesPaths, valuesPath)
+			}
+
+			got, err := ReadManifest(manifestPath, valuesPaths)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReadManifest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !tt.wantErr && string(got) != tt.want {
+				t.Errorf("ReadManifest() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
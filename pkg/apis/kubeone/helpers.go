@@ -20,19 +20,29 @@ import (
 	"bytes"
 	"fmt"
 	"math/rand"
+	"net"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/pkg/errors"
 
 	"k8c.io/kubeone/pkg/fail"
 	"k8c.io/kubeone/pkg/semverutil"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 const (
 	credentialSecretName = "kube-system/kubeone-registry-credentials" //nolint:gosec
+
+	// WorkerCloudProviderLabel is set on the nodes of the worker pools created on a cloud provider other than the
+	// cluster cloud provider
+	WorkerCloudProviderLabel = "kubeone.io/cloud-provider"
 )
 
 var (
@@ -127,6 +137,12 @@ func (h *HostConfig) SetLeader(leader bool) {
 	h.IsLeader = leader
 }
 
+// PrivateNetworkOnly returns true if the host is reachable only over the
+// private network, i.e. through the bastion host using its private address
+func (h *HostConfig) PrivateNetworkOnly() bool {
+	return h.Bastion != "" && h.PublicAddress == h.PrivateAddress
+}
+
 func (crc ContainerRuntimeConfig) MachineControllerFlags() []string {
 	var mcFlags []string
 	switch {
@@ -241,6 +257,448 @@ func (crc ContainerRuntimeConfig) CRISocket() string {
 	return ""
 }
 
+// ContainerdVersion returns the pinned containerd version, or an empty
+// string if the version is not pinned
+func (crc ContainerRuntimeConfig) ContainerdVersion() string {
+	if crc.Containerd == nil {
+		return ""
+	}
+
+	return crc.Containerd.Version
+}
+
+// CgroupDriver returns the cgroup driver to be used by the kubelet and the
+// container runtime. The systemd driver is used if the driver is not set
+// (e.g. when the v1beta1 API is used).
+func (c CgroupsConfig) CgroupDriver() CgroupDriver {
+	if c.Driver == "" {
+		return CgroupDriverSystemd
+	}
+
+	return c.Driver
+}
+
+// SystemdCgroup returns true if the systemd cgroup driver should be used
+func (c CgroupsConfig) SystemdCgroup() bool {
+	return c.CgroupDriver() == CgroupDriverSystemd
+}
+
+// SwapEnabled returns true if nodes are allowed to use swap memory
+func (f Features) SwapEnabled() bool {
+	return f.NodeSwap != nil && f.NodeSwap.Enable
+}
+
+// EtcdBackupsEnabled returns true if scheduled etcd backups are enabled
+func (c KubeOneCluster) EtcdBackupsEnabled() bool {
+	return c.Backups != nil && c.Backups.Etcd != nil && c.Backups.Etcd.Enable
+}
+
+// EtcdDefragmentation returns the configuration of the etcd defragmentation,
+// which is empty if the defragmentation is not configured
+func (c KubeOneCluster) EtcdDefragmentation() EtcdDefragmentationConfig {
+	if c.Upgrades == nil || c.Upgrades.EtcdDefragmentation == nil {
+		return EtcdDefragmentationConfig{}
+	}
+
+	return *c.Upgrades.EtcdDefragmentation
+}
+
+// EtcdEndpoints returns the client endpoints of the etcd members running on
+// the control plane nodes
+func (c KubeOneCluster) EtcdEndpoints() []string {
+	endpoints := []string{}
+	for _, host := range c.ControlPlane.Hosts {
+		endpoints = append(endpoints, net.JoinHostPort(c.ClusterNetwork.NodeIP(host), "2379"))
+	}
+
+	return endpoints
+}
+
+// DrainConfig returns the configuration used to drain the nodes
+func (c KubeOneCluster) DrainConfig() DrainConfig {
+	if c.Upgrades == nil || c.Upgrades.Drain == nil {
+		return DrainConfig{}
+	}
+
+	return *c.Upgrades.Drain
+}
+
+// OperatingSystemUpgrade returns the configuration of the operating system
+// release upgrades, which is empty if the upgrades are not configured
+func (c KubeOneCluster) OperatingSystemUpgrade() OperatingSystemUpgradeConfig {
+	if c.Upgrades == nil || c.Upgrades.OperatingSystem == nil {
+		return OperatingSystemUpgradeConfig{}
+	}
+
+	return *c.Upgrades.OperatingSystem
+}
+
+// StaticWorkersUpgradeBatchSize returns how many of the given number of
+// static worker nodes are upgraded at the same time, which is at least one
+func (c KubeOneCluster) StaticWorkersUpgradeBatchSize(nodes int) int {
+	if c.Upgrades == nil || c.Upgrades.StaticWorkers == nil || c.Upgrades.StaticWorkers.MaxUnavailable == nil {
+		return 1
+	}
+
+	batchSize, err := intstr.GetScaledValueFromIntOrPercent(c.Upgrades.StaticWorkers.MaxUnavailable, nodes, false)
+	if err != nil || batchSize < 1 {
+		return 1
+	}
+
+	return batchSize
+}
+
+// unattendedUpgradesExcludedPackages are the packages managed by KubeOne,
+// which are never updated by the unattended upgrades
+var unattendedUpgradesExcludedPackages = []string{
+	"containerd",
+	"containerd.io",
+	"cri-tools",
+	"kubeadm",
+	"kubectl",
+	"kubelet",
+	"kubernetes-cni",
+}
+
+// UnattendedUpgradesEnabled returns true if the unattended upgrades of the
+// operating system packages should be configured on all nodes
+func (c KubeOneCluster) UnattendedUpgradesEnabled() bool {
+	return c.Upgrades != nil && c.Upgrades.Unattended != nil && c.Upgrades.Unattended.Enable
+}
+
+// UnattendedUpgradesPackageBlacklist returns the sorted list of the packages
+// excluded from the unattended upgrades, including the packages managed by
+// KubeOne
+func (c KubeOneCluster) UnattendedUpgradesPackageBlacklist() []string {
+	packages := sets.New(unattendedUpgradesExcludedPackages...)
+	if c.Upgrades != nil && c.Upgrades.Unattended != nil {
+		packages.Insert(c.Upgrades.Unattended.PackageBlacklist...)
+	}
+
+	return sets.List(packages)
+}
+
+// UnattendedUpgradesRebootArgs returns the kured flags restricting the reboots
+// of the nodes to the reboot window. kured checks the days and the time of
+// the day separately, so a window past midnight also allows the reboots
+// after midnight on the days the window starts on.
+func (c KubeOneCluster) UnattendedUpgradesRebootArgs() []string {
+	if c.Upgrades == nil || c.Upgrades.Unattended == nil || c.Upgrades.Unattended.RebootWindow == nil {
+		return nil
+	}
+
+	window := c.Upgrades.Unattended.RebootWindow
+	args := []string{}
+
+	if len(window.Days) > 0 {
+		days := []string{}
+		for _, day := range window.Days {
+			days = append(days, strings.ToLower(day))
+		}
+		args = append(args, fmt.Sprintf("--reboot-days=%s", strings.Join(days, ",")))
+	}
+
+	start, err := time.Parse("15:04", window.Start)
+	if err == nil && window.Duration.Duration < 24*time.Hour {
+		end := start.Add(window.Duration.Duration)
+		args = append(args,
+			fmt.Sprintf("--start-time=%s", start.Format("15:04")),
+			fmt.Sprintf("--end-time=%s", end.Format("15:04")),
+		)
+	}
+
+	if window.TimeZone != "" {
+		args = append(args, fmt.Sprintf("--time-zone=%s", window.TimeZone))
+	}
+
+	return args
+}
+
+// RollingUpdate returns the maximum number of machines created above the
+// desired number of replicas and the maximum number of unavailable machines
+// while the machines of the MachineDeployment are replaced. Machines with a
+// static network configuration can't be surged, as their addresses are
+// reused, so the old machines are deleted first by default.
+func (w DynamicWorkerConfig) RollingUpdate() (maxSurge, maxUnavailable intstr.IntOrString) {
+	maxSurge = intstr.FromInt(1)
+	maxUnavailable = intstr.FromInt(0)
+	if w.Config.Network != nil {
+		maxSurge = intstr.FromInt(0)
+		maxUnavailable = intstr.FromInt(1)
+	}
+
+	if strategy := w.UpgradeStrategy; strategy != nil {
+		if strategy.MaxSurge != nil {
+			maxSurge = *strategy.MaxSurge
+		}
+		if strategy.MaxUnavailable != nil {
+			maxUnavailable = *strategy.MaxUnavailable
+		}
+	}
+
+	return maxSurge, maxUnavailable
+}
+
+// SkipEviction returns true if the nodes of the MachineDeployment should be
+// deleted without evicting the pods first
+func (w DynamicWorkerConfig) SkipEviction() bool {
+	return w.UpgradeStrategy != nil && w.UpgradeStrategy.SkipEviction
+}
+
+// CanaryUpgradeEnabled returns true if the leader control plane node should
+// be upgraded and verified before the remaining nodes
+func (c KubeOneCluster) CanaryUpgradeEnabled() bool {
+	return c.Upgrades != nil && c.Upgrades.Canary != nil && c.Upgrades.Canary.Enable
+}
+
+// InMaintenanceWindow returns true if the disruptive actions are allowed at
+// the given time, which is always the case without maintenance windows
+func (c KubeOneCluster) InMaintenanceWindow(t time.Time) bool {
+	if c.Upgrades == nil || len(c.Upgrades.MaintenanceWindows) == 0 {
+		return true
+	}
+
+	for _, window := range c.Upgrades.MaintenanceWindows {
+		if window.Contains(t) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NextMaintenanceWindow returns the start of the first maintenance window
+// after the given time, or the zero time without maintenance windows
+func (c KubeOneCluster) NextMaintenanceWindow(t time.Time) time.Time {
+	var next time.Time
+	if c.Upgrades == nil {
+		return next
+	}
+
+	for _, window := range c.Upgrades.MaintenanceWindows {
+		for _, start := range window.starts(t, 0, 7) {
+			if start.After(t) && (next.IsZero() || start.Before(next)) {
+				next = start
+			}
+		}
+	}
+
+	return next
+}
+
+// Contains returns true if the given time falls into the window
+func (w MaintenanceWindow) Contains(t time.Time) bool {
+	// the window might have started on the previous day
+	for _, start := range w.starts(t, -1, 0) {
+		if !t.Before(start) && t.Before(start.Add(w.Duration.Duration)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// starts returns the starts of the window on the days from the given offsets
+// relative to the day of the given time, in the window's time zone
+func (w MaintenanceWindow) starts(t time.Time, fromDay, toDay int) []time.Time {
+	loc, err := time.LoadLocation(w.TimeZone)
+	if err != nil {
+		return nil
+	}
+
+	clock, err := time.Parse("15:04", w.Start)
+	if err != nil {
+		return nil
+	}
+
+	local := t.In(loc)
+	starts := []time.Time{}
+	for offset := fromDay; offset <= toDay; offset++ {
+		start := time.Date(local.Year(), local.Month(), local.Day()+offset, clock.Hour(), clock.Minute(), 0, 0, loc)
+		if w.startsOn(start.Weekday()) {
+			starts = append(starts, start)
+		}
+	}
+
+	return starts
+}
+
+func (w MaintenanceWindow) startsOn(weekday time.Weekday) bool {
+	if len(w.Days) == 0 {
+		return true
+	}
+
+	for _, day := range w.Days {
+		if day == weekday.String() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// KubernetesRepository returns the custom repository of the Kubernetes
+// packages, or nil if the upstream repository should be used
+func (c KubeOneCluster) KubernetesRepository() *PackageRepository {
+	if c.SystemPackages == nil || c.SystemPackages.Repositories == nil {
+		return nil
+	}
+
+	return c.SystemPackages.Repositories.Kubernetes
+}
+
+// ContainerRuntimeRepository returns the custom repository of the container
+// runtime packages, or nil if the upstream repository should be used
+func (c KubeOneCluster) ContainerRuntimeRepository() *PackageRepository {
+	if c.SystemPackages == nil || c.SystemPackages.Repositories == nil {
+		return nil
+	}
+
+	return c.SystemPackages.Repositories.ContainerRuntime
+}
+
+// MetalLBEnabled returns true if MetalLB should be deployed to the cluster
+func (c KubeOneCluster) MetalLBEnabled() bool {
+	if c.Features.MetalLB != nil && c.Features.MetalLB.Enable {
+		return true
+	}
+
+	return c.CloudProvider.EquinixMetal != nil && c.CloudProvider.EquinixMetal.LoadBalancer != nil
+}
+
+// GatewayAPIEnabled returns true if the Gateway API CRDs should be deployed to the cluster
+func (c KubeOneCluster) GatewayAPIEnabled() bool {
+	return c.Features.GatewayAPI != nil && c.Features.GatewayAPI.Enable
+}
+
+// CiliumGatewayAPIEnabled returns true if the Gateway API controller of the Cilium CNI should be enabled
+func (c KubeOneCluster) CiliumGatewayAPIEnabled() bool {
+	return c.GatewayAPIEnabled() && c.Features.GatewayAPI.Controller == GatewayAPIControllerCilium
+}
+
+// Addresses returns the address (host:port) of the API endpoint followed by the
+// addresses of the additional endpoints sorted by their priority
+func (a APIEndpoint) Addresses() []string {
+	additional := make([]AdditionalAPIEndpoint, len(a.AdditionalEndpoints))
+	copy(additional, a.AdditionalEndpoints)
+	sort.SliceStable(additional, func(i, j int) bool {
+		return additional[i].Priority < additional[j].Priority
+	})
+
+	addresses := []string{net.JoinHostPort(a.Host, strconv.Itoa(a.Port))}
+	for _, endpoint := range additional {
+		addresses = append(addresses, net.JoinHostPort(endpoint.Host, strconv.Itoa(endpoint.Port)))
+	}
+
+	return addresses
+}
+
+// BandwidthPluginEnabled returns true if the bandwidth CNI plugin should be chained to the CNI plugin
+func (c KubeOneCluster) BandwidthPluginEnabled() bool {
+	cni := c.ClusterNetwork.CNI
+
+	return cni != nil && cni.EnableBandwidthPlugin != nil && *cni.EnableBandwidthPlugin
+}
+
+// KubeVIPEnabled returns true if kube-vip should be deployed on the control plane nodes
+func (c KubeOneCluster) KubeVIPEnabled() bool {
+	return c.Features.KubeVIP != nil && c.Features.KubeVIP.Enable
+}
+
+// ClusterAutoscalerEnabled returns true if cluster-autoscaler should be deployed
+func (c KubeOneCluster) ClusterAutoscalerEnabled() bool {
+	return c.Features.ClusterAutoscaler != nil && c.Features.ClusterAutoscaler.Enable
+}
+
+// IngressNginxEnabled returns true if the ingress-nginx controller should be deployed
+func (c KubeOneCluster) IngressNginxEnabled() bool {
+	return c.Features.IngressNginx != nil && c.Features.IngressNginx.Enable
+}
+
+// LocalStorageEnabled returns true if the local storage provisioner should be deployed
+func (c KubeOneCluster) LocalStorageEnabled() bool {
+	return c.Features.LocalStorage != nil && c.Features.LocalStorage.Enable
+}
+
+// DefaultDenyNetworkPolicyEnabled returns true if the default-deny NetworkPolicies should be deployed
+func (c KubeOneCluster) DefaultDenyNetworkPolicyEnabled() bool {
+	return c.Features.DefaultDenyNetworkPolicy != nil && c.Features.DefaultDenyNetworkPolicy.Enable
+}
+
+// IstioAmbientEnabled returns true if Istio should be deployed in the ambient mode
+func (c KubeOneCluster) IstioAmbientEnabled() bool {
+	return c.Features.IstioAmbient != nil && c.Features.IstioAmbient.Enable
+}
+
+// EgressGatewayHosts returns the control plane and static worker hosts matched
+// by the node selector of the egress gateway. The hosts are matched by their
+// labels and the kubernetes.io/hostname label.
+func (c KubeOneCluster) EgressGatewayHosts(gateway EgressGateway) []HostConfig {
+	var hosts []HostConfig
+
+	for _, host := range append(append([]HostConfig{}, c.ControlPlane.Hosts...), c.StaticWorkers.Hosts...) {
+		labels := map[string]string{}
+		for k, v := range host.Labels {
+			labels[k] = v
+		}
+		if host.Hostname != "" {
+			labels["kubernetes.io/hostname"] = host.Hostname
+		}
+
+		matches := len(gateway.NodeSelector) > 0
+		for k, v := range gateway.NodeSelector {
+			if labels[k] != v {
+				matches = false
+
+				break
+			}
+		}
+		if matches {
+			hosts = append(hosts, host)
+		}
+	}
+
+	return hosts
+}
+
+// EgressIPs returns the egress IP addresses of the egress gateways which must
+// be assigned to the given host
+func (c KubeOneCluster) EgressIPs(host HostConfig) []string {
+	var ips []string
+	seen := map[string]bool{}
+
+	for _, gateway := range c.ClusterNetwork.EgressGateways {
+		if gateway.EgressIP == "" || seen[gateway.EgressIP] {
+			continue
+		}
+		for _, h := range c.EgressGatewayHosts(gateway) {
+			if h.PublicAddress == host.PublicAddress && h.PrivateAddress == host.PrivateAddress {
+				ips = append(ips, gateway.EgressIP)
+				seen[gateway.EgressIP] = true
+
+				break
+			}
+		}
+	}
+
+	return ips
+}
+
+// KubeadmPatchesEnabled returns true if kubeadm patches for the control plane components are configured
+func (c KubeOneCluster) KubeadmPatchesEnabled() bool {
+	return c.ControlPlaneComponents != nil && c.ControlPlaneComponents.Patches != nil && c.ControlPlaneComponents.Patches.Directory != ""
+}
+
+// ResticRepository returns the Restic repository where etcd backups are stored
+func (t EtcdBackupsTarget) ResticRepository() string {
+	repo := fmt.Sprintf("s3:%s/%s", t.Endpoint, t.Bucket)
+	if prefix := strings.Trim(t.Prefix, "/"); prefix != "" {
+		repo += "/" + prefix
+	}
+
+	return repo
+}
+
 // SandboxImage is used to determine the pause image version that should be used,
 // depending on the desired Kubernetes version. It's important to use the same
 // pause image version for both container runtime and kubeadm to avoid issues.
@@ -267,6 +725,22 @@ func (v VersionConfig) SandboxImage(imageRegistry func(string) string) (string,
 	}
 }
 
+// SandboxImage returns the sandbox (pause) image that should be used by the
+// container runtime and kubelet. The image explicitly configured in the
+// ContainerRuntime API has the highest priority, followed by the deprecated
+// AssetConfiguration API, and finally the image matching the Kubernetes version.
+func (c KubeOneCluster) SandboxImage() (string, error) {
+	if c.ContainerRuntime.SandboxImage != "" {
+		return c.ContainerRuntime.SandboxImage, nil
+	}
+
+	if c.AssetConfiguration.Pause.ImageRepository != "" {
+		return c.AssetConfiguration.Pause.ImageRepository + "/pause:" + c.AssetConfiguration.Pause.ImageTag, nil
+	}
+
+	return c.Versions.SandboxImage(c.RegistryConfiguration.ImageRegistry)
+}
+
 // CloudProviderName returns name of the cloud provider
 func (p CloudProviderSpec) CloudProviderName() string {
 	switch {
@@ -280,10 +754,16 @@ func (p CloudProviderSpec) CloudProviderName() string {
 		return "gce"
 	case p.Hetzner != nil:
 		return "hetzner"
+	case p.Kubevirt != nil:
+		return "kubevirt"
 	case p.Nutanix != nil:
 		return "nutanix"
+	case p.OCI != nil:
+		return "oci"
 	case p.Openstack != nil:
 		return "openstack"
+	case p.Proxmox != nil:
+		return "proxmox"
 	case p.EquinixMetal != nil:
 		return "equinixmetal"
 	case p.Vsphere != nil:
@@ -297,6 +777,64 @@ func (p CloudProviderSpec) CloudProviderName() string {
 	return ""
 }
 
+// WorkerCloudProvider returns the cloud provider of the worker pool. It's the cluster cloud provider unless the pool
+// overrides it with the CloudProvider field. For pools on other providers, only the provider itself is set, because
+// all other options (e.g. external CCM and cloud config) apply only to the cluster cloud provider.
+func (p CloudProviderSpec) WorkerCloudProvider(workerset DynamicWorkerConfig) (CloudProviderSpec, error) {
+	if workerset.CloudProvider == "" || workerset.CloudProvider == p.CloudProviderName() {
+		return p, nil
+	}
+
+	cp := CloudProviderSpec{}
+	switch workerset.CloudProvider {
+	case "aws":
+		cp.AWS = &AWSSpec{}
+	case "azure":
+		cp.Azure = &AzureSpec{}
+	case "digitalocean":
+		cp.DigitalOcean = &DigitalOceanSpec{}
+	case "gce":
+		cp.GCE = &GCESpec{}
+	case "hetzner":
+		cp.Hetzner = &HetznerSpec{}
+	case "kubevirt":
+		cp.Kubevirt = &KubevirtSpec{}
+	case "nutanix":
+		cp.Nutanix = &NutanixSpec{}
+	case "openstack":
+		cp.Openstack = &OpenstackSpec{}
+	case "equinixmetal":
+		cp.EquinixMetal = &EquinixMetalSpec{}
+	case "vmwareCloudDirector":
+		cp.VMwareCloudDirector = &VMwareCloudDirectorSpec{}
+	case "vsphere":
+		cp.Vsphere = &VsphereSpec{}
+	default:
+		return cp, fail.ConfigValidation(fmt.Errorf("worker pool %q: machine-controller doesn't support the %q cloud provider", workerset.Name, workerset.CloudProvider))
+	}
+
+	return cp, nil
+}
+
+// WorkerCloudProviders returns the names of the cloud providers used by the worker pools in addition to the cluster
+// cloud provider
+func (c KubeOneCluster) WorkerCloudProviders() []string {
+	providers := map[string]struct{}{}
+	for _, workerset := range c.DynamicWorkers {
+		if workerset.CloudProvider != "" && workerset.CloudProvider != c.CloudProvider.CloudProviderName() {
+			providers[workerset.CloudProvider] = struct{}{}
+		}
+	}
+
+	names := make([]string, 0, len(providers))
+	for name := range providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
 // MachineControllerCloudProvider returns name of the cloud provider for machine-controller
 // It handles special cases where the cloud provider name in KubeOne might differ to that required in machine-controller.
 func (p CloudProviderSpec) MachineControllerCloudProvider() string {
@@ -308,8 +846,30 @@ func (p CloudProviderSpec) MachineControllerCloudProvider() string {
 	}
 }
 
+// VsphereCSIConfig returns the vSphere CSI driver configuration (csi-vsphere.conf). It's the CSIConfig extended with
+// the NetPermissions sections for the file volumes, if they are enabled.
+func (p CloudProviderSpec) VsphereCSIConfig() string {
+	if p.Vsphere == nil || p.Vsphere.FileVolumes == nil || len(p.Vsphere.FileVolumes.NetPermissions) == 0 {
+		return p.CSIConfig
+	}
+
+	var buf strings.Builder
+	buf.WriteString(strings.TrimRight(p.CSIConfig, "\n"))
+	buf.WriteString("\n")
+
+	for _, np := range p.Vsphere.FileVolumes.NetPermissions {
+		fmt.Fprintf(&buf, "\n[NetPermissions %q]\n", np.Name)
+		fmt.Fprintf(&buf, "ips = %q\n", np.IPs)
+		fmt.Fprintf(&buf, "permissions = %q\n", np.Permissions)
+		fmt.Fprintf(&buf, "rootsquash = %t\n", np.RootSquash)
+	}
+
+	return buf.String()
+}
+
 // CloudProviderInTree detects is there in-tree cloud provider implementation for specified provider.
 // List of in-tree provider can be found here: https://github.com/kubernetes/kubernetes/tree/master/pkg/cloudprovider
+// KubeVirt, Nutanix, OCI and Proxmox, as well as other providers not listed below, don't have an in-tree cloud provider at all.
 func (p CloudProviderSpec) CloudProviderInTree() bool {
 	if p.AWS != nil || p.Azure != nil || p.Openstack != nil || p.Vsphere != nil {
 		return !p.External
@@ -320,6 +880,17 @@ func (p CloudProviderSpec) CloudProviderInTree() bool {
 	return false
 }
 
+// CCMMigrationSupported returns if migration to the external CCM is supported for the specified provider.
+// Nutanix doesn't have an in-tree cloud provider, so existing Nutanix clusters are migrated by switching
+// kubelets to the external cloud provider and deploying the Nutanix CCM, without the CSI migration.
+func (c KubeOneCluster) CCMMigrationSupported() bool {
+	if c.CloudProvider.External && c.CloudProvider.Nutanix != nil {
+		return true
+	}
+
+	return c.CSIMigrationSupported()
+}
+
 // CSIMigrationSupported returns if CSI migration is supported for the specified provider.
 // NB: The CSI migration can be supported only if KubeOne supports CSI plugin and driver
 // for the provider
@@ -375,10 +946,12 @@ func (c KubeOneCluster) CSIMigrationFeatureGates(complete bool) (map[string]bool
 		return nil, "", err
 	}
 
-	return featureGates, marshalFeatureGates(featureGates), nil
+	return featureGates, MarshalFeatureGates(featureGates), nil
 }
 
-func marshalFeatureGates(fgm map[string]bool) string {
+// MarshalFeatureGates converts the feature gates map into the comma-separated
+// key=value form accepted by the --feature-gates flag
+func MarshalFeatureGates(fgm map[string]bool) string {
 	keys := []string{}
 	for k, v := range fgm {
 		keys = append(keys, fmt.Sprintf("%s=%t", k, v))
@@ -414,6 +987,48 @@ func (ads *Addons) Enabled() bool {
 	return ads != nil && ads.Enable
 }
 
+// AddonPhase returns the lifecycle phase of the addon with the given name. The
+// addons that are not listed are applied in the default phase.
+func (ads *Addons) AddonPhase(name string) AddonPhase {
+	if ads == nil {
+		return ""
+	}
+
+	for _, addon := range ads.Addons {
+		if addon.Name == name {
+			return addon.Phase
+		}
+	}
+
+	return ""
+}
+
+// PhaseEnabled returns true if any addon is applied in the given lifecycle
+// phase
+func (ads *Addons) PhaseEnabled(phase AddonPhase) bool {
+	if !ads.Enabled() {
+		return false
+	}
+
+	for _, addon := range ads.Addons {
+		if addon.Phase == phase && !addon.Delete {
+			return true
+		}
+	}
+
+	return false
+}
+
+// OCIReference returns the reference to the OCI artifact with addons (without
+// the oci:// prefix) and true if the addons path references an OCI artifact
+func (ads *Addons) OCIReference() (string, bool) {
+	if ads == nil {
+		return "", false
+	}
+
+	return strings.CutPrefix(ads.Path, "oci://")
+}
+
 // RelativePath returns addons path relative to the KubeOneCluster manifest file
 // path
 func (ads *Addons) RelativePath(manifestFilePath string) (string, error) {
@@ -501,6 +1116,79 @@ func (c ClusterNetworkConfig) HasIPv6() bool {
 	return c.IPFamily == IPFamilyIPv6 || c.IPFamily == IPFamilyIPv4IPv6 || c.IPFamily == IPFamilyIPv6IPv4
 }
 
+// NodeIP returns the address of the host in the primary IP family of the
+// cluster. It's used by the cluster components and KubeOne itself to reach the
+// host.
+func (c ClusterNetworkConfig) NodeIP(host HostConfig) string {
+	if c.IPFamily.IsIPv6Primary() && len(host.IPv6Addresses) > 0 {
+		return host.IPv6Addresses[0]
+	}
+	if host.PrivateAddress != "" {
+		return host.PrivateAddress
+	}
+
+	return host.PublicAddress
+}
+
+// PodSubnets returns the pod CIDRs of the cluster ordered by the configured IP family
+func (c ClusterNetworkConfig) PodSubnets() []string {
+	return c.IPFamily.subnets(c.PodSubnet, c.PodSubnetIPv6)
+}
+
+// ServiceSubnets returns the service CIDRs of the cluster ordered by the configured IP family
+func (c ClusterNetworkConfig) ServiceSubnets() []string {
+	return c.IPFamily.subnets(c.ServiceSubnet, c.ServiceSubnetIPv6)
+}
+
+func (c IPFamily) subnets(ipv4Subnet, ipv6Subnet string) []string {
+	switch c {
+	case IPFamilyIPv4:
+		return []string{ipv4Subnet}
+	case IPFamilyIPv6:
+		return []string{ipv6Subnet}
+	case IPFamilyIPv4IPv6:
+		return []string{ipv4Subnet, ipv6Subnet}
+	case IPFamilyIPv6IPv4:
+		return []string{ipv6Subnet, ipv4Subnet}
+	}
+
+	return nil
+}
+
+// SplitDualStackCIDRs splits a comma-separated list of exactly one IPv4 and one IPv6 CIDR (e.g.
+// "10.244.0.0/16,fd01::/48") into per-family CIDRs. The returned IP family follows the order of the list.
+// ok is false if the list doesn't have that form.
+func SplitDualStackCIDRs(cidrs string) (ipv4, ipv6 string, ipFamily IPFamily, ok bool) {
+	parts := strings.Split(cidrs, ",")
+	if len(parts) != 2 {
+		return "", "", "", false
+	}
+
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+		ip, _, err := net.ParseCIDR(parts[i])
+		if err != nil {
+			return "", "", "", false
+		}
+		if ip.To4() != nil {
+			ipv4 = parts[i]
+		} else {
+			ipv6 = parts[i]
+		}
+	}
+
+	if ipv4 == "" || ipv6 == "" {
+		return "", "", "", false
+	}
+
+	ipFamily = IPFamilyIPv4IPv6
+	if ipv6 == parts[0] {
+		ipFamily = IPFamilyIPv6IPv4
+	}
+
+	return ipv4, ipv6, ipFamily, true
+}
+
 func (c IPFamily) IsDualstack() bool {
 	return c == IPFamilyIPv4IPv6 || c == IPFamilyIPv6IPv4
 }
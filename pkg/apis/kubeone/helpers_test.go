@@ -19,6 +19,12 @@ package kubeone
 import (
 	"reflect"
 	"testing"
+	"time"
+
+	"k8c.io/kubeone/pkg/pointer"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 func TestFeatureGatesString(t *testing.T) {
@@ -67,7 +73,7 @@ func TestFeatureGatesString(t *testing.T) {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
-			got := marshalFeatureGates(tc.featureGates)
+			got := MarshalFeatureGates(tc.featureGates)
 			if got != tc.expected {
 				t.Errorf("TestFeatureGatesString() got = %v, expected %v", got, tc.expected)
 			}
@@ -178,6 +184,226 @@ func TestMapStringStringToString(t *testing.T) {
 	}
 }
 
+func TestClusterNetworkConfigSubnets(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                   string
+		ipFamily               IPFamily
+		expectedPodSubnets     []string
+		expectedServiceSubnets []string
+	}{
+		{
+			name:                   "IPv4",
+			ipFamily:               IPFamilyIPv4,
+			expectedPodSubnets:     []string{"10.244.0.0/16"},
+			expectedServiceSubnets: []string{"10.96.0.0/12"},
+		},
+		{
+			name:                   "IPv6",
+			ipFamily:               IPFamilyIPv6,
+			expectedPodSubnets:     []string{"fd01::/48"},
+			expectedServiceSubnets: []string{"fd02::/120"},
+		},
+		{
+			name:                   "IPv4+IPv6",
+			ipFamily:               IPFamilyIPv4IPv6,
+			expectedPodSubnets:     []string{"10.244.0.0/16", "fd01::/48"},
+			expectedServiceSubnets: []string{"10.96.0.0/12", "fd02::/120"},
+		},
+		{
+			name:                   "IPv6+IPv4",
+			ipFamily:               IPFamilyIPv6IPv4,
+			expectedPodSubnets:     []string{"fd01::/48", "10.244.0.0/16"},
+			expectedServiceSubnets: []string{"fd02::/120", "10.96.0.0/12"},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := ClusterNetworkConfig{
+				PodSubnet:         "10.244.0.0/16",
+				PodSubnetIPv6:     "fd01::/48",
+				ServiceSubnet:     "10.96.0.0/12",
+				ServiceSubnetIPv6: "fd02::/120",
+				IPFamily:          tc.ipFamily,
+			}
+
+			if got := c.PodSubnets(); !reflect.DeepEqual(got, tc.expectedPodSubnets) {
+				t.Errorf("PodSubnets() = %v, want %v", got, tc.expectedPodSubnets)
+			}
+			if got := c.ServiceSubnets(); !reflect.DeepEqual(got, tc.expectedServiceSubnets) {
+				t.Errorf("ServiceSubnets() = %v, want %v", got, tc.expectedServiceSubnets)
+			}
+		})
+	}
+}
+
+func TestClusterNetworkConfigNodeIP(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		ipFamily IPFamily
+		host     HostConfig
+		expected string
+	}{
+		{
+			name:     "IPv4",
+			ipFamily: IPFamilyIPv4,
+			host:     HostConfig{PublicAddress: "1.1.1.1", PrivateAddress: "10.0.0.1", IPv6Addresses: []string{"fd00::1"}},
+			expected: "10.0.0.1",
+		},
+		{
+			name:     "IPv4 without private address",
+			ipFamily: IPFamilyIPv4,
+			host:     HostConfig{PublicAddress: "1.1.1.1"},
+			expected: "1.1.1.1",
+		},
+		{
+			name:     "IPv4+IPv6",
+			ipFamily: IPFamilyIPv4IPv6,
+			host:     HostConfig{PrivateAddress: "10.0.0.1", IPv6Addresses: []string{"fd00::1"}},
+			expected: "10.0.0.1",
+		},
+		{
+			name:     "IPv6",
+			ipFamily: IPFamilyIPv6,
+			host:     HostConfig{PublicAddress: "2001:db8::1", IPv6Addresses: []string{"fd00::1", "fd00::2"}},
+			expected: "fd00::1",
+		},
+		{
+			name:     "IPv6+IPv4",
+			ipFamily: IPFamilyIPv6IPv4,
+			host:     HostConfig{PrivateAddress: "10.0.0.1", IPv6Addresses: []string{"fd00::1"}},
+			expected: "fd00::1",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := ClusterNetworkConfig{IPFamily: tc.ipFamily}
+			if got := c.NodeIP(tc.host); got != tc.expected {
+				t.Errorf("NodeIP() = %v, want %v", got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestAPIEndpointAddresses(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		endpoint APIEndpoint
+		expected []string
+	}{
+		{
+			name:     "no additional endpoints",
+			endpoint: APIEndpoint{Host: "lb.example.com", Port: 6443},
+			expected: []string{"lb.example.com:6443"},
+		},
+		{
+			name: "additional endpoints sorted by priority",
+			endpoint: APIEndpoint{
+				Host: "lb.example.com",
+				Port: 6443,
+				AdditionalEndpoints: []AdditionalAPIEndpoint{
+					{Host: "lb-c.example.com", Port: 6443, Priority: 2},
+					{Host: "lb-a.example.com", Port: 443, Priority: 1},
+					{Host: "lb-b.example.com", Port: 6443, Priority: 1},
+				},
+			},
+			expected: []string{"lb.example.com:6443", "lb-a.example.com:443", "lb-b.example.com:6443", "lb-c.example.com:6443"},
+		},
+		{
+			name: "IPv6",
+			endpoint: APIEndpoint{
+				Host:                "fd00::1",
+				Port:                6443,
+				AdditionalEndpoints: []AdditionalAPIEndpoint{{Host: "fd00::2", Port: 6443}},
+			},
+			expected: []string{"[fd00::1]:6443", "[fd00::2]:6443"},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tc.endpoint.Addresses(); !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("Addresses() = %v, want %v", got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestSplitDualStackCIDRs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		cidrs            string
+		expectedIPv4     string
+		expectedIPv6     string
+		expectedIPFamily IPFamily
+		expectedOK       bool
+	}{
+		{
+			name:             "IPv4 first",
+			cidrs:            "10.244.0.0/16,fd01::/48",
+			expectedIPv4:     "10.244.0.0/16",
+			expectedIPv6:     "fd01::/48",
+			expectedIPFamily: IPFamilyIPv4IPv6,
+			expectedOK:       true,
+		},
+		{
+			name:             "IPv6 first with spaces",
+			cidrs:            "fd01::/48, 10.244.0.0/16",
+			expectedIPv4:     "10.244.0.0/16",
+			expectedIPv6:     "fd01::/48",
+			expectedIPFamily: IPFamilyIPv6IPv4,
+			expectedOK:       true,
+		},
+		{
+			name:  "single CIDR",
+			cidrs: "10.244.0.0/16",
+		},
+		{
+			name:  "two IPv4 CIDRs",
+			cidrs: "10.244.0.0/16,10.245.0.0/16",
+		},
+		{
+			name:  "invalid CIDR",
+			cidrs: "10.244.0.0/16,fd01::",
+		},
+		{
+			name:  "three CIDRs",
+			cidrs: "10.244.0.0/16,fd01::/48,10.245.0.0/16",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ipv4, ipv6, ipFamily, ok := SplitDualStackCIDRs(tc.cidrs)
+			if ipv4 != tc.expectedIPv4 || ipv6 != tc.expectedIPv6 || ipFamily != tc.expectedIPFamily || ok != tc.expectedOK {
+				t.Errorf("SplitDualStackCIDRs(%q) = (%q, %q, %q, %t), want (%q, %q, %q, %t)",
+					tc.cidrs, ipv4, ipv6, ipFamily, ok, tc.expectedIPv4, tc.expectedIPv6, tc.expectedIPFamily, tc.expectedOK)
+			}
+		})
+	}
+}
+
 func TestDefaultAssetConfiguration(t *testing.T) {
 	tests := []struct {
 		name                       string
@@ -292,3 +518,317 @@ func TestDefaultAssetConfiguration(t *testing.T) {
 		})
 	}
 }
+
+func TestCloudProviderSpecVsphereCSIConfig(t *testing.T) {
+	t.Parallel()
+
+	csiConfig := "[Global]\ncluster-id = \"test\"\n"
+
+	tests := []struct {
+		name     string
+		provider CloudProviderSpec
+		want     string
+	}{
+		{
+			name: "file volumes disabled",
+			provider: CloudProviderSpec{
+				Vsphere:   &VsphereSpec{},
+				CSIConfig: csiConfig,
+			},
+			want: csiConfig,
+		},
+		{
+			name: "file volumes without net permissions",
+			provider: CloudProviderSpec{
+				Vsphere:   &VsphereSpec{FileVolumes: &VsphereFileVolumesSpec{}},
+				CSIConfig: csiConfig,
+			},
+			want: csiConfig,
+		},
+		{
+			name: "file volumes with net permissions",
+			provider: CloudProviderSpec{
+				Vsphere: &VsphereSpec{
+					FileVolumes: &VsphereFileVolumesSpec{
+						NetPermissions: []VsphereNetPermission{
+							{Name: "nodes", IPs: "10.0.0.0/16", Permissions: "READ_WRITE"},
+							{Name: "others", IPs: "*", Permissions: "READ_ONLY", RootSquash: true},
+						},
+					},
+				},
+				CSIConfig: csiConfig,
+			},
+			want: `[Global]
+cluster-id = "test"
+
+[NetPermissions "nodes"]
+ips = "10.0.0.0/16"
+permissions = "READ_WRITE"
+rootsquash = false
+
+[NetPermissions "others"]
+ips = "*"
+permissions = "READ_ONLY"
+rootsquash = true
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.provider.VsphereCSIConfig(); got != tt.want {
+				t.Errorf("VsphereCSIConfig() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMaintenanceWindows(t *testing.T) {
+	t.Parallel()
+
+	weekend := []MaintenanceWindow{
+		{
+			Days:     []string{"Saturday"},
+			Start:    "22:00",
+			Duration: metav1.Duration{Duration: 4 * time.Hour},
+			TimeZone: "Europe/Berlin",
+		},
+	}
+
+	tests := []struct {
+		name     string
+		windows  []MaintenanceWindow
+		now      time.Time
+		inWindow bool
+		next     time.Time
+	}{
+		{
+			name:     "no maintenance windows",
+			now:      time.Date(2023, 10, 16, 12, 0, 0, 0, time.UTC),
+			inWindow: true,
+		},
+		{
+			name:     "before the window",
+			windows:  weekend,
+			now:      time.Date(2023, 10, 14, 19, 59, 0, 0, time.UTC),
+			inWindow: false,
+			next:     time.Date(2023, 10, 14, 20, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "in the window",
+			windows:  weekend,
+			now:      time.Date(2023, 10, 14, 20, 30, 0, 0, time.UTC),
+			inWindow: true,
+			next:     time.Date(2023, 10, 21, 20, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "in the window past midnight",
+			windows:  weekend,
+			now:      time.Date(2023, 10, 14, 23, 30, 0, 0, time.UTC),
+			inWindow: true,
+			next:     time.Date(2023, 10, 21, 20, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "after the window",
+			windows:  weekend,
+			now:      time.Date(2023, 10, 15, 0, 0, 0, 0, time.UTC),
+			inWindow: false,
+			next:     time.Date(2023, 10, 21, 20, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "daily window",
+			windows: []MaintenanceWindow{
+				{Start: "03:00", Duration: metav1.Duration{Duration: time.Hour}},
+			},
+			now:      time.Date(2023, 10, 16, 12, 0, 0, 0, time.UTC),
+			inWindow: false,
+			next:     time.Date(2023, 10, 17, 3, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := KubeOneCluster{Upgrades: &UpgradesConfig{MaintenanceWindows: tt.windows}}
+
+			if got := c.InMaintenanceWindow(tt.now); got != tt.inWindow {
+				t.Errorf("InMaintenanceWindow() = %v, want %v", got, tt.inWindow)
+			}
+			if got := c.NextMaintenanceWindow(tt.now); !got.Equal(tt.next) {
+				t.Errorf("NextMaintenanceWindow() = %v, want %v", got, tt.next)
+			}
+		})
+	}
+}
+
+func TestStaticWorkersUpgradeBatchSize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		maxUnavailable *intstr.IntOrString
+		nodes          int
+		expected       int
+	}{
+		{
+			name:     "not configured",
+			nodes:    10,
+			expected: 1,
+		},
+		{
+			name:           "number of nodes",
+			maxUnavailable: pointer.New(intstr.FromInt(3)),
+			nodes:          10,
+			expected:       3,
+		},
+		{
+			name:           "percentage rounded down",
+			maxUnavailable: pointer.New(intstr.FromString("25%")),
+			nodes:          10,
+			expected:       2,
+		},
+		{
+			name:           "percentage of few nodes",
+			maxUnavailable: pointer.New(intstr.FromString("25%")),
+			nodes:          3,
+			expected:       1,
+		},
+		{
+			name:           "zero",
+			maxUnavailable: pointer.New(intstr.FromInt(0)),
+			nodes:          10,
+			expected:       1,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			cluster := KubeOneCluster{
+				Upgrades: &UpgradesConfig{
+					StaticWorkers: &StaticWorkersUpgradeConfig{MaxUnavailable: tc.maxUnavailable},
+				},
+			}
+			if got := cluster.StaticWorkersUpgradeBatchSize(tc.nodes); got != tc.expected {
+				t.Errorf("StaticWorkersUpgradeBatchSize() = %d, want %d", got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestUnattendedUpgrades(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		unattended *UnattendedUpgradesConfig
+		blacklist  []string
+		rebootArgs []string
+	}{
+		{
+			name:      "not configured",
+			blacklist: []string{"containerd", "containerd.io", "cri-tools", "kubeadm", "kubectl", "kubelet", "kubernetes-cni"},
+		},
+		{
+			name: "additional packages",
+			unattended: &UnattendedUpgradesConfig{
+				Enable:           true,
+				PackageBlacklist: []string{"linux-image-*", "kubelet"},
+			},
+			blacklist: []string{"containerd", "containerd.io", "cri-tools", "kubeadm", "kubectl", "kubelet", "kubernetes-cni", "linux-image-*"},
+		},
+		{
+			name: "reboot window past midnight",
+			unattended: &UnattendedUpgradesConfig{
+				Enable: true,
+				RebootWindow: &MaintenanceWindow{
+					Days:     []string{"Saturday", "Sunday"},
+					Start:    "22:30",
+					Duration: metav1.Duration{Duration: 4 * time.Hour},
+					TimeZone: "Europe/Berlin",
+				},
+			},
+			blacklist:  []string{"containerd", "containerd.io", "cri-tools", "kubeadm", "kubectl", "kubelet", "kubernetes-cni"},
+			rebootArgs: []string{"--reboot-days=saturday,sunday", "--start-time=22:30", "--end-time=02:30", "--time-zone=Europe/Berlin"},
+		},
+		{
+			name: "whole day reboot window",
+			unattended: &UnattendedUpgradesConfig{
+				Enable: true,
+				RebootWindow: &MaintenanceWindow{
+					Days:     []string{"Sunday"},
+					Start:    "00:00",
+					Duration: metav1.Duration{Duration: 24 * time.Hour},
+				},
+			},
+			blacklist:  []string{"containerd", "containerd.io", "cri-tools", "kubeadm", "kubectl", "kubelet", "kubernetes-cni"},
+			rebootArgs: []string{"--reboot-days=sunday"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := KubeOneCluster{Upgrades: &UpgradesConfig{Unattended: tt.unattended}}
+			if got := c.UnattendedUpgradesEnabled(); got != (tt.unattended != nil) {
+				t.Errorf("UnattendedUpgradesEnabled() = %v, want %v", got, tt.unattended != nil)
+			}
+			if got := c.UnattendedUpgradesPackageBlacklist(); !reflect.DeepEqual(got, tt.blacklist) {
+				t.Errorf("UnattendedUpgradesPackageBlacklist() = %v, want %v", got, tt.blacklist)
+			}
+			if got := c.UnattendedUpgradesRebootArgs(); !reflect.DeepEqual(got, tt.rebootArgs) {
+				t.Errorf("UnattendedUpgradesRebootArgs() = %v, want %v", got, tt.rebootArgs)
+			}
+		})
+	}
+}
+
+func TestEtcdEndpoints(t *testing.T) {
+	t.Parallel()
+
+	hosts := []HostConfig{
+		{PublicAddress: "1.1.1.1", PrivateAddress: "10.0.0.1", IPv6Addresses: []string{"fd00::1"}},
+		{PublicAddress: "1.1.1.2", IPv6Addresses: []string{"fd00::2"}},
+	}
+
+	tests := []struct {
+		name     string
+		ipFamily IPFamily
+		want     []string
+	}{
+		{
+			name:     "IPv4",
+			ipFamily: IPFamilyIPv4,
+			want:     []string{"10.0.0.1:2379", "1.1.1.2:2379"},
+		},
+		{
+			name:     "IPv6 primary",
+			ipFamily: IPFamilyIPv6IPv4,
+			want:     []string{"[fd00::1]:2379", "[fd00::2]:2379"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := KubeOneCluster{
+				ClusterNetwork: ClusterNetworkConfig{IPFamily: tt.ipFamily},
+				ControlPlane:   ControlPlaneConfig{Hosts: hosts},
+			}
+			if got := c.EtcdEndpoints(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EtcdEndpoints() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheme
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	fuzz "github.com/google/gofuzz"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	kubeonev1beta2 "k8c.io/kubeone/pkg/apis/kubeone/v1beta2"
+	kubeonev1beta3 "k8c.io/kubeone/pkg/apis/kubeone/v1beta3"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const roundTripIterations = 200
+
+func TestRoundTripVersionedKubeOneCluster(t *testing.T) {
+	testcases := []struct {
+		name   string
+		newObj func() runtime.Object
+	}{
+		{
+			name:   "v1beta2",
+			newObj: func() runtime.Object { return &kubeonev1beta2.KubeOneCluster{} },
+		},
+		{
+			name:   "v1beta3",
+			newObj: func() runtime.Object { return &kubeonev1beta3.KubeOneCluster{} },
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			seed := rand.Int63()
+			fuzzer := fuzz.NewWithSeed(seed).
+				NilChance(0.2).
+				NumElements(0, 3).
+				Funcs(
+					// TypeMeta is not a subject of conversion
+					func(tm *metav1.TypeMeta, _ fuzz.Continue) {
+						tm.APIVersion = ""
+						tm.Kind = ""
+					},
+				)
+
+			for i := 0; i < roundTripIterations; i++ {
+				original := tc.newObj()
+				fuzzer.Fuzz(original)
+
+				internal := &kubeoneapi.KubeOneCluster{}
+				if err := Scheme.Convert(original, internal, nil); err != nil {
+					t.Fatalf("seed %d: converting %s to internal: %v", seed, tc.name, err)
+				}
+
+				roundTripped := tc.newObj()
+				if err := Scheme.Convert(internal, roundTripped, nil); err != nil {
+					t.Fatalf("seed %d: converting internal to %s: %v", seed, tc.name, err)
+				}
+
+				if !apiequality.Semantic.DeepEqual(original, roundTripped) {
+					t.Fatalf("seed %d: round trip through internal is not lossless (-original +roundTripped):\n%s", seed, cmp.Diff(original, roundTripped))
+				}
+			}
+		})
+	}
+}
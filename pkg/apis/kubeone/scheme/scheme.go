@@ -20,6 +20,7 @@ import (
 	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
 	kubeonev1beta1 "k8c.io/kubeone/pkg/apis/kubeone/v1beta1"
 	kubeonev1beta2 "k8c.io/kubeone/pkg/apis/kubeone/v1beta2"
+	kubeonev1beta3 "k8c.io/kubeone/pkg/apis/kubeone/v1beta3"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -42,6 +43,7 @@ func init() {
 // AddToScheme builds the KubeOne scheme
 func AddToScheme(scheme *runtime.Scheme) {
 	utilruntime.Must(kubeoneapi.AddToScheme(scheme))
+	utilruntime.Must(kubeonev1beta3.AddToScheme(scheme))
 	utilruntime.Must(kubeonev1beta2.AddToScheme(scheme))
 	utilruntime.Must(kubeonev1beta1.AddToScheme(scheme))
 	utilruntime.Must(scheme.SetVersionPriority(kubeonev1beta1.SchemeGroupVersion))
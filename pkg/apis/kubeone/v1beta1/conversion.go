@@ -17,8 +17,6 @@ limitations under the License.
 package v1beta1
 
 import (
-	unsafe "unsafe"
-
 	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
 	"k8c.io/kubeone/pkg/pointer"
 
@@ -57,7 +55,9 @@ func Convert_v1beta1_CloudProviderSpec_To_kubeone_CloudProviderSpec(in *CloudPro
 	}
 
 	// PacketSpec has been renamed to EquinixMetalSpec
-	out.EquinixMetal = (*kubeoneapi.EquinixMetalSpec)(unsafe.Pointer(in.Packet))
+	if in.Packet != nil {
+		out.EquinixMetal = &kubeoneapi.EquinixMetalSpec{}
+	}
 
 	return nil
 }
@@ -69,18 +69,91 @@ func Convert_kubeone_CloudProviderSpec_To_v1beta1_CloudProviderSpec(in *kubeonea
 	}
 
 	// PacketSpec has been renamed to EquinixMetalSpec
-	out.Packet = (*PacketSpec)(unsafe.Pointer(in.EquinixMetal))
+	// LoadBalancer was introduced only in new v1beta2 API, so we skip it here
+	if in.EquinixMetal != nil {
+		out.Packet = &PacketSpec{}
+	}
 
 	return nil
 }
 
+func Convert_kubeone_APIEndpoint_To_v1beta1_APIEndpoint(in *kubeoneapi.APIEndpoint, out *APIEndpoint, s conversion.Scope) error {
+	// AdditionalEndpoints were introduced only in new v1beta2 API, so we skip them here
+	return autoConvert_kubeone_APIEndpoint_To_v1beta1_APIEndpoint(in, out, s)
+}
+
+func Convert_kubeone_AWSSpec_To_v1beta1_AWSSpec(in *kubeoneapi.AWSSpec, out *AWSSpec, s conversion.Scope) error {
+	// CredentialsMode was introduced only in new v1beta2 API, so we skip it here
+	return autoConvert_kubeone_AWSSpec_To_v1beta1_AWSSpec(in, out, s)
+}
+
+func Convert_kubeone_AzureSpec_To_v1beta1_AzureSpec(in *kubeoneapi.AzureSpec, out *AzureSpec, s conversion.Scope) error {
+	// CredentialsMode, UserAssignedIdentityID, VMType and AvailabilityZones were introduced only in new v1beta2 API, so we skip them here
+	return autoConvert_kubeone_AzureSpec_To_v1beta1_AzureSpec(in, out, s)
+}
+
+func Convert_kubeone_GCESpec_To_v1beta1_GCESpec(in *kubeoneapi.GCESpec, out *GCESpec, s conversion.Scope) error {
+	// CredentialsMode and SharedVPC were introduced only in new v1beta2 API, so we skip them here
+	return autoConvert_kubeone_GCESpec_To_v1beta1_GCESpec(in, out, s)
+}
+
+func Convert_kubeone_VsphereSpec_To_v1beta1_VsphereSpec(in *kubeoneapi.VsphereSpec, out *VsphereSpec, s conversion.Scope) error {
+	// StoragePolicyName, DatastoreURL and FileVolumes were introduced only in new v1beta2 API, so we skip them here
+	return autoConvert_kubeone_VsphereSpec_To_v1beta1_VsphereSpec(in, out, s)
+}
+
+func Convert_kubeone_DynamicWorkerConfig_To_v1beta1_DynamicWorkerConfig(in *kubeoneapi.DynamicWorkerConfig, out *DynamicWorkerConfig, s conversion.Scope) error {
+	// CloudProvider was introduced only in new v1beta2 API, so we skip it here
+	return autoConvert_kubeone_DynamicWorkerConfig_To_v1beta1_DynamicWorkerConfig(in, out, s)
+}
+
+func Convert_kubeone_DigitalOceanSpec_To_v1beta1_DigitalOceanSpec(in *kubeoneapi.DigitalOceanSpec, out *DigitalOceanSpec, s conversion.Scope) error {
+	// VPCID and VPCIPRange were introduced only in new v1beta2 API, so we skip them here
+	return autoConvert_kubeone_DigitalOceanSpec_To_v1beta1_DigitalOceanSpec(in, out, s)
+}
+
+func Convert_kubeone_HetznerSpec_To_v1beta1_HetznerSpec(in *kubeoneapi.HetznerSpec, out *HetznerSpec, s conversion.Scope) error {
+	// Robot is not supported in v1beta1, so it's dropped
+	return autoConvert_kubeone_HetznerSpec_To_v1beta1_HetznerSpec(in, out, s)
+}
+
 func Convert_kubeone_HostConfig_To_v1beta1_HostConfig(in *kubeoneapi.HostConfig, out *HostConfig, scope conversion.Scope) error {
-	// explicitly skip kubelet conversion omitted in autoConvert_kubeone_HostConfig_To_v1beta1_HostConfig
+	// explicitly skip kubelet, zone and bmc conversion omitted in autoConvert_kubeone_HostConfig_To_v1beta1_HostConfig
 	return autoConvert_kubeone_HostConfig_To_v1beta1_HostConfig(in, out, scope)
 }
 
+func Convert_kubeone_ContainerRuntimeConfig_To_v1beta1_ContainerRuntimeConfig(in *kubeoneapi.ContainerRuntimeConfig, out *ContainerRuntimeConfig, s conversion.Scope) error {
+	// SandboxImage was introduced only in new v1beta2 API, so we skip it here
+	return autoConvert_kubeone_ContainerRuntimeConfig_To_v1beta1_ContainerRuntimeConfig(in, out, s)
+}
+
+func Convert_kubeone_ControlPlaneConfig_To_v1beta1_ControlPlaneConfig(in *kubeoneapi.ControlPlaneConfig, out *ControlPlaneConfig, s conversion.Scope) error {
+	// RequireZoneSpread and Kernel were introduced only in new v1beta2 API, so we skip them here
+	return autoConvert_kubeone_ControlPlaneConfig_To_v1beta1_ControlPlaneConfig(in, out, s)
+}
+
+func Convert_kubeone_StaticWorkersConfig_To_v1beta1_StaticWorkersConfig(in *kubeoneapi.StaticWorkersConfig, out *StaticWorkersConfig, s conversion.Scope) error {
+	// Kernel was introduced only in new v1beta2 API, so we skip it here
+	return autoConvert_kubeone_StaticWorkersConfig_To_v1beta1_StaticWorkersConfig(in, out, s)
+}
+
+func Convert_kubeone_KubeProxyConfig_To_v1beta1_KubeProxyConfig(in *kubeoneapi.KubeProxyConfig, out *KubeProxyConfig, s conversion.Scope) error {
+	// NFTables was introduced only in new v1beta2 API, so we skip it here
+	return autoConvert_kubeone_KubeProxyConfig_To_v1beta1_KubeProxyConfig(in, out, s)
+}
+
+func Convert_kubeone_IPVSConfig_To_v1beta1_IPVSConfig(in *kubeoneapi.IPVSConfig, out *IPVSConfig, s conversion.Scope) error {
+	// SyncPeriod and MinSyncPeriod were introduced only in new v1beta2 API, so we skip them here
+	return autoConvert_kubeone_IPVSConfig_To_v1beta1_IPVSConfig(in, out, s)
+}
+
+func Convert_kubeone_IPTables_To_v1beta1_IPTables(in *kubeoneapi.IPTables, out *IPTables, s conversion.Scope) error {
+	// MasqueradeAll, SyncPeriod and MinSyncPeriod were introduced only in new v1beta2 API, so we skip them here
+	return autoConvert_kubeone_IPTables_To_v1beta1_IPTables(in, out, s)
+}
+
 func Convert_kubeone_KubeOneCluster_To_v1beta1_KubeOneCluster(in *kubeoneapi.KubeOneCluster, out *KubeOneCluster, s conversion.Scope) error {
-	// LoggingConfig was introduced only in new v1beta2 API, so we skip it here
+	// LoggingConfig, Cgroups, ControlPlaneComponents, AdditionalTrustedCAs, Backups, Upgrades and CertificateAuthority were introduced only in new v1beta2 API, so we skip them here
 	return autoConvert_kubeone_KubeOneCluster_To_v1beta1_KubeOneCluster(in, out, s)
 }
 
@@ -90,10 +163,25 @@ func Convert_kubeone_ProviderSpec_To_v1beta1_ProviderSpec(in *kubeoneapi.Provide
 }
 
 func Convert_kubeone_Features_To_v1beta1_Features(in *kubeoneapi.Features, out *Features, s conversion.Scope) error {
-	// CoreDNS feature is introduced only in the v1beta2 API
+	// CoreDNS, NvidiaGPU, NodeSwap, SeccompDefault, MetalLB, GatewayAPI, IstioAmbient, KubeVIP, DefaultDenyNetworkPolicy, ClusterAutoscaler, LocalStorage and IngressNginx features are introduced only in the v1beta2 API
 	return autoConvert_kubeone_Features_To_v1beta1_Features(in, out, s)
 }
 
+func Convert_kubeone_MetricsServer_To_v1beta1_MetricsServer(in *kubeoneapi.MetricsServer, out *MetricsServer, s conversion.Scope) error {
+	// Replicas, SecureKubeletTLS, Resources and HostNetwork were introduced only in new v1beta2 API, so we skip them here
+	return autoConvert_kubeone_MetricsServer_To_v1beta1_MetricsServer(in, out, s)
+}
+
+func Convert_kubeone_CNI_To_v1beta1_CNI(in *kubeoneapi.CNI, out *CNI, s conversion.Scope) error {
+	// Calico and EnableBandwidthPlugin were introduced only in new v1beta2 API, so we skip them here
+	return autoConvert_kubeone_CNI_To_v1beta1_CNI(in, out, s)
+}
+
+func Convert_kubeone_CiliumSpec_To_v1beta1_CiliumSpec(in *kubeoneapi.CiliumSpec, out *CiliumSpec, s conversion.Scope) error {
+	// DisableHubbleUI, RoutingMode, TunnelProtocol, AutoDirectNodeRoutes, IPAM, MTU, Encryption and HostRouting were introduced only in new v1beta2 API, so we skip them here
+	return autoConvert_kubeone_CiliumSpec_To_v1beta1_CiliumSpec(in, out, s)
+}
+
 func Convert_kubeone_ClusterNetworkConfig_To_v1beta1_ClusterNetworkConfig(in *kubeoneapi.ClusterNetworkConfig, out *ClusterNetworkConfig, s conversion.Scope) error {
 	return autoConvert_kubeone_ClusterNetworkConfig_To_v1beta1_ClusterNetworkConfig(in, out, s)
 }
@@ -102,6 +190,16 @@ func Convert_kubeone_ProviderStaticNetworkConfig_To_v1beta1_ProviderStaticNetwor
 	return autoConvert_kubeone_ProviderStaticNetworkConfig_To_v1beta1_ProviderStaticNetworkConfig(in, out, s)
 }
 
+func Convert_kubeone_Addons_To_v1beta1_Addons(in *kubeoneapi.Addons, out *Addons, s conversion.Scope) error {
+	// Source and Verification were introduced only in new v1beta2 API, so we skip them here
+	return autoConvert_kubeone_Addons_To_v1beta1_Addons(in, out, s)
+}
+
 func Convert_kubeone_Addon_To_v1beta1_Addon(in *kubeoneapi.Addon, out *Addon, s conversion.Scope) error {
 	return autoConvert_kubeone_Addon_To_v1beta1_Addon(in, out, s)
 }
+
+func Convert_kubeone_SystemPackages_To_v1beta1_SystemPackages(in *kubeoneapi.SystemPackages, out *SystemPackages, s conversion.Scope) error {
+	// Repositories were introduced only in new v1beta2 API, so we skip them here
+	return autoConvert_kubeone_SystemPackages_To_v1beta1_SystemPackages(in, out, s)
+}
@@ -43,21 +43,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*kubeone.APIEndpoint)(nil), (*APIEndpoint)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_kubeone_APIEndpoint_To_v1beta1_APIEndpoint(a.(*kubeone.APIEndpoint), b.(*APIEndpoint), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*AWSSpec)(nil), (*kubeone.AWSSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AWSSpec_To_kubeone_AWSSpec(a.(*AWSSpec), b.(*kubeone.AWSSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*kubeone.AWSSpec)(nil), (*AWSSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_kubeone_AWSSpec_To_v1beta1_AWSSpec(a.(*kubeone.AWSSpec), b.(*AWSSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*Addon)(nil), (*kubeone.Addon)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Addon_To_kubeone_Addon(a.(*Addon), b.(*kubeone.Addon), scope)
 	}); err != nil {
@@ -68,11 +58,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*kubeone.Addons)(nil), (*Addons)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_kubeone_Addons_To_v1beta1_Addons(a.(*kubeone.Addons), b.(*Addons), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*AssetConfiguration)(nil), (*kubeone.AssetConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AssetConfiguration_To_kubeone_AssetConfiguration(a.(*AssetConfiguration), b.(*kubeone.AssetConfiguration), scope)
 	}); err != nil {
@@ -88,11 +73,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*kubeone.AzureSpec)(nil), (*AzureSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_kubeone_AzureSpec_To_v1beta1_AzureSpec(a.(*kubeone.AzureSpec), b.(*AzureSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*BinaryAsset)(nil), (*kubeone.BinaryAsset)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_BinaryAsset_To_kubeone_BinaryAsset(a.(*BinaryAsset), b.(*kubeone.BinaryAsset), scope)
 	}); err != nil {
@@ -108,11 +88,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*kubeone.CNI)(nil), (*CNI)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_kubeone_CNI_To_v1beta1_CNI(a.(*kubeone.CNI), b.(*CNI), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*CanalSpec)(nil), (*kubeone.CanalSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_CanalSpec_To_kubeone_CanalSpec(a.(*CanalSpec), b.(*kubeone.CanalSpec), scope)
 	}); err != nil {
@@ -128,11 +103,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*kubeone.CiliumSpec)(nil), (*CiliumSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_kubeone_CiliumSpec_To_v1beta1_CiliumSpec(a.(*kubeone.CiliumSpec), b.(*CiliumSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*ClusterNetworkConfig)(nil), (*kubeone.ClusterNetworkConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ClusterNetworkConfig_To_kubeone_ClusterNetworkConfig(a.(*ClusterNetworkConfig), b.(*kubeone.ClusterNetworkConfig), scope)
 	}); err != nil {
@@ -143,11 +113,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*kubeone.ContainerRuntimeConfig)(nil), (*ContainerRuntimeConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_kubeone_ContainerRuntimeConfig_To_v1beta1_ContainerRuntimeConfig(a.(*kubeone.ContainerRuntimeConfig), b.(*ContainerRuntimeConfig), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*ContainerRuntimeContainerd)(nil), (*kubeone.ContainerRuntimeContainerd)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ContainerRuntimeContainerd_To_kubeone_ContainerRuntimeContainerd(a.(*ContainerRuntimeContainerd), b.(*kubeone.ContainerRuntimeContainerd), scope)
 	}); err != nil {
@@ -163,11 +128,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*kubeone.ControlPlaneConfig)(nil), (*ControlPlaneConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_kubeone_ControlPlaneConfig_To_v1beta1_ControlPlaneConfig(a.(*kubeone.ControlPlaneConfig), b.(*ControlPlaneConfig), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*DNSConfig)(nil), (*kubeone.DNSConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_DNSConfig_To_kubeone_DNSConfig(a.(*DNSConfig), b.(*kubeone.DNSConfig), scope)
 	}); err != nil {
@@ -183,11 +143,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*kubeone.DigitalOceanSpec)(nil), (*DigitalOceanSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_kubeone_DigitalOceanSpec_To_v1beta1_DigitalOceanSpec(a.(*kubeone.DigitalOceanSpec), b.(*DigitalOceanSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*DynamicAuditLog)(nil), (*kubeone.DynamicAuditLog)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_DynamicAuditLog_To_kubeone_DynamicAuditLog(a.(*DynamicAuditLog), b.(*kubeone.DynamicAuditLog), scope)
 	}); err != nil {
@@ -203,11 +158,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*kubeone.DynamicWorkerConfig)(nil), (*DynamicWorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_kubeone_DynamicWorkerConfig_To_v1beta1_DynamicWorkerConfig(a.(*kubeone.DynamicWorkerConfig), b.(*DynamicWorkerConfig), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*EncryptionProviders)(nil), (*kubeone.EncryptionProviders)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_EncryptionProviders_To_kubeone_EncryptionProviders(a.(*EncryptionProviders), b.(*kubeone.EncryptionProviders), scope)
 	}); err != nil {
@@ -233,21 +183,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*kubeone.GCESpec)(nil), (*GCESpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_kubeone_GCESpec_To_v1beta1_GCESpec(a.(*kubeone.GCESpec), b.(*GCESpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*HetznerSpec)(nil), (*kubeone.HetznerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_HetznerSpec_To_kubeone_HetznerSpec(a.(*HetznerSpec), b.(*kubeone.HetznerSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*kubeone.HetznerSpec)(nil), (*HetznerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_kubeone_HetznerSpec_To_v1beta1_HetznerSpec(a.(*kubeone.HetznerSpec), b.(*HetznerSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*HostConfig)(nil), (*kubeone.HostConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_HostConfig_To_kubeone_HostConfig(a.(*HostConfig), b.(*kubeone.HostConfig), scope)
 	}); err != nil {
@@ -258,21 +198,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*kubeone.IPTables)(nil), (*IPTables)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_kubeone_IPTables_To_v1beta1_IPTables(a.(*kubeone.IPTables), b.(*IPTables), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*IPVSConfig)(nil), (*kubeone.IPVSConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_IPVSConfig_To_kubeone_IPVSConfig(a.(*IPVSConfig), b.(*kubeone.IPVSConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*kubeone.IPVSConfig)(nil), (*IPVSConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_kubeone_IPVSConfig_To_v1beta1_IPVSConfig(a.(*kubeone.IPVSConfig), b.(*IPVSConfig), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*ImageAsset)(nil), (*kubeone.ImageAsset)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ImageAsset_To_kubeone_ImageAsset(a.(*ImageAsset), b.(*kubeone.ImageAsset), scope)
 	}); err != nil {
@@ -293,11 +223,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*kubeone.KubeProxyConfig)(nil), (*KubeProxyConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_kubeone_KubeProxyConfig_To_v1beta1_KubeProxyConfig(a.(*kubeone.KubeProxyConfig), b.(*KubeProxyConfig), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*MachineControllerConfig)(nil), (*kubeone.MachineControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MachineControllerConfig_To_kubeone_MachineControllerConfig(a.(*MachineControllerConfig), b.(*kubeone.MachineControllerConfig), scope)
 	}); err != nil {
@@ -313,11 +238,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*kubeone.MetricsServer)(nil), (*MetricsServer)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_kubeone_MetricsServer_To_v1beta1_MetricsServer(a.(*kubeone.MetricsServer), b.(*MetricsServer), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*NoneSpec)(nil), (*kubeone.NoneSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_NoneSpec_To_kubeone_NoneSpec(a.(*NoneSpec), b.(*kubeone.NoneSpec), scope)
 	}); err != nil {
@@ -443,21 +363,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*kubeone.StaticWorkersConfig)(nil), (*StaticWorkersConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_kubeone_StaticWorkersConfig_To_v1beta1_StaticWorkersConfig(a.(*kubeone.StaticWorkersConfig), b.(*StaticWorkersConfig), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*SystemPackages)(nil), (*kubeone.SystemPackages)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SystemPackages_To_kubeone_SystemPackages(a.(*SystemPackages), b.(*kubeone.SystemPackages), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*kubeone.SystemPackages)(nil), (*SystemPackages)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_kubeone_SystemPackages_To_v1beta1_SystemPackages(a.(*kubeone.SystemPackages), b.(*SystemPackages), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VersionConfig)(nil), (*kubeone.VersionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_VersionConfig_To_kubeone_VersionConfig(a.(*VersionConfig), b.(*kubeone.VersionConfig), scope)
 	}); err != nil {
@@ -473,11 +383,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*kubeone.VsphereSpec)(nil), (*VsphereSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_kubeone_VsphereSpec_To_v1beta1_VsphereSpec(a.(*kubeone.VsphereSpec), b.(*VsphereSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*WeaveNetSpec)(nil), (*kubeone.WeaveNetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_WeaveNetSpec_To_kubeone_WeaveNetSpec(a.(*WeaveNetSpec), b.(*kubeone.WeaveNetSpec), scope)
 	}); err != nil {
@@ -488,11 +393,41 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*kubeone.APIEndpoint)(nil), (*APIEndpoint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_APIEndpoint_To_v1beta1_APIEndpoint(a.(*kubeone.APIEndpoint), b.(*APIEndpoint), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*kubeone.AWSSpec)(nil), (*AWSSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_AWSSpec_To_v1beta1_AWSSpec(a.(*kubeone.AWSSpec), b.(*AWSSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*kubeone.Addon)(nil), (*Addon)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_kubeone_Addon_To_v1beta1_Addon(a.(*kubeone.Addon), b.(*Addon), scope)
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*kubeone.Addons)(nil), (*Addons)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_Addons_To_v1beta1_Addons(a.(*kubeone.Addons), b.(*Addons), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*kubeone.AzureSpec)(nil), (*AzureSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_AzureSpec_To_v1beta1_AzureSpec(a.(*kubeone.AzureSpec), b.(*AzureSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*kubeone.CNI)(nil), (*CNI)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_CNI_To_v1beta1_CNI(a.(*kubeone.CNI), b.(*CNI), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*kubeone.CiliumSpec)(nil), (*CiliumSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_CiliumSpec_To_v1beta1_CiliumSpec(a.(*kubeone.CiliumSpec), b.(*CiliumSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*kubeone.CloudProviderSpec)(nil), (*CloudProviderSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_kubeone_CloudProviderSpec_To_v1beta1_CloudProviderSpec(a.(*kubeone.CloudProviderSpec), b.(*CloudProviderSpec), scope)
 	}); err != nil {
@@ -503,6 +438,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*kubeone.ContainerRuntimeConfig)(nil), (*ContainerRuntimeConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_ContainerRuntimeConfig_To_v1beta1_ContainerRuntimeConfig(a.(*kubeone.ContainerRuntimeConfig), b.(*ContainerRuntimeConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*kubeone.ContainerRuntimeContainerd)(nil), (*ContainerRuntimeContainerd)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_kubeone_ContainerRuntimeContainerd_To_v1beta1_ContainerRuntimeContainerd(a.(*kubeone.ContainerRuntimeContainerd), b.(*ContainerRuntimeContainerd), scope)
 	}); err != nil {
@@ -513,21 +453,66 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*kubeone.ControlPlaneConfig)(nil), (*ControlPlaneConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_ControlPlaneConfig_To_v1beta1_ControlPlaneConfig(a.(*kubeone.ControlPlaneConfig), b.(*ControlPlaneConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*kubeone.DigitalOceanSpec)(nil), (*DigitalOceanSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_DigitalOceanSpec_To_v1beta1_DigitalOceanSpec(a.(*kubeone.DigitalOceanSpec), b.(*DigitalOceanSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*kubeone.DynamicWorkerConfig)(nil), (*DynamicWorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_DynamicWorkerConfig_To_v1beta1_DynamicWorkerConfig(a.(*kubeone.DynamicWorkerConfig), b.(*DynamicWorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*kubeone.Features)(nil), (*Features)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_kubeone_Features_To_v1beta1_Features(a.(*kubeone.Features), b.(*Features), scope)
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*kubeone.GCESpec)(nil), (*GCESpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_GCESpec_To_v1beta1_GCESpec(a.(*kubeone.GCESpec), b.(*GCESpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*kubeone.HetznerSpec)(nil), (*HetznerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_HetznerSpec_To_v1beta1_HetznerSpec(a.(*kubeone.HetznerSpec), b.(*HetznerSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*kubeone.HostConfig)(nil), (*HostConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_kubeone_HostConfig_To_v1beta1_HostConfig(a.(*kubeone.HostConfig), b.(*HostConfig), scope)
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*kubeone.IPTables)(nil), (*IPTables)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_IPTables_To_v1beta1_IPTables(a.(*kubeone.IPTables), b.(*IPTables), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*kubeone.IPVSConfig)(nil), (*IPVSConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_IPVSConfig_To_v1beta1_IPVSConfig(a.(*kubeone.IPVSConfig), b.(*IPVSConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*kubeone.KubeOneCluster)(nil), (*KubeOneCluster)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_kubeone_KubeOneCluster_To_v1beta1_KubeOneCluster(a.(*kubeone.KubeOneCluster), b.(*KubeOneCluster), scope)
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*kubeone.KubeProxyConfig)(nil), (*KubeProxyConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_KubeProxyConfig_To_v1beta1_KubeProxyConfig(a.(*kubeone.KubeProxyConfig), b.(*KubeProxyConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*kubeone.MetricsServer)(nil), (*MetricsServer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_MetricsServer_To_v1beta1_MetricsServer(a.(*kubeone.MetricsServer), b.(*MetricsServer), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*kubeone.ProviderSpec)(nil), (*ProviderSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_kubeone_ProviderSpec_To_v1beta1_ProviderSpec(a.(*kubeone.ProviderSpec), b.(*ProviderSpec), scope)
 	}); err != nil {
@@ -538,6 +523,21 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*kubeone.StaticWorkersConfig)(nil), (*StaticWorkersConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_StaticWorkersConfig_To_v1beta1_StaticWorkersConfig(a.(*kubeone.StaticWorkersConfig), b.(*StaticWorkersConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*kubeone.SystemPackages)(nil), (*SystemPackages)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_SystemPackages_To_v1beta1_SystemPackages(a.(*kubeone.SystemPackages), b.(*SystemPackages), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*kubeone.VsphereSpec)(nil), (*VsphereSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_VsphereSpec_To_v1beta1_VsphereSpec(a.(*kubeone.VsphereSpec), b.(*VsphereSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*CloudProviderSpec)(nil), (*kubeone.CloudProviderSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_CloudProviderSpec_To_kubeone_CloudProviderSpec(a.(*CloudProviderSpec), b.(*kubeone.CloudProviderSpec), scope)
 	}); err != nil {
@@ -567,14 +567,10 @@ func autoConvert_kubeone_APIEndpoint_To_v1beta1_APIEndpoint(in *kubeone.APIEndpo
 	out.Host = in.Host
 	out.Port = in.Port
 	out.AlternativeNames = *(*[]string)(unsafe.Pointer(&in.AlternativeNames))
+	// WARNING: in.AdditionalEndpoints requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_kubeone_APIEndpoint_To_v1beta1_APIEndpoint is an autogenerated conversion function.
-func Convert_kubeone_APIEndpoint_To_v1beta1_APIEndpoint(in *kubeone.APIEndpoint, out *APIEndpoint, s conversion.Scope) error {
-	return autoConvert_kubeone_APIEndpoint_To_v1beta1_APIEndpoint(in, out, s)
-}
-
 func autoConvert_v1beta1_AWSSpec_To_kubeone_AWSSpec(in *AWSSpec, out *kubeone.AWSSpec, s conversion.Scope) error {
 	return nil
 }
@@ -585,14 +581,10 @@ func Convert_v1beta1_AWSSpec_To_kubeone_AWSSpec(in *AWSSpec, out *kubeone.AWSSpe
 }
 
 func autoConvert_kubeone_AWSSpec_To_v1beta1_AWSSpec(in *kubeone.AWSSpec, out *AWSSpec, s conversion.Scope) error {
+	// WARNING: in.CredentialsMode requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_kubeone_AWSSpec_To_v1beta1_AWSSpec is an autogenerated conversion function.
-func Convert_kubeone_AWSSpec_To_v1beta1_AWSSpec(in *kubeone.AWSSpec, out *AWSSpec, s conversion.Scope) error {
-	return autoConvert_kubeone_AWSSpec_To_v1beta1_AWSSpec(in, out, s)
-}
-
 func autoConvert_v1beta1_Addon_To_kubeone_Addon(in *Addon, out *kubeone.Addon, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Params = *(*map[string]string)(unsafe.Pointer(&in.Params))
@@ -610,6 +602,9 @@ func autoConvert_kubeone_Addon_To_v1beta1_Addon(in *kubeone.Addon, out *Addon, s
 	out.Params = *(*map[string]string)(unsafe.Pointer(&in.Params))
 	// WARNING: in.DisableTemplating requires manual conversion: does not exist in peer-type
 	out.Delete = in.Delete
+	// WARNING: in.DependsOn requires manual conversion: does not exist in peer-type
+	// WARNING: in.ReadinessChecks requires manual conversion: does not exist in peer-type
+	// WARNING: in.Phase requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -639,6 +634,8 @@ func Convert_v1beta1_Addons_To_kubeone_Addons(in *Addons, out *kubeone.Addons, s
 func autoConvert_kubeone_Addons_To_v1beta1_Addons(in *kubeone.Addons, out *Addons, s conversion.Scope) error {
 	out.Enable = in.Enable
 	out.Path = in.Path
+	// WARNING: in.Source requires manual conversion: does not exist in peer-type
+	// WARNING: in.Verification requires manual conversion: does not exist in peer-type
 	out.GlobalParams = *(*map[string]string)(unsafe.Pointer(&in.GlobalParams))
 	if in.Addons != nil {
 		in, out := &in.Addons, &out.Addons
@@ -654,11 +651,6 @@ func autoConvert_kubeone_Addons_To_v1beta1_Addons(in *kubeone.Addons, out *Addon
 	return nil
 }
 
-// Convert_kubeone_Addons_To_v1beta1_Addons is an autogenerated conversion function.
-func Convert_kubeone_Addons_To_v1beta1_Addons(in *kubeone.Addons, out *Addons, s conversion.Scope) error {
-	return autoConvert_kubeone_Addons_To_v1beta1_Addons(in, out, s)
-}
-
 func autoConvert_v1beta1_AssetConfiguration_To_kubeone_AssetConfiguration(in *AssetConfiguration, out *kubeone.AssetConfiguration, s conversion.Scope) error {
 	if err := Convert_v1beta1_ImageAsset_To_kubeone_ImageAsset(&in.Kubernetes, &out.Kubernetes, s); err != nil {
 		return err
@@ -735,14 +727,11 @@ func Convert_v1beta1_AzureSpec_To_kubeone_AzureSpec(in *AzureSpec, out *kubeone.
 }
 
 func autoConvert_kubeone_AzureSpec_To_v1beta1_AzureSpec(in *kubeone.AzureSpec, out *AzureSpec, s conversion.Scope) error {
+	// WARNING: in.CredentialsMode requires manual conversion: does not exist in peer-type
+	// WARNING: in.UserAssignedIdentityID requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_kubeone_AzureSpec_To_v1beta1_AzureSpec is an autogenerated conversion function.
-func Convert_kubeone_AzureSpec_To_v1beta1_AzureSpec(in *kubeone.AzureSpec, out *AzureSpec, s conversion.Scope) error {
-	return autoConvert_kubeone_AzureSpec_To_v1beta1_AzureSpec(in, out, s)
-}
-
 func autoConvert_v1beta1_BinaryAsset_To_kubeone_BinaryAsset(in *BinaryAsset, out *kubeone.BinaryAsset, s conversion.Scope) error {
 	out.URL = in.URL
 	return nil
@@ -765,7 +754,15 @@ func Convert_kubeone_BinaryAsset_To_v1beta1_BinaryAsset(in *kubeone.BinaryAsset,
 
 func autoConvert_v1beta1_CNI_To_kubeone_CNI(in *CNI, out *kubeone.CNI, s conversion.Scope) error {
 	out.Canal = (*kubeone.CanalSpec)(unsafe.Pointer(in.Canal))
-	out.Cilium = (*kubeone.CiliumSpec)(unsafe.Pointer(in.Cilium))
+	if in.Cilium != nil {
+		in, out := &in.Cilium, &out.Cilium
+		*out = new(kubeone.CiliumSpec)
+		if err := Convert_v1beta1_CiliumSpec_To_kubeone_CiliumSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Cilium = nil
+	}
 	out.WeaveNet = (*kubeone.WeaveNetSpec)(unsafe.Pointer(in.WeaveNet))
 	out.External = (*kubeone.ExternalCNISpec)(unsafe.Pointer(in.External))
 	return nil
@@ -778,17 +775,22 @@ func Convert_v1beta1_CNI_To_kubeone_CNI(in *CNI, out *kubeone.CNI, s conversion.
 
 func autoConvert_kubeone_CNI_To_v1beta1_CNI(in *kubeone.CNI, out *CNI, s conversion.Scope) error {
 	out.Canal = (*CanalSpec)(unsafe.Pointer(in.Canal))
-	out.Cilium = (*CiliumSpec)(unsafe.Pointer(in.Cilium))
+	if in.Cilium != nil {
+		in, out := &in.Cilium, &out.Cilium
+		*out = new(CiliumSpec)
+		if err := Convert_kubeone_CiliumSpec_To_v1beta1_CiliumSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Cilium = nil
+	}
+	// WARNING: in.Calico requires manual conversion: does not exist in peer-type
 	out.WeaveNet = (*WeaveNetSpec)(unsafe.Pointer(in.WeaveNet))
 	out.External = (*ExternalCNISpec)(unsafe.Pointer(in.External))
+	// WARNING: in.EnableBandwidthPlugin requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_kubeone_CNI_To_v1beta1_CNI is an autogenerated conversion function.
-func Convert_kubeone_CNI_To_v1beta1_CNI(in *kubeone.CNI, out *CNI, s conversion.Scope) error {
-	return autoConvert_kubeone_CNI_To_v1beta1_CNI(in, out, s)
-}
-
 func autoConvert_v1beta1_CanalSpec_To_kubeone_CanalSpec(in *CanalSpec, out *kubeone.CanalSpec, s conversion.Scope) error {
 	out.MTU = in.MTU
 	return nil
@@ -823,26 +825,77 @@ func Convert_v1beta1_CiliumSpec_To_kubeone_CiliumSpec(in *CiliumSpec, out *kubeo
 func autoConvert_kubeone_CiliumSpec_To_v1beta1_CiliumSpec(in *kubeone.CiliumSpec, out *CiliumSpec, s conversion.Scope) error {
 	out.KubeProxyReplacement = KubeProxyReplacementType(in.KubeProxyReplacement)
 	out.EnableHubble = in.EnableHubble
+	// WARNING: in.DisableHubbleUI requires manual conversion: does not exist in peer-type
+	// WARNING: in.RoutingMode requires manual conversion: does not exist in peer-type
+	// WARNING: in.TunnelProtocol requires manual conversion: does not exist in peer-type
+	// WARNING: in.AutoDirectNodeRoutes requires manual conversion: does not exist in peer-type
+	// WARNING: in.IPAM requires manual conversion: does not exist in peer-type
+	// WARNING: in.MTU requires manual conversion: does not exist in peer-type
+	// WARNING: in.Encryption requires manual conversion: does not exist in peer-type
+	// WARNING: in.HostRouting requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_kubeone_CiliumSpec_To_v1beta1_CiliumSpec is an autogenerated conversion function.
-func Convert_kubeone_CiliumSpec_To_v1beta1_CiliumSpec(in *kubeone.CiliumSpec, out *CiliumSpec, s conversion.Scope) error {
-	return autoConvert_kubeone_CiliumSpec_To_v1beta1_CiliumSpec(in, out, s)
-}
-
 func autoConvert_v1beta1_CloudProviderSpec_To_kubeone_CloudProviderSpec(in *CloudProviderSpec, out *kubeone.CloudProviderSpec, s conversion.Scope) error {
 	out.External = in.External
 	out.CloudConfig = in.CloudConfig
 	out.CSIConfig = in.CSIConfig
-	out.AWS = (*kubeone.AWSSpec)(unsafe.Pointer(in.AWS))
-	out.Azure = (*kubeone.AzureSpec)(unsafe.Pointer(in.Azure))
-	out.DigitalOcean = (*kubeone.DigitalOceanSpec)(unsafe.Pointer(in.DigitalOcean))
-	out.GCE = (*kubeone.GCESpec)(unsafe.Pointer(in.GCE))
-	out.Hetzner = (*kubeone.HetznerSpec)(unsafe.Pointer(in.Hetzner))
+	if in.AWS != nil {
+		in, out := &in.AWS, &out.AWS
+		*out = new(kubeone.AWSSpec)
+		if err := Convert_v1beta1_AWSSpec_To_kubeone_AWSSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AWS = nil
+	}
+	if in.Azure != nil {
+		in, out := &in.Azure, &out.Azure
+		*out = new(kubeone.AzureSpec)
+		if err := Convert_v1beta1_AzureSpec_To_kubeone_AzureSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Azure = nil
+	}
+	if in.DigitalOcean != nil {
+		in, out := &in.DigitalOcean, &out.DigitalOcean
+		*out = new(kubeone.DigitalOceanSpec)
+		if err := Convert_v1beta1_DigitalOceanSpec_To_kubeone_DigitalOceanSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.DigitalOcean = nil
+	}
+	if in.GCE != nil {
+		in, out := &in.GCE, &out.GCE
+		*out = new(kubeone.GCESpec)
+		if err := Convert_v1beta1_GCESpec_To_kubeone_GCESpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.GCE = nil
+	}
+	if in.Hetzner != nil {
+		in, out := &in.Hetzner, &out.Hetzner
+		*out = new(kubeone.HetznerSpec)
+		if err := Convert_v1beta1_HetznerSpec_To_kubeone_HetznerSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Hetzner = nil
+	}
 	out.Openstack = (*kubeone.OpenstackSpec)(unsafe.Pointer(in.Openstack))
 	// WARNING: in.Packet requires manual conversion: does not exist in peer-type
-	out.Vsphere = (*kubeone.VsphereSpec)(unsafe.Pointer(in.Vsphere))
+	if in.Vsphere != nil {
+		in, out := &in.Vsphere, &out.Vsphere
+		*out = new(kubeone.VsphereSpec)
+		if err := Convert_v1beta1_VsphereSpec_To_kubeone_VsphereSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Vsphere = nil
+	}
 	out.None = (*kubeone.NoneSpec)(unsafe.Pointer(in.None))
 	return nil
 }
@@ -853,16 +906,68 @@ func autoConvert_kubeone_CloudProviderSpec_To_v1beta1_CloudProviderSpec(in *kube
 	out.CloudConfig = in.CloudConfig
 	out.CSIConfig = in.CSIConfig
 	// WARNING: in.SecretProviderClassName requires manual conversion: does not exist in peer-type
-	out.AWS = (*AWSSpec)(unsafe.Pointer(in.AWS))
-	out.Azure = (*AzureSpec)(unsafe.Pointer(in.Azure))
-	out.DigitalOcean = (*DigitalOceanSpec)(unsafe.Pointer(in.DigitalOcean))
-	out.GCE = (*GCESpec)(unsafe.Pointer(in.GCE))
-	out.Hetzner = (*HetznerSpec)(unsafe.Pointer(in.Hetzner))
+	// WARNING: in.CloudControllerManager requires manual conversion: does not exist in peer-type
+	if in.AWS != nil {
+		in, out := &in.AWS, &out.AWS
+		*out = new(AWSSpec)
+		if err := Convert_kubeone_AWSSpec_To_v1beta1_AWSSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AWS = nil
+	}
+	if in.Azure != nil {
+		in, out := &in.Azure, &out.Azure
+		*out = new(AzureSpec)
+		if err := Convert_kubeone_AzureSpec_To_v1beta1_AzureSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Azure = nil
+	}
+	if in.DigitalOcean != nil {
+		in, out := &in.DigitalOcean, &out.DigitalOcean
+		*out = new(DigitalOceanSpec)
+		if err := Convert_kubeone_DigitalOceanSpec_To_v1beta1_DigitalOceanSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.DigitalOcean = nil
+	}
+	if in.GCE != nil {
+		in, out := &in.GCE, &out.GCE
+		*out = new(GCESpec)
+		if err := Convert_kubeone_GCESpec_To_v1beta1_GCESpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.GCE = nil
+	}
+	if in.Hetzner != nil {
+		in, out := &in.Hetzner, &out.Hetzner
+		*out = new(HetznerSpec)
+		if err := Convert_kubeone_HetznerSpec_To_v1beta1_HetznerSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Hetzner = nil
+	}
+	// WARNING: in.Kubevirt requires manual conversion: does not exist in peer-type
 	// WARNING: in.Nutanix requires manual conversion: does not exist in peer-type
+	// WARNING: in.OCI requires manual conversion: does not exist in peer-type
 	out.Openstack = (*OpenstackSpec)(unsafe.Pointer(in.Openstack))
+	// WARNING: in.Proxmox requires manual conversion: does not exist in peer-type
 	// WARNING: in.EquinixMetal requires manual conversion: does not exist in peer-type
 	// WARNING: in.VMwareCloudDirector requires manual conversion: does not exist in peer-type
-	out.Vsphere = (*VsphereSpec)(unsafe.Pointer(in.Vsphere))
+	if in.Vsphere != nil {
+		in, out := &in.Vsphere, &out.Vsphere
+		*out = new(VsphereSpec)
+		if err := Convert_kubeone_VsphereSpec_To_v1beta1_VsphereSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Vsphere = nil
+	}
 	out.None = (*NoneSpec)(unsafe.Pointer(in.None))
 	return nil
 }
@@ -872,8 +977,24 @@ func autoConvert_v1beta1_ClusterNetworkConfig_To_kubeone_ClusterNetworkConfig(in
 	out.ServiceSubnet = in.ServiceSubnet
 	out.ServiceDomainName = in.ServiceDomainName
 	out.NodePortRange = in.NodePortRange
-	out.CNI = (*kubeone.CNI)(unsafe.Pointer(in.CNI))
-	out.KubeProxy = (*kubeone.KubeProxyConfig)(unsafe.Pointer(in.KubeProxy))
+	if in.CNI != nil {
+		in, out := &in.CNI, &out.CNI
+		*out = new(kubeone.CNI)
+		if err := Convert_v1beta1_CNI_To_kubeone_CNI(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CNI = nil
+	}
+	if in.KubeProxy != nil {
+		in, out := &in.KubeProxy, &out.KubeProxy
+		*out = new(kubeone.KubeProxyConfig)
+		if err := Convert_v1beta1_KubeProxyConfig_To_kubeone_KubeProxyConfig(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.KubeProxy = nil
+	}
 	return nil
 }
 
@@ -889,11 +1010,31 @@ func autoConvert_kubeone_ClusterNetworkConfig_To_v1beta1_ClusterNetworkConfig(in
 	// WARNING: in.ServiceSubnetIPv6 requires manual conversion: does not exist in peer-type
 	out.ServiceDomainName = in.ServiceDomainName
 	out.NodePortRange = in.NodePortRange
-	out.CNI = (*CNI)(unsafe.Pointer(in.CNI))
-	out.KubeProxy = (*KubeProxyConfig)(unsafe.Pointer(in.KubeProxy))
+	if in.CNI != nil {
+		in, out := &in.CNI, &out.CNI
+		*out = new(CNI)
+		if err := Convert_kubeone_CNI_To_v1beta1_CNI(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CNI = nil
+	}
+	if in.KubeProxy != nil {
+		in, out := &in.KubeProxy, &out.KubeProxy
+		*out = new(KubeProxyConfig)
+		if err := Convert_kubeone_KubeProxyConfig_To_v1beta1_KubeProxyConfig(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.KubeProxy = nil
+	}
 	// WARNING: in.IPFamily requires manual conversion: does not exist in peer-type
 	// WARNING: in.NodeCIDRMaskSizeIPv4 requires manual conversion: does not exist in peer-type
 	// WARNING: in.NodeCIDRMaskSizeIPv6 requires manual conversion: does not exist in peer-type
+	// WARNING: in.AllocateNodeCIDRs requires manual conversion: does not exist in peer-type
+	// WARNING: in.EgressGateways requires manual conversion: does not exist in peer-type
+	// WARNING: in.DNS requires manual conversion: does not exist in peer-type
+	// WARNING: in.BGP requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -943,14 +1084,10 @@ func autoConvert_kubeone_ContainerRuntimeConfig_To_v1beta1_ContainerRuntimeConfi
 	} else {
 		out.Containerd = nil
 	}
+	// WARNING: in.SandboxImage requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_kubeone_ContainerRuntimeConfig_To_v1beta1_ContainerRuntimeConfig is an autogenerated conversion function.
-func Convert_kubeone_ContainerRuntimeConfig_To_v1beta1_ContainerRuntimeConfig(in *kubeone.ContainerRuntimeConfig, out *ContainerRuntimeConfig, s conversion.Scope) error {
-	return autoConvert_kubeone_ContainerRuntimeConfig_To_v1beta1_ContainerRuntimeConfig(in, out, s)
-}
-
 func autoConvert_v1beta1_ContainerRuntimeContainerd_To_kubeone_ContainerRuntimeContainerd(in *ContainerRuntimeContainerd, out *kubeone.ContainerRuntimeContainerd, s conversion.Scope) error {
 	return nil
 }
@@ -962,6 +1099,7 @@ func Convert_v1beta1_ContainerRuntimeContainerd_To_kubeone_ContainerRuntimeConta
 
 func autoConvert_kubeone_ContainerRuntimeContainerd_To_v1beta1_ContainerRuntimeContainerd(in *kubeone.ContainerRuntimeContainerd, out *ContainerRuntimeContainerd, s conversion.Scope) error {
 	// WARNING: in.Registries requires manual conversion: does not exist in peer-type
+	// WARNING: in.Version requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -1011,14 +1149,11 @@ func autoConvert_kubeone_ControlPlaneConfig_To_v1beta1_ControlPlaneConfig(in *ku
 	} else {
 		out.Hosts = nil
 	}
+	// WARNING: in.RequireZoneSpread requires manual conversion: does not exist in peer-type
+	// WARNING: in.Kernel requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_kubeone_ControlPlaneConfig_To_v1beta1_ControlPlaneConfig is an autogenerated conversion function.
-func Convert_kubeone_ControlPlaneConfig_To_v1beta1_ControlPlaneConfig(in *kubeone.ControlPlaneConfig, out *ControlPlaneConfig, s conversion.Scope) error {
-	return autoConvert_kubeone_ControlPlaneConfig_To_v1beta1_ControlPlaneConfig(in, out, s)
-}
-
 func autoConvert_v1beta1_DNSConfig_To_kubeone_DNSConfig(in *DNSConfig, out *kubeone.DNSConfig, s conversion.Scope) error {
 	out.Servers = *(*[]string)(unsafe.Pointer(&in.Servers))
 	return nil
@@ -1049,14 +1184,11 @@ func Convert_v1beta1_DigitalOceanSpec_To_kubeone_DigitalOceanSpec(in *DigitalOce
 }
 
 func autoConvert_kubeone_DigitalOceanSpec_To_v1beta1_DigitalOceanSpec(in *kubeone.DigitalOceanSpec, out *DigitalOceanSpec, s conversion.Scope) error {
+	// WARNING: in.VPCID requires manual conversion: does not exist in peer-type
+	// WARNING: in.VPCIPRange requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_kubeone_DigitalOceanSpec_To_v1beta1_DigitalOceanSpec is an autogenerated conversion function.
-func Convert_kubeone_DigitalOceanSpec_To_v1beta1_DigitalOceanSpec(in *kubeone.DigitalOceanSpec, out *DigitalOceanSpec, s conversion.Scope) error {
-	return autoConvert_kubeone_DigitalOceanSpec_To_v1beta1_DigitalOceanSpec(in, out, s)
-}
-
 func autoConvert_v1beta1_DynamicAuditLog_To_kubeone_DynamicAuditLog(in *DynamicAuditLog, out *kubeone.DynamicAuditLog, s conversion.Scope) error {
 	out.Enable = in.Enable
 	return nil
@@ -1094,17 +1226,14 @@ func Convert_v1beta1_DynamicWorkerConfig_To_kubeone_DynamicWorkerConfig(in *Dyna
 func autoConvert_kubeone_DynamicWorkerConfig_To_v1beta1_DynamicWorkerConfig(in *kubeone.DynamicWorkerConfig, out *DynamicWorkerConfig, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Replicas = (*int)(unsafe.Pointer(in.Replicas))
+	// WARNING: in.CloudProvider requires manual conversion: does not exist in peer-type
+	// WARNING: in.UpgradeStrategy requires manual conversion: does not exist in peer-type
 	if err := Convert_kubeone_ProviderSpec_To_v1beta1_ProviderSpec(&in.Config, &out.Config, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_kubeone_DynamicWorkerConfig_To_v1beta1_DynamicWorkerConfig is an autogenerated conversion function.
-func Convert_kubeone_DynamicWorkerConfig_To_v1beta1_DynamicWorkerConfig(in *kubeone.DynamicWorkerConfig, out *DynamicWorkerConfig, s conversion.Scope) error {
-	return autoConvert_kubeone_DynamicWorkerConfig_To_v1beta1_DynamicWorkerConfig(in, out, s)
-}
-
 func autoConvert_v1beta1_EncryptionProviders_To_kubeone_EncryptionProviders(in *EncryptionProviders, out *kubeone.EncryptionProviders, s conversion.Scope) error {
 	out.Enable = in.Enable
 	out.CustomEncryptionConfiguration = in.CustomEncryptionConfiguration
@@ -1151,7 +1280,15 @@ func autoConvert_v1beta1_Features_To_kubeone_Features(in *Features, out *kubeone
 	out.PodSecurityPolicy = (*kubeone.PodSecurityPolicy)(unsafe.Pointer(in.PodSecurityPolicy))
 	out.StaticAuditLog = (*kubeone.StaticAuditLog)(unsafe.Pointer(in.StaticAuditLog))
 	out.DynamicAuditLog = (*kubeone.DynamicAuditLog)(unsafe.Pointer(in.DynamicAuditLog))
-	out.MetricsServer = (*kubeone.MetricsServer)(unsafe.Pointer(in.MetricsServer))
+	if in.MetricsServer != nil {
+		in, out := &in.MetricsServer, &out.MetricsServer
+		*out = new(kubeone.MetricsServer)
+		if err := Convert_v1beta1_MetricsServer_To_kubeone_MetricsServer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.MetricsServer = nil
+	}
 	out.OpenIDConnect = (*kubeone.OpenIDConnect)(unsafe.Pointer(in.OpenIDConnect))
 	out.EncryptionProviders = (*kubeone.EncryptionProviders)(unsafe.Pointer(in.EncryptionProviders))
 	return nil
@@ -1163,10 +1300,29 @@ func autoConvert_kubeone_Features_To_v1beta1_Features(in *kubeone.Features, out
 	out.PodSecurityPolicy = (*PodSecurityPolicy)(unsafe.Pointer(in.PodSecurityPolicy))
 	out.StaticAuditLog = (*StaticAuditLog)(unsafe.Pointer(in.StaticAuditLog))
 	out.DynamicAuditLog = (*DynamicAuditLog)(unsafe.Pointer(in.DynamicAuditLog))
-	out.MetricsServer = (*MetricsServer)(unsafe.Pointer(in.MetricsServer))
+	if in.MetricsServer != nil {
+		in, out := &in.MetricsServer, &out.MetricsServer
+		*out = new(MetricsServer)
+		if err := Convert_kubeone_MetricsServer_To_v1beta1_MetricsServer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.MetricsServer = nil
+	}
 	out.OpenIDConnect = (*OpenIDConnect)(unsafe.Pointer(in.OpenIDConnect))
 	out.EncryptionProviders = (*EncryptionProviders)(unsafe.Pointer(in.EncryptionProviders))
 	// WARNING: in.NodeLocalDNS requires manual conversion: does not exist in peer-type
+	// WARNING: in.NvidiaGPU requires manual conversion: does not exist in peer-type
+	// WARNING: in.NodeSwap requires manual conversion: does not exist in peer-type
+	// WARNING: in.SeccompDefault requires manual conversion: does not exist in peer-type
+	// WARNING: in.MetalLB requires manual conversion: does not exist in peer-type
+	// WARNING: in.GatewayAPI requires manual conversion: does not exist in peer-type
+	// WARNING: in.IstioAmbient requires manual conversion: does not exist in peer-type
+	// WARNING: in.KubeVIP requires manual conversion: does not exist in peer-type
+	// WARNING: in.DefaultDenyNetworkPolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.ClusterAutoscaler requires manual conversion: does not exist in peer-type
+	// WARNING: in.LocalStorage requires manual conversion: does not exist in peer-type
+	// WARNING: in.IngressNginx requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -1180,14 +1336,10 @@ func Convert_v1beta1_GCESpec_To_kubeone_GCESpec(in *GCESpec, out *kubeone.GCESpe
 }
 
 func autoConvert_kubeone_GCESpec_To_v1beta1_GCESpec(in *kubeone.GCESpec, out *GCESpec, s conversion.Scope) error {
+	// WARNING: in.CredentialsMode requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_kubeone_GCESpec_To_v1beta1_GCESpec is an autogenerated conversion function.
-func Convert_kubeone_GCESpec_To_v1beta1_GCESpec(in *kubeone.GCESpec, out *GCESpec, s conversion.Scope) error {
-	return autoConvert_kubeone_GCESpec_To_v1beta1_GCESpec(in, out, s)
-}
-
 func autoConvert_v1beta1_HetznerSpec_To_kubeone_HetznerSpec(in *HetznerSpec, out *kubeone.HetznerSpec, s conversion.Scope) error {
 	out.NetworkID = in.NetworkID
 	return nil
@@ -1200,14 +1352,10 @@ func Convert_v1beta1_HetznerSpec_To_kubeone_HetznerSpec(in *HetznerSpec, out *ku
 
 func autoConvert_kubeone_HetznerSpec_To_v1beta1_HetznerSpec(in *kubeone.HetznerSpec, out *HetznerSpec, s conversion.Scope) error {
 	out.NetworkID = in.NetworkID
+	// WARNING: in.Robot requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_kubeone_HetznerSpec_To_v1beta1_HetznerSpec is an autogenerated conversion function.
-func Convert_kubeone_HetznerSpec_To_v1beta1_HetznerSpec(in *kubeone.HetznerSpec, out *HetznerSpec, s conversion.Scope) error {
-	return autoConvert_kubeone_HetznerSpec_To_v1beta1_HetznerSpec(in, out, s)
-}
-
 func autoConvert_v1beta1_HostConfig_To_kubeone_HostConfig(in *HostConfig, out *kubeone.HostConfig, s conversion.Scope) error {
 	out.ID = in.ID
 	out.PublicAddress = in.PublicAddress
@@ -1249,8 +1397,10 @@ func autoConvert_kubeone_HostConfig_To_v1beta1_HostConfig(in *kubeone.HostConfig
 	out.IsLeader = in.IsLeader
 	out.Taints = *(*[]v1.Taint)(unsafe.Pointer(&in.Taints))
 	// WARNING: in.Labels requires manual conversion: does not exist in peer-type
+	// WARNING: in.Zone requires manual conversion: does not exist in peer-type
 	// WARNING: in.Kubelet requires manual conversion: does not exist in peer-type
 	out.OperatingSystem = OperatingSystemName(in.OperatingSystem)
+	// WARNING: in.BMC requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -1264,14 +1414,12 @@ func Convert_v1beta1_IPTables_To_kubeone_IPTables(in *IPTables, out *kubeone.IPT
 }
 
 func autoConvert_kubeone_IPTables_To_v1beta1_IPTables(in *kubeone.IPTables, out *IPTables, s conversion.Scope) error {
+	// WARNING: in.MasqueradeAll requires manual conversion: does not exist in peer-type
+	// WARNING: in.SyncPeriod requires manual conversion: does not exist in peer-type
+	// WARNING: in.MinSyncPeriod requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_kubeone_IPTables_To_v1beta1_IPTables is an autogenerated conversion function.
-func Convert_kubeone_IPTables_To_v1beta1_IPTables(in *kubeone.IPTables, out *IPTables, s conversion.Scope) error {
-	return autoConvert_kubeone_IPTables_To_v1beta1_IPTables(in, out, s)
-}
-
 func autoConvert_v1beta1_IPVSConfig_To_kubeone_IPVSConfig(in *IPVSConfig, out *kubeone.IPVSConfig, s conversion.Scope) error {
 	out.Scheduler = in.Scheduler
 	out.ExcludeCIDRs = *(*[]string)(unsafe.Pointer(&in.ExcludeCIDRs))
@@ -1294,14 +1442,11 @@ func autoConvert_kubeone_IPVSConfig_To_v1beta1_IPVSConfig(in *kubeone.IPVSConfig
 	out.TCPTimeout = in.TCPTimeout
 	out.TCPFinTimeout = in.TCPFinTimeout
 	out.UDPTimeout = in.UDPTimeout
+	// WARNING: in.SyncPeriod requires manual conversion: does not exist in peer-type
+	// WARNING: in.MinSyncPeriod requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_kubeone_IPVSConfig_To_v1beta1_IPVSConfig is an autogenerated conversion function.
-func Convert_kubeone_IPVSConfig_To_v1beta1_IPVSConfig(in *kubeone.IPVSConfig, out *IPVSConfig, s conversion.Scope) error {
-	return autoConvert_kubeone_IPVSConfig_To_v1beta1_IPVSConfig(in, out, s)
-}
-
 func autoConvert_v1beta1_ImageAsset_To_kubeone_ImageAsset(in *ImageAsset, out *kubeone.ImageAsset, s conversion.Scope) error {
 	out.ImageRepository = in.ImageRepository
 	out.ImageTag = in.ImageTag
@@ -1375,7 +1520,15 @@ func autoConvert_v1beta1_KubeOneCluster_To_kubeone_KubeOneCluster(in *KubeOneClu
 	} else {
 		out.Addons = nil
 	}
-	out.SystemPackages = (*kubeone.SystemPackages)(unsafe.Pointer(in.SystemPackages))
+	if in.SystemPackages != nil {
+		in, out := &in.SystemPackages, &out.SystemPackages
+		*out = new(kubeone.SystemPackages)
+		if err := Convert_v1beta1_SystemPackages_To_kubeone_SystemPackages(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SystemPackages = nil
+	}
 	if err := Convert_v1beta1_AssetConfiguration_To_kubeone_AssetConfiguration(&in.AssetConfiguration, &out.AssetConfiguration, s); err != nil {
 		return err
 	}
@@ -1428,6 +1581,7 @@ func autoConvert_kubeone_KubeOneCluster_To_v1beta1_KubeOneCluster(in *kubeone.Ku
 	out.MachineController = (*MachineControllerConfig)(unsafe.Pointer(in.MachineController))
 	// WARNING: in.OperatingSystemManager requires manual conversion: does not exist in peer-type
 	out.CABundle = in.CABundle
+	// WARNING: in.AdditionalTrustedCAs requires manual conversion: does not exist in peer-type
 	if err := Convert_kubeone_Features_To_v1beta1_Features(&in.Features, &out.Features, s); err != nil {
 		return err
 	}
@@ -1441,19 +1595,48 @@ func autoConvert_kubeone_KubeOneCluster_To_v1beta1_KubeOneCluster(in *kubeone.Ku
 		out.Addons = nil
 	}
 	// WARNING: in.HelmReleases requires manual conversion: does not exist in peer-type
-	out.SystemPackages = (*SystemPackages)(unsafe.Pointer(in.SystemPackages))
+	if in.SystemPackages != nil {
+		in, out := &in.SystemPackages, &out.SystemPackages
+		*out = new(SystemPackages)
+		if err := Convert_kubeone_SystemPackages_To_v1beta1_SystemPackages(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SystemPackages = nil
+	}
 	if err := Convert_kubeone_AssetConfiguration_To_v1beta1_AssetConfiguration(&in.AssetConfiguration, &out.AssetConfiguration, s); err != nil {
 		return err
 	}
 	out.RegistryConfiguration = (*RegistryConfiguration)(unsafe.Pointer(in.RegistryConfiguration))
 	// WARNING: in.LoggingConfig requires manual conversion: does not exist in peer-type
+	// WARNING: in.Cgroups requires manual conversion: does not exist in peer-type
+	// WARNING: in.ControlPlaneComponents requires manual conversion: does not exist in peer-type
+	// WARNING: in.Backups requires manual conversion: does not exist in peer-type
+	// WARNING: in.Upgrades requires manual conversion: does not exist in peer-type
+	// WARNING: in.CertificateAuthority requires manual conversion: does not exist in peer-type
 	return nil
 }
 
 func autoConvert_v1beta1_KubeProxyConfig_To_kubeone_KubeProxyConfig(in *KubeProxyConfig, out *kubeone.KubeProxyConfig, s conversion.Scope) error {
 	out.SkipInstallation = in.SkipInstallation
-	out.IPVS = (*kubeone.IPVSConfig)(unsafe.Pointer(in.IPVS))
-	out.IPTables = (*kubeone.IPTables)(unsafe.Pointer(in.IPTables))
+	if in.IPVS != nil {
+		in, out := &in.IPVS, &out.IPVS
+		*out = new(kubeone.IPVSConfig)
+		if err := Convert_v1beta1_IPVSConfig_To_kubeone_IPVSConfig(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.IPVS = nil
+	}
+	if in.IPTables != nil {
+		in, out := &in.IPTables, &out.IPTables
+		*out = new(kubeone.IPTables)
+		if err := Convert_v1beta1_IPTables_To_kubeone_IPTables(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.IPTables = nil
+	}
 	return nil
 }
 
@@ -1464,16 +1647,28 @@ func Convert_v1beta1_KubeProxyConfig_To_kubeone_KubeProxyConfig(in *KubeProxyCon
 
 func autoConvert_kubeone_KubeProxyConfig_To_v1beta1_KubeProxyConfig(in *kubeone.KubeProxyConfig, out *KubeProxyConfig, s conversion.Scope) error {
 	out.SkipInstallation = in.SkipInstallation
-	out.IPVS = (*IPVSConfig)(unsafe.Pointer(in.IPVS))
-	out.IPTables = (*IPTables)(unsafe.Pointer(in.IPTables))
+	if in.IPVS != nil {
+		in, out := &in.IPVS, &out.IPVS
+		*out = new(IPVSConfig)
+		if err := Convert_kubeone_IPVSConfig_To_v1beta1_IPVSConfig(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.IPVS = nil
+	}
+	if in.IPTables != nil {
+		in, out := &in.IPTables, &out.IPTables
+		*out = new(IPTables)
+		if err := Convert_kubeone_IPTables_To_v1beta1_IPTables(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.IPTables = nil
+	}
+	// WARNING: in.NFTables requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_kubeone_KubeProxyConfig_To_v1beta1_KubeProxyConfig is an autogenerated conversion function.
-func Convert_kubeone_KubeProxyConfig_To_v1beta1_KubeProxyConfig(in *kubeone.KubeProxyConfig, out *KubeProxyConfig, s conversion.Scope) error {
-	return autoConvert_kubeone_KubeProxyConfig_To_v1beta1_KubeProxyConfig(in, out, s)
-}
-
 func autoConvert_v1beta1_MachineControllerConfig_To_kubeone_MachineControllerConfig(in *MachineControllerConfig, out *kubeone.MachineControllerConfig, s conversion.Scope) error {
 	out.Deploy = in.Deploy
 	return nil
@@ -1506,14 +1701,13 @@ func Convert_v1beta1_MetricsServer_To_kubeone_MetricsServer(in *MetricsServer, o
 
 func autoConvert_kubeone_MetricsServer_To_v1beta1_MetricsServer(in *kubeone.MetricsServer, out *MetricsServer, s conversion.Scope) error {
 	out.Enable = in.Enable
+	// WARNING: in.Replicas requires manual conversion: does not exist in peer-type
+	// WARNING: in.SecureKubeletTLS requires manual conversion: does not exist in peer-type
+	// WARNING: in.Resources requires manual conversion: does not exist in peer-type
+	// WARNING: in.HostNetwork requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_kubeone_MetricsServer_To_v1beta1_MetricsServer is an autogenerated conversion function.
-func Convert_kubeone_MetricsServer_To_v1beta1_MetricsServer(in *kubeone.MetricsServer, out *MetricsServer, s conversion.Scope) error {
-	return autoConvert_kubeone_MetricsServer_To_v1beta1_MetricsServer(in, out, s)
-}
-
 func autoConvert_v1beta1_NoneSpec_To_kubeone_NoneSpec(in *NoneSpec, out *kubeone.NoneSpec, s conversion.Scope) error {
 	return nil
 }
@@ -1885,14 +2079,10 @@ func autoConvert_kubeone_StaticWorkersConfig_To_v1beta1_StaticWorkersConfig(in *
 	} else {
 		out.Hosts = nil
 	}
+	// WARNING: in.Kernel requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_kubeone_StaticWorkersConfig_To_v1beta1_StaticWorkersConfig is an autogenerated conversion function.
-func Convert_kubeone_StaticWorkersConfig_To_v1beta1_StaticWorkersConfig(in *kubeone.StaticWorkersConfig, out *StaticWorkersConfig, s conversion.Scope) error {
-	return autoConvert_kubeone_StaticWorkersConfig_To_v1beta1_StaticWorkersConfig(in, out, s)
-}
-
 func autoConvert_v1beta1_SystemPackages_To_kubeone_SystemPackages(in *SystemPackages, out *kubeone.SystemPackages, s conversion.Scope) error {
 	out.ConfigureRepositories = in.ConfigureRepositories
 	return nil
@@ -1905,14 +2095,10 @@ func Convert_v1beta1_SystemPackages_To_kubeone_SystemPackages(in *SystemPackages
 
 func autoConvert_kubeone_SystemPackages_To_v1beta1_SystemPackages(in *kubeone.SystemPackages, out *SystemPackages, s conversion.Scope) error {
 	out.ConfigureRepositories = in.ConfigureRepositories
+	// WARNING: in.Repositories requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_kubeone_SystemPackages_To_v1beta1_SystemPackages is an autogenerated conversion function.
-func Convert_kubeone_SystemPackages_To_v1beta1_SystemPackages(in *kubeone.SystemPackages, out *SystemPackages, s conversion.Scope) error {
-	return autoConvert_kubeone_SystemPackages_To_v1beta1_SystemPackages(in, out, s)
-}
-
 func autoConvert_v1beta1_VersionConfig_To_kubeone_VersionConfig(in *VersionConfig, out *kubeone.VersionConfig, s conversion.Scope) error {
 	out.Kubernetes = in.Kubernetes
 	return nil
@@ -1943,14 +2129,11 @@ func Convert_v1beta1_VsphereSpec_To_kubeone_VsphereSpec(in *VsphereSpec, out *ku
 }
 
 func autoConvert_kubeone_VsphereSpec_To_v1beta1_VsphereSpec(in *kubeone.VsphereSpec, out *VsphereSpec, s conversion.Scope) error {
+	// WARNING: in.StoragePolicyName requires manual conversion: does not exist in peer-type
+	// WARNING: in.DatastoreURL requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_kubeone_VsphereSpec_To_v1beta1_VsphereSpec is an autogenerated conversion function.
-func Convert_kubeone_VsphereSpec_To_v1beta1_VsphereSpec(in *kubeone.VsphereSpec, out *VsphereSpec, s conversion.Scope) error {
-	return autoConvert_kubeone_VsphereSpec_To_v1beta1_VsphereSpec(in, out, s)
-}
-
 func autoConvert_v1beta1_WeaveNetSpec_To_kubeone_WeaveNetSpec(in *WeaveNetSpec, out *kubeone.WeaveNetSpec, s conversion.Scope) error {
 	out.Encrypted = in.Encrypted
 	return nil
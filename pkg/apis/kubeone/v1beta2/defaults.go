@@ -18,10 +18,12 @@ package v1beta2
 
 import (
 	"fmt"
+	"path"
 	"strings"
 
 	"github.com/Masterminds/semver/v3"
 
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
 	"k8c.io/kubeone/pkg/pointer"
 
 	corev1 "k8s.io/api/core/v1"
@@ -41,6 +43,17 @@ const (
 	DefaultStaticNoProxy = "127.0.0.1/8,localhost"
 	// DefaultCanalMTU defines default VXLAN MTU for Canal CNI
 	DefaultCanalMTU = 1450
+
+	// vxlanIPv4Overhead is the number of bytes added by the VXLAN encapsulation over IPv4
+	vxlanIPv4Overhead = 50
+	// vxlanIPv6Overhead is the number of bytes added by the VXLAN encapsulation over IPv6
+	vxlanIPv6Overhead = 70
+	// ipipOverhead is the number of bytes added by the IP-in-IP encapsulation
+	ipipOverhead = 20
+	// wireguardIPv4Overhead is the number of bytes added by the WireGuard encryption over IPv4
+	wireguardIPv4Overhead = 60
+	// wireguardIPv6Overhead is the number of bytes added by the WireGuard encryption over IPv6
+	wireguardIPv6Overhead = 80
 )
 
 const (
@@ -63,6 +76,7 @@ func SetDefaults_KubeOneCluster(obj *KubeOneCluster) {
 	SetDefaults_APIEndpoints(obj)
 	SetDefaults_Versions(obj)
 	SetDefaults_ContainerRuntime(obj)
+	SetDefaults_Cgroups(obj)
 	SetDefaults_ClusterNetwork(obj)
 	SetDefaults_Proxy(obj)
 	SetDefaults_MachineController(obj)
@@ -70,7 +84,10 @@ func SetDefaults_KubeOneCluster(obj *KubeOneCluster) {
 	SetDefaults_HelmReleases(obj)
 	SetDefaults_SystemPackages(obj)
 	SetDefaults_Features(obj)
+	SetDefaults_Backups(obj)
+	SetDefaults_CertificateAuthority(obj)
 	SetDefaults_CloudConfig(obj)
+	SetDefaults_CloudProvider(obj)
 }
 
 func SetDefaults_CloudConfig(obj *KubeOneCluster) {
@@ -79,6 +96,22 @@ func SetDefaults_CloudConfig(obj *KubeOneCluster) {
 			obj.CloudProvider.CloudConfig = defaultAWSCCMCloudConfig(obj.Name, obj.ClusterNetwork.IPFamily)
 		}
 	}
+
+	if obj.CloudProvider.GCE != nil && obj.CloudProvider.GCE.SharedVPC != nil {
+		if obj.CloudProvider.CloudConfig == "" {
+			obj.CloudProvider.CloudConfig = defaultGCESharedVPCCloudConfig(*obj.CloudProvider.GCE.SharedVPC)
+		}
+	}
+}
+
+func SetDefaults_CloudProvider(obj *KubeOneCluster) {
+	if obj.CloudProvider.Vsphere != nil && obj.CloudProvider.Vsphere.FileVolumes != nil {
+		fileVolumes := obj.CloudProvider.Vsphere.FileVolumes
+		fileVolumes.StorageClassName = defaults(fileVolumes.StorageClassName, "vsphere-csi-file")
+		for i := range fileVolumes.NetPermissions {
+			fileVolumes.NetPermissions[i].Permissions = defaults(fileVolumes.NetPermissions[i].Permissions, "READ_WRITE")
+		}
+	}
 }
 
 func SetDefaults_Hosts(obj *KubeOneCluster) {
@@ -148,6 +181,10 @@ func SetDefaults_APIEndpoints(obj *KubeOneCluster) {
 		obj.APIEndpoint.Host = obj.ControlPlane.Hosts[0].PublicAddress
 	}
 	obj.APIEndpoint.Port = defaults(obj.APIEndpoint.Port, 6443)
+
+	for i := range obj.APIEndpoint.AdditionalEndpoints {
+		obj.APIEndpoint.AdditionalEndpoints[i].Port = defaults(obj.APIEndpoint.AdditionalEndpoints[i].Port, obj.APIEndpoint.Port)
+	}
 }
 
 func SetDefaults_Versions(obj *KubeOneCluster) {
@@ -166,7 +203,15 @@ func SetDefaults_ContainerRuntime(obj *KubeOneCluster) {
 	}
 }
 
+func SetDefaults_Cgroups(obj *KubeOneCluster) {
+	if obj.Cgroups.Driver == "" {
+		obj.Cgroups.Driver = CgroupDriverSystemd
+	}
+}
+
 func SetDefaults_ClusterNetwork(obj *KubeOneCluster) {
+	setDualStackSubnets(&obj.ClusterNetwork)
+
 	if obj.ClusterNetwork.IPFamily == "" {
 		obj.ClusterNetwork.IPFamily = IPFamilyIPv4
 	}
@@ -190,17 +235,24 @@ func SetDefaults_ClusterNetwork(obj *KubeOneCluster) {
 
 	obj.ClusterNetwork.ServiceDomainName = defaults(obj.ClusterNetwork.ServiceDomainName, DefaultServiceDNS)
 	obj.ClusterNetwork.NodePortRange = defaults(obj.ClusterNetwork.NodePortRange, DefaultNodePortRange)
+	obj.ClusterNetwork.AllocateNodeCIDRs = defaults(obj.ClusterNetwork.AllocateNodeCIDRs, ptr(true))
+	for i := range obj.ClusterNetwork.EgressGateways {
+		if len(obj.ClusterNetwork.EgressGateways[i].DestinationCIDRs) == 0 {
+			obj.ClusterNetwork.EgressGateways[i].DestinationCIDRs = []string{"0.0.0.0/0"}
+		}
+	}
+	if bgp := obj.ClusterNetwork.BGP; bgp != nil {
+		for i := range bgp.Peers {
+			bgp.Peers[i].PeerPort = defaults(bgp.Peers[i].PeerPort, 179)
+		}
+		if bgp.RouteReflectors != nil {
+			bgp.RouteReflectors.ClusterID = defaults(bgp.RouteReflectors.ClusterID, "244.0.0.1")
+		}
+	}
 
 	defaultCanal := &CanalSpec{MTU: DefaultCanalMTU}
-	switch {
-	case obj.CloudProvider.AWS != nil:
-		defaultCanal.MTU = defaults(defaultCanal.MTU, 8951) // 9001 AWS Jumbo Frame - 50 VXLAN bytes
-	case obj.CloudProvider.GCE != nil:
-		defaultCanal.MTU = defaults(defaultCanal.MTU, 1410) // GCE specific 1460 bytes - 50 VXLAN bytes
-	case obj.CloudProvider.Hetzner != nil:
-		defaultCanal.MTU = defaults(defaultCanal.MTU, 1400) // Hetzner specific 1450 bytes - 50 VXLAN bytes
-	case obj.CloudProvider.Openstack != nil:
-		defaultCanal.MTU = defaults(defaultCanal.MTU, 1400) // Openstack specific 1450 bytes - 50 VXLAN bytes
+	if mtu := providerMTU(obj.CloudProvider); mtu > 0 {
+		defaultCanal.MTU = mtu - vxlanIPv4Overhead
 	}
 
 	if obj.ClusterNetwork.CNI == nil {
@@ -211,9 +263,37 @@ func SetDefaults_ClusterNetwork(obj *KubeOneCluster) {
 	if obj.ClusterNetwork.CNI.Canal != nil && obj.ClusterNetwork.CNI.Canal.MTU == 0 {
 		obj.ClusterNetwork.CNI.Canal.MTU = defaultCanal.MTU
 	}
+	// the bandwidth plugin is chained in the upstream Canal and Calico manifests
+	if cni := obj.ClusterNetwork.CNI; cni.Canal != nil || cni.Calico != nil {
+		cni.EnableBandwidthPlugin = defaults(cni.EnableBandwidthPlugin, ptr(true))
+	}
+
+	if calico := obj.ClusterNetwork.CNI.Calico; calico != nil {
+		calico.EncapsulationMode = defaults(calico.EncapsulationMode, CalicoEncapsulationModeVXLAN)
+		if mtu := providerMTU(obj.CloudProvider); mtu > 0 && calico.MTU == 0 {
+			calico.MTU = mtu - calicoEncapsulationOverhead(calico, obj.ClusterNetwork.IPFamily)
+		}
+	}
 
-	if obj.ClusterNetwork.CNI.Cilium != nil && obj.ClusterNetwork.CNI.Cilium.KubeProxyReplacement == "" {
-		obj.ClusterNetwork.CNI.Cilium.KubeProxyReplacement = "disabled"
+	if cilium := obj.ClusterNetwork.CNI.Cilium; cilium != nil {
+		if cilium.KubeProxyReplacement == "" {
+			cilium.KubeProxyReplacement = KubeProxyReplacementDisabled
+		}
+		cilium.RoutingMode = defaults(cilium.RoutingMode, CiliumRoutingModeTunnel)
+		if cilium.RoutingMode == CiliumRoutingModeTunnel {
+			cilium.TunnelProtocol = defaults(cilium.TunnelProtocol, CiliumTunnelProtocolVXLAN)
+		}
+		cilium.IPAM = defaults(cilium.IPAM, CiliumIPAMModeClusterPool)
+		cilium.MTU = defaults(cilium.MTU, providerMTU(obj.CloudProvider))
+		cilium.HostRouting = defaults(cilium.HostRouting, CiliumHostRoutingLegacy)
+
+		// kube-proxy is replaced by cilium, so it must not be installed
+		if cilium.KubeProxyReplacement == KubeProxyReplacementStrict {
+			if obj.ClusterNetwork.KubeProxy == nil {
+				obj.ClusterNetwork.KubeProxy = &KubeProxyConfig{}
+			}
+			obj.ClusterNetwork.KubeProxy.SkipInstallation = true
+		}
 	}
 }
 
@@ -252,7 +332,7 @@ func SetDefaults_OperatingSystemManager(obj *KubeOneCluster) {
 func SetDefaults_HelmReleases(obj *KubeOneCluster) {
 	for idx, hr := range obj.HelmReleases {
 		if hr.ReleaseName == "" {
-			obj.HelmReleases[idx].ReleaseName = hr.Chart
+			obj.HelmReleases[idx].ReleaseName = path.Base(hr.Chart)
 		}
 	}
 }
@@ -281,6 +361,9 @@ func SetDefaults_Features(obj *KubeOneCluster) {
 			Enable: true,
 		}
 	}
+	if obj.Features.MetricsServer.Replicas == nil {
+		obj.Features.MetricsServer.Replicas = pointer.New(int32(1))
+	}
 	if obj.Features.StaticAuditLog != nil && obj.Features.StaticAuditLog.Enable {
 		defaultStaticAuditLogConfig(&obj.Features.StaticAuditLog.Config)
 	}
@@ -289,8 +372,65 @@ func SetDefaults_Features(obj *KubeOneCluster) {
 	}
 	if obj.Features.NodeLocalDNS == nil {
 		obj.Features.NodeLocalDNS = &NodeLocalDNS{
-			Deploy: true,
+			// NodeLocalDNS listens on a link-local IPv4 address which is not
+			// reachable from pods in IPv6-only clusters
+			Deploy: obj.ClusterNetwork.IPFamily != IPFamilyIPv6,
+		}
+	}
+	if obj.Features.NodeSwap != nil && obj.Features.NodeSwap.Enable {
+		obj.Features.NodeSwap.SwapBehavior = defaults(obj.Features.NodeSwap.SwapBehavior, SwapBehaviorLimitedSwap)
+	}
+	if obj.Features.MetalLB != nil && obj.Features.MetalLB.Enable {
+		defaultMetalLB(obj.Features.MetalLB)
+	}
+	if obj.Features.GatewayAPI != nil && obj.Features.GatewayAPI.Enable {
+		obj.Features.GatewayAPI.Controller = defaults(obj.Features.GatewayAPI.Controller, defaultGatewayAPIController(obj.ClusterNetwork.CNI))
+	}
+	if obj.Features.DefaultDenyNetworkPolicy != nil && obj.Features.DefaultDenyNetworkPolicy.Enable {
+		if len(obj.Features.DefaultDenyNetworkPolicy.Namespaces) == 0 {
+			obj.Features.DefaultDenyNetworkPolicy.Namespaces = []string{"default"}
+		}
+	}
+	if obj.Features.ClusterAutoscaler != nil && obj.Features.ClusterAutoscaler.Enable {
+		if obj.Features.ClusterAutoscaler.SkipNodesWithLocalStorage == nil {
+			obj.Features.ClusterAutoscaler.SkipNodesWithLocalStorage = pointer.New(true)
+		}
+		obj.Features.ClusterAutoscaler.Expander = defaults(obj.Features.ClusterAutoscaler.Expander, "random")
+	}
+	if obj.Features.LocalStorage != nil && obj.Features.LocalStorage.Enable {
+		defaultLocalStorage(obj.Features.LocalStorage)
+	}
+	if obj.Features.IngressNginx != nil && obj.Features.IngressNginx.Enable {
+		defaultIngressNginx(obj.Features.IngressNginx, obj)
+	}
+}
+
+func SetDefaults_Backups(obj *KubeOneCluster) {
+	if obj.Backups == nil || obj.Backups.Etcd == nil || !obj.Backups.Etcd.Enable {
+		return
+	}
+
+	obj.Backups.Etcd.Schedule = defaults(obj.Backups.Etcd.Schedule, "@every 30m")
+	obj.Backups.Etcd.Retention = defaults(obj.Backups.Etcd.Retention, 48)
+	obj.Backups.Etcd.Target.Endpoint = defaults(obj.Backups.Etcd.Target.Endpoint, "s3.amazonaws.com")
+}
+
+func SetDefaults_CertificateAuthority(obj *KubeOneCluster) {
+	if obj.CertificateAuthority == nil {
+		return
+	}
+
+	for _, source := range []*CertificateAuthoritySource{
+		obj.CertificateAuthority.Kubernetes,
+		obj.CertificateAuthority.Etcd,
+		obj.CertificateAuthority.FrontProxy,
+	} {
+		if source == nil || source.Vault == nil {
+			continue
 		}
+
+		source.Vault.CertificateKey = defaults(source.Vault.CertificateKey, "tls.crt")
+		source.Vault.PrivateKeyKey = defaults(source.Vault.PrivateKeyKey, "tls.key")
 	}
 }
 
@@ -303,6 +443,77 @@ func defaultOpenIDConnect(config *OpenIDConnectConfig) {
 	config.SigningAlgs = defaults(config.SigningAlgs, "RS256")
 }
 
+func defaultMetalLB(obj *MetalLB) {
+	for i := range obj.AddressPools {
+		obj.AddressPools[i].Mode = defaults(obj.AddressPools[i].Mode, MetalLBModeL2)
+	}
+	for i := range obj.BGPPeers {
+		obj.BGPPeers[i].PeerPort = defaults(obj.BGPPeers[i].PeerPort, 179)
+	}
+}
+
+func defaultLocalStorage(obj *LocalStorage) {
+	if obj.LocalPath == nil && obj.TopoLVM == nil {
+		obj.LocalPath = &LocalPathProvisioner{}
+	}
+
+	if obj.LocalPath != nil {
+		obj.LocalPath.Path = defaults(obj.LocalPath.Path, "/opt/local-path-provisioner")
+		obj.StorageClassName = defaults(obj.StorageClassName, "local-path")
+	}
+	if obj.TopoLVM != nil {
+		obj.TopoLVM.FSType = defaults(obj.TopoLVM.FSType, "xfs")
+		if obj.TopoLVM.SpareGB == nil {
+			obj.TopoLVM.SpareGB = pointer.New(10)
+		}
+		obj.StorageClassName = defaults(obj.StorageClassName, "topolvm-provisioner")
+	}
+
+	if obj.DefaultStorageClass == nil {
+		obj.DefaultStorageClass = pointer.New(true)
+	}
+	obj.ReclaimPolicy = defaults(obj.ReclaimPolicy, corev1.PersistentVolumeReclaimDelete)
+}
+
+func defaultIngressNginx(obj *IngressNginx, cluster *KubeOneCluster) {
+	if obj.Replicas == nil {
+		obj.Replicas = pointer.New(int32(2))
+	}
+	if obj.DefaultIngressClass == nil {
+		obj.DefaultIngressClass = pointer.New(true)
+	}
+
+	// the load balancers of DigitalOcean and Hetzner pass the client
+	// addresses only using the PROXY protocol
+	if obj.ProxyProtocol == nil {
+		obj.ProxyProtocol = pointer.New(cluster.CloudProvider.DigitalOcean != nil || cluster.CloudProvider.Hetzner != nil)
+	}
+
+	// without MetalLB, there is nothing providing the LoadBalancer Services
+	// on baremetal
+	serviceType := corev1.ServiceTypeLoadBalancer
+	if cluster.CloudProvider.None != nil && (cluster.Features.MetalLB == nil || !cluster.Features.MetalLB.Enable) {
+		serviceType = corev1.ServiceTypeNodePort
+	}
+	obj.ServiceType = defaults(obj.ServiceType, serviceType)
+
+	trafficPolicy := corev1.ServiceExternalTrafficPolicyLocal
+	if *obj.ProxyProtocol {
+		trafficPolicy = corev1.ServiceExternalTrafficPolicyCluster
+	}
+	obj.ExternalTrafficPolicy = defaults(obj.ExternalTrafficPolicy, trafficPolicy)
+}
+
+// defaultGatewayAPIController returns the gateway controller bundled with the
+// configured CNI plugin, or none if the CNI plugin doesn't provide one
+func defaultGatewayAPIController(cni *CNI) GatewayAPIController {
+	if cni != nil && cni.Cilium != nil && cni.Cilium.KubeProxyReplacement == KubeProxyReplacementStrict {
+		return GatewayAPIControllerCilium
+	}
+
+	return GatewayAPIControllerNone
+}
+
 func defaultStaticAuditLogConfig(obj *StaticAuditLogConfig) {
 	obj.LogPath = defaults(obj.LogPath, "/var/log/kubernetes/audit.log")
 	obj.LogMaxAge = defaults(obj.LogMaxAge, 30)
@@ -324,6 +535,44 @@ func defaultHostConfig(obj *HostConfig) {
 	obj.SSHPort = defaults(obj.SSHPort, 22)
 	obj.BastionPort = defaults(obj.BastionPort, 22)
 	obj.BastionUser = defaults(obj.BastionUser, obj.SSHUsername)
+	if obj.BMC != nil {
+		obj.BMC.BootDevice = defaults(obj.BMC.BootDevice, BMCBootDevicePXE)
+	}
+}
+
+// providerMTU returns the MTU of the network interfaces of the nodes on the
+// given cloud provider, or 0 if it's not known and should be autodetected
+func providerMTU(cloudProvider CloudProviderSpec) int {
+	switch {
+	case cloudProvider.AWS != nil:
+		return 9001 // AWS Jumbo Frame
+	case cloudProvider.GCE != nil:
+		return 1460
+	case cloudProvider.Hetzner != nil, cloudProvider.Openstack != nil:
+		return 1450
+	}
+
+	return 0
+}
+
+// calicoEncapsulationOverhead returns the number of bytes added to the pod
+// traffic by the encapsulation mode or WireGuard encryption of the given
+// Calico spec
+func calicoEncapsulationOverhead(calico *CalicoSpec, ipFamily IPFamily) int {
+	switch {
+	case calico.EnableWireGuard && ipFamily == IPFamilyIPv4:
+		return wireguardIPv4Overhead
+	case calico.EnableWireGuard:
+		return wireguardIPv6Overhead
+	case calico.EncapsulationMode == CalicoEncapsulationModeNone:
+		return 0
+	case calico.EncapsulationMode == CalicoEncapsulationModeIPIP:
+		return ipipOverhead
+	case ipFamily == IPFamilyIPv4:
+		return vxlanIPv4Overhead
+	}
+
+	return vxlanIPv6Overhead
 }
 
 func defaultAWSCCMCloudConfig(name string, ipFamily IPFamily) string {
@@ -348,6 +597,45 @@ func defaultAWSCCMCloudConfig(name string, ipFamily IPFamily) string {
 	return strings.Join(lines, "\n")
 }
 
+func defaultGCESharedVPCCloudConfig(sharedVPC GCESharedVPCSpec) string {
+	lines := []string{
+		"[global]",
+		fmt.Sprintf("network-project-id = %q", sharedVPC.HostProjectID),
+		fmt.Sprintf("network-name = %q", sharedVPC.NetworkName),
+		fmt.Sprintf("subnetwork-name = %q", sharedVPC.SubnetworkName),
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// setDualStackSubnets splits dual-stack CIDR lists provided via podSubnet and serviceSubnet (e.g.
+// "10.244.0.0/16,fd01::/48") into the per-family fields. If ipFamily is not set, it's inferred from the order
+// of the CIDRs in the podSubnet list, or in the serviceSubnet list if podSubnet is a single CIDR. Lists that
+// don't match the IP family are left untouched so that validation can report them.
+func setDualStackSubnets(obj *ClusterNetworkConfig) {
+	podIPv4, podIPv6, podIPFamily, podOK := kubeoneapi.SplitDualStackCIDRs(obj.PodSubnet)
+	svcIPv4, svcIPv6, svcIPFamily, svcOK := kubeoneapi.SplitDualStackCIDRs(obj.ServiceSubnet)
+
+	if obj.IPFamily == "" {
+		switch {
+		case podOK:
+			obj.IPFamily = IPFamily(podIPFamily)
+		case svcOK:
+			obj.IPFamily = IPFamily(svcIPFamily)
+		}
+	}
+
+	if podOK && IPFamily(podIPFamily) == obj.IPFamily {
+		obj.PodSubnet = podIPv4
+		obj.PodSubnetIPv6 = defaults(podIPv6, obj.PodSubnetIPv6)
+	}
+
+	if svcOK && IPFamily(svcIPFamily) == obj.IPFamily {
+		obj.ServiceSubnet = svcIPv4
+		obj.ServiceSubnetIPv6 = defaults(svcIPv6, obj.ServiceSubnetIPv6)
+	}
+}
+
 func defaults[T comparable](input, defaultValue T) T {
 	var zero T
 
@@ -38,10 +38,16 @@ func SetCloudProvider(cp *CloudProviderSpec, name string) error {
 		cp.GCE = &GCESpec{}
 	case "hetzner":
 		cp.Hetzner = &HetznerSpec{}
+	case "kubevirt":
+		cp.Kubevirt = &KubevirtSpec{}
 	case "nutanix":
 		cp.Nutanix = &NutanixSpec{}
+	case "oci":
+		cp.OCI = &OCISpec{}
 	case "openstack":
 		cp.Openstack = &OpenstackSpec{}
+	case "proxmox":
+		cp.Proxmox = &ProxmoxSpec{}
 	case "equinixmetal", "packet":
 		cp.EquinixMetal = &EquinixMetalSpec{}
 	case "vmwareCloudDirector":
@@ -69,10 +75,16 @@ func (cps *CloudProviderSpec) Name() string {
 		return "gce"
 	case cps.Hetzner != nil:
 		return "hetzner"
+	case cps.Kubevirt != nil:
+		return "kubevirt"
 	case cps.Nutanix != nil:
 		return "nutanix"
+	case cps.OCI != nil:
+		return "oci"
 	case cps.Openstack != nil:
 		return "openstack"
+	case cps.Proxmox != nil:
+		return "proxmox"
 	case cps.EquinixMetal != nil:
 		return "equinixmetal"
 	case cps.VMwareCloudDirector != nil:
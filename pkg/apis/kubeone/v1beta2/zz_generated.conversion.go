@@ -27,8 +27,10 @@ import (
 
 	kubeone "k8c.io/kubeone/pkg/apis/kubeone"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
 func init() {
@@ -58,6 +60,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AdditionalAPIEndpoint)(nil), (*kubeone.AdditionalAPIEndpoint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_AdditionalAPIEndpoint_To_kubeone_AdditionalAPIEndpoint(a.(*AdditionalAPIEndpoint), b.(*kubeone.AdditionalAPIEndpoint), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.AdditionalAPIEndpoint)(nil), (*AdditionalAPIEndpoint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_AdditionalAPIEndpoint_To_v1beta2_AdditionalAPIEndpoint(a.(*kubeone.AdditionalAPIEndpoint), b.(*AdditionalAPIEndpoint), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Addon)(nil), (*kubeone.Addon)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_Addon_To_kubeone_Addon(a.(*Addon), b.(*kubeone.Addon), scope)
 	}); err != nil {
@@ -68,6 +80,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AddonReadinessCheck)(nil), (*kubeone.AddonReadinessCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_AddonReadinessCheck_To_kubeone_AddonReadinessCheck(a.(*AddonReadinessCheck), b.(*kubeone.AddonReadinessCheck), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.AddonReadinessCheck)(nil), (*AddonReadinessCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_AddonReadinessCheck_To_v1beta2_AddonReadinessCheck(a.(*kubeone.AddonReadinessCheck), b.(*AddonReadinessCheck), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Addons)(nil), (*kubeone.Addons)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_Addons_To_kubeone_Addons(a.(*Addons), b.(*kubeone.Addons), scope)
 	}); err != nil {
@@ -78,6 +100,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AddonsGitSource)(nil), (*kubeone.AddonsGitSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_AddonsGitSource_To_kubeone_AddonsGitSource(a.(*AddonsGitSource), b.(*kubeone.AddonsGitSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.AddonsGitSource)(nil), (*AddonsGitSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_AddonsGitSource_To_v1beta2_AddonsGitSource(a.(*kubeone.AddonsGitSource), b.(*AddonsGitSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AddonsHTTPSource)(nil), (*kubeone.AddonsHTTPSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_AddonsHTTPSource_To_kubeone_AddonsHTTPSource(a.(*AddonsHTTPSource), b.(*kubeone.AddonsHTTPSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.AddonsHTTPSource)(nil), (*AddonsHTTPSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_AddonsHTTPSource_To_v1beta2_AddonsHTTPSource(a.(*kubeone.AddonsHTTPSource), b.(*AddonsHTTPSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AddonsSource)(nil), (*kubeone.AddonsSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_AddonsSource_To_kubeone_AddonsSource(a.(*AddonsSource), b.(*kubeone.AddonsSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.AddonsSource)(nil), (*AddonsSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_AddonsSource_To_v1beta2_AddonsSource(a.(*kubeone.AddonsSource), b.(*AddonsSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AddonsVerification)(nil), (*kubeone.AddonsVerification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_AddonsVerification_To_kubeone_AddonsVerification(a.(*AddonsVerification), b.(*kubeone.AddonsVerification), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.AddonsVerification)(nil), (*AddonsVerification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_AddonsVerification_To_v1beta2_AddonsVerification(a.(*kubeone.AddonsVerification), b.(*AddonsVerification), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AzureSpec)(nil), (*kubeone.AzureSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_AzureSpec_To_kubeone_AzureSpec(a.(*AzureSpec), b.(*kubeone.AzureSpec), scope)
 	}); err != nil {
@@ -88,6 +150,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BGPConfig)(nil), (*kubeone.BGPConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_BGPConfig_To_kubeone_BGPConfig(a.(*BGPConfig), b.(*kubeone.BGPConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.BGPConfig)(nil), (*BGPConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_BGPConfig_To_v1beta2_BGPConfig(a.(*kubeone.BGPConfig), b.(*BGPConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BGPPeer)(nil), (*kubeone.BGPPeer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_BGPPeer_To_kubeone_BGPPeer(a.(*BGPPeer), b.(*kubeone.BGPPeer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.BGPPeer)(nil), (*BGPPeer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_BGPPeer_To_v1beta2_BGPPeer(a.(*kubeone.BGPPeer), b.(*BGPPeer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BGPRouteReflectors)(nil), (*kubeone.BGPRouteReflectors)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_BGPRouteReflectors_To_kubeone_BGPRouteReflectors(a.(*BGPRouteReflectors), b.(*kubeone.BGPRouteReflectors), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.BGPRouteReflectors)(nil), (*BGPRouteReflectors)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_BGPRouteReflectors_To_v1beta2_BGPRouteReflectors(a.(*kubeone.BGPRouteReflectors), b.(*BGPRouteReflectors), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BMCConfig)(nil), (*kubeone.BMCConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_BMCConfig_To_kubeone_BMCConfig(a.(*BMCConfig), b.(*kubeone.BMCConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.BMCConfig)(nil), (*BMCConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_BMCConfig_To_v1beta2_BMCConfig(a.(*kubeone.BMCConfig), b.(*BMCConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BackupsConfig)(nil), (*kubeone.BackupsConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_BackupsConfig_To_kubeone_BackupsConfig(a.(*BackupsConfig), b.(*kubeone.BackupsConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.BackupsConfig)(nil), (*BackupsConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_BackupsConfig_To_v1beta2_BackupsConfig(a.(*kubeone.BackupsConfig), b.(*BackupsConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*BinaryAsset)(nil), (*kubeone.BinaryAsset)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_BinaryAsset_To_kubeone_BinaryAsset(a.(*BinaryAsset), b.(*kubeone.BinaryAsset), scope)
 	}); err != nil {
@@ -108,6 +220,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CalicoSpec)(nil), (*kubeone.CalicoSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_CalicoSpec_To_kubeone_CalicoSpec(a.(*CalicoSpec), b.(*kubeone.CalicoSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.CalicoSpec)(nil), (*CalicoSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_CalicoSpec_To_v1beta2_CalicoSpec(a.(*kubeone.CalicoSpec), b.(*CalicoSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CanalSpec)(nil), (*kubeone.CanalSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_CanalSpec_To_kubeone_CanalSpec(a.(*CanalSpec), b.(*kubeone.CanalSpec), scope)
 	}); err != nil {
@@ -118,6 +240,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CanaryUpgradeConfig)(nil), (*kubeone.CanaryUpgradeConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_CanaryUpgradeConfig_To_kubeone_CanaryUpgradeConfig(a.(*CanaryUpgradeConfig), b.(*kubeone.CanaryUpgradeConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.CanaryUpgradeConfig)(nil), (*CanaryUpgradeConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_CanaryUpgradeConfig_To_v1beta2_CanaryUpgradeConfig(a.(*kubeone.CanaryUpgradeConfig), b.(*CanaryUpgradeConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CertificateAuthorityConfig)(nil), (*kubeone.CertificateAuthorityConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_CertificateAuthorityConfig_To_kubeone_CertificateAuthorityConfig(a.(*CertificateAuthorityConfig), b.(*kubeone.CertificateAuthorityConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.CertificateAuthorityConfig)(nil), (*CertificateAuthorityConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_CertificateAuthorityConfig_To_v1beta2_CertificateAuthorityConfig(a.(*kubeone.CertificateAuthorityConfig), b.(*CertificateAuthorityConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CertificateAuthoritySource)(nil), (*kubeone.CertificateAuthoritySource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_CertificateAuthoritySource_To_kubeone_CertificateAuthoritySource(a.(*CertificateAuthoritySource), b.(*kubeone.CertificateAuthoritySource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.CertificateAuthoritySource)(nil), (*CertificateAuthoritySource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_CertificateAuthoritySource_To_v1beta2_CertificateAuthoritySource(a.(*kubeone.CertificateAuthoritySource), b.(*CertificateAuthoritySource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CgroupsConfig)(nil), (*kubeone.CgroupsConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_CgroupsConfig_To_kubeone_CgroupsConfig(a.(*CgroupsConfig), b.(*kubeone.CgroupsConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.CgroupsConfig)(nil), (*CgroupsConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_CgroupsConfig_To_v1beta2_CgroupsConfig(a.(*kubeone.CgroupsConfig), b.(*CgroupsConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CiliumSpec)(nil), (*kubeone.CiliumSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_CiliumSpec_To_kubeone_CiliumSpec(a.(*CiliumSpec), b.(*kubeone.CiliumSpec), scope)
 	}); err != nil {
@@ -128,6 +290,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CloudControllerManagerConfig)(nil), (*kubeone.CloudControllerManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_CloudControllerManagerConfig_To_kubeone_CloudControllerManagerConfig(a.(*CloudControllerManagerConfig), b.(*kubeone.CloudControllerManagerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.CloudControllerManagerConfig)(nil), (*CloudControllerManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_CloudControllerManagerConfig_To_v1beta2_CloudControllerManagerConfig(a.(*kubeone.CloudControllerManagerConfig), b.(*CloudControllerManagerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudProviderSpec)(nil), (*kubeone.CloudProviderSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_CloudProviderSpec_To_kubeone_CloudProviderSpec(a.(*CloudProviderSpec), b.(*kubeone.CloudProviderSpec), scope)
 	}); err != nil {
@@ -138,6 +310,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ClusterAutoscaler)(nil), (*kubeone.ClusterAutoscaler)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ClusterAutoscaler_To_kubeone_ClusterAutoscaler(a.(*ClusterAutoscaler), b.(*kubeone.ClusterAutoscaler), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.ClusterAutoscaler)(nil), (*ClusterAutoscaler)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_ClusterAutoscaler_To_v1beta2_ClusterAutoscaler(a.(*kubeone.ClusterAutoscaler), b.(*ClusterAutoscaler), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterAutoscalerNodeGroup)(nil), (*kubeone.ClusterAutoscalerNodeGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ClusterAutoscalerNodeGroup_To_kubeone_ClusterAutoscalerNodeGroup(a.(*ClusterAutoscalerNodeGroup), b.(*kubeone.ClusterAutoscalerNodeGroup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.ClusterAutoscalerNodeGroup)(nil), (*ClusterAutoscalerNodeGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_ClusterAutoscalerNodeGroup_To_v1beta2_ClusterAutoscalerNodeGroup(a.(*kubeone.ClusterAutoscalerNodeGroup), b.(*ClusterAutoscalerNodeGroup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterDNSConfig)(nil), (*kubeone.ClusterDNSConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ClusterDNSConfig_To_kubeone_ClusterDNSConfig(a.(*ClusterDNSConfig), b.(*kubeone.ClusterDNSConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.ClusterDNSConfig)(nil), (*ClusterDNSConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_ClusterDNSConfig_To_v1beta2_ClusterDNSConfig(a.(*kubeone.ClusterDNSConfig), b.(*ClusterDNSConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ClusterNetworkConfig)(nil), (*kubeone.ClusterNetworkConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_ClusterNetworkConfig_To_kubeone_ClusterNetworkConfig(a.(*ClusterNetworkConfig), b.(*kubeone.ClusterNetworkConfig), scope)
 	}); err != nil {
@@ -208,6 +410,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ControlPlaneComponentConfig)(nil), (*kubeone.ControlPlaneComponentConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ControlPlaneComponentConfig_To_kubeone_ControlPlaneComponentConfig(a.(*ControlPlaneComponentConfig), b.(*kubeone.ControlPlaneComponentConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.ControlPlaneComponentConfig)(nil), (*ControlPlaneComponentConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_ControlPlaneComponentConfig_To_v1beta2_ControlPlaneComponentConfig(a.(*kubeone.ControlPlaneComponentConfig), b.(*ControlPlaneComponentConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ControlPlaneComponents)(nil), (*kubeone.ControlPlaneComponents)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ControlPlaneComponents_To_kubeone_ControlPlaneComponents(a.(*ControlPlaneComponents), b.(*kubeone.ControlPlaneComponents), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.ControlPlaneComponents)(nil), (*ControlPlaneComponents)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_ControlPlaneComponents_To_v1beta2_ControlPlaneComponents(a.(*kubeone.ControlPlaneComponents), b.(*ControlPlaneComponents), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControlPlaneConfig)(nil), (*kubeone.ControlPlaneConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_ControlPlaneConfig_To_kubeone_ControlPlaneConfig(a.(*ControlPlaneConfig), b.(*kubeone.ControlPlaneConfig), scope)
 	}); err != nil {
@@ -238,6 +460,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DefaultDenyNetworkPolicy)(nil), (*kubeone.DefaultDenyNetworkPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_DefaultDenyNetworkPolicy_To_kubeone_DefaultDenyNetworkPolicy(a.(*DefaultDenyNetworkPolicy), b.(*kubeone.DefaultDenyNetworkPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.DefaultDenyNetworkPolicy)(nil), (*DefaultDenyNetworkPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_DefaultDenyNetworkPolicy_To_v1beta2_DefaultDenyNetworkPolicy(a.(*kubeone.DefaultDenyNetworkPolicy), b.(*DefaultDenyNetworkPolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DigitalOceanSpec)(nil), (*kubeone.DigitalOceanSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_DigitalOceanSpec_To_kubeone_DigitalOceanSpec(a.(*DigitalOceanSpec), b.(*kubeone.DigitalOceanSpec), scope)
 	}); err != nil {
@@ -248,6 +480,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DrainConfig)(nil), (*kubeone.DrainConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_DrainConfig_To_kubeone_DrainConfig(a.(*DrainConfig), b.(*kubeone.DrainConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.DrainConfig)(nil), (*DrainConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_DrainConfig_To_v1beta2_DrainConfig(a.(*kubeone.DrainConfig), b.(*DrainConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DynamicAuditLog)(nil), (*kubeone.DynamicAuditLog)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_DynamicAuditLog_To_kubeone_DynamicAuditLog(a.(*DynamicAuditLog), b.(*kubeone.DynamicAuditLog), scope)
 	}); err != nil {
@@ -268,6 +510,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EgressGateway)(nil), (*kubeone.EgressGateway)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_EgressGateway_To_kubeone_EgressGateway(a.(*EgressGateway), b.(*kubeone.EgressGateway), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.EgressGateway)(nil), (*EgressGateway)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_EgressGateway_To_v1beta2_EgressGateway(a.(*kubeone.EgressGateway), b.(*EgressGateway), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*EncryptionProviders)(nil), (*kubeone.EncryptionProviders)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_EncryptionProviders_To_kubeone_EncryptionProviders(a.(*EncryptionProviders), b.(*kubeone.EncryptionProviders), scope)
 	}); err != nil {
@@ -278,6 +530,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EquinixMetalLoadBalancerSpec)(nil), (*kubeone.EquinixMetalLoadBalancerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_EquinixMetalLoadBalancerSpec_To_kubeone_EquinixMetalLoadBalancerSpec(a.(*EquinixMetalLoadBalancerSpec), b.(*kubeone.EquinixMetalLoadBalancerSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.EquinixMetalLoadBalancerSpec)(nil), (*EquinixMetalLoadBalancerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_EquinixMetalLoadBalancerSpec_To_v1beta2_EquinixMetalLoadBalancerSpec(a.(*kubeone.EquinixMetalLoadBalancerSpec), b.(*EquinixMetalLoadBalancerSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*EquinixMetalSpec)(nil), (*kubeone.EquinixMetalSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_EquinixMetalSpec_To_kubeone_EquinixMetalSpec(a.(*EquinixMetalSpec), b.(*kubeone.EquinixMetalSpec), scope)
 	}); err != nil {
@@ -288,6 +550,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EtcdBackupsConfig)(nil), (*kubeone.EtcdBackupsConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_EtcdBackupsConfig_To_kubeone_EtcdBackupsConfig(a.(*EtcdBackupsConfig), b.(*kubeone.EtcdBackupsConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.EtcdBackupsConfig)(nil), (*EtcdBackupsConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_EtcdBackupsConfig_To_v1beta2_EtcdBackupsConfig(a.(*kubeone.EtcdBackupsConfig), b.(*EtcdBackupsConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EtcdBackupsTarget)(nil), (*kubeone.EtcdBackupsTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_EtcdBackupsTarget_To_kubeone_EtcdBackupsTarget(a.(*EtcdBackupsTarget), b.(*kubeone.EtcdBackupsTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.EtcdBackupsTarget)(nil), (*EtcdBackupsTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_EtcdBackupsTarget_To_v1beta2_EtcdBackupsTarget(a.(*kubeone.EtcdBackupsTarget), b.(*EtcdBackupsTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EtcdDefragmentationConfig)(nil), (*kubeone.EtcdDefragmentationConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_EtcdDefragmentationConfig_To_kubeone_EtcdDefragmentationConfig(a.(*EtcdDefragmentationConfig), b.(*kubeone.EtcdDefragmentationConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.EtcdDefragmentationConfig)(nil), (*EtcdDefragmentationConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_EtcdDefragmentationConfig_To_v1beta2_EtcdDefragmentationConfig(a.(*kubeone.EtcdDefragmentationConfig), b.(*EtcdDefragmentationConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ExternalCNISpec)(nil), (*kubeone.ExternalCNISpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_ExternalCNISpec_To_kubeone_ExternalCNISpec(a.(*ExternalCNISpec), b.(*kubeone.ExternalCNISpec), scope)
 	}); err != nil {
@@ -308,6 +600,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*GCESharedVPCSpec)(nil), (*kubeone.GCESharedVPCSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_GCESharedVPCSpec_To_kubeone_GCESharedVPCSpec(a.(*GCESharedVPCSpec), b.(*kubeone.GCESharedVPCSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.GCESharedVPCSpec)(nil), (*GCESharedVPCSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_GCESharedVPCSpec_To_v1beta2_GCESharedVPCSpec(a.(*kubeone.GCESharedVPCSpec), b.(*GCESharedVPCSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*GCESpec)(nil), (*kubeone.GCESpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_GCESpec_To_kubeone_GCESpec(a.(*GCESpec), b.(*kubeone.GCESpec), scope)
 	}); err != nil {
@@ -318,6 +620,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*GatewayAPI)(nil), (*kubeone.GatewayAPI)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_GatewayAPI_To_kubeone_GatewayAPI(a.(*GatewayAPI), b.(*kubeone.GatewayAPI), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.GatewayAPI)(nil), (*GatewayAPI)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_GatewayAPI_To_v1beta2_GatewayAPI(a.(*kubeone.GatewayAPI), b.(*GatewayAPI), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HelmRelease)(nil), (*kubeone.HelmRelease)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_HelmRelease_To_kubeone_HelmRelease(a.(*HelmRelease), b.(*kubeone.HelmRelease), scope)
 	}); err != nil {
@@ -358,6 +670,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HostPathMount)(nil), (*kubeone.HostPathMount)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_HostPathMount_To_kubeone_HostPathMount(a.(*HostPathMount), b.(*kubeone.HostPathMount), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.HostPathMount)(nil), (*HostPathMount)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_HostPathMount_To_v1beta2_HostPathMount(a.(*kubeone.HostPathMount), b.(*HostPathMount), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*IPTables)(nil), (*kubeone.IPTables)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_IPTables_To_kubeone_IPTables(a.(*IPTables), b.(*kubeone.IPTables), scope)
 	}); err != nil {
@@ -388,123 +710,293 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*KubeOneCluster)(nil), (*kubeone.KubeOneCluster)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_KubeOneCluster_To_kubeone_KubeOneCluster(a.(*KubeOneCluster), b.(*kubeone.KubeOneCluster), scope)
+	if err := s.AddGeneratedConversionFunc((*IngressNginx)(nil), (*kubeone.IngressNginx)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_IngressNginx_To_kubeone_IngressNginx(a.(*IngressNginx), b.(*kubeone.IngressNginx), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*KubeProxyConfig)(nil), (*kubeone.KubeProxyConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_KubeProxyConfig_To_kubeone_KubeProxyConfig(a.(*KubeProxyConfig), b.(*kubeone.KubeProxyConfig), scope)
+	if err := s.AddGeneratedConversionFunc((*kubeone.IngressNginx)(nil), (*IngressNginx)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_IngressNginx_To_v1beta2_IngressNginx(a.(*kubeone.IngressNginx), b.(*IngressNginx), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*kubeone.KubeProxyConfig)(nil), (*KubeProxyConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_kubeone_KubeProxyConfig_To_v1beta2_KubeProxyConfig(a.(*kubeone.KubeProxyConfig), b.(*KubeProxyConfig), scope)
+	if err := s.AddGeneratedConversionFunc((*IstioAmbient)(nil), (*kubeone.IstioAmbient)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_IstioAmbient_To_kubeone_IstioAmbient(a.(*IstioAmbient), b.(*kubeone.IstioAmbient), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*KubeletConfig)(nil), (*kubeone.KubeletConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_KubeletConfig_To_kubeone_KubeletConfig(a.(*KubeletConfig), b.(*kubeone.KubeletConfig), scope)
+	if err := s.AddGeneratedConversionFunc((*kubeone.IstioAmbient)(nil), (*IstioAmbient)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_IstioAmbient_To_v1beta2_IstioAmbient(a.(*kubeone.IstioAmbient), b.(*IstioAmbient), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*kubeone.KubeletConfig)(nil), (*KubeletConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_kubeone_KubeletConfig_To_v1beta2_KubeletConfig(a.(*kubeone.KubeletConfig), b.(*KubeletConfig), scope)
+	if err := s.AddGeneratedConversionFunc((*KernelConfig)(nil), (*kubeone.KernelConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_KernelConfig_To_kubeone_KernelConfig(a.(*KernelConfig), b.(*kubeone.KernelConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*LoggingConfig)(nil), (*kubeone.LoggingConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_LoggingConfig_To_kubeone_LoggingConfig(a.(*LoggingConfig), b.(*kubeone.LoggingConfig), scope)
+	if err := s.AddGeneratedConversionFunc((*kubeone.KernelConfig)(nil), (*KernelConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_KernelConfig_To_v1beta2_KernelConfig(a.(*kubeone.KernelConfig), b.(*KernelConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*kubeone.LoggingConfig)(nil), (*LoggingConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_kubeone_LoggingConfig_To_v1beta2_LoggingConfig(a.(*kubeone.LoggingConfig), b.(*LoggingConfig), scope)
+	if err := s.AddGeneratedConversionFunc((*KubeOneCluster)(nil), (*kubeone.KubeOneCluster)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_KubeOneCluster_To_kubeone_KubeOneCluster(a.(*KubeOneCluster), b.(*kubeone.KubeOneCluster), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*MachineControllerConfig)(nil), (*kubeone.MachineControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_MachineControllerConfig_To_kubeone_MachineControllerConfig(a.(*MachineControllerConfig), b.(*kubeone.MachineControllerConfig), scope)
+	if err := s.AddGeneratedConversionFunc((*KubeProxyConfig)(nil), (*kubeone.KubeProxyConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_KubeProxyConfig_To_kubeone_KubeProxyConfig(a.(*KubeProxyConfig), b.(*kubeone.KubeProxyConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*kubeone.MachineControllerConfig)(nil), (*MachineControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_kubeone_MachineControllerConfig_To_v1beta2_MachineControllerConfig(a.(*kubeone.MachineControllerConfig), b.(*MachineControllerConfig), scope)
+	if err := s.AddGeneratedConversionFunc((*kubeone.KubeProxyConfig)(nil), (*KubeProxyConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_KubeProxyConfig_To_v1beta2_KubeProxyConfig(a.(*kubeone.KubeProxyConfig), b.(*KubeProxyConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*MetricsServer)(nil), (*kubeone.MetricsServer)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_MetricsServer_To_kubeone_MetricsServer(a.(*MetricsServer), b.(*kubeone.MetricsServer), scope)
+	if err := s.AddGeneratedConversionFunc((*KubeVIP)(nil), (*kubeone.KubeVIP)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_KubeVIP_To_kubeone_KubeVIP(a.(*KubeVIP), b.(*kubeone.KubeVIP), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*kubeone.MetricsServer)(nil), (*MetricsServer)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_kubeone_MetricsServer_To_v1beta2_MetricsServer(a.(*kubeone.MetricsServer), b.(*MetricsServer), scope)
+	if err := s.AddGeneratedConversionFunc((*kubeone.KubeVIP)(nil), (*KubeVIP)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_KubeVIP_To_v1beta2_KubeVIP(a.(*kubeone.KubeVIP), b.(*KubeVIP), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*NodeLocalDNS)(nil), (*kubeone.NodeLocalDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_NodeLocalDNS_To_kubeone_NodeLocalDNS(a.(*NodeLocalDNS), b.(*kubeone.NodeLocalDNS), scope)
+	if err := s.AddGeneratedConversionFunc((*KubeadmPatches)(nil), (*kubeone.KubeadmPatches)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_KubeadmPatches_To_kubeone_KubeadmPatches(a.(*KubeadmPatches), b.(*kubeone.KubeadmPatches), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*kubeone.NodeLocalDNS)(nil), (*NodeLocalDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_kubeone_NodeLocalDNS_To_v1beta2_NodeLocalDNS(a.(*kubeone.NodeLocalDNS), b.(*NodeLocalDNS), scope)
+	if err := s.AddGeneratedConversionFunc((*kubeone.KubeadmPatches)(nil), (*KubeadmPatches)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_KubeadmPatches_To_v1beta2_KubeadmPatches(a.(*kubeone.KubeadmPatches), b.(*KubeadmPatches), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*NoneSpec)(nil), (*kubeone.NoneSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_NoneSpec_To_kubeone_NoneSpec(a.(*NoneSpec), b.(*kubeone.NoneSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*KubeletConfig)(nil), (*kubeone.KubeletConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_KubeletConfig_To_kubeone_KubeletConfig(a.(*KubeletConfig), b.(*kubeone.KubeletConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*kubeone.NoneSpec)(nil), (*NoneSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_kubeone_NoneSpec_To_v1beta2_NoneSpec(a.(*kubeone.NoneSpec), b.(*NoneSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*kubeone.KubeletConfig)(nil), (*KubeletConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_KubeletConfig_To_v1beta2_KubeletConfig(a.(*kubeone.KubeletConfig), b.(*KubeletConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*NutanixSpec)(nil), (*kubeone.NutanixSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_NutanixSpec_To_kubeone_NutanixSpec(a.(*NutanixSpec), b.(*kubeone.NutanixSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*KubevirtSpec)(nil), (*kubeone.KubevirtSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_KubevirtSpec_To_kubeone_KubevirtSpec(a.(*KubevirtSpec), b.(*kubeone.KubevirtSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*kubeone.NutanixSpec)(nil), (*NutanixSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_kubeone_NutanixSpec_To_v1beta2_NutanixSpec(a.(*kubeone.NutanixSpec), b.(*NutanixSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*kubeone.KubevirtSpec)(nil), (*KubevirtSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_KubevirtSpec_To_v1beta2_KubevirtSpec(a.(*kubeone.KubevirtSpec), b.(*KubevirtSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*OpenIDConnect)(nil), (*kubeone.OpenIDConnect)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_OpenIDConnect_To_kubeone_OpenIDConnect(a.(*OpenIDConnect), b.(*kubeone.OpenIDConnect), scope)
+	if err := s.AddGeneratedConversionFunc((*LocalPathProvisioner)(nil), (*kubeone.LocalPathProvisioner)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_LocalPathProvisioner_To_kubeone_LocalPathProvisioner(a.(*LocalPathProvisioner), b.(*kubeone.LocalPathProvisioner), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*kubeone.OpenIDConnect)(nil), (*OpenIDConnect)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_kubeone_OpenIDConnect_To_v1beta2_OpenIDConnect(a.(*kubeone.OpenIDConnect), b.(*OpenIDConnect), scope)
+	if err := s.AddGeneratedConversionFunc((*kubeone.LocalPathProvisioner)(nil), (*LocalPathProvisioner)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_LocalPathProvisioner_To_v1beta2_LocalPathProvisioner(a.(*kubeone.LocalPathProvisioner), b.(*LocalPathProvisioner), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*OpenIDConnectConfig)(nil), (*kubeone.OpenIDConnectConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_OpenIDConnectConfig_To_kubeone_OpenIDConnectConfig(a.(*OpenIDConnectConfig), b.(*kubeone.OpenIDConnectConfig), scope)
+	if err := s.AddGeneratedConversionFunc((*LocalStorage)(nil), (*kubeone.LocalStorage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_LocalStorage_To_kubeone_LocalStorage(a.(*LocalStorage), b.(*kubeone.LocalStorage), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*kubeone.OpenIDConnectConfig)(nil), (*OpenIDConnectConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_kubeone_OpenIDConnectConfig_To_v1beta2_OpenIDConnectConfig(a.(*kubeone.OpenIDConnectConfig), b.(*OpenIDConnectConfig), scope)
+	if err := s.AddGeneratedConversionFunc((*kubeone.LocalStorage)(nil), (*LocalStorage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_LocalStorage_To_v1beta2_LocalStorage(a.(*kubeone.LocalStorage), b.(*LocalStorage), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*OpenstackSpec)(nil), (*kubeone.OpenstackSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_OpenstackSpec_To_kubeone_OpenstackSpec(a.(*OpenstackSpec), b.(*kubeone.OpenstackSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*LoggingConfig)(nil), (*kubeone.LoggingConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_LoggingConfig_To_kubeone_LoggingConfig(a.(*LoggingConfig), b.(*kubeone.LoggingConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*kubeone.OpenstackSpec)(nil), (*OpenstackSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_kubeone_OpenstackSpec_To_v1beta2_OpenstackSpec(a.(*kubeone.OpenstackSpec), b.(*OpenstackSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*kubeone.LoggingConfig)(nil), (*LoggingConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_LoggingConfig_To_v1beta2_LoggingConfig(a.(*kubeone.LoggingConfig), b.(*LoggingConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*OperatingSystemManagerConfig)(nil), (*kubeone.OperatingSystemManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_OperatingSystemManagerConfig_To_kubeone_OperatingSystemManagerConfig(a.(*OperatingSystemManagerConfig), b.(*kubeone.OperatingSystemManagerConfig), scope)
+	if err := s.AddGeneratedConversionFunc((*MachineControllerConfig)(nil), (*kubeone.MachineControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_MachineControllerConfig_To_kubeone_MachineControllerConfig(a.(*MachineControllerConfig), b.(*kubeone.MachineControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.MachineControllerConfig)(nil), (*MachineControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_MachineControllerConfig_To_v1beta2_MachineControllerConfig(a.(*kubeone.MachineControllerConfig), b.(*MachineControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineDeploymentUpgradeStrategy)(nil), (*kubeone.MachineDeploymentUpgradeStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_MachineDeploymentUpgradeStrategy_To_kubeone_MachineDeploymentUpgradeStrategy(a.(*MachineDeploymentUpgradeStrategy), b.(*kubeone.MachineDeploymentUpgradeStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.MachineDeploymentUpgradeStrategy)(nil), (*MachineDeploymentUpgradeStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_MachineDeploymentUpgradeStrategy_To_v1beta2_MachineDeploymentUpgradeStrategy(a.(*kubeone.MachineDeploymentUpgradeStrategy), b.(*MachineDeploymentUpgradeStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceWindow)(nil), (*kubeone.MaintenanceWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_MaintenanceWindow_To_kubeone_MaintenanceWindow(a.(*MaintenanceWindow), b.(*kubeone.MaintenanceWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.MaintenanceWindow)(nil), (*MaintenanceWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_MaintenanceWindow_To_v1beta2_MaintenanceWindow(a.(*kubeone.MaintenanceWindow), b.(*MaintenanceWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MetalLB)(nil), (*kubeone.MetalLB)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_MetalLB_To_kubeone_MetalLB(a.(*MetalLB), b.(*kubeone.MetalLB), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.MetalLB)(nil), (*MetalLB)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_MetalLB_To_v1beta2_MetalLB(a.(*kubeone.MetalLB), b.(*MetalLB), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MetalLBAddressPool)(nil), (*kubeone.MetalLBAddressPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_MetalLBAddressPool_To_kubeone_MetalLBAddressPool(a.(*MetalLBAddressPool), b.(*kubeone.MetalLBAddressPool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.MetalLBAddressPool)(nil), (*MetalLBAddressPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_MetalLBAddressPool_To_v1beta2_MetalLBAddressPool(a.(*kubeone.MetalLBAddressPool), b.(*MetalLBAddressPool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MetalLBBGPPeer)(nil), (*kubeone.MetalLBBGPPeer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_MetalLBBGPPeer_To_kubeone_MetalLBBGPPeer(a.(*MetalLBBGPPeer), b.(*kubeone.MetalLBBGPPeer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.MetalLBBGPPeer)(nil), (*MetalLBBGPPeer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_MetalLBBGPPeer_To_v1beta2_MetalLBBGPPeer(a.(*kubeone.MetalLBBGPPeer), b.(*MetalLBBGPPeer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MetricsServer)(nil), (*kubeone.MetricsServer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_MetricsServer_To_kubeone_MetricsServer(a.(*MetricsServer), b.(*kubeone.MetricsServer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.MetricsServer)(nil), (*MetricsServer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_MetricsServer_To_v1beta2_MetricsServer(a.(*kubeone.MetricsServer), b.(*MetricsServer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NFTables)(nil), (*kubeone.NFTables)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_NFTables_To_kubeone_NFTables(a.(*NFTables), b.(*kubeone.NFTables), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.NFTables)(nil), (*NFTables)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_NFTables_To_v1beta2_NFTables(a.(*kubeone.NFTables), b.(*NFTables), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NodeLocalDNS)(nil), (*kubeone.NodeLocalDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_NodeLocalDNS_To_kubeone_NodeLocalDNS(a.(*NodeLocalDNS), b.(*kubeone.NodeLocalDNS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.NodeLocalDNS)(nil), (*NodeLocalDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_NodeLocalDNS_To_v1beta2_NodeLocalDNS(a.(*kubeone.NodeLocalDNS), b.(*NodeLocalDNS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NodeSwap)(nil), (*kubeone.NodeSwap)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_NodeSwap_To_kubeone_NodeSwap(a.(*NodeSwap), b.(*kubeone.NodeSwap), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.NodeSwap)(nil), (*NodeSwap)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_NodeSwap_To_v1beta2_NodeSwap(a.(*kubeone.NodeSwap), b.(*NodeSwap), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NoneSpec)(nil), (*kubeone.NoneSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_NoneSpec_To_kubeone_NoneSpec(a.(*NoneSpec), b.(*kubeone.NoneSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.NoneSpec)(nil), (*NoneSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_NoneSpec_To_v1beta2_NoneSpec(a.(*kubeone.NoneSpec), b.(*NoneSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NutanixSpec)(nil), (*kubeone.NutanixSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_NutanixSpec_To_kubeone_NutanixSpec(a.(*NutanixSpec), b.(*kubeone.NutanixSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.NutanixSpec)(nil), (*NutanixSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_NutanixSpec_To_v1beta2_NutanixSpec(a.(*kubeone.NutanixSpec), b.(*NutanixSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NvidiaGPU)(nil), (*kubeone.NvidiaGPU)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_NvidiaGPU_To_kubeone_NvidiaGPU(a.(*NvidiaGPU), b.(*kubeone.NvidiaGPU), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.NvidiaGPU)(nil), (*NvidiaGPU)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_NvidiaGPU_To_v1beta2_NvidiaGPU(a.(*kubeone.NvidiaGPU), b.(*NvidiaGPU), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OCISpec)(nil), (*kubeone.OCISpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_OCISpec_To_kubeone_OCISpec(a.(*OCISpec), b.(*kubeone.OCISpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.OCISpec)(nil), (*OCISpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_OCISpec_To_v1beta2_OCISpec(a.(*kubeone.OCISpec), b.(*OCISpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OpenIDConnect)(nil), (*kubeone.OpenIDConnect)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_OpenIDConnect_To_kubeone_OpenIDConnect(a.(*OpenIDConnect), b.(*kubeone.OpenIDConnect), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.OpenIDConnect)(nil), (*OpenIDConnect)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_OpenIDConnect_To_v1beta2_OpenIDConnect(a.(*kubeone.OpenIDConnect), b.(*OpenIDConnect), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OpenIDConnectConfig)(nil), (*kubeone.OpenIDConnectConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_OpenIDConnectConfig_To_kubeone_OpenIDConnectConfig(a.(*OpenIDConnectConfig), b.(*kubeone.OpenIDConnectConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.OpenIDConnectConfig)(nil), (*OpenIDConnectConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_OpenIDConnectConfig_To_v1beta2_OpenIDConnectConfig(a.(*kubeone.OpenIDConnectConfig), b.(*OpenIDConnectConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OpenstackSpec)(nil), (*kubeone.OpenstackSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_OpenstackSpec_To_kubeone_OpenstackSpec(a.(*OpenstackSpec), b.(*kubeone.OpenstackSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.OpenstackSpec)(nil), (*OpenstackSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_OpenstackSpec_To_v1beta2_OpenstackSpec(a.(*kubeone.OpenstackSpec), b.(*OpenstackSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OperatingSystemManagerConfig)(nil), (*kubeone.OperatingSystemManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_OperatingSystemManagerConfig_To_kubeone_OperatingSystemManagerConfig(a.(*OperatingSystemManagerConfig), b.(*kubeone.OperatingSystemManagerConfig), scope)
 	}); err != nil {
 		return err
 	}
@@ -513,6 +1005,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*OperatingSystemUpgradeConfig)(nil), (*kubeone.OperatingSystemUpgradeConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_OperatingSystemUpgradeConfig_To_kubeone_OperatingSystemUpgradeConfig(a.(*OperatingSystemUpgradeConfig), b.(*kubeone.OperatingSystemUpgradeConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.OperatingSystemUpgradeConfig)(nil), (*OperatingSystemUpgradeConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_OperatingSystemUpgradeConfig_To_v1beta2_OperatingSystemUpgradeConfig(a.(*kubeone.OperatingSystemUpgradeConfig), b.(*OperatingSystemUpgradeConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PackageRepositories)(nil), (*kubeone.PackageRepositories)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_PackageRepositories_To_kubeone_PackageRepositories(a.(*PackageRepositories), b.(*kubeone.PackageRepositories), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.PackageRepositories)(nil), (*PackageRepositories)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_PackageRepositories_To_v1beta2_PackageRepositories(a.(*kubeone.PackageRepositories), b.(*PackageRepositories), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PackageRepository)(nil), (*kubeone.PackageRepository)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_PackageRepository_To_kubeone_PackageRepository(a.(*PackageRepository), b.(*kubeone.PackageRepository), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.PackageRepository)(nil), (*PackageRepository)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_PackageRepository_To_v1beta2_PackageRepository(a.(*kubeone.PackageRepository), b.(*PackageRepository), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PodNodeSelector)(nil), (*kubeone.PodNodeSelector)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_PodNodeSelector_To_kubeone_PodNodeSelector(a.(*PodNodeSelector), b.(*kubeone.PodNodeSelector), scope)
 	}); err != nil {
@@ -563,6 +1085,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProxmoxSpec)(nil), (*kubeone.ProxmoxSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ProxmoxSpec_To_kubeone_ProxmoxSpec(a.(*ProxmoxSpec), b.(*kubeone.ProxmoxSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.ProxmoxSpec)(nil), (*ProxmoxSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_ProxmoxSpec_To_v1beta2_ProxmoxSpec(a.(*kubeone.ProxmoxSpec), b.(*ProxmoxSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProxyConfig)(nil), (*kubeone.ProxyConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_ProxyConfig_To_kubeone_ProxyConfig(a.(*ProxyConfig), b.(*kubeone.ProxyConfig), scope)
 	}); err != nil {
@@ -583,6 +1115,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeccompDefault)(nil), (*kubeone.SeccompDefault)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_SeccompDefault_To_kubeone_SeccompDefault(a.(*SeccompDefault), b.(*kubeone.SeccompDefault), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.SeccompDefault)(nil), (*SeccompDefault)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_SeccompDefault_To_v1beta2_SeccompDefault(a.(*kubeone.SeccompDefault), b.(*SeccompDefault), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*StaticAuditLog)(nil), (*kubeone.StaticAuditLog)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_StaticAuditLog_To_kubeone_StaticAuditLog(a.(*StaticAuditLog), b.(*kubeone.StaticAuditLog), scope)
 	}); err != nil {
@@ -613,6 +1155,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*StaticWorkersUpgradeConfig)(nil), (*kubeone.StaticWorkersUpgradeConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_StaticWorkersUpgradeConfig_To_kubeone_StaticWorkersUpgradeConfig(a.(*StaticWorkersUpgradeConfig), b.(*kubeone.StaticWorkersUpgradeConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.StaticWorkersUpgradeConfig)(nil), (*StaticWorkersUpgradeConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_StaticWorkersUpgradeConfig_To_v1beta2_StaticWorkersUpgradeConfig(a.(*kubeone.StaticWorkersUpgradeConfig), b.(*StaticWorkersUpgradeConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SystemPackages)(nil), (*kubeone.SystemPackages)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_SystemPackages_To_kubeone_SystemPackages(a.(*SystemPackages), b.(*kubeone.SystemPackages), scope)
 	}); err != nil {
@@ -623,6 +1175,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TopoLVMProvisioner)(nil), (*kubeone.TopoLVMProvisioner)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_TopoLVMProvisioner_To_kubeone_TopoLVMProvisioner(a.(*TopoLVMProvisioner), b.(*kubeone.TopoLVMProvisioner), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.TopoLVMProvisioner)(nil), (*TopoLVMProvisioner)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_TopoLVMProvisioner_To_v1beta2_TopoLVMProvisioner(a.(*kubeone.TopoLVMProvisioner), b.(*TopoLVMProvisioner), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*UnattendedUpgradesConfig)(nil), (*kubeone.UnattendedUpgradesConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_UnattendedUpgradesConfig_To_kubeone_UnattendedUpgradesConfig(a.(*UnattendedUpgradesConfig), b.(*kubeone.UnattendedUpgradesConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.UnattendedUpgradesConfig)(nil), (*UnattendedUpgradesConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_UnattendedUpgradesConfig_To_v1beta2_UnattendedUpgradesConfig(a.(*kubeone.UnattendedUpgradesConfig), b.(*UnattendedUpgradesConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*UpgradesConfig)(nil), (*kubeone.UpgradesConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_UpgradesConfig_To_kubeone_UpgradesConfig(a.(*UpgradesConfig), b.(*kubeone.UpgradesConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.UpgradesConfig)(nil), (*UpgradesConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_UpgradesConfig_To_v1beta2_UpgradesConfig(a.(*kubeone.UpgradesConfig), b.(*UpgradesConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*VMwareCloudDirectorSpec)(nil), (*kubeone.VMwareCloudDirectorSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_VMwareCloudDirectorSpec_To_kubeone_VMwareCloudDirectorSpec(a.(*VMwareCloudDirectorSpec), b.(*kubeone.VMwareCloudDirectorSpec), scope)
 	}); err != nil {
@@ -633,6 +1215,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*VaultCertificateAuthoritySource)(nil), (*kubeone.VaultCertificateAuthoritySource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_VaultCertificateAuthoritySource_To_kubeone_VaultCertificateAuthoritySource(a.(*VaultCertificateAuthoritySource), b.(*kubeone.VaultCertificateAuthoritySource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.VaultCertificateAuthoritySource)(nil), (*VaultCertificateAuthoritySource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_VaultCertificateAuthoritySource_To_v1beta2_VaultCertificateAuthoritySource(a.(*kubeone.VaultCertificateAuthoritySource), b.(*VaultCertificateAuthoritySource), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*VersionConfig)(nil), (*kubeone.VersionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_VersionConfig_To_kubeone_VersionConfig(a.(*VersionConfig), b.(*kubeone.VersionConfig), scope)
 	}); err != nil {
@@ -643,6 +1235,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*VsphereFileVolumesSpec)(nil), (*kubeone.VsphereFileVolumesSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_VsphereFileVolumesSpec_To_kubeone_VsphereFileVolumesSpec(a.(*VsphereFileVolumesSpec), b.(*kubeone.VsphereFileVolumesSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.VsphereFileVolumesSpec)(nil), (*VsphereFileVolumesSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_VsphereFileVolumesSpec_To_v1beta2_VsphereFileVolumesSpec(a.(*kubeone.VsphereFileVolumesSpec), b.(*VsphereFileVolumesSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VsphereNetPermission)(nil), (*kubeone.VsphereNetPermission)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_VsphereNetPermission_To_kubeone_VsphereNetPermission(a.(*VsphereNetPermission), b.(*kubeone.VsphereNetPermission), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kubeone.VsphereNetPermission)(nil), (*VsphereNetPermission)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kubeone_VsphereNetPermission_To_v1beta2_VsphereNetPermission(a.(*kubeone.VsphereNetPermission), b.(*VsphereNetPermission), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*VsphereSpec)(nil), (*kubeone.VsphereSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_VsphereSpec_To_kubeone_VsphereSpec(a.(*VsphereSpec), b.(*kubeone.VsphereSpec), scope)
 	}); err != nil {
@@ -675,6 +1287,7 @@ func autoConvert_v1beta2_APIEndpoint_To_kubeone_APIEndpoint(in *APIEndpoint, out
 	out.Host = in.Host
 	out.Port = in.Port
 	out.AlternativeNames = *(*[]string)(unsafe.Pointer(&in.AlternativeNames))
+	out.AdditionalEndpoints = *(*[]kubeone.AdditionalAPIEndpoint)(unsafe.Pointer(&in.AdditionalEndpoints))
 	return nil
 }
 
@@ -687,6 +1300,7 @@ func autoConvert_kubeone_APIEndpoint_To_v1beta2_APIEndpoint(in *kubeone.APIEndpo
 	out.Host = in.Host
 	out.Port = in.Port
 	out.AlternativeNames = *(*[]string)(unsafe.Pointer(&in.AlternativeNames))
+	out.AdditionalEndpoints = *(*[]AdditionalAPIEndpoint)(unsafe.Pointer(&in.AdditionalEndpoints))
 	return nil
 }
 
@@ -696,6 +1310,7 @@ func Convert_kubeone_APIEndpoint_To_v1beta2_APIEndpoint(in *kubeone.APIEndpoint,
 }
 
 func autoConvert_v1beta2_AWSSpec_To_kubeone_AWSSpec(in *AWSSpec, out *kubeone.AWSSpec, s conversion.Scope) error {
+	out.CredentialsMode = kubeone.AWSCredentialsMode(in.CredentialsMode)
 	return nil
 }
 
@@ -705,6 +1320,7 @@ func Convert_v1beta2_AWSSpec_To_kubeone_AWSSpec(in *AWSSpec, out *kubeone.AWSSpe
 }
 
 func autoConvert_kubeone_AWSSpec_To_v1beta2_AWSSpec(in *kubeone.AWSSpec, out *AWSSpec, s conversion.Scope) error {
+	out.CredentialsMode = AWSCredentialsMode(in.CredentialsMode)
 	return nil
 }
 
@@ -713,11 +1329,38 @@ func Convert_kubeone_AWSSpec_To_v1beta2_AWSSpec(in *kubeone.AWSSpec, out *AWSSpe
 	return autoConvert_kubeone_AWSSpec_To_v1beta2_AWSSpec(in, out, s)
 }
 
+func autoConvert_v1beta2_AdditionalAPIEndpoint_To_kubeone_AdditionalAPIEndpoint(in *AdditionalAPIEndpoint, out *kubeone.AdditionalAPIEndpoint, s conversion.Scope) error {
+	out.Host = in.Host
+	out.Port = in.Port
+	out.Priority = in.Priority
+	return nil
+}
+
+// Convert_v1beta2_AdditionalAPIEndpoint_To_kubeone_AdditionalAPIEndpoint is an autogenerated conversion function.
+func Convert_v1beta2_AdditionalAPIEndpoint_To_kubeone_AdditionalAPIEndpoint(in *AdditionalAPIEndpoint, out *kubeone.AdditionalAPIEndpoint, s conversion.Scope) error {
+	return autoConvert_v1beta2_AdditionalAPIEndpoint_To_kubeone_AdditionalAPIEndpoint(in, out, s)
+}
+
+func autoConvert_kubeone_AdditionalAPIEndpoint_To_v1beta2_AdditionalAPIEndpoint(in *kubeone.AdditionalAPIEndpoint, out *AdditionalAPIEndpoint, s conversion.Scope) error {
+	out.Host = in.Host
+	out.Port = in.Port
+	out.Priority = in.Priority
+	return nil
+}
+
+// Convert_kubeone_AdditionalAPIEndpoint_To_v1beta2_AdditionalAPIEndpoint is an autogenerated conversion function.
+func Convert_kubeone_AdditionalAPIEndpoint_To_v1beta2_AdditionalAPIEndpoint(in *kubeone.AdditionalAPIEndpoint, out *AdditionalAPIEndpoint, s conversion.Scope) error {
+	return autoConvert_kubeone_AdditionalAPIEndpoint_To_v1beta2_AdditionalAPIEndpoint(in, out, s)
+}
+
 func autoConvert_v1beta2_Addon_To_kubeone_Addon(in *Addon, out *kubeone.Addon, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Params = *(*map[string]string)(unsafe.Pointer(&in.Params))
 	out.DisableTemplating = in.DisableTemplating
 	out.Delete = in.Delete
+	out.DependsOn = *(*[]string)(unsafe.Pointer(&in.DependsOn))
+	out.ReadinessChecks = *(*[]kubeone.AddonReadinessCheck)(unsafe.Pointer(&in.ReadinessChecks))
+	out.Phase = kubeone.AddonPhase(in.Phase)
 	return nil
 }
 
@@ -731,6 +1374,9 @@ func autoConvert_kubeone_Addon_To_v1beta2_Addon(in *kubeone.Addon, out *Addon, s
 	out.Params = *(*map[string]string)(unsafe.Pointer(&in.Params))
 	out.DisableTemplating = in.DisableTemplating
 	out.Delete = in.Delete
+	out.DependsOn = *(*[]string)(unsafe.Pointer(&in.DependsOn))
+	out.ReadinessChecks = *(*[]AddonReadinessCheck)(unsafe.Pointer(&in.ReadinessChecks))
+	out.Phase = AddonPhase(in.Phase)
 	return nil
 }
 
@@ -739,9 +1385,35 @@ func Convert_kubeone_Addon_To_v1beta2_Addon(in *kubeone.Addon, out *Addon, s con
 	return autoConvert_kubeone_Addon_To_v1beta2_Addon(in, out, s)
 }
 
+func autoConvert_v1beta2_AddonReadinessCheck_To_kubeone_AddonReadinessCheck(in *AddonReadinessCheck, out *kubeone.AddonReadinessCheck, s conversion.Scope) error {
+	out.Kind = kubeone.AddonReadinessCheckKind(in.Kind)
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	return nil
+}
+
+// Convert_v1beta2_AddonReadinessCheck_To_kubeone_AddonReadinessCheck is an autogenerated conversion function.
+func Convert_v1beta2_AddonReadinessCheck_To_kubeone_AddonReadinessCheck(in *AddonReadinessCheck, out *kubeone.AddonReadinessCheck, s conversion.Scope) error {
+	return autoConvert_v1beta2_AddonReadinessCheck_To_kubeone_AddonReadinessCheck(in, out, s)
+}
+
+func autoConvert_kubeone_AddonReadinessCheck_To_v1beta2_AddonReadinessCheck(in *kubeone.AddonReadinessCheck, out *AddonReadinessCheck, s conversion.Scope) error {
+	out.Kind = AddonReadinessCheckKind(in.Kind)
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	return nil
+}
+
+// Convert_kubeone_AddonReadinessCheck_To_v1beta2_AddonReadinessCheck is an autogenerated conversion function.
+func Convert_kubeone_AddonReadinessCheck_To_v1beta2_AddonReadinessCheck(in *kubeone.AddonReadinessCheck, out *AddonReadinessCheck, s conversion.Scope) error {
+	return autoConvert_kubeone_AddonReadinessCheck_To_v1beta2_AddonReadinessCheck(in, out, s)
+}
+
 func autoConvert_v1beta2_Addons_To_kubeone_Addons(in *Addons, out *kubeone.Addons, s conversion.Scope) error {
 	out.Enable = in.Enable
 	out.Path = in.Path
+	out.Source = (*kubeone.AddonsSource)(unsafe.Pointer(in.Source))
+	out.Verification = (*kubeone.AddonsVerification)(unsafe.Pointer(in.Verification))
 	out.GlobalParams = *(*map[string]string)(unsafe.Pointer(&in.GlobalParams))
 	out.Addons = *(*[]kubeone.Addon)(unsafe.Pointer(&in.Addons))
 	return nil
@@ -755,6 +1427,8 @@ func Convert_v1beta2_Addons_To_kubeone_Addons(in *Addons, out *kubeone.Addons, s
 func autoConvert_kubeone_Addons_To_v1beta2_Addons(in *kubeone.Addons, out *Addons, s conversion.Scope) error {
 	out.Enable = in.Enable
 	out.Path = in.Path
+	out.Source = (*AddonsSource)(unsafe.Pointer(in.Source))
+	out.Verification = (*AddonsVerification)(unsafe.Pointer(in.Verification))
 	out.GlobalParams = *(*map[string]string)(unsafe.Pointer(&in.GlobalParams))
 	out.Addons = *(*[]Addon)(unsafe.Pointer(&in.Addons))
 	return nil
@@ -765,7 +1439,109 @@ func Convert_kubeone_Addons_To_v1beta2_Addons(in *kubeone.Addons, out *Addons, s
 	return autoConvert_kubeone_Addons_To_v1beta2_Addons(in, out, s)
 }
 
+func autoConvert_v1beta2_AddonsGitSource_To_kubeone_AddonsGitSource(in *AddonsGitSource, out *kubeone.AddonsGitSource, s conversion.Scope) error {
+	out.URL = in.URL
+	out.Ref = in.Ref
+	out.Path = in.Path
+	return nil
+}
+
+// Convert_v1beta2_AddonsGitSource_To_kubeone_AddonsGitSource is an autogenerated conversion function.
+func Convert_v1beta2_AddonsGitSource_To_kubeone_AddonsGitSource(in *AddonsGitSource, out *kubeone.AddonsGitSource, s conversion.Scope) error {
+	return autoConvert_v1beta2_AddonsGitSource_To_kubeone_AddonsGitSource(in, out, s)
+}
+
+func autoConvert_kubeone_AddonsGitSource_To_v1beta2_AddonsGitSource(in *kubeone.AddonsGitSource, out *AddonsGitSource, s conversion.Scope) error {
+	out.URL = in.URL
+	out.Ref = in.Ref
+	out.Path = in.Path
+	return nil
+}
+
+// Convert_kubeone_AddonsGitSource_To_v1beta2_AddonsGitSource is an autogenerated conversion function.
+func Convert_kubeone_AddonsGitSource_To_v1beta2_AddonsGitSource(in *kubeone.AddonsGitSource, out *AddonsGitSource, s conversion.Scope) error {
+	return autoConvert_kubeone_AddonsGitSource_To_v1beta2_AddonsGitSource(in, out, s)
+}
+
+func autoConvert_v1beta2_AddonsHTTPSource_To_kubeone_AddonsHTTPSource(in *AddonsHTTPSource, out *kubeone.AddonsHTTPSource, s conversion.Scope) error {
+	out.URL = in.URL
+	out.SHA256 = in.SHA256
+	out.Path = in.Path
+	out.BundleURL = in.BundleURL
+	return nil
+}
+
+// Convert_v1beta2_AddonsHTTPSource_To_kubeone_AddonsHTTPSource is an autogenerated conversion function.
+func Convert_v1beta2_AddonsHTTPSource_To_kubeone_AddonsHTTPSource(in *AddonsHTTPSource, out *kubeone.AddonsHTTPSource, s conversion.Scope) error {
+	return autoConvert_v1beta2_AddonsHTTPSource_To_kubeone_AddonsHTTPSource(in, out, s)
+}
+
+func autoConvert_kubeone_AddonsHTTPSource_To_v1beta2_AddonsHTTPSource(in *kubeone.AddonsHTTPSource, out *AddonsHTTPSource, s conversion.Scope) error {
+	out.URL = in.URL
+	out.SHA256 = in.SHA256
+	out.Path = in.Path
+	out.BundleURL = in.BundleURL
+	return nil
+}
+
+// Convert_kubeone_AddonsHTTPSource_To_v1beta2_AddonsHTTPSource is an autogenerated conversion function.
+func Convert_kubeone_AddonsHTTPSource_To_v1beta2_AddonsHTTPSource(in *kubeone.AddonsHTTPSource, out *AddonsHTTPSource, s conversion.Scope) error {
+	return autoConvert_kubeone_AddonsHTTPSource_To_v1beta2_AddonsHTTPSource(in, out, s)
+}
+
+func autoConvert_v1beta2_AddonsSource_To_kubeone_AddonsSource(in *AddonsSource, out *kubeone.AddonsSource, s conversion.Scope) error {
+	out.Git = (*kubeone.AddonsGitSource)(unsafe.Pointer(in.Git))
+	out.HTTP = (*kubeone.AddonsHTTPSource)(unsafe.Pointer(in.HTTP))
+	return nil
+}
+
+// Convert_v1beta2_AddonsSource_To_kubeone_AddonsSource is an autogenerated conversion function.
+func Convert_v1beta2_AddonsSource_To_kubeone_AddonsSource(in *AddonsSource, out *kubeone.AddonsSource, s conversion.Scope) error {
+	return autoConvert_v1beta2_AddonsSource_To_kubeone_AddonsSource(in, out, s)
+}
+
+func autoConvert_kubeone_AddonsSource_To_v1beta2_AddonsSource(in *kubeone.AddonsSource, out *AddonsSource, s conversion.Scope) error {
+	out.Git = (*AddonsGitSource)(unsafe.Pointer(in.Git))
+	out.HTTP = (*AddonsHTTPSource)(unsafe.Pointer(in.HTTP))
+	return nil
+}
+
+// Convert_kubeone_AddonsSource_To_v1beta2_AddonsSource is an autogenerated conversion function.
+func Convert_kubeone_AddonsSource_To_v1beta2_AddonsSource(in *kubeone.AddonsSource, out *AddonsSource, s conversion.Scope) error {
+	return autoConvert_kubeone_AddonsSource_To_v1beta2_AddonsSource(in, out, s)
+}
+
+func autoConvert_v1beta2_AddonsVerification_To_kubeone_AddonsVerification(in *AddonsVerification, out *kubeone.AddonsVerification, s conversion.Scope) error {
+	out.PublicKey = in.PublicKey
+	out.CertificateIdentity = in.CertificateIdentity
+	out.CertificateOIDCIssuer = in.CertificateOIDCIssuer
+	out.Images = in.Images
+	return nil
+}
+
+// Convert_v1beta2_AddonsVerification_To_kubeone_AddonsVerification is an autogenerated conversion function.
+func Convert_v1beta2_AddonsVerification_To_kubeone_AddonsVerification(in *AddonsVerification, out *kubeone.AddonsVerification, s conversion.Scope) error {
+	return autoConvert_v1beta2_AddonsVerification_To_kubeone_AddonsVerification(in, out, s)
+}
+
+func autoConvert_kubeone_AddonsVerification_To_v1beta2_AddonsVerification(in *kubeone.AddonsVerification, out *AddonsVerification, s conversion.Scope) error {
+	out.PublicKey = in.PublicKey
+	out.CertificateIdentity = in.CertificateIdentity
+	out.CertificateOIDCIssuer = in.CertificateOIDCIssuer
+	out.Images = in.Images
+	return nil
+}
+
+// Convert_kubeone_AddonsVerification_To_v1beta2_AddonsVerification is an autogenerated conversion function.
+func Convert_kubeone_AddonsVerification_To_v1beta2_AddonsVerification(in *kubeone.AddonsVerification, out *AddonsVerification, s conversion.Scope) error {
+	return autoConvert_kubeone_AddonsVerification_To_v1beta2_AddonsVerification(in, out, s)
+}
+
 func autoConvert_v1beta2_AzureSpec_To_kubeone_AzureSpec(in *AzureSpec, out *kubeone.AzureSpec, s conversion.Scope) error {
+	out.CredentialsMode = kubeone.AzureCredentialsMode(in.CredentialsMode)
+	out.UserAssignedIdentityID = in.UserAssignedIdentityID
+	out.VMType = kubeone.AzureVMType(in.VMType)
+	out.AvailabilityZones = in.AvailabilityZones
 	return nil
 }
 
@@ -775,6 +1551,10 @@ func Convert_v1beta2_AzureSpec_To_kubeone_AzureSpec(in *AzureSpec, out *kubeone.
 }
 
 func autoConvert_kubeone_AzureSpec_To_v1beta2_AzureSpec(in *kubeone.AzureSpec, out *AzureSpec, s conversion.Scope) error {
+	out.CredentialsMode = AzureCredentialsMode(in.CredentialsMode)
+	out.UserAssignedIdentityID = in.UserAssignedIdentityID
+	out.VMType = AzureVMType(in.VMType)
+	out.AvailabilityZones = in.AvailabilityZones
 	return nil
 }
 
@@ -783,6 +1563,134 @@ func Convert_kubeone_AzureSpec_To_v1beta2_AzureSpec(in *kubeone.AzureSpec, out *
 	return autoConvert_kubeone_AzureSpec_To_v1beta2_AzureSpec(in, out, s)
 }
 
+func autoConvert_v1beta2_BGPConfig_To_kubeone_BGPConfig(in *BGPConfig, out *kubeone.BGPConfig, s conversion.Scope) error {
+	out.ASN = in.ASN
+	out.Peers = *(*[]kubeone.BGPPeer)(unsafe.Pointer(&in.Peers))
+	out.RouteReflectors = (*kubeone.BGPRouteReflectors)(unsafe.Pointer(in.RouteReflectors))
+	out.AdvertisedCIDRs = *(*[]string)(unsafe.Pointer(&in.AdvertisedCIDRs))
+	return nil
+}
+
+// Convert_v1beta2_BGPConfig_To_kubeone_BGPConfig is an autogenerated conversion function.
+func Convert_v1beta2_BGPConfig_To_kubeone_BGPConfig(in *BGPConfig, out *kubeone.BGPConfig, s conversion.Scope) error {
+	return autoConvert_v1beta2_BGPConfig_To_kubeone_BGPConfig(in, out, s)
+}
+
+func autoConvert_kubeone_BGPConfig_To_v1beta2_BGPConfig(in *kubeone.BGPConfig, out *BGPConfig, s conversion.Scope) error {
+	out.ASN = in.ASN
+	out.Peers = *(*[]BGPPeer)(unsafe.Pointer(&in.Peers))
+	out.RouteReflectors = (*BGPRouteReflectors)(unsafe.Pointer(in.RouteReflectors))
+	out.AdvertisedCIDRs = *(*[]string)(unsafe.Pointer(&in.AdvertisedCIDRs))
+	return nil
+}
+
+// Convert_kubeone_BGPConfig_To_v1beta2_BGPConfig is an autogenerated conversion function.
+func Convert_kubeone_BGPConfig_To_v1beta2_BGPConfig(in *kubeone.BGPConfig, out *BGPConfig, s conversion.Scope) error {
+	return autoConvert_kubeone_BGPConfig_To_v1beta2_BGPConfig(in, out, s)
+}
+
+func autoConvert_v1beta2_BGPPeer_To_kubeone_BGPPeer(in *BGPPeer, out *kubeone.BGPPeer, s conversion.Scope) error {
+	out.PeerAddress = in.PeerAddress
+	out.PeerASN = in.PeerASN
+	out.PeerPort = in.PeerPort
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
+	return nil
+}
+
+// Convert_v1beta2_BGPPeer_To_kubeone_BGPPeer is an autogenerated conversion function.
+func Convert_v1beta2_BGPPeer_To_kubeone_BGPPeer(in *BGPPeer, out *kubeone.BGPPeer, s conversion.Scope) error {
+	return autoConvert_v1beta2_BGPPeer_To_kubeone_BGPPeer(in, out, s)
+}
+
+func autoConvert_kubeone_BGPPeer_To_v1beta2_BGPPeer(in *kubeone.BGPPeer, out *BGPPeer, s conversion.Scope) error {
+	out.PeerAddress = in.PeerAddress
+	out.PeerASN = in.PeerASN
+	out.PeerPort = in.PeerPort
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
+	return nil
+}
+
+// Convert_kubeone_BGPPeer_To_v1beta2_BGPPeer is an autogenerated conversion function.
+func Convert_kubeone_BGPPeer_To_v1beta2_BGPPeer(in *kubeone.BGPPeer, out *BGPPeer, s conversion.Scope) error {
+	return autoConvert_kubeone_BGPPeer_To_v1beta2_BGPPeer(in, out, s)
+}
+
+func autoConvert_v1beta2_BGPRouteReflectors_To_kubeone_BGPRouteReflectors(in *BGPRouteReflectors, out *kubeone.BGPRouteReflectors, s conversion.Scope) error {
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
+	out.ClusterID = in.ClusterID
+	return nil
+}
+
+// Convert_v1beta2_BGPRouteReflectors_To_kubeone_BGPRouteReflectors is an autogenerated conversion function.
+func Convert_v1beta2_BGPRouteReflectors_To_kubeone_BGPRouteReflectors(in *BGPRouteReflectors, out *kubeone.BGPRouteReflectors, s conversion.Scope) error {
+	return autoConvert_v1beta2_BGPRouteReflectors_To_kubeone_BGPRouteReflectors(in, out, s)
+}
+
+func autoConvert_kubeone_BGPRouteReflectors_To_v1beta2_BGPRouteReflectors(in *kubeone.BGPRouteReflectors, out *BGPRouteReflectors, s conversion.Scope) error {
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
+	out.ClusterID = in.ClusterID
+	return nil
+}
+
+// Convert_kubeone_BGPRouteReflectors_To_v1beta2_BGPRouteReflectors is an autogenerated conversion function.
+func Convert_kubeone_BGPRouteReflectors_To_v1beta2_BGPRouteReflectors(in *kubeone.BGPRouteReflectors, out *BGPRouteReflectors, s conversion.Scope) error {
+	return autoConvert_kubeone_BGPRouteReflectors_To_v1beta2_BGPRouteReflectors(in, out, s)
+}
+
+func autoConvert_v1beta2_BMCConfig_To_kubeone_BMCConfig(in *BMCConfig, out *kubeone.BMCConfig, s conversion.Scope) error {
+	out.Protocol = kubeone.BMCProtocol(in.Protocol)
+	out.Address = in.Address
+	out.Username = in.Username
+	out.Password = in.Password
+	out.InsecureSkipTLSVerify = in.InsecureSkipTLSVerify
+	out.SystemID = in.SystemID
+	out.BootDevice = kubeone.BMCBootDevice(in.BootDevice)
+	out.ImageURL = in.ImageURL
+	return nil
+}
+
+// Convert_v1beta2_BMCConfig_To_kubeone_BMCConfig is an autogenerated conversion function.
+func Convert_v1beta2_BMCConfig_To_kubeone_BMCConfig(in *BMCConfig, out *kubeone.BMCConfig, s conversion.Scope) error {
+	return autoConvert_v1beta2_BMCConfig_To_kubeone_BMCConfig(in, out, s)
+}
+
+func autoConvert_kubeone_BMCConfig_To_v1beta2_BMCConfig(in *kubeone.BMCConfig, out *BMCConfig, s conversion.Scope) error {
+	out.Protocol = BMCProtocol(in.Protocol)
+	out.Address = in.Address
+	out.Username = in.Username
+	out.Password = in.Password
+	out.InsecureSkipTLSVerify = in.InsecureSkipTLSVerify
+	out.SystemID = in.SystemID
+	out.BootDevice = BMCBootDevice(in.BootDevice)
+	out.ImageURL = in.ImageURL
+	return nil
+}
+
+// Convert_kubeone_BMCConfig_To_v1beta2_BMCConfig is an autogenerated conversion function.
+func Convert_kubeone_BMCConfig_To_v1beta2_BMCConfig(in *kubeone.BMCConfig, out *BMCConfig, s conversion.Scope) error {
+	return autoConvert_kubeone_BMCConfig_To_v1beta2_BMCConfig(in, out, s)
+}
+
+func autoConvert_v1beta2_BackupsConfig_To_kubeone_BackupsConfig(in *BackupsConfig, out *kubeone.BackupsConfig, s conversion.Scope) error {
+	out.Etcd = (*kubeone.EtcdBackupsConfig)(unsafe.Pointer(in.Etcd))
+	return nil
+}
+
+// Convert_v1beta2_BackupsConfig_To_kubeone_BackupsConfig is an autogenerated conversion function.
+func Convert_v1beta2_BackupsConfig_To_kubeone_BackupsConfig(in *BackupsConfig, out *kubeone.BackupsConfig, s conversion.Scope) error {
+	return autoConvert_v1beta2_BackupsConfig_To_kubeone_BackupsConfig(in, out, s)
+}
+
+func autoConvert_kubeone_BackupsConfig_To_v1beta2_BackupsConfig(in *kubeone.BackupsConfig, out *BackupsConfig, s conversion.Scope) error {
+	out.Etcd = (*EtcdBackupsConfig)(unsafe.Pointer(in.Etcd))
+	return nil
+}
+
+// Convert_kubeone_BackupsConfig_To_v1beta2_BackupsConfig is an autogenerated conversion function.
+func Convert_kubeone_BackupsConfig_To_v1beta2_BackupsConfig(in *kubeone.BackupsConfig, out *BackupsConfig, s conversion.Scope) error {
+	return autoConvert_kubeone_BackupsConfig_To_v1beta2_BackupsConfig(in, out, s)
+}
+
 func autoConvert_v1beta2_BinaryAsset_To_kubeone_BinaryAsset(in *BinaryAsset, out *kubeone.BinaryAsset, s conversion.Scope) error {
 	out.URL = in.URL
 	return nil
@@ -806,8 +1714,10 @@ func Convert_kubeone_BinaryAsset_To_v1beta2_BinaryAsset(in *kubeone.BinaryAsset,
 func autoConvert_v1beta2_CNI_To_kubeone_CNI(in *CNI, out *kubeone.CNI, s conversion.Scope) error {
 	out.Canal = (*kubeone.CanalSpec)(unsafe.Pointer(in.Canal))
 	out.Cilium = (*kubeone.CiliumSpec)(unsafe.Pointer(in.Cilium))
+	out.Calico = (*kubeone.CalicoSpec)(unsafe.Pointer(in.Calico))
 	out.WeaveNet = (*kubeone.WeaveNetSpec)(unsafe.Pointer(in.WeaveNet))
 	out.External = (*kubeone.ExternalCNISpec)(unsafe.Pointer(in.External))
+	out.EnableBandwidthPlugin = (*bool)(unsafe.Pointer(in.EnableBandwidthPlugin))
 	return nil
 }
 
@@ -819,8 +1729,10 @@ func Convert_v1beta2_CNI_To_kubeone_CNI(in *CNI, out *kubeone.CNI, s conversion.
 func autoConvert_kubeone_CNI_To_v1beta2_CNI(in *kubeone.CNI, out *CNI, s conversion.Scope) error {
 	out.Canal = (*CanalSpec)(unsafe.Pointer(in.Canal))
 	out.Cilium = (*CiliumSpec)(unsafe.Pointer(in.Cilium))
+	out.Calico = (*CalicoSpec)(unsafe.Pointer(in.Calico))
 	out.WeaveNet = (*WeaveNetSpec)(unsafe.Pointer(in.WeaveNet))
 	out.External = (*ExternalCNISpec)(unsafe.Pointer(in.External))
+	out.EnableBandwidthPlugin = (*bool)(unsafe.Pointer(in.EnableBandwidthPlugin))
 	return nil
 }
 
@@ -829,6 +1741,34 @@ func Convert_kubeone_CNI_To_v1beta2_CNI(in *kubeone.CNI, out *CNI, s conversion.
 	return autoConvert_kubeone_CNI_To_v1beta2_CNI(in, out, s)
 }
 
+func autoConvert_v1beta2_CalicoSpec_To_kubeone_CalicoSpec(in *CalicoSpec, out *kubeone.CalicoSpec, s conversion.Scope) error {
+	out.EncapsulationMode = kubeone.CalicoEncapsulationMode(in.EncapsulationMode)
+	out.CrossSubnet = in.CrossSubnet
+	out.EnableTypha = in.EnableTypha
+	out.MTU = in.MTU
+	out.EnableWireGuard = in.EnableWireGuard
+	return nil
+}
+
+// Convert_v1beta2_CalicoSpec_To_kubeone_CalicoSpec is an autogenerated conversion function.
+func Convert_v1beta2_CalicoSpec_To_kubeone_CalicoSpec(in *CalicoSpec, out *kubeone.CalicoSpec, s conversion.Scope) error {
+	return autoConvert_v1beta2_CalicoSpec_To_kubeone_CalicoSpec(in, out, s)
+}
+
+func autoConvert_kubeone_CalicoSpec_To_v1beta2_CalicoSpec(in *kubeone.CalicoSpec, out *CalicoSpec, s conversion.Scope) error {
+	out.EncapsulationMode = CalicoEncapsulationMode(in.EncapsulationMode)
+	out.CrossSubnet = in.CrossSubnet
+	out.EnableTypha = in.EnableTypha
+	out.MTU = in.MTU
+	out.EnableWireGuard = in.EnableWireGuard
+	return nil
+}
+
+// Convert_kubeone_CalicoSpec_To_v1beta2_CalicoSpec is an autogenerated conversion function.
+func Convert_kubeone_CalicoSpec_To_v1beta2_CalicoSpec(in *kubeone.CalicoSpec, out *CalicoSpec, s conversion.Scope) error {
+	return autoConvert_kubeone_CalicoSpec_To_v1beta2_CalicoSpec(in, out, s)
+}
+
 func autoConvert_v1beta2_CanalSpec_To_kubeone_CanalSpec(in *CanalSpec, out *kubeone.CanalSpec, s conversion.Scope) error {
 	out.MTU = in.MTU
 	return nil
@@ -849,9 +1789,109 @@ func Convert_kubeone_CanalSpec_To_v1beta2_CanalSpec(in *kubeone.CanalSpec, out *
 	return autoConvert_kubeone_CanalSpec_To_v1beta2_CanalSpec(in, out, s)
 }
 
+func autoConvert_v1beta2_CanaryUpgradeConfig_To_kubeone_CanaryUpgradeConfig(in *CanaryUpgradeConfig, out *kubeone.CanaryUpgradeConfig, s conversion.Scope) error {
+	out.Enable = in.Enable
+	out.SoakTime = (*metav1.Duration)(unsafe.Pointer(in.SoakTime))
+	return nil
+}
+
+// Convert_v1beta2_CanaryUpgradeConfig_To_kubeone_CanaryUpgradeConfig is an autogenerated conversion function.
+func Convert_v1beta2_CanaryUpgradeConfig_To_kubeone_CanaryUpgradeConfig(in *CanaryUpgradeConfig, out *kubeone.CanaryUpgradeConfig, s conversion.Scope) error {
+	return autoConvert_v1beta2_CanaryUpgradeConfig_To_kubeone_CanaryUpgradeConfig(in, out, s)
+}
+
+func autoConvert_kubeone_CanaryUpgradeConfig_To_v1beta2_CanaryUpgradeConfig(in *kubeone.CanaryUpgradeConfig, out *CanaryUpgradeConfig, s conversion.Scope) error {
+	out.Enable = in.Enable
+	out.SoakTime = (*metav1.Duration)(unsafe.Pointer(in.SoakTime))
+	return nil
+}
+
+// Convert_kubeone_CanaryUpgradeConfig_To_v1beta2_CanaryUpgradeConfig is an autogenerated conversion function.
+func Convert_kubeone_CanaryUpgradeConfig_To_v1beta2_CanaryUpgradeConfig(in *kubeone.CanaryUpgradeConfig, out *CanaryUpgradeConfig, s conversion.Scope) error {
+	return autoConvert_kubeone_CanaryUpgradeConfig_To_v1beta2_CanaryUpgradeConfig(in, out, s)
+}
+
+func autoConvert_v1beta2_CertificateAuthorityConfig_To_kubeone_CertificateAuthorityConfig(in *CertificateAuthorityConfig, out *kubeone.CertificateAuthorityConfig, s conversion.Scope) error {
+	out.Kubernetes = (*kubeone.CertificateAuthoritySource)(unsafe.Pointer(in.Kubernetes))
+	out.Etcd = (*kubeone.CertificateAuthoritySource)(unsafe.Pointer(in.Etcd))
+	out.FrontProxy = (*kubeone.CertificateAuthoritySource)(unsafe.Pointer(in.FrontProxy))
+	return nil
+}
+
+// Convert_v1beta2_CertificateAuthorityConfig_To_kubeone_CertificateAuthorityConfig is an autogenerated conversion function.
+func Convert_v1beta2_CertificateAuthorityConfig_To_kubeone_CertificateAuthorityConfig(in *CertificateAuthorityConfig, out *kubeone.CertificateAuthorityConfig, s conversion.Scope) error {
+	return autoConvert_v1beta2_CertificateAuthorityConfig_To_kubeone_CertificateAuthorityConfig(in, out, s)
+}
+
+func autoConvert_kubeone_CertificateAuthorityConfig_To_v1beta2_CertificateAuthorityConfig(in *kubeone.CertificateAuthorityConfig, out *CertificateAuthorityConfig, s conversion.Scope) error {
+	out.Kubernetes = (*CertificateAuthoritySource)(unsafe.Pointer(in.Kubernetes))
+	out.Etcd = (*CertificateAuthoritySource)(unsafe.Pointer(in.Etcd))
+	out.FrontProxy = (*CertificateAuthoritySource)(unsafe.Pointer(in.FrontProxy))
+	return nil
+}
+
+// Convert_kubeone_CertificateAuthorityConfig_To_v1beta2_CertificateAuthorityConfig is an autogenerated conversion function.
+func Convert_kubeone_CertificateAuthorityConfig_To_v1beta2_CertificateAuthorityConfig(in *kubeone.CertificateAuthorityConfig, out *CertificateAuthorityConfig, s conversion.Scope) error {
+	return autoConvert_kubeone_CertificateAuthorityConfig_To_v1beta2_CertificateAuthorityConfig(in, out, s)
+}
+
+func autoConvert_v1beta2_CertificateAuthoritySource_To_kubeone_CertificateAuthoritySource(in *CertificateAuthoritySource, out *kubeone.CertificateAuthoritySource, s conversion.Scope) error {
+	out.CertificateFile = in.CertificateFile
+	out.KeyFile = in.KeyFile
+	out.Vault = (*kubeone.VaultCertificateAuthoritySource)(unsafe.Pointer(in.Vault))
+	return nil
+}
+
+// Convert_v1beta2_CertificateAuthoritySource_To_kubeone_CertificateAuthoritySource is an autogenerated conversion function.
+func Convert_v1beta2_CertificateAuthoritySource_To_kubeone_CertificateAuthoritySource(in *CertificateAuthoritySource, out *kubeone.CertificateAuthoritySource, s conversion.Scope) error {
+	return autoConvert_v1beta2_CertificateAuthoritySource_To_kubeone_CertificateAuthoritySource(in, out, s)
+}
+
+func autoConvert_kubeone_CertificateAuthoritySource_To_v1beta2_CertificateAuthoritySource(in *kubeone.CertificateAuthoritySource, out *CertificateAuthoritySource, s conversion.Scope) error {
+	out.CertificateFile = in.CertificateFile
+	out.KeyFile = in.KeyFile
+	out.Vault = (*VaultCertificateAuthoritySource)(unsafe.Pointer(in.Vault))
+	return nil
+}
+
+// Convert_kubeone_CertificateAuthoritySource_To_v1beta2_CertificateAuthoritySource is an autogenerated conversion function.
+func Convert_kubeone_CertificateAuthoritySource_To_v1beta2_CertificateAuthoritySource(in *kubeone.CertificateAuthoritySource, out *CertificateAuthoritySource, s conversion.Scope) error {
+	return autoConvert_kubeone_CertificateAuthoritySource_To_v1beta2_CertificateAuthoritySource(in, out, s)
+}
+
+func autoConvert_v1beta2_CgroupsConfig_To_kubeone_CgroupsConfig(in *CgroupsConfig, out *kubeone.CgroupsConfig, s conversion.Scope) error {
+	out.Driver = kubeone.CgroupDriver(in.Driver)
+	out.Version = kubeone.CgroupVersion(in.Version)
+	return nil
+}
+
+// Convert_v1beta2_CgroupsConfig_To_kubeone_CgroupsConfig is an autogenerated conversion function.
+func Convert_v1beta2_CgroupsConfig_To_kubeone_CgroupsConfig(in *CgroupsConfig, out *kubeone.CgroupsConfig, s conversion.Scope) error {
+	return autoConvert_v1beta2_CgroupsConfig_To_kubeone_CgroupsConfig(in, out, s)
+}
+
+func autoConvert_kubeone_CgroupsConfig_To_v1beta2_CgroupsConfig(in *kubeone.CgroupsConfig, out *CgroupsConfig, s conversion.Scope) error {
+	out.Driver = CgroupDriver(in.Driver)
+	out.Version = CgroupVersion(in.Version)
+	return nil
+}
+
+// Convert_kubeone_CgroupsConfig_To_v1beta2_CgroupsConfig is an autogenerated conversion function.
+func Convert_kubeone_CgroupsConfig_To_v1beta2_CgroupsConfig(in *kubeone.CgroupsConfig, out *CgroupsConfig, s conversion.Scope) error {
+	return autoConvert_kubeone_CgroupsConfig_To_v1beta2_CgroupsConfig(in, out, s)
+}
+
 func autoConvert_v1beta2_CiliumSpec_To_kubeone_CiliumSpec(in *CiliumSpec, out *kubeone.CiliumSpec, s conversion.Scope) error {
 	out.KubeProxyReplacement = kubeone.KubeProxyReplacementType(in.KubeProxyReplacement)
 	out.EnableHubble = in.EnableHubble
+	out.DisableHubbleUI = in.DisableHubbleUI
+	out.RoutingMode = kubeone.CiliumRoutingMode(in.RoutingMode)
+	out.TunnelProtocol = kubeone.CiliumTunnelProtocol(in.TunnelProtocol)
+	out.AutoDirectNodeRoutes = in.AutoDirectNodeRoutes
+	out.IPAM = kubeone.CiliumIPAMMode(in.IPAM)
+	out.MTU = in.MTU
+	out.Encryption = kubeone.CiliumEncryption(in.Encryption)
+	out.HostRouting = kubeone.CiliumHostRouting(in.HostRouting)
 	return nil
 }
 
@@ -863,6 +1903,14 @@ func Convert_v1beta2_CiliumSpec_To_kubeone_CiliumSpec(in *CiliumSpec, out *kubeo
 func autoConvert_kubeone_CiliumSpec_To_v1beta2_CiliumSpec(in *kubeone.CiliumSpec, out *CiliumSpec, s conversion.Scope) error {
 	out.KubeProxyReplacement = KubeProxyReplacementType(in.KubeProxyReplacement)
 	out.EnableHubble = in.EnableHubble
+	out.DisableHubbleUI = in.DisableHubbleUI
+	out.RoutingMode = CiliumRoutingMode(in.RoutingMode)
+	out.TunnelProtocol = CiliumTunnelProtocol(in.TunnelProtocol)
+	out.AutoDirectNodeRoutes = in.AutoDirectNodeRoutes
+	out.IPAM = CiliumIPAMMode(in.IPAM)
+	out.MTU = in.MTU
+	out.Encryption = CiliumEncryption(in.Encryption)
+	out.HostRouting = CiliumHostRouting(in.HostRouting)
 	return nil
 }
 
@@ -871,19 +1919,47 @@ func Convert_kubeone_CiliumSpec_To_v1beta2_CiliumSpec(in *kubeone.CiliumSpec, ou
 	return autoConvert_kubeone_CiliumSpec_To_v1beta2_CiliumSpec(in, out, s)
 }
 
+func autoConvert_v1beta2_CloudControllerManagerConfig_To_kubeone_CloudControllerManagerConfig(in *CloudControllerManagerConfig, out *kubeone.CloudControllerManagerConfig, s conversion.Scope) error {
+	out.ImageRepository = in.ImageRepository
+	out.ImageTag = in.ImageTag
+	out.Flags = *(*map[string]string)(unsafe.Pointer(&in.Flags))
+	return nil
+}
+
+// Convert_v1beta2_CloudControllerManagerConfig_To_kubeone_CloudControllerManagerConfig is an autogenerated conversion function.
+func Convert_v1beta2_CloudControllerManagerConfig_To_kubeone_CloudControllerManagerConfig(in *CloudControllerManagerConfig, out *kubeone.CloudControllerManagerConfig, s conversion.Scope) error {
+	return autoConvert_v1beta2_CloudControllerManagerConfig_To_kubeone_CloudControllerManagerConfig(in, out, s)
+}
+
+func autoConvert_kubeone_CloudControllerManagerConfig_To_v1beta2_CloudControllerManagerConfig(in *kubeone.CloudControllerManagerConfig, out *CloudControllerManagerConfig, s conversion.Scope) error {
+	out.ImageRepository = in.ImageRepository
+	out.ImageTag = in.ImageTag
+	out.Flags = *(*map[string]string)(unsafe.Pointer(&in.Flags))
+	return nil
+}
+
+// Convert_kubeone_CloudControllerManagerConfig_To_v1beta2_CloudControllerManagerConfig is an autogenerated conversion function.
+func Convert_kubeone_CloudControllerManagerConfig_To_v1beta2_CloudControllerManagerConfig(in *kubeone.CloudControllerManagerConfig, out *CloudControllerManagerConfig, s conversion.Scope) error {
+	return autoConvert_kubeone_CloudControllerManagerConfig_To_v1beta2_CloudControllerManagerConfig(in, out, s)
+}
+
 func autoConvert_v1beta2_CloudProviderSpec_To_kubeone_CloudProviderSpec(in *CloudProviderSpec, out *kubeone.CloudProviderSpec, s conversion.Scope) error {
 	out.External = in.External
 	out.DisableBundledCSIDrivers = in.DisableBundledCSIDrivers
 	out.CloudConfig = in.CloudConfig
 	out.CSIConfig = in.CSIConfig
 	out.SecretProviderClassName = in.SecretProviderClassName
+	out.CloudControllerManager = (*kubeone.CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
 	out.AWS = (*kubeone.AWSSpec)(unsafe.Pointer(in.AWS))
 	out.Azure = (*kubeone.AzureSpec)(unsafe.Pointer(in.Azure))
 	out.DigitalOcean = (*kubeone.DigitalOceanSpec)(unsafe.Pointer(in.DigitalOcean))
 	out.GCE = (*kubeone.GCESpec)(unsafe.Pointer(in.GCE))
 	out.Hetzner = (*kubeone.HetznerSpec)(unsafe.Pointer(in.Hetzner))
+	out.Kubevirt = (*kubeone.KubevirtSpec)(unsafe.Pointer(in.Kubevirt))
 	out.Nutanix = (*kubeone.NutanixSpec)(unsafe.Pointer(in.Nutanix))
+	out.OCI = (*kubeone.OCISpec)(unsafe.Pointer(in.OCI))
 	out.Openstack = (*kubeone.OpenstackSpec)(unsafe.Pointer(in.Openstack))
+	out.Proxmox = (*kubeone.ProxmoxSpec)(unsafe.Pointer(in.Proxmox))
 	out.EquinixMetal = (*kubeone.EquinixMetalSpec)(unsafe.Pointer(in.EquinixMetal))
 	out.VMwareCloudDirector = (*kubeone.VMwareCloudDirectorSpec)(unsafe.Pointer(in.VMwareCloudDirector))
 	out.Vsphere = (*kubeone.VsphereSpec)(unsafe.Pointer(in.Vsphere))
@@ -902,13 +1978,17 @@ func autoConvert_kubeone_CloudProviderSpec_To_v1beta2_CloudProviderSpec(in *kube
 	out.CloudConfig = in.CloudConfig
 	out.CSIConfig = in.CSIConfig
 	out.SecretProviderClassName = in.SecretProviderClassName
+	out.CloudControllerManager = (*CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
 	out.AWS = (*AWSSpec)(unsafe.Pointer(in.AWS))
 	out.Azure = (*AzureSpec)(unsafe.Pointer(in.Azure))
 	out.DigitalOcean = (*DigitalOceanSpec)(unsafe.Pointer(in.DigitalOcean))
 	out.GCE = (*GCESpec)(unsafe.Pointer(in.GCE))
 	out.Hetzner = (*HetznerSpec)(unsafe.Pointer(in.Hetzner))
+	out.Kubevirt = (*KubevirtSpec)(unsafe.Pointer(in.Kubevirt))
 	out.Nutanix = (*NutanixSpec)(unsafe.Pointer(in.Nutanix))
+	out.OCI = (*OCISpec)(unsafe.Pointer(in.OCI))
 	out.Openstack = (*OpenstackSpec)(unsafe.Pointer(in.Openstack))
+	out.Proxmox = (*ProxmoxSpec)(unsafe.Pointer(in.Proxmox))
 	out.EquinixMetal = (*EquinixMetalSpec)(unsafe.Pointer(in.EquinixMetal))
 	out.VMwareCloudDirector = (*VMwareCloudDirectorSpec)(unsafe.Pointer(in.VMwareCloudDirector))
 	out.Vsphere = (*VsphereSpec)(unsafe.Pointer(in.Vsphere))
@@ -921,6 +2001,80 @@ func Convert_kubeone_CloudProviderSpec_To_v1beta2_CloudProviderSpec(in *kubeone.
 	return autoConvert_kubeone_CloudProviderSpec_To_v1beta2_CloudProviderSpec(in, out, s)
 }
 
+func autoConvert_v1beta2_ClusterAutoscaler_To_kubeone_ClusterAutoscaler(in *ClusterAutoscaler, out *kubeone.ClusterAutoscaler, s conversion.Scope) error {
+	out.Enable = in.Enable
+	out.NodeGroups = *(*[]kubeone.ClusterAutoscalerNodeGroup)(unsafe.Pointer(&in.NodeGroups))
+	out.SkipNodesWithLocalStorage = (*bool)(unsafe.Pointer(in.SkipNodesWithLocalStorage))
+	out.ScaleDownUnneededTime = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownUnneededTime))
+	out.Expander = in.Expander
+	return nil
+}
+
+// Convert_v1beta2_ClusterAutoscaler_To_kubeone_ClusterAutoscaler is an autogenerated conversion function.
+func Convert_v1beta2_ClusterAutoscaler_To_kubeone_ClusterAutoscaler(in *ClusterAutoscaler, out *kubeone.ClusterAutoscaler, s conversion.Scope) error {
+	return autoConvert_v1beta2_ClusterAutoscaler_To_kubeone_ClusterAutoscaler(in, out, s)
+}
+
+func autoConvert_kubeone_ClusterAutoscaler_To_v1beta2_ClusterAutoscaler(in *kubeone.ClusterAutoscaler, out *ClusterAutoscaler, s conversion.Scope) error {
+	out.Enable = in.Enable
+	out.NodeGroups = *(*[]ClusterAutoscalerNodeGroup)(unsafe.Pointer(&in.NodeGroups))
+	out.SkipNodesWithLocalStorage = (*bool)(unsafe.Pointer(in.SkipNodesWithLocalStorage))
+	out.ScaleDownUnneededTime = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownUnneededTime))
+	out.Expander = in.Expander
+	return nil
+}
+
+// Convert_kubeone_ClusterAutoscaler_To_v1beta2_ClusterAutoscaler is an autogenerated conversion function.
+func Convert_kubeone_ClusterAutoscaler_To_v1beta2_ClusterAutoscaler(in *kubeone.ClusterAutoscaler, out *ClusterAutoscaler, s conversion.Scope) error {
+	return autoConvert_kubeone_ClusterAutoscaler_To_v1beta2_ClusterAutoscaler(in, out, s)
+}
+
+func autoConvert_v1beta2_ClusterAutoscalerNodeGroup_To_kubeone_ClusterAutoscalerNodeGroup(in *ClusterAutoscalerNodeGroup, out *kubeone.ClusterAutoscalerNodeGroup, s conversion.Scope) error {
+	out.MachineDeployment = in.MachineDeployment
+	out.MinReplicas = in.MinReplicas
+	out.MaxReplicas = in.MaxReplicas
+	return nil
+}
+
+// Convert_v1beta2_ClusterAutoscalerNodeGroup_To_kubeone_ClusterAutoscalerNodeGroup is an autogenerated conversion function.
+func Convert_v1beta2_ClusterAutoscalerNodeGroup_To_kubeone_ClusterAutoscalerNodeGroup(in *ClusterAutoscalerNodeGroup, out *kubeone.ClusterAutoscalerNodeGroup, s conversion.Scope) error {
+	return autoConvert_v1beta2_ClusterAutoscalerNodeGroup_To_kubeone_ClusterAutoscalerNodeGroup(in, out, s)
+}
+
+func autoConvert_kubeone_ClusterAutoscalerNodeGroup_To_v1beta2_ClusterAutoscalerNodeGroup(in *kubeone.ClusterAutoscalerNodeGroup, out *ClusterAutoscalerNodeGroup, s conversion.Scope) error {
+	out.MachineDeployment = in.MachineDeployment
+	out.MinReplicas = in.MinReplicas
+	out.MaxReplicas = in.MaxReplicas
+	return nil
+}
+
+// Convert_kubeone_ClusterAutoscalerNodeGroup_To_v1beta2_ClusterAutoscalerNodeGroup is an autogenerated conversion function.
+func Convert_kubeone_ClusterAutoscalerNodeGroup_To_v1beta2_ClusterAutoscalerNodeGroup(in *kubeone.ClusterAutoscalerNodeGroup, out *ClusterAutoscalerNodeGroup, s conversion.Scope) error {
+	return autoConvert_kubeone_ClusterAutoscalerNodeGroup_To_v1beta2_ClusterAutoscalerNodeGroup(in, out, s)
+}
+
+func autoConvert_v1beta2_ClusterDNSConfig_To_kubeone_ClusterDNSConfig(in *ClusterDNSConfig, out *kubeone.ClusterDNSConfig, s conversion.Scope) error {
+	out.UpstreamServers = *(*[]string)(unsafe.Pointer(&in.UpstreamServers))
+	out.SearchDomains = *(*[]string)(unsafe.Pointer(&in.SearchDomains))
+	return nil
+}
+
+// Convert_v1beta2_ClusterDNSConfig_To_kubeone_ClusterDNSConfig is an autogenerated conversion function.
+func Convert_v1beta2_ClusterDNSConfig_To_kubeone_ClusterDNSConfig(in *ClusterDNSConfig, out *kubeone.ClusterDNSConfig, s conversion.Scope) error {
+	return autoConvert_v1beta2_ClusterDNSConfig_To_kubeone_ClusterDNSConfig(in, out, s)
+}
+
+func autoConvert_kubeone_ClusterDNSConfig_To_v1beta2_ClusterDNSConfig(in *kubeone.ClusterDNSConfig, out *ClusterDNSConfig, s conversion.Scope) error {
+	out.UpstreamServers = *(*[]string)(unsafe.Pointer(&in.UpstreamServers))
+	out.SearchDomains = *(*[]string)(unsafe.Pointer(&in.SearchDomains))
+	return nil
+}
+
+// Convert_kubeone_ClusterDNSConfig_To_v1beta2_ClusterDNSConfig is an autogenerated conversion function.
+func Convert_kubeone_ClusterDNSConfig_To_v1beta2_ClusterDNSConfig(in *kubeone.ClusterDNSConfig, out *ClusterDNSConfig, s conversion.Scope) error {
+	return autoConvert_kubeone_ClusterDNSConfig_To_v1beta2_ClusterDNSConfig(in, out, s)
+}
+
 func autoConvert_v1beta2_ClusterNetworkConfig_To_kubeone_ClusterNetworkConfig(in *ClusterNetworkConfig, out *kubeone.ClusterNetworkConfig, s conversion.Scope) error {
 	out.PodSubnet = in.PodSubnet
 	out.PodSubnetIPv6 = in.PodSubnetIPv6
@@ -933,6 +2087,10 @@ func autoConvert_v1beta2_ClusterNetworkConfig_To_kubeone_ClusterNetworkConfig(in
 	out.IPFamily = kubeone.IPFamily(in.IPFamily)
 	out.NodeCIDRMaskSizeIPv4 = (*int)(unsafe.Pointer(in.NodeCIDRMaskSizeIPv4))
 	out.NodeCIDRMaskSizeIPv6 = (*int)(unsafe.Pointer(in.NodeCIDRMaskSizeIPv6))
+	out.AllocateNodeCIDRs = (*bool)(unsafe.Pointer(in.AllocateNodeCIDRs))
+	out.EgressGateways = *(*[]kubeone.EgressGateway)(unsafe.Pointer(&in.EgressGateways))
+	out.DNS = (*kubeone.ClusterDNSConfig)(unsafe.Pointer(in.DNS))
+	out.BGP = (*kubeone.BGPConfig)(unsafe.Pointer(in.BGP))
 	return nil
 }
 
@@ -953,6 +2111,10 @@ func autoConvert_kubeone_ClusterNetworkConfig_To_v1beta2_ClusterNetworkConfig(in
 	out.IPFamily = IPFamily(in.IPFamily)
 	out.NodeCIDRMaskSizeIPv4 = (*int)(unsafe.Pointer(in.NodeCIDRMaskSizeIPv4))
 	out.NodeCIDRMaskSizeIPv6 = (*int)(unsafe.Pointer(in.NodeCIDRMaskSizeIPv6))
+	out.AllocateNodeCIDRs = (*bool)(unsafe.Pointer(in.AllocateNodeCIDRs))
+	out.EgressGateways = *(*[]EgressGateway)(unsafe.Pointer(&in.EgressGateways))
+	out.DNS = (*ClusterDNSConfig)(unsafe.Pointer(in.DNS))
+	out.BGP = (*BGPConfig)(unsafe.Pointer(in.BGP))
 	return nil
 }
 
@@ -964,6 +2126,7 @@ func Convert_kubeone_ClusterNetworkConfig_To_v1beta2_ClusterNetworkConfig(in *ku
 func autoConvert_v1beta2_ContainerRuntimeConfig_To_kubeone_ContainerRuntimeConfig(in *ContainerRuntimeConfig, out *kubeone.ContainerRuntimeConfig, s conversion.Scope) error {
 	out.Docker = (*kubeone.ContainerRuntimeDocker)(unsafe.Pointer(in.Docker))
 	out.Containerd = (*kubeone.ContainerRuntimeContainerd)(unsafe.Pointer(in.Containerd))
+	out.SandboxImage = in.SandboxImage
 	return nil
 }
 
@@ -975,6 +2138,7 @@ func Convert_v1beta2_ContainerRuntimeConfig_To_kubeone_ContainerRuntimeConfig(in
 func autoConvert_kubeone_ContainerRuntimeConfig_To_v1beta2_ContainerRuntimeConfig(in *kubeone.ContainerRuntimeConfig, out *ContainerRuntimeConfig, s conversion.Scope) error {
 	out.Docker = (*ContainerRuntimeDocker)(unsafe.Pointer(in.Docker))
 	out.Containerd = (*ContainerRuntimeContainerd)(unsafe.Pointer(in.Containerd))
+	out.SandboxImage = in.SandboxImage
 	return nil
 }
 
@@ -985,6 +2149,7 @@ func Convert_kubeone_ContainerRuntimeConfig_To_v1beta2_ContainerRuntimeConfig(in
 
 func autoConvert_v1beta2_ContainerRuntimeContainerd_To_kubeone_ContainerRuntimeContainerd(in *ContainerRuntimeContainerd, out *kubeone.ContainerRuntimeContainerd, s conversion.Scope) error {
 	out.Registries = *(*map[string]kubeone.ContainerdRegistry)(unsafe.Pointer(&in.Registries))
+	out.Version = in.Version
 	return nil
 }
 
@@ -995,6 +2160,7 @@ func Convert_v1beta2_ContainerRuntimeContainerd_To_kubeone_ContainerRuntimeConta
 
 func autoConvert_kubeone_ContainerRuntimeContainerd_To_v1beta2_ContainerRuntimeContainerd(in *kubeone.ContainerRuntimeContainerd, out *ContainerRuntimeContainerd, s conversion.Scope) error {
 	out.Registries = *(*map[string]ContainerdRegistry)(unsafe.Pointer(&in.Registries))
+	out.Version = in.Version
 	return nil
 }
 
@@ -1093,8 +2259,60 @@ func Convert_kubeone_ContainerdTLSConfig_To_v1beta2_ContainerdTLSConfig(in *kube
 	return autoConvert_kubeone_ContainerdTLSConfig_To_v1beta2_ContainerdTLSConfig(in, out, s)
 }
 
+func autoConvert_v1beta2_ControlPlaneComponentConfig_To_kubeone_ControlPlaneComponentConfig(in *ControlPlaneComponentConfig, out *kubeone.ControlPlaneComponentConfig, s conversion.Scope) error {
+	out.Flags = *(*map[string]string)(unsafe.Pointer(&in.Flags))
+	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.ExtraVolumes = *(*[]kubeone.HostPathMount)(unsafe.Pointer(&in.ExtraVolumes))
+	return nil
+}
+
+// Convert_v1beta2_ControlPlaneComponentConfig_To_kubeone_ControlPlaneComponentConfig is an autogenerated conversion function.
+func Convert_v1beta2_ControlPlaneComponentConfig_To_kubeone_ControlPlaneComponentConfig(in *ControlPlaneComponentConfig, out *kubeone.ControlPlaneComponentConfig, s conversion.Scope) error {
+	return autoConvert_v1beta2_ControlPlaneComponentConfig_To_kubeone_ControlPlaneComponentConfig(in, out, s)
+}
+
+func autoConvert_kubeone_ControlPlaneComponentConfig_To_v1beta2_ControlPlaneComponentConfig(in *kubeone.ControlPlaneComponentConfig, out *ControlPlaneComponentConfig, s conversion.Scope) error {
+	out.Flags = *(*map[string]string)(unsafe.Pointer(&in.Flags))
+	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.ExtraVolumes = *(*[]HostPathMount)(unsafe.Pointer(&in.ExtraVolumes))
+	return nil
+}
+
+// Convert_kubeone_ControlPlaneComponentConfig_To_v1beta2_ControlPlaneComponentConfig is an autogenerated conversion function.
+func Convert_kubeone_ControlPlaneComponentConfig_To_v1beta2_ControlPlaneComponentConfig(in *kubeone.ControlPlaneComponentConfig, out *ControlPlaneComponentConfig, s conversion.Scope) error {
+	return autoConvert_kubeone_ControlPlaneComponentConfig_To_v1beta2_ControlPlaneComponentConfig(in, out, s)
+}
+
+func autoConvert_v1beta2_ControlPlaneComponents_To_kubeone_ControlPlaneComponents(in *ControlPlaneComponents, out *kubeone.ControlPlaneComponents, s conversion.Scope) error {
+	out.ControllerManager = (*kubeone.ControlPlaneComponentConfig)(unsafe.Pointer(in.ControllerManager))
+	out.Scheduler = (*kubeone.ControlPlaneComponentConfig)(unsafe.Pointer(in.Scheduler))
+	out.APIServer = (*kubeone.ControlPlaneComponentConfig)(unsafe.Pointer(in.APIServer))
+	out.Patches = (*kubeone.KubeadmPatches)(unsafe.Pointer(in.Patches))
+	return nil
+}
+
+// Convert_v1beta2_ControlPlaneComponents_To_kubeone_ControlPlaneComponents is an autogenerated conversion function.
+func Convert_v1beta2_ControlPlaneComponents_To_kubeone_ControlPlaneComponents(in *ControlPlaneComponents, out *kubeone.ControlPlaneComponents, s conversion.Scope) error {
+	return autoConvert_v1beta2_ControlPlaneComponents_To_kubeone_ControlPlaneComponents(in, out, s)
+}
+
+func autoConvert_kubeone_ControlPlaneComponents_To_v1beta2_ControlPlaneComponents(in *kubeone.ControlPlaneComponents, out *ControlPlaneComponents, s conversion.Scope) error {
+	out.ControllerManager = (*ControlPlaneComponentConfig)(unsafe.Pointer(in.ControllerManager))
+	out.Scheduler = (*ControlPlaneComponentConfig)(unsafe.Pointer(in.Scheduler))
+	out.APIServer = (*ControlPlaneComponentConfig)(unsafe.Pointer(in.APIServer))
+	out.Patches = (*KubeadmPatches)(unsafe.Pointer(in.Patches))
+	return nil
+}
+
+// Convert_kubeone_ControlPlaneComponents_To_v1beta2_ControlPlaneComponents is an autogenerated conversion function.
+func Convert_kubeone_ControlPlaneComponents_To_v1beta2_ControlPlaneComponents(in *kubeone.ControlPlaneComponents, out *ControlPlaneComponents, s conversion.Scope) error {
+	return autoConvert_kubeone_ControlPlaneComponents_To_v1beta2_ControlPlaneComponents(in, out, s)
+}
+
 func autoConvert_v1beta2_ControlPlaneConfig_To_kubeone_ControlPlaneConfig(in *ControlPlaneConfig, out *kubeone.ControlPlaneConfig, s conversion.Scope) error {
 	out.Hosts = *(*[]kubeone.HostConfig)(unsafe.Pointer(&in.Hosts))
+	out.RequireZoneSpread = in.RequireZoneSpread
+	out.Kernel = (*kubeone.KernelConfig)(unsafe.Pointer(in.Kernel))
 	return nil
 }
 
@@ -1105,6 +2323,8 @@ func Convert_v1beta2_ControlPlaneConfig_To_kubeone_ControlPlaneConfig(in *Contro
 
 func autoConvert_kubeone_ControlPlaneConfig_To_v1beta2_ControlPlaneConfig(in *kubeone.ControlPlaneConfig, out *ControlPlaneConfig, s conversion.Scope) error {
 	out.Hosts = *(*[]HostConfig)(unsafe.Pointer(&in.Hosts))
+	out.RequireZoneSpread = in.RequireZoneSpread
+	out.Kernel = (*KernelConfig)(unsafe.Pointer(in.Kernel))
 	return nil
 }
 
@@ -1157,7 +2377,31 @@ func Convert_kubeone_DNSConfig_To_v1beta2_DNSConfig(in *kubeone.DNSConfig, out *
 	return autoConvert_kubeone_DNSConfig_To_v1beta2_DNSConfig(in, out, s)
 }
 
+func autoConvert_v1beta2_DefaultDenyNetworkPolicy_To_kubeone_DefaultDenyNetworkPolicy(in *DefaultDenyNetworkPolicy, out *kubeone.DefaultDenyNetworkPolicy, s conversion.Scope) error {
+	out.Enable = in.Enable
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	return nil
+}
+
+// Convert_v1beta2_DefaultDenyNetworkPolicy_To_kubeone_DefaultDenyNetworkPolicy is an autogenerated conversion function.
+func Convert_v1beta2_DefaultDenyNetworkPolicy_To_kubeone_DefaultDenyNetworkPolicy(in *DefaultDenyNetworkPolicy, out *kubeone.DefaultDenyNetworkPolicy, s conversion.Scope) error {
+	return autoConvert_v1beta2_DefaultDenyNetworkPolicy_To_kubeone_DefaultDenyNetworkPolicy(in, out, s)
+}
+
+func autoConvert_kubeone_DefaultDenyNetworkPolicy_To_v1beta2_DefaultDenyNetworkPolicy(in *kubeone.DefaultDenyNetworkPolicy, out *DefaultDenyNetworkPolicy, s conversion.Scope) error {
+	out.Enable = in.Enable
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	return nil
+}
+
+// Convert_kubeone_DefaultDenyNetworkPolicy_To_v1beta2_DefaultDenyNetworkPolicy is an autogenerated conversion function.
+func Convert_kubeone_DefaultDenyNetworkPolicy_To_v1beta2_DefaultDenyNetworkPolicy(in *kubeone.DefaultDenyNetworkPolicy, out *DefaultDenyNetworkPolicy, s conversion.Scope) error {
+	return autoConvert_kubeone_DefaultDenyNetworkPolicy_To_v1beta2_DefaultDenyNetworkPolicy(in, out, s)
+}
+
 func autoConvert_v1beta2_DigitalOceanSpec_To_kubeone_DigitalOceanSpec(in *DigitalOceanSpec, out *kubeone.DigitalOceanSpec, s conversion.Scope) error {
+	out.VPCID = in.VPCID
+	out.VPCIPRange = in.VPCIPRange
 	return nil
 }
 
@@ -1167,6 +2411,8 @@ func Convert_v1beta2_DigitalOceanSpec_To_kubeone_DigitalOceanSpec(in *DigitalOce
 }
 
 func autoConvert_kubeone_DigitalOceanSpec_To_v1beta2_DigitalOceanSpec(in *kubeone.DigitalOceanSpec, out *DigitalOceanSpec, s conversion.Scope) error {
+	out.VPCID = in.VPCID
+	out.VPCIPRange = in.VPCIPRange
 	return nil
 }
 
@@ -1175,6 +2421,32 @@ func Convert_kubeone_DigitalOceanSpec_To_v1beta2_DigitalOceanSpec(in *kubeone.Di
 	return autoConvert_kubeone_DigitalOceanSpec_To_v1beta2_DigitalOceanSpec(in, out, s)
 }
 
+func autoConvert_v1beta2_DrainConfig_To_kubeone_DrainConfig(in *DrainConfig, out *kubeone.DrainConfig, s conversion.Scope) error {
+	out.Timeout = (*metav1.Duration)(unsafe.Pointer(in.Timeout))
+	out.ForceEviction = in.ForceEviction
+	out.SkipNamespaces = *(*[]string)(unsafe.Pointer(&in.SkipNamespaces))
+	out.SkipPodSelector = in.SkipPodSelector
+	return nil
+}
+
+// Convert_v1beta2_DrainConfig_To_kubeone_DrainConfig is an autogenerated conversion function.
+func Convert_v1beta2_DrainConfig_To_kubeone_DrainConfig(in *DrainConfig, out *kubeone.DrainConfig, s conversion.Scope) error {
+	return autoConvert_v1beta2_DrainConfig_To_kubeone_DrainConfig(in, out, s)
+}
+
+func autoConvert_kubeone_DrainConfig_To_v1beta2_DrainConfig(in *kubeone.DrainConfig, out *DrainConfig, s conversion.Scope) error {
+	out.Timeout = (*metav1.Duration)(unsafe.Pointer(in.Timeout))
+	out.ForceEviction = in.ForceEviction
+	out.SkipNamespaces = *(*[]string)(unsafe.Pointer(&in.SkipNamespaces))
+	out.SkipPodSelector = in.SkipPodSelector
+	return nil
+}
+
+// Convert_kubeone_DrainConfig_To_v1beta2_DrainConfig is an autogenerated conversion function.
+func Convert_kubeone_DrainConfig_To_v1beta2_DrainConfig(in *kubeone.DrainConfig, out *DrainConfig, s conversion.Scope) error {
+	return autoConvert_kubeone_DrainConfig_To_v1beta2_DrainConfig(in, out, s)
+}
+
 func autoConvert_v1beta2_DynamicAuditLog_To_kubeone_DynamicAuditLog(in *DynamicAuditLog, out *kubeone.DynamicAuditLog, s conversion.Scope) error {
 	out.Enable = in.Enable
 	return nil
@@ -1198,6 +2470,8 @@ func Convert_kubeone_DynamicAuditLog_To_v1beta2_DynamicAuditLog(in *kubeone.Dyna
 func autoConvert_v1beta2_DynamicWorkerConfig_To_kubeone_DynamicWorkerConfig(in *DynamicWorkerConfig, out *kubeone.DynamicWorkerConfig, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Replicas = (*int)(unsafe.Pointer(in.Replicas))
+	out.CloudProvider = in.CloudProvider
+	out.UpgradeStrategy = (*kubeone.MachineDeploymentUpgradeStrategy)(unsafe.Pointer(in.UpgradeStrategy))
 	if err := Convert_v1beta2_ProviderSpec_To_kubeone_ProviderSpec(&in.Config, &out.Config, s); err != nil {
 		return err
 	}
@@ -1212,6 +2486,8 @@ func Convert_v1beta2_DynamicWorkerConfig_To_kubeone_DynamicWorkerConfig(in *Dyna
 func autoConvert_kubeone_DynamicWorkerConfig_To_v1beta2_DynamicWorkerConfig(in *kubeone.DynamicWorkerConfig, out *DynamicWorkerConfig, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Replicas = (*int)(unsafe.Pointer(in.Replicas))
+	out.CloudProvider = in.CloudProvider
+	out.UpgradeStrategy = (*MachineDeploymentUpgradeStrategy)(unsafe.Pointer(in.UpgradeStrategy))
 	if err := Convert_kubeone_ProviderSpec_To_v1beta2_ProviderSpec(&in.Config, &out.Config, s); err != nil {
 		return err
 	}
@@ -1223,6 +2499,40 @@ func Convert_kubeone_DynamicWorkerConfig_To_v1beta2_DynamicWorkerConfig(in *kube
 	return autoConvert_kubeone_DynamicWorkerConfig_To_v1beta2_DynamicWorkerConfig(in, out, s)
 }
 
+func autoConvert_v1beta2_EgressGateway_To_kubeone_EgressGateway(in *EgressGateway, out *kubeone.EgressGateway, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.PodSelector = *(*map[string]string)(unsafe.Pointer(&in.PodSelector))
+	out.DestinationCIDRs = *(*[]string)(unsafe.Pointer(&in.DestinationCIDRs))
+	out.ExcludedCIDRs = *(*[]string)(unsafe.Pointer(&in.ExcludedCIDRs))
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
+	out.EgressIP = in.EgressIP
+	out.Interface = in.Interface
+	return nil
+}
+
+// Convert_v1beta2_EgressGateway_To_kubeone_EgressGateway is an autogenerated conversion function.
+func Convert_v1beta2_EgressGateway_To_kubeone_EgressGateway(in *EgressGateway, out *kubeone.EgressGateway, s conversion.Scope) error {
+	return autoConvert_v1beta2_EgressGateway_To_kubeone_EgressGateway(in, out, s)
+}
+
+func autoConvert_kubeone_EgressGateway_To_v1beta2_EgressGateway(in *kubeone.EgressGateway, out *EgressGateway, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.PodSelector = *(*map[string]string)(unsafe.Pointer(&in.PodSelector))
+	out.DestinationCIDRs = *(*[]string)(unsafe.Pointer(&in.DestinationCIDRs))
+	out.ExcludedCIDRs = *(*[]string)(unsafe.Pointer(&in.ExcludedCIDRs))
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
+	out.EgressIP = in.EgressIP
+	out.Interface = in.Interface
+	return nil
+}
+
+// Convert_kubeone_EgressGateway_To_v1beta2_EgressGateway is an autogenerated conversion function.
+func Convert_kubeone_EgressGateway_To_v1beta2_EgressGateway(in *kubeone.EgressGateway, out *EgressGateway, s conversion.Scope) error {
+	return autoConvert_kubeone_EgressGateway_To_v1beta2_EgressGateway(in, out, s)
+}
+
 func autoConvert_v1beta2_EncryptionProviders_To_kubeone_EncryptionProviders(in *EncryptionProviders, out *kubeone.EncryptionProviders, s conversion.Scope) error {
 	out.Enable = in.Enable
 	out.CustomEncryptionConfiguration = in.CustomEncryptionConfiguration
@@ -1245,7 +2555,28 @@ func Convert_kubeone_EncryptionProviders_To_v1beta2_EncryptionProviders(in *kube
 	return autoConvert_kubeone_EncryptionProviders_To_v1beta2_EncryptionProviders(in, out, s)
 }
 
+func autoConvert_v1beta2_EquinixMetalLoadBalancerSpec_To_kubeone_EquinixMetalLoadBalancerSpec(in *EquinixMetalLoadBalancerSpec, out *kubeone.EquinixMetalLoadBalancerSpec, s conversion.Scope) error {
+	out.LocalASN = in.LocalASN
+	return nil
+}
+
+// Convert_v1beta2_EquinixMetalLoadBalancerSpec_To_kubeone_EquinixMetalLoadBalancerSpec is an autogenerated conversion function.
+func Convert_v1beta2_EquinixMetalLoadBalancerSpec_To_kubeone_EquinixMetalLoadBalancerSpec(in *EquinixMetalLoadBalancerSpec, out *kubeone.EquinixMetalLoadBalancerSpec, s conversion.Scope) error {
+	return autoConvert_v1beta2_EquinixMetalLoadBalancerSpec_To_kubeone_EquinixMetalLoadBalancerSpec(in, out, s)
+}
+
+func autoConvert_kubeone_EquinixMetalLoadBalancerSpec_To_v1beta2_EquinixMetalLoadBalancerSpec(in *kubeone.EquinixMetalLoadBalancerSpec, out *EquinixMetalLoadBalancerSpec, s conversion.Scope) error {
+	out.LocalASN = in.LocalASN
+	return nil
+}
+
+// Convert_kubeone_EquinixMetalLoadBalancerSpec_To_v1beta2_EquinixMetalLoadBalancerSpec is an autogenerated conversion function.
+func Convert_kubeone_EquinixMetalLoadBalancerSpec_To_v1beta2_EquinixMetalLoadBalancerSpec(in *kubeone.EquinixMetalLoadBalancerSpec, out *EquinixMetalLoadBalancerSpec, s conversion.Scope) error {
+	return autoConvert_kubeone_EquinixMetalLoadBalancerSpec_To_v1beta2_EquinixMetalLoadBalancerSpec(in, out, s)
+}
+
 func autoConvert_v1beta2_EquinixMetalSpec_To_kubeone_EquinixMetalSpec(in *EquinixMetalSpec, out *kubeone.EquinixMetalSpec, s conversion.Scope) error {
+	out.LoadBalancer = (*kubeone.EquinixMetalLoadBalancerSpec)(unsafe.Pointer(in.LoadBalancer))
 	return nil
 }
 
@@ -1255,6 +2586,7 @@ func Convert_v1beta2_EquinixMetalSpec_To_kubeone_EquinixMetalSpec(in *EquinixMet
 }
 
 func autoConvert_kubeone_EquinixMetalSpec_To_v1beta2_EquinixMetalSpec(in *kubeone.EquinixMetalSpec, out *EquinixMetalSpec, s conversion.Scope) error {
+	out.LoadBalancer = (*EquinixMetalLoadBalancerSpec)(unsafe.Pointer(in.LoadBalancer))
 	return nil
 }
 
@@ -1263,6 +2595,84 @@ func Convert_kubeone_EquinixMetalSpec_To_v1beta2_EquinixMetalSpec(in *kubeone.Eq
 	return autoConvert_kubeone_EquinixMetalSpec_To_v1beta2_EquinixMetalSpec(in, out, s)
 }
 
+func autoConvert_v1beta2_EtcdBackupsConfig_To_kubeone_EtcdBackupsConfig(in *EtcdBackupsConfig, out *kubeone.EtcdBackupsConfig, s conversion.Scope) error {
+	out.Enable = in.Enable
+	out.Schedule = in.Schedule
+	out.Retention = in.Retention
+	if err := Convert_v1beta2_EtcdBackupsTarget_To_kubeone_EtcdBackupsTarget(&in.Target, &out.Target, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta2_EtcdBackupsConfig_To_kubeone_EtcdBackupsConfig is an autogenerated conversion function.
+func Convert_v1beta2_EtcdBackupsConfig_To_kubeone_EtcdBackupsConfig(in *EtcdBackupsConfig, out *kubeone.EtcdBackupsConfig, s conversion.Scope) error {
+	return autoConvert_v1beta2_EtcdBackupsConfig_To_kubeone_EtcdBackupsConfig(in, out, s)
+}
+
+func autoConvert_kubeone_EtcdBackupsConfig_To_v1beta2_EtcdBackupsConfig(in *kubeone.EtcdBackupsConfig, out *EtcdBackupsConfig, s conversion.Scope) error {
+	out.Enable = in.Enable
+	out.Schedule = in.Schedule
+	out.Retention = in.Retention
+	if err := Convert_kubeone_EtcdBackupsTarget_To_v1beta2_EtcdBackupsTarget(&in.Target, &out.Target, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_kubeone_EtcdBackupsConfig_To_v1beta2_EtcdBackupsConfig is an autogenerated conversion function.
+func Convert_kubeone_EtcdBackupsConfig_To_v1beta2_EtcdBackupsConfig(in *kubeone.EtcdBackupsConfig, out *EtcdBackupsConfig, s conversion.Scope) error {
+	return autoConvert_kubeone_EtcdBackupsConfig_To_v1beta2_EtcdBackupsConfig(in, out, s)
+}
+
+func autoConvert_v1beta2_EtcdBackupsTarget_To_kubeone_EtcdBackupsTarget(in *EtcdBackupsTarget, out *kubeone.EtcdBackupsTarget, s conversion.Scope) error {
+	out.Endpoint = in.Endpoint
+	out.Bucket = in.Bucket
+	out.Prefix = in.Prefix
+	out.Region = in.Region
+	return nil
+}
+
+// Convert_v1beta2_EtcdBackupsTarget_To_kubeone_EtcdBackupsTarget is an autogenerated conversion function.
+func Convert_v1beta2_EtcdBackupsTarget_To_kubeone_EtcdBackupsTarget(in *EtcdBackupsTarget, out *kubeone.EtcdBackupsTarget, s conversion.Scope) error {
+	return autoConvert_v1beta2_EtcdBackupsTarget_To_kubeone_EtcdBackupsTarget(in, out, s)
+}
+
+func autoConvert_kubeone_EtcdBackupsTarget_To_v1beta2_EtcdBackupsTarget(in *kubeone.EtcdBackupsTarget, out *EtcdBackupsTarget, s conversion.Scope) error {
+	out.Endpoint = in.Endpoint
+	out.Bucket = in.Bucket
+	out.Prefix = in.Prefix
+	out.Region = in.Region
+	return nil
+}
+
+// Convert_kubeone_EtcdBackupsTarget_To_v1beta2_EtcdBackupsTarget is an autogenerated conversion function.
+func Convert_kubeone_EtcdBackupsTarget_To_v1beta2_EtcdBackupsTarget(in *kubeone.EtcdBackupsTarget, out *EtcdBackupsTarget, s conversion.Scope) error {
+	return autoConvert_kubeone_EtcdBackupsTarget_To_v1beta2_EtcdBackupsTarget(in, out, s)
+}
+
+func autoConvert_v1beta2_EtcdDefragmentationConfig_To_kubeone_EtcdDefragmentationConfig(in *EtcdDefragmentationConfig, out *kubeone.EtcdDefragmentationConfig, s conversion.Scope) error {
+	out.AfterUpgrade = in.AfterUpgrade
+	out.Schedule = in.Schedule
+	return nil
+}
+
+// Convert_v1beta2_EtcdDefragmentationConfig_To_kubeone_EtcdDefragmentationConfig is an autogenerated conversion function.
+func Convert_v1beta2_EtcdDefragmentationConfig_To_kubeone_EtcdDefragmentationConfig(in *EtcdDefragmentationConfig, out *kubeone.EtcdDefragmentationConfig, s conversion.Scope) error {
+	return autoConvert_v1beta2_EtcdDefragmentationConfig_To_kubeone_EtcdDefragmentationConfig(in, out, s)
+}
+
+func autoConvert_kubeone_EtcdDefragmentationConfig_To_v1beta2_EtcdDefragmentationConfig(in *kubeone.EtcdDefragmentationConfig, out *EtcdDefragmentationConfig, s conversion.Scope) error {
+	out.AfterUpgrade = in.AfterUpgrade
+	out.Schedule = in.Schedule
+	return nil
+}
+
+// Convert_kubeone_EtcdDefragmentationConfig_To_v1beta2_EtcdDefragmentationConfig is an autogenerated conversion function.
+func Convert_kubeone_EtcdDefragmentationConfig_To_v1beta2_EtcdDefragmentationConfig(in *kubeone.EtcdDefragmentationConfig, out *EtcdDefragmentationConfig, s conversion.Scope) error {
+	return autoConvert_kubeone_EtcdDefragmentationConfig_To_v1beta2_EtcdDefragmentationConfig(in, out, s)
+}
+
 func autoConvert_v1beta2_ExternalCNISpec_To_kubeone_ExternalCNISpec(in *ExternalCNISpec, out *kubeone.ExternalCNISpec, s conversion.Scope) error {
 	return nil
 }
@@ -1291,6 +2701,17 @@ func autoConvert_v1beta2_Features_To_kubeone_Features(in *Features, out *kubeone
 	out.OpenIDConnect = (*kubeone.OpenIDConnect)(unsafe.Pointer(in.OpenIDConnect))
 	out.EncryptionProviders = (*kubeone.EncryptionProviders)(unsafe.Pointer(in.EncryptionProviders))
 	out.NodeLocalDNS = (*kubeone.NodeLocalDNS)(unsafe.Pointer(in.NodeLocalDNS))
+	out.NvidiaGPU = (*kubeone.NvidiaGPU)(unsafe.Pointer(in.NvidiaGPU))
+	out.NodeSwap = (*kubeone.NodeSwap)(unsafe.Pointer(in.NodeSwap))
+	out.SeccompDefault = (*kubeone.SeccompDefault)(unsafe.Pointer(in.SeccompDefault))
+	out.MetalLB = (*kubeone.MetalLB)(unsafe.Pointer(in.MetalLB))
+	out.GatewayAPI = (*kubeone.GatewayAPI)(unsafe.Pointer(in.GatewayAPI))
+	out.IstioAmbient = (*kubeone.IstioAmbient)(unsafe.Pointer(in.IstioAmbient))
+	out.KubeVIP = (*kubeone.KubeVIP)(unsafe.Pointer(in.KubeVIP))
+	out.DefaultDenyNetworkPolicy = (*kubeone.DefaultDenyNetworkPolicy)(unsafe.Pointer(in.DefaultDenyNetworkPolicy))
+	out.ClusterAutoscaler = (*kubeone.ClusterAutoscaler)(unsafe.Pointer(in.ClusterAutoscaler))
+	out.LocalStorage = (*kubeone.LocalStorage)(unsafe.Pointer(in.LocalStorage))
+	out.IngressNginx = (*kubeone.IngressNginx)(unsafe.Pointer(in.IngressNginx))
 	return nil
 }
 
@@ -1309,6 +2730,17 @@ func autoConvert_kubeone_Features_To_v1beta2_Features(in *kubeone.Features, out
 	out.OpenIDConnect = (*OpenIDConnect)(unsafe.Pointer(in.OpenIDConnect))
 	out.EncryptionProviders = (*EncryptionProviders)(unsafe.Pointer(in.EncryptionProviders))
 	out.NodeLocalDNS = (*NodeLocalDNS)(unsafe.Pointer(in.NodeLocalDNS))
+	out.NvidiaGPU = (*NvidiaGPU)(unsafe.Pointer(in.NvidiaGPU))
+	out.NodeSwap = (*NodeSwap)(unsafe.Pointer(in.NodeSwap))
+	out.SeccompDefault = (*SeccompDefault)(unsafe.Pointer(in.SeccompDefault))
+	out.MetalLB = (*MetalLB)(unsafe.Pointer(in.MetalLB))
+	out.GatewayAPI = (*GatewayAPI)(unsafe.Pointer(in.GatewayAPI))
+	out.IstioAmbient = (*IstioAmbient)(unsafe.Pointer(in.IstioAmbient))
+	out.KubeVIP = (*KubeVIP)(unsafe.Pointer(in.KubeVIP))
+	out.DefaultDenyNetworkPolicy = (*DefaultDenyNetworkPolicy)(unsafe.Pointer(in.DefaultDenyNetworkPolicy))
+	out.ClusterAutoscaler = (*ClusterAutoscaler)(unsafe.Pointer(in.ClusterAutoscaler))
+	out.LocalStorage = (*LocalStorage)(unsafe.Pointer(in.LocalStorage))
+	out.IngressNginx = (*IngressNginx)(unsafe.Pointer(in.IngressNginx))
 	return nil
 }
 
@@ -1317,7 +2749,33 @@ func Convert_kubeone_Features_To_v1beta2_Features(in *kubeone.Features, out *Fea
 	return autoConvert_kubeone_Features_To_v1beta2_Features(in, out, s)
 }
 
+func autoConvert_v1beta2_GCESharedVPCSpec_To_kubeone_GCESharedVPCSpec(in *GCESharedVPCSpec, out *kubeone.GCESharedVPCSpec, s conversion.Scope) error {
+	out.HostProjectID = in.HostProjectID
+	out.NetworkName = in.NetworkName
+	out.SubnetworkName = in.SubnetworkName
+	return nil
+}
+
+// Convert_v1beta2_GCESharedVPCSpec_To_kubeone_GCESharedVPCSpec is an autogenerated conversion function.
+func Convert_v1beta2_GCESharedVPCSpec_To_kubeone_GCESharedVPCSpec(in *GCESharedVPCSpec, out *kubeone.GCESharedVPCSpec, s conversion.Scope) error {
+	return autoConvert_v1beta2_GCESharedVPCSpec_To_kubeone_GCESharedVPCSpec(in, out, s)
+}
+
+func autoConvert_kubeone_GCESharedVPCSpec_To_v1beta2_GCESharedVPCSpec(in *kubeone.GCESharedVPCSpec, out *GCESharedVPCSpec, s conversion.Scope) error {
+	out.HostProjectID = in.HostProjectID
+	out.NetworkName = in.NetworkName
+	out.SubnetworkName = in.SubnetworkName
+	return nil
+}
+
+// Convert_kubeone_GCESharedVPCSpec_To_v1beta2_GCESharedVPCSpec is an autogenerated conversion function.
+func Convert_kubeone_GCESharedVPCSpec_To_v1beta2_GCESharedVPCSpec(in *kubeone.GCESharedVPCSpec, out *GCESharedVPCSpec, s conversion.Scope) error {
+	return autoConvert_kubeone_GCESharedVPCSpec_To_v1beta2_GCESharedVPCSpec(in, out, s)
+}
+
 func autoConvert_v1beta2_GCESpec_To_kubeone_GCESpec(in *GCESpec, out *kubeone.GCESpec, s conversion.Scope) error {
+	out.CredentialsMode = kubeone.GCECredentialsMode(in.CredentialsMode)
+	out.SharedVPC = (*kubeone.GCESharedVPCSpec)(unsafe.Pointer(in.SharedVPC))
 	return nil
 }
 
@@ -1327,6 +2785,8 @@ func Convert_v1beta2_GCESpec_To_kubeone_GCESpec(in *GCESpec, out *kubeone.GCESpe
 }
 
 func autoConvert_kubeone_GCESpec_To_v1beta2_GCESpec(in *kubeone.GCESpec, out *GCESpec, s conversion.Scope) error {
+	out.CredentialsMode = GCECredentialsMode(in.CredentialsMode)
+	out.SharedVPC = (*GCESharedVPCSpec)(unsafe.Pointer(in.SharedVPC))
 	return nil
 }
 
@@ -1335,6 +2795,28 @@ func Convert_kubeone_GCESpec_To_v1beta2_GCESpec(in *kubeone.GCESpec, out *GCESpe
 	return autoConvert_kubeone_GCESpec_To_v1beta2_GCESpec(in, out, s)
 }
 
+func autoConvert_v1beta2_GatewayAPI_To_kubeone_GatewayAPI(in *GatewayAPI, out *kubeone.GatewayAPI, s conversion.Scope) error {
+	out.Enable = in.Enable
+	out.Controller = kubeone.GatewayAPIController(in.Controller)
+	return nil
+}
+
+// Convert_v1beta2_GatewayAPI_To_kubeone_GatewayAPI is an autogenerated conversion function.
+func Convert_v1beta2_GatewayAPI_To_kubeone_GatewayAPI(in *GatewayAPI, out *kubeone.GatewayAPI, s conversion.Scope) error {
+	return autoConvert_v1beta2_GatewayAPI_To_kubeone_GatewayAPI(in, out, s)
+}
+
+func autoConvert_kubeone_GatewayAPI_To_v1beta2_GatewayAPI(in *kubeone.GatewayAPI, out *GatewayAPI, s conversion.Scope) error {
+	out.Enable = in.Enable
+	out.Controller = GatewayAPIController(in.Controller)
+	return nil
+}
+
+// Convert_kubeone_GatewayAPI_To_v1beta2_GatewayAPI is an autogenerated conversion function.
+func Convert_kubeone_GatewayAPI_To_v1beta2_GatewayAPI(in *kubeone.GatewayAPI, out *GatewayAPI, s conversion.Scope) error {
+	return autoConvert_kubeone_GatewayAPI_To_v1beta2_GatewayAPI(in, out, s)
+}
+
 func autoConvert_v1beta2_HelmRelease_To_kubeone_HelmRelease(in *HelmRelease, out *kubeone.HelmRelease, s conversion.Scope) error {
 	out.Chart = in.Chart
 	out.RepoURL = in.RepoURL
@@ -1390,6 +2872,7 @@ func Convert_kubeone_HelmValues_To_v1beta2_HelmValues(in *kubeone.HelmValues, ou
 }
 
 func autoConvert_v1beta2_HetznerSpec_To_kubeone_HetznerSpec(in *HetznerSpec, out *kubeone.HetznerSpec, s conversion.Scope) error {
+	out.Robot = in.Robot
 	out.NetworkID = in.NetworkID
 	return nil
 }
@@ -1400,6 +2883,7 @@ func Convert_v1beta2_HetznerSpec_To_kubeone_HetznerSpec(in *HetznerSpec, out *ku
 }
 
 func autoConvert_kubeone_HetznerSpec_To_v1beta2_HetznerSpec(in *kubeone.HetznerSpec, out *HetznerSpec, s conversion.Scope) error {
+	out.Robot = in.Robot
 	out.NetworkID = in.NetworkID
 	return nil
 }
@@ -1427,10 +2911,12 @@ func autoConvert_v1beta2_HostConfig_To_kubeone_HostConfig(in *HostConfig, out *k
 	out.IsLeader = in.IsLeader
 	out.Taints = *(*[]v1.Taint)(unsafe.Pointer(&in.Taints))
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Zone = in.Zone
 	if err := Convert_v1beta2_KubeletConfig_To_kubeone_KubeletConfig(&in.Kubelet, &out.Kubelet, s); err != nil {
 		return err
 	}
 	out.OperatingSystem = kubeone.OperatingSystemName(in.OperatingSystem)
+	out.BMC = (*kubeone.BMCConfig)(unsafe.Pointer(in.BMC))
 	return nil
 }
 
@@ -1457,10 +2943,12 @@ func autoConvert_kubeone_HostConfig_To_v1beta2_HostConfig(in *kubeone.HostConfig
 	out.IsLeader = in.IsLeader
 	out.Taints = *(*[]v1.Taint)(unsafe.Pointer(&in.Taints))
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Zone = in.Zone
 	if err := Convert_kubeone_KubeletConfig_To_v1beta2_KubeletConfig(&in.Kubelet, &out.Kubelet, s); err != nil {
 		return err
 	}
 	out.OperatingSystem = OperatingSystemName(in.OperatingSystem)
+	out.BMC = (*BMCConfig)(unsafe.Pointer(in.BMC))
 	return nil
 }
 
@@ -1469,7 +2957,38 @@ func Convert_kubeone_HostConfig_To_v1beta2_HostConfig(in *kubeone.HostConfig, ou
 	return autoConvert_kubeone_HostConfig_To_v1beta2_HostConfig(in, out, s)
 }
 
+func autoConvert_v1beta2_HostPathMount_To_kubeone_HostPathMount(in *HostPathMount, out *kubeone.HostPathMount, s conversion.Scope) error {
+	out.Name = in.Name
+	out.HostPath = in.HostPath
+	out.MountPath = in.MountPath
+	out.ReadOnly = in.ReadOnly
+	out.PathType = v1.HostPathType(in.PathType)
+	return nil
+}
+
+// Convert_v1beta2_HostPathMount_To_kubeone_HostPathMount is an autogenerated conversion function.
+func Convert_v1beta2_HostPathMount_To_kubeone_HostPathMount(in *HostPathMount, out *kubeone.HostPathMount, s conversion.Scope) error {
+	return autoConvert_v1beta2_HostPathMount_To_kubeone_HostPathMount(in, out, s)
+}
+
+func autoConvert_kubeone_HostPathMount_To_v1beta2_HostPathMount(in *kubeone.HostPathMount, out *HostPathMount, s conversion.Scope) error {
+	out.Name = in.Name
+	out.HostPath = in.HostPath
+	out.MountPath = in.MountPath
+	out.ReadOnly = in.ReadOnly
+	out.PathType = v1.HostPathType(in.PathType)
+	return nil
+}
+
+// Convert_kubeone_HostPathMount_To_v1beta2_HostPathMount is an autogenerated conversion function.
+func Convert_kubeone_HostPathMount_To_v1beta2_HostPathMount(in *kubeone.HostPathMount, out *HostPathMount, s conversion.Scope) error {
+	return autoConvert_kubeone_HostPathMount_To_v1beta2_HostPathMount(in, out, s)
+}
+
 func autoConvert_v1beta2_IPTables_To_kubeone_IPTables(in *IPTables, out *kubeone.IPTables, s conversion.Scope) error {
+	out.MasqueradeAll = in.MasqueradeAll
+	out.SyncPeriod = in.SyncPeriod
+	out.MinSyncPeriod = in.MinSyncPeriod
 	return nil
 }
 
@@ -1479,6 +2998,9 @@ func Convert_v1beta2_IPTables_To_kubeone_IPTables(in *IPTables, out *kubeone.IPT
 }
 
 func autoConvert_kubeone_IPTables_To_v1beta2_IPTables(in *kubeone.IPTables, out *IPTables, s conversion.Scope) error {
+	out.MasqueradeAll = in.MasqueradeAll
+	out.SyncPeriod = in.SyncPeriod
+	out.MinSyncPeriod = in.MinSyncPeriod
 	return nil
 }
 
@@ -1494,6 +3016,8 @@ func autoConvert_v1beta2_IPVSConfig_To_kubeone_IPVSConfig(in *IPVSConfig, out *k
 	out.TCPTimeout = in.TCPTimeout
 	out.TCPFinTimeout = in.TCPFinTimeout
 	out.UDPTimeout = in.UDPTimeout
+	out.SyncPeriod = in.SyncPeriod
+	out.MinSyncPeriod = in.MinSyncPeriod
 	return nil
 }
 
@@ -1509,6 +3033,8 @@ func autoConvert_kubeone_IPVSConfig_To_v1beta2_IPVSConfig(in *kubeone.IPVSConfig
 	out.TCPTimeout = in.TCPTimeout
 	out.TCPFinTimeout = in.TCPFinTimeout
 	out.UDPTimeout = in.UDPTimeout
+	out.SyncPeriod = in.SyncPeriod
+	out.MinSyncPeriod = in.MinSyncPeriod
 	return nil
 }
 
@@ -1539,6 +3065,82 @@ func Convert_kubeone_ImageAsset_To_v1beta2_ImageAsset(in *kubeone.ImageAsset, ou
 	return autoConvert_kubeone_ImageAsset_To_v1beta2_ImageAsset(in, out, s)
 }
 
+func autoConvert_v1beta2_IngressNginx_To_kubeone_IngressNginx(in *IngressNginx, out *kubeone.IngressNginx, s conversion.Scope) error {
+	out.Enable = in.Enable
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.DefaultIngressClass = (*bool)(unsafe.Pointer(in.DefaultIngressClass))
+	out.ServiceType = v1.ServiceType(in.ServiceType)
+	out.ExternalTrafficPolicy = v1.ServiceExternalTrafficPolicy(in.ExternalTrafficPolicy)
+	out.ProxyProtocol = (*bool)(unsafe.Pointer(in.ProxyProtocol))
+	out.ServiceAnnotations = *(*map[string]string)(unsafe.Pointer(&in.ServiceAnnotations))
+	out.Config = *(*map[string]string)(unsafe.Pointer(&in.Config))
+	return nil
+}
+
+// Convert_v1beta2_IngressNginx_To_kubeone_IngressNginx is an autogenerated conversion function.
+func Convert_v1beta2_IngressNginx_To_kubeone_IngressNginx(in *IngressNginx, out *kubeone.IngressNginx, s conversion.Scope) error {
+	return autoConvert_v1beta2_IngressNginx_To_kubeone_IngressNginx(in, out, s)
+}
+
+func autoConvert_kubeone_IngressNginx_To_v1beta2_IngressNginx(in *kubeone.IngressNginx, out *IngressNginx, s conversion.Scope) error {
+	out.Enable = in.Enable
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.DefaultIngressClass = (*bool)(unsafe.Pointer(in.DefaultIngressClass))
+	out.ServiceType = v1.ServiceType(in.ServiceType)
+	out.ExternalTrafficPolicy = v1.ServiceExternalTrafficPolicy(in.ExternalTrafficPolicy)
+	out.ProxyProtocol = (*bool)(unsafe.Pointer(in.ProxyProtocol))
+	out.ServiceAnnotations = *(*map[string]string)(unsafe.Pointer(&in.ServiceAnnotations))
+	out.Config = *(*map[string]string)(unsafe.Pointer(&in.Config))
+	return nil
+}
+
+// Convert_kubeone_IngressNginx_To_v1beta2_IngressNginx is an autogenerated conversion function.
+func Convert_kubeone_IngressNginx_To_v1beta2_IngressNginx(in *kubeone.IngressNginx, out *IngressNginx, s conversion.Scope) error {
+	return autoConvert_kubeone_IngressNginx_To_v1beta2_IngressNginx(in, out, s)
+}
+
+func autoConvert_v1beta2_IstioAmbient_To_kubeone_IstioAmbient(in *IstioAmbient, out *kubeone.IstioAmbient, s conversion.Scope) error {
+	out.Enable = in.Enable
+	return nil
+}
+
+// Convert_v1beta2_IstioAmbient_To_kubeone_IstioAmbient is an autogenerated conversion function.
+func Convert_v1beta2_IstioAmbient_To_kubeone_IstioAmbient(in *IstioAmbient, out *kubeone.IstioAmbient, s conversion.Scope) error {
+	return autoConvert_v1beta2_IstioAmbient_To_kubeone_IstioAmbient(in, out, s)
+}
+
+func autoConvert_kubeone_IstioAmbient_To_v1beta2_IstioAmbient(in *kubeone.IstioAmbient, out *IstioAmbient, s conversion.Scope) error {
+	out.Enable = in.Enable
+	return nil
+}
+
+// Convert_kubeone_IstioAmbient_To_v1beta2_IstioAmbient is an autogenerated conversion function.
+func Convert_kubeone_IstioAmbient_To_v1beta2_IstioAmbient(in *kubeone.IstioAmbient, out *IstioAmbient, s conversion.Scope) error {
+	return autoConvert_kubeone_IstioAmbient_To_v1beta2_IstioAmbient(in, out, s)
+}
+
+func autoConvert_v1beta2_KernelConfig_To_kubeone_KernelConfig(in *KernelConfig, out *kubeone.KernelConfig, s conversion.Scope) error {
+	out.Sysctls = *(*map[string]string)(unsafe.Pointer(&in.Sysctls))
+	out.Modules = *(*[]string)(unsafe.Pointer(&in.Modules))
+	return nil
+}
+
+// Convert_v1beta2_KernelConfig_To_kubeone_KernelConfig is an autogenerated conversion function.
+func Convert_v1beta2_KernelConfig_To_kubeone_KernelConfig(in *KernelConfig, out *kubeone.KernelConfig, s conversion.Scope) error {
+	return autoConvert_v1beta2_KernelConfig_To_kubeone_KernelConfig(in, out, s)
+}
+
+func autoConvert_kubeone_KernelConfig_To_v1beta2_KernelConfig(in *kubeone.KernelConfig, out *KernelConfig, s conversion.Scope) error {
+	out.Sysctls = *(*map[string]string)(unsafe.Pointer(&in.Sysctls))
+	out.Modules = *(*[]string)(unsafe.Pointer(&in.Modules))
+	return nil
+}
+
+// Convert_kubeone_KernelConfig_To_v1beta2_KernelConfig is an autogenerated conversion function.
+func Convert_kubeone_KernelConfig_To_v1beta2_KernelConfig(in *kubeone.KernelConfig, out *KernelConfig, s conversion.Scope) error {
+	return autoConvert_kubeone_KernelConfig_To_v1beta2_KernelConfig(in, out, s)
+}
+
 func autoConvert_v1beta2_KubeOneCluster_To_kubeone_KubeOneCluster(in *KubeOneCluster, out *kubeone.KubeOneCluster, s conversion.Scope) error {
 	out.Name = in.Name
 	if err := Convert_v1beta2_ControlPlaneConfig_To_kubeone_ControlPlaneConfig(&in.ControlPlane, &out.ControlPlane, s); err != nil {
@@ -1569,6 +3171,7 @@ func autoConvert_v1beta2_KubeOneCluster_To_kubeone_KubeOneCluster(in *KubeOneClu
 	out.MachineController = (*kubeone.MachineControllerConfig)(unsafe.Pointer(in.MachineController))
 	out.OperatingSystemManager = (*kubeone.OperatingSystemManagerConfig)(unsafe.Pointer(in.OperatingSystemManager))
 	out.CABundle = in.CABundle
+	out.AdditionalTrustedCAs = in.AdditionalTrustedCAs
 	if err := Convert_v1beta2_Features_To_kubeone_Features(&in.Features, &out.Features, s); err != nil {
 		return err
 	}
@@ -1579,6 +3182,13 @@ func autoConvert_v1beta2_KubeOneCluster_To_kubeone_KubeOneCluster(in *KubeOneClu
 	if err := Convert_v1beta2_LoggingConfig_To_kubeone_LoggingConfig(&in.LoggingConfig, &out.LoggingConfig, s); err != nil {
 		return err
 	}
+	if err := Convert_v1beta2_CgroupsConfig_To_kubeone_CgroupsConfig(&in.Cgroups, &out.Cgroups, s); err != nil {
+		return err
+	}
+	out.ControlPlaneComponents = (*kubeone.ControlPlaneComponents)(unsafe.Pointer(in.ControlPlaneComponents))
+	out.Backups = (*kubeone.BackupsConfig)(unsafe.Pointer(in.Backups))
+	out.Upgrades = (*kubeone.UpgradesConfig)(unsafe.Pointer(in.Upgrades))
+	out.CertificateAuthority = (*kubeone.CertificateAuthorityConfig)(unsafe.Pointer(in.CertificateAuthority))
 	return nil
 }
 
@@ -1617,6 +3227,7 @@ func autoConvert_kubeone_KubeOneCluster_To_v1beta2_KubeOneCluster(in *kubeone.Ku
 	out.MachineController = (*MachineControllerConfig)(unsafe.Pointer(in.MachineController))
 	out.OperatingSystemManager = (*OperatingSystemManagerConfig)(unsafe.Pointer(in.OperatingSystemManager))
 	out.CABundle = in.CABundle
+	out.AdditionalTrustedCAs = in.AdditionalTrustedCAs
 	if err := Convert_kubeone_Features_To_v1beta2_Features(&in.Features, &out.Features, s); err != nil {
 		return err
 	}
@@ -1628,6 +3239,13 @@ func autoConvert_kubeone_KubeOneCluster_To_v1beta2_KubeOneCluster(in *kubeone.Ku
 	if err := Convert_kubeone_LoggingConfig_To_v1beta2_LoggingConfig(&in.LoggingConfig, &out.LoggingConfig, s); err != nil {
 		return err
 	}
+	if err := Convert_kubeone_CgroupsConfig_To_v1beta2_CgroupsConfig(&in.Cgroups, &out.Cgroups, s); err != nil {
+		return err
+	}
+	out.ControlPlaneComponents = (*ControlPlaneComponents)(unsafe.Pointer(in.ControlPlaneComponents))
+	out.Backups = (*BackupsConfig)(unsafe.Pointer(in.Backups))
+	out.Upgrades = (*UpgradesConfig)(unsafe.Pointer(in.Upgrades))
+	out.CertificateAuthority = (*CertificateAuthorityConfig)(unsafe.Pointer(in.CertificateAuthority))
 	return nil
 }
 
@@ -1635,6 +3253,7 @@ func autoConvert_v1beta2_KubeProxyConfig_To_kubeone_KubeProxyConfig(in *KubeProx
 	out.SkipInstallation = in.SkipInstallation
 	out.IPVS = (*kubeone.IPVSConfig)(unsafe.Pointer(in.IPVS))
 	out.IPTables = (*kubeone.IPTables)(unsafe.Pointer(in.IPTables))
+	out.NFTables = (*kubeone.NFTables)(unsafe.Pointer(in.NFTables))
 	return nil
 }
 
@@ -1643,42 +3262,157 @@ func Convert_v1beta2_KubeProxyConfig_To_kubeone_KubeProxyConfig(in *KubeProxyCon
 	return autoConvert_v1beta2_KubeProxyConfig_To_kubeone_KubeProxyConfig(in, out, s)
 }
 
-func autoConvert_kubeone_KubeProxyConfig_To_v1beta2_KubeProxyConfig(in *kubeone.KubeProxyConfig, out *KubeProxyConfig, s conversion.Scope) error {
-	out.SkipInstallation = in.SkipInstallation
-	out.IPVS = (*IPVSConfig)(unsafe.Pointer(in.IPVS))
-	out.IPTables = (*IPTables)(unsafe.Pointer(in.IPTables))
+func autoConvert_kubeone_KubeProxyConfig_To_v1beta2_KubeProxyConfig(in *kubeone.KubeProxyConfig, out *KubeProxyConfig, s conversion.Scope) error {
+	out.SkipInstallation = in.SkipInstallation
+	out.IPVS = (*IPVSConfig)(unsafe.Pointer(in.IPVS))
+	out.IPTables = (*IPTables)(unsafe.Pointer(in.IPTables))
+	out.NFTables = (*NFTables)(unsafe.Pointer(in.NFTables))
+	return nil
+}
+
+// Convert_kubeone_KubeProxyConfig_To_v1beta2_KubeProxyConfig is an autogenerated conversion function.
+func Convert_kubeone_KubeProxyConfig_To_v1beta2_KubeProxyConfig(in *kubeone.KubeProxyConfig, out *KubeProxyConfig, s conversion.Scope) error {
+	return autoConvert_kubeone_KubeProxyConfig_To_v1beta2_KubeProxyConfig(in, out, s)
+}
+
+func autoConvert_v1beta2_KubeVIP_To_kubeone_KubeVIP(in *KubeVIP, out *kubeone.KubeVIP, s conversion.Scope) error {
+	out.Enable = in.Enable
+	out.Interface = in.Interface
+	return nil
+}
+
+// Convert_v1beta2_KubeVIP_To_kubeone_KubeVIP is an autogenerated conversion function.
+func Convert_v1beta2_KubeVIP_To_kubeone_KubeVIP(in *KubeVIP, out *kubeone.KubeVIP, s conversion.Scope) error {
+	return autoConvert_v1beta2_KubeVIP_To_kubeone_KubeVIP(in, out, s)
+}
+
+func autoConvert_kubeone_KubeVIP_To_v1beta2_KubeVIP(in *kubeone.KubeVIP, out *KubeVIP, s conversion.Scope) error {
+	out.Enable = in.Enable
+	out.Interface = in.Interface
+	return nil
+}
+
+// Convert_kubeone_KubeVIP_To_v1beta2_KubeVIP is an autogenerated conversion function.
+func Convert_kubeone_KubeVIP_To_v1beta2_KubeVIP(in *kubeone.KubeVIP, out *KubeVIP, s conversion.Scope) error {
+	return autoConvert_kubeone_KubeVIP_To_v1beta2_KubeVIP(in, out, s)
+}
+
+func autoConvert_v1beta2_KubeadmPatches_To_kubeone_KubeadmPatches(in *KubeadmPatches, out *kubeone.KubeadmPatches, s conversion.Scope) error {
+	out.Directory = in.Directory
+	return nil
+}
+
+// Convert_v1beta2_KubeadmPatches_To_kubeone_KubeadmPatches is an autogenerated conversion function.
+func Convert_v1beta2_KubeadmPatches_To_kubeone_KubeadmPatches(in *KubeadmPatches, out *kubeone.KubeadmPatches, s conversion.Scope) error {
+	return autoConvert_v1beta2_KubeadmPatches_To_kubeone_KubeadmPatches(in, out, s)
+}
+
+func autoConvert_kubeone_KubeadmPatches_To_v1beta2_KubeadmPatches(in *kubeone.KubeadmPatches, out *KubeadmPatches, s conversion.Scope) error {
+	out.Directory = in.Directory
+	return nil
+}
+
+// Convert_kubeone_KubeadmPatches_To_v1beta2_KubeadmPatches is an autogenerated conversion function.
+func Convert_kubeone_KubeadmPatches_To_v1beta2_KubeadmPatches(in *kubeone.KubeadmPatches, out *KubeadmPatches, s conversion.Scope) error {
+	return autoConvert_kubeone_KubeadmPatches_To_v1beta2_KubeadmPatches(in, out, s)
+}
+
+func autoConvert_v1beta2_KubeletConfig_To_kubeone_KubeletConfig(in *KubeletConfig, out *kubeone.KubeletConfig, s conversion.Scope) error {
+	out.SystemReserved = *(*map[string]string)(unsafe.Pointer(&in.SystemReserved))
+	out.KubeReserved = *(*map[string]string)(unsafe.Pointer(&in.KubeReserved))
+	out.EvictionHard = *(*map[string]string)(unsafe.Pointer(&in.EvictionHard))
+	out.MaxPods = (*int32)(unsafe.Pointer(in.MaxPods))
+	return nil
+}
+
+// Convert_v1beta2_KubeletConfig_To_kubeone_KubeletConfig is an autogenerated conversion function.
+func Convert_v1beta2_KubeletConfig_To_kubeone_KubeletConfig(in *KubeletConfig, out *kubeone.KubeletConfig, s conversion.Scope) error {
+	return autoConvert_v1beta2_KubeletConfig_To_kubeone_KubeletConfig(in, out, s)
+}
+
+func autoConvert_kubeone_KubeletConfig_To_v1beta2_KubeletConfig(in *kubeone.KubeletConfig, out *KubeletConfig, s conversion.Scope) error {
+	out.SystemReserved = *(*map[string]string)(unsafe.Pointer(&in.SystemReserved))
+	out.KubeReserved = *(*map[string]string)(unsafe.Pointer(&in.KubeReserved))
+	out.EvictionHard = *(*map[string]string)(unsafe.Pointer(&in.EvictionHard))
+	out.MaxPods = (*int32)(unsafe.Pointer(in.MaxPods))
+	return nil
+}
+
+// Convert_kubeone_KubeletConfig_To_v1beta2_KubeletConfig is an autogenerated conversion function.
+func Convert_kubeone_KubeletConfig_To_v1beta2_KubeletConfig(in *kubeone.KubeletConfig, out *KubeletConfig, s conversion.Scope) error {
+	return autoConvert_kubeone_KubeletConfig_To_v1beta2_KubeletConfig(in, out, s)
+}
+
+func autoConvert_v1beta2_KubevirtSpec_To_kubeone_KubevirtSpec(in *KubevirtSpec, out *kubeone.KubevirtSpec, s conversion.Scope) error {
+	out.InfraNamespace = in.InfraNamespace
+	out.InfraStorageClassName = in.InfraStorageClassName
+	return nil
+}
+
+// Convert_v1beta2_KubevirtSpec_To_kubeone_KubevirtSpec is an autogenerated conversion function.
+func Convert_v1beta2_KubevirtSpec_To_kubeone_KubevirtSpec(in *KubevirtSpec, out *kubeone.KubevirtSpec, s conversion.Scope) error {
+	return autoConvert_v1beta2_KubevirtSpec_To_kubeone_KubevirtSpec(in, out, s)
+}
+
+func autoConvert_kubeone_KubevirtSpec_To_v1beta2_KubevirtSpec(in *kubeone.KubevirtSpec, out *KubevirtSpec, s conversion.Scope) error {
+	out.InfraNamespace = in.InfraNamespace
+	out.InfraStorageClassName = in.InfraStorageClassName
+	return nil
+}
+
+// Convert_kubeone_KubevirtSpec_To_v1beta2_KubevirtSpec is an autogenerated conversion function.
+func Convert_kubeone_KubevirtSpec_To_v1beta2_KubevirtSpec(in *kubeone.KubevirtSpec, out *KubevirtSpec, s conversion.Scope) error {
+	return autoConvert_kubeone_KubevirtSpec_To_v1beta2_KubevirtSpec(in, out, s)
+}
+
+func autoConvert_v1beta2_LocalPathProvisioner_To_kubeone_LocalPathProvisioner(in *LocalPathProvisioner, out *kubeone.LocalPathProvisioner, s conversion.Scope) error {
+	out.Path = in.Path
+	return nil
+}
+
+// Convert_v1beta2_LocalPathProvisioner_To_kubeone_LocalPathProvisioner is an autogenerated conversion function.
+func Convert_v1beta2_LocalPathProvisioner_To_kubeone_LocalPathProvisioner(in *LocalPathProvisioner, out *kubeone.LocalPathProvisioner, s conversion.Scope) error {
+	return autoConvert_v1beta2_LocalPathProvisioner_To_kubeone_LocalPathProvisioner(in, out, s)
+}
+
+func autoConvert_kubeone_LocalPathProvisioner_To_v1beta2_LocalPathProvisioner(in *kubeone.LocalPathProvisioner, out *LocalPathProvisioner, s conversion.Scope) error {
+	out.Path = in.Path
 	return nil
 }
 
-// Convert_kubeone_KubeProxyConfig_To_v1beta2_KubeProxyConfig is an autogenerated conversion function.
-func Convert_kubeone_KubeProxyConfig_To_v1beta2_KubeProxyConfig(in *kubeone.KubeProxyConfig, out *KubeProxyConfig, s conversion.Scope) error {
-	return autoConvert_kubeone_KubeProxyConfig_To_v1beta2_KubeProxyConfig(in, out, s)
+// Convert_kubeone_LocalPathProvisioner_To_v1beta2_LocalPathProvisioner is an autogenerated conversion function.
+func Convert_kubeone_LocalPathProvisioner_To_v1beta2_LocalPathProvisioner(in *kubeone.LocalPathProvisioner, out *LocalPathProvisioner, s conversion.Scope) error {
+	return autoConvert_kubeone_LocalPathProvisioner_To_v1beta2_LocalPathProvisioner(in, out, s)
 }
 
-func autoConvert_v1beta2_KubeletConfig_To_kubeone_KubeletConfig(in *KubeletConfig, out *kubeone.KubeletConfig, s conversion.Scope) error {
-	out.SystemReserved = *(*map[string]string)(unsafe.Pointer(&in.SystemReserved))
-	out.KubeReserved = *(*map[string]string)(unsafe.Pointer(&in.KubeReserved))
-	out.EvictionHard = *(*map[string]string)(unsafe.Pointer(&in.EvictionHard))
-	out.MaxPods = (*int32)(unsafe.Pointer(in.MaxPods))
+func autoConvert_v1beta2_LocalStorage_To_kubeone_LocalStorage(in *LocalStorage, out *kubeone.LocalStorage, s conversion.Scope) error {
+	out.Enable = in.Enable
+	out.LocalPath = (*kubeone.LocalPathProvisioner)(unsafe.Pointer(in.LocalPath))
+	out.TopoLVM = (*kubeone.TopoLVMProvisioner)(unsafe.Pointer(in.TopoLVM))
+	out.StorageClassName = in.StorageClassName
+	out.DefaultStorageClass = (*bool)(unsafe.Pointer(in.DefaultStorageClass))
+	out.ReclaimPolicy = v1.PersistentVolumeReclaimPolicy(in.ReclaimPolicy)
 	return nil
 }
 
-// Convert_v1beta2_KubeletConfig_To_kubeone_KubeletConfig is an autogenerated conversion function.
-func Convert_v1beta2_KubeletConfig_To_kubeone_KubeletConfig(in *KubeletConfig, out *kubeone.KubeletConfig, s conversion.Scope) error {
-	return autoConvert_v1beta2_KubeletConfig_To_kubeone_KubeletConfig(in, out, s)
+// Convert_v1beta2_LocalStorage_To_kubeone_LocalStorage is an autogenerated conversion function.
+func Convert_v1beta2_LocalStorage_To_kubeone_LocalStorage(in *LocalStorage, out *kubeone.LocalStorage, s conversion.Scope) error {
+	return autoConvert_v1beta2_LocalStorage_To_kubeone_LocalStorage(in, out, s)
 }
 
-func autoConvert_kubeone_KubeletConfig_To_v1beta2_KubeletConfig(in *kubeone.KubeletConfig, out *KubeletConfig, s conversion.Scope) error {
-	out.SystemReserved = *(*map[string]string)(unsafe.Pointer(&in.SystemReserved))
-	out.KubeReserved = *(*map[string]string)(unsafe.Pointer(&in.KubeReserved))
-	out.EvictionHard = *(*map[string]string)(unsafe.Pointer(&in.EvictionHard))
-	out.MaxPods = (*int32)(unsafe.Pointer(in.MaxPods))
+func autoConvert_kubeone_LocalStorage_To_v1beta2_LocalStorage(in *kubeone.LocalStorage, out *LocalStorage, s conversion.Scope) error {
+	out.Enable = in.Enable
+	out.LocalPath = (*LocalPathProvisioner)(unsafe.Pointer(in.LocalPath))
+	out.TopoLVM = (*TopoLVMProvisioner)(unsafe.Pointer(in.TopoLVM))
+	out.StorageClassName = in.StorageClassName
+	out.DefaultStorageClass = (*bool)(unsafe.Pointer(in.DefaultStorageClass))
+	out.ReclaimPolicy = v1.PersistentVolumeReclaimPolicy(in.ReclaimPolicy)
 	return nil
 }
 
-// Convert_kubeone_KubeletConfig_To_v1beta2_KubeletConfig is an autogenerated conversion function.
-func Convert_kubeone_KubeletConfig_To_v1beta2_KubeletConfig(in *kubeone.KubeletConfig, out *KubeletConfig, s conversion.Scope) error {
-	return autoConvert_kubeone_KubeletConfig_To_v1beta2_KubeletConfig(in, out, s)
+// Convert_kubeone_LocalStorage_To_v1beta2_LocalStorage is an autogenerated conversion function.
+func Convert_kubeone_LocalStorage_To_v1beta2_LocalStorage(in *kubeone.LocalStorage, out *LocalStorage, s conversion.Scope) error {
+	return autoConvert_kubeone_LocalStorage_To_v1beta2_LocalStorage(in, out, s)
 }
 
 func autoConvert_v1beta2_LoggingConfig_To_kubeone_LoggingConfig(in *LoggingConfig, out *kubeone.LoggingConfig, s conversion.Scope) error {
@@ -1723,8 +3457,138 @@ func Convert_kubeone_MachineControllerConfig_To_v1beta2_MachineControllerConfig(
 	return autoConvert_kubeone_MachineControllerConfig_To_v1beta2_MachineControllerConfig(in, out, s)
 }
 
+func autoConvert_v1beta2_MachineDeploymentUpgradeStrategy_To_kubeone_MachineDeploymentUpgradeStrategy(in *MachineDeploymentUpgradeStrategy, out *kubeone.MachineDeploymentUpgradeStrategy, s conversion.Scope) error {
+	out.Type = kubeone.MachineDeploymentUpgradeStrategyType(in.Type)
+	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	out.SkipEviction = in.SkipEviction
+	return nil
+}
+
+// Convert_v1beta2_MachineDeploymentUpgradeStrategy_To_kubeone_MachineDeploymentUpgradeStrategy is an autogenerated conversion function.
+func Convert_v1beta2_MachineDeploymentUpgradeStrategy_To_kubeone_MachineDeploymentUpgradeStrategy(in *MachineDeploymentUpgradeStrategy, out *kubeone.MachineDeploymentUpgradeStrategy, s conversion.Scope) error {
+	return autoConvert_v1beta2_MachineDeploymentUpgradeStrategy_To_kubeone_MachineDeploymentUpgradeStrategy(in, out, s)
+}
+
+func autoConvert_kubeone_MachineDeploymentUpgradeStrategy_To_v1beta2_MachineDeploymentUpgradeStrategy(in *kubeone.MachineDeploymentUpgradeStrategy, out *MachineDeploymentUpgradeStrategy, s conversion.Scope) error {
+	out.Type = MachineDeploymentUpgradeStrategyType(in.Type)
+	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	out.SkipEviction = in.SkipEviction
+	return nil
+}
+
+// Convert_kubeone_MachineDeploymentUpgradeStrategy_To_v1beta2_MachineDeploymentUpgradeStrategy is an autogenerated conversion function.
+func Convert_kubeone_MachineDeploymentUpgradeStrategy_To_v1beta2_MachineDeploymentUpgradeStrategy(in *kubeone.MachineDeploymentUpgradeStrategy, out *MachineDeploymentUpgradeStrategy, s conversion.Scope) error {
+	return autoConvert_kubeone_MachineDeploymentUpgradeStrategy_To_v1beta2_MachineDeploymentUpgradeStrategy(in, out, s)
+}
+
+func autoConvert_v1beta2_MaintenanceWindow_To_kubeone_MaintenanceWindow(in *MaintenanceWindow, out *kubeone.MaintenanceWindow, s conversion.Scope) error {
+	out.Days = *(*[]string)(unsafe.Pointer(&in.Days))
+	out.Start = in.Start
+	out.Duration = in.Duration
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_v1beta2_MaintenanceWindow_To_kubeone_MaintenanceWindow is an autogenerated conversion function.
+func Convert_v1beta2_MaintenanceWindow_To_kubeone_MaintenanceWindow(in *MaintenanceWindow, out *kubeone.MaintenanceWindow, s conversion.Scope) error {
+	return autoConvert_v1beta2_MaintenanceWindow_To_kubeone_MaintenanceWindow(in, out, s)
+}
+
+func autoConvert_kubeone_MaintenanceWindow_To_v1beta2_MaintenanceWindow(in *kubeone.MaintenanceWindow, out *MaintenanceWindow, s conversion.Scope) error {
+	out.Days = *(*[]string)(unsafe.Pointer(&in.Days))
+	out.Start = in.Start
+	out.Duration = in.Duration
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_kubeone_MaintenanceWindow_To_v1beta2_MaintenanceWindow is an autogenerated conversion function.
+func Convert_kubeone_MaintenanceWindow_To_v1beta2_MaintenanceWindow(in *kubeone.MaintenanceWindow, out *MaintenanceWindow, s conversion.Scope) error {
+	return autoConvert_kubeone_MaintenanceWindow_To_v1beta2_MaintenanceWindow(in, out, s)
+}
+
+func autoConvert_v1beta2_MetalLB_To_kubeone_MetalLB(in *MetalLB, out *kubeone.MetalLB, s conversion.Scope) error {
+	out.Enable = in.Enable
+	*(*[]kubeone.MetalLBAddressPool)(unsafe.Pointer(&out.AddressPools)) = *(*[]kubeone.MetalLBAddressPool)(unsafe.Pointer(&in.AddressPools))
+	*(*[]kubeone.MetalLBBGPPeer)(unsafe.Pointer(&out.BGPPeers)) = *(*[]kubeone.MetalLBBGPPeer)(unsafe.Pointer(&in.BGPPeers))
+	return nil
+}
+
+// Convert_v1beta2_MetalLB_To_kubeone_MetalLB is an autogenerated conversion function.
+func Convert_v1beta2_MetalLB_To_kubeone_MetalLB(in *MetalLB, out *kubeone.MetalLB, s conversion.Scope) error {
+	return autoConvert_v1beta2_MetalLB_To_kubeone_MetalLB(in, out, s)
+}
+
+func autoConvert_kubeone_MetalLB_To_v1beta2_MetalLB(in *kubeone.MetalLB, out *MetalLB, s conversion.Scope) error {
+	out.Enable = in.Enable
+	*(*[]MetalLBAddressPool)(unsafe.Pointer(&out.AddressPools)) = *(*[]MetalLBAddressPool)(unsafe.Pointer(&in.AddressPools))
+	*(*[]MetalLBBGPPeer)(unsafe.Pointer(&out.BGPPeers)) = *(*[]MetalLBBGPPeer)(unsafe.Pointer(&in.BGPPeers))
+	return nil
+}
+
+// Convert_kubeone_MetalLB_To_v1beta2_MetalLB is an autogenerated conversion function.
+func Convert_kubeone_MetalLB_To_v1beta2_MetalLB(in *kubeone.MetalLB, out *MetalLB, s conversion.Scope) error {
+	return autoConvert_kubeone_MetalLB_To_v1beta2_MetalLB(in, out, s)
+}
+
+func autoConvert_v1beta2_MetalLBAddressPool_To_kubeone_MetalLBAddressPool(in *MetalLBAddressPool, out *kubeone.MetalLBAddressPool, s conversion.Scope) error {
+	out.Name = in.Name
+	*(*[]string)(unsafe.Pointer(&out.Addresses)) = *(*[]string)(unsafe.Pointer(&in.Addresses))
+	out.Mode = kubeone.MetalLBMode(in.Mode)
+	return nil
+}
+
+// Convert_v1beta2_MetalLBAddressPool_To_kubeone_MetalLBAddressPool is an autogenerated conversion function.
+func Convert_v1beta2_MetalLBAddressPool_To_kubeone_MetalLBAddressPool(in *MetalLBAddressPool, out *kubeone.MetalLBAddressPool, s conversion.Scope) error {
+	return autoConvert_v1beta2_MetalLBAddressPool_To_kubeone_MetalLBAddressPool(in, out, s)
+}
+
+func autoConvert_kubeone_MetalLBAddressPool_To_v1beta2_MetalLBAddressPool(in *kubeone.MetalLBAddressPool, out *MetalLBAddressPool, s conversion.Scope) error {
+	out.Name = in.Name
+	*(*[]string)(unsafe.Pointer(&out.Addresses)) = *(*[]string)(unsafe.Pointer(&in.Addresses))
+	out.Mode = MetalLBMode(in.Mode)
+	return nil
+}
+
+// Convert_kubeone_MetalLBAddressPool_To_v1beta2_MetalLBAddressPool is an autogenerated conversion function.
+func Convert_kubeone_MetalLBAddressPool_To_v1beta2_MetalLBAddressPool(in *kubeone.MetalLBAddressPool, out *MetalLBAddressPool, s conversion.Scope) error {
+	return autoConvert_kubeone_MetalLBAddressPool_To_v1beta2_MetalLBAddressPool(in, out, s)
+}
+
+func autoConvert_v1beta2_MetalLBBGPPeer_To_kubeone_MetalLBBGPPeer(in *MetalLBBGPPeer, out *kubeone.MetalLBBGPPeer, s conversion.Scope) error {
+	out.PeerAddress = in.PeerAddress
+	out.PeerASN = in.PeerASN
+	out.MyASN = in.MyASN
+	out.PeerPort = in.PeerPort
+	return nil
+}
+
+// Convert_v1beta2_MetalLBBGPPeer_To_kubeone_MetalLBBGPPeer is an autogenerated conversion function.
+func Convert_v1beta2_MetalLBBGPPeer_To_kubeone_MetalLBBGPPeer(in *MetalLBBGPPeer, out *kubeone.MetalLBBGPPeer, s conversion.Scope) error {
+	return autoConvert_v1beta2_MetalLBBGPPeer_To_kubeone_MetalLBBGPPeer(in, out, s)
+}
+
+func autoConvert_kubeone_MetalLBBGPPeer_To_v1beta2_MetalLBBGPPeer(in *kubeone.MetalLBBGPPeer, out *MetalLBBGPPeer, s conversion.Scope) error {
+	out.PeerAddress = in.PeerAddress
+	out.PeerASN = in.PeerASN
+	out.MyASN = in.MyASN
+	out.PeerPort = in.PeerPort
+	return nil
+}
+
+// Convert_kubeone_MetalLBBGPPeer_To_v1beta2_MetalLBBGPPeer is an autogenerated conversion function.
+func Convert_kubeone_MetalLBBGPPeer_To_v1beta2_MetalLBBGPPeer(in *kubeone.MetalLBBGPPeer, out *MetalLBBGPPeer, s conversion.Scope) error {
+	return autoConvert_kubeone_MetalLBBGPPeer_To_v1beta2_MetalLBBGPPeer(in, out, s)
+}
+
 func autoConvert_v1beta2_MetricsServer_To_kubeone_MetricsServer(in *MetricsServer, out *kubeone.MetricsServer, s conversion.Scope) error {
 	out.Enable = in.Enable
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.SecureKubeletTLS = in.SecureKubeletTLS
+	out.Resources = (*v1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.HostNetwork = in.HostNetwork
 	return nil
 }
 
@@ -1735,6 +3599,10 @@ func Convert_v1beta2_MetricsServer_To_kubeone_MetricsServer(in *MetricsServer, o
 
 func autoConvert_kubeone_MetricsServer_To_v1beta2_MetricsServer(in *kubeone.MetricsServer, out *MetricsServer, s conversion.Scope) error {
 	out.Enable = in.Enable
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.SecureKubeletTLS = in.SecureKubeletTLS
+	out.Resources = (*v1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.HostNetwork = in.HostNetwork
 	return nil
 }
 
@@ -1743,6 +3611,30 @@ func Convert_kubeone_MetricsServer_To_v1beta2_MetricsServer(in *kubeone.MetricsS
 	return autoConvert_kubeone_MetricsServer_To_v1beta2_MetricsServer(in, out, s)
 }
 
+func autoConvert_v1beta2_NFTables_To_kubeone_NFTables(in *NFTables, out *kubeone.NFTables, s conversion.Scope) error {
+	out.MasqueradeAll = in.MasqueradeAll
+	out.SyncPeriod = in.SyncPeriod
+	out.MinSyncPeriod = in.MinSyncPeriod
+	return nil
+}
+
+// Convert_v1beta2_NFTables_To_kubeone_NFTables is an autogenerated conversion function.
+func Convert_v1beta2_NFTables_To_kubeone_NFTables(in *NFTables, out *kubeone.NFTables, s conversion.Scope) error {
+	return autoConvert_v1beta2_NFTables_To_kubeone_NFTables(in, out, s)
+}
+
+func autoConvert_kubeone_NFTables_To_v1beta2_NFTables(in *kubeone.NFTables, out *NFTables, s conversion.Scope) error {
+	out.MasqueradeAll = in.MasqueradeAll
+	out.SyncPeriod = in.SyncPeriod
+	out.MinSyncPeriod = in.MinSyncPeriod
+	return nil
+}
+
+// Convert_kubeone_NFTables_To_v1beta2_NFTables is an autogenerated conversion function.
+func Convert_kubeone_NFTables_To_v1beta2_NFTables(in *kubeone.NFTables, out *NFTables, s conversion.Scope) error {
+	return autoConvert_kubeone_NFTables_To_v1beta2_NFTables(in, out, s)
+}
+
 func autoConvert_v1beta2_NodeLocalDNS_To_kubeone_NodeLocalDNS(in *NodeLocalDNS, out *kubeone.NodeLocalDNS, s conversion.Scope) error {
 	out.Deploy = in.Deploy
 	return nil
@@ -1763,6 +3655,28 @@ func Convert_kubeone_NodeLocalDNS_To_v1beta2_NodeLocalDNS(in *kubeone.NodeLocalD
 	return autoConvert_kubeone_NodeLocalDNS_To_v1beta2_NodeLocalDNS(in, out, s)
 }
 
+func autoConvert_v1beta2_NodeSwap_To_kubeone_NodeSwap(in *NodeSwap, out *kubeone.NodeSwap, s conversion.Scope) error {
+	out.Enable = in.Enable
+	out.SwapBehavior = kubeone.SwapBehavior(in.SwapBehavior)
+	return nil
+}
+
+// Convert_v1beta2_NodeSwap_To_kubeone_NodeSwap is an autogenerated conversion function.
+func Convert_v1beta2_NodeSwap_To_kubeone_NodeSwap(in *NodeSwap, out *kubeone.NodeSwap, s conversion.Scope) error {
+	return autoConvert_v1beta2_NodeSwap_To_kubeone_NodeSwap(in, out, s)
+}
+
+func autoConvert_kubeone_NodeSwap_To_v1beta2_NodeSwap(in *kubeone.NodeSwap, out *NodeSwap, s conversion.Scope) error {
+	out.Enable = in.Enable
+	out.SwapBehavior = SwapBehavior(in.SwapBehavior)
+	return nil
+}
+
+// Convert_kubeone_NodeSwap_To_v1beta2_NodeSwap is an autogenerated conversion function.
+func Convert_kubeone_NodeSwap_To_v1beta2_NodeSwap(in *kubeone.NodeSwap, out *NodeSwap, s conversion.Scope) error {
+	return autoConvert_kubeone_NodeSwap_To_v1beta2_NodeSwap(in, out, s)
+}
+
 func autoConvert_v1beta2_NoneSpec_To_kubeone_NoneSpec(in *NoneSpec, out *kubeone.NoneSpec, s conversion.Scope) error {
 	return nil
 }
@@ -1782,6 +3696,9 @@ func Convert_kubeone_NoneSpec_To_v1beta2_NoneSpec(in *kubeone.NoneSpec, out *Non
 }
 
 func autoConvert_v1beta2_NutanixSpec_To_kubeone_NutanixSpec(in *NutanixSpec, out *kubeone.NutanixSpec, s conversion.Scope) error {
+	out.StorageContainer = in.StorageContainer
+	out.FSType = in.FSType
+	out.IsSegmentedISCSINetwork = in.IsSegmentedISCSINetwork
 	return nil
 }
 
@@ -1791,6 +3708,9 @@ func Convert_v1beta2_NutanixSpec_To_kubeone_NutanixSpec(in *NutanixSpec, out *ku
 }
 
 func autoConvert_kubeone_NutanixSpec_To_v1beta2_NutanixSpec(in *kubeone.NutanixSpec, out *NutanixSpec, s conversion.Scope) error {
+	out.StorageContainer = in.StorageContainer
+	out.FSType = in.FSType
+	out.IsSegmentedISCSINetwork = in.IsSegmentedISCSINetwork
 	return nil
 }
 
@@ -1799,6 +3719,50 @@ func Convert_kubeone_NutanixSpec_To_v1beta2_NutanixSpec(in *kubeone.NutanixSpec,
 	return autoConvert_kubeone_NutanixSpec_To_v1beta2_NutanixSpec(in, out, s)
 }
 
+func autoConvert_v1beta2_NvidiaGPU_To_kubeone_NvidiaGPU(in *NvidiaGPU, out *kubeone.NvidiaGPU, s conversion.Scope) error {
+	out.Enable = in.Enable
+	return nil
+}
+
+// Convert_v1beta2_NvidiaGPU_To_kubeone_NvidiaGPU is an autogenerated conversion function.
+func Convert_v1beta2_NvidiaGPU_To_kubeone_NvidiaGPU(in *NvidiaGPU, out *kubeone.NvidiaGPU, s conversion.Scope) error {
+	return autoConvert_v1beta2_NvidiaGPU_To_kubeone_NvidiaGPU(in, out, s)
+}
+
+func autoConvert_kubeone_NvidiaGPU_To_v1beta2_NvidiaGPU(in *kubeone.NvidiaGPU, out *NvidiaGPU, s conversion.Scope) error {
+	out.Enable = in.Enable
+	return nil
+}
+
+// Convert_kubeone_NvidiaGPU_To_v1beta2_NvidiaGPU is an autogenerated conversion function.
+func Convert_kubeone_NvidiaGPU_To_v1beta2_NvidiaGPU(in *kubeone.NvidiaGPU, out *NvidiaGPU, s conversion.Scope) error {
+	return autoConvert_kubeone_NvidiaGPU_To_v1beta2_NvidiaGPU(in, out, s)
+}
+
+func autoConvert_v1beta2_OCISpec_To_kubeone_OCISpec(in *OCISpec, out *kubeone.OCISpec, s conversion.Scope) error {
+	out.CompartmentID = in.CompartmentID
+	out.VCNID = in.VCNID
+	out.LoadBalancerSubnetIDs = *(*[]string)(unsafe.Pointer(&in.LoadBalancerSubnetIDs))
+	return nil
+}
+
+// Convert_v1beta2_OCISpec_To_kubeone_OCISpec is an autogenerated conversion function.
+func Convert_v1beta2_OCISpec_To_kubeone_OCISpec(in *OCISpec, out *kubeone.OCISpec, s conversion.Scope) error {
+	return autoConvert_v1beta2_OCISpec_To_kubeone_OCISpec(in, out, s)
+}
+
+func autoConvert_kubeone_OCISpec_To_v1beta2_OCISpec(in *kubeone.OCISpec, out *OCISpec, s conversion.Scope) error {
+	out.CompartmentID = in.CompartmentID
+	out.VCNID = in.VCNID
+	out.LoadBalancerSubnetIDs = *(*[]string)(unsafe.Pointer(&in.LoadBalancerSubnetIDs))
+	return nil
+}
+
+// Convert_kubeone_OCISpec_To_v1beta2_OCISpec is an autogenerated conversion function.
+func Convert_kubeone_OCISpec_To_v1beta2_OCISpec(in *kubeone.OCISpec, out *OCISpec, s conversion.Scope) error {
+	return autoConvert_kubeone_OCISpec_To_v1beta2_OCISpec(in, out, s)
+}
+
 func autoConvert_v1beta2_OpenIDConnect_To_kubeone_OpenIDConnect(in *OpenIDConnect, out *kubeone.OpenIDConnect, s conversion.Scope) error {
 	out.Enable = in.Enable
 	if err := Convert_v1beta2_OpenIDConnectConfig_To_kubeone_OpenIDConnectConfig(&in.Config, &out.Config, s); err != nil {
@@ -1899,6 +3863,78 @@ func Convert_kubeone_OperatingSystemManagerConfig_To_v1beta2_OperatingSystemMana
 	return autoConvert_kubeone_OperatingSystemManagerConfig_To_v1beta2_OperatingSystemManagerConfig(in, out, s)
 }
 
+func autoConvert_v1beta2_OperatingSystemUpgradeConfig_To_kubeone_OperatingSystemUpgradeConfig(in *OperatingSystemUpgradeConfig, out *kubeone.OperatingSystemUpgradeConfig, s conversion.Scope) error {
+	out.UbuntuRelease = in.UbuntuRelease
+	out.MachineDeploymentImages = *(*map[string]string)(unsafe.Pointer(&in.MachineDeploymentImages))
+	return nil
+}
+
+// Convert_v1beta2_OperatingSystemUpgradeConfig_To_kubeone_OperatingSystemUpgradeConfig is an autogenerated conversion function.
+func Convert_v1beta2_OperatingSystemUpgradeConfig_To_kubeone_OperatingSystemUpgradeConfig(in *OperatingSystemUpgradeConfig, out *kubeone.OperatingSystemUpgradeConfig, s conversion.Scope) error {
+	return autoConvert_v1beta2_OperatingSystemUpgradeConfig_To_kubeone_OperatingSystemUpgradeConfig(in, out, s)
+}
+
+func autoConvert_kubeone_OperatingSystemUpgradeConfig_To_v1beta2_OperatingSystemUpgradeConfig(in *kubeone.OperatingSystemUpgradeConfig, out *OperatingSystemUpgradeConfig, s conversion.Scope) error {
+	out.UbuntuRelease = in.UbuntuRelease
+	out.MachineDeploymentImages = *(*map[string]string)(unsafe.Pointer(&in.MachineDeploymentImages))
+	return nil
+}
+
+// Convert_kubeone_OperatingSystemUpgradeConfig_To_v1beta2_OperatingSystemUpgradeConfig is an autogenerated conversion function.
+func Convert_kubeone_OperatingSystemUpgradeConfig_To_v1beta2_OperatingSystemUpgradeConfig(in *kubeone.OperatingSystemUpgradeConfig, out *OperatingSystemUpgradeConfig, s conversion.Scope) error {
+	return autoConvert_kubeone_OperatingSystemUpgradeConfig_To_v1beta2_OperatingSystemUpgradeConfig(in, out, s)
+}
+
+func autoConvert_v1beta2_PackageRepositories_To_kubeone_PackageRepositories(in *PackageRepositories, out *kubeone.PackageRepositories, s conversion.Scope) error {
+	out.Kubernetes = (*kubeone.PackageRepository)(unsafe.Pointer(in.Kubernetes))
+	out.ContainerRuntime = (*kubeone.PackageRepository)(unsafe.Pointer(in.ContainerRuntime))
+	return nil
+}
+
+// Convert_v1beta2_PackageRepositories_To_kubeone_PackageRepositories is an autogenerated conversion function.
+func Convert_v1beta2_PackageRepositories_To_kubeone_PackageRepositories(in *PackageRepositories, out *kubeone.PackageRepositories, s conversion.Scope) error {
+	return autoConvert_v1beta2_PackageRepositories_To_kubeone_PackageRepositories(in, out, s)
+}
+
+func autoConvert_kubeone_PackageRepositories_To_v1beta2_PackageRepositories(in *kubeone.PackageRepositories, out *PackageRepositories, s conversion.Scope) error {
+	out.Kubernetes = (*PackageRepository)(unsafe.Pointer(in.Kubernetes))
+	out.ContainerRuntime = (*PackageRepository)(unsafe.Pointer(in.ContainerRuntime))
+	return nil
+}
+
+// Convert_kubeone_PackageRepositories_To_v1beta2_PackageRepositories is an autogenerated conversion function.
+func Convert_kubeone_PackageRepositories_To_v1beta2_PackageRepositories(in *kubeone.PackageRepositories, out *PackageRepositories, s conversion.Scope) error {
+	return autoConvert_kubeone_PackageRepositories_To_v1beta2_PackageRepositories(in, out, s)
+}
+
+func autoConvert_v1beta2_PackageRepository_To_kubeone_PackageRepository(in *PackageRepository, out *kubeone.PackageRepository, s conversion.Scope) error {
+	out.URL = in.URL
+	out.Suite = in.Suite
+	out.Components = *(*[]string)(unsafe.Pointer(&in.Components))
+	out.GPGKeyURL = in.GPGKeyURL
+	out.GPGKey = in.GPGKey
+	return nil
+}
+
+// Convert_v1beta2_PackageRepository_To_kubeone_PackageRepository is an autogenerated conversion function.
+func Convert_v1beta2_PackageRepository_To_kubeone_PackageRepository(in *PackageRepository, out *kubeone.PackageRepository, s conversion.Scope) error {
+	return autoConvert_v1beta2_PackageRepository_To_kubeone_PackageRepository(in, out, s)
+}
+
+func autoConvert_kubeone_PackageRepository_To_v1beta2_PackageRepository(in *kubeone.PackageRepository, out *PackageRepository, s conversion.Scope) error {
+	out.URL = in.URL
+	out.Suite = in.Suite
+	out.Components = *(*[]string)(unsafe.Pointer(&in.Components))
+	out.GPGKeyURL = in.GPGKeyURL
+	out.GPGKey = in.GPGKey
+	return nil
+}
+
+// Convert_kubeone_PackageRepository_To_v1beta2_PackageRepository is an autogenerated conversion function.
+func Convert_kubeone_PackageRepository_To_v1beta2_PackageRepository(in *kubeone.PackageRepository, out *PackageRepository, s conversion.Scope) error {
+	return autoConvert_kubeone_PackageRepository_To_v1beta2_PackageRepository(in, out, s)
+}
+
 func autoConvert_v1beta2_PodNodeSelector_To_kubeone_PodNodeSelector(in *PodNodeSelector, out *kubeone.PodNodeSelector, s conversion.Scope) error {
 	out.Enable = in.Enable
 	if err := Convert_v1beta2_PodNodeSelectorConfig_To_kubeone_PodNodeSelectorConfig(&in.Config, &out.Config, s); err != nil {
@@ -2037,6 +4073,24 @@ func Convert_kubeone_ProviderStaticNetworkConfig_To_v1beta2_ProviderStaticNetwor
 	return autoConvert_kubeone_ProviderStaticNetworkConfig_To_v1beta2_ProviderStaticNetworkConfig(in, out, s)
 }
 
+func autoConvert_v1beta2_ProxmoxSpec_To_kubeone_ProxmoxSpec(in *ProxmoxSpec, out *kubeone.ProxmoxSpec, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1beta2_ProxmoxSpec_To_kubeone_ProxmoxSpec is an autogenerated conversion function.
+func Convert_v1beta2_ProxmoxSpec_To_kubeone_ProxmoxSpec(in *ProxmoxSpec, out *kubeone.ProxmoxSpec, s conversion.Scope) error {
+	return autoConvert_v1beta2_ProxmoxSpec_To_kubeone_ProxmoxSpec(in, out, s)
+}
+
+func autoConvert_kubeone_ProxmoxSpec_To_v1beta2_ProxmoxSpec(in *kubeone.ProxmoxSpec, out *ProxmoxSpec, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_kubeone_ProxmoxSpec_To_v1beta2_ProxmoxSpec is an autogenerated conversion function.
+func Convert_kubeone_ProxmoxSpec_To_v1beta2_ProxmoxSpec(in *kubeone.ProxmoxSpec, out *ProxmoxSpec, s conversion.Scope) error {
+	return autoConvert_kubeone_ProxmoxSpec_To_v1beta2_ProxmoxSpec(in, out, s)
+}
+
 func autoConvert_v1beta2_ProxyConfig_To_kubeone_ProxyConfig(in *ProxyConfig, out *kubeone.ProxyConfig, s conversion.Scope) error {
 	out.HTTP = in.HTTP
 	out.HTTPS = in.HTTPS
@@ -2083,6 +4137,28 @@ func Convert_kubeone_RegistryConfiguration_To_v1beta2_RegistryConfiguration(in *
 	return autoConvert_kubeone_RegistryConfiguration_To_v1beta2_RegistryConfiguration(in, out, s)
 }
 
+func autoConvert_v1beta2_SeccompDefault_To_kubeone_SeccompDefault(in *SeccompDefault, out *kubeone.SeccompDefault, s conversion.Scope) error {
+	out.Enable = in.Enable
+	out.ProfilePaths = *(*[]string)(unsafe.Pointer(&in.ProfilePaths))
+	return nil
+}
+
+// Convert_v1beta2_SeccompDefault_To_kubeone_SeccompDefault is an autogenerated conversion function.
+func Convert_v1beta2_SeccompDefault_To_kubeone_SeccompDefault(in *SeccompDefault, out *kubeone.SeccompDefault, s conversion.Scope) error {
+	return autoConvert_v1beta2_SeccompDefault_To_kubeone_SeccompDefault(in, out, s)
+}
+
+func autoConvert_kubeone_SeccompDefault_To_v1beta2_SeccompDefault(in *kubeone.SeccompDefault, out *SeccompDefault, s conversion.Scope) error {
+	out.Enable = in.Enable
+	out.ProfilePaths = *(*[]string)(unsafe.Pointer(&in.ProfilePaths))
+	return nil
+}
+
+// Convert_kubeone_SeccompDefault_To_v1beta2_SeccompDefault is an autogenerated conversion function.
+func Convert_kubeone_SeccompDefault_To_v1beta2_SeccompDefault(in *kubeone.SeccompDefault, out *SeccompDefault, s conversion.Scope) error {
+	return autoConvert_kubeone_SeccompDefault_To_v1beta2_SeccompDefault(in, out, s)
+}
+
 func autoConvert_v1beta2_StaticAuditLog_To_kubeone_StaticAuditLog(in *StaticAuditLog, out *kubeone.StaticAuditLog, s conversion.Scope) error {
 	out.Enable = in.Enable
 	if err := Convert_v1beta2_StaticAuditLogConfig_To_kubeone_StaticAuditLogConfig(&in.Config, &out.Config, s); err != nil {
@@ -2139,6 +4215,7 @@ func Convert_kubeone_StaticAuditLogConfig_To_v1beta2_StaticAuditLogConfig(in *ku
 
 func autoConvert_v1beta2_StaticWorkersConfig_To_kubeone_StaticWorkersConfig(in *StaticWorkersConfig, out *kubeone.StaticWorkersConfig, s conversion.Scope) error {
 	out.Hosts = *(*[]kubeone.HostConfig)(unsafe.Pointer(&in.Hosts))
+	out.Kernel = (*kubeone.KernelConfig)(unsafe.Pointer(in.Kernel))
 	return nil
 }
 
@@ -2149,6 +4226,7 @@ func Convert_v1beta2_StaticWorkersConfig_To_kubeone_StaticWorkersConfig(in *Stat
 
 func autoConvert_kubeone_StaticWorkersConfig_To_v1beta2_StaticWorkersConfig(in *kubeone.StaticWorkersConfig, out *StaticWorkersConfig, s conversion.Scope) error {
 	out.Hosts = *(*[]HostConfig)(unsafe.Pointer(&in.Hosts))
+	out.Kernel = (*KernelConfig)(unsafe.Pointer(in.Kernel))
 	return nil
 }
 
@@ -2157,8 +4235,29 @@ func Convert_kubeone_StaticWorkersConfig_To_v1beta2_StaticWorkersConfig(in *kube
 	return autoConvert_kubeone_StaticWorkersConfig_To_v1beta2_StaticWorkersConfig(in, out, s)
 }
 
+func autoConvert_v1beta2_StaticWorkersUpgradeConfig_To_kubeone_StaticWorkersUpgradeConfig(in *StaticWorkersUpgradeConfig, out *kubeone.StaticWorkersUpgradeConfig, s conversion.Scope) error {
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	return nil
+}
+
+// Convert_v1beta2_StaticWorkersUpgradeConfig_To_kubeone_StaticWorkersUpgradeConfig is an autogenerated conversion function.
+func Convert_v1beta2_StaticWorkersUpgradeConfig_To_kubeone_StaticWorkersUpgradeConfig(in *StaticWorkersUpgradeConfig, out *kubeone.StaticWorkersUpgradeConfig, s conversion.Scope) error {
+	return autoConvert_v1beta2_StaticWorkersUpgradeConfig_To_kubeone_StaticWorkersUpgradeConfig(in, out, s)
+}
+
+func autoConvert_kubeone_StaticWorkersUpgradeConfig_To_v1beta2_StaticWorkersUpgradeConfig(in *kubeone.StaticWorkersUpgradeConfig, out *StaticWorkersUpgradeConfig, s conversion.Scope) error {
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	return nil
+}
+
+// Convert_kubeone_StaticWorkersUpgradeConfig_To_v1beta2_StaticWorkersUpgradeConfig is an autogenerated conversion function.
+func Convert_kubeone_StaticWorkersUpgradeConfig_To_v1beta2_StaticWorkersUpgradeConfig(in *kubeone.StaticWorkersUpgradeConfig, out *StaticWorkersUpgradeConfig, s conversion.Scope) error {
+	return autoConvert_kubeone_StaticWorkersUpgradeConfig_To_v1beta2_StaticWorkersUpgradeConfig(in, out, s)
+}
+
 func autoConvert_v1beta2_SystemPackages_To_kubeone_SystemPackages(in *SystemPackages, out *kubeone.SystemPackages, s conversion.Scope) error {
 	out.ConfigureRepositories = in.ConfigureRepositories
+	out.Repositories = (*kubeone.PackageRepositories)(unsafe.Pointer(in.Repositories))
 	return nil
 }
 
@@ -2169,6 +4268,7 @@ func Convert_v1beta2_SystemPackages_To_kubeone_SystemPackages(in *SystemPackages
 
 func autoConvert_kubeone_SystemPackages_To_v1beta2_SystemPackages(in *kubeone.SystemPackages, out *SystemPackages, s conversion.Scope) error {
 	out.ConfigureRepositories = in.ConfigureRepositories
+	out.Repositories = (*PackageRepositories)(unsafe.Pointer(in.Repositories))
 	return nil
 }
 
@@ -2177,6 +4277,88 @@ func Convert_kubeone_SystemPackages_To_v1beta2_SystemPackages(in *kubeone.System
 	return autoConvert_kubeone_SystemPackages_To_v1beta2_SystemPackages(in, out, s)
 }
 
+func autoConvert_v1beta2_TopoLVMProvisioner_To_kubeone_TopoLVMProvisioner(in *TopoLVMProvisioner, out *kubeone.TopoLVMProvisioner, s conversion.Scope) error {
+	out.VolumeGroup = in.VolumeGroup
+	out.FSType = in.FSType
+	out.SpareGB = (*int)(unsafe.Pointer(in.SpareGB))
+	return nil
+}
+
+// Convert_v1beta2_TopoLVMProvisioner_To_kubeone_TopoLVMProvisioner is an autogenerated conversion function.
+func Convert_v1beta2_TopoLVMProvisioner_To_kubeone_TopoLVMProvisioner(in *TopoLVMProvisioner, out *kubeone.TopoLVMProvisioner, s conversion.Scope) error {
+	return autoConvert_v1beta2_TopoLVMProvisioner_To_kubeone_TopoLVMProvisioner(in, out, s)
+}
+
+func autoConvert_kubeone_TopoLVMProvisioner_To_v1beta2_TopoLVMProvisioner(in *kubeone.TopoLVMProvisioner, out *TopoLVMProvisioner, s conversion.Scope) error {
+	out.VolumeGroup = in.VolumeGroup
+	out.FSType = in.FSType
+	out.SpareGB = (*int)(unsafe.Pointer(in.SpareGB))
+	return nil
+}
+
+// Convert_kubeone_TopoLVMProvisioner_To_v1beta2_TopoLVMProvisioner is an autogenerated conversion function.
+func Convert_kubeone_TopoLVMProvisioner_To_v1beta2_TopoLVMProvisioner(in *kubeone.TopoLVMProvisioner, out *TopoLVMProvisioner, s conversion.Scope) error {
+	return autoConvert_kubeone_TopoLVMProvisioner_To_v1beta2_TopoLVMProvisioner(in, out, s)
+}
+
+func autoConvert_v1beta2_UnattendedUpgradesConfig_To_kubeone_UnattendedUpgradesConfig(in *UnattendedUpgradesConfig, out *kubeone.UnattendedUpgradesConfig, s conversion.Scope) error {
+	out.Enable = in.Enable
+	out.RebootWindow = (*kubeone.MaintenanceWindow)(unsafe.Pointer(in.RebootWindow))
+	out.PackageBlacklist = *(*[]string)(unsafe.Pointer(&in.PackageBlacklist))
+	return nil
+}
+
+// Convert_v1beta2_UnattendedUpgradesConfig_To_kubeone_UnattendedUpgradesConfig is an autogenerated conversion function.
+func Convert_v1beta2_UnattendedUpgradesConfig_To_kubeone_UnattendedUpgradesConfig(in *UnattendedUpgradesConfig, out *kubeone.UnattendedUpgradesConfig, s conversion.Scope) error {
+	return autoConvert_v1beta2_UnattendedUpgradesConfig_To_kubeone_UnattendedUpgradesConfig(in, out, s)
+}
+
+func autoConvert_kubeone_UnattendedUpgradesConfig_To_v1beta2_UnattendedUpgradesConfig(in *kubeone.UnattendedUpgradesConfig, out *UnattendedUpgradesConfig, s conversion.Scope) error {
+	out.Enable = in.Enable
+	out.RebootWindow = (*MaintenanceWindow)(unsafe.Pointer(in.RebootWindow))
+	out.PackageBlacklist = *(*[]string)(unsafe.Pointer(&in.PackageBlacklist))
+	return nil
+}
+
+// Convert_kubeone_UnattendedUpgradesConfig_To_v1beta2_UnattendedUpgradesConfig is an autogenerated conversion function.
+func Convert_kubeone_UnattendedUpgradesConfig_To_v1beta2_UnattendedUpgradesConfig(in *kubeone.UnattendedUpgradesConfig, out *UnattendedUpgradesConfig, s conversion.Scope) error {
+	return autoConvert_kubeone_UnattendedUpgradesConfig_To_v1beta2_UnattendedUpgradesConfig(in, out, s)
+}
+
+func autoConvert_v1beta2_UpgradesConfig_To_kubeone_UpgradesConfig(in *UpgradesConfig, out *kubeone.UpgradesConfig, s conversion.Scope) error {
+	out.Drain = (*kubeone.DrainConfig)(unsafe.Pointer(in.Drain))
+	out.Canary = (*kubeone.CanaryUpgradeConfig)(unsafe.Pointer(in.Canary))
+	out.IntermediateVersions = *(*[]string)(unsafe.Pointer(&in.IntermediateVersions))
+	out.MaintenanceWindows = *(*[]kubeone.MaintenanceWindow)(unsafe.Pointer(&in.MaintenanceWindows))
+	out.OperatingSystem = (*kubeone.OperatingSystemUpgradeConfig)(unsafe.Pointer(in.OperatingSystem))
+	out.StaticWorkers = (*kubeone.StaticWorkersUpgradeConfig)(unsafe.Pointer(in.StaticWorkers))
+	out.Unattended = (*kubeone.UnattendedUpgradesConfig)(unsafe.Pointer(in.Unattended))
+	out.EtcdDefragmentation = (*kubeone.EtcdDefragmentationConfig)(unsafe.Pointer(in.EtcdDefragmentation))
+	return nil
+}
+
+// Convert_v1beta2_UpgradesConfig_To_kubeone_UpgradesConfig is an autogenerated conversion function.
+func Convert_v1beta2_UpgradesConfig_To_kubeone_UpgradesConfig(in *UpgradesConfig, out *kubeone.UpgradesConfig, s conversion.Scope) error {
+	return autoConvert_v1beta2_UpgradesConfig_To_kubeone_UpgradesConfig(in, out, s)
+}
+
+func autoConvert_kubeone_UpgradesConfig_To_v1beta2_UpgradesConfig(in *kubeone.UpgradesConfig, out *UpgradesConfig, s conversion.Scope) error {
+	out.Drain = (*DrainConfig)(unsafe.Pointer(in.Drain))
+	out.Canary = (*CanaryUpgradeConfig)(unsafe.Pointer(in.Canary))
+	out.IntermediateVersions = *(*[]string)(unsafe.Pointer(&in.IntermediateVersions))
+	out.MaintenanceWindows = *(*[]MaintenanceWindow)(unsafe.Pointer(&in.MaintenanceWindows))
+	out.OperatingSystem = (*OperatingSystemUpgradeConfig)(unsafe.Pointer(in.OperatingSystem))
+	out.StaticWorkers = (*StaticWorkersUpgradeConfig)(unsafe.Pointer(in.StaticWorkers))
+	out.Unattended = (*UnattendedUpgradesConfig)(unsafe.Pointer(in.Unattended))
+	out.EtcdDefragmentation = (*EtcdDefragmentationConfig)(unsafe.Pointer(in.EtcdDefragmentation))
+	return nil
+}
+
+// Convert_kubeone_UpgradesConfig_To_v1beta2_UpgradesConfig is an autogenerated conversion function.
+func Convert_kubeone_UpgradesConfig_To_v1beta2_UpgradesConfig(in *kubeone.UpgradesConfig, out *UpgradesConfig, s conversion.Scope) error {
+	return autoConvert_kubeone_UpgradesConfig_To_v1beta2_UpgradesConfig(in, out, s)
+}
+
 func autoConvert_v1beta2_VMwareCloudDirectorSpec_To_kubeone_VMwareCloudDirectorSpec(in *VMwareCloudDirectorSpec, out *kubeone.VMwareCloudDirectorSpec, s conversion.Scope) error {
 	out.VApp = in.VApp
 	out.StorageProfile = in.StorageProfile
@@ -2199,6 +4381,30 @@ func Convert_kubeone_VMwareCloudDirectorSpec_To_v1beta2_VMwareCloudDirectorSpec(
 	return autoConvert_kubeone_VMwareCloudDirectorSpec_To_v1beta2_VMwareCloudDirectorSpec(in, out, s)
 }
 
+func autoConvert_v1beta2_VaultCertificateAuthoritySource_To_kubeone_VaultCertificateAuthoritySource(in *VaultCertificateAuthoritySource, out *kubeone.VaultCertificateAuthoritySource, s conversion.Scope) error {
+	out.Path = in.Path
+	out.CertificateKey = in.CertificateKey
+	out.PrivateKeyKey = in.PrivateKeyKey
+	return nil
+}
+
+// Convert_v1beta2_VaultCertificateAuthoritySource_To_kubeone_VaultCertificateAuthoritySource is an autogenerated conversion function.
+func Convert_v1beta2_VaultCertificateAuthoritySource_To_kubeone_VaultCertificateAuthoritySource(in *VaultCertificateAuthoritySource, out *kubeone.VaultCertificateAuthoritySource, s conversion.Scope) error {
+	return autoConvert_v1beta2_VaultCertificateAuthoritySource_To_kubeone_VaultCertificateAuthoritySource(in, out, s)
+}
+
+func autoConvert_kubeone_VaultCertificateAuthoritySource_To_v1beta2_VaultCertificateAuthoritySource(in *kubeone.VaultCertificateAuthoritySource, out *VaultCertificateAuthoritySource, s conversion.Scope) error {
+	out.Path = in.Path
+	out.CertificateKey = in.CertificateKey
+	out.PrivateKeyKey = in.PrivateKeyKey
+	return nil
+}
+
+// Convert_kubeone_VaultCertificateAuthoritySource_To_v1beta2_VaultCertificateAuthoritySource is an autogenerated conversion function.
+func Convert_kubeone_VaultCertificateAuthoritySource_To_v1beta2_VaultCertificateAuthoritySource(in *kubeone.VaultCertificateAuthoritySource, out *VaultCertificateAuthoritySource, s conversion.Scope) error {
+	return autoConvert_kubeone_VaultCertificateAuthoritySource_To_v1beta2_VaultCertificateAuthoritySource(in, out, s)
+}
+
 func autoConvert_v1beta2_VersionConfig_To_kubeone_VersionConfig(in *VersionConfig, out *kubeone.VersionConfig, s conversion.Scope) error {
 	out.Kubernetes = in.Kubernetes
 	return nil
@@ -2219,7 +4425,60 @@ func Convert_kubeone_VersionConfig_To_v1beta2_VersionConfig(in *kubeone.VersionC
 	return autoConvert_kubeone_VersionConfig_To_v1beta2_VersionConfig(in, out, s)
 }
 
+func autoConvert_v1beta2_VsphereFileVolumesSpec_To_kubeone_VsphereFileVolumesSpec(in *VsphereFileVolumesSpec, out *kubeone.VsphereFileVolumesSpec, s conversion.Scope) error {
+	out.StorageClassName = in.StorageClassName
+	out.DatastoreURL = in.DatastoreURL
+	out.NetPermissions = *(*[]kubeone.VsphereNetPermission)(unsafe.Pointer(&in.NetPermissions))
+	return nil
+}
+
+// Convert_v1beta2_VsphereFileVolumesSpec_To_kubeone_VsphereFileVolumesSpec is an autogenerated conversion function.
+func Convert_v1beta2_VsphereFileVolumesSpec_To_kubeone_VsphereFileVolumesSpec(in *VsphereFileVolumesSpec, out *kubeone.VsphereFileVolumesSpec, s conversion.Scope) error {
+	return autoConvert_v1beta2_VsphereFileVolumesSpec_To_kubeone_VsphereFileVolumesSpec(in, out, s)
+}
+
+func autoConvert_kubeone_VsphereFileVolumesSpec_To_v1beta2_VsphereFileVolumesSpec(in *kubeone.VsphereFileVolumesSpec, out *VsphereFileVolumesSpec, s conversion.Scope) error {
+	out.StorageClassName = in.StorageClassName
+	out.DatastoreURL = in.DatastoreURL
+	out.NetPermissions = *(*[]VsphereNetPermission)(unsafe.Pointer(&in.NetPermissions))
+	return nil
+}
+
+// Convert_kubeone_VsphereFileVolumesSpec_To_v1beta2_VsphereFileVolumesSpec is an autogenerated conversion function.
+func Convert_kubeone_VsphereFileVolumesSpec_To_v1beta2_VsphereFileVolumesSpec(in *kubeone.VsphereFileVolumesSpec, out *VsphereFileVolumesSpec, s conversion.Scope) error {
+	return autoConvert_kubeone_VsphereFileVolumesSpec_To_v1beta2_VsphereFileVolumesSpec(in, out, s)
+}
+
+func autoConvert_v1beta2_VsphereNetPermission_To_kubeone_VsphereNetPermission(in *VsphereNetPermission, out *kubeone.VsphereNetPermission, s conversion.Scope) error {
+	out.Name = in.Name
+	out.IPs = in.IPs
+	out.Permissions = in.Permissions
+	out.RootSquash = in.RootSquash
+	return nil
+}
+
+// Convert_v1beta2_VsphereNetPermission_To_kubeone_VsphereNetPermission is an autogenerated conversion function.
+func Convert_v1beta2_VsphereNetPermission_To_kubeone_VsphereNetPermission(in *VsphereNetPermission, out *kubeone.VsphereNetPermission, s conversion.Scope) error {
+	return autoConvert_v1beta2_VsphereNetPermission_To_kubeone_VsphereNetPermission(in, out, s)
+}
+
+func autoConvert_kubeone_VsphereNetPermission_To_v1beta2_VsphereNetPermission(in *kubeone.VsphereNetPermission, out *VsphereNetPermission, s conversion.Scope) error {
+	out.Name = in.Name
+	out.IPs = in.IPs
+	out.Permissions = in.Permissions
+	out.RootSquash = in.RootSquash
+	return nil
+}
+
+// Convert_kubeone_VsphereNetPermission_To_v1beta2_VsphereNetPermission is an autogenerated conversion function.
+func Convert_kubeone_VsphereNetPermission_To_v1beta2_VsphereNetPermission(in *kubeone.VsphereNetPermission, out *VsphereNetPermission, s conversion.Scope) error {
+	return autoConvert_kubeone_VsphereNetPermission_To_v1beta2_VsphereNetPermission(in, out, s)
+}
+
 func autoConvert_v1beta2_VsphereSpec_To_kubeone_VsphereSpec(in *VsphereSpec, out *kubeone.VsphereSpec, s conversion.Scope) error {
+	out.StoragePolicyName = in.StoragePolicyName
+	out.DatastoreURL = in.DatastoreURL
+	out.FileVolumes = (*kubeone.VsphereFileVolumesSpec)(unsafe.Pointer(in.FileVolumes))
 	return nil
 }
 
@@ -2229,6 +4488,9 @@ func Convert_v1beta2_VsphereSpec_To_kubeone_VsphereSpec(in *VsphereSpec, out *ku
 }
 
 func autoConvert_kubeone_VsphereSpec_To_v1beta2_VsphereSpec(in *kubeone.VsphereSpec, out *VsphereSpec, s conversion.Scope) error {
+	out.StoragePolicyName = in.StoragePolicyName
+	out.DatastoreURL = in.DatastoreURL
+	out.FileVolumes = (*VsphereFileVolumesSpec)(unsafe.Pointer(in.FileVolumes))
 	return nil
 }
 
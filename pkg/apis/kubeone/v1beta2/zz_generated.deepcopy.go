@@ -25,7 +25,9 @@ import (
 	json "encoding/json"
 
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -36,6 +38,11 @@ func (in *APIEndpoint) DeepCopyInto(out *APIEndpoint) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AdditionalEndpoints != nil {
+		in, out := &in.AdditionalEndpoints, &out.AdditionalEndpoints
+		*out = make([]AdditionalAPIEndpoint, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -65,6 +72,22 @@ func (in *AWSSpec) DeepCopy() *AWSSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdditionalAPIEndpoint) DeepCopyInto(out *AdditionalAPIEndpoint) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdditionalAPIEndpoint.
+func (in *AdditionalAPIEndpoint) DeepCopy() *AdditionalAPIEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(AdditionalAPIEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Addon) DeepCopyInto(out *Addon) {
 	*out = *in
@@ -75,6 +98,16 @@ func (in *Addon) DeepCopyInto(out *Addon) {
 			(*out)[key] = val
 		}
 	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReadinessChecks != nil {
+		in, out := &in.ReadinessChecks, &out.ReadinessChecks
+		*out = make([]AddonReadinessCheck, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -88,9 +121,35 @@ func (in *Addon) DeepCopy() *Addon {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonReadinessCheck) DeepCopyInto(out *AddonReadinessCheck) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonReadinessCheck.
+func (in *AddonReadinessCheck) DeepCopy() *AddonReadinessCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonReadinessCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Addons) DeepCopyInto(out *Addons) {
 	*out = *in
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(AddonsSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(AddonsVerification)
+		**out = **in
+	}
 	if in.GlobalParams != nil {
 		in, out := &in.GlobalParams, &out.GlobalParams
 		*out = make(map[string]string, len(*in))
@@ -119,321 +178,764 @@ func (in *Addons) DeepCopy() *Addons {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AzureSpec) DeepCopyInto(out *AzureSpec) {
+func (in *AddonsGitSource) DeepCopyInto(out *AddonsGitSource) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureSpec.
-func (in *AzureSpec) DeepCopy() *AzureSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonsGitSource.
+func (in *AddonsGitSource) DeepCopy() *AddonsGitSource {
 	if in == nil {
 		return nil
 	}
-	out := new(AzureSpec)
+	out := new(AddonsGitSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BinaryAsset) DeepCopyInto(out *BinaryAsset) {
+func (in *AddonsHTTPSource) DeepCopyInto(out *AddonsHTTPSource) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BinaryAsset.
-func (in *BinaryAsset) DeepCopy() *BinaryAsset {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonsHTTPSource.
+func (in *AddonsHTTPSource) DeepCopy() *AddonsHTTPSource {
 	if in == nil {
 		return nil
 	}
-	out := new(BinaryAsset)
+	out := new(AddonsHTTPSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CNI) DeepCopyInto(out *CNI) {
+func (in *AddonsSource) DeepCopyInto(out *AddonsSource) {
 	*out = *in
-	if in.Canal != nil {
-		in, out := &in.Canal, &out.Canal
-		*out = new(CanalSpec)
-		**out = **in
-	}
-	if in.Cilium != nil {
-		in, out := &in.Cilium, &out.Cilium
-		*out = new(CiliumSpec)
-		**out = **in
-	}
-	if in.WeaveNet != nil {
-		in, out := &in.WeaveNet, &out.WeaveNet
-		*out = new(WeaveNetSpec)
+	if in.Git != nil {
+		in, out := &in.Git, &out.Git
+		*out = new(AddonsGitSource)
 		**out = **in
 	}
-	if in.External != nil {
-		in, out := &in.External, &out.External
-		*out = new(ExternalCNISpec)
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(AddonsHTTPSource)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CNI.
-func (in *CNI) DeepCopy() *CNI {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonsSource.
+func (in *AddonsSource) DeepCopy() *AddonsSource {
 	if in == nil {
 		return nil
 	}
-	out := new(CNI)
+	out := new(AddonsSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CanalSpec) DeepCopyInto(out *CanalSpec) {
+func (in *AddonsVerification) DeepCopyInto(out *AddonsVerification) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanalSpec.
-func (in *CanalSpec) DeepCopy() *CanalSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonsVerification.
+func (in *AddonsVerification) DeepCopy() *AddonsVerification {
 	if in == nil {
 		return nil
 	}
-	out := new(CanalSpec)
+	out := new(AddonsVerification)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CiliumSpec) DeepCopyInto(out *CiliumSpec) {
+func (in *AzureSpec) DeepCopyInto(out *AzureSpec) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CiliumSpec.
-func (in *CiliumSpec) DeepCopy() *CiliumSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureSpec.
+func (in *AzureSpec) DeepCopy() *AzureSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(CiliumSpec)
+	out := new(AzureSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CloudProviderSpec) DeepCopyInto(out *CloudProviderSpec) {
+func (in *BGPConfig) DeepCopyInto(out *BGPConfig) {
 	*out = *in
-	if in.AWS != nil {
-		in, out := &in.AWS, &out.AWS
-		*out = new(AWSSpec)
-		**out = **in
-	}
-	if in.Azure != nil {
-		in, out := &in.Azure, &out.Azure
-		*out = new(AzureSpec)
-		**out = **in
-	}
-	if in.DigitalOcean != nil {
-		in, out := &in.DigitalOcean, &out.DigitalOcean
-		*out = new(DigitalOceanSpec)
-		**out = **in
-	}
-	if in.GCE != nil {
-		in, out := &in.GCE, &out.GCE
-		*out = new(GCESpec)
-		**out = **in
-	}
-	if in.Hetzner != nil {
-		in, out := &in.Hetzner, &out.Hetzner
-		*out = new(HetznerSpec)
-		**out = **in
-	}
-	if in.Nutanix != nil {
-		in, out := &in.Nutanix, &out.Nutanix
-		*out = new(NutanixSpec)
-		**out = **in
-	}
-	if in.Openstack != nil {
-		in, out := &in.Openstack, &out.Openstack
-		*out = new(OpenstackSpec)
-		**out = **in
-	}
-	if in.EquinixMetal != nil {
-		in, out := &in.EquinixMetal, &out.EquinixMetal
-		*out = new(EquinixMetalSpec)
-		**out = **in
-	}
-	if in.VMwareCloudDirector != nil {
-		in, out := &in.VMwareCloudDirector, &out.VMwareCloudDirector
-		*out = new(VMwareCloudDirectorSpec)
-		**out = **in
+	if in.Peers != nil {
+		in, out := &in.Peers, &out.Peers
+		*out = make([]BGPPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.Vsphere != nil {
-		in, out := &in.Vsphere, &out.Vsphere
-		*out = new(VsphereSpec)
-		**out = **in
+	if in.RouteReflectors != nil {
+		in, out := &in.RouteReflectors, &out.RouteReflectors
+		*out = new(BGPRouteReflectors)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.None != nil {
-		in, out := &in.None, &out.None
-		*out = new(NoneSpec)
-		**out = **in
+	if in.AdvertisedCIDRs != nil {
+		in, out := &in.AdvertisedCIDRs, &out.AdvertisedCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudProviderSpec.
-func (in *CloudProviderSpec) DeepCopy() *CloudProviderSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPConfig.
+func (in *BGPConfig) DeepCopy() *BGPConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(CloudProviderSpec)
+	out := new(BGPConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterNetworkConfig) DeepCopyInto(out *ClusterNetworkConfig) {
+func (in *BGPPeer) DeepCopyInto(out *BGPPeer) {
 	*out = *in
-	if in.CNI != nil {
-		in, out := &in.CNI, &out.CNI
-		*out = new(CNI)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.KubeProxy != nil {
-		in, out := &in.KubeProxy, &out.KubeProxy
-		*out = new(KubeProxyConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.NodeCIDRMaskSizeIPv4 != nil {
-		in, out := &in.NodeCIDRMaskSizeIPv4, &out.NodeCIDRMaskSizeIPv4
-		*out = new(int)
-		**out = **in
-	}
-	if in.NodeCIDRMaskSizeIPv6 != nil {
-		in, out := &in.NodeCIDRMaskSizeIPv6, &out.NodeCIDRMaskSizeIPv6
-		*out = new(int)
-		**out = **in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterNetworkConfig.
-func (in *ClusterNetworkConfig) DeepCopy() *ClusterNetworkConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPPeer.
+func (in *BGPPeer) DeepCopy() *BGPPeer {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterNetworkConfig)
+	out := new(BGPPeer)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContainerRuntimeConfig) DeepCopyInto(out *ContainerRuntimeConfig) {
+func (in *BGPRouteReflectors) DeepCopyInto(out *BGPRouteReflectors) {
 	*out = *in
-	if in.Docker != nil {
-		in, out := &in.Docker, &out.Docker
-		*out = new(ContainerRuntimeDocker)
-		(*in).DeepCopyInto(*out)
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
-	if in.Containerd != nil {
-		in, out := &in.Containerd, &out.Containerd
-		*out = new(ContainerRuntimeContainerd)
-		(*in).DeepCopyInto(*out)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPRouteReflectors.
+func (in *BGPRouteReflectors) DeepCopy() *BGPRouteReflectors {
+	if in == nil {
+		return nil
 	}
+	out := new(BGPRouteReflectors)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BMCConfig) DeepCopyInto(out *BMCConfig) {
+	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerRuntimeConfig.
-func (in *ContainerRuntimeConfig) DeepCopy() *ContainerRuntimeConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BMCConfig.
+func (in *BMCConfig) DeepCopy() *BMCConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(ContainerRuntimeConfig)
+	out := new(BMCConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContainerRuntimeContainerd) DeepCopyInto(out *ContainerRuntimeContainerd) {
+func (in *BackupsConfig) DeepCopyInto(out *BackupsConfig) {
 	*out = *in
-	if in.Registries != nil {
-		in, out := &in.Registries, &out.Registries
-		*out = make(map[string]ContainerdRegistry, len(*in))
-		for key, val := range *in {
-			(*out)[key] = *val.DeepCopy()
-		}
+	if in.Etcd != nil {
+		in, out := &in.Etcd, &out.Etcd
+		*out = new(EtcdBackupsConfig)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerRuntimeContainerd.
-func (in *ContainerRuntimeContainerd) DeepCopy() *ContainerRuntimeContainerd {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupsConfig.
+func (in *BackupsConfig) DeepCopy() *BackupsConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(ContainerRuntimeContainerd)
+	out := new(BackupsConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContainerRuntimeDocker) DeepCopyInto(out *ContainerRuntimeDocker) {
+func (in *BinaryAsset) DeepCopyInto(out *BinaryAsset) {
 	*out = *in
-	if in.RegistryMirrors != nil {
-		in, out := &in.RegistryMirrors, &out.RegistryMirrors
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerRuntimeDocker.
-func (in *ContainerRuntimeDocker) DeepCopy() *ContainerRuntimeDocker {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BinaryAsset.
+func (in *BinaryAsset) DeepCopy() *BinaryAsset {
 	if in == nil {
 		return nil
 	}
-	out := new(ContainerRuntimeDocker)
+	out := new(BinaryAsset)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContainerdRegistry) DeepCopyInto(out *ContainerdRegistry) {
+func (in *CNI) DeepCopyInto(out *CNI) {
 	*out = *in
-	if in.Mirrors != nil {
-		in, out := &in.Mirrors, &out.Mirrors
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Canal != nil {
+		in, out := &in.Canal, &out.Canal
+		*out = new(CanalSpec)
+		**out = **in
 	}
-	if in.TLSConfig != nil {
-		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(ContainerdTLSConfig)
+	if in.Cilium != nil {
+		in, out := &in.Cilium, &out.Cilium
+		*out = new(CiliumSpec)
 		**out = **in
 	}
-	if in.Auth != nil {
-		in, out := &in.Auth, &out.Auth
-		*out = new(ContainerdRegistryAuthConfig)
+	if in.Calico != nil {
+		in, out := &in.Calico, &out.Calico
+		*out = new(CalicoSpec)
+		**out = **in
+	}
+	if in.WeaveNet != nil {
+		in, out := &in.WeaveNet, &out.WeaveNet
+		*out = new(WeaveNetSpec)
+		**out = **in
+	}
+	if in.External != nil {
+		in, out := &in.External, &out.External
+		*out = new(ExternalCNISpec)
+		**out = **in
+	}
+	if in.EnableBandwidthPlugin != nil {
+		in, out := &in.EnableBandwidthPlugin, &out.EnableBandwidthPlugin
+		*out = new(bool)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerdRegistry.
-func (in *ContainerdRegistry) DeepCopy() *ContainerdRegistry {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CNI.
+func (in *CNI) DeepCopy() *CNI {
 	if in == nil {
 		return nil
 	}
-	out := new(ContainerdRegistry)
+	out := new(CNI)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContainerdRegistryAuthConfig) DeepCopyInto(out *ContainerdRegistryAuthConfig) {
+func (in *CalicoSpec) DeepCopyInto(out *CalicoSpec) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerdRegistryAuthConfig.
-func (in *ContainerdRegistryAuthConfig) DeepCopy() *ContainerdRegistryAuthConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CalicoSpec.
+func (in *CalicoSpec) DeepCopy() *CalicoSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CalicoSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanalSpec) DeepCopyInto(out *CanalSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanalSpec.
+func (in *CanalSpec) DeepCopy() *CanalSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CanalSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryUpgradeConfig) DeepCopyInto(out *CanaryUpgradeConfig) {
+	*out = *in
+	if in.SoakTime != nil {
+		in, out := &in.SoakTime, &out.SoakTime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryUpgradeConfig.
+func (in *CanaryUpgradeConfig) DeepCopy() *CanaryUpgradeConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryUpgradeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateAuthorityConfig) DeepCopyInto(out *CertificateAuthorityConfig) {
+	*out = *in
+	if in.Kubernetes != nil {
+		in, out := &in.Kubernetes, &out.Kubernetes
+		*out = new(CertificateAuthoritySource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Etcd != nil {
+		in, out := &in.Etcd, &out.Etcd
+		*out = new(CertificateAuthoritySource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FrontProxy != nil {
+		in, out := &in.FrontProxy, &out.FrontProxy
+		*out = new(CertificateAuthoritySource)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateAuthorityConfig.
+func (in *CertificateAuthorityConfig) DeepCopy() *CertificateAuthorityConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateAuthorityConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateAuthoritySource) DeepCopyInto(out *CertificateAuthoritySource) {
+	*out = *in
+	if in.Vault != nil {
+		in, out := &in.Vault, &out.Vault
+		*out = new(VaultCertificateAuthoritySource)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateAuthoritySource.
+func (in *CertificateAuthoritySource) DeepCopy() *CertificateAuthoritySource {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateAuthoritySource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CgroupsConfig) DeepCopyInto(out *CgroupsConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CgroupsConfig.
+func (in *CgroupsConfig) DeepCopy() *CgroupsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CgroupsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CiliumSpec) DeepCopyInto(out *CiliumSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CiliumSpec.
+func (in *CiliumSpec) DeepCopy() *CiliumSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CiliumSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudControllerManagerConfig) DeepCopyInto(out *CloudControllerManagerConfig) {
+	*out = *in
+	if in.Flags != nil {
+		in, out := &in.Flags, &out.Flags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudControllerManagerConfig.
+func (in *CloudControllerManagerConfig) DeepCopy() *CloudControllerManagerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudControllerManagerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudProviderSpec) DeepCopyInto(out *CloudProviderSpec) {
+	*out = *in
+	if in.CloudControllerManager != nil {
+		in, out := &in.CloudControllerManager, &out.CloudControllerManager
+		*out = new(CloudControllerManagerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AWS != nil {
+		in, out := &in.AWS, &out.AWS
+		*out = new(AWSSpec)
+		**out = **in
+	}
+	if in.Azure != nil {
+		in, out := &in.Azure, &out.Azure
+		*out = new(AzureSpec)
+		**out = **in
+	}
+	if in.DigitalOcean != nil {
+		in, out := &in.DigitalOcean, &out.DigitalOcean
+		*out = new(DigitalOceanSpec)
+		**out = **in
+	}
+	if in.GCE != nil {
+		in, out := &in.GCE, &out.GCE
+		*out = new(GCESpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hetzner != nil {
+		in, out := &in.Hetzner, &out.Hetzner
+		*out = new(HetznerSpec)
+		**out = **in
+	}
+	if in.Kubevirt != nil {
+		in, out := &in.Kubevirt, &out.Kubevirt
+		*out = new(KubevirtSpec)
+		**out = **in
+	}
+	if in.Nutanix != nil {
+		in, out := &in.Nutanix, &out.Nutanix
+		*out = new(NutanixSpec)
+		**out = **in
+	}
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(OCISpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Openstack != nil {
+		in, out := &in.Openstack, &out.Openstack
+		*out = new(OpenstackSpec)
+		**out = **in
+	}
+	if in.Proxmox != nil {
+		in, out := &in.Proxmox, &out.Proxmox
+		*out = new(ProxmoxSpec)
+		**out = **in
+	}
+	if in.EquinixMetal != nil {
+		in, out := &in.EquinixMetal, &out.EquinixMetal
+		*out = new(EquinixMetalSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VMwareCloudDirector != nil {
+		in, out := &in.VMwareCloudDirector, &out.VMwareCloudDirector
+		*out = new(VMwareCloudDirectorSpec)
+		**out = **in
+	}
+	if in.Vsphere != nil {
+		in, out := &in.Vsphere, &out.Vsphere
+		*out = new(VsphereSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.None != nil {
+		in, out := &in.None, &out.None
+		*out = new(NoneSpec)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudProviderSpec.
+func (in *CloudProviderSpec) DeepCopy() *CloudProviderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudProviderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAutoscaler) DeepCopyInto(out *ClusterAutoscaler) {
+	*out = *in
+	if in.NodeGroups != nil {
+		in, out := &in.NodeGroups, &out.NodeGroups
+		*out = make([]ClusterAutoscalerNodeGroup, len(*in))
+		copy(*out, *in)
+	}
+	if in.SkipNodesWithLocalStorage != nil {
+		in, out := &in.SkipNodesWithLocalStorage, &out.SkipNodesWithLocalStorage
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ScaleDownUnneededTime != nil {
+		in, out := &in.ScaleDownUnneededTime, &out.ScaleDownUnneededTime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAutoscaler.
+func (in *ClusterAutoscaler) DeepCopy() *ClusterAutoscaler {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAutoscaler)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAutoscalerNodeGroup) DeepCopyInto(out *ClusterAutoscalerNodeGroup) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAutoscalerNodeGroup.
+func (in *ClusterAutoscalerNodeGroup) DeepCopy() *ClusterAutoscalerNodeGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAutoscalerNodeGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterDNSConfig) DeepCopyInto(out *ClusterDNSConfig) {
+	*out = *in
+	if in.UpstreamServers != nil {
+		in, out := &in.UpstreamServers, &out.UpstreamServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SearchDomains != nil {
+		in, out := &in.SearchDomains, &out.SearchDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterDNSConfig.
+func (in *ClusterDNSConfig) DeepCopy() *ClusterDNSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterDNSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterNetworkConfig) DeepCopyInto(out *ClusterNetworkConfig) {
+	*out = *in
+	if in.CNI != nil {
+		in, out := &in.CNI, &out.CNI
+		*out = new(CNI)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubeProxy != nil {
+		in, out := &in.KubeProxy, &out.KubeProxy
+		*out = new(KubeProxyConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeCIDRMaskSizeIPv4 != nil {
+		in, out := &in.NodeCIDRMaskSizeIPv4, &out.NodeCIDRMaskSizeIPv4
+		*out = new(int)
+		**out = **in
+	}
+	if in.NodeCIDRMaskSizeIPv6 != nil {
+		in, out := &in.NodeCIDRMaskSizeIPv6, &out.NodeCIDRMaskSizeIPv6
+		*out = new(int)
+		**out = **in
+	}
+	if in.AllocateNodeCIDRs != nil {
+		in, out := &in.AllocateNodeCIDRs, &out.AllocateNodeCIDRs
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EgressGateways != nil {
+		in, out := &in.EgressGateways, &out.EgressGateways
+		*out = make([]EgressGateway, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(ClusterDNSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BGP != nil {
+		in, out := &in.BGP, &out.BGP
+		*out = new(BGPConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterNetworkConfig.
+func (in *ClusterNetworkConfig) DeepCopy() *ClusterNetworkConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterNetworkConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerRuntimeConfig) DeepCopyInto(out *ContainerRuntimeConfig) {
+	*out = *in
+	if in.Docker != nil {
+		in, out := &in.Docker, &out.Docker
+		*out = new(ContainerRuntimeDocker)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Containerd != nil {
+		in, out := &in.Containerd, &out.Containerd
+		*out = new(ContainerRuntimeContainerd)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerRuntimeConfig.
+func (in *ContainerRuntimeConfig) DeepCopy() *ContainerRuntimeConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerRuntimeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerRuntimeContainerd) DeepCopyInto(out *ContainerRuntimeContainerd) {
+	*out = *in
+	if in.Registries != nil {
+		in, out := &in.Registries, &out.Registries
+		*out = make(map[string]ContainerdRegistry, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerRuntimeContainerd.
+func (in *ContainerRuntimeContainerd) DeepCopy() *ContainerRuntimeContainerd {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerRuntimeContainerd)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerRuntimeDocker) DeepCopyInto(out *ContainerRuntimeDocker) {
+	*out = *in
+	if in.RegistryMirrors != nil {
+		in, out := &in.RegistryMirrors, &out.RegistryMirrors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerRuntimeDocker.
+func (in *ContainerRuntimeDocker) DeepCopy() *ContainerRuntimeDocker {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerRuntimeDocker)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerdRegistry) DeepCopyInto(out *ContainerdRegistry) {
+	*out = *in
+	if in.Mirrors != nil {
+		in, out := &in.Mirrors, &out.Mirrors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TLSConfig != nil {
+		in, out := &in.TLSConfig, &out.TLSConfig
+		*out = new(ContainerdTLSConfig)
+		**out = **in
+	}
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(ContainerdRegistryAuthConfig)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerdRegistry.
+func (in *ContainerdRegistry) DeepCopy() *ContainerdRegistry {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerdRegistry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerdRegistryAuthConfig) DeepCopyInto(out *ContainerdRegistryAuthConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerdRegistryAuthConfig.
+func (in *ContainerdRegistryAuthConfig) DeepCopy() *ContainerdRegistryAuthConfig {
 	if in == nil {
 		return nil
 	}
@@ -458,6 +960,77 @@ func (in *ContainerdTLSConfig) DeepCopy() *ContainerdTLSConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneComponentConfig) DeepCopyInto(out *ControlPlaneComponentConfig) {
+	*out = *in
+	if in.Flags != nil {
+		in, out := &in.Flags, &out.Flags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.FeatureGates != nil {
+		in, out := &in.FeatureGates, &out.FeatureGates
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ExtraVolumes != nil {
+		in, out := &in.ExtraVolumes, &out.ExtraVolumes
+		*out = make([]HostPathMount, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneComponentConfig.
+func (in *ControlPlaneComponentConfig) DeepCopy() *ControlPlaneComponentConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneComponentConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneComponents) DeepCopyInto(out *ControlPlaneComponents) {
+	*out = *in
+	if in.ControllerManager != nil {
+		in, out := &in.ControllerManager, &out.ControllerManager
+		*out = new(ControlPlaneComponentConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Scheduler != nil {
+		in, out := &in.Scheduler, &out.Scheduler
+		*out = new(ControlPlaneComponentConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.APIServer != nil {
+		in, out := &in.APIServer, &out.APIServer
+		*out = new(ControlPlaneComponentConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = new(KubeadmPatches)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneComponents.
+func (in *ControlPlaneComponents) DeepCopy() *ControlPlaneComponents {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneComponents)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControlPlaneConfig) DeepCopyInto(out *ControlPlaneConfig) {
 	*out = *in
@@ -468,6 +1041,11 @@ func (in *ControlPlaneConfig) DeepCopyInto(out *ControlPlaneConfig) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Kernel != nil {
+		in, out := &in.Kernel, &out.Kernel
+		*out = new(KernelConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -528,6 +1106,27 @@ func (in *DNSConfig) DeepCopy() *DNSConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultDenyNetworkPolicy) DeepCopyInto(out *DefaultDenyNetworkPolicy) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultDenyNetworkPolicy.
+func (in *DefaultDenyNetworkPolicy) DeepCopy() *DefaultDenyNetworkPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultDenyNetworkPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DigitalOceanSpec) DeepCopyInto(out *DigitalOceanSpec) {
 	*out = *in
@@ -544,6 +1143,32 @@ func (in *DigitalOceanSpec) DeepCopy() *DigitalOceanSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DrainConfig) DeepCopyInto(out *DrainConfig) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.SkipNamespaces != nil {
+		in, out := &in.SkipNamespaces, &out.SkipNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DrainConfig.
+func (in *DrainConfig) DeepCopy() *DrainConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DrainConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DynamicAuditLog) DeepCopyInto(out *DynamicAuditLog) {
 	*out = *in
@@ -568,6 +1193,11 @@ func (in *DynamicWorkerConfig) DeepCopyInto(out *DynamicWorkerConfig) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.UpgradeStrategy != nil {
+		in, out := &in.UpgradeStrategy, &out.UpgradeStrategy
+		*out = new(MachineDeploymentUpgradeStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Config.DeepCopyInto(&out.Config)
 	return
 }
@@ -582,6 +1212,46 @@ func (in *DynamicWorkerConfig) DeepCopy() *DynamicWorkerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressGateway) DeepCopyInto(out *EgressGateway) {
+	*out = *in
+	if in.PodSelector != nil {
+		in, out := &in.PodSelector, &out.PodSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.DestinationCIDRs != nil {
+		in, out := &in.DestinationCIDRs, &out.DestinationCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludedCIDRs != nil {
+		in, out := &in.ExcludedCIDRs, &out.ExcludedCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressGateway.
+func (in *EgressGateway) DeepCopy() *EgressGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EncryptionProviders) DeepCopyInto(out *EncryptionProviders) {
 	*out = *in
@@ -598,9 +1268,30 @@ func (in *EncryptionProviders) DeepCopy() *EncryptionProviders {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EquinixMetalLoadBalancerSpec) DeepCopyInto(out *EquinixMetalLoadBalancerSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EquinixMetalLoadBalancerSpec.
+func (in *EquinixMetalLoadBalancerSpec) DeepCopy() *EquinixMetalLoadBalancerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EquinixMetalLoadBalancerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EquinixMetalSpec) DeepCopyInto(out *EquinixMetalSpec) {
 	*out = *in
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(EquinixMetalLoadBalancerSpec)
+		**out = **in
+	}
 	return
 }
 
@@ -614,6 +1305,55 @@ func (in *EquinixMetalSpec) DeepCopy() *EquinixMetalSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdBackupsConfig) DeepCopyInto(out *EtcdBackupsConfig) {
+	*out = *in
+	out.Target = in.Target
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdBackupsConfig.
+func (in *EtcdBackupsConfig) DeepCopy() *EtcdBackupsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdBackupsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdBackupsTarget) DeepCopyInto(out *EtcdBackupsTarget) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdBackupsTarget.
+func (in *EtcdBackupsTarget) DeepCopy() *EtcdBackupsTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdBackupsTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdDefragmentationConfig) DeepCopyInto(out *EtcdDefragmentationConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdDefragmentationConfig.
+func (in *EtcdDefragmentationConfig) DeepCopy() *EtcdDefragmentationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdDefragmentationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalCNISpec) DeepCopyInto(out *ExternalCNISpec) {
 	*out = *in
@@ -661,7 +1401,7 @@ func (in *Features) DeepCopyInto(out *Features) {
 	if in.MetricsServer != nil {
 		in, out := &in.MetricsServer, &out.MetricsServer
 		*out = new(MetricsServer)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.OpenIDConnect != nil {
 		in, out := &in.OpenIDConnect, &out.OpenIDConnect
@@ -678,6 +1418,61 @@ func (in *Features) DeepCopyInto(out *Features) {
 		*out = new(NodeLocalDNS)
 		**out = **in
 	}
+	if in.NvidiaGPU != nil {
+		in, out := &in.NvidiaGPU, &out.NvidiaGPU
+		*out = new(NvidiaGPU)
+		**out = **in
+	}
+	if in.NodeSwap != nil {
+		in, out := &in.NodeSwap, &out.NodeSwap
+		*out = new(NodeSwap)
+		**out = **in
+	}
+	if in.SeccompDefault != nil {
+		in, out := &in.SeccompDefault, &out.SeccompDefault
+		*out = new(SeccompDefault)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MetalLB != nil {
+		in, out := &in.MetalLB, &out.MetalLB
+		*out = new(MetalLB)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GatewayAPI != nil {
+		in, out := &in.GatewayAPI, &out.GatewayAPI
+		*out = new(GatewayAPI)
+		**out = **in
+	}
+	if in.IstioAmbient != nil {
+		in, out := &in.IstioAmbient, &out.IstioAmbient
+		*out = new(IstioAmbient)
+		**out = **in
+	}
+	if in.KubeVIP != nil {
+		in, out := &in.KubeVIP, &out.KubeVIP
+		*out = new(KubeVIP)
+		**out = **in
+	}
+	if in.DefaultDenyNetworkPolicy != nil {
+		in, out := &in.DefaultDenyNetworkPolicy, &out.DefaultDenyNetworkPolicy
+		*out = new(DefaultDenyNetworkPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterAutoscaler != nil {
+		in, out := &in.ClusterAutoscaler, &out.ClusterAutoscaler
+		*out = new(ClusterAutoscaler)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LocalStorage != nil {
+		in, out := &in.LocalStorage, &out.LocalStorage
+		*out = new(LocalStorage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IngressNginx != nil {
+		in, out := &in.IngressNginx, &out.IngressNginx
+		*out = new(IngressNginx)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -691,9 +1486,30 @@ func (in *Features) DeepCopy() *Features {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCESharedVPCSpec) DeepCopyInto(out *GCESharedVPCSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCESharedVPCSpec.
+func (in *GCESharedVPCSpec) DeepCopy() *GCESharedVPCSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GCESharedVPCSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GCESpec) DeepCopyInto(out *GCESpec) {
 	*out = *in
+	if in.SharedVPC != nil {
+		in, out := &in.SharedVPC, &out.SharedVPC
+		*out = new(GCESharedVPCSpec)
+		**out = **in
+	}
 	return
 }
 
@@ -707,6 +1523,22 @@ func (in *GCESpec) DeepCopy() *GCESpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayAPI) DeepCopyInto(out *GatewayAPI) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayAPI.
+func (in *GatewayAPI) DeepCopy() *GatewayAPI {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayAPI)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HelmRelease) DeepCopyInto(out *HelmRelease) {
 	*out = *in
@@ -800,15 +1632,36 @@ func (in *HostConfig) DeepCopyInto(out *HostConfig) {
 		}
 	}
 	in.Kubelet.DeepCopyInto(&out.Kubelet)
+	if in.BMC != nil {
+		in, out := &in.BMC, &out.BMC
+		*out = new(BMCConfig)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostConfig.
+func (in *HostConfig) DeepCopy() *HostConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HostConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostPathMount) DeepCopyInto(out *HostPathMount) {
+	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostConfig.
-func (in *HostConfig) DeepCopy() *HostConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostPathMount.
+func (in *HostPathMount) DeepCopy() *HostPathMount {
 	if in == nil {
 		return nil
 	}
-	out := new(HostConfig)
+	out := new(HostPathMount)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -816,6 +1669,8 @@ func (in *HostConfig) DeepCopy() *HostConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPTables) DeepCopyInto(out *IPTables) {
 	*out = *in
+	out.SyncPeriod = in.SyncPeriod
+	out.MinSyncPeriod = in.MinSyncPeriod
 	return
 }
 
@@ -840,6 +1695,8 @@ func (in *IPVSConfig) DeepCopyInto(out *IPVSConfig) {
 	out.TCPTimeout = in.TCPTimeout
 	out.TCPFinTimeout = in.TCPFinTimeout
 	out.UDPTimeout = in.UDPTimeout
+	out.SyncPeriod = in.SyncPeriod
+	out.MinSyncPeriod = in.MinSyncPeriod
 	return
 }
 
@@ -869,6 +1726,95 @@ func (in *ImageAsset) DeepCopy() *ImageAsset {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressNginx) DeepCopyInto(out *IngressNginx) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DefaultIngressClass != nil {
+		in, out := &in.DefaultIngressClass, &out.DefaultIngressClass
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ProxyProtocol != nil {
+		in, out := &in.ProxyProtocol, &out.ProxyProtocol
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ServiceAnnotations != nil {
+		in, out := &in.ServiceAnnotations, &out.ServiceAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressNginx.
+func (in *IngressNginx) DeepCopy() *IngressNginx {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressNginx)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IstioAmbient) DeepCopyInto(out *IstioAmbient) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IstioAmbient.
+func (in *IstioAmbient) DeepCopy() *IstioAmbient {
+	if in == nil {
+		return nil
+	}
+	out := new(IstioAmbient)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KernelConfig) DeepCopyInto(out *KernelConfig) {
+	*out = *in
+	if in.Sysctls != nil {
+		in, out := &in.Sysctls, &out.Sysctls
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Modules != nil {
+		in, out := &in.Modules, &out.Modules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KernelConfig.
+func (in *KernelConfig) DeepCopy() *KernelConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KernelConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeOneCluster) DeepCopyInto(out *KubeOneCluster) {
 	*out = *in
@@ -914,7 +1860,7 @@ func (in *KubeOneCluster) DeepCopyInto(out *KubeOneCluster) {
 	if in.SystemPackages != nil {
 		in, out := &in.SystemPackages, &out.SystemPackages
 		*out = new(SystemPackages)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.RegistryConfiguration != nil {
 		in, out := &in.RegistryConfiguration, &out.RegistryConfiguration
@@ -922,6 +1868,27 @@ func (in *KubeOneCluster) DeepCopyInto(out *KubeOneCluster) {
 		**out = **in
 	}
 	out.LoggingConfig = in.LoggingConfig
+	out.Cgroups = in.Cgroups
+	if in.ControlPlaneComponents != nil {
+		in, out := &in.ControlPlaneComponents, &out.ControlPlaneComponents
+		*out = new(ControlPlaneComponents)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Backups != nil {
+		in, out := &in.Backups, &out.Backups
+		*out = new(BackupsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Upgrades != nil {
+		in, out := &in.Upgrades, &out.Upgrades
+		*out = new(UpgradesConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CertificateAuthority != nil {
+		in, out := &in.CertificateAuthority, &out.CertificateAuthority
+		*out = new(CertificateAuthorityConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -956,6 +1923,11 @@ func (in *KubeProxyConfig) DeepCopyInto(out *KubeProxyConfig) {
 		*out = new(IPTables)
 		**out = **in
 	}
+	if in.NFTables != nil {
+		in, out := &in.NFTables, &out.NFTables
+		*out = new(NFTables)
+		**out = **in
+	}
 	return
 }
 
@@ -969,6 +1941,38 @@ func (in *KubeProxyConfig) DeepCopy() *KubeProxyConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeVIP) DeepCopyInto(out *KubeVIP) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeVIP.
+func (in *KubeVIP) DeepCopy() *KubeVIP {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeVIP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeadmPatches) DeepCopyInto(out *KubeadmPatches) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeadmPatches.
+func (in *KubeadmPatches) DeepCopy() *KubeadmPatches {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeadmPatches)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeletConfig) DeepCopyInto(out *KubeletConfig) {
 	*out = *in
@@ -1006,71 +2010,291 @@ func (in *KubeletConfig) DeepCopy() *KubeletConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(KubeletConfig)
+	out := new(KubeletConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubevirtSpec) DeepCopyInto(out *KubevirtSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubevirtSpec.
+func (in *KubevirtSpec) DeepCopy() *KubevirtSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubevirtSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalPathProvisioner) DeepCopyInto(out *LocalPathProvisioner) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalPathProvisioner.
+func (in *LocalPathProvisioner) DeepCopy() *LocalPathProvisioner {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalPathProvisioner)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalStorage) DeepCopyInto(out *LocalStorage) {
+	*out = *in
+	if in.LocalPath != nil {
+		in, out := &in.LocalPath, &out.LocalPath
+		*out = new(LocalPathProvisioner)
+		**out = **in
+	}
+	if in.TopoLVM != nil {
+		in, out := &in.TopoLVM, &out.TopoLVM
+		*out = new(TopoLVMProvisioner)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DefaultStorageClass != nil {
+		in, out := &in.DefaultStorageClass, &out.DefaultStorageClass
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalStorage.
+func (in *LocalStorage) DeepCopy() *LocalStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingConfig) DeepCopyInto(out *LoggingConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingConfig.
+func (in *LoggingConfig) DeepCopy() *LoggingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineControllerConfig) DeepCopyInto(out *MachineControllerConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineControllerConfig.
+func (in *MachineControllerConfig) DeepCopy() *MachineControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineDeploymentUpgradeStrategy) DeepCopyInto(out *MachineDeploymentUpgradeStrategy) {
+	*out = *in
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineDeploymentUpgradeStrategy.
+func (in *MachineDeploymentUpgradeStrategy) DeepCopy() *MachineDeploymentUpgradeStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineDeploymentUpgradeStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.Duration = in.Duration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetalLB) DeepCopyInto(out *MetalLB) {
+	*out = *in
+	if in.AddressPools != nil {
+		in, out := &in.AddressPools, &out.AddressPools
+		*out = make([]MetalLBAddressPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BGPPeers != nil {
+		in, out := &in.BGPPeers, &out.BGPPeers
+		*out = make([]MetalLBBGPPeer, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetalLB.
+func (in *MetalLB) DeepCopy() *MetalLB {
+	if in == nil {
+		return nil
+	}
+	out := new(MetalLB)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetalLBAddressPool) DeepCopyInto(out *MetalLBAddressPool) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetalLBAddressPool.
+func (in *MetalLBAddressPool) DeepCopy() *MetalLBAddressPool {
+	if in == nil {
+		return nil
+	}
+	out := new(MetalLBAddressPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetalLBBGPPeer) DeepCopyInto(out *MetalLBBGPPeer) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetalLBBGPPeer.
+func (in *MetalLBBGPPeer) DeepCopy() *MetalLBBGPPeer {
+	if in == nil {
+		return nil
+	}
+	out := new(MetalLBBGPPeer)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LoggingConfig) DeepCopyInto(out *LoggingConfig) {
+func (in *MetricsServer) DeepCopyInto(out *MetricsServer) {
 	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingConfig.
-func (in *LoggingConfig) DeepCopy() *LoggingConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsServer.
+func (in *MetricsServer) DeepCopy() *MetricsServer {
 	if in == nil {
 		return nil
 	}
-	out := new(LoggingConfig)
+	out := new(MetricsServer)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineControllerConfig) DeepCopyInto(out *MachineControllerConfig) {
+func (in *NFTables) DeepCopyInto(out *NFTables) {
 	*out = *in
+	out.SyncPeriod = in.SyncPeriod
+	out.MinSyncPeriod = in.MinSyncPeriod
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineControllerConfig.
-func (in *MachineControllerConfig) DeepCopy() *MachineControllerConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NFTables.
+func (in *NFTables) DeepCopy() *NFTables {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineControllerConfig)
+	out := new(NFTables)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MetricsServer) DeepCopyInto(out *MetricsServer) {
+func (in *NodeLocalDNS) DeepCopyInto(out *NodeLocalDNS) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsServer.
-func (in *MetricsServer) DeepCopy() *MetricsServer {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeLocalDNS.
+func (in *NodeLocalDNS) DeepCopy() *NodeLocalDNS {
 	if in == nil {
 		return nil
 	}
-	out := new(MetricsServer)
+	out := new(NodeLocalDNS)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NodeLocalDNS) DeepCopyInto(out *NodeLocalDNS) {
+func (in *NodeSwap) DeepCopyInto(out *NodeSwap) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeLocalDNS.
-func (in *NodeLocalDNS) DeepCopy() *NodeLocalDNS {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeSwap.
+func (in *NodeSwap) DeepCopy() *NodeSwap {
 	if in == nil {
 		return nil
 	}
-	out := new(NodeLocalDNS)
+	out := new(NodeSwap)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1107,6 +2331,43 @@ func (in *NutanixSpec) DeepCopy() *NutanixSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NvidiaGPU) DeepCopyInto(out *NvidiaGPU) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NvidiaGPU.
+func (in *NvidiaGPU) DeepCopy() *NvidiaGPU {
+	if in == nil {
+		return nil
+	}
+	out := new(NvidiaGPU)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCISpec) DeepCopyInto(out *OCISpec) {
+	*out = *in
+	if in.LoadBalancerSubnetIDs != nil {
+		in, out := &in.LoadBalancerSubnetIDs, &out.LoadBalancerSubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCISpec.
+func (in *OCISpec) DeepCopy() *OCISpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OCISpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenIDConnect) DeepCopyInto(out *OpenIDConnect) {
 	*out = *in
@@ -1172,6 +2433,76 @@ func (in *OperatingSystemManagerConfig) DeepCopy() *OperatingSystemManagerConfig
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatingSystemUpgradeConfig) DeepCopyInto(out *OperatingSystemUpgradeConfig) {
+	*out = *in
+	if in.MachineDeploymentImages != nil {
+		in, out := &in.MachineDeploymentImages, &out.MachineDeploymentImages
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatingSystemUpgradeConfig.
+func (in *OperatingSystemUpgradeConfig) DeepCopy() *OperatingSystemUpgradeConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatingSystemUpgradeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PackageRepositories) DeepCopyInto(out *PackageRepositories) {
+	*out = *in
+	if in.Kubernetes != nil {
+		in, out := &in.Kubernetes, &out.Kubernetes
+		*out = new(PackageRepository)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ContainerRuntime != nil {
+		in, out := &in.ContainerRuntime, &out.ContainerRuntime
+		*out = new(PackageRepository)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageRepositories.
+func (in *PackageRepositories) DeepCopy() *PackageRepositories {
+	if in == nil {
+		return nil
+	}
+	out := new(PackageRepositories)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PackageRepository) DeepCopyInto(out *PackageRepository) {
+	*out = *in
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageRepository.
+func (in *PackageRepository) DeepCopy() *PackageRepository {
+	if in == nil {
+		return nil
+	}
+	out := new(PackageRepository)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodNodeSelector) DeepCopyInto(out *PodNodeSelector) {
 	*out = *in
@@ -1321,6 +2652,22 @@ func (in *ProviderStaticNetworkConfig) DeepCopy() *ProviderStaticNetworkConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxmoxSpec) DeepCopyInto(out *ProxmoxSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxmoxSpec.
+func (in *ProxmoxSpec) DeepCopy() *ProxmoxSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxmoxSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProxyConfig) DeepCopyInto(out *ProxyConfig) {
 	*out = *in
@@ -1353,6 +2700,27 @@ func (in *RegistryConfiguration) DeepCopy() *RegistryConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeccompDefault) DeepCopyInto(out *SeccompDefault) {
+	*out = *in
+	if in.ProfilePaths != nil {
+		in, out := &in.ProfilePaths, &out.ProfilePaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeccompDefault.
+func (in *SeccompDefault) DeepCopy() *SeccompDefault {
+	if in == nil {
+		return nil
+	}
+	out := new(SeccompDefault)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StaticAuditLog) DeepCopyInto(out *StaticAuditLog) {
 	*out = *in
@@ -1396,6 +2764,11 @@ func (in *StaticWorkersConfig) DeepCopyInto(out *StaticWorkersConfig) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Kernel != nil {
+		in, out := &in.Kernel, &out.Kernel
+		*out = new(KernelConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1409,9 +2782,35 @@ func (in *StaticWorkersConfig) DeepCopy() *StaticWorkersConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticWorkersUpgradeConfig) DeepCopyInto(out *StaticWorkersUpgradeConfig) {
+	*out = *in
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticWorkersUpgradeConfig.
+func (in *StaticWorkersUpgradeConfig) DeepCopy() *StaticWorkersUpgradeConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticWorkersUpgradeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SystemPackages) DeepCopyInto(out *SystemPackages) {
 	*out = *in
+	if in.Repositories != nil {
+		in, out := &in.Repositories, &out.Repositories
+		*out = new(PackageRepositories)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1425,6 +2824,111 @@ func (in *SystemPackages) DeepCopy() *SystemPackages {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopoLVMProvisioner) DeepCopyInto(out *TopoLVMProvisioner) {
+	*out = *in
+	if in.SpareGB != nil {
+		in, out := &in.SpareGB, &out.SpareGB
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopoLVMProvisioner.
+func (in *TopoLVMProvisioner) DeepCopy() *TopoLVMProvisioner {
+	if in == nil {
+		return nil
+	}
+	out := new(TopoLVMProvisioner)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnattendedUpgradesConfig) DeepCopyInto(out *UnattendedUpgradesConfig) {
+	*out = *in
+	if in.RebootWindow != nil {
+		in, out := &in.RebootWindow, &out.RebootWindow
+		*out = new(MaintenanceWindow)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PackageBlacklist != nil {
+		in, out := &in.PackageBlacklist, &out.PackageBlacklist
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnattendedUpgradesConfig.
+func (in *UnattendedUpgradesConfig) DeepCopy() *UnattendedUpgradesConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(UnattendedUpgradesConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradesConfig) DeepCopyInto(out *UpgradesConfig) {
+	*out = *in
+	if in.Drain != nil {
+		in, out := &in.Drain, &out.Drain
+		*out = new(DrainConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(CanaryUpgradeConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IntermediateVersions != nil {
+		in, out := &in.IntermediateVersions, &out.IntermediateVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make([]MaintenanceWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OperatingSystem != nil {
+		in, out := &in.OperatingSystem, &out.OperatingSystem
+		*out = new(OperatingSystemUpgradeConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StaticWorkers != nil {
+		in, out := &in.StaticWorkers, &out.StaticWorkers
+		*out = new(StaticWorkersUpgradeConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Unattended != nil {
+		in, out := &in.Unattended, &out.Unattended
+		*out = new(UnattendedUpgradesConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EtcdDefragmentation != nil {
+		in, out := &in.EtcdDefragmentation, &out.EtcdDefragmentation
+		*out = new(EtcdDefragmentationConfig)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradesConfig.
+func (in *UpgradesConfig) DeepCopy() *UpgradesConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradesConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VMwareCloudDirectorSpec) DeepCopyInto(out *VMwareCloudDirectorSpec) {
 	*out = *in
@@ -1441,6 +2945,22 @@ func (in *VMwareCloudDirectorSpec) DeepCopy() *VMwareCloudDirectorSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultCertificateAuthoritySource) DeepCopyInto(out *VaultCertificateAuthoritySource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultCertificateAuthoritySource.
+func (in *VaultCertificateAuthoritySource) DeepCopy() *VaultCertificateAuthoritySource {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultCertificateAuthoritySource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VersionConfig) DeepCopyInto(out *VersionConfig) {
 	*out = *in
@@ -1457,9 +2977,51 @@ func (in *VersionConfig) DeepCopy() *VersionConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VsphereFileVolumesSpec) DeepCopyInto(out *VsphereFileVolumesSpec) {
+	*out = *in
+	if in.NetPermissions != nil {
+		in, out := &in.NetPermissions, &out.NetPermissions
+		*out = make([]VsphereNetPermission, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VsphereFileVolumesSpec.
+func (in *VsphereFileVolumesSpec) DeepCopy() *VsphereFileVolumesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VsphereFileVolumesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VsphereNetPermission) DeepCopyInto(out *VsphereNetPermission) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VsphereNetPermission.
+func (in *VsphereNetPermission) DeepCopy() *VsphereNetPermission {
+	if in == nil {
+		return nil
+	}
+	out := new(VsphereNetPermission)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VsphereSpec) DeepCopyInto(out *VsphereSpec) {
 	*out = *in
+	if in.FileVolumes != nil {
+		in, out := &in.FileVolumes, &out.FileVolumes
+		*out = new(VsphereFileVolumesSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
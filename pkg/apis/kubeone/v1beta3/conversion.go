@@ -0,0 +1,54 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta3
+
+import (
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+
+	conversion "k8s.io/apimachinery/pkg/conversion"
+)
+
+func Convert_kubeone_KubeOneCluster_To_v1beta3_KubeOneCluster(in *kubeoneapi.KubeOneCluster, out *KubeOneCluster, scope conversion.Scope) error {
+	// AssetConfiguration has been removed in the v1beta2 API and doesn't exist in the v1beta3 API either
+	return autoConvert_kubeone_KubeOneCluster_To_v1beta3_KubeOneCluster(in, out, scope)
+}
+
+func Convert_kubeone_ContainerRuntimeConfig_To_v1beta3_ContainerRuntimeConfig(in *kubeoneapi.ContainerRuntimeConfig, out *ContainerRuntimeConfig, scope conversion.Scope) error {
+	// Docker container runtime has been removed in the v1beta3 API because Kubernetes 1.24 removed dockershim
+	return autoConvert_kubeone_ContainerRuntimeConfig_To_v1beta3_ContainerRuntimeConfig(in, out, scope)
+}
+
+func Convert_kubeone_Features_To_v1beta3_Features(in *kubeoneapi.Features, out *Features, scope conversion.Scope) error {
+	// PodSecurityPolicy feature has been removed in the v1beta3 API because Kubernetes 1.25 removed
+	// PodSecurityPolicy, and 1.25 is the oldest Kubernetes version supported by KubeOne
+	return autoConvert_kubeone_Features_To_v1beta3_Features(in, out, scope)
+}
+
+func Convert_kubeone_ProviderSpec_To_v1beta3_ProviderSpec(in *kubeoneapi.ProviderSpec, out *ProviderSpec, scope conversion.Scope) error {
+	if err := autoConvert_kubeone_ProviderSpec_To_v1beta3_ProviderSpec(in, out, scope); err != nil {
+		return err
+	}
+
+	// MachineAnnotations has been removed in the v1beta3 API in favor of NodeAnnotations.
+	// Only one of those two fields can be set, so we move MachineAnnotations to
+	// NodeAnnotations to avoid losing them.
+	if len(in.MachineAnnotations) > 0 && len(in.NodeAnnotations) == 0 {
+		out.NodeAnnotations = in.MachineAnnotations
+	}
+
+	return nil
+}
@@ -0,0 +1,646 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta3
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/pointer"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// DefaultPodSubnet defines the default subnet used by pods
+	DefaultPodSubnet = "10.244.0.0/16"
+	// DefaultServiceSubnet defines the default subnet used by services
+	DefaultServiceSubnet = "10.96.0.0/12"
+	// DefaultServiceDNS defines the default DNS domain name used by services
+	DefaultServiceDNS = "cluster.local"
+	// DefaultNodePortRange defines the default NodePort range
+	DefaultNodePortRange = "30000-32767"
+	// DefaultStaticNoProxy defined static NoProxy
+	DefaultStaticNoProxy = "127.0.0.1/8,localhost"
+	// DefaultCanalMTU defines default VXLAN MTU for Canal CNI
+	DefaultCanalMTU = 1450
+
+	// vxlanIPv4Overhead is the number of bytes added by the VXLAN encapsulation over IPv4
+	vxlanIPv4Overhead = 50
+	// vxlanIPv6Overhead is the number of bytes added by the VXLAN encapsulation over IPv6
+	vxlanIPv6Overhead = 70
+	// ipipOverhead is the number of bytes added by the IP-in-IP encapsulation
+	ipipOverhead = 20
+	// wireguardIPv4Overhead is the number of bytes added by the WireGuard encryption over IPv4
+	wireguardIPv4Overhead = 60
+	// wireguardIPv6Overhead is the number of bytes added by the WireGuard encryption over IPv6
+	wireguardIPv6Overhead = 80
+)
+
+const (
+	// DefaultPodSubnetIPv6 is the default network range from which IPv6 POD networks are allocated.
+	DefaultPodSubnetIPv6 = "fd01::/48"
+	// DefaultServiceSubnetIPv6 is the default network range from which IPv6 service VIPs are allocated.
+	DefaultServiceSubnetIPv6 = "fd02::/120"
+	// DefaultNodeCIDRMaskSizeIPv4 is the default mask size used to address the nodes within provided IPv4 Pods CIDR.
+	DefaultNodeCIDRMaskSizeIPv4 = 24
+	// DefaultNodeCIDRMaskSizeIPv6 is the default mask size used to address the nodes within provided IPv6 Pods CIDR.
+	DefaultNodeCIDRMaskSizeIPv6 = 64
+)
+
+func addDefaultingFuncs(scheme *runtime.Scheme) error {
+	return RegisterDefaults(scheme)
+}
+
+func SetDefaults_KubeOneCluster(obj *KubeOneCluster) {
+	SetDefaults_Hosts(obj)
+	SetDefaults_APIEndpoints(obj)
+	SetDefaults_Versions(obj)
+	SetDefaults_ContainerRuntime(obj)
+	SetDefaults_Cgroups(obj)
+	SetDefaults_ClusterNetwork(obj)
+	SetDefaults_Proxy(obj)
+	SetDefaults_MachineController(obj)
+	SetDefaults_OperatingSystemManager(obj)
+	SetDefaults_HelmReleases(obj)
+	SetDefaults_SystemPackages(obj)
+	SetDefaults_Features(obj)
+	SetDefaults_Backups(obj)
+	SetDefaults_CertificateAuthority(obj)
+	SetDefaults_CloudConfig(obj)
+	SetDefaults_CloudProvider(obj)
+}
+
+func SetDefaults_CloudConfig(obj *KubeOneCluster) {
+	if obj.CloudProvider.AWS != nil && obj.CloudProvider.External {
+		if obj.CloudProvider.CloudConfig == "" {
+			obj.CloudProvider.CloudConfig = defaultAWSCCMCloudConfig(obj.Name, obj.ClusterNetwork.IPFamily)
+		}
+	}
+
+	if obj.CloudProvider.GCE != nil && obj.CloudProvider.GCE.SharedVPC != nil {
+		if obj.CloudProvider.CloudConfig == "" {
+			obj.CloudProvider.CloudConfig = defaultGCESharedVPCCloudConfig(*obj.CloudProvider.GCE.SharedVPC)
+		}
+	}
+}
+
+func SetDefaults_CloudProvider(obj *KubeOneCluster) {
+	if obj.CloudProvider.Vsphere != nil && obj.CloudProvider.Vsphere.FileVolumes != nil {
+		fileVolumes := obj.CloudProvider.Vsphere.FileVolumes
+		fileVolumes.StorageClassName = defaults(fileVolumes.StorageClassName, "vsphere-csi-file")
+		for i := range fileVolumes.NetPermissions {
+			fileVolumes.NetPermissions[i].Permissions = defaults(fileVolumes.NetPermissions[i].Permissions, "READ_WRITE")
+		}
+	}
+}
+
+func SetDefaults_Hosts(obj *KubeOneCluster) {
+	// No hosts, so skip defaulting
+	if len(obj.ControlPlane.Hosts) == 0 {
+		return
+	}
+
+	setDefaultLeader := true
+
+	gteKube124Condition, _ := semver.NewConstraint(">= 1.24")
+	ltKube125Condition, _ := semver.NewConstraint("< 1.25")
+	actualVer, err := semver.NewVersion(obj.Versions.Kubernetes)
+	if err != nil {
+		return
+	}
+
+	// Define a unique ID for each host
+	for idx := range obj.ControlPlane.Hosts {
+		if setDefaultLeader && obj.ControlPlane.Hosts[idx].IsLeader {
+			// override setting default leader, as explicit leader already
+			// defined
+			setDefaultLeader = false
+		}
+		obj.ControlPlane.Hosts[idx].ID = idx
+		defaultHostConfig(&obj.ControlPlane.Hosts[idx])
+		if obj.ControlPlane.Hosts[idx].Taints == nil {
+			if ltKube125Condition.Check(actualVer) {
+				obj.ControlPlane.Hosts[idx].Taints = []corev1.Taint{
+					{
+						Effect: corev1.TaintEffectNoSchedule,
+						Key:    "node-role.kubernetes.io/master",
+					},
+				}
+			}
+			if gteKube124Condition.Check(actualVer) {
+				obj.ControlPlane.Hosts[idx].Taints = append(obj.ControlPlane.Hosts[idx].Taints, corev1.Taint{
+					Effect: corev1.TaintEffectNoSchedule,
+					Key:    "node-role.kubernetes.io/control-plane",
+				})
+			}
+		}
+	}
+	if setDefaultLeader {
+		// In absence of explicitly defined leader set the first host to be the
+		// default leader
+		obj.ControlPlane.Hosts[0].IsLeader = true
+	}
+
+	for idx := range obj.StaticWorkers.Hosts {
+		// continue assigning IDs after control plane hosts. This way every node gets a unique ID regardless of the different host slices
+		obj.StaticWorkers.Hosts[idx].ID = idx + len(obj.ControlPlane.Hosts)
+		defaultHostConfig(&obj.StaticWorkers.Hosts[idx])
+		if obj.StaticWorkers.Hosts[idx].Taints == nil {
+			obj.StaticWorkers.Hosts[idx].Taints = []corev1.Taint{}
+		}
+	}
+}
+
+func SetDefaults_APIEndpoints(obj *KubeOneCluster) {
+	// If no API endpoint is provided, assume the public address is an endpoint
+	if len(obj.APIEndpoint.Host) == 0 {
+		if len(obj.ControlPlane.Hosts) == 0 {
+			// No hosts, so can't default to the first one
+			return
+		}
+		obj.APIEndpoint.Host = obj.ControlPlane.Hosts[0].PublicAddress
+	}
+	obj.APIEndpoint.Port = defaults(obj.APIEndpoint.Port, 6443)
+
+	for i := range obj.APIEndpoint.AdditionalEndpoints {
+		obj.APIEndpoint.AdditionalEndpoints[i].Port = defaults(obj.APIEndpoint.AdditionalEndpoints[i].Port, obj.APIEndpoint.Port)
+	}
+}
+
+func SetDefaults_Versions(obj *KubeOneCluster) {
+	// The cluster provisioning fails if there is a leading "v" in the version
+	obj.Versions.Kubernetes = strings.TrimPrefix(obj.Versions.Kubernetes, "v")
+}
+
+func SetDefaults_ContainerRuntime(obj *KubeOneCluster) {
+	if obj.ContainerRuntime.Containerd == nil {
+		obj.ContainerRuntime.Containerd = &ContainerRuntimeContainerd{}
+	}
+}
+
+func SetDefaults_Cgroups(obj *KubeOneCluster) {
+	if obj.Cgroups.Driver == "" {
+		obj.Cgroups.Driver = CgroupDriverSystemd
+	}
+}
+
+func SetDefaults_ClusterNetwork(obj *KubeOneCluster) {
+	setDualStackSubnets(&obj.ClusterNetwork)
+
+	if obj.ClusterNetwork.IPFamily == "" {
+		obj.ClusterNetwork.IPFamily = IPFamilyIPv4
+	}
+	switch obj.ClusterNetwork.IPFamily {
+	case IPFamilyIPv4:
+		obj.ClusterNetwork.PodSubnet = defaults(obj.ClusterNetwork.PodSubnet, DefaultPodSubnet)
+		obj.ClusterNetwork.ServiceSubnet = defaults(obj.ClusterNetwork.ServiceSubnet, DefaultServiceSubnet)
+		obj.ClusterNetwork.NodeCIDRMaskSizeIPv4 = defaults(obj.ClusterNetwork.NodeCIDRMaskSizeIPv4, ptr(DefaultNodeCIDRMaskSizeIPv4))
+	case IPFamilyIPv6:
+		obj.ClusterNetwork.PodSubnetIPv6 = defaults(obj.ClusterNetwork.PodSubnetIPv6, DefaultPodSubnetIPv6)
+		obj.ClusterNetwork.ServiceSubnetIPv6 = defaults(obj.ClusterNetwork.ServiceSubnetIPv6, DefaultServiceSubnetIPv6)
+		obj.ClusterNetwork.NodeCIDRMaskSizeIPv6 = defaults(obj.ClusterNetwork.NodeCIDRMaskSizeIPv6, ptr(DefaultNodeCIDRMaskSizeIPv6))
+	case IPFamilyIPv4IPv6, IPFamilyIPv6IPv4:
+		obj.ClusterNetwork.PodSubnet = defaults(obj.ClusterNetwork.PodSubnet, DefaultPodSubnet)
+		obj.ClusterNetwork.ServiceSubnet = defaults(obj.ClusterNetwork.ServiceSubnet, DefaultServiceSubnet)
+		obj.ClusterNetwork.PodSubnetIPv6 = defaults(obj.ClusterNetwork.PodSubnetIPv6, DefaultPodSubnetIPv6)
+		obj.ClusterNetwork.ServiceSubnetIPv6 = defaults(obj.ClusterNetwork.ServiceSubnetIPv6, DefaultServiceSubnetIPv6)
+		obj.ClusterNetwork.NodeCIDRMaskSizeIPv4 = defaults(obj.ClusterNetwork.NodeCIDRMaskSizeIPv4, ptr(DefaultNodeCIDRMaskSizeIPv4))
+		obj.ClusterNetwork.NodeCIDRMaskSizeIPv6 = defaults(obj.ClusterNetwork.NodeCIDRMaskSizeIPv6, ptr(DefaultNodeCIDRMaskSizeIPv6))
+	}
+
+	obj.ClusterNetwork.ServiceDomainName = defaults(obj.ClusterNetwork.ServiceDomainName, DefaultServiceDNS)
+	obj.ClusterNetwork.NodePortRange = defaults(obj.ClusterNetwork.NodePortRange, DefaultNodePortRange)
+	obj.ClusterNetwork.AllocateNodeCIDRs = defaults(obj.ClusterNetwork.AllocateNodeCIDRs, ptr(true))
+	for i := range obj.ClusterNetwork.EgressGateways {
+		if len(obj.ClusterNetwork.EgressGateways[i].DestinationCIDRs) == 0 {
+			obj.ClusterNetwork.EgressGateways[i].DestinationCIDRs = []string{"0.0.0.0/0"}
+		}
+	}
+	if bgp := obj.ClusterNetwork.BGP; bgp != nil {
+		for i := range bgp.Peers {
+			bgp.Peers[i].PeerPort = defaults(bgp.Peers[i].PeerPort, 179)
+		}
+		if bgp.RouteReflectors != nil {
+			bgp.RouteReflectors.ClusterID = defaults(bgp.RouteReflectors.ClusterID, "244.0.0.1")
+		}
+	}
+
+	defaultCanal := &CanalSpec{MTU: DefaultCanalMTU}
+	if mtu := providerMTU(obj.CloudProvider); mtu > 0 {
+		defaultCanal.MTU = mtu - vxlanIPv4Overhead
+	}
+
+	if obj.ClusterNetwork.CNI == nil {
+		obj.ClusterNetwork.CNI = &CNI{
+			Canal: defaultCanal,
+		}
+	}
+	if obj.ClusterNetwork.CNI.Canal != nil && obj.ClusterNetwork.CNI.Canal.MTU == 0 {
+		obj.ClusterNetwork.CNI.Canal.MTU = defaultCanal.MTU
+	}
+	// the bandwidth plugin is chained in the upstream Canal and Calico manifests
+	if cni := obj.ClusterNetwork.CNI; cni.Canal != nil || cni.Calico != nil {
+		cni.EnableBandwidthPlugin = defaults(cni.EnableBandwidthPlugin, ptr(true))
+	}
+
+	if calico := obj.ClusterNetwork.CNI.Calico; calico != nil {
+		calico.EncapsulationMode = defaults(calico.EncapsulationMode, CalicoEncapsulationModeVXLAN)
+		if mtu := providerMTU(obj.CloudProvider); mtu > 0 && calico.MTU == 0 {
+			calico.MTU = mtu - calicoEncapsulationOverhead(calico, obj.ClusterNetwork.IPFamily)
+		}
+	}
+
+	if cilium := obj.ClusterNetwork.CNI.Cilium; cilium != nil {
+		if cilium.KubeProxyReplacement == "" {
+			cilium.KubeProxyReplacement = KubeProxyReplacementDisabled
+		}
+		cilium.RoutingMode = defaults(cilium.RoutingMode, CiliumRoutingModeTunnel)
+		if cilium.RoutingMode == CiliumRoutingModeTunnel {
+			cilium.TunnelProtocol = defaults(cilium.TunnelProtocol, CiliumTunnelProtocolVXLAN)
+		}
+		cilium.IPAM = defaults(cilium.IPAM, CiliumIPAMModeClusterPool)
+		cilium.MTU = defaults(cilium.MTU, providerMTU(obj.CloudProvider))
+		cilium.HostRouting = defaults(cilium.HostRouting, CiliumHostRoutingLegacy)
+
+		// kube-proxy is replaced by cilium, so it must not be installed
+		if cilium.KubeProxyReplacement == KubeProxyReplacementStrict {
+			if obj.ClusterNetwork.KubeProxy == nil {
+				obj.ClusterNetwork.KubeProxy = &KubeProxyConfig{}
+			}
+			obj.ClusterNetwork.KubeProxy.SkipInstallation = true
+		}
+	}
+}
+
+func SetDefaults_Proxy(obj *KubeOneCluster) {
+	if obj.Proxy.HTTP == "" && obj.Proxy.HTTPS == "" {
+		return
+	}
+	noproxy := []string{
+		DefaultStaticNoProxy,
+		obj.ClusterNetwork.ServiceDomainName,
+		obj.ClusterNetwork.PodSubnet,
+		obj.ClusterNetwork.ServiceSubnet,
+	}
+	if obj.Proxy.NoProxy != "" {
+		noproxy = append(noproxy, obj.Proxy.NoProxy)
+	}
+	obj.Proxy.NoProxy = strings.Join(noproxy, ",")
+}
+
+func SetDefaults_MachineController(obj *KubeOneCluster) {
+	if obj.MachineController == nil {
+		obj.MachineController = &MachineControllerConfig{
+			Deploy: true,
+		}
+	}
+}
+
+func SetDefaults_OperatingSystemManager(obj *KubeOneCluster) {
+	if obj.OperatingSystemManager == nil {
+		obj.OperatingSystemManager = &OperatingSystemManagerConfig{
+			Deploy: obj.MachineController.Deploy,
+		}
+	}
+}
+
+func SetDefaults_HelmReleases(obj *KubeOneCluster) {
+	for idx, hr := range obj.HelmReleases {
+		if hr.ReleaseName == "" {
+			obj.HelmReleases[idx].ReleaseName = path.Base(hr.Chart)
+		}
+	}
+}
+
+func SetDefaults_SystemPackages(obj *KubeOneCluster) {
+	if obj.SystemPackages == nil {
+		obj.SystemPackages = &SystemPackages{
+			ConfigureRepositories: true,
+		}
+	}
+}
+
+func SetDefaults_Features(obj *KubeOneCluster) {
+	if obj.Features.CoreDNS == nil {
+		obj.Features.CoreDNS = &CoreDNS{}
+	}
+	if obj.Features.CoreDNS.Replicas == nil {
+		obj.Features.CoreDNS.Replicas = pointer.New(int32(2))
+	}
+	if obj.Features.CoreDNS.DeployPodDisruptionBudget == nil {
+		obj.Features.CoreDNS.DeployPodDisruptionBudget = pointer.New(true)
+	}
+
+	if obj.Features.MetricsServer == nil {
+		obj.Features.MetricsServer = &MetricsServer{
+			Enable: true,
+		}
+	}
+	if obj.Features.MetricsServer.Replicas == nil {
+		obj.Features.MetricsServer.Replicas = pointer.New(int32(1))
+	}
+	if obj.Features.StaticAuditLog != nil && obj.Features.StaticAuditLog.Enable {
+		defaultStaticAuditLogConfig(&obj.Features.StaticAuditLog.Config)
+	}
+	if obj.Features.OpenIDConnect != nil && obj.Features.OpenIDConnect.Enable {
+		defaultOpenIDConnect(&obj.Features.OpenIDConnect.Config)
+	}
+	if obj.Features.NodeLocalDNS == nil {
+		obj.Features.NodeLocalDNS = &NodeLocalDNS{
+			// NodeLocalDNS listens on a link-local IPv4 address which is not
+			// reachable from pods in IPv6-only clusters
+			Deploy: obj.ClusterNetwork.IPFamily != IPFamilyIPv6,
+		}
+	}
+	if obj.Features.NodeSwap != nil && obj.Features.NodeSwap.Enable {
+		obj.Features.NodeSwap.SwapBehavior = defaults(obj.Features.NodeSwap.SwapBehavior, SwapBehaviorLimitedSwap)
+	}
+	if obj.Features.MetalLB != nil && obj.Features.MetalLB.Enable {
+		defaultMetalLB(obj.Features.MetalLB)
+	}
+	if obj.Features.GatewayAPI != nil && obj.Features.GatewayAPI.Enable {
+		obj.Features.GatewayAPI.Controller = defaults(obj.Features.GatewayAPI.Controller, defaultGatewayAPIController(obj.ClusterNetwork.CNI))
+	}
+	if obj.Features.DefaultDenyNetworkPolicy != nil && obj.Features.DefaultDenyNetworkPolicy.Enable {
+		if len(obj.Features.DefaultDenyNetworkPolicy.Namespaces) == 0 {
+			obj.Features.DefaultDenyNetworkPolicy.Namespaces = []string{"default"}
+		}
+	}
+	if obj.Features.ClusterAutoscaler != nil && obj.Features.ClusterAutoscaler.Enable {
+		if obj.Features.ClusterAutoscaler.SkipNodesWithLocalStorage == nil {
+			obj.Features.ClusterAutoscaler.SkipNodesWithLocalStorage = pointer.New(true)
+		}
+		obj.Features.ClusterAutoscaler.Expander = defaults(obj.Features.ClusterAutoscaler.Expander, "random")
+	}
+	if obj.Features.LocalStorage != nil && obj.Features.LocalStorage.Enable {
+		defaultLocalStorage(obj.Features.LocalStorage)
+	}
+	if obj.Features.IngressNginx != nil && obj.Features.IngressNginx.Enable {
+		defaultIngressNginx(obj.Features.IngressNginx, obj)
+	}
+}
+
+func SetDefaults_Backups(obj *KubeOneCluster) {
+	if obj.Backups == nil || obj.Backups.Etcd == nil || !obj.Backups.Etcd.Enable {
+		return
+	}
+
+	obj.Backups.Etcd.Schedule = defaults(obj.Backups.Etcd.Schedule, "@every 30m")
+	obj.Backups.Etcd.Retention = defaults(obj.Backups.Etcd.Retention, 48)
+	obj.Backups.Etcd.Target.Endpoint = defaults(obj.Backups.Etcd.Target.Endpoint, "s3.amazonaws.com")
+}
+
+func SetDefaults_CertificateAuthority(obj *KubeOneCluster) {
+	if obj.CertificateAuthority == nil {
+		return
+	}
+
+	for _, source := range []*CertificateAuthoritySource{
+		obj.CertificateAuthority.Kubernetes,
+		obj.CertificateAuthority.Etcd,
+		obj.CertificateAuthority.FrontProxy,
+	} {
+		if source == nil || source.Vault == nil {
+			continue
+		}
+
+		source.Vault.CertificateKey = defaults(source.Vault.CertificateKey, "tls.crt")
+		source.Vault.PrivateKeyKey = defaults(source.Vault.PrivateKeyKey, "tls.key")
+	}
+}
+
+func defaultOpenIDConnect(config *OpenIDConnectConfig) {
+	config.ClientID = defaults(config.ClientID, "kubernetes")
+	config.UsernameClaim = defaults(config.UsernameClaim, "sub")
+	config.UsernamePrefix = defaults(config.UsernamePrefix, "oidc:")
+	config.GroupsClaim = defaults(config.GroupsClaim, "groups")
+	config.GroupsPrefix = defaults(config.GroupsPrefix, "oidc:")
+	config.SigningAlgs = defaults(config.SigningAlgs, "RS256")
+}
+
+func defaultMetalLB(obj *MetalLB) {
+	for i := range obj.AddressPools {
+		obj.AddressPools[i].Mode = defaults(obj.AddressPools[i].Mode, MetalLBModeL2)
+	}
+	for i := range obj.BGPPeers {
+		obj.BGPPeers[i].PeerPort = defaults(obj.BGPPeers[i].PeerPort, 179)
+	}
+}
+
+func defaultLocalStorage(obj *LocalStorage) {
+	if obj.LocalPath == nil && obj.TopoLVM == nil {
+		obj.LocalPath = &LocalPathProvisioner{}
+	}
+
+	if obj.LocalPath != nil {
+		obj.LocalPath.Path = defaults(obj.LocalPath.Path, "/opt/local-path-provisioner")
+		obj.StorageClassName = defaults(obj.StorageClassName, "local-path")
+	}
+	if obj.TopoLVM != nil {
+		obj.TopoLVM.FSType = defaults(obj.TopoLVM.FSType, "xfs")
+		if obj.TopoLVM.SpareGB == nil {
+			obj.TopoLVM.SpareGB = pointer.New(10)
+		}
+		obj.StorageClassName = defaults(obj.StorageClassName, "topolvm-provisioner")
+	}
+
+	if obj.DefaultStorageClass == nil {
+		obj.DefaultStorageClass = pointer.New(true)
+	}
+	obj.ReclaimPolicy = defaults(obj.ReclaimPolicy, corev1.PersistentVolumeReclaimDelete)
+}
+
+func defaultIngressNginx(obj *IngressNginx, cluster *KubeOneCluster) {
+	if obj.Replicas == nil {
+		obj.Replicas = pointer.New(int32(2))
+	}
+	if obj.DefaultIngressClass == nil {
+		obj.DefaultIngressClass = pointer.New(true)
+	}
+
+	// the load balancers of DigitalOcean and Hetzner pass the client
+	// addresses only using the PROXY protocol
+	if obj.ProxyProtocol == nil {
+		obj.ProxyProtocol = pointer.New(cluster.CloudProvider.DigitalOcean != nil || cluster.CloudProvider.Hetzner != nil)
+	}
+
+	// without MetalLB, there is nothing providing the LoadBalancer Services
+	// on baremetal
+	serviceType := corev1.ServiceTypeLoadBalancer
+	if cluster.CloudProvider.None != nil && (cluster.Features.MetalLB == nil || !cluster.Features.MetalLB.Enable) {
+		serviceType = corev1.ServiceTypeNodePort
+	}
+	obj.ServiceType = defaults(obj.ServiceType, serviceType)
+
+	trafficPolicy := corev1.ServiceExternalTrafficPolicyLocal
+	if *obj.ProxyProtocol {
+		trafficPolicy = corev1.ServiceExternalTrafficPolicyCluster
+	}
+	obj.ExternalTrafficPolicy = defaults(obj.ExternalTrafficPolicy, trafficPolicy)
+}
+
+// defaultGatewayAPIController returns the gateway controller bundled with the
+// configured CNI plugin, or none if the CNI plugin doesn't provide one
+func defaultGatewayAPIController(cni *CNI) GatewayAPIController {
+	if cni != nil && cni.Cilium != nil && cni.Cilium.KubeProxyReplacement == KubeProxyReplacementStrict {
+		return GatewayAPIControllerCilium
+	}
+
+	return GatewayAPIControllerNone
+}
+
+func defaultStaticAuditLogConfig(obj *StaticAuditLogConfig) {
+	obj.LogPath = defaults(obj.LogPath, "/var/log/kubernetes/audit.log")
+	obj.LogMaxAge = defaults(obj.LogMaxAge, 30)
+	obj.LogMaxBackup = defaults(obj.LogMaxBackup, 3)
+	obj.LogMaxSize = defaults(obj.LogMaxSize, 100)
+}
+
+func defaultHostConfig(obj *HostConfig) {
+	if len(obj.PublicAddress) == 0 && len(obj.PrivateAddress) > 0 {
+		obj.PublicAddress = obj.PrivateAddress
+	}
+	if len(obj.PrivateAddress) == 0 && len(obj.PublicAddress) > 0 {
+		obj.PrivateAddress = obj.PublicAddress
+	}
+	if obj.SSHPrivateKeyFile == "" {
+		obj.SSHAgentSocket = defaults(obj.SSHAgentSocket, "env:SSH_AUTH_SOCK")
+	}
+	obj.SSHUsername = defaults(obj.SSHUsername, "root")
+	obj.SSHPort = defaults(obj.SSHPort, 22)
+	obj.BastionPort = defaults(obj.BastionPort, 22)
+	obj.BastionUser = defaults(obj.BastionUser, obj.SSHUsername)
+	if obj.BMC != nil {
+		obj.BMC.BootDevice = defaults(obj.BMC.BootDevice, BMCBootDevicePXE)
+	}
+}
+
+// providerMTU returns the MTU of the network interfaces of the nodes on the
+// given cloud provider, or 0 if it's not known and should be autodetected
+func providerMTU(cloudProvider CloudProviderSpec) int {
+	switch {
+	case cloudProvider.AWS != nil:
+		return 9001 // AWS Jumbo Frame
+	case cloudProvider.GCE != nil:
+		return 1460
+	case cloudProvider.Hetzner != nil, cloudProvider.Openstack != nil:
+		return 1450
+	}
+
+	return 0
+}
+
+// calicoEncapsulationOverhead returns the number of bytes added to the pod
+// traffic by the encapsulation mode or WireGuard encryption of the given
+// Calico spec
+func calicoEncapsulationOverhead(calico *CalicoSpec, ipFamily IPFamily) int {
+	switch {
+	case calico.EnableWireGuard && ipFamily == IPFamilyIPv4:
+		return wireguardIPv4Overhead
+	case calico.EnableWireGuard:
+		return wireguardIPv6Overhead
+	case calico.EncapsulationMode == CalicoEncapsulationModeNone:
+		return 0
+	case calico.EncapsulationMode == CalicoEncapsulationModeIPIP:
+		return ipipOverhead
+	case ipFamily == IPFamilyIPv4:
+		return vxlanIPv4Overhead
+	}
+
+	return vxlanIPv6Overhead
+}
+
+func defaultAWSCCMCloudConfig(name string, ipFamily IPFamily) string {
+	lines := []string{
+		"[global]",
+		fmt.Sprintf("KubernetesClusterID=%q", name),
+	}
+
+	switch ipFamily {
+	case IPFamilyIPv4:
+		lines = append(lines, fmt.Sprintf("NodeIPFamilies=%q", "ipv4"))
+	case IPFamilyIPv6:
+		lines = append(lines, fmt.Sprintf("NodeIPFamilies=%q", "ipv6"))
+	case IPFamilyIPv4IPv6:
+		lines = append(lines, fmt.Sprintf("NodeIPFamilies=%q", "ipv4"))
+		lines = append(lines, fmt.Sprintf("NodeIPFamilies=%q", "ipv6"))
+	case IPFamilyIPv6IPv4:
+		lines = append(lines, fmt.Sprintf("NodeIPFamilies=%q", "ipv6"))
+		lines = append(lines, fmt.Sprintf("NodeIPFamilies=%q", "ipv4"))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func defaultGCESharedVPCCloudConfig(sharedVPC GCESharedVPCSpec) string {
+	lines := []string{
+		"[global]",
+		fmt.Sprintf("network-project-id = %q", sharedVPC.HostProjectID),
+		fmt.Sprintf("network-name = %q", sharedVPC.NetworkName),
+		fmt.Sprintf("subnetwork-name = %q", sharedVPC.SubnetworkName),
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// setDualStackSubnets splits dual-stack CIDR lists provided via podSubnet and serviceSubnet (e.g.
+// "10.244.0.0/16,fd01::/48") into the per-family fields. If ipFamily is not set, it's inferred from the order
+// of the CIDRs in the podSubnet list, or in the serviceSubnet list if podSubnet is a single CIDR. Lists that
+// don't match the IP family are left untouched so that validation can report them.
+func setDualStackSubnets(obj *ClusterNetworkConfig) {
+	podIPv4, podIPv6, podIPFamily, podOK := kubeoneapi.SplitDualStackCIDRs(obj.PodSubnet)
+	svcIPv4, svcIPv6, svcIPFamily, svcOK := kubeoneapi.SplitDualStackCIDRs(obj.ServiceSubnet)
+
+	if obj.IPFamily == "" {
+		switch {
+		case podOK:
+			obj.IPFamily = IPFamily(podIPFamily)
+		case svcOK:
+			obj.IPFamily = IPFamily(svcIPFamily)
+		}
+	}
+
+	if podOK && IPFamily(podIPFamily) == obj.IPFamily {
+		obj.PodSubnet = podIPv4
+		obj.PodSubnetIPv6 = defaults(podIPv6, obj.PodSubnetIPv6)
+	}
+
+	if svcOK && IPFamily(svcIPFamily) == obj.IPFamily {
+		obj.ServiceSubnet = svcIPv4
+		obj.ServiceSubnetIPv6 = defaults(svcIPv6, obj.ServiceSubnetIPv6)
+	}
+}
+
+func defaults[T comparable](input, defaultValue T) T {
+	var zero T
+
+	if input != zero {
+		return input
+	}
+
+	return defaultValue
+}
+
+func ptr[T any](x T) *T {
+	return &x
+}
@@ -0,0 +1,2654 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta3
+
+import (
+	"encoding/json"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// KubeOneCluster is KubeOne Cluster API Schema
+type KubeOneCluster struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Name is the name of the cluster.
+	Name string `json:"name"`
+
+	// ControlPlane describes the control plane nodes and how to access them.
+	ControlPlane ControlPlaneConfig `json:"controlPlane"`
+
+	// APIEndpoint are pairs of address and port used to communicate with the Kubernetes API.
+	APIEndpoint APIEndpoint `json:"apiEndpoint"`
+
+	// CloudProvider configures the cloud provider specific features.
+	CloudProvider CloudProviderSpec `json:"cloudProvider"`
+
+	// Versions defines which Kubernetes version will be installed.
+	Versions VersionConfig `json:"versions"`
+
+	// ContainerRuntime defines which container runtime will be installed
+	ContainerRuntime ContainerRuntimeConfig `json:"containerRuntime,omitempty"`
+
+	// ClusterNetwork configures the in-cluster networking.
+	ClusterNetwork ClusterNetworkConfig `json:"clusterNetwork,omitempty"`
+
+	// Proxy configures proxy used while installing Kubernetes and by the Docker daemon.
+	Proxy ProxyConfig `json:"proxy,omitempty"`
+
+	// StaticWorkers describes the worker nodes that are managed by KubeOne/kubeadm.
+	StaticWorkers StaticWorkersConfig `json:"staticWorkers,omitempty"`
+
+	// DynamicWorkers describes the worker nodes that are managed by Kubermatic machine-controller/Cluster-API.
+	DynamicWorkers []DynamicWorkerConfig `json:"dynamicWorkers,omitempty"`
+
+	// MachineController configures the Kubermatic machine-controller component.
+	MachineController *MachineControllerConfig `json:"machineController,omitempty"`
+
+	// OperatingSystemManager configures the Kubermatic operating-system-manager component.
+	OperatingSystemManager *OperatingSystemManagerConfig `json:"operatingSystemManager,omitempty"`
+
+	// CABundle PEM encoded global CA
+	CABundle string `json:"caBundle,omitempty"`
+
+	// AdditionalTrustedCAs is a PEM encoded bundle of additional CA certificates that will be installed into the
+	// operating system trust store on all control plane and static worker nodes and used by containerd to verify
+	// configured registries. Useful for environments with TLS-intercepting proxies or private registries.
+	// The bundle is kept in sync on every apply, and removed from nodes if it's removed from the manifest.
+	// Dynamic worker nodes (MachineDeployments) are not covered, so this field can't be used together with
+	// dynamicWorkers.
+	AdditionalTrustedCAs string `json:"additionalTrustedCAs,omitempty"`
+
+	// Features enables and configures additional cluster features.
+	Features Features `json:"features,omitempty"`
+
+	// Addons are used to deploy additional manifests.
+	Addons *Addons `json:"addons,omitempty"`
+
+	// HelmReleases configure helm charts to reconcile. For each HelmRelease it will run analog of: `helm upgrade
+	// --namespace <NAMESPACE> --install --create-namespace <RELEASE> <CHART> [--values=values-override.yaml]`
+	HelmReleases []HelmRelease `json:"helmReleases,omitempty"`
+
+	// SystemPackages configure kubeone behaviour regarding OS packages.
+	SystemPackages *SystemPackages `json:"systemPackages,omitempty"`
+
+	// RegistryConfiguration configures how Docker images are pulled from an image registry
+	RegistryConfiguration *RegistryConfiguration `json:"registryConfiguration,omitempty"`
+
+	// LoggingConfig configures the Kubelet's log rotation
+	LoggingConfig LoggingConfig `json:"loggingConfig,omitempty"`
+
+	// Cgroups configures the cgroup driver and the cgroup version used by the
+	// kubelet and the container runtime on control plane and static worker nodes
+	Cgroups CgroupsConfig `json:"cgroups,omitempty"`
+
+	// ControlPlaneComponents configures the Kubernetes control plane components
+	ControlPlaneComponents *ControlPlaneComponents `json:"controlPlaneComponents,omitempty"`
+
+	// Backups configures backups managed by KubeOne
+	Backups *BackupsConfig `json:"backups,omitempty"`
+
+	// Upgrades configures how the nodes are upgraded
+	Upgrades *UpgradesConfig `json:"upgrades,omitempty"`
+
+	// CertificateAuthority configures externally provided certificate authorities used by kubeadm to sign the
+	// cluster PKI, instead of the self-signed certificate authorities generated by kubeadm
+	CertificateAuthority *CertificateAuthorityConfig `json:"certificateAuthority,omitempty"`
+}
+
+// BackupsConfig configures backups managed by KubeOne
+type BackupsConfig struct {
+	// Etcd configures scheduled etcd snapshots uploaded to the object storage
+	Etcd *EtcdBackupsConfig `json:"etcd,omitempty"`
+}
+
+// EtcdBackupsConfig configures scheduled etcd backups. When enabled, KubeOne
+// deploys a CronJob that periodically takes an etcd snapshot together with the
+// etcd and Kubernetes PKI, encrypts it and uploads it to the object storage
+// using Restic. Backups can be restored using the `kubeone restore` command.
+type EtcdBackupsConfig struct {
+	// Enable etcd backups
+	Enable bool `json:"enable"`
+
+	// Schedule in the cron format used by the CronJob taking backups.
+	// Default value is "@every 30m".
+	Schedule string `json:"schedule,omitempty"`
+
+	// Retention is a number of the most recent backups to keep. Older backups
+	// are pruned from the object storage after each successful backup.
+	// Default value is 48.
+	Retention int `json:"retention,omitempty"`
+
+	// Target is the object storage where backups are uploaded
+	Target EtcdBackupsTarget `json:"target"`
+}
+
+// EtcdBackupsTarget describes an S3-compatible object storage bucket.
+// Credentials for accessing the bucket are sourced from the
+// ETCD_BACKUP_AWS_ACCESS_KEY_ID and ETCD_BACKUP_AWS_SECRET_ACCESS_KEY
+// variables, falling back to AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY.
+type EtcdBackupsTarget struct {
+	// Endpoint of the S3-compatible object storage.
+	// Default value is "s3.amazonaws.com".
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Bucket where backups are stored
+	Bucket string `json:"bucket"`
+
+	// Prefix is a path inside the bucket where backups are stored
+	Prefix string `json:"prefix,omitempty"`
+
+	// Region of the bucket
+	Region string `json:"region,omitempty"`
+}
+
+// UpgradesConfig configures how the control plane and static worker nodes
+// are upgraded
+type UpgradesConfig struct {
+	// Drain configures draining of the nodes before they're upgraded
+	Drain *DrainConfig `json:"drain,omitempty"`
+
+	// Canary upgrades the leader control plane node first, and verifies the
+	// cluster health before upgrading the remaining nodes
+	Canary *CanaryUpgradeConfig `json:"canary,omitempty"`
+
+	// IntermediateVersions are the Kubernetes versions the cluster is
+	// upgraded to when versions.kubernetes is more than one minor version
+	// ahead of the cluster. The cluster is upgraded one minor version at a
+	// time, using the latest patch release of the intermediate minor
+	// versions that are not listed.
+	IntermediateVersions []string `json:"intermediateVersions,omitempty"`
+
+	// MaintenanceWindows restrict when apply performs the disruptive actions,
+	// such as upgrading Kubernetes or containerd, which drain and restart the
+	// nodes, and rotating the encryption key, which restarts the API servers.
+	// Outside of the windows those actions are deferred and reported. The
+	// other changes are still applied, unless a Kubernetes upgrade is
+	// deferred. If empty, the disruptive actions are allowed at any time.
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
+
+	// OperatingSystem configures the upgrades of the operating system
+	// release of the nodes
+	OperatingSystem *OperatingSystemUpgradeConfig `json:"operatingSystem,omitempty"`
+
+	// StaticWorkers configures how many static worker nodes are upgraded at
+	// the same time
+	StaticWorkers *StaticWorkersUpgradeConfig `json:"staticWorkers,omitempty"`
+
+	// Unattended configures the unattended security updates of the operating
+	// system packages on all nodes
+	Unattended *UnattendedUpgradesConfig `json:"unattended,omitempty"`
+
+	// EtcdDefragmentation configures the defragmentation of the etcd members
+	// after the upgrades and on a schedule
+	EtcdDefragmentation *EtcdDefragmentationConfig `json:"etcdDefragmentation,omitempty"`
+}
+
+// MaintenanceWindow is a recurring time window in which the disruptive
+// actions are allowed
+type MaintenanceWindow struct {
+	// Days of the week the window starts on, e.g. Saturday. If empty, the
+	// window starts every day.
+	Days []string `json:"days,omitempty"`
+
+	// Start is the time of the day the window starts at, in the HH:MM format
+	Start string `json:"start"`
+
+	// Duration of the window, up to 24h
+	Duration metav1.Duration `json:"duration"`
+
+	// TimeZone is the IANA time zone of the start time, e.g. Europe/Berlin.
+	// Defaults to UTC.
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// OperatingSystemUpgradeConfig configures the upgrades of the operating
+// system release of the nodes, e.g. from Ubuntu 22.04 to Ubuntu 24.04. The
+// nodes are upgraded one at a time, and each node has to rejoin the cluster
+// before the next node is upgraded.
+type OperatingSystemUpgradeConfig struct {
+	// UbuntuRelease is the Ubuntu release, e.g. 24.04, the control plane and
+	// static worker nodes running an older Ubuntu release are upgraded to.
+	// Each node is drained, upgraded in place using do-release-upgrade, and
+	// rebooted.
+	UbuntuRelease string `json:"ubuntuRelease,omitempty"`
+
+	// MachineDeploymentImages maps the names of the MachineDeployments to
+	// the images of the new operating system release. The image is set in
+	// the cloud provider spec of the MachineDeployment, and its machines are
+	// re-provisioned by a rolling update, one MachineDeployment at a time.
+	MachineDeploymentImages map[string]string `json:"machineDeploymentImages,omitempty"`
+}
+
+// StaticWorkersUpgradeConfig configures the upgrades of the static worker
+// nodes
+type StaticWorkersUpgradeConfig struct {
+	// MaxUnavailable is the number of static worker nodes (or a percentage of
+	// the static worker nodes, rounded down) that are drained and upgraded at
+	// the same time. The nodes are upgraded in batches of that size, and the
+	// next batch is started only after the whole batch is upgraded. The drain
+	// respects the PodDisruptionBudgets, so the evictions of the pods whose
+	// replicas run on the nodes in the same batch wait until the budget
+	// allows them, up to the drain timeout. forceEviction should be disabled
+	// when upgrading more than one node at a time.
+	// Default value is 1.
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// EtcdDefragmentationConfig configures the defragmentation of the etcd
+// members, which releases the space freed by the compaction of the etcd
+// database. The members are defragmented one at a time, and all members have
+// to be healthy before the next member is defragmented. A member doesn't serve
+// any requests while it's being defragmented. The members can also be
+// defragmented on demand using the `kubeone etcd defrag` command.
+type EtcdDefragmentationConfig struct {
+	// AfterUpgrade defragments the etcd members after the cluster is upgraded
+	AfterUpgrade bool `json:"afterUpgrade,omitempty"`
+
+	// Schedule in the cron format used by the CronJob defragmenting the etcd
+	// members, e.g. "0 3 * * 0". If empty, the CronJob is not deployed.
+	Schedule string `json:"schedule,omitempty"`
+}
+
+// UnattendedUpgradesConfig configures the unattended security updates of the
+// operating system packages, using unattended-upgrades on Debian and Ubuntu,
+// and yum-cron or dnf-automatic on the RHEL-based distributions. The nodes
+// that require a reboot after an update are drained and rebooted one at a
+// time by kured.
+type UnattendedUpgradesConfig struct {
+	// Enable deploys the unattended-upgrades addon, which installs and
+	// configures the unattended updates on all nodes. Disabling it removes
+	// the addon, but the packages installed on the nodes are kept.
+	Enable bool `json:"enable,omitempty"`
+
+	// RebootWindow restricts the reboots of the nodes to the given time
+	// window. If not set, the nodes are rebooted at any time.
+	RebootWindow *MaintenanceWindow `json:"rebootWindow,omitempty"`
+
+	// PackageBlacklist is a list of the packages excluded from the unattended
+	// updates. The Kubernetes and container runtime packages managed by
+	// KubeOne (kubelet, kubeadm, kubectl, kubernetes-cni, cri-tools and
+	// containerd) are always excluded.
+	PackageBlacklist []string `json:"packageBlacklist,omitempty"`
+}
+
+// CanaryUpgradeConfig configures the canary upgrades. After the leader
+// control plane node is upgraded, the health of the API server and etcd is
+// verified, and a test pod is scheduled on the upgraded node. The upgrade of
+// the remaining nodes then waits for the confirmation, or for the SoakTime
+// after which the health is verified again.
+type CanaryUpgradeConfig struct {
+	// Enable the canary upgrades
+	Enable bool `json:"enable,omitempty"`
+
+	// SoakTime is how long to wait after the canary node is verified, before
+	// upgrading the remaining nodes. If not set, the confirmation is asked
+	// instead, which is skipped by --auto-approve.
+	SoakTime *metav1.Duration `json:"soakTime,omitempty"`
+}
+
+// DrainConfig configures draining of the control plane and static worker
+// nodes. The pods are evicted respecting their PodDisruptionBudgets, and the
+// pods that couldn't be evicted within the timeout are reported along with
+// the PodDisruptionBudgets blocking them.
+type DrainConfig struct {
+	// Timeout is how long to wait for the pods to be evicted from the node.
+	// The drain fails if the pods are not evicted in time, unless
+	// ForceEviction is enabled. The drain used to wait for the evictions
+	// indefinitely, so a longer timeout should be set if the
+	// PodDisruptionBudgets take longer to allow the evictions.
+	// Default value is 5m.
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// ForceEviction deletes the pods that couldn't be evicted within the
+	// Timeout, bypassing their PodDisruptionBudgets. The drain fails
+	// otherwise.
+	ForceEviction bool `json:"forceEviction,omitempty"`
+
+	// SkipNamespaces is a list of namespaces whose pods are not evicted
+	SkipNamespaces []string `json:"skipNamespaces,omitempty"`
+
+	// SkipPodSelector is a label selector of the pods that are not evicted,
+	// e.g. "app=etcd-operator,tier!=frontend"
+	SkipPodSelector string `json:"skipPodSelector,omitempty"`
+}
+
+// CertificateAuthorityConfig configures externally provided certificate
+// authorities, e.g. intermediate certificate authorities issued by the root
+// certificate authority of an organization. The certificate authorities are
+// installed on the control plane nodes when the cluster is provisioned, and
+// kubeadm uses them to sign the cluster PKI. The certificate authorities that
+// are not configured are generated by kubeadm.
+type CertificateAuthorityConfig struct {
+	// Kubernetes is the certificate authority of the Kubernetes PKI
+	// (/etc/kubernetes/pki/ca.crt)
+	Kubernetes *CertificateAuthoritySource `json:"kubernetes,omitempty"`
+
+	// Etcd is the certificate authority of the etcd PKI
+	// (/etc/kubernetes/pki/etcd/ca.crt)
+	Etcd *CertificateAuthoritySource `json:"etcd,omitempty"`
+
+	// FrontProxy is the certificate authority of the front proxy PKI
+	// (/etc/kubernetes/pki/front-proxy-ca.crt)
+	FrontProxy *CertificateAuthoritySource `json:"frontProxy,omitempty"`
+}
+
+// CertificateAuthoritySource is the source of the certificate and the private
+// key of a certificate authority. Exactly one of the files or vault must be
+// set.
+type CertificateAuthoritySource struct {
+	// CertificateFile is the path to the PEM-encoded certificate of the
+	// certificate authority. The file can also include the chain of the
+	// certificate authority up to the root certificate authority, which is
+	// used only to validate the certificate authority. The path is relative
+	// to the KubeOneCluster manifest.
+	CertificateFile string `json:"certificateFile,omitempty"`
+
+	// KeyFile is the path to the PEM-encoded private key of the certificate
+	// authority. The file must not be accessible by the group and others. The
+	// path is relative to the KubeOneCluster manifest.
+	KeyFile string `json:"keyFile,omitempty"`
+
+	// Vault reads the certificate and the private key of the certificate
+	// authority from a secret stored in the KV secrets engine of HashiCorp
+	// Vault
+	Vault *VaultCertificateAuthoritySource `json:"vault,omitempty"`
+}
+
+// VaultCertificateAuthoritySource refers to a secret in the KV secrets engine
+// of HashiCorp Vault holding the certificate and the private key of a
+// certificate authority. The Vault server and the authentication are
+// configured using the same environment variables as for the credentials read
+// from Vault, e.g. VAULT_ADDR and VAULT_TOKEN.
+type VaultCertificateAuthoritySource struct {
+	// Path of the secret, including the mount path of the secrets engine, and
+	// the data/ segment for the version 2 of the KV secrets engine, e.g.
+	// secret/data/kubeone/ca
+	Path string `json:"path"`
+
+	// CertificateKey is the key of the secret holding the PEM-encoded
+	// certificate of the certificate authority. Default value is "tls.crt".
+	CertificateKey string `json:"certificateKey,omitempty"`
+
+	// PrivateKeyKey is the key of the secret holding the PEM-encoded private
+	// key of the certificate authority. Default value is "tls.key".
+	PrivateKeyKey string `json:"privateKeyKey,omitempty"`
+}
+
+type HelmRelease struct {
+	// Chart is [CHART] part of the `helm upgrade [RELEASE] [CHART]` command. It's the name of the chart in the
+	// repository given by RepoURL, a path to a local chart, or an OCI reference (e.g.
+	// oci://registry.example.com/charts/example), in which case RepoURL and ChartURL must not be set.
+	Chart string `json:"chart"`
+
+	// RepoURL is a chart repository URL where to locate the requested chart.
+	RepoURL string `json:"repoURL,omitempty"`
+
+	// ChartURL is a direct chart URL location.
+	ChartURL string `json:"chartURL,omitempty"`
+
+	// Version is --version flag of the `helm upgrade` command. Specify the exact chart version to use. If this is not
+	// specified, the latest version is used.
+	Version string `json:"version,omitempty"`
+
+	// ReleaseName is [RELEASE] part of the `helm upgrade [RELEASE] [CHART]` command. Empty is defaulted to the
+	// last element of chart, e.g. "example" for oci://registry.example.com/charts/example. Releases that are
+	// removed from the list are uninstalled.
+	ReleaseName string `json:"releaseName,omitempty"`
+
+	// Namespace is --namespace flag of the `helm upgrade` command. A namespace to use for a release.
+	Namespace string `json:"namespace"`
+
+	// Values provide optional overrides of the helm values.
+	Values []HelmValues `json:"values,omitempty"`
+}
+
+// HelmValues configure inputs to `helm upgrade --install` command analog.
+type HelmValues struct {
+	// ValuesFile is an optional path on the local file system containing helm values to override. An analog of --values
+	// flag of the `helm upgrade` command.
+	ValuesFile string `json:"valuesFile,omitempty"`
+
+	// Inline is optionally used as a convenient way to provide short user input overrides to the helm upgrade process.
+	// Is written to a temporary file and used as an analog of the `helm upgrade --values=/tmp/inline-helm-values-XXX`
+	// command.
+	Inline json.RawMessage `json:"inline,omitempty"`
+}
+
+// LoggingConfig configures the Kubelet's log rotation
+type LoggingConfig struct {
+	// ContainerLogMaxSize configures the maximum size of container log file before it is rotated
+	// See more at: https://kubernetes.io/docs/reference/config-api/kubelet-config.v1beta1/
+	ContainerLogMaxSize string `json:"containerLogMaxSize,omitempty"`
+
+	// ContainerLogMaxFiles configures the maximum number of container log files that can be present for a container
+	// See more at: https://kubernetes.io/docs/reference/config-api/kubelet-config.v1beta1/
+	ContainerLogMaxFiles int32 `json:"containerLogMaxFiles,omitempty"`
+}
+
+// CgroupsConfig configures the cgroup driver and the cgroup version
+type CgroupsConfig struct {
+	// Driver is the cgroup driver used by the kubelet and the container runtime.
+	// Possible values: systemd, cgroupfs. The cgroupfs driver can't be used
+	// on nodes running cgroup v2, and the driver can't be changed on already
+	// provisioned nodes.
+	// Default value: systemd.
+	Driver CgroupDriver `json:"driver,omitempty"`
+
+	// Version is the cgroup version that must be enabled on all control plane and
+	// static worker nodes. Nodes using a different cgroup version are rejected
+	// while probing the hosts.
+	// Possible values: v1, v2. By default, the cgroup version is not enforced.
+	Version CgroupVersion `json:"version,omitempty"`
+}
+
+// CgroupDriver is the cgroup driver used by the kubelet and the container runtime
+type CgroupDriver string
+
+const (
+	// CgroupDriverSystemd uses systemd to manage cgroups
+	CgroupDriverSystemd CgroupDriver = "systemd"
+	// CgroupDriverCgroupfs uses the cgroupfs directly to manage cgroups
+	CgroupDriverCgroupfs CgroupDriver = "cgroupfs"
+)
+
+// CgroupVersion is the cgroup version enabled on the node
+type CgroupVersion string
+
+const (
+	// CgroupVersionV1 is the legacy cgroup v1 hierarchy
+	CgroupVersionV1 CgroupVersion = "v1"
+	// CgroupVersionV2 is the unified cgroup v2 hierarchy
+	CgroupVersionV2 CgroupVersion = "v2"
+)
+
+// ControlPlaneComponents configures the Kubernetes control plane components
+type ControlPlaneComponents struct {
+	// ControllerManager configures the kube-controller-manager
+	ControllerManager *ControlPlaneComponentConfig `json:"controllerManager,omitempty"`
+
+	// Scheduler configures the kube-scheduler
+	Scheduler *ControlPlaneComponentConfig `json:"scheduler,omitempty"`
+
+	// APIServer configures the kube-apiserver
+	APIServer *ControlPlaneComponentConfig `json:"apiServer,omitempty"`
+
+	// Patches configures kubeadm patches applied to the control plane components
+	Patches *KubeadmPatches `json:"patches,omitempty"`
+}
+
+// KubeadmPatches configures patches applied by kubeadm to the control plane components.
+// Patches are uploaded to the control plane and static worker nodes and passed to kubeadm on init, join and upgrade,
+// allowing to configure the settings that are not modeled by KubeOne.
+type KubeadmPatches struct {
+	// Directory is a path to the directory with the patch files. Relative path is relative to the KubeOne
+	// configuration file. Files must be named "target[suffix][+patchtype].extension", where "target" is one of
+	// "etcd", "kube-apiserver", "kube-controller-manager", "kube-scheduler" or "kubeletconfiguration",
+	// "patchtype" is one of "strategic" (default), "merge" or "json", and "extension" is "json" or "yaml".
+	// "suffix" is an optional string used to order the patches for the same target alpha-numerically.
+	Directory string `json:"directory"`
+}
+
+// ControlPlaneComponentConfig configures a single control plane component
+type ControlPlaneComponentConfig struct {
+	// Flags is a set of additional flags that will be passed to the control plane component.
+	// KubeOne internally configures some flags that are essential for the cluster to work. Those flags set by KubeOne
+	// will be merged with the ones specified in the configuration. In case of conflict the value provided by the user
+	// will be used. Usage of `feature-gates` is not allowed here, use `FeatureGates` field instead.
+	// IMPORTANT: Use of these flags is at the user's own risk, as KubeOne does not provide support for issues caused by
+	// invalid values and configurations.
+	Flags map[string]string `json:"flags,omitempty"`
+
+	// FeatureGates is a map of additional feature gates that will be passed on to the component.
+	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+
+	// ExtraVolumes is a list of additional host path volumes (e.g. audit webhook configs, cloud configs, or admission
+	// plugin credentials) that will be mounted into the control plane component.
+	ExtraVolumes []HostPathMount `json:"extraVolumes,omitempty"`
+}
+
+// HostPathMount describes a volume that is mounted from the host into the control plane component
+type HostPathMount struct {
+	// Name of the volume inside the pod template.
+	Name string `json:"name"`
+
+	// HostPath is the path on the host that will be mounted inside the pod.
+	HostPath string `json:"hostPath"`
+
+	// MountPath is the path inside the pod where hostPath will be mounted.
+	MountPath string `json:"mountPath"`
+
+	// ReadOnly controls write access to the volume
+	ReadOnly bool `json:"readOnly,omitempty"`
+
+	// PathType is the type of the HostPath.
+	PathType corev1.HostPathType `json:"pathType,omitempty"`
+}
+
+// ContainerRuntimeConfig
+type ContainerRuntimeConfig struct {
+	// Containerd related configurations
+	Containerd *ContainerRuntimeContainerd `json:"containerd,omitempty"`
+
+	// SandboxImage is the full reference of the sandbox (pause) image, e.g. `registry.example.com/pause:3.9`.
+	// It's used consistently by the container runtime, kubelet, and nodes managed by machine-controller,
+	// which is useful for air-gapped clusters where registry.k8s.io is unreachable.
+	// Default value is defaulted dynamically based on the Kubernetes version and
+	// RegistryConfiguration.OverwriteRegistry if specified.
+	SandboxImage string `json:"sandboxImage,omitempty"`
+}
+
+// ContainerRuntimeContainerd defines containerd container runtime
+type ContainerRuntimeContainerd struct {
+	// A map of registries to use to render configs and mirrors for containerd registries
+	Registries map[string]ContainerdRegistry `json:"registries,omitempty"`
+
+	// Version pins the containerd version installed on the control plane and
+	// static worker nodes, e.g. 1.6.24. If it changes, containerd is upgraded
+	// one node at a time, draining each node before the upgrade. If not set,
+	// the latest 1.6 patch release available in the package repository is
+	// installed. Ignored on Flatcar, which ships containerd with the OS.
+	Version string `json:"version,omitempty"`
+}
+
+// ContainerdRegistry defines endpoints and security for given container registry
+type ContainerdRegistry struct {
+	// List of registry mirrors to use
+	Mirrors []string `json:"mirrors,omitempty"`
+
+	// TLSConfig for the registry
+	TLSConfig *ContainerdTLSConfig `json:"tlsConfig,omitempty"`
+
+	// Registry authentication
+	Auth *ContainerdRegistryAuthConfig `json:"auth,omitempty"`
+}
+
+// Containerd per-registry credentials config
+type ContainerdRegistryAuthConfig struct {
+	Username      string `json:"username,omitempty"`
+	Password      string `json:"password,omitempty"`
+	Auth          string `json:"auth,omitempty"`
+	IdentityToken string `json:"identityToken,omitempty"`
+}
+
+// Configures containerd TLS for a registry
+type ContainerdTLSConfig struct {
+	// Don't validate remote TLS certificate
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+}
+
+// OperatingSystemName defines the operating system used on instances
+type OperatingSystemName string
+
+const (
+	OperatingSystemNameUbuntu     OperatingSystemName = "ubuntu"
+	OperatingSystemNameDebian     OperatingSystemName = "debian"
+	OperatingSystemNameCentOS     OperatingSystemName = "centos"
+	OperatingSystemNameRHEL       OperatingSystemName = "rhel"
+	OperatingSystemNameRockyLinux OperatingSystemName = "rockylinux"
+	OperatingSystemNameAmazon     OperatingSystemName = "amzn"
+	OperatingSystemNameFlatcar    OperatingSystemName = "flatcar"
+	OperatingSystemNameUnknown    OperatingSystemName = ""
+)
+
+// HostConfig describes a single control plane node.
+type HostConfig struct {
+	// ID automatically assigned at runtime.
+	ID int `json:"-"`
+
+	// PublicAddress is externally accessible IP address from public internet.
+	PublicAddress string `json:"publicAddress"`
+
+	// IPv6Addresses is IPv6 addresses of the node, only the first one will be announced to the k8s control plane.
+	// It is a list because you can request lots of IPv6 addresses (for example in case you want to assign one address per service).
+	IPv6Addresses []string `json:"ipv6Addresses"`
+
+	// PrivateAddress is internal RFC-1918 IP address.
+	PrivateAddress string `json:"privateAddress"`
+
+	// SSHPort is port to connect ssh to.
+	// Default value is 22.
+	SSHPort int `json:"sshPort,omitempty"`
+
+	// SSHUsername is system login name.
+	// Default value is "root".
+	SSHUsername string `json:"sshUsername,omitempty"`
+
+	// SSHPrivateKeyFile is path to the file with PRIVATE AND CLEANTEXT ssh key.
+	// The key can also be read from a secret stored in the KV secrets engine of
+	// HashiCorp Vault, referenced in the vault://<path>#<key> format.
+	// Default value is "".
+	SSHPrivateKeyFile string `json:"sshPrivateKeyFile,omitempty"`
+
+	// SSHHostPublicKey if not empty, will be used to verify remote host public key
+	SSHHostPublicKey []byte `json:"sshHostPublicKey,omitempty"`
+
+	// SSHAgentSocket path (or reference to the environment) to the SSH agent unix domain socket.
+	// Default value is "env:SSH_AUTH_SOCK".
+	SSHAgentSocket string `json:"sshAgentSocket,omitempty"`
+
+	// Bastion is an IP or hostname of the bastion (or jump) host to connect to.
+	// Default value is "".
+	Bastion string `json:"bastion,omitempty"`
+
+	// BastionPort is SSH port to use when connecting to the bastion if it's configured in .Bastion.
+	// Default value is 22.
+	BastionPort int `json:"bastionPort,omitempty"`
+
+	// BastionUser is system login name to use when connecting to bastion host.
+	// Default value is "root".
+	BastionUser string `json:"bastionUser,omitempty"`
+
+	// BastionHostPublicKey if not empty, will be used to verify bastion SSH public key
+	BastionHostPublicKey []byte `json:"bastionHostPublicKey,omitempty"`
+
+	// Hostname is the hostname(1) of the host.
+	// Default value is populated at the runtime via running `hostname -f` command over ssh.
+	Hostname string `json:"hostname,omitempty"`
+
+	// IsLeader indicates this host as a session leader.
+	// Default value is populated at the runtime.
+	IsLeader bool `json:"isLeader,omitempty"`
+
+	// Taints are taints applied to nodes. Those taints are only applied when the node is being provisioned.
+	// If not provided (i.e. nil) for control plane nodes, it defaults to:
+	//   * For Kubernetes 1.23 and older: TaintEffectNoSchedule with key node-role.kubernetes.io/master
+	//   * For Kubernetes 1.24 and newer: TaintEffectNoSchedule with keys
+	//     node-role.kubernetes.io/control-plane and node-role.kubernetes.io/master
+	// Explicitly empty (i.e. []corev1.Taint{}) means no taints will be applied (this is default for worker nodes).
+	Taints []corev1.Taint `json:"taints,omitempty"`
+
+	// Labels to be used to apply (or remove, with minus symbol suffix, see more kubectl help label) labels to/from node
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Zone is the failure domain (zone) the host is located in. If set, it's used as the value of
+	// the `topology.kubernetes.io/zone` label applied to the node.
+	// Default value is "".
+	Zone string `json:"zone,omitempty"`
+
+	// Kubelet
+	Kubelet KubeletConfig `json:"kubelet,omitempty"`
+
+	// OperatingSystem information, can be populated at the runtime.
+	OperatingSystem OperatingSystemName `json:"operatingSystem,omitempty"`
+
+	// BMC configures the baseboard management controller of the host. When
+	// set, the host can be power cycled and re-provisioned by KubeOne, e.g.
+	// by running `kubeone reset --reprovision`.
+	BMC *BMCConfig `json:"bmc,omitempty"`
+}
+
+// BMCProtocol is the protocol used to talk to the baseboard management controller
+type BMCProtocol string
+
+const (
+	BMCProtocolRedfish BMCProtocol = "Redfish"
+	BMCProtocolIPMI    BMCProtocol = "IPMI"
+)
+
+// BMCBootDevice is the device a host is booted from when re-provisioned
+type BMCBootDevice string
+
+const (
+	BMCBootDevicePXE          BMCBootDevice = "PXE"
+	BMCBootDeviceVirtualMedia BMCBootDevice = "VirtualMedia"
+)
+
+// BMCConfig configures the baseboard management controller used to power
+// cycle and re-provision a host
+type BMCConfig struct {
+	// Protocol used to talk to the BMC.
+	// Possible values: Redfish, IPMI.
+	Protocol BMCProtocol `json:"protocol"`
+
+	// Address of the BMC. For Redfish it's the base URL of the endpoint, e.g.
+	// https://10.0.0.10, for IPMI it's the hostname or IP address.
+	Address string `json:"address"`
+
+	// Username used to authenticate to the BMC.
+	Username string `json:"username"`
+
+	// Password used to authenticate to the BMC. Can be prefixed with "env:"
+	// to refer to an environment variable.
+	Password string `json:"password"`
+
+	// InsecureSkipTLSVerify disables verification of the Redfish endpoint
+	// certificate.
+	// Default value is false.
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
+
+	// SystemID is the Redfish ID of the computer system.
+	// Default value is the first system reported by the BMC.
+	SystemID string `json:"systemID,omitempty"`
+
+	// BootDevice is the device the host is booted from when re-provisioned.
+	// Possible values: PXE, VirtualMedia.
+	// Default value is PXE.
+	BootDevice BMCBootDevice `json:"bootDevice,omitempty"`
+
+	// ImageURL is the URL of the ISO image inserted as virtual media. Required
+	// if BootDevice is VirtualMedia, supported only by Redfish.
+	ImageURL string `json:"imageURL,omitempty"`
+}
+
+// ControlPlaneConfig defines control plane nodes
+type ControlPlaneConfig struct {
+	// Hosts array of all control plane hosts.
+	Hosts []HostConfig `json:"hosts"`
+
+	// RequireZoneSpread enables validation that the control plane hosts (and therefore etcd members)
+	// are spread across zones, so that a failure of any single zone doesn't cause a loss of etcd quorum.
+	// Requires at least 3 control plane hosts with the zone set.
+	// Default value is false.
+	RequireZoneSpread bool `json:"requireZoneSpread,omitempty"`
+
+	// Kernel configures sysctls and kernel modules on all control plane hosts
+	Kernel *KernelConfig `json:"kernel,omitempty"`
+}
+
+// StaticWorkersConfig defines static worker nodes provisioned by KubeOne and kubeadm
+type StaticWorkersConfig struct {
+	// Hosts
+	Hosts []HostConfig `json:"hosts,omitempty"`
+
+	// Kernel configures sysctls and kernel modules on all static worker hosts
+	Kernel *KernelConfig `json:"kernel,omitempty"`
+}
+
+// KernelConfig configures sysctls and kernel modules on a group of hosts.
+// The configuration is persisted on hosts, applied on every apply and
+// verified afterwards.
+type KernelConfig struct {
+	// Sysctls is a map of kernel parameters (e.g. net.core.somaxconn) and
+	// their values. Sysctls are written to /etc/sysctl.d/99-kubeone.conf.
+	Sysctls map[string]string `json:"sysctls,omitempty"`
+
+	// Modules is a list of kernel modules to load. Modules are written to
+	// /etc/modules-load.d/kubeone.conf so they are loaded on boot.
+	Modules []string `json:"modules,omitempty"`
+}
+
+// KubeletConfig provides some kubelet configuration options
+type KubeletConfig struct {
+	// SystemReserved configure --system-reserved command-line flag of the kubelet.
+	// See more at: https://kubernetes.io/docs/tasks/administer-cluster/reserve-compute-resources/
+	SystemReserved map[string]string `json:"systemReserved,omitempty"`
+
+	// KubeReserved configure --kube-reserved command-line flag of the kubelet.
+	// See more at: https://kubernetes.io/docs/tasks/administer-cluster/reserve-compute-resources/
+	KubeReserved map[string]string `json:"kubeReserved,omitempty"`
+
+	// EvictionHard configure --eviction-hard command-line flag of the kubelet.
+	// See more at: https://kubernetes.io/docs/tasks/administer-cluster/reserve-compute-resources/
+	EvictionHard map[string]string `json:"evictionHard,omitempty"`
+
+	// MaxPods configures maximum number of pods per node.
+	// If not provided, default value provided by kubelet will be used
+	// (max. 110 pods per node)
+	MaxPods *int32 `json:"maxPods,omitempty"`
+}
+
+// APIEndpoint is the endpoint used to communicate with the Kubernetes API
+type APIEndpoint struct {
+	// Host is the hostname or IP on which API is running.
+	Host string `json:"host"`
+
+	// Port is the port used to reach to the API.
+	// Default value is 6443.
+	Port int `json:"port,omitempty"`
+
+	// AlternativeNames is a list of Subject Alternative Names for the API Server signing cert.
+	AlternativeNames []string `json:"alternativeNames,omitempty"`
+
+	// AdditionalEndpoints is a list of additional endpoints (e.g. load balancers
+	// in other availability zones) used to reach the API when the endpoint
+	// defined by host and port is not reachable. KubeOne fails over between the
+	// endpoints in order of their priority and adds a context for each endpoint
+	// to the downloaded kubeconfig file, while the kubelets keep using host and
+	// port. The additional hosts are added to the Subject Alternative Names of
+	// the API Server signing cert.
+	AdditionalEndpoints []AdditionalAPIEndpoint `json:"additionalEndpoints,omitempty"`
+}
+
+// AdditionalAPIEndpoint is an additional endpoint used to reach the Kubernetes API
+type AdditionalAPIEndpoint struct {
+	// Host is the hostname or IP on which API is running.
+	Host string `json:"host"`
+
+	// Port is the port used to reach to the API.
+	// Defaults to the apiEndpoint.port.
+	Port int `json:"port,omitempty"`
+
+	// Priority of the endpoint, lower values are tried first. Endpoints with
+	// the same priority are tried in the order they're defined. The endpoint
+	// defined by apiEndpoint.host and apiEndpoint.port is always tried first.
+	// Default value is 0.
+	Priority int `json:"priority,omitempty"`
+}
+
+// CloudProviderSpec describes the cloud provider that is running the machines.
+// Only one cloud provider must be defined at the single time.
+type CloudProviderSpec struct {
+	// External
+	External bool `json:"external,omitempty"`
+
+	// DisableBundledCSIDrivers disables automatic deployment of CSI drivers bundled with KubeOne
+	DisableBundledCSIDrivers bool `json:"disableBundledCSIDrivers"`
+
+	// CloudConfig
+	CloudConfig string `json:"cloudConfig,omitempty"`
+
+	// CSIConfig
+	CSIConfig string `json:"csiConfig,omitempty"`
+
+	// SecretProviderClassName
+	SecretProviderClassName string `json:"secretProviderClassName,omitempty"`
+
+	// CloudControllerManager allows customizing the external cloud-controller-manager deployed by KubeOne
+	// for the configured cloud provider. Used only if `external` is set to true.
+	CloudControllerManager *CloudControllerManagerConfig `json:"cloudControllerManager,omitempty"`
+
+	// AWS
+	AWS *AWSSpec `json:"aws,omitempty"`
+
+	// Azure
+	Azure *AzureSpec `json:"azure,omitempty"`
+
+	// DigitalOcean
+	DigitalOcean *DigitalOceanSpec `json:"digitalocean,omitempty"`
+
+	// GCE
+	GCE *GCESpec `json:"gce,omitempty"`
+
+	// Hetzner
+	Hetzner *HetznerSpec `json:"hetzner,omitempty"`
+
+	// Kubevirt
+	Kubevirt *KubevirtSpec `json:"kubevirt,omitempty"`
+
+	// Nutanix
+	Nutanix *NutanixSpec `json:"nutanix,omitempty"`
+
+	// OCI
+	OCI *OCISpec `json:"oci,omitempty"`
+
+	// Openstack
+	Openstack *OpenstackSpec `json:"openstack,omitempty"`
+
+	// Proxmox
+	Proxmox *ProxmoxSpec `json:"proxmox,omitempty"`
+
+	// EquinixMetal
+	EquinixMetal *EquinixMetalSpec `json:"equinixmetal,omitempty"`
+
+	// VMware Cloud Director
+	VMwareCloudDirector *VMwareCloudDirectorSpec `json:"vmwareCloudDirector,omitempty"`
+
+	// Vsphere
+	Vsphere *VsphereSpec `json:"vsphere,omitempty"`
+
+	// None
+	None *NoneSpec `json:"none,omitempty"`
+}
+
+// CloudControllerManagerConfig configures the external cloud-controller-manager
+type CloudControllerManagerConfig struct {
+	// ImageRepository overrides the repository of the cloud-controller-manager image,
+	// e.g. `my-registry.example.com/hcloud-cloud-controller-manager`.
+	// By default it's empty, which means the image bundled with KubeOne is used.
+	// ImageRepository has the highest priority, meaning that it'll override
+	// overwriteRegistry if specified.
+	ImageRepository string `json:"imageRepository,omitempty"`
+
+	// ImageTag overrides the tag (version) of the cloud-controller-manager image.
+	// By default it's empty, which means the version bundled with KubeOne is used.
+	ImageTag string `json:"imageTag,omitempty"`
+
+	// Flags is a set of additional flags that will be passed to the cloud-controller-manager.
+	// Those flags are appended after the flags set by KubeOne, so in case of conflict the value
+	// provided by the user will be used.
+	// IMPORTANT: Use of these flags is at the user's own risk, as KubeOne does not provide support for issues caused by
+	// invalid values and configurations.
+	Flags map[string]string `json:"flags,omitempty"`
+}
+
+// AWSSpec defines the AWS cloud provider
+type AWSSpec struct {
+	// CredentialsMode defines how the AWS credentials are sourced by the
+	// components deployed by KubeOne.
+	// Possible values: Static, InstanceProfile. With InstanceProfile, static
+	// access keys are not required for the cloud-controller-manager and the
+	// EBS CSI driver, which use the IAM instance profile attached to the nodes
+	// instead. machine-controller doesn't support instance profiles and still
+	// requires static access keys if it's deployed.
+	// Default value: Static.
+	CredentialsMode AWSCredentialsMode `json:"credentialsMode,omitempty"`
+}
+
+// AWSCredentialsMode is the way AWS credentials are sourced
+type AWSCredentialsMode string
+
+const (
+	// AWSCredentialsModeStatic uses static access keys from the environment or the credentials file
+	AWSCredentialsModeStatic AWSCredentialsMode = "Static"
+	// AWSCredentialsModeInstanceProfile uses the IAM instance profile attached to the nodes
+	AWSCredentialsModeInstanceProfile AWSCredentialsMode = "InstanceProfile"
+)
+
+// AzureSpec defines the Azure cloud provider
+type AzureSpec struct {
+	// CredentialsMode defines how the components deployed by KubeOne
+	// authenticate against the Azure API.
+	// Possible values: ClientSecret, ManagedIdentity. With ManagedIdentity, the
+	// client ID and secret are not required for the cloud-controller-manager
+	// and the CSI drivers, which use the managed identity assigned to the
+	// control plane VMs instead, and `useManagedIdentityExtension` is set in
+	// the cloud config. machine-controller doesn't support managed identities
+	// and still requires the client ID and secret if it's deployed.
+	// Default value: ClientSecret.
+	CredentialsMode AzureCredentialsMode `json:"credentialsMode,omitempty"`
+
+	// UserAssignedIdentityID is the client ID of the user-assigned managed
+	// identity used with the ManagedIdentity credentials mode. If empty, the
+	// system-assigned managed identity of the VMs is used.
+	UserAssignedIdentityID string `json:"userAssignedIdentityID,omitempty"`
+
+	// VMType is the type of the VMs backing the nodes, set as `vmType` in the
+	// cloud config used by the cloud-controller-manager and the CSI drivers.
+	// Possible values: standard, vmss. With vmss, nodes running on VM scale
+	// set instances (e.g. static workers) are supported in addition to
+	// standalone VMs. If empty, the cloud config is used as-is.
+	VMType AzureVMType `json:"vmType,omitempty"`
+
+	// AvailabilityZones should be enabled when the nodes are spread across
+	// availability zones. The default azuredisk-csi StorageClass then uses
+	// the WaitForFirstConsumer volume binding mode, so the zonal disks are
+	// created in the zone of the node where the pod is scheduled.
+	// StorageClasses are immutable, so the azuredisk-csi StorageClass has to
+	// be deleted when enabling this option for an existing cluster.
+	AvailabilityZones bool `json:"availabilityZones,omitempty"`
+}
+
+// AzureCredentialsMode is the way Azure credentials are sourced
+type AzureCredentialsMode string
+
+const (
+	// AzureCredentialsModeClientSecret uses the service principal client ID and secret
+	AzureCredentialsModeClientSecret AzureCredentialsMode = "ClientSecret"
+	// AzureCredentialsModeManagedIdentity uses the managed identity assigned to the VMs
+	AzureCredentialsModeManagedIdentity AzureCredentialsMode = "ManagedIdentity"
+)
+
+// AzureVMType is the type of the Azure VMs backing the nodes
+type AzureVMType string
+
+const (
+	// AzureVMTypeStandard is used for standalone VMs
+	AzureVMTypeStandard AzureVMType = "standard"
+	// AzureVMTypeVMSS is used for VM scale set instances and standalone VMs
+	AzureVMTypeVMSS AzureVMType = "vmss"
+)
+
+// DigitalOceanSpec defines the DigitalOcean cloud provider
+type DigitalOceanSpec struct {
+	// VPCID is the ID of the VPC used by the cluster. If set, the
+	// DigitalOcean CCM creates load balancers in this VPC.
+	VPCID string `json:"vpcID,omitempty"`
+
+	// VPCIPRange is the IP range (CIDR) of the VPC used by the cluster.
+	// If set, the private addresses of all control plane and static worker
+	// nodes must be in this range.
+	VPCIPRange string `json:"vpcIPRange,omitempty"`
+}
+
+// GCESpec defines the GCE cloud provider
+type GCESpec struct {
+	// CredentialsMode defines how the components deployed by KubeOne
+	// authenticate against the Google Cloud API.
+	// Possible values: ServiceAccountKey, AttachedServiceAccount. With
+	// AttachedServiceAccount, the service account key is not required for the
+	// cloud controller and the Compute Persistent Disk CSI driver, which use
+	// the service account attached to the control plane instances instead.
+	// The attached service account must have the compute or the cloud-platform
+	// scope. machine-controller doesn't support attached service accounts and
+	// still requires the service account key if it's deployed.
+	// Default value: ServiceAccountKey.
+	CredentialsMode GCECredentialsMode `json:"credentialsMode,omitempty"`
+
+	// SharedVPC configures the cluster to use a Shared VPC network from a host
+	// project. If the cloudConfig is empty, the cloud config with the Shared
+	// VPC network is generated, otherwise it must set the network-project-id,
+	// network-name and subnetwork-name keys.
+	SharedVPC *GCESharedVPCSpec `json:"sharedVPC,omitempty"`
+}
+
+// GCESharedVPCSpec defines the Shared VPC network used by the cluster
+type GCESharedVPCSpec struct {
+	// HostProjectID is the ID of the Shared VPC host project
+	HostProjectID string `json:"hostProjectID"`
+
+	// NetworkName is the name of the Shared VPC network
+	NetworkName string `json:"networkName"`
+
+	// SubnetworkName is the name of the Shared VPC subnetwork used by the nodes
+	SubnetworkName string `json:"subnetworkName"`
+}
+
+// GCECredentialsMode is the way Google Cloud credentials are sourced
+type GCECredentialsMode string
+
+const (
+	// GCECredentialsModeServiceAccountKey uses the service account key from the environment or the credentials file
+	GCECredentialsModeServiceAccountKey GCECredentialsMode = "ServiceAccountKey"
+	// GCECredentialsModeAttachedServiceAccount uses the service account attached to the instances
+	GCECredentialsModeAttachedServiceAccount GCECredentialsMode = "AttachedServiceAccount"
+)
+
+// HetznerSpec defines the Hetzner cloud provider
+type HetznerSpec struct {
+	// NetworkID
+	NetworkID string `json:"networkID,omitempty"`
+
+	// Robot enables the support for Hetzner Robot (dedicated) servers in the
+	// Hetzner CCM, so dedicated servers can be used as static workers. The
+	// Robot webservice credentials (HETZNER_ROBOT_USER and
+	// HETZNER_ROBOT_PASSWORD) are required.
+	// Default value is false.
+	Robot bool `json:"robot,omitempty"`
+}
+
+// KubevirtSpec defines the KubeVirt provider. The cluster nodes are KubeVirt
+// virtual machines running in an infrastructure Kubernetes cluster. KubeVirt
+// doesn't have an in-tree cloud provider, so the KubeVirt CCM is used and
+// `.cloudProvider.external` must be enabled. The kubeconfig of the
+// infrastructure cluster is taken from the KUBEVIRT_KUBECONFIG credential.
+type KubevirtSpec struct {
+	// InfraNamespace is the namespace in the infrastructure cluster in which
+	// the virtual machines of the cluster are running.
+	InfraNamespace string `json:"infraNamespace"`
+
+	// InfraStorageClassName is the StorageClass in the infrastructure cluster
+	// used by the KubeVirt CSI driver to provision the volumes of the cluster.
+	// If empty, the default StorageClass of the infrastructure cluster is used.
+	InfraStorageClassName string `json:"infraStorageClassName,omitempty"`
+}
+
+// NutanixSpec defines the Nutanix provider. Nutanix doesn't have an in-tree
+// cloud provider, so the Nutanix CCM is used and `.cloudProvider.external` must
+// be enabled.
+type NutanixSpec struct {
+	// StorageContainer is the name of the Nutanix storage container used by
+	// the default storage class.
+	// Default value is "Default".
+	StorageContainer string `json:"storageContainer,omitempty"`
+
+	// FSType is the filesystem type used by the default storage class.
+	// Default value is "xfs".
+	FSType string `json:"fsType,omitempty"`
+
+	// IsSegmentedISCSINetwork should be set to true if the iSCSI network is
+	// segmented from the management network.
+	// Default value is false.
+	IsSegmentedISCSINetwork bool `json:"isSegmentedIscsiNetwork,omitempty"`
+}
+
+// OCISpec defines the Oracle Cloud Infrastructure (OCI) provider. OCI doesn't
+// have an in-tree cloud provider, so the OCI CCM is used and
+// `.cloudProvider.external` must be enabled.
+type OCISpec struct {
+	// CompartmentID is the OCID of the compartment in which the cluster is running.
+	CompartmentID string `json:"compartmentID"`
+
+	// VCNID is the OCID of the Virtual Cloud Network (VCN) used by the cluster.
+	VCNID string `json:"vcnID"`
+
+	// LoadBalancerSubnetIDs is a list of OCIDs of subnets used for load
+	// balancers created by the OCI CCM. One regional subnet or two
+	// availability domain specific subnets can be provided.
+	// If not provided, the OCI CCM will not be able to create load balancers.
+	LoadBalancerSubnetIDs []string `json:"loadBalancerSubnetIDs,omitempty"`
+}
+
+// OpenstackSpec defines the Openstack provider
+type OpenstackSpec struct{}
+
+// ProxmoxSpec defines the Proxmox VE provider. Proxmox VE doesn't have an
+// in-tree cloud provider and isn't supported by machine-controller, so only
+// static workers can be used.
+type ProxmoxSpec struct{}
+
+// EquinixMetalSpec defines the Equinix Metal cloud provider
+type EquinixMetalSpec struct {
+	// LoadBalancer enables LoadBalancer Services backed by Equinix Metal
+	// Elastic IPs announced over BGP. When set, the Equinix Metal CCM enables
+	// BGP on the project and on the cluster devices, and MetalLB is deployed
+	// and configured with the BGP peering details of each node.
+	LoadBalancer *EquinixMetalLoadBalancerSpec `json:"loadBalancer,omitempty"`
+}
+
+// EquinixMetalLoadBalancerSpec configures the BGP based LoadBalancer
+// integration for Equinix Metal
+type EquinixMetalLoadBalancerSpec struct {
+	// LocalASN is the ASN announced by the cluster nodes.
+	// Default value is 65000.
+	LocalASN int `json:"localASN,omitempty"`
+}
+
+// VMwareCloudDirectorSpec defines the VMware Cloud Director provider
+type VMwareCloudDirectorSpec struct {
+	// VApp is the name of vApp for VMs.
+	VApp string `json:"vApp,omitempty"`
+
+	// StorageProfile is the name of storage profile to be used for disks.
+	StorageProfile string `json:"storageProfile"`
+}
+
+// VsphereSpec defines the vSphere provider
+type VsphereSpec struct {
+	// StoragePolicyName is the name of the vSphere storage policy used by the
+	// default vsphere-csi storage class. Useful when nodes are spread across
+	// multiple datastores, as the storage policy selects a datastore
+	// compatible with the node where the volume is attached.
+	// Can't be combined with DatastoreURL.
+	StoragePolicyName string `json:"storagePolicyName,omitempty"`
+
+	// DatastoreURL is the URL of the datastore used by the default
+	// vsphere-csi storage class, e.g. "ds:///vmfs/volumes/<uuid>/". The
+	// datastore must be accessible from all nodes.
+	// Can't be combined with StoragePolicyName.
+	DatastoreURL string `json:"datastoreURL,omitempty"`
+
+	// FileVolumes enables ReadWriteMany volumes backed by vSAN File Service.
+	// Requires the vSphere CSI driver to be deployed.
+	FileVolumes *VsphereFileVolumesSpec `json:"fileVolumes,omitempty"`
+}
+
+// VsphereFileVolumesSpec configures the vSphere CSI file volumes (vSAN File Service)
+type VsphereFileVolumesSpec struct {
+	// StorageClassName is the name of the StorageClass created for the file volumes.
+	// Default value is "vsphere-csi-file".
+	StorageClassName string `json:"storageClassName,omitempty"`
+
+	// DatastoreURL is the URL of the vSAN datastore with the File Service enabled,
+	// used by the file volumes StorageClass. If empty, any vSAN datastore with the
+	// File Service enabled is used.
+	DatastoreURL string `json:"datastoreURL,omitempty"`
+
+	// NetPermissions controls the access to the file volumes from the client networks.
+	// If empty, all networks have the read-write access with root squash enabled.
+	NetPermissions []VsphereNetPermission `json:"netPermissions,omitempty"`
+}
+
+// VsphereNetPermission configures the access to the file volumes from a client network
+type VsphereNetPermission struct {
+	// Name of the permission, used as the csi-vsphere.conf section name
+	Name string `json:"name"`
+
+	// IPs is the client network in the CIDR format, or "*" for all networks
+	IPs string `json:"ips"`
+
+	// Permissions is one of READ_WRITE, READ_ONLY and NO_ACCESS.
+	// Default value is "READ_WRITE".
+	Permissions string `json:"permissions,omitempty"`
+
+	// RootSquash enables the root squash for the clients
+	RootSquash bool `json:"rootSquash,omitempty"`
+}
+
+// NoneSpec defines a none provider
+type NoneSpec struct{}
+
+// VersionConfig describes the versions of components that are installed on the machines
+type VersionConfig struct {
+	Kubernetes string `json:"kubernetes"`
+}
+
+// ClusterNetworkConfig describes the cluster network
+type ClusterNetworkConfig struct {
+	// PodSubnet
+	// default value is "10.244.0.0/16"
+	// A comma-separated dual-stack list (e.g. "10.244.0.0/16,fd01::/48") is also accepted, in which case the IPv6
+	// CIDR is moved to PodSubnetIPv6 and IPFamily (if not set) is inferred from the order of the CIDRs.
+	PodSubnet string `json:"podSubnet,omitempty"`
+
+	// PodSubnetIPv6
+	// default value is ""fd01::/48""
+	PodSubnetIPv6 string `json:"podSubnetIPv6,omitempty"`
+
+	// ServiceSubnet
+	// default value is "10.96.0.0/12"
+	// A comma-separated dual-stack list (e.g. "10.96.0.0/12,fd02::/120") is also accepted, in which case the IPv6
+	// CIDR is moved to ServiceSubnetIPv6. The order of the CIDRs must match IPFamily, which is inferred from this
+	// list if neither IPFamily nor a dual-stack PodSubnet is set.
+	ServiceSubnet string `json:"serviceSubnet,omitempty"`
+
+	// ServiceSubnetIPv6
+	// default value is "fd02::/120"
+	ServiceSubnetIPv6 string `json:"serviceSubnetIPv6,omitempty"`
+
+	// ServiceDomainName
+	// default value is "cluster.local"
+	ServiceDomainName string `json:"serviceDomainName,omitempty"`
+
+	// NodePortRange
+	// default value is "30000-32767"
+	NodePortRange string `json:"nodePortRange,omitempty"`
+
+	// CNI
+	// default value is {canal: {mtu: 1450}}
+	CNI *CNI `json:"cni,omitempty"`
+
+	// KubeProxy config
+	KubeProxy *KubeProxyConfig `json:"kubeProxy,omitempty"`
+
+	// IPFamily allows specifying IP family of a cluster.
+	// Valid values are IPv4 | IPv6 | IPv4+IPv6 | IPv6+IPv4.
+	IPFamily IPFamily `json:"ipFamily,omitempty"`
+
+	// NodeCIDRMaskSizeIPv4 is the mask size used to address the nodes within provided IPv4 Pods CIDR. It has to be larger than the provided IPv4 Pods CIDR. Defaults to 24.
+	NodeCIDRMaskSizeIPv4 *int `json:"nodeCIDRMaskSizeIPv4,omitempty"`
+
+	// NodeCIDRMaskSizeIPv6 is the mask size used to address the nodes within provided IPv6 Pods CIDR. It has to be larger than the provided IPv6 Pods CIDR. Defaults to 64.
+	NodeCIDRMaskSizeIPv6 *int `json:"nodeCIDRMaskSizeIPv6,omitempty"`
+
+	// AllocateNodeCIDRs configures kube-controller-manager to allocate the pod CIDRs of the nodes
+	// from the pod subnet, using the node CIDR mask sizes. It can be disabled if the CNI plugin
+	// allocates the pod IP addresses on its own (e.g. Calico, Cilium with the cluster-pool IPAM or
+	// an external CNI plugin). Canal and Cilium with the kubernetes IPAM require it to be enabled.
+	// Default value is true.
+	AllocateNodeCIDRs *bool `json:"allocateNodeCIDRs,omitempty"`
+
+	// EgressGateways route the traffic of the selected pods leaving the cluster
+	// through the gateway nodes, so that it has a stable source IP address.
+	// Egress gateways require the Cilium CNI with kubeProxyReplacement set to strict.
+	EgressGateways []EgressGateway `json:"egressGateways,omitempty"`
+
+	// DNS configures the upstream DNS servers and the search domains used by
+	// the cluster, instead of the ones configured on the hosts.
+	DNS *ClusterDNSConfig `json:"dns,omitempty"`
+
+	// BGP configures the BGP peering of the CNI plugin with the routers of the
+	// network of the nodes, which is rendered into the BGP resources of the
+	// CNI plugin. BGP is supported only with the Calico and Cilium CNI plugins.
+	BGP *BGPConfig `json:"bgp,omitempty"`
+}
+
+// ClusterDNSConfig configures the upstream DNS servers and the search domains
+// of the control plane and static worker nodes and CoreDNS
+type ClusterDNSConfig struct {
+	// UpstreamServers are the DNS servers used to resolve the names outside of
+	// the cluster. The servers are used by CoreDNS and written to the resolv.conf
+	// file used by kubelet, instead of the nameservers of the host. At most 3
+	// servers can be specified. If not set, the nameservers of the host are used,
+	// skipping the loopback addresses (e.g. the systemd-resolved stub resolver),
+	// which are not reachable from the pods.
+	UpstreamServers []string `json:"upstreamServers,omitempty"`
+
+	// SearchDomains are written to the resolv.conf file used by kubelet,
+	// instead of the search domains of the host, and appended to the search
+	// domains of the pods. At most 3 search domains can be specified.
+	SearchDomains []string `json:"searchDomains,omitempty"`
+}
+
+// BGPConfig configures the BGP peering of the CNI plugin
+type BGPConfig struct {
+	// ASN is the autonomous system number of the cluster nodes.
+	ASN int `json:"asn"`
+
+	// Peers is a list of BGP routers to which the nodes announce the pod
+	// CIDRs and the advertised CIDRs.
+	Peers []BGPPeer `json:"peers,omitempty"`
+
+	// RouteReflectors configures the nodes acting as BGP route reflectors for
+	// the other nodes, replacing the full mesh of the BGP sessions between the
+	// nodes. Route reflectors are supported only with the Calico CNI plugin.
+	RouteReflectors *BGPRouteReflectors `json:"routeReflectors,omitempty"`
+
+	// AdvertisedCIDRs is a list of CIDRs of the LoadBalancer and external IP
+	// addresses of the Services announced to the peers. With the Cilium CNI
+	// plugin, the LoadBalancer IP addresses are also allocated from these
+	// CIDRs, so it can't be used together with MetalLB.
+	AdvertisedCIDRs []string `json:"advertisedCIDRs,omitempty"`
+}
+
+// BGPPeer is a BGP router the nodes peer with
+type BGPPeer struct {
+	// PeerAddress is the IP address of the BGP router.
+	PeerAddress string `json:"peerAddress"`
+
+	// PeerASN is the ASN of the BGP router.
+	PeerASN int `json:"peerASN"`
+
+	// PeerPort is the port of the BGP router.
+	// Default value is 179.
+	PeerPort int `json:"peerPort,omitempty"`
+
+	// NodeSelector selects the nodes peering with the BGP router by their
+	// labels (e.g. the nodes in the rack of a top-of-rack router). All nodes
+	// peer with the router if not set. The node selector is supported only
+	// with the Calico CNI plugin.
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+}
+
+// BGPRouteReflectors configures the nodes acting as BGP route reflectors
+type BGPRouteReflectors struct {
+	// NodeSelector selects the route reflector nodes by their labels. KubeOne
+	// annotates the selected nodes with the route reflector cluster ID, so the
+	// nodes joining the cluster later become route reflectors on the next
+	// apply.
+	NodeSelector map[string]string `json:"nodeSelector"`
+
+	// ClusterID is the route reflector cluster ID, given as an IPv4 address.
+	// Default value is "244.0.0.1".
+	ClusterID string `json:"clusterID,omitempty"`
+}
+
+// EgressGateway routes the traffic of the selected pods to the destinations
+// outside of the cluster through a gateway node
+type EgressGateway struct {
+	// Name of the egress gateway
+	Name string `json:"name"`
+
+	// Namespace of the pods whose traffic is routed through the gateway.
+	// Pods in all namespaces are selected if not set. At least one of
+	// namespace and podSelector is required.
+	Namespace string `json:"namespace,omitempty"`
+
+	// PodSelector selects the pods whose traffic is routed through the gateway
+	// by their labels.
+	PodSelector map[string]string `json:"podSelector,omitempty"`
+
+	// DestinationCIDRs are the destinations of the traffic routed through the gateway.
+	// Default value is ["0.0.0.0/0"].
+	DestinationCIDRs []string `json:"destinationCIDRs,omitempty"`
+
+	// ExcludedCIDRs are the destinations excluded from the destinationCIDRs.
+	ExcludedCIDRs []string `json:"excludedCIDRs,omitempty"`
+
+	// NodeSelector selects the gateway node by its labels.
+	NodeSelector map[string]string `json:"nodeSelector"`
+
+	// EgressIP is the source IP address of the traffic leaving the gateway
+	// node. KubeOne assigns the address to the network interface with the
+	// default route of the static host (control plane or static worker)
+	// matched by the nodeSelector, so exactly one static host must match it.
+	// On the cloud providers, the address must also be assigned to the
+	// instance (e.g. as a secondary private IP address on AWS, an alias IP on
+	// Hetzner or an allowed address pair on OpenStack).
+	EgressIP string `json:"egressIP,omitempty"`
+
+	// Interface of the gateway node used by the traffic leaving the cluster.
+	// Can't be used with egressIP. If neither is set, the interface with the
+	// default route is used.
+	Interface string `json:"interface,omitempty"`
+}
+
+// IPFamily allows specifying IP family of a cluster.
+// Valid values are IPv4 | IPv6 | IPv4+IPv6 | IPv6+IPv4.
+type IPFamily string
+
+const (
+	// IPFamilyIPv4 IPv4 only cluster.
+	IPFamilyIPv4 IPFamily = "IPv4"
+	// IPFamilyIPv6 IPv6 only cluster.
+	IPFamilyIPv6 IPFamily = "IPv6"
+	// IPFamilyIPv4IPv6 Dualstack cluster with IPv4 as primary address family.
+	IPFamilyIPv4IPv6 IPFamily = "IPv4+IPv6"
+	// IPFamilyIPv6IPv4 Dualstack cluster with IPv6 as primary address family.
+	IPFamilyIPv6IPv4 IPFamily = "IPv6+IPv4"
+)
+
+// KubeProxyConfig defines configured kube-proxy mode, default is iptables mode
+type KubeProxyConfig struct {
+	// SkipInstallation will skip the installation of kube-proxy
+	// default value is false
+	SkipInstallation bool `json:"skipInstallation"`
+
+	// IPVS config
+	IPVS *IPVSConfig `json:"ipvs"`
+
+	// IPTables config
+	IPTables *IPTables `json:"iptables"`
+
+	// NFTables config. The nftables mode requires Kubernetes 1.29 or newer.
+	NFTables *NFTables `json:"nftables,omitempty"`
+}
+
+// IPVSConfig contains different options to configure IPVS kube-proxy mode
+type IPVSConfig struct {
+	// ipvs scheduler, if it’s not configured, then round-robin (rr) is the default value.
+	// Can be one of:
+	// * rr: round-robin
+	// * lc: least connection (smallest number of open connections)
+	// * dh: destination hashing
+	// * sh: source hashing
+	// * sed: shortest expected delay
+	// * nq: never queue
+	Scheduler string `json:"scheduler"`
+
+	// excludeCIDRs is a list of CIDR's which the ipvs proxier should not touch
+	// when cleaning up ipvs services.
+	ExcludeCIDRs []string `json:"excludeCIDRs"`
+
+	// strict ARP configure arp_ignore and arp_announce to avoid answering ARP queries
+	// from kube-ipvs0 interface
+	StrictARP bool `json:"strictARP"`
+
+	// tcpTimeout is the timeout value used for idle IPVS TCP sessions.
+	// The default value is 0, which preserves the current timeout value on the system.
+	TCPTimeout metav1.Duration `json:"tcpTimeout"`
+
+	// tcpFinTimeout is the timeout value used for IPVS TCP sessions after receiving a FIN.
+	// The default value is 0, which preserves the current timeout value on the system.
+	TCPFinTimeout metav1.Duration `json:"tcpFinTimeout"`
+
+	// udpTimeout is the timeout value used for IPVS UDP packets.
+	// The default value is 0, which preserves the current timeout value on the system.
+	UDPTimeout metav1.Duration `json:"udpTimeout"`
+
+	// syncPeriod is the maximum interval of how often IPVS rules are refreshed.
+	// The default value is 0, which uses the kube-proxy default (30s).
+	SyncPeriod metav1.Duration `json:"syncPeriod"`
+
+	// minSyncPeriod is the minimum period that IPVS rules are refreshed.
+	// The default value is 0, which uses the kube-proxy default (0s).
+	MinSyncPeriod metav1.Duration `json:"minSyncPeriod"`
+}
+
+// IPTables contains different options to configure iptables kube-proxy mode
+type IPTables struct {
+	// masqueradeAll tells kube-proxy to SNAT all traffic sent to Service cluster IPs
+	MasqueradeAll bool `json:"masqueradeAll,omitempty"`
+
+	// syncPeriod is the maximum interval of how often iptables rules are refreshed.
+	// The default value is 0, which uses the kube-proxy default (30s).
+	SyncPeriod metav1.Duration `json:"syncPeriod"`
+
+	// minSyncPeriod is the minimum period that iptables rules are refreshed.
+	// The default value is 0, which uses the kube-proxy default (1s).
+	MinSyncPeriod metav1.Duration `json:"minSyncPeriod"`
+}
+
+// NFTables contains different options to configure nftables kube-proxy mode
+type NFTables struct {
+	// masqueradeAll tells kube-proxy to SNAT all traffic sent to Service cluster IPs
+	MasqueradeAll bool `json:"masqueradeAll,omitempty"`
+
+	// syncPeriod is the maximum interval of how often nftables rules are refreshed.
+	// The default value is 0, which uses the kube-proxy default (30s).
+	SyncPeriod metav1.Duration `json:"syncPeriod"`
+
+	// minSyncPeriod is the minimum period that nftables rules are refreshed.
+	// The default value is 0, which uses the kube-proxy default (1s).
+	MinSyncPeriod metav1.Duration `json:"minSyncPeriod"`
+}
+
+// CNI config. Only one CNI provider must be used at the single time.
+type CNI struct {
+	// Canal
+	Canal *CanalSpec `json:"canal,omitempty"`
+
+	// Cilium
+	Cilium *CiliumSpec `json:"cilium,omitempty"`
+
+	// Calico
+	Calico *CalicoSpec `json:"calico,omitempty"`
+
+	// WeaveNet
+	WeaveNet *WeaveNetSpec `json:"weaveNet,omitempty"`
+
+	// External
+	External *ExternalCNISpec `json:"external,omitempty"`
+
+	// EnableBandwidthPlugin chains the bandwidth CNI plugin to the CNI plugin,
+	// so that the kubernetes.io/ingress-bandwidth and
+	// kubernetes.io/egress-bandwidth annotations of the pods are honored.
+	// The bandwidth plugin can be chained only to the Canal and Calico CNI
+	// plugins, as Cilium and WeaveNet manage their CNI configuration on their
+	// own.
+	// Default value is true for Canal and Calico, as in the upstream
+	// manifests, and false otherwise.
+	EnableBandwidthPlugin *bool `json:"enableBandwidthPlugin,omitempty"`
+}
+
+// CanalSpec defines the Canal CNI plugin
+type CanalSpec struct {
+	// MTU automatically detected based on the cloudProvider
+	// default value is 1450
+	MTU int `json:"mtu,omitempty"`
+}
+
+// CalicoEncapsulationMode defines the encapsulation of the pod traffic between the nodes
+type CalicoEncapsulationMode string
+
+const (
+	CalicoEncapsulationModeVXLAN CalicoEncapsulationMode = "VXLAN"
+	CalicoEncapsulationModeIPIP  CalicoEncapsulationMode = "IPIP"
+	CalicoEncapsulationModeNone  CalicoEncapsulationMode = "None"
+)
+
+// CalicoSpec defines the Calico CNI plugin
+type CalicoSpec struct {
+	// EncapsulationMode defines the encapsulation of the pod traffic between
+	// the nodes. Can be "VXLAN", "IPIP" or "None" (the pod traffic is routed
+	// using BGP). The encapsulation mode of the default IP pools is updated by
+	// KubeOne when it's changed.
+	// Default value is "VXLAN".
+	EncapsulationMode CalicoEncapsulationMode `json:"encapsulationMode,omitempty"`
+
+	// CrossSubnet encapsulates the pod traffic only between nodes in different
+	// subnets. It can't be used with the "None" encapsulation mode.
+	// Default value is false.
+	CrossSubnet bool `json:"crossSubnet,omitempty"`
+
+	// EnableTypha deploys Typha, which caches the Kubernetes API for the
+	// calico-node pods and is recommended for clusters with more than 50
+	// nodes. The number of Typha replicas is scaled with the number of nodes
+	// by the cluster-proportional-autoscaler.
+	// Default value is false.
+	EnableTypha bool `json:"enableTypha,omitempty"`
+
+	// MTU of the pod network interfaces. If not set, it's detected based on
+	// the cloudProvider and the overhead of the encapsulation mode, or
+	// autodetected by Calico on the other providers.
+	MTU int `json:"mtu,omitempty"`
+
+	// EnableWireGuard enables the transparent encryption of the pod traffic
+	// between the nodes using WireGuard. The WireGuard keys of the nodes are
+	// managed by Calico.
+	// Default value is false.
+	EnableWireGuard bool `json:"enableWireGuard,omitempty"`
+}
+
+type KubeProxyReplacementType string
+
+const (
+	KubeProxyReplacementStrict   KubeProxyReplacementType = "strict"
+	KubeProxyReplacementDisabled KubeProxyReplacementType = "disabled"
+)
+
+// CiliumRoutingMode defines how the pod traffic is routed between the nodes
+type CiliumRoutingMode string
+
+const (
+	CiliumRoutingModeTunnel CiliumRoutingMode = "tunnel"
+	CiliumRoutingModeNative CiliumRoutingMode = "native"
+)
+
+// CiliumTunnelProtocol defines the encapsulation protocol of the tunnel routing mode
+type CiliumTunnelProtocol string
+
+const (
+	CiliumTunnelProtocolVXLAN  CiliumTunnelProtocol = "vxlan"
+	CiliumTunnelProtocolGeneve CiliumTunnelProtocol = "geneve"
+)
+
+// CiliumIPAMMode defines the Cilium IP address management mode
+type CiliumIPAMMode string
+
+const (
+	CiliumIPAMModeClusterPool CiliumIPAMMode = "cluster-pool"
+	CiliumIPAMModeKubernetes  CiliumIPAMMode = "kubernetes"
+)
+
+// CiliumEncryption defines the transparent encryption of the pod traffic between the nodes
+type CiliumEncryption string
+
+const (
+	CiliumEncryptionWireGuard CiliumEncryption = "wireguard"
+	CiliumEncryptionIPsec     CiliumEncryption = "ipsec"
+)
+
+// CiliumHostRouting defines how the traffic is routed between the host and the pods
+type CiliumHostRouting string
+
+const (
+	CiliumHostRoutingLegacy CiliumHostRouting = "legacy"
+	CiliumHostRoutingBPF    CiliumHostRouting = "bpf"
+)
+
+// CiliumSpec defines the Cilium CNI plugin
+type CiliumSpec struct {
+	// KubeProxyReplacement defines weather cilium relies on underlying Kernel support
+	// to replace kube-proxy functionality by eBPF (strict), or disables a subset of those
+	// features so cilium does not bail out if the kernel support is missing (disabled).
+	// kube-proxy is not installed if set to "strict".
+	// default is "disabled"
+	KubeProxyReplacement KubeProxyReplacementType `json:"kubeProxyReplacement"`
+
+	// EnableHubble to deploy Hubble relay and UI
+	// default value is false
+	EnableHubble bool `json:"enableHubble"`
+
+	// DisableHubbleUI skips the deployment of the Hubble UI, so only the
+	// Hubble relay is deployed if EnableHubble is set.
+	// Default value is false.
+	DisableHubbleUI bool `json:"disableHubbleUI,omitempty"`
+
+	// RoutingMode defines how the pod traffic is routed between the nodes.
+	// Can be "tunnel" (the pod traffic is encapsulated) or "native" (the pod
+	// traffic is routed by the underlying network).
+	// Default value is "tunnel".
+	RoutingMode CiliumRoutingMode `json:"routingMode,omitempty"`
+
+	// TunnelProtocol defines the encapsulation protocol used with the "tunnel"
+	// routing mode. Can be "vxlan" or "geneve".
+	// Default value is "vxlan".
+	TunnelProtocol CiliumTunnelProtocol `json:"tunnelProtocol,omitempty"`
+
+	// AutoDirectNodeRoutes installs the routes to the pod CIDRs of the other
+	// nodes on each node. It requires all nodes to share a L2 network and is
+	// usually used with the "native" routing mode.
+	// Default value is false.
+	AutoDirectNodeRoutes bool `json:"autoDirectNodeRoutes,omitempty"`
+
+	// IPAM defines the IP address management mode. Can be "cluster-pool" (the
+	// pod CIDRs of the nodes are allocated by the Cilium operator) or
+	// "kubernetes" (the pod CIDRs of the nodes are allocated by
+	// kube-controller-manager).
+	// Default value is "cluster-pool".
+	IPAM CiliumIPAMMode `json:"ipam,omitempty"`
+
+	// MTU of the underlying network. Cilium subtracts the overhead of the
+	// tunnel routing mode by itself. If not set, it's detected based on the
+	// cloudProvider, or autodetected by Cilium on the other providers.
+	MTU int `json:"mtu,omitempty"`
+
+	// Encryption enables the transparent encryption of the pod traffic
+	// between the nodes. Can be "wireguard" or "ipsec". The WireGuard keys
+	// are managed by Cilium, while the IPsec key is generated by KubeOne and
+	// stored in the kube-system/cilium-ipsec-keys Secret.
+	// Encryption is disabled by default.
+	Encryption CiliumEncryption `json:"encryption,omitempty"`
+
+	// HostRouting defines how the traffic is routed between the host and the
+	// pods. Can be "legacy" (the traffic goes through the host network stack
+	// and iptables) or "bpf" (the traffic is redirected by eBPF, bypassing
+	// the host network stack). The "bpf" host routing requires
+	// kubeProxyReplacement set to "strict" and Linux kernel 5.10 or newer
+	// with BTF enabled on all nodes.
+	// Default value is "legacy".
+	HostRouting CiliumHostRouting `json:"hostRouting,omitempty"`
+}
+
+// WeaveNetSpec defines the WeaveNet CNI plugin
+type WeaveNetSpec struct {
+	// Encrypted
+	Encrypted bool `json:"encrypted,omitempty"`
+}
+
+// ExternalCNISpec defines the external CNI plugin.
+// It's up to the user's responsibility to deploy the external CNI plugin manually or as an addon
+type ExternalCNISpec struct{}
+
+// ProxyConfig configures proxy for the Docker daemon and is used by KubeOne scripts
+type ProxyConfig struct {
+	// HTTP
+	HTTP string `json:"http,omitempty"`
+
+	// HTTPS
+	HTTPS string `json:"https,omitempty"`
+
+	// NoProxy
+	NoProxy string `json:"noProxy,omitempty"`
+}
+
+// DynamicWorkerConfig describes a set of worker machines
+type DynamicWorkerConfig struct {
+	// Name
+	Name string `json:"name"`
+
+	// Replicas
+	Replicas *int `json:"replicas"`
+
+	// CloudProvider is the name of the cloud provider to create the
+	// MachineDeployment on (e.g. aws or hetzner), if it differs from the
+	// cluster cloud provider. The provider credentials are read the same way
+	// as for the cluster cloud provider.
+	CloudProvider string `json:"cloudProvider,omitempty"`
+
+	// UpgradeStrategy configures how the machines of the MachineDeployment
+	// are replaced when the MachineDeployment is upgraded or changed.
+	UpgradeStrategy *MachineDeploymentUpgradeStrategy `json:"upgradeStrategy,omitempty"`
+
+	// Config
+	Config ProviderSpec `json:"providerSpec"`
+}
+
+// MachineDeploymentUpgradeStrategyType is the way the machines of a
+// MachineDeployment are upgraded
+type MachineDeploymentUpgradeStrategyType string
+
+const (
+	// MachineDeploymentUpgradeStrategyReplace replaces the machines with new
+	// machines, a few at a time
+	MachineDeploymentUpgradeStrategyReplace MachineDeploymentUpgradeStrategyType = "Replace"
+	// MachineDeploymentUpgradeStrategyInPlace upgrades the existing machines
+	// without replacing them. It's not supported by machine-controller.
+	MachineDeploymentUpgradeStrategyInPlace MachineDeploymentUpgradeStrategyType = "InPlace"
+)
+
+// MachineDeploymentUpgradeStrategy configures how the machines of a
+// MachineDeployment are replaced
+type MachineDeploymentUpgradeStrategy struct {
+	// Type of the upgrade strategy. Only Replace is supported, as
+	// machine-controller always replaces the machines.
+	// Default value is Replace.
+	Type MachineDeploymentUpgradeStrategyType `json:"type,omitempty"`
+
+	// MaxSurge is the maximum number of machines (or a percentage of the
+	// replicas) created above the desired number of replicas during the
+	// upgrade.
+	// Default value is 1, or 0 if the MachineDeployment uses a static network
+	// configuration.
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+
+	// MaxUnavailable is the maximum number of machines (or a percentage of the
+	// replicas) that can be unavailable during the upgrade.
+	// Default value is 0, or 1 if the MachineDeployment uses a static network
+	// configuration.
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// SkipEviction deletes the replaced machines without evicting the pods
+	// from their nodes first, for the worker pools that don't need to be
+	// drained gracefully. The time after which machine-controller gives up on
+	// draining a node is configured globally by machine-controller and can't
+	// be set per MachineDeployment.
+	SkipEviction bool `json:"skipEviction,omitempty"`
+}
+
+// ProviderSpec describes a worker node
+type ProviderSpec struct {
+	// CloudProviderSpec
+	CloudProviderSpec json.RawMessage `json:"cloudProviderSpec"`
+
+	// Annotations set MachineDeployment.ObjectMeta.Annotations
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// NodeAnnotations set MachineDeployment.Spec.Template.Spec.ObjectMeta.Annotations
+	// as a way to annotate resulting Nodes
+	NodeAnnotations map[string]string `json:"nodeAnnotations,omitempty"`
+
+	// MachineObjectAnnotations set MachineDeployment.Spec.Template.Metadata.Annotations
+	// as a way to annotate resulting Machine objects. Those annotations are not
+	// propagated to Node objects. If you want to annotate resulting Nodes as well,
+	// see NodeAnnotations
+	MachineObjectAnnotations map[string]string `json:"machineObjectAnnotations,omitempty"`
+
+	// Labels
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Taints
+	Taints []corev1.Taint `json:"taints,omitempty"`
+
+	// SSHPublicKeys
+	SSHPublicKeys []string `json:"sshPublicKeys,omitempty"`
+
+	// OperatingSystem
+	OperatingSystem string `json:"operatingSystem"`
+
+	// OperatingSystemSpec
+	OperatingSystemSpec json.RawMessage `json:"operatingSystemSpec,omitempty"`
+
+	// Network
+	Network *ProviderStaticNetworkConfig `json:"network,omitempty"`
+
+	// OverwriteCloudConfig
+	OverwriteCloudConfig *string `json:"overwriteCloudConfig,omitempty"`
+}
+
+// DNSConfig contains a machine's DNS configuration
+type DNSConfig struct {
+	// Servers
+	Servers []string `json:"servers"`
+}
+
+// ProviderStaticNetworkConfig contains a machine's static network configuration
+type ProviderStaticNetworkConfig struct {
+	// CIDR
+	CIDR string `json:"cidr"`
+
+	// Gateway
+	Gateway string `json:"gateway"`
+
+	// DNS
+	DNS DNSConfig `json:"dns"`
+
+	// IPFamily
+	IPFamily IPFamily `json:"ipFamily"`
+}
+
+// MachineControllerConfig configures kubermatic machine-controller deployment
+type MachineControllerConfig struct {
+	// Deploy
+	Deploy bool `json:"deploy,omitempty"`
+}
+
+// OperatingSystemManagerConfig configures kubermatic operating-system-manager deployment.
+type OperatingSystemManagerConfig struct {
+	// Deploy
+	Deploy bool `json:"deploy,omitempty"`
+}
+
+// SystemPackages controls configurations of APT/YUM
+type SystemPackages struct {
+	// ConfigureRepositories (true by default) is a flag to control automatic
+	// configuration of kubeadm / docker repositories.
+	ConfigureRepositories bool `json:"configureRepositories,omitempty"`
+
+	// Repositories are the custom APT/YUM repositories, e.g. the mirrors used
+	// in the air-gapped environments. They are configured instead of the
+	// upstream repositories if ConfigureRepositories is enabled.
+	Repositories *PackageRepositories `json:"repositories,omitempty"`
+}
+
+// PackageRepositories are the custom repositories of the packages installed by KubeOne
+type PackageRepositories struct {
+	// Kubernetes is the repository of the kubelet, kubeadm, kubectl,
+	// kubernetes-cni and cri-tools packages
+	Kubernetes *PackageRepository `json:"kubernetes,omitempty"`
+
+	// ContainerRuntime is the repository of the containerd.io and docker-ce
+	// packages. It's not used on Amazon Linux 2, which installs the container
+	// runtime from the Amazon Linux repositories.
+	ContainerRuntime *PackageRepository `json:"containerRuntime,omitempty"`
+}
+
+// PackageRepository is an APT or YUM repository
+type PackageRepository struct {
+	// URL is the base URL of the repository
+	URL string `json:"url"`
+
+	// Suite is the APT suite of the repository, e.g. jammy. The APT repository
+	// is used as a flat repository if the suite is not set, like the upstream
+	// Kubernetes repositories. Ignored for the YUM repositories.
+	Suite string `json:"suite,omitempty"`
+
+	// Components are the APT components of the suite, e.g. stable. Ignored for
+	// the YUM repositories.
+	Components []string `json:"components,omitempty"`
+
+	// GPGKeyURL is the URL of the GPG key the repository is signed with
+	GPGKeyURL string `json:"gpgKeyURL,omitempty"`
+
+	// GPGKey is the ASCII-armored GPG key the repository is signed with. It's
+	// used instead of GPGKeyURL when the nodes can't download the key.
+	// The repository is trusted without verifying the signatures if neither
+	// GPGKeyURL nor GPGKey is set.
+	GPGKey string `json:"gpgKey,omitempty"`
+}
+
+// ImageAsset is used to customize the image repository and the image tag
+type ImageAsset struct {
+	// ImageRepository customizes the registry/repository
+	ImageRepository string `json:"imageRepository,omitempty"`
+
+	// ImageTag customizes the image tag
+	ImageTag string `json:"imageTag,omitempty"`
+}
+
+// BinaryAsset is used to customize the URL of the binary asset
+type BinaryAsset struct {
+	// URL from where to download the binary
+	URL string `json:"url,omitempty"`
+}
+
+// RegistryConfiguration controls how images used for components deployed by
+// KubeOne and kubeadm are pulled from an image registry
+type RegistryConfiguration struct {
+	// OverwriteRegistry specifies a custom Docker registry which will be used
+	// for all images required for KubeOne and kubeadm. This also applies to
+	// addons deployed by KubeOne.
+	// This field doesn't modify the user/organization part of the image. For example,
+	// if OverwriteRegistry is set to 127.0.0.1:5000/example, image called
+	// calico/cni would translate to 127.0.0.1:5000/example/calico/cni.
+	// Default: ""
+	OverwriteRegistry string `json:"overwriteRegistry,omitempty"`
+
+	// InsecureRegistry configures Docker to threat the registry specified
+	// in OverwriteRegistry as an insecure registry. This is also propagated
+	// to the worker nodes managed by machine-controller and/or KubeOne.
+	InsecureRegistry bool `json:"insecureRegistry,omitempty"`
+}
+
+// Features controls what features will be enabled on the cluster
+type Features struct {
+	// CoreDNS
+	CoreDNS *CoreDNS `json:"coreDNS,omitempty"`
+
+	// PodNodeSelector
+	PodNodeSelector *PodNodeSelector `json:"podNodeSelector,omitempty"`
+
+	// StaticAuditLog
+	StaticAuditLog *StaticAuditLog `json:"staticAuditLog,omitempty"`
+
+	// DynamicAuditLog
+	DynamicAuditLog *DynamicAuditLog `json:"dynamicAuditLog,omitempty"`
+
+	// MetricsServer
+	MetricsServer *MetricsServer `json:"metricsServer,omitempty"`
+
+	// OpenIDConnect
+	OpenIDConnect *OpenIDConnect `json:"openidConnect,omitempty"`
+
+	// Encryption Providers
+	EncryptionProviders *EncryptionProviders `json:"encryptionProviders,omitempty"`
+
+	// NodeLocalDNS config
+	NodeLocalDNS *NodeLocalDNS `json:"nodeLocalDNS,omitempty"`
+
+	// NvidiaGPU configures support for worker nodes with NVIDIA GPUs
+	NvidiaGPU *NvidiaGPU `json:"nvidiaGPU,omitempty"`
+
+	// NodeSwap configures support for swap memory on nodes
+	NodeSwap *NodeSwap `json:"nodeSwap,omitempty"`
+
+	// SeccompDefault configures the RuntimeDefault seccomp profile as the default for all workloads
+	SeccompDefault *SeccompDefault `json:"seccompDefault,omitempty"`
+
+	// MetalLB deploys MetalLB to provide LoadBalancer Services on clusters
+	// without a cloud load balancer, such as baremetal clusters
+	MetalLB *MetalLB `json:"metalLB,omitempty"`
+
+	// GatewayAPI installs the Gateway API CRDs and configures the gateway controller
+	GatewayAPI *GatewayAPI `json:"gatewayAPI,omitempty"`
+
+	// IstioAmbient installs Istio in the ambient mode
+	IstioAmbient *IstioAmbient `json:"istioAmbient,omitempty"`
+
+	// KubeVIP deploys kube-vip to provide the virtual IP address of the API endpoint
+	KubeVIP *KubeVIP `json:"kubeVIP,omitempty"`
+
+	// DefaultDenyNetworkPolicy deploys a baseline set of NetworkPolicies
+	// denying the traffic of the pods in the selected namespaces
+	DefaultDenyNetworkPolicy *DefaultDenyNetworkPolicy `json:"defaultDenyNetworkPolicy,omitempty"`
+
+	// ClusterAutoscaler deploys cluster-autoscaler, which scales the
+	// MachineDeployments based on the pending pods and the node utilization
+	ClusterAutoscaler *ClusterAutoscaler `json:"clusterAutoscaler,omitempty"`
+
+	// LocalStorage deploys a local storage provisioner with a StorageClass,
+	// so that the PersistentVolumeClaims can be used on clusters without a
+	// cloud CSI driver, such as baremetal clusters
+	LocalStorage *LocalStorage `json:"localStorage,omitempty"`
+
+	// IngressNginx deploys the ingress-nginx controller with its Service
+	// pre-configured for the cloud provider
+	IngressNginx *IngressNginx `json:"ingressNginx,omitempty"`
+}
+
+// MetalLBMode is the mode used by MetalLB to announce LoadBalancer IP addresses
+type MetalLBMode string
+
+const (
+	// MetalLBModeL2 announces addresses using ARP (IPv4) and NDP (IPv6) from a single node
+	MetalLBModeL2 MetalLBMode = "L2"
+
+	// MetalLBModeBGP announces addresses to the configured BGP peers from all nodes
+	MetalLBModeBGP MetalLBMode = "BGP"
+)
+
+// KubeVIP feature flag
+type KubeVIP struct {
+	// Enable deploys kube-vip as a static pod on the control plane nodes. The
+	// apiEndpoint.host must be an unused IP address in the subnet of the
+	// control plane nodes. It's assigned to the kube-vip leader and announced
+	// using ARP (NDP for IPv6), so the control plane nodes must share a layer 2
+	// network.
+	Enable bool `json:"enable,omitempty"`
+
+	// Interface is the network interface of the control plane nodes the
+	// virtual IP address is assigned to. If not set, the interface with the
+	// default route is used.
+	Interface string `json:"interface,omitempty"`
+}
+
+// DefaultDenyNetworkPolicy feature flag
+type DefaultDenyNetworkPolicy struct {
+	// Enable deploys NetworkPolicies denying all ingress and egress traffic of
+	// the pods in the selected namespaces right after the CNI plugin is
+	// deployed. The DNS traffic, the traffic to the Kubernetes API and the
+	// ingress traffic from the kube-system namespace are explicitly allowed.
+	Enable bool `json:"enable,omitempty"`
+
+	// Namespaces is a list of namespaces the NetworkPolicies are deployed to.
+	// The namespaces are created if they don't exist. The namespaces of the
+	// components deployed by KubeOne (e.g. kube-system) can't be selected.
+	// Default value is ["default"].
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// MetalLB feature flag
+type MetalLB struct {
+	// Enable deploys MetalLB and configures it with the given address pools and BGP peers.
+	// The L2 mode requires .clusterNetwork.kubeProxy.ipvs.strictARP to be enabled if
+	// kube-proxy runs in the IPVS mode. This feature can't be used on Equinix Metal with
+	// .cloudProvider.equinixmetal.loadBalancer, where MetalLB is managed by the CCM.
+	Enable bool `json:"enable,omitempty"`
+
+	// AddressPools is a list of IP address pools from which the LoadBalancer
+	// Services get their IP addresses.
+	AddressPools []MetalLBAddressPool `json:"addressPools,omitempty"`
+
+	// BGPPeers is a list of BGP routers to which the addresses from the
+	// address pools in the BGP mode are announced.
+	BGPPeers []MetalLBBGPPeer `json:"bgpPeers,omitempty"`
+}
+
+// MetalLBAddressPool is a pool of IP addresses managed by MetalLB
+type MetalLBAddressPool struct {
+	// Name is the name of the address pool. Services can request an address from
+	// a specific pool using the metallb.universe.tf/address-pool annotation.
+	Name string `json:"name"`
+
+	// Addresses is a list of IP addresses in the pool, given either as a CIDR
+	// (e.g. 192.168.10.0/24) or as a range (e.g. 192.168.10.100-192.168.10.200).
+	Addresses []string `json:"addresses"`
+
+	// Mode is the mode used to announce the addresses from the pool.
+	// Possible values are L2 and BGP. Default value is L2.
+	Mode MetalLBMode `json:"mode,omitempty"`
+}
+
+// MetalLBBGPPeer is a BGP router to which MetalLB announces addresses
+type MetalLBBGPPeer struct {
+	// PeerAddress is the IP address of the BGP router.
+	PeerAddress string `json:"peerAddress"`
+
+	// PeerASN is the ASN of the BGP router.
+	PeerASN int `json:"peerASN"`
+
+	// MyASN is the ASN announced by the cluster nodes.
+	MyASN int `json:"myASN"`
+
+	// PeerPort is the port of the BGP router.
+	// Default value is 179.
+	PeerPort int `json:"peerPort,omitempty"`
+}
+
+// GatewayAPIController is the controller implementing the Gateway API
+type GatewayAPIController string
+
+const (
+	// GatewayAPIControllerCilium enables the Gateway API controller of the Cilium CNI
+	GatewayAPIControllerCilium GatewayAPIController = "cilium"
+
+	// GatewayAPIControllerNone installs only the Gateway API CRDs, so that a
+	// gateway controller can be deployed by the user
+	GatewayAPIControllerNone GatewayAPIController = "none"
+)
+
+// GatewayAPI feature flag
+type GatewayAPI struct {
+	// Enable installs the Gateway API CRDs. The CRDs are bundled with KubeOne,
+	// matching the version supported by the bundled gateway controller, and
+	// are upgraded by kubeone apply.
+	Enable bool `json:"enable,omitempty"`
+
+	// Controller is the controller implementing the Gateway API.
+	// Possible values are cilium and none. The cilium controller requires the
+	// Cilium CNI with kubeProxyReplacement set to strict.
+	// Default value is cilium if the Cilium CNI is used with kubeProxyReplacement
+	// set to strict, otherwise none.
+	Controller GatewayAPIController `json:"controller,omitempty"`
+}
+
+// ClusterAutoscaler feature flag
+type ClusterAutoscaler struct {
+	// Enable deploys cluster-autoscaler using the Cluster-API provider. The
+	// cluster-autoscaler minor version matches the Kubernetes minor version of
+	// the cluster. Requires machine-controller to be deployed.
+	Enable bool `json:"enable,omitempty"`
+
+	// NodeGroups are the MachineDeployments scaled by cluster-autoscaler.
+	// KubeOne annotates the MachineDeployments with the minimum and the
+	// maximum number of replicas on each apply, and removes the annotations
+	// from the MachineDeployments that are not in the list anymore.
+	NodeGroups []ClusterAutoscalerNodeGroup `json:"nodeGroups,omitempty"`
+
+	// SkipNodesWithLocalStorage prevents scaling down the nodes running pods
+	// with local storage, e.g. EmptyDir or HostPath volumes.
+	// Default value is true.
+	SkipNodesWithLocalStorage *bool `json:"skipNodesWithLocalStorage,omitempty"`
+
+	// ScaleDownUnneededTime is how long a node must be unneeded before it's
+	// scaled down. The cluster-autoscaler default (10m) is used if not set.
+	ScaleDownUnneededTime *metav1.Duration `json:"scaleDownUnneededTime,omitempty"`
+
+	// Expander selects the node group to scale up.
+	// Possible values: random, most-pods, least-waste, price, priority
+	// Default value is random.
+	Expander string `json:"expander,omitempty"`
+}
+
+// ClusterAutoscalerNodeGroup is a MachineDeployment scaled by cluster-autoscaler
+type ClusterAutoscalerNodeGroup struct {
+	// MachineDeployment is the name of the MachineDeployment in the
+	// kube-system namespace, usually the name of the dynamic worker.
+	MachineDeployment string `json:"machineDeployment"`
+
+	// MinReplicas is the minimum number of replicas, it must be at least 1
+	MinReplicas int `json:"minReplicas"`
+
+	// MaxReplicas is the maximum number of replicas
+	MaxReplicas int `json:"maxReplicas"`
+}
+
+// LocalStorage feature flag
+type LocalStorage struct {
+	// Enable deploys the local storage provisioner and its StorageClass. The
+	// volumes are local to the node they're provisioned on, so the pods using
+	// them are always scheduled on that node. It can be used only with the
+	// none cloud provider, or with .cloudProvider.disableBundledCSIDrivers.
+	Enable bool `json:"enable,omitempty"`
+
+	// LocalPath provisions the volumes as directories on the nodes using
+	// local-path-provisioner. It's used if neither LocalPath nor TopoLVM are
+	// set.
+	LocalPath *LocalPathProvisioner `json:"localPath,omitempty"`
+
+	// TopoLVM provisions the volumes as LVM logical volumes on the nodes using
+	// TopoLVM. Unlike LocalPath, it supports the volume size limits, the
+	// volume expansion and the storage capacity tracking.
+	TopoLVM *TopoLVMProvisioner `json:"topolvm,omitempty"`
+
+	// StorageClassName is the name of the StorageClass.
+	// Default value is local-path for LocalPath and topolvm-provisioner for
+	// TopoLVM.
+	StorageClassName string `json:"storageClassName,omitempty"`
+
+	// DefaultStorageClass marks the StorageClass as the default one.
+	// Default value is true.
+	DefaultStorageClass *bool `json:"defaultStorageClass,omitempty"`
+
+	// ReclaimPolicy of the StorageClass.
+	// Possible values: Delete, Retain
+	// Default value is Delete.
+	ReclaimPolicy corev1.PersistentVolumeReclaimPolicy `json:"reclaimPolicy,omitempty"`
+}
+
+// LocalPathProvisioner configures local-path-provisioner
+type LocalPathProvisioner struct {
+	// Path is the directory on the nodes in which the volumes are created.
+	// Default value is /opt/local-path-provisioner.
+	Path string `json:"path,omitempty"`
+}
+
+// TopoLVMProvisioner configures TopoLVM
+type TopoLVMProvisioner struct {
+	// VolumeGroup is the name of the LVM volume group in which the logical
+	// volumes are created. The volume group must be created on the nodes in
+	// advance, the nodes without it don't get any volumes.
+	VolumeGroup string `json:"volumeGroup"`
+
+	// FSType is the filesystem of the volumes.
+	// Possible values: xfs, ext4, btrfs
+	// Default value is xfs.
+	FSType string `json:"fsType,omitempty"`
+
+	// SpareGB is the capacity of the volume group in GiB which is not used
+	// for the volumes. Default value is 10.
+	SpareGB *int `json:"spareGB,omitempty"`
+}
+
+// IngressNginx feature flag
+type IngressNginx struct {
+	// Enable deploys the ingress-nginx controller with the nginx
+	// IngressClass. The controller Service is annotated for the cloud
+	// provider: a Network Load Balancer is used on AWS, and the load
+	// balancer is named after the cluster on Hetzner and DigitalOcean. On
+	// Hetzner, the load balancer location must be set in ServiceAnnotations
+	// using load-balancer.hetzner.cloud/location or
+	// load-balancer.hetzner.cloud/network-zone.
+	Enable bool `json:"enable,omitempty"`
+
+	// Replicas is the number of the controller replicas. The replicas are
+	// spread across the nodes.
+	// Default value is 2.
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// DefaultIngressClass marks the nginx IngressClass as the default one.
+	// Default value is true.
+	DefaultIngressClass *bool `json:"defaultIngressClass,omitempty"`
+
+	// ServiceType is the type of the controller Service.
+	// Possible values: LoadBalancer, NodePort
+	// Default value is NodePort with the none cloud provider without MetalLB,
+	// and LoadBalancer otherwise.
+	ServiceType corev1.ServiceType `json:"serviceType,omitempty"`
+
+	// ExternalTrafficPolicy of the controller Service.
+	// Possible values: Local, Cluster
+	// Default value is Cluster if ProxyProtocol is enabled, as the client
+	// addresses are passed by the load balancer, and Local otherwise.
+	ExternalTrafficPolicy corev1.ServiceExternalTrafficPolicy `json:"externalTrafficPolicy,omitempty"`
+
+	// ProxyProtocol enables the PROXY protocol on the load balancer and the
+	// controller, passing the client addresses to the controller. It's
+	// supported only on AWS, DigitalOcean and Hetzner.
+	// Default value is true on DigitalOcean and Hetzner, and false otherwise.
+	ProxyProtocol *bool `json:"proxyProtocol,omitempty"`
+
+	// ServiceAnnotations are the annotations of the controller Service. They
+	// override the annotations set for the cloud provider.
+	ServiceAnnotations map[string]string `json:"serviceAnnotations,omitempty"`
+
+	// Config is the ingress-nginx configuration, set in the controller
+	// ConfigMap. See
+	// https://kubernetes.github.io/ingress-nginx/user-guide/nginx-configuration/configmap/
+	Config map[string]string `json:"config,omitempty"`
+}
+
+// IstioAmbient feature flag
+type IstioAmbient struct {
+	// Enable installs the Istio control plane, the istio-cni node agent and
+	// ztunnel in the ambient mode. The istio-cni plugin is chained to the CNI
+	// plugin deployed by KubeOne.
+	Enable bool `json:"enable,omitempty"`
+}
+
+// SeccompDefault feature flag
+type SeccompDefault struct {
+	// Enable configures kubelets to use the RuntimeDefault seccomp profile as the default
+	// profile for all workloads, instead of Unconfined. Changing this setting on an existing
+	// cluster restarts the kubelet on all control plane and static worker nodes.
+	Enable bool `json:"enable,omitempty"`
+
+	// ProfilePaths is a list of paths to custom seccomp profiles on the local filesystem.
+	// The profiles are distributed to the /var/lib/kubelet/seccomp/profiles directory on all
+	// control plane and static worker nodes, and can be referenced in workloads as
+	// `localhostProfile: profiles/<file name>`. File names must be unique. The directory is
+	// managed by KubeOne, so profiles removed from this list are removed from the nodes.
+	ProfilePaths []string `json:"profilePaths,omitempty"`
+}
+
+// NodeSwap feature flag
+type NodeSwap struct {
+	// Enable allows the kubelet to start on nodes with swap enabled by setting failSwapOn to false
+	// and enabling the NodeSwap feature gate. KubeOne doesn't disable swap on the static nodes when
+	// this feature is enabled, however, swap itself must be provisioned on the nodes by the operator.
+	// Requires Kubernetes 1.28 or newer and nodes running cgroup v2.
+	Enable bool `json:"enable,omitempty"`
+
+	// SwapBehavior configures how workloads can use swap memory.
+	// Possible values: LimitedSwap, UnlimitedSwap
+	// Default value: LimitedSwap
+	SwapBehavior SwapBehavior `json:"swapBehavior,omitempty"`
+}
+
+// SwapBehavior is the kubelet swap behavior
+type SwapBehavior string
+
+const (
+	// SwapBehaviorLimitedSwap limits the amount of swap that workloads can use
+	SwapBehaviorLimitedSwap SwapBehavior = "LimitedSwap"
+	// SwapBehaviorUnlimitedSwap allows workloads to use as much swap as they request, up to the system limit
+	SwapBehaviorUnlimitedSwap SwapBehavior = "UnlimitedSwap"
+)
+
+// NvidiaGPU feature flag
+type NvidiaGPU struct {
+	// Enable configures the nvidia container runtime and deploys the NVIDIA device plugin.
+	// The nvidia-container-toolkit is installed on each apply on control plane and static worker hosts labeled
+	// with `nvidia.com/gpu.present: "true"`, while NVIDIA drivers on such hosts are expected to be preinstalled.
+	// Flatcar hosts and MachineDeployments (dynamic workers) are not supported.
+	Enable bool `json:"enable,omitempty"`
+}
+
+type NodeLocalDNS struct {
+	// Deploy is enabled by default
+	Deploy bool `json:"deploy,omitempty"`
+}
+
+type CoreDNS struct {
+	Replicas                  *int32 `json:"replicas,omitempty"`
+	DeployPodDisruptionBudget *bool  `json:"deployPodDisruptionBudget,omitempty"`
+
+	// ImageRepository allows users to specify the image registry to be used
+	// for CoreDNS. Kubeadm automatically appends `/coredns` at the end, so it's
+	// not necessary to specify it.
+	// By default it's empty, which means it'll be defaulted based on kubeadm
+	// defaults and if overwriteRegistry feature is used.
+	// ImageRepository has the highest priority, meaning that it'll override
+	// overwriteRegistry if specified.
+	ImageRepository string `json:"imageRepository,omitempty"`
+}
+
+// PodNodeSelector feature flag
+type PodNodeSelector struct {
+	// Enable
+	Enable bool `json:"enable,omitempty"`
+
+	// Config
+	Config PodNodeSelectorConfig `json:"config"`
+}
+
+// PodNodeSelectorConfig config
+type PodNodeSelectorConfig struct {
+	// ConfigFilePath is a path on the local file system to the PodNodeSelector
+	// configuration file.
+	// ConfigFilePath is a required field.
+	// More info: https://kubernetes.io/docs/reference/access-authn-authz/admission-controllers/#podnodeselector
+	ConfigFilePath string `json:"configFilePath"`
+}
+
+// StaticAuditLog feature flag
+type StaticAuditLog struct {
+	// Enable
+	Enable bool `json:"enable,omitempty"`
+
+	// Config
+	Config StaticAuditLogConfig `json:"config"`
+}
+
+// StaticAuditLogConfig config
+type StaticAuditLogConfig struct {
+	// PolicyFilePath is a path on local file system to the audit policy manifest
+	// which defines what events should be recorded and what data they should include.
+	// PolicyFilePath is a required field.
+	// More info: https://kubernetes.io/docs/tasks/debug-application-cluster/audit/#audit-policy
+	PolicyFilePath string `json:"policyFilePath"`
+
+	// LogPath is path on control plane instances where audit log files are stored.
+	// Default value is /var/log/kubernetes/audit.log
+	LogPath string `json:"logPath,omitempty"`
+
+	// LogMaxAge is maximum number of days to retain old audit log files.
+	// Default value is 30
+	LogMaxAge int `json:"logMaxAge,omitempty"`
+
+	// LogMaxBackup is maximum number of audit log files to retain.
+	// Default value is 3.
+	LogMaxBackup int `json:"logMaxBackup,omitempty"`
+
+	// LogMaxSize is maximum size in megabytes of audit log file before it gets rotated.
+	// Default value is 100.
+	LogMaxSize int `json:"logMaxSize,omitempty"`
+}
+
+// DynamicAuditLog feature flag
+type DynamicAuditLog struct {
+	// Enable
+	// Default value is false.
+	Enable bool `json:"enable,omitempty"`
+}
+
+// MetricsServer feature flag
+type MetricsServer struct {
+	// Enable deployment of metrics-server.
+	// Default value is true.
+	Enable bool `json:"enable,omitempty"`
+
+	// Replicas is the number of metrics-server replicas. The replicas are
+	// spread across the nodes.
+	// Default value is 1.
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// SecureKubeletTLS verifies the kubelet serving certificates using the
+	// cluster CA instead of skipping the verification with
+	// --kubelet-insecure-tls. The serving certificates of the control plane
+	// and static worker nodes are approved by KubeOne, and of the dynamic
+	// worker nodes by machine-controller.
+	// Default value is false.
+	SecureKubeletTLS bool `json:"secureKubeletTLS,omitempty"`
+
+	// Resources are the compute resources of the metrics-server container.
+	// The default requests are 100m CPU and 200Mi memory, and the default
+	// limits are 1 CPU and 512Mi memory.
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// HostNetwork runs metrics-server in the host network namespace. It's
+	// needed when the API server can't reach the pod network, e.g. on the
+	// providers where the CNI plugin doesn't route the pod traffic of the
+	// control plane nodes.
+	// Default value is false.
+	HostNetwork bool `json:"hostNetwork,omitempty"`
+}
+
+// OpenIDConnect feature flag
+type OpenIDConnect struct {
+	// Enable
+	Enable bool `json:"enable,omitempty"`
+
+	// Config
+	Config OpenIDConnectConfig `json:"config"`
+}
+
+// OpenIDConnectConfig config
+type OpenIDConnectConfig struct {
+	// IssuerURL
+	IssuerURL string `json:"issuerUrl"`
+
+	// ClientID
+	ClientID string `json:"clientId,omitempty"`
+
+	// UsernameClaim
+	UsernameClaim string `json:"usernameClaim,omitempty"`
+
+	// UsernamePrefix. The value `-` can be used to disable all prefixing.
+	UsernamePrefix string `json:"usernamePrefix,omitempty"`
+
+	// GroupsClaim
+	GroupsClaim string `json:"groupsClaim,omitempty"`
+
+	// GroupsPrefix. The value `-` can be used to disable all prefixing.
+	GroupsPrefix string `json:"groupsPrefix,omitempty"`
+
+	// RequiredClaim
+	RequiredClaim string `json:"requiredClaim"`
+
+	// SigningAlgs
+	SigningAlgs string `json:"signingAlgs,omitempty"`
+
+	// CAFile
+	CAFile string `json:"caFile"`
+}
+
+// Addon config
+type Addon struct {
+	// Name of the addon to configure
+	Name string `json:"name"`
+
+	// Params to the addon, to render the addon using text/template, this will override globalParams
+	Params map[string]string `json:"params,omitempty"`
+
+	// DisableTemplating is used to disable templatization for the addon.
+	DisableTemplating bool `json:"disableTemplating,omitempty"`
+
+	// Delete flag to ensure the named addon with all its contents to be deleted
+	Delete bool `json:"delete,omitempty"`
+
+	// DependsOn is a list of names of the addons that must be applied, and
+	// pass their readiness checks, before this addon is applied.
+	DependsOn []string `json:"dependsOn,omitempty"`
+
+	// ReadinessChecks is a list of objects that must become ready after the
+	// addon is applied. Applying addons fails if the objects are not ready
+	// within 5 minutes.
+	ReadinessChecks []AddonReadinessCheck `json:"readinessChecks,omitempty"`
+
+	// Phase of the cluster lifecycle in which the addon is applied. The addons
+	// without the phase are applied along with the other addons, after the
+	// cluster is provisioned or reconciled.
+	// Possible values: PreKubeadm, PostJoin, PostUpgrade, PreReset
+	Phase AddonPhase `json:"phase,omitempty"`
+}
+
+// AddonReadinessCheck is an object deployed by the addon that must become
+// ready after the addon is applied
+type AddonReadinessCheck struct {
+	// Kind of the object. Deployment, StatefulSet and DaemonSet must be
+	// available, Job must complete, CustomResourceDefinition must be
+	// established.
+	Kind AddonReadinessCheckKind `json:"kind"`
+
+	// Name of the object
+	Name string `json:"name"`
+
+	// Namespace of the object, not set for CustomResourceDefinition
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// AddonReadinessCheckKind is a kind of object checked by AddonReadinessCheck
+type AddonReadinessCheckKind string
+
+const (
+	AddonReadinessCheckKindDeployment               AddonReadinessCheckKind = "Deployment"
+	AddonReadinessCheckKindStatefulSet              AddonReadinessCheckKind = "StatefulSet"
+	AddonReadinessCheckKindDaemonSet                AddonReadinessCheckKind = "DaemonSet"
+	AddonReadinessCheckKindJob                      AddonReadinessCheckKind = "Job"
+	AddonReadinessCheckKindCustomResourceDefinition AddonReadinessCheckKind = "CustomResourceDefinition"
+)
+
+// AddonPhase is a phase of the cluster lifecycle in which the addon is applied
+type AddonPhase string
+
+const (
+	// AddonPhasePreKubeadm addons are applied before kubeadm upgrades the
+	// control plane, and before the new control plane and static worker nodes
+	// join the cluster. On the new cluster, they're applied right after the
+	// leader is initialized, when the pod network is not deployed yet.
+	AddonPhasePreKubeadm AddonPhase = "PreKubeadm"
+
+	// AddonPhasePostJoin addons are applied after all control plane and static
+	// worker nodes have joined the cluster.
+	AddonPhasePostJoin AddonPhase = "PostJoin"
+
+	// AddonPhasePostUpgrade addons are applied after all nodes are upgraded,
+	// including the MachineDeployments if they're upgraded.
+	AddonPhasePostUpgrade AddonPhase = "PostUpgrade"
+
+	// AddonPhasePreReset addons are applied by kubeone reset, before the
+	// worker nodes are destroyed and the cluster is reset.
+	AddonPhasePreReset AddonPhase = "PreReset"
+)
+
+// Addons config
+type Addons struct {
+	// Enable
+	Enable bool `json:"enable,omitempty"`
+
+	// Path on the local file system to the directory with addons manifests,
+	// or a reference to an OCI artifact with addons manifests, e.g.
+	// oci://registry.example.com/addons/monitoring:1.2.3. The OCI artifact is
+	// pulled using the credentials configured for the registry in
+	// containerRuntime.containerd.registries, or the Docker credentials file
+	// otherwise. If the reference includes a digest (tag@sha256:...), the
+	// pulled artifact must match it.
+	// Path can't be set along with Source.
+	Path string `json:"path,omitempty"`
+
+	// Source is a remote source of the addons directory, fetched and cached
+	// by KubeOne when it runs. Source can't be set along with Path.
+	Source *AddonsSource `json:"source,omitempty"`
+
+	// Verification configures the cosign verification of the addons. If set,
+	// the addons must be pulled from an OCI artifact or downloaded from a
+	// tarball, and KubeOne refuses to apply them if their signature can't be
+	// verified.
+	Verification *AddonsVerification `json:"verification,omitempty"`
+
+	// GlobalParams to the addon, to render all addons using text/template
+	GlobalParams map[string]string `json:"globalParams,omitempty"`
+
+	// Addons is a list of config options for named addon
+	Addons []Addon `json:"addons,omitempty"`
+}
+
+// AddonsSource is a remote source of the addons directory. Exactly one source
+// must be set.
+type AddonsSource struct {
+	// Git repository with the addons directory
+	Git *AddonsGitSource `json:"git,omitempty"`
+
+	// HTTP is a gzipped tarball with the addons directory, downloaded over
+	// HTTPS
+	HTTP *AddonsHTTPSource `json:"http,omitempty"`
+}
+
+// AddonsGitSource is a git repository with the addons directory
+type AddonsGitSource struct {
+	// URL of the git repository, e.g. https://github.com/example/addons.git.
+	// The repository is cloned using the git binary, so its credentials
+	// helpers and SSH configuration are used.
+	URL string `json:"url"`
+
+	// Ref is the branch, tag or commit checked out. The default branch of the
+	// repository is checked out if not set.
+	Ref string `json:"ref,omitempty"`
+
+	// Path to the addons directory in the repository. The root of the
+	// repository is used if not set.
+	Path string `json:"path,omitempty"`
+}
+
+// AddonsHTTPSource is a gzipped tarball with the addons directory
+type AddonsHTTPSource struct {
+	// URL of the tarball, must use HTTPS
+	URL string `json:"url"`
+
+	// SHA256 checksum of the tarball, in hex. The tarball is cached by its
+	// checksum, so it's downloaded only once.
+	SHA256 string `json:"sha256"`
+
+	// Path to the addons directory in the tarball. The root of the tarball is
+	// used if not set.
+	Path string `json:"path,omitempty"`
+
+	// BundleURL is the URL of the cosign bundle with the signature of the
+	// tarball, used if the addons verification is configured. Defaults to the
+	// tarball URL with the .bundle suffix.
+	BundleURL string `json:"bundleURL,omitempty"`
+}
+
+// AddonsVerification configures the cosign verification of the addons. The
+// signatures are verified using the cosign binary, which must be installed
+// on the machine running KubeOne. Either the public key, or the certificate
+// identity and OIDC issuer for the keyless verification, must be set.
+type AddonsVerification struct {
+	// PublicKey is the PEM encoded cosign public key
+	PublicKey string `json:"publicKey,omitempty"`
+
+	// CertificateIdentity is the identity expected in the signing certificate
+	// for the keyless verification, e.g. an email address or the URL of the
+	// workflow signing the addons
+	CertificateIdentity string `json:"certificateIdentity,omitempty"`
+
+	// CertificateOIDCIssuer is the OIDC issuer expected in the signing
+	// certificate for the keyless verification, e.g.
+	// https://token.actions.githubusercontent.com
+	CertificateOIDCIssuer string `json:"certificateOIDCIssuer,omitempty"`
+
+	// Images enables verifying the signatures of the addon images overridden
+	// using registryConfiguration.overwriteRegistry or the CCM image
+	// repository and tag. The images must be signed with the same key or
+	// identity as the addons.
+	Images bool `json:"images,omitempty"`
+}
+
+// Encryption Providers feature flag
+type EncryptionProviders struct {
+	// Enable
+	Enable bool `json:"enable"`
+
+	// CustomEncryptionConfiguration
+	CustomEncryptionConfiguration string `json:"customEncryptionConfiguration"`
+}
@@ -19,18 +19,34 @@ package validation
 import (
 	"bytes"
 	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
 	"fmt"
+	"math"
 	"net"
+	"net/url"
 	"os"
+	"path/filepath"
 	"reflect"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
+	"github.com/distribution/reference"
 
 	"k8c.io/kubeone/pkg/addons"
 	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
 	"k8c.io/kubeone/pkg/semverutil"
+	"k8c.io/kubeone/pkg/templates/resources"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	netutils "k8s.io/utils/net"
@@ -44,12 +60,38 @@ const (
 	upperVersionConstraint = "<= 1.28"
 	// gte125VersionConstraint defines a semver constraint that validates Kubernetes versions >= 1.25
 	gte125VersionConstraint = ">= 1.25"
+	// gte128VersionConstraint defines a semver constraint that validates Kubernetes versions >= 1.28
+	gte128VersionConstraint = ">= 1.28"
+	// gte129VersionConstraint defines a semver constraint that validates Kubernetes versions >= 1.29
+	gte129VersionConstraint = ">= 1.29"
+	// defaultKubeletMaxPods is the maximum number of pods per node used by the kubelet if maxPods is not set
+	defaultKubeletMaxPods = 110
 )
 
 var (
 	lowerConstraint  = semverutil.MustParseConstraint(lowerVersionConstraint)
 	upperConstraint  = semverutil.MustParseConstraint(upperVersionConstraint)
 	gte125Constraint = semverutil.MustParseConstraint(gte125VersionConstraint)
+	gte128Constraint = semverutil.MustParseConstraint(gte128VersionConstraint)
+	gte129Constraint = semverutil.MustParseConstraint(gte129VersionConstraint)
+)
+
+var (
+	// sysctlNameRegexp matches sysctl names, both dot and slash separated
+	sysctlNameRegexp = regexp.MustCompile(`^([a-z0-9]([-_a-z0-9]*[a-z0-9])?[\./])*[a-z0-9]([-_a-z0-9]*[a-z0-9])?$`)
+
+	// sysctlValueRegexp matches sysctl values that are safe to be used in scripts
+	sysctlValueRegexp = regexp.MustCompile(`^[a-zA-Z0-9 \t._:/,+=-]+$`)
+
+	// kernelModuleRegexp matches kernel module names
+	kernelModuleRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+	// ubuntuReleaseRegexp matches Ubuntu release versions, e.g. 24.04
+	ubuntuReleaseRegexp = regexp.MustCompile(`^[0-9]{2}\.(04|10)$`)
+
+	// packageNameRegexp matches the names of the Debian and RPM packages,
+	// allowing the wildcards supported by yum and dnf
+	packageNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9*][a-zA-Z0-9.+_*-]*$`)
 )
 
 // ValidateKubeOneCluster validates the KubeOneCluster object
@@ -63,10 +105,22 @@ func ValidateKubeOneCluster(c kubeoneapi.KubeOneCluster) field.ErrorList {
 	allErrs = append(allErrs, ValidateVersionConfig(c.Versions, field.NewPath("versions"))...)
 	allErrs = append(allErrs, ValidateKubernetesSupport(c, field.NewPath(""))...)
 	allErrs = append(allErrs, ValidateContainerRuntimeConfig(c.ContainerRuntime, c.Versions, field.NewPath("containerRuntime"))...)
+	allErrs = append(allErrs, ValidateCgroupsConfig(c.Cgroups, c.Versions, field.NewPath("cgroups"))...)
 	allErrs = append(allErrs, ValidateClusterNetworkConfig(c.ClusterNetwork, c.CloudProvider, field.NewPath("clusterNetwork"))...)
+	allErrs = append(allErrs, ValidateKubeProxyVersion(c.ClusterNetwork.KubeProxy, c.Versions, field.NewPath("clusterNetwork", "kubeProxy"))...)
+	allErrs = append(allErrs, ValidateKubeProxyLoadBalancer(c.ClusterNetwork.KubeProxy, c.Addons, c.HelmReleases, c.Features.MetalLB, field.NewPath("clusterNetwork", "kubeProxy"))...)
 	allErrs = append(allErrs, ValidateStaticWorkersConfig(c.StaticWorkers, c.Versions, c.ClusterNetwork, field.NewPath("staticWorkers"))...)
+	allErrs = append(allErrs, ValidateNodeCIDRAllocation(c, field.NewPath("clusterNetwork"))...)
+	allErrs = append(allErrs, ValidateEgressGateways(c, field.NewPath("clusterNetwork", "egressGateways"))...)
+	if c.ClusterNetwork.DNS != nil {
+		allErrs = append(allErrs, ValidateClusterDNS(c.ClusterNetwork.DNS, field.NewPath("clusterNetwork", "dns"))...)
+	}
+	allErrs = append(allErrs, ValidateBGP(c, field.NewPath("clusterNetwork", "bgp"))...)
 
-	if c.MachineController != nil && c.MachineController.Deploy {
+	if c.MachineController != nil && c.MachineController.Deploy && (c.CloudProvider.OCI != nil || c.CloudProvider.Proxmox != nil) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("machineController", "deploy"),
+			fmt.Sprintf("machine-controller doesn't support the %s provider, use static workers instead", c.CloudProvider.CloudProviderName())))
+	} else if c.MachineController != nil && c.MachineController.Deploy {
 		allErrs = append(allErrs, ValidateDynamicWorkerConfig(c.DynamicWorkers, c.CloudProvider, field.NewPath("dynamicWorkers"))...)
 	} else if len(c.DynamicWorkers) > 0 {
 		allErrs = append(allErrs, field.Forbidden(field.NewPath("dynamicWorkers"),
@@ -78,10 +132,32 @@ func ValidateKubeOneCluster(c kubeoneapi.KubeOneCluster) field.ErrorList {
 	}
 
 	allErrs = append(allErrs, ValidateCABundle(c.CABundle, field.NewPath("caBundle"))...)
+	allErrs = append(allErrs, ValidateAdditionalTrustedCAs(c.AdditionalTrustedCAs, c.DynamicWorkers, field.NewPath("additionalTrustedCAs"))...)
 	allErrs = append(allErrs, ValidateFeatures(c.Features, c.Versions, field.NewPath("features"))...)
+	if c.ClusterNetwork.IPFamily == kubeoneapi.IPFamilyIPv6 && c.Features.NodeLocalDNS != nil && c.Features.NodeLocalDNS.Deploy {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("features", "nodeLocalDNS", "deploy"), "nodeLocalDNS is not supported in IPv6-only clusters"))
+	}
+	allErrs = append(allErrs, ValidateNvidiaGPU(c, field.NewPath("features", "nvidiaGPU"))...)
+	allErrs = append(allErrs, ValidateMetalLB(c, field.NewPath("features", "metalLB"))...)
+	allErrs = append(allErrs, ValidateGatewayAPI(c, field.NewPath("features", "gatewayAPI"))...)
+	allErrs = append(allErrs, ValidateIstioAmbient(c, field.NewPath("features", "istioAmbient"))...)
+	allErrs = append(allErrs, ValidateKubeVIP(c, field.NewPath("features", "kubeVIP"))...)
+	allErrs = append(allErrs, ValidateDefaultDenyNetworkPolicy(c.Features.DefaultDenyNetworkPolicy, field.NewPath("features", "defaultDenyNetworkPolicy"))...)
+	allErrs = append(allErrs, ValidateClusterAutoscaler(c, field.NewPath("features", "clusterAutoscaler"))...)
+	allErrs = append(allErrs, ValidateLocalStorage(c, field.NewPath("features", "localStorage"))...)
+	allErrs = append(allErrs, ValidateIngressNginx(c, field.NewPath("features", "ingressNginx"))...)
+	allErrs = append(allErrs, ValidateHetznerPrivateNetwork(c, field.NewPath("cloudProvider", "hetzner", "networkID"))...)
+	allErrs = append(allErrs, ValidateDigitalOceanVPC(c)...)
+	allErrs = append(allErrs, ValidateNodeSwap(c.Features.NodeSwap, c.ContainerRuntime, c.Cgroups, c.Versions, field.NewPath("features", "nodeSwap"))...)
 	allErrs = append(allErrs, ValidateAddons(c.Addons, field.NewPath("addons"))...)
+	allErrs = append(allErrs, validateCNIAddons(c.ClusterNetwork.CNI, c.Addons, field.NewPath("addons", "addons"))...)
 	allErrs = append(allErrs, ValidateHelmReleases(c.HelmReleases, field.NewPath("helmReleases"))...)
+	allErrs = append(allErrs, ValidateSystemPackages(c.SystemPackages, field.NewPath("systemPackages"))...)
 	allErrs = append(allErrs, ValidateRegistryConfiguration(c.RegistryConfiguration, field.NewPath("registryConfiguration"))...)
+	allErrs = append(allErrs, ValidateControlPlaneComponents(c.ControlPlaneComponents, field.NewPath("controlPlaneComponents"))...)
+	allErrs = append(allErrs, ValidateBackupsConfig(c.Backups, field.NewPath("backups"))...)
+	allErrs = append(allErrs, ValidateUpgradesConfig(c.Upgrades, c.Versions, field.NewPath("upgrades"))...)
+	allErrs = append(allErrs, ValidateCertificateAuthorityConfig(c.CertificateAuthority, field.NewPath("certificateAuthority"))...)
 	allErrs = append(allErrs,
 		ValidateContainerRuntimeVSRegistryConfiguration(
 			c.ContainerRuntime,
@@ -145,6 +221,43 @@ func ValidateControlPlaneConfig(c kubeoneapi.ControlPlaneConfig, version kubeone
 			".controlPlane.Hosts is a required field. There must be at least one control plane instance in the cluster."))
 	}
 
+	if c.RequireZoneSpread {
+		allErrs = append(allErrs, validateControlPlaneZoneSpread(c.Hosts, fldPath)...)
+	}
+
+	allErrs = append(allErrs, ValidateKernelConfig(c.Kernel, fldPath.Child("kernel"))...)
+
+	return allErrs
+}
+
+// validateControlPlaneZoneSpread validates that a failure of any single zone
+// doesn't cause the loss of the etcd quorum
+func validateControlPlaneZoneSpread(hosts []kubeoneapi.HostConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(hosts) < 3 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("hosts"), len(hosts), "at least 3 control plane hosts are required to spread etcd members across zones"))
+
+		return allErrs
+	}
+
+	membersPerZone := map[string]int{}
+	for i, h := range hosts {
+		if h.Zone == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("hosts").Index(i).Child("zone"), "zone is required when requireZoneSpread is enabled"))
+
+			continue
+		}
+		membersPerZone[h.Zone]++
+	}
+
+	quorum := len(hosts)/2 + 1
+	for zone, members := range membersPerZone {
+		if len(hosts)-members < quorum {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("hosts"), zone, fmt.Sprintf("zone %q has %d out of %d etcd members, failure of this zone would cause the loss of etcd quorum", zone, members, len(hosts))))
+		}
+	}
+
 	return allErrs
 }
 
@@ -172,6 +285,72 @@ func ValidateAPIEndpoint(a kubeoneapi.APIEndpoint, fldPath *field.Path) field.Er
 		visited[altName] = true
 	}
 
+	addresses := map[string]bool{
+		net.JoinHostPort(a.Host, strconv.Itoa(a.Port)): true,
+	}
+	for i, endpoint := range a.AdditionalEndpoints {
+		endpointPath := fldPath.Child("additionalEndpoints").Index(i)
+		if len(endpoint.Host) == 0 {
+			allErrs = append(allErrs, field.Required(endpointPath.Child("host"), "host of the additional endpoint is a required field"))
+		}
+		if endpoint.Port <= 0 || endpoint.Port > 65535 {
+			allErrs = append(allErrs, field.Invalid(endpointPath.Child("port"), endpoint.Port, "port must be between 1 and 65535"))
+		}
+		if endpoint.Priority < 0 {
+			allErrs = append(allErrs, field.Invalid(endpointPath.Child("priority"), endpoint.Priority, "priority must not be negative"))
+		}
+
+		address := net.JoinHostPort(endpoint.Host, strconv.Itoa(endpoint.Port))
+		if addresses[address] {
+			allErrs = append(allErrs, field.Duplicate(endpointPath, address))
+		}
+		addresses[address] = true
+	}
+
+	return allErrs
+}
+
+func validateOCISpec(oci kubeoneapi.OCISpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if oci.CompartmentID == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("compartmentID"), ".cloudProvider.oci.compartmentID is a required field"))
+	}
+	if oci.VCNID == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("vcnID"), ".cloudProvider.oci.vcnID is a required field"))
+	}
+	if len(oci.LoadBalancerSubnetIDs) > 2 {
+		allErrs = append(allErrs, field.TooMany(fldPath.Child("loadBalancerSubnetIDs"), len(oci.LoadBalancerSubnetIDs), 2))
+	}
+
+	return allErrs
+}
+
+func validateVsphereFileVolumesSpec(fileVolumes kubeoneapi.VsphereFileVolumesSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	names := map[string]struct{}{}
+	for i, np := range fileVolumes.NetPermissions {
+		npPath := fldPath.Child("netPermissions").Index(i)
+		if np.Name == "" {
+			allErrs = append(allErrs, field.Required(npPath.Child("name"), "name of the net permission is required"))
+		} else if _, ok := names[np.Name]; ok {
+			allErrs = append(allErrs, field.Duplicate(npPath.Child("name"), np.Name))
+		}
+		names[np.Name] = struct{}{}
+
+		if np.IPs != "*" {
+			if _, _, err := net.ParseCIDR(np.IPs); err != nil {
+				allErrs = append(allErrs, field.Invalid(npPath.Child("ips"), np.IPs, "ips must be a valid CIDR or \"*\""))
+			}
+		}
+		switch np.Permissions {
+		case "", "READ_WRITE", "READ_ONLY", "NO_ACCESS":
+		default:
+			allErrs = append(allErrs, field.NotSupported(npPath.Child("permissions"), np.Permissions, []string{"READ_WRITE", "READ_ONLY", "NO_ACCESS"}))
+		}
+	}
+
 	return allErrs
 }
 
@@ -186,6 +365,11 @@ func ValidateCloudProviderSpec(providerSpec kubeoneapi.CloudProviderSpec, networ
 		if networkConfig.IPFamily.IsDualstack() && providerSpec.External && len(providerSpec.CloudConfig) == 0 {
 			allErrs = append(allErrs, field.Required(fldPath.Child("cloudConfig"), "cloudConfig is required for dualstack clusters for aws provider"))
 		}
+		switch providerSpec.AWS.CredentialsMode {
+		case "", kubeoneapi.AWSCredentialsModeStatic, kubeoneapi.AWSCredentialsModeInstanceProfile:
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("aws", "credentialsMode"), providerSpec.AWS.CredentialsMode, []string{string(kubeoneapi.AWSCredentialsModeStatic), string(kubeoneapi.AWSCredentialsModeInstanceProfile)}))
+		}
 		providerFound = true
 	}
 	if providerSpec.Azure != nil {
@@ -195,30 +379,94 @@ func ValidateCloudProviderSpec(providerSpec kubeoneapi.CloudProviderSpec, networ
 		if len(providerSpec.CloudConfig) == 0 {
 			allErrs = append(allErrs, field.Required(fldPath.Child("cloudConfig"), ".cloudProvider.cloudConfig is required for azure provider"))
 		}
+		switch providerSpec.Azure.CredentialsMode {
+		case "", kubeoneapi.AzureCredentialsModeClientSecret:
+			if providerSpec.Azure.UserAssignedIdentityID != "" {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("azure", "userAssignedIdentityID"), "userAssignedIdentityID can be used only with the ManagedIdentity credentials mode"))
+			}
+		case kubeoneapi.AzureCredentialsModeManagedIdentity:
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("azure", "credentialsMode"), providerSpec.Azure.CredentialsMode, []string{string(kubeoneapi.AzureCredentialsModeClientSecret), string(kubeoneapi.AzureCredentialsModeManagedIdentity)}))
+		}
+		switch providerSpec.Azure.VMType {
+		case "", kubeoneapi.AzureVMTypeStandard, kubeoneapi.AzureVMTypeVMSS:
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("azure", "vmType"), providerSpec.Azure.VMType, []string{string(kubeoneapi.AzureVMTypeStandard), string(kubeoneapi.AzureVMTypeVMSS)}))
+		}
 		providerFound = true
 	}
 	if providerSpec.DigitalOcean != nil {
 		if providerFound {
 			allErrs = append(allErrs, field.Forbidden(fldPath.Child("digitalocean"), "only one provider can be used at the same time"))
 		}
+		if r := providerSpec.DigitalOcean.VPCIPRange; r != "" {
+			if _, _, err := net.ParseCIDR(r); err != nil {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("digitalocean", "vpcIPRange"), r, "vpcIPRange must be a valid CIDR"))
+			}
+		}
 		providerFound = true
 	}
 	if providerSpec.GCE != nil {
 		if providerFound {
 			allErrs = append(allErrs, field.Forbidden(fldPath.Child("gce"), "only one provider can be used at the same time"))
 		}
+		switch providerSpec.GCE.CredentialsMode {
+		case "", kubeoneapi.GCECredentialsModeServiceAccountKey, kubeoneapi.GCECredentialsModeAttachedServiceAccount:
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("gce", "credentialsMode"), providerSpec.GCE.CredentialsMode, []string{string(kubeoneapi.GCECredentialsModeServiceAccountKey), string(kubeoneapi.GCECredentialsModeAttachedServiceAccount)}))
+		}
+		if sharedVPC := providerSpec.GCE.SharedVPC; sharedVPC != nil {
+			sharedVPCPath := fldPath.Child("gce", "sharedVPC")
+			if sharedVPC.HostProjectID == "" {
+				allErrs = append(allErrs, field.Required(sharedVPCPath.Child("hostProjectID"), "hostProjectID is required for the Shared VPC"))
+			}
+			if sharedVPC.NetworkName == "" {
+				allErrs = append(allErrs, field.Required(sharedVPCPath.Child("networkName"), "networkName is required for the Shared VPC"))
+			}
+			if sharedVPC.SubnetworkName == "" {
+				allErrs = append(allErrs, field.Required(sharedVPCPath.Child("subnetworkName"), "subnetworkName is required for the Shared VPC"))
+			}
+		}
 		providerFound = true
 	}
 	if providerSpec.Hetzner != nil {
 		if providerFound {
 			allErrs = append(allErrs, field.Forbidden(fldPath.Child("hetzner"), "only one provider can be used at the same time"))
 		}
+		if providerSpec.Hetzner.Robot && !providerSpec.External {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("hetzner", "robot"), "robot requires the external cloud provider"))
+		}
+		providerFound = true
+	}
+	if providerSpec.Kubevirt != nil {
+		if providerFound {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("kubevirt"), "only one provider can be used at the same time"))
+		}
+		if !providerSpec.External {
+			allErrs = append(allErrs, field.Required(fldPath.Child("external"), ".cloudProvider.external is required for kubevirt provider"))
+		}
+		if providerSpec.Kubevirt.InfraNamespace == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("kubevirt", "infraNamespace"), ".cloudProvider.kubevirt.infraNamespace is a required field"))
+		}
 		providerFound = true
 	}
 	if providerSpec.Nutanix != nil {
 		if providerFound {
 			allErrs = append(allErrs, field.Forbidden(fldPath.Child("nutanix"), "only one provider can be used at the same time"))
 		}
+		if !providerSpec.External {
+			allErrs = append(allErrs, field.Required(fldPath.Child("external"), ".cloudProvider.external is required for nutanix provider, existing clusters can be migrated using 'kubeone migrate to-ccm-csi'"))
+		}
+		providerFound = true
+	}
+	if providerSpec.OCI != nil {
+		if providerFound {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("oci"), "only one provider can be used at the same time"))
+		}
+		if !providerSpec.External {
+			allErrs = append(allErrs, field.Required(fldPath.Child("external"), ".cloudProvider.external is required for oci provider"))
+		}
+		allErrs = append(allErrs, validateOCISpec(*providerSpec.OCI, fldPath.Child("oci"))...)
 		providerFound = true
 	}
 	if providerSpec.Openstack != nil {
@@ -230,10 +478,27 @@ func ValidateCloudProviderSpec(providerSpec kubeoneapi.CloudProviderSpec, networ
 		}
 		providerFound = true
 	}
+	if providerSpec.Proxmox != nil {
+		if providerFound {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("proxmox"), "only one provider can be used at the same time"))
+		}
+		if providerSpec.External {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("external"), "external cloud provider is not supported for proxmox clusters"))
+		}
+		providerFound = true
+	}
 	if providerSpec.EquinixMetal != nil {
 		if providerFound {
 			allErrs = append(allErrs, field.Forbidden(fldPath.Child("equinixmetal"), "only one provider can be used at the same time"))
 		}
+		if lb := providerSpec.EquinixMetal.LoadBalancer; lb != nil {
+			if !providerSpec.External {
+				allErrs = append(allErrs, field.Required(fldPath.Child("external"), ".cloudProvider.external is required for the equinixmetal loadBalancer integration"))
+			}
+			if lb.LocalASN < 0 || int64(lb.LocalASN) > math.MaxUint32 {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("equinixmetal", "loadBalancer", "localASN"), lb.LocalASN, "localASN must be a valid 32-bit ASN"))
+			}
+		}
 		providerFound = true
 	}
 	if providerSpec.VMwareCloudDirector != nil {
@@ -255,6 +520,15 @@ func ValidateCloudProviderSpec(providerSpec kubeoneapi.CloudProviderSpec, networ
 		if providerSpec.External && !providerSpec.DisableBundledCSIDrivers && len(providerSpec.CSIConfig) == 0 {
 			allErrs = append(allErrs, field.Required(fldPath.Child("csiConfig"), ".cloudProvider.csiConfig is required for vSphere provider"))
 		}
+		if providerSpec.Vsphere.StoragePolicyName != "" && providerSpec.Vsphere.DatastoreURL != "" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("vsphere", "datastoreURL"), providerSpec.Vsphere.DatastoreURL, "only one of storagePolicyName and datastoreURL can be set"))
+		}
+		if providerSpec.Vsphere.FileVolumes != nil {
+			if !providerSpec.External || providerSpec.DisableBundledCSIDrivers {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("vsphere", "fileVolumes"), "file volumes require the external cloud provider and the bundled vSphere CSI driver"))
+			}
+			allErrs = append(allErrs, validateVsphereFileVolumesSpec(*providerSpec.Vsphere.FileVolumes, fldPath.Child("vsphere", "fileVolumes"))...)
+		}
 		providerFound = true
 	}
 	if providerSpec.None != nil {
@@ -276,6 +550,26 @@ func ValidateCloudProviderSpec(providerSpec kubeoneapi.CloudProviderSpec, networ
 		allErrs = append(allErrs, field.Forbidden(fldPath.Child("csiConfig"), ".cloudProvider.csiConfig is currently supported only for vsphere clusters"))
 	}
 
+	if providerSpec.CloudControllerManager != nil {
+		allErrs = append(allErrs, validateCloudControllerManagerConfig(providerSpec.CloudControllerManager, providerSpec.External, fldPath.Child("cloudControllerManager"))...)
+	}
+
+	return allErrs
+}
+
+func validateCloudControllerManagerConfig(c *kubeoneapi.CloudControllerManagerConfig, external bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !external {
+		allErrs = append(allErrs, field.Forbidden(fldPath, ".cloudProvider.cloudControllerManager can be used only with the external cloud provider"))
+	}
+
+	for name := range c.Flags {
+		if name == "" || strings.HasPrefix(name, "-") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("flags").Key(name), name, "flag name must not be empty or start with a dash"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -371,6 +665,56 @@ func ValidateContainerRuntimeConfig(cr kubeoneapi.ContainerRuntimeConfig, versio
 		}
 	}
 
+	if cr.Containerd != nil && cr.Containerd.Version != "" {
+		containerdVer, err := semver.StrictNewVersion(cr.Containerd.Version)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("containerd", "version"), cr.Containerd.Version, "version must be a valid version without the leading v, e.g. 1.6.24"))
+		} else if containerdVer.Major() != 1 || containerdVer.Minor() < 6 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("containerd", "version"), cr.Containerd.Version, "only containerd 1.6 and newer 1.x versions are supported"))
+		}
+	}
+
+	if cr.SandboxImage != "" {
+		if _, err := reference.ParseNormalizedNamed(cr.SandboxImage); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("sandboxImage"), cr.SandboxImage, fmt.Sprintf("invalid sandbox image reference: %v", err)))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateCgroupsConfig validates the CgroupsConfig structure
+func ValidateCgroupsConfig(c kubeoneapi.CgroupsConfig, versions kubeoneapi.VersionConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch c.Driver {
+	case "", kubeoneapi.CgroupDriverSystemd, kubeoneapi.CgroupDriverCgroupfs:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("driver"), c.Driver, []string{
+			string(kubeoneapi.CgroupDriverSystemd),
+			string(kubeoneapi.CgroupDriverCgroupfs),
+		}))
+	}
+
+	switch c.Version {
+	case "", kubeoneapi.CgroupVersionV1:
+	case kubeoneapi.CgroupVersionV2:
+		// cgroup v2 support has graduated to GA in Kubernetes 1.25
+		kubeVer, err := semver.NewVersion(versions.Kubernetes)
+		if err == nil && !gte125Constraint.Check(kubeVer) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("version"), c.Version, "cgroup v2 requires kubernetes v1.25 or newer"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("version"), c.Version, []string{
+			string(kubeoneapi.CgroupVersionV1),
+			string(kubeoneapi.CgroupVersionV2),
+		}))
+	}
+
+	if c.Driver == kubeoneapi.CgroupDriverCgroupfs && c.Version == kubeoneapi.CgroupVersionV2 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("driver"), c.Driver, "cgroupfs driver is not supported with cgroup v2, use the systemd driver instead"))
+	}
+
 	return allErrs
 }
 
@@ -385,6 +729,14 @@ func ValidateClusterNetworkConfig(c kubeoneapi.ClusterNetworkConfig, prov kubeon
 	if c.CNI != nil {
 		allErrs = append(allErrs, ValidateCNI(c.CNI, fldPath.Child("cni"))...)
 
+		if c.CNI.WeaveNet != nil && c.HasIPv6() {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("cni", "weaveNet"), "weave-net doesn't support IPv6 and dualstack clusters"))
+		}
+
+		if c.CNI.Calico != nil && c.CNI.Calico.EncapsulationMode == kubeoneapi.CalicoEncapsulationModeIPIP && c.HasIPv6() {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("cni", "calico", "encapsulationMode"), "IPIP encapsulation doesn't support IPv6 and dualstack clusters"))
+		}
+
 		// validated cilium kube-proxy replacement
 		if c.CNI.Cilium != nil && c.CNI.Cilium.KubeProxyReplacement != kubeoneapi.KubeProxyReplacementDisabled && (c.KubeProxy == nil || !c.KubeProxy.SkipInstallation) {
 			allErrs = append(allErrs, field.Invalid(fldPath.Child("cni"), c.CNI.Cilium.KubeProxyReplacement, ".cilium.kubeProxyReplacement cannot be set with kube-proxy enabled"))
@@ -392,6 +744,11 @@ func ValidateClusterNetworkConfig(c kubeoneapi.ClusterNetworkConfig, prov kubeon
 	}
 	if c.KubeProxy != nil {
 		allErrs = append(allErrs, ValidateKubeProxy(c.KubeProxy, fldPath.Child("kubeProxy"))...)
+
+		// canal, calico and weave-net configure their data plane using iptables
+		if c.KubeProxy.NFTables != nil && c.CNI != nil && (c.CNI.Canal != nil || c.CNI.Calico != nil || c.CNI.WeaveNet != nil) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("kubeProxy", "nftables"), "nftables kube-proxy mode is supported only with cilium or external CNI"))
+		}
 	}
 
 	return allErrs
@@ -400,8 +757,12 @@ func ValidateClusterNetworkConfig(c kubeoneapi.ClusterNetworkConfig, prov kubeon
 func validateIPFamily(ipFamily kubeoneapi.IPFamily, prov kubeoneapi.CloudProviderSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	if ipFamily == kubeoneapi.IPFamilyIPv6 || ipFamily == kubeoneapi.IPFamilyIPv6IPv4 {
-		allErrs = append(allErrs, field.Forbidden(fldPath, "ipv6 and ipv6+ipv4 ip families are currently not supported"))
+	if ipFamily == kubeoneapi.IPFamilyIPv6IPv4 {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "ipv6+ipv4 ip family is currently not supported"))
+	}
+
+	if ipFamily == kubeoneapi.IPFamilyIPv6 && !(prov.AWS != nil || prov.None != nil || prov.Vsphere != nil) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "ipv6 is currently supported only on AWS, vSphere and baremetal (none)"))
 	}
 
 	if ipFamily == kubeoneapi.IPFamilyIPv4IPv6 && !(prov.AWS != nil || prov.None != nil || prov.Vsphere != nil) {
@@ -450,6 +811,95 @@ func validateNodeCIDRMaskSize(c kubeoneapi.ClusterNetworkConfig, fldPath *field.
 	return allErrs
 }
 
+// ValidateNodeCIDRAllocation validates that the pod CIDRs allocated to the nodes
+// provide enough pod IP addresses for the maxPods setting of every static node,
+// and that the pod subnet can be split among all static nodes
+func ValidateNodeCIDRAllocation(c kubeoneapi.KubeOneCluster, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	cn := c.ClusterNetwork
+	cni := cn.CNI
+	if cni == nil {
+		return allErrs
+	}
+
+	if cn.AllocateNodeCIDRs != nil && !*cn.AllocateNodeCIDRs {
+		// canal and cilium with the kubernetes IPAM use the pod CIDRs allocated by kube-controller-manager
+		if cni.Canal != nil || (cni.Cilium != nil && cni.Cilium.IPAM == kubeoneapi.CiliumIPAMModeKubernetes) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("allocateNodeCIDRs"), "the CNI plugin requires kube-controller-manager to allocate the pod CIDRs of the nodes"))
+		}
+
+		return allErrs
+	}
+
+	// calico allocates the pod IP addresses in blocks which are borrowed between
+	// the nodes, and external CNI plugins are not known to use the node pod CIDRs
+	if cni.Calico != nil || cni.External != nil {
+		return allErrs
+	}
+
+	// cilium with the cluster-pool IPAM allocates the pod CIDRs on its own, so
+	// the kube-controller-manager limits don't apply
+	kcmAllocation := cni.Cilium == nil || cni.Cilium.IPAM == kubeoneapi.CiliumIPAMModeKubernetes
+
+	hostGroups := []struct {
+		hosts   []kubeoneapi.HostConfig
+		fldPath *field.Path
+	}{
+		{hosts: c.ControlPlane.Hosts, fldPath: field.NewPath("controlPlane", "hosts")},
+		{hosts: c.StaticWorkers.Hosts, fldPath: field.NewPath("staticWorkers", "hosts")},
+	}
+	hostsCount := len(c.ControlPlane.Hosts) + len(c.StaticWorkers.Hosts)
+
+	validateFamily := func(nodeCIDRMaskSize *int, podCIDR string, fldPath *field.Path) {
+		// invalid values are reported by validateNodeCIDRMaskSize
+		_, podCIDRNet, err := net.ParseCIDR(podCIDR)
+		if nodeCIDRMaskSize == nil || err != nil {
+			return
+		}
+		podCIDRMaskSize, bits := podCIDRNet.Mask.Size()
+		if *nodeCIDRMaskSize <= podCIDRMaskSize || *nodeCIDRMaskSize > bits {
+			return
+		}
+
+		if nodeBits := *nodeCIDRMaskSize - podCIDRMaskSize; kcmAllocation && nodeBits > 16 {
+			allErrs = append(allErrs, field.Invalid(fldPath, *nodeCIDRMaskSize,
+				fmt.Sprintf("node CIDR mask size can be at most 16 bits longer than the mask size of the pod CIDR (%q)", podCIDR)))
+		} else if nodeBits < 31 && hostsCount > 1<<nodeBits {
+			allErrs = append(allErrs, field.Invalid(fldPath, *nodeCIDRMaskSize,
+				fmt.Sprintf("pod CIDR (%q) can be split to %d node CIDRs, but the cluster has %d static nodes", podCIDR, 1<<nodeBits, hostsCount)))
+		}
+
+		hostBits := bits - *nodeCIDRMaskSize
+		if hostBits >= 31 {
+			return
+		}
+		// the network and the gateway addresses can't be assigned to the pods
+		podIPs := int64(1)<<hostBits - 2
+		for _, group := range hostGroups {
+			for i, host := range group.hosts {
+				maxPods := int64(defaultKubeletMaxPods)
+				if host.Kubelet.MaxPods != nil {
+					maxPods = int64(*host.Kubelet.MaxPods)
+				}
+				if maxPods > podIPs {
+					allErrs = append(allErrs, field.Invalid(group.fldPath.Index(i).Child("kubelet", "maxPods"), maxPods,
+						fmt.Sprintf("%s (%d) provides only %d pod IP addresses per node", fldPath.String(), *nodeCIDRMaskSize, podIPs)))
+				}
+			}
+		}
+	}
+
+	if cn.HasIPv4() {
+		validateFamily(cn.NodeCIDRMaskSizeIPv4, cn.PodSubnet, fldPath.Child("nodeCIDRMaskSizeIPv4"))
+	}
+	if cn.HasIPv6() {
+		validateFamily(cn.NodeCIDRMaskSizeIPv6, cn.PodSubnetIPv6, fldPath.Child("nodeCIDRMaskSizeIPv6"))
+	}
+
+	return allErrs
+}
+
 func validateCIDRs(c kubeoneapi.ClusterNetworkConfig, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -472,6 +922,20 @@ func validateCIDRs(c kubeoneapi.ClusterNetworkConfig, fldPath *field.Path) field
 		}
 	}
 
+	// dual-stack lists are split by defaulting only if they match the IP family, so a remaining list means that
+	// pod and service CIDRs are of different IP families or ordered differently
+	validateNoCIDRList := func(node, subnet string) {
+		if strings.Contains(subnet, ",") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(node), subnet,
+				fmt.Sprintf(".clusterNetwork.%s must be a single CIDR or one IPv4 and one IPv6 CIDR ordered according to the %q ipFamily", node, c.IPFamily)))
+		}
+	}
+	validateNoCIDRList("podSubnet", c.PodSubnet)
+	validateNoCIDRList("serviceSubnet", c.ServiceSubnet)
+	if len(allErrs) > 0 {
+		return allErrs
+	}
+
 	switch c.IPFamily {
 	case kubeoneapi.IPFamilyIPv4:
 		validateCIDR("podSubnet", c.PodSubnet, kubeoneapi.IPFamilyIPv4)
@@ -491,22 +955,139 @@ func validateCIDRs(c kubeoneapi.ClusterNetworkConfig, fldPath *field.Path) field
 	return allErrs
 }
 
+// ValidateKubeProxy validates the KubeProxyConfig structure
 func ValidateKubeProxy(kbPrxConf *kubeoneapi.KubeProxyConfig, fldPath *field.Path) field.ErrorList {
 	var (
-		allErrs     field.ErrorList
-		configFound bool
+		allErrs    field.ErrorList
+		modesFound int
 	)
 
 	if kbPrxConf.IPTables != nil {
-		configFound = true
+		modesFound++
+		allErrs = append(allErrs, validateKubeProxySyncPeriods(kbPrxConf.IPTables.SyncPeriod, kbPrxConf.IPTables.MinSyncPeriod, fldPath.Child("iptables"))...)
 	}
 
 	if kbPrxConf.IPVS != nil {
-		if configFound {
-			allErrs = append(allErrs, field.Invalid(fldPath, "", "should have only 1, ether iptables or ipvs or none"))
+		modesFound++
+		allErrs = append(allErrs, validateIPVSConfig(kbPrxConf.IPVS, fldPath.Child("ipvs"))...)
+	}
+
+	if kbPrxConf.NFTables != nil {
+		modesFound++
+		allErrs = append(allErrs, validateKubeProxySyncPeriods(kbPrxConf.NFTables.SyncPeriod, kbPrxConf.NFTables.MinSyncPeriod, fldPath.Child("nftables"))...)
+	}
+
+	if modesFound > 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath, "", "should have only 1, either iptables, ipvs, nftables or none"))
+	}
+
+	return allErrs
+}
+
+// ValidateKubeProxyVersion validates that the chosen kube-proxy mode is supported by the Kubernetes version
+func ValidateKubeProxyVersion(kbPrxConf *kubeoneapi.KubeProxyConfig, versions kubeoneapi.VersionConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if kbPrxConf == nil || kbPrxConf.NFTables == nil {
+		return allErrs
+	}
+
+	// nftables kube-proxy mode has been introduced as alpha in Kubernetes 1.29
+	kubeVer, err := semver.NewVersion(versions.Kubernetes)
+	if err == nil && !gte129Constraint.Check(kubeVer) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("nftables"), "nftables kube-proxy mode requires kubernetes v1.29 or newer"))
+	}
+
+	return allErrs
+}
+
+// ValidateKubeProxyLoadBalancer validates the kube-proxy configuration against
+// the load balancer deployed in the cluster. MetalLB in the L2 mode requires
+// strictARP to be enabled when kube-proxy runs in the IPVS mode.
+func ValidateKubeProxyLoadBalancer(kbPrxConf *kubeoneapi.KubeProxyConfig, addons *kubeoneapi.Addons, helmReleases []kubeoneapi.HelmRelease, metalLBFeature *kubeoneapi.MetalLB, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if kbPrxConf == nil || kbPrxConf.IPVS == nil || kbPrxConf.IPVS.StrictARP {
+		return allErrs
+	}
+
+	metalLB := false
+	if metalLBFeature != nil && metalLBFeature.Enable {
+		for _, pool := range metalLBFeature.AddressPools {
+			if pool.Mode == kubeoneapi.MetalLBModeL2 {
+				metalLB = true
+			}
+		}
+	}
+	if addons.Enabled() {
+		for _, addon := range addons.Addons {
+			if addon.Name == "metallb" && !addon.Delete {
+				metalLB = true
+			}
+		}
+	}
+	for _, release := range helmReleases {
+		if strings.Contains(release.Chart, "metallb") {
+			metalLB = true
+		}
+	}
+
+	if metalLB {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ipvs", "strictARP"), kbPrxConf.IPVS.StrictARP, "strictARP must be enabled when MetalLB is deployed with IPVS kube-proxy mode"))
+	}
+
+	return allErrs
+}
+
+func validateIPVSConfig(ipvs *kubeoneapi.IPVSConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch ipvs.Scheduler {
+	case "", "rr", "wrr", "lc", "wlc", "lblc", "lblcr", "dh", "sh", "sed", "nq", "mh":
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("scheduler"), ipvs.Scheduler, []string{
+			"rr", "wrr", "lc", "wlc", "lblc", "lblcr", "dh", "sh", "sed", "nq", "mh",
+		}))
+	}
+
+	for i, cidr := range ipvs.ExcludeCIDRs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("excludeCIDRs").Index(i), cidr, "invalid CIDR"))
+		}
+	}
+
+	timeouts := []struct {
+		name    string
+		timeout metav1.Duration
+	}{
+		{name: "tcpTimeout", timeout: ipvs.TCPTimeout},
+		{name: "tcpFinTimeout", timeout: ipvs.TCPFinTimeout},
+		{name: "udpTimeout", timeout: ipvs.UDPTimeout},
+	}
+	for _, t := range timeouts {
+		if t.timeout.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(t.name), t.timeout.Duration.String(), "must be greater than or equal to 0"))
 		}
 	}
 
+	allErrs = append(allErrs, validateKubeProxySyncPeriods(ipvs.SyncPeriod, ipvs.MinSyncPeriod, fldPath)...)
+
+	return allErrs
+}
+
+func validateKubeProxySyncPeriods(syncPeriod, minSyncPeriod metav1.Duration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if syncPeriod.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("syncPeriod"), syncPeriod.Duration.String(), "must be greater than or equal to 0"))
+	}
+	if minSyncPeriod.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minSyncPeriod"), minSyncPeriod.Duration.String(), "must be greater than or equal to 0"))
+	}
+	if syncPeriod.Duration > 0 && minSyncPeriod.Duration > syncPeriod.Duration {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minSyncPeriod"), minSyncPeriod.Duration.String(), "must be less than or equal to syncPeriod"))
+	}
+
 	return allErrs
 }
 
@@ -527,6 +1108,14 @@ func ValidateCNI(c *kubeoneapi.CNI, fldPath *field.Path) field.ErrorList {
 			allErrs = append(allErrs, field.Forbidden(fldPath.Child("cilium"), "only one cni plugin can be used at the same time"))
 		}
 		cniFound = true
+		allErrs = append(allErrs, validateCilium(c.Cilium, fldPath.Child("cilium"))...)
+	}
+	if c.Calico != nil {
+		if cniFound {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("calico"), "only one cni plugin can be used at the same time"))
+		}
+		cniFound = true
+		allErrs = append(allErrs, validateCalico(c.Calico, fldPath.Child("calico"))...)
 	}
 	if c.WeaveNet != nil {
 		if cniFound {
@@ -545,37 +1134,234 @@ func ValidateCNI(c *kubeoneapi.CNI, fldPath *field.Path) field.ErrorList {
 		allErrs = append(allErrs, field.Invalid(fldPath, "", "cni plugin must be specified"))
 	}
 
+	// Cilium and WeaveNet write their CNI configuration on their own, so the
+	// bandwidth plugin can't be chained to them
+	if c.EnableBandwidthPlugin != nil && *c.EnableBandwidthPlugin && c.Canal == nil && c.Calico == nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("enableBandwidthPlugin"), "the bandwidth plugin can be chained only to the Canal and Calico CNI plugins"))
+	}
+
 	return allErrs
 }
 
-// ValidateStaticWorkersConfig validates the StaticWorkersConfig structure
-func ValidateStaticWorkersConfig(staticWorkers kubeoneapi.StaticWorkersConfig, version kubeoneapi.VersionConfig, clusterNetwork kubeoneapi.ClusterNetworkConfig, fldPath *field.Path) field.ErrorList {
+func validateCalico(c *kubeoneapi.CalicoSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	if len(staticWorkers.Hosts) > 0 {
-		allErrs = append(allErrs, ValidateHostConfig(staticWorkers.Hosts, version, clusterNetwork, fldPath.Child("hosts"))...)
+	switch c.EncapsulationMode {
+	case "", kubeoneapi.CalicoEncapsulationModeVXLAN, kubeoneapi.CalicoEncapsulationModeIPIP:
+	case kubeoneapi.CalicoEncapsulationModeNone:
+		if c.CrossSubnet {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("crossSubnet"), "crossSubnet can't be used with the None encapsulation mode"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("encapsulationMode"), c.EncapsulationMode, []string{string(kubeoneapi.CalicoEncapsulationModeVXLAN), string(kubeoneapi.CalicoEncapsulationModeIPIP), string(kubeoneapi.CalicoEncapsulationModeNone)}))
+	}
+
+	if c.MTU < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("mtu"), c.MTU, "mtu can't be negative"))
 	}
 
 	return allErrs
 }
 
-// ValidateDynamicWorkerConfig validates the DynamicWorkerConfig structure
-func ValidateDynamicWorkerConfig(workerset []kubeoneapi.DynamicWorkerConfig, prov kubeoneapi.CloudProviderSpec, fldPath *field.Path) field.ErrorList {
+func validateCilium(c *kubeoneapi.CiliumSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	for _, w := range workerset {
-		if w.Name == "" {
-			allErrs = append(allErrs, field.Required(fldPath.Child("name"), ".dynamicWorkers.name is a required field"))
-		}
-		if w.Replicas == nil || *w.Replicas < 0 {
-			allErrs = append(allErrs, field.Invalid(fldPath.Child("replicas"), w.Replicas, ".dynamicWorkers.replicas must be specified and >= 0"))
+	switch c.RoutingMode {
+	case "", kubeoneapi.CiliumRoutingModeTunnel:
+		switch c.TunnelProtocol {
+		case "", kubeoneapi.CiliumTunnelProtocolVXLAN, kubeoneapi.CiliumTunnelProtocolGeneve:
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("tunnelProtocol"), c.TunnelProtocol, []string{string(kubeoneapi.CiliumTunnelProtocolVXLAN), string(kubeoneapi.CiliumTunnelProtocolGeneve)}))
 		}
-		if len(w.Config.MachineAnnotations) > 0 && len(w.Config.NodeAnnotations) > 0 {
-			allErrs = append(allErrs, field.Invalid(fldPath.Child("machineAnnotations"), w.Config.MachineAnnotations, "machineAnnotations has been replaced with nodeAnnotations, only one of those two can be set"))
+	case kubeoneapi.CiliumRoutingModeNative:
+		if c.TunnelProtocol != "" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("tunnelProtocol"), "tunnelProtocol can be used only with the tunnel routing mode"))
 		}
-		if w.Config.Network != nil && w.Config.Network.IPFamily != "" {
-			allErrs = append(allErrs, validateIPFamily(w.Config.Network.IPFamily, prov, fldPath.Child("network", "ipFamily"))...)
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("routingMode"), c.RoutingMode, []string{string(kubeoneapi.CiliumRoutingModeTunnel), string(kubeoneapi.CiliumRoutingModeNative)}))
+	}
+
+	switch c.IPAM {
+	case "", kubeoneapi.CiliumIPAMModeClusterPool, kubeoneapi.CiliumIPAMModeKubernetes:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("ipam"), c.IPAM, []string{string(kubeoneapi.CiliumIPAMModeClusterPool), string(kubeoneapi.CiliumIPAMModeKubernetes)}))
+	}
+
+	if c.DisableHubbleUI && !c.EnableHubble {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("disableHubbleUI"), "disableHubbleUI can be used only with enableHubble"))
+	}
+	if c.MTU < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("mtu"), c.MTU, "mtu can't be negative"))
+	}
+
+	switch c.Encryption {
+	case "", kubeoneapi.CiliumEncryptionWireGuard, kubeoneapi.CiliumEncryptionIPsec:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("encryption"), c.Encryption, []string{string(kubeoneapi.CiliumEncryptionWireGuard), string(kubeoneapi.CiliumEncryptionIPsec)}))
+	}
+
+	switch c.HostRouting {
+	case "", kubeoneapi.CiliumHostRoutingLegacy:
+	case kubeoneapi.CiliumHostRoutingBPF:
+		if c.KubeProxyReplacement != kubeoneapi.KubeProxyReplacementStrict {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("hostRouting"), "bpf host routing requires kubeProxyReplacement set to strict"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("hostRouting"), c.HostRouting, []string{string(kubeoneapi.CiliumHostRoutingLegacy), string(kubeoneapi.CiliumHostRoutingBPF)}))
+	}
+
+	return allErrs
+}
+
+// ValidateStaticWorkersConfig validates the StaticWorkersConfig structure
+func ValidateStaticWorkersConfig(staticWorkers kubeoneapi.StaticWorkersConfig, version kubeoneapi.VersionConfig, clusterNetwork kubeoneapi.ClusterNetworkConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(staticWorkers.Hosts) > 0 {
+		allErrs = append(allErrs, ValidateHostConfig(staticWorkers.Hosts, version, clusterNetwork, fldPath.Child("hosts"))...)
+	}
+
+	allErrs = append(allErrs, ValidateKernelConfig(staticWorkers.Kernel, fldPath.Child("kernel"))...)
+
+	return allErrs
+}
+
+// ValidateKernelConfig validates the KernelConfig structure
+func ValidateKernelConfig(k *kubeoneapi.KernelConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if k == nil {
+		return allErrs
+	}
+
+	for name, value := range k.Sysctls {
+		if !sysctlNameRegexp.MatchString(name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("sysctls").Key(name), name, "invalid sysctl name"))
+		}
+		if strings.TrimSpace(value) == "" || !sysctlValueRegexp.MatchString(value) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("sysctls").Key(name), value, "sysctl value must be non-empty and contain only alphanumeric characters, spaces and any of '._:/,+=-'"))
+		}
+	}
+
+	seen := map[string]bool{}
+	for i, module := range k.Modules {
+		switch {
+		case !kernelModuleRegexp.MatchString(module):
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("modules").Index(i), module, "invalid kernel module name"))
+		case seen[module]:
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("modules").Index(i), module))
+		}
+		seen[module] = true
+	}
+
+	return allErrs
+}
+
+// ValidateDynamicWorkerConfig validates the DynamicWorkerConfig structure
+func ValidateDynamicWorkerConfig(workerset []kubeoneapi.DynamicWorkerConfig, prov kubeoneapi.CloudProviderSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for _, w := range workerset {
+		if w.Name == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("name"), ".dynamicWorkers.name is a required field"))
+		}
+		if w.Replicas == nil || *w.Replicas < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("replicas"), w.Replicas, ".dynamicWorkers.replicas must be specified and >= 0"))
+		}
+		if len(w.Config.MachineAnnotations) > 0 && len(w.Config.NodeAnnotations) > 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("machineAnnotations"), w.Config.MachineAnnotations, "machineAnnotations has been replaced with nodeAnnotations, only one of those two can be set"))
+		}
+		workerProv := prov
+		if w.CloudProvider != "" && w.CloudProvider != prov.CloudProviderName() {
+			var err error
+			if workerProv, err = prov.WorkerCloudProvider(w); err != nil {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("cloudProvider"), w.CloudProvider, err.Error()))
+
+				continue
+			}
+			if prov.External {
+				// machine-controller configures all kubelets to use the external cloud provider, so nodes on other
+				// cloud providers would never be initialized by the cloud-controller-manager
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("cloudProvider"), "worker pools on other cloud providers are not supported with the external cloud provider"))
+			}
+		}
+		if w.Config.Network != nil && w.Config.Network.IPFamily != "" {
+			allErrs = append(allErrs, validateIPFamily(w.Config.Network.IPFamily, workerProv, fldPath.Child("network", "ipFamily"))...)
+		}
+		if workerProv.Vsphere != nil {
+			allErrs = append(allErrs, validateVsphereWorkerSpec(w.Config.CloudProviderSpec, fldPath.Child("providerSpec", "cloudProviderSpec"))...)
 		}
+		if w.UpgradeStrategy != nil {
+			allErrs = append(allErrs, validateMachineDeploymentUpgradeStrategy(w, fldPath.Child("upgradeStrategy"))...)
+		}
+	}
+
+	return allErrs
+}
+
+// validateMachineDeploymentUpgradeStrategy validates the upgrade strategy of
+// the MachineDeployment, so that machine-controller can roll it out
+func validateMachineDeploymentUpgradeStrategy(w kubeoneapi.DynamicWorkerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch w.UpgradeStrategy.Type {
+	case "", kubeoneapi.MachineDeploymentUpgradeStrategyReplace:
+	case kubeoneapi.MachineDeploymentUpgradeStrategyInPlace:
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("type"), "machine-controller doesn't support in-place upgrades, the machines are always replaced"))
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), w.UpgradeStrategy.Type, []string{string(kubeoneapi.MachineDeploymentUpgradeStrategyReplace)}))
+	}
+
+	replicas := 0
+	if w.Replicas != nil {
+		replicas = *w.Replicas
+	}
+
+	maxSurge, maxUnavailable := w.RollingUpdate()
+	surge, surgeErr := intstr.GetScaledValueFromIntOrPercent(&maxSurge, replicas, true)
+	if surgeErr != nil || surge < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxSurge"), maxSurge.String(), "must be a non-negative number or percentage"))
+	}
+	unavailable, unavailableErr := intstr.GetScaledValueFromIntOrPercent(&maxUnavailable, replicas, false)
+	if unavailableErr != nil || unavailable < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), maxUnavailable.String(), "must be a non-negative number or percentage"))
+	}
+	if surgeErr == nil && unavailableErr == nil && surge == 0 && unavailable == 0 {
+		// machine-controller couldn't replace any machine
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), maxUnavailable.String(), "must not be 0 when maxSurge is 0"))
+	}
+
+	return allErrs
+}
+
+// validateVsphereWorkerSpec validates the placement of vSphere
+// MachineDeployments, so that each MachineDeployment can use a distinct
+// datastore and resource pool
+func validateVsphereWorkerSpec(cloudProviderSpec json.RawMessage, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(cloudProviderSpec) == 0 {
+		return allErrs
+	}
+
+	spec := map[string]json.RawMessage{}
+	if err := json.Unmarshal(cloudProviderSpec, &spec); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, string(cloudProviderSpec), fmt.Sprintf("unable to parse cloudProviderSpec: %v", err)))
+
+		return allErrs
+	}
+
+	isSet := func(key string) bool {
+		val, ok := spec[key]
+
+		return ok && string(val) != `""` && string(val) != "null" && string(val) != "false"
+	}
+
+	if isSet("datastore") && isSet("datastoreCluster") {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("datastoreCluster"), string(spec["datastoreCluster"]), "only one of datastore and datastoreCluster can be set"))
+	}
+	if isSet("vmAntiAffinity") && !isSet("cluster") {
+		allErrs = append(allErrs, field.Required(fldPath.Child("cluster"), "cluster is required when vmAntiAffinity is enabled"))
 	}
 
 	return allErrs
@@ -597,6 +1383,19 @@ func ValidateCABundle(caBundle string, fldPath *field.Path) field.ErrorList {
 	return allErrs
 }
 
+// ValidateAdditionalTrustedCAs validates the additional trusted CAs bundle.
+// The bundle is installed only on control plane and static worker nodes, so
+// it can't be combined with dynamic workers managed by machine-controller.
+func ValidateAdditionalTrustedCAs(trustedCAs string, dynamicWorkers []kubeoneapi.DynamicWorkerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := ValidateCABundle(trustedCAs, fldPath)
+
+	if strings.TrimSpace(trustedCAs) != "" && len(dynamicWorkers) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "additional trusted CAs are not installed on dynamic worker nodes (MachineDeployments), use caBundle instead"))
+	}
+
+	return allErrs
+}
+
 // ValidateFeatures validates the Features structure
 func ValidateFeatures(f kubeoneapi.Features, versions kubeoneapi.VersionConfig, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -611,6 +1410,9 @@ func ValidateFeatures(f kubeoneapi.Features, versions kubeoneapi.VersionConfig,
 	if f.CoreDNS != nil && f.CoreDNS.Replicas != nil && *f.CoreDNS.Replicas < 0 {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("coreDNS", "replicas"), *f.CoreDNS.Replicas, "coreDNS replicas cannot be < 0"))
 	}
+	if f.MetricsServer != nil && f.MetricsServer.Enable {
+		allErrs = append(allErrs, ValidateMetricsServer(f.MetricsServer, fldPath.Child("metricsServer"))...)
+	}
 	if f.PodNodeSelector != nil && f.PodNodeSelector.Enable {
 		allErrs = append(allErrs, ValidatePodNodeSelectorConfig(f.PodNodeSelector.Config, fldPath.Child("podNodeSelector"))...)
 	}
@@ -620,6 +1422,9 @@ func ValidateFeatures(f kubeoneapi.Features, versions kubeoneapi.VersionConfig,
 	if f.OpenIDConnect != nil && f.OpenIDConnect.Enable {
 		allErrs = append(allErrs, ValidateOIDCConfig(f.OpenIDConnect.Config, fldPath.Child("openidConnect"))...)
 	}
+	if f.SeccompDefault != nil && f.SeccompDefault.Enable {
+		allErrs = append(allErrs, ValidateSeccompDefault(f.SeccompDefault, fldPath.Child("seccompDefault"))...)
+	}
 	if f.PodSecurityPolicy != nil && f.PodSecurityPolicy.Enable && v.Minor() >= 25 {
 		allErrs = append(allErrs, field.Forbidden(fldPath.Child("podSecurityPolicy"), "podSecurityPolicy is not supported on Kubernetes 1.25 and newer"))
 	}
@@ -627,73 +1432,1115 @@ func ValidateFeatures(f kubeoneapi.Features, versions kubeoneapi.VersionConfig,
 	return allErrs
 }
 
-// ValidatePodNodeSelectorConfig validates the PodNodeSelectorConfig structure
-func ValidatePodNodeSelectorConfig(n kubeoneapi.PodNodeSelectorConfig, fldPath *field.Path) field.ErrorList {
+// ValidateNvidiaGPU validates the NvidiaGPU feature against the configured container runtime and nodes
+func ValidateNvidiaGPU(c kubeoneapi.KubeOneCluster, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	if len(n.ConfigFilePath) == 0 {
-		allErrs = append(allErrs, field.Required(fldPath.Child("configFilePath"), ".podNodeSelector.config.configFilePath is a required field"))
+	n := c.Features.NvidiaGPU
+	if n == nil || !n.Enable {
+		return allErrs
+	}
+
+	if c.ContainerRuntime.Containerd == nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "nvidiaGPU is supported only with the containerd container runtime"))
+	}
+
+	hostGroups := []struct {
+		hosts   []kubeoneapi.HostConfig
+		fldPath *field.Path
+	}{
+		{hosts: c.ControlPlane.Hosts, fldPath: field.NewPath("controlPlane", "hosts")},
+		{hosts: c.StaticWorkers.Hosts, fldPath: field.NewPath("staticWorkers", "hosts")},
+	}
+	for _, group := range hostGroups {
+		for i, host := range group.hosts {
+			if host.Labels[resources.NvidiaGPUNodeLabel] == "true" && host.OperatingSystem == kubeoneapi.OperatingSystemNameFlatcar {
+				allErrs = append(allErrs, field.Forbidden(group.fldPath.Index(i), "nvidia-container-toolkit can't be installed on Flatcar hosts"))
+			}
+		}
+	}
+
+	for i, dw := range c.DynamicWorkers {
+		if dw.Config.Labels[resources.NvidiaGPUNodeLabel] == "true" {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("dynamicWorkers").Index(i).Child("providerSpec", "labels"),
+				"nvidia-container-toolkit is not installed on dynamic worker nodes (MachineDeployments)"))
+		}
 	}
 
 	return allErrs
 }
 
-// ValidateStaticAuditLogConfig validates the StaticAuditLogConfig structure
-func ValidateStaticAuditLogConfig(s kubeoneapi.StaticAuditLogConfig, fldPath *field.Path) field.ErrorList {
+// ValidateMetalLB validates the MetalLB feature
+func ValidateMetalLB(c kubeoneapi.KubeOneCluster, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	if len(s.PolicyFilePath) == 0 {
-		allErrs = append(allErrs, field.Required(fldPath.Child("policyFilePath"), ".staticAuditLog.config.policyFilePath is a required field"))
+	m := c.Features.MetalLB
+	if m == nil || !m.Enable {
+		return allErrs
 	}
-	if len(s.LogPath) == 0 {
-		allErrs = append(allErrs, field.Required(fldPath.Child("logPath"), ".staticAuditLog.config.logPath is a required field"))
+
+	if c.CloudProvider.EquinixMetal != nil && c.CloudProvider.EquinixMetal.LoadBalancer != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("enable"), "metalLB can't be used together with .cloudProvider.equinixmetal.loadBalancer"))
 	}
-	if s.LogMaxAge <= 0 {
-		allErrs = append(allErrs, field.Invalid(fldPath.Child("logMaxAge"), s.LogMaxAge, ".staticAuditLog.config.logMaxAge must be greater than 0"))
+
+	if len(m.AddressPools) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("addressPools"), "at least one address pool is required"))
 	}
-	if s.LogMaxBackup <= 0 {
-		allErrs = append(allErrs, field.Invalid(fldPath.Child("logMaxBackup"), s.LogMaxBackup, ".staticAuditLog.config.logMaxBackup must be greater than 0"))
+
+	poolNames := map[string]bool{}
+	bgpMode := false
+	for i, pool := range m.AddressPools {
+		poolPath := fldPath.Child("addressPools").Index(i)
+
+		for _, err := range validation.IsDNS1123Subdomain(pool.Name) {
+			allErrs = append(allErrs, field.Invalid(poolPath.Child("name"), pool.Name, err))
+		}
+		if poolNames[pool.Name] {
+			allErrs = append(allErrs, field.Duplicate(poolPath.Child("name"), pool.Name))
+		}
+		poolNames[pool.Name] = true
+
+		if len(pool.Addresses) == 0 {
+			allErrs = append(allErrs, field.Required(poolPath.Child("addresses"), "at least one address is required"))
+		}
+		for j, addr := range pool.Addresses {
+			if !validMetalLBAddress(addr) {
+				allErrs = append(allErrs, field.Invalid(poolPath.Child("addresses").Index(j), addr, "address must be a CIDR or a range of IP addresses of the same family"))
+			}
+		}
+
+		switch pool.Mode {
+		case kubeoneapi.MetalLBModeL2:
+		case kubeoneapi.MetalLBModeBGP:
+			bgpMode = true
+		default:
+			allErrs = append(allErrs, field.NotSupported(poolPath.Child("mode"), pool.Mode, []string{string(kubeoneapi.MetalLBModeL2), string(kubeoneapi.MetalLBModeBGP)}))
+		}
 	}
-	if s.LogMaxSize <= 0 {
-		allErrs = append(allErrs, field.Invalid(fldPath.Child("logMaxSize"), s.LogMaxSize, ".staticAuditLog.config.logMaxSize must be greater than 0"))
+
+	if bgpMode && len(m.BGPPeers) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("bgpPeers"), "at least one BGP peer is required for address pools in the BGP mode"))
+	}
+
+	for i, peer := range m.BGPPeers {
+		peerPath := fldPath.Child("bgpPeers").Index(i)
+
+		if net.ParseIP(peer.PeerAddress) == nil {
+			allErrs = append(allErrs, field.Invalid(peerPath.Child("peerAddress"), peer.PeerAddress, "peerAddress must be a valid IP address"))
+		}
+		if peer.PeerASN <= 0 || int64(peer.PeerASN) > math.MaxUint32 {
+			allErrs = append(allErrs, field.Invalid(peerPath.Child("peerASN"), peer.PeerASN, "peerASN must be a valid 32-bit ASN"))
+		}
+		if peer.MyASN <= 0 || int64(peer.MyASN) > math.MaxUint32 {
+			allErrs = append(allErrs, field.Invalid(peerPath.Child("myASN"), peer.MyASN, "myASN must be a valid 32-bit ASN"))
+		}
+		if peer.PeerPort < 0 || peer.PeerPort > 65535 {
+			allErrs = append(allErrs, field.Invalid(peerPath.Child("peerPort"), peer.PeerPort, "peerPort must be a valid port number"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateGatewayAPI validates the GatewayAPI feature against the configured CNI plugin
+func ValidateGatewayAPI(c kubeoneapi.KubeOneCluster, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	g := c.Features.GatewayAPI
+	if g == nil || !g.Enable {
+		return allErrs
+	}
+
+	switch g.Controller {
+	case kubeoneapi.GatewayAPIControllerNone:
+	case kubeoneapi.GatewayAPIControllerCilium:
+		cni := c.ClusterNetwork.CNI
+		if cni == nil || cni.Cilium == nil || cni.Cilium.KubeProxyReplacement != kubeoneapi.KubeProxyReplacementStrict {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("controller"), "the cilium gateway controller requires the Cilium CNI with kubeProxyReplacement set to strict"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("controller"), g.Controller, []string{string(kubeoneapi.GatewayAPIControllerCilium), string(kubeoneapi.GatewayAPIControllerNone)}))
+	}
+
+	return allErrs
+}
+
+// ValidateIstioAmbient validates the IstioAmbient feature against the cluster
+// network configuration, as the ambient traffic redirection is configured by
+// the istio-cni plugin chained to the CNI plugin
+func ValidateIstioAmbient(c kubeoneapi.KubeOneCluster, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !c.IstioAmbientEnabled() {
+		return allErrs
+	}
+
+	if c.ClusterNetwork.IPFamily != kubeoneapi.IPFamilyIPv4 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("enable"), "istio ambient mode is supported only in IPv4 clusters"))
+	}
+
+	cni := c.ClusterNetwork.CNI
+	if cni != nil && cni.Cilium != nil && cni.Cilium.HostRouting == kubeoneapi.CiliumHostRoutingBPF {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("enable"), "istio ambient mode can't be used with the bpf host routing of the Cilium CNI, as it bypasses the host network stack used to redirect the traffic to ztunnel"))
+	}
+
+	return allErrs
+}
+
+// ValidateClusterAutoscaler validates the ClusterAutoscaler feature and its
+// node groups against the dynamic workers
+func ValidateClusterAutoscaler(c kubeoneapi.KubeOneCluster, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !c.ClusterAutoscalerEnabled() {
+		return allErrs
+	}
+
+	ca := c.Features.ClusterAutoscaler
+
+	if c.MachineController == nil || !c.MachineController.Deploy {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("enable"), "cluster-autoscaler requires machine-controller to be deployed"))
+	}
+
+	switch ca.Expander {
+	case "", "random", "most-pods", "least-waste", "price", "priority":
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("expander"), ca.Expander, []string{"random", "most-pods", "least-waste", "price", "priority"}))
+	}
+
+	if ca.ScaleDownUnneededTime != nil && ca.ScaleDownUnneededTime.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("scaleDownUnneededTime"), ca.ScaleDownUnneededTime.Duration.String(), "scaleDownUnneededTime must be greater than zero"))
+	}
+
+	replicas := map[string]*int{}
+	for _, worker := range c.DynamicWorkers {
+		replicas[worker.Name] = worker.Replicas
+	}
+
+	nodeGroups := map[string]bool{}
+	for i, ng := range ca.NodeGroups {
+		ngPath := fldPath.Child("nodeGroups").Index(i)
+
+		if ng.MachineDeployment == "" {
+			allErrs = append(allErrs, field.Required(ngPath.Child("machineDeployment"), "machineDeployment name is required"))
+		} else if nodeGroups[ng.MachineDeployment] {
+			allErrs = append(allErrs, field.Duplicate(ngPath.Child("machineDeployment"), ng.MachineDeployment))
+		}
+		nodeGroups[ng.MachineDeployment] = true
+
+		if ng.MinReplicas < 1 {
+			allErrs = append(allErrs, field.Invalid(ngPath.Child("minReplicas"), ng.MinReplicas, "minReplicas must be at least 1"))
+		}
+		if ng.MaxReplicas < ng.MinReplicas {
+			allErrs = append(allErrs, field.Invalid(ngPath.Child("maxReplicas"), ng.MaxReplicas, "maxReplicas must not be lower than minReplicas"))
+		}
+
+		if r := replicas[ng.MachineDeployment]; r != nil && (*r < ng.MinReplicas || *r > ng.MaxReplicas) {
+			allErrs = append(allErrs, field.Invalid(ngPath.Child("machineDeployment"), ng.MachineDeployment,
+				fmt.Sprintf("replicas of the dynamic worker (%d) must be between minReplicas and maxReplicas", *r)))
+		}
+	}
+
+	if c.Addons.Enabled() {
+		for i, addon := range c.Addons.Addons {
+			if addon.Name == "cluster-autoscaler" && !addon.Delete {
+				allErrs = append(allErrs, field.Forbidden(field.NewPath("addons", "addons").Index(i), "the cluster-autoscaler addon is deployed by the clusterAutoscaler feature and can't be listed in the addons"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateLocalStorage validates the LocalStorage feature
+func ValidateLocalStorage(c kubeoneapi.KubeOneCluster, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !c.LocalStorageEnabled() {
+		return allErrs
+	}
+
+	ls := c.Features.LocalStorage
+
+	// the bundled CSI drivers come with their own default StorageClass
+	if c.CloudProvider.None == nil && !c.CloudProvider.DisableBundledCSIDrivers {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("enable"), "localStorage can be used only with the none cloud provider or with .cloudProvider.disableBundledCSIDrivers"))
+	}
+
+	switch {
+	case ls.LocalPath != nil && ls.TopoLVM != nil:
+		allErrs = append(allErrs, field.Forbidden(fldPath, "only one of localPath and topolvm can be set"))
+	case ls.LocalPath != nil:
+		if !filepath.IsAbs(ls.LocalPath.Path) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("localPath", "path"), ls.LocalPath.Path, "path must be an absolute path"))
+		}
+	case ls.TopoLVM != nil:
+		lvmPath := fldPath.Child("topolvm")
+
+		if ls.TopoLVM.VolumeGroup == "" {
+			allErrs = append(allErrs, field.Required(lvmPath.Child("volumeGroup"), "volumeGroup is required"))
+		}
+
+		switch ls.TopoLVM.FSType {
+		case "xfs", "ext4", "btrfs":
+		default:
+			allErrs = append(allErrs, field.NotSupported(lvmPath.Child("fsType"), ls.TopoLVM.FSType, []string{"xfs", "ext4", "btrfs"}))
+		}
+
+		if ls.TopoLVM.SpareGB != nil && *ls.TopoLVM.SpareGB < 0 {
+			allErrs = append(allErrs, field.Invalid(lvmPath.Child("spareGB"), *ls.TopoLVM.SpareGB, "spareGB must not be negative"))
+		}
+	}
+
+	for _, err := range validation.IsDNS1123Subdomain(ls.StorageClassName) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("storageClassName"), ls.StorageClassName, err))
+	}
+
+	switch ls.ReclaimPolicy {
+	case corev1.PersistentVolumeReclaimDelete, corev1.PersistentVolumeReclaimRetain:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("reclaimPolicy"), ls.ReclaimPolicy, []string{string(corev1.PersistentVolumeReclaimDelete), string(corev1.PersistentVolumeReclaimRetain)}))
+	}
+
+	return allErrs
+}
+
+// ValidateIngressNginx validates the IngressNginx feature and its controller
+// Service against the cloud provider
+func ValidateIngressNginx(c kubeoneapi.KubeOneCluster, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !c.IngressNginxEnabled() {
+		return allErrs
+	}
+
+	in := c.Features.IngressNginx
+
+	if in.Replicas != nil && *in.Replicas < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("replicas"), *in.Replicas, "replicas must be at least 1"))
+	}
+
+	switch in.ServiceType {
+	case corev1.ServiceTypeLoadBalancer, corev1.ServiceTypeNodePort:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("serviceType"), in.ServiceType, []string{string(corev1.ServiceTypeLoadBalancer), string(corev1.ServiceTypeNodePort)}))
+	}
+
+	switch in.ExternalTrafficPolicy {
+	case corev1.ServiceExternalTrafficPolicyLocal, corev1.ServiceExternalTrafficPolicyCluster:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("externalTrafficPolicy"), in.ExternalTrafficPolicy, []string{string(corev1.ServiceExternalTrafficPolicyLocal), string(corev1.ServiceExternalTrafficPolicyCluster)}))
+	}
+
+	if in.ProxyProtocol != nil && *in.ProxyProtocol {
+		switch {
+		case c.CloudProvider.AWS == nil && c.CloudProvider.DigitalOcean == nil && c.CloudProvider.Hetzner == nil:
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("proxyProtocol"), "proxyProtocol is supported only on AWS, DigitalOcean and Hetzner"))
+		case in.ServiceType != corev1.ServiceTypeLoadBalancer:
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("proxyProtocol"), "proxyProtocol requires the LoadBalancer service type"))
+		}
+	}
+
+	for key := range in.ServiceAnnotations {
+		for _, err := range validation.IsQualifiedName(strings.ToLower(key)) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("serviceAnnotations"), key, err))
+		}
+	}
+
+	// the Hetzner CCM can't create the load balancer without its location
+	if c.CloudProvider.Hetzner != nil && in.ServiceType == corev1.ServiceTypeLoadBalancer {
+		_, location := in.ServiceAnnotations["load-balancer.hetzner.cloud/location"]
+		_, networkZone := in.ServiceAnnotations["load-balancer.hetzner.cloud/network-zone"]
+		if !location && !networkZone {
+			allErrs = append(allErrs, field.Required(fldPath.Child("serviceAnnotations"), "load-balancer.hetzner.cloud/location or load-balancer.hetzner.cloud/network-zone annotation is required on Hetzner"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateKubeVIP validates the KubeVIP feature against the API endpoint,
+// which is used as the virtual IP address
+func ValidateKubeVIP(c kubeoneapi.KubeOneCluster, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !c.KubeVIPEnabled() {
+		return allErrs
+	}
+
+	vip := c.APIEndpoint.Host
+	if net.ParseIP(vip) == nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("apiEndpoint", "host"), vip, "apiEndpoint.host must be an IP address when kube-vip is enabled"))
+
+		return allErrs
+	}
+
+	for i, host := range c.ControlPlane.Hosts {
+		if host.PublicAddress == vip || host.PrivateAddress == vip {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("controlPlane", "hosts").Index(i), vip, "the kube-vip virtual IP address can't be used by a control plane host"))
+		}
+	}
+
+	return allErrs
+}
+
+// defaultDenyReservedNamespaces are the namespaces of the components deployed
+// by KubeOne, which are not covered by the default-deny NetworkPolicies
+var defaultDenyReservedNamespaces = sets.New(
+	metav1.NamespaceSystem,
+	metav1.NamespacePublic,
+	corev1.NamespaceNodeLease,
+	"cilium-secrets",
+	"cloud-init-settings",
+	"istio-system",
+	"metallb-system",
+	"reboot-coordinator",
+	"vmware-system-csi",
+)
+
+// ValidateDefaultDenyNetworkPolicy validates the namespaces the default-deny
+// NetworkPolicies are deployed to
+func ValidateDefaultDenyNetworkPolicy(ddnp *kubeoneapi.DefaultDenyNetworkPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if ddnp == nil || !ddnp.Enable {
+		return allErrs
+	}
+
+	visited := map[string]bool{}
+	for i, namespace := range ddnp.Namespaces {
+		nsPath := fldPath.Child("namespaces").Index(i)
+		for _, err := range validation.IsDNS1123Label(namespace) {
+			allErrs = append(allErrs, field.Invalid(nsPath, namespace, err))
+		}
+		if defaultDenyReservedNamespaces.Has(namespace) {
+			allErrs = append(allErrs, field.Forbidden(nsPath, fmt.Sprintf("namespace %q is used by the components deployed by KubeOne", namespace)))
+		}
+		if visited[namespace] {
+			allErrs = append(allErrs, field.Duplicate(nsPath, namespace))
+		}
+		visited[namespace] = true
+	}
+
+	return allErrs
+}
+
+// ValidateEgressGateways validates the egress gateways against the configured
+// CNI plugin and the static hosts the egress IP addresses are assigned to
+func ValidateEgressGateways(c kubeoneapi.KubeOneCluster, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(c.ClusterNetwork.EgressGateways) == 0 {
+		return allErrs
+	}
+
+	cni := c.ClusterNetwork.CNI
+	if cni == nil || cni.Cilium == nil || cni.Cilium.KubeProxyReplacement != kubeoneapi.KubeProxyReplacementStrict {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "egress gateways require the Cilium CNI with kubeProxyReplacement set to strict"))
+	}
+	if !c.ClusterNetwork.HasIPv4() {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "egress gateways are supported only for the IPv4 traffic"))
+	}
+	// the Cilium egress gateway is not compatible with the L7 proxy, which is
+	// required by the Cilium Gateway API controller
+	if c.CiliumGatewayAPIEnabled() {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "egress gateways are not compatible with the Cilium Gateway API controller"))
+	}
+
+	names := map[string]bool{}
+	egressIPHosts := map[string]string{}
+	for i, gateway := range c.ClusterNetwork.EgressGateways {
+		gwPath := fldPath.Index(i)
+
+		for _, err := range validation.IsDNS1123Subdomain(gateway.Name) {
+			allErrs = append(allErrs, field.Invalid(gwPath.Child("name"), gateway.Name, err))
+		}
+		if names[gateway.Name] {
+			allErrs = append(allErrs, field.Duplicate(gwPath.Child("name"), gateway.Name))
+		}
+		names[gateway.Name] = true
+
+		if gateway.Namespace == "" && len(gateway.PodSelector) == 0 {
+			allErrs = append(allErrs, field.Required(gwPath.Child("podSelector"), "at least one of namespace and podSelector is required"))
+		}
+		if gateway.Namespace != "" {
+			for _, err := range validation.IsDNS1123Label(gateway.Namespace) {
+				allErrs = append(allErrs, field.Invalid(gwPath.Child("namespace"), gateway.Namespace, err))
+			}
+		}
+		if len(gateway.NodeSelector) == 0 {
+			allErrs = append(allErrs, field.Required(gwPath.Child("nodeSelector"), "nodeSelector is required"))
+		}
+
+		for j, cidr := range gateway.DestinationCIDRs {
+			if ip, _, err := net.ParseCIDR(cidr); err != nil || ip.To4() == nil {
+				allErrs = append(allErrs, field.Invalid(gwPath.Child("destinationCIDRs").Index(j), cidr, "must be a valid IPv4 CIDR"))
+			}
+		}
+		for j, cidr := range gateway.ExcludedCIDRs {
+			if ip, _, err := net.ParseCIDR(cidr); err != nil || ip.To4() == nil {
+				allErrs = append(allErrs, field.Invalid(gwPath.Child("excludedCIDRs").Index(j), cidr, "must be a valid IPv4 CIDR"))
+			}
+		}
+
+		if gateway.EgressIP == "" {
+			continue
+		}
+		if gateway.Interface != "" {
+			allErrs = append(allErrs, field.Forbidden(gwPath.Child("interface"), "interface can't be used together with egressIP"))
+		}
+		if ip := net.ParseIP(gateway.EgressIP); ip == nil || ip.To4() == nil {
+			allErrs = append(allErrs, field.Invalid(gwPath.Child("egressIP"), gateway.EgressIP, "egressIP must be a valid IPv4 address"))
+		}
+
+		// the egress IP is assigned to the gateway host by KubeOne, so it
+		// must be a static host and the address can't be moved between hosts
+		hosts := c.EgressGatewayHosts(gateway)
+		if len(hosts) != 1 {
+			allErrs = append(allErrs, field.Invalid(gwPath.Child("nodeSelector"), gateway.NodeSelector, fmt.Sprintf("nodeSelector must match exactly one control plane or static worker host when egressIP is set, matched %d", len(hosts))))
+
+			continue
+		}
+		if host, ok := egressIPHosts[gateway.EgressIP]; ok && host != hosts[0].PublicAddress {
+			allErrs = append(allErrs, field.Invalid(gwPath.Child("egressIP"), gateway.EgressIP, "egressIP is already assigned to another host"))
+		}
+		egressIPHosts[gateway.EgressIP] = hosts[0].PublicAddress
+	}
+
+	return allErrs
+}
+
+// ValidateBGP validates the BGP configuration against the configured CNI
+// plugin and MetalLB
+func ValidateBGP(c kubeoneapi.KubeOneCluster, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	bgp := c.ClusterNetwork.BGP
+	if bgp == nil {
+		return allErrs
+	}
+
+	cni := c.ClusterNetwork.CNI
+	calico := cni != nil && cni.Calico != nil
+	cilium := cni != nil && cni.Cilium != nil
+	switch {
+	case calico:
+		// the BGP daemon (BIRD) is not started with the VXLAN encapsulation
+		if cni.Calico.EncapsulationMode == kubeoneapi.CalicoEncapsulationModeVXLAN {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "BGP requires the Calico encapsulationMode set to IPIP or None"))
+		}
+	case cilium:
+	default:
+		allErrs = append(allErrs, field.Forbidden(fldPath, "BGP is supported only with the Calico and Cilium CNI plugins"))
+	}
+
+	// both the CNI plugin and MetalLB would run a BGP speaker on the nodes
+	if m := c.Features.MetalLB; m != nil && m.Enable {
+		for _, pool := range m.AddressPools {
+			if pool.Mode == kubeoneapi.MetalLBModeBGP {
+				allErrs = append(allErrs, field.Forbidden(fldPath, "BGP can't be used together with MetalLB address pools in the BGP mode"))
+
+				break
+			}
+		}
+		if cilium && len(bgp.AdvertisedCIDRs) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("advertisedCIDRs"), "advertisedCIDRs can't be used together with MetalLB with the Cilium CNI plugin"))
+		}
+	}
+
+	if bgp.ASN <= 0 || int64(bgp.ASN) > math.MaxUint32 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("asn"), bgp.ASN, "asn must be a valid 32-bit ASN"))
+	}
+
+	if len(bgp.Peers) == 0 && bgp.RouteReflectors == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("peers"), "at least one BGP peer is required"))
+	}
+
+	peers := map[string]bool{}
+	for i, peer := range bgp.Peers {
+		peerPath := fldPath.Child("peers").Index(i)
+
+		if net.ParseIP(peer.PeerAddress) == nil {
+			allErrs = append(allErrs, field.Invalid(peerPath.Child("peerAddress"), peer.PeerAddress, "peerAddress must be a valid IP address"))
+		}
+		if peers[peer.PeerAddress] {
+			allErrs = append(allErrs, field.Duplicate(peerPath.Child("peerAddress"), peer.PeerAddress))
+		}
+		peers[peer.PeerAddress] = true
+
+		if peer.PeerASN <= 0 || int64(peer.PeerASN) > math.MaxUint32 {
+			allErrs = append(allErrs, field.Invalid(peerPath.Child("peerASN"), peer.PeerASN, "peerASN must be a valid 32-bit ASN"))
+		}
+		if peer.PeerPort < 1 || peer.PeerPort > 65535 {
+			allErrs = append(allErrs, field.Invalid(peerPath.Child("peerPort"), peer.PeerPort, "peerPort must be between 1 and 65535"))
+		}
+
+		if len(peer.NodeSelector) > 0 && !calico {
+			allErrs = append(allErrs, field.Forbidden(peerPath.Child("nodeSelector"), "nodeSelector is supported only with the Calico CNI plugin"))
+		}
+		allErrs = append(allErrs, validateBGPNodeSelector(peer.NodeSelector, peerPath.Child("nodeSelector"))...)
+	}
+
+	if rr := bgp.RouteReflectors; rr != nil {
+		rrPath := fldPath.Child("routeReflectors")
+
+		if !calico {
+			allErrs = append(allErrs, field.Forbidden(rrPath, "routeReflectors are supported only with the Calico CNI plugin"))
+		}
+		if len(rr.NodeSelector) == 0 {
+			allErrs = append(allErrs, field.Required(rrPath.Child("nodeSelector"), "nodeSelector is required"))
+		}
+		allErrs = append(allErrs, validateBGPNodeSelector(rr.NodeSelector, rrPath.Child("nodeSelector"))...)
+
+		if ip := net.ParseIP(rr.ClusterID); ip == nil || ip.To4() == nil {
+			allErrs = append(allErrs, field.Invalid(rrPath.Child("clusterID"), rr.ClusterID, "clusterID must be a valid IPv4 address"))
+		}
+	}
+
+	for i, cidr := range bgp.AdvertisedCIDRs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("advertisedCIDRs").Index(i), cidr, "must be a valid CIDR"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateBGPNodeSelector validates the labels of the node selector, which is
+// rendered into a Calico selector expression
+func validateBGPNodeSelector(selector map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for key, value := range selector {
+		for _, err := range validation.IsQualifiedName(key) {
+			allErrs = append(allErrs, field.Invalid(fldPath, key, err))
+		}
+		for _, err := range validation.IsValidLabelValue(value) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(key), value, err))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateClusterDNS validates the upstream DNS servers and the search domains
+func ValidateClusterDNS(dns *kubeoneapi.ClusterDNSConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	// resolvers use at most 3 nameservers
+	if len(dns.UpstreamServers) > 3 {
+		allErrs = append(allErrs, field.TooMany(fldPath.Child("upstreamServers"), len(dns.UpstreamServers), 3))
+	}
+	for i, server := range dns.UpstreamServers {
+		ip := net.ParseIP(server)
+		if ip == nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("upstreamServers").Index(i), server, "upstream server must be a valid IP address"))
+
+			continue
+		}
+		// loopback resolvers are not reachable from the pods and cause a
+		// forwarding loop in CoreDNS
+		if ip.IsLoopback() {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("upstreamServers").Index(i), server, "upstream server can't be a loopback address"))
+		}
+	}
+
+	// kubelet adds 3 cluster search domains and rejects more than 6 search
+	// domains in total
+	if len(dns.SearchDomains) > 3 {
+		allErrs = append(allErrs, field.TooMany(fldPath.Child("searchDomains"), len(dns.SearchDomains), 3))
+	}
+	for i, domain := range dns.SearchDomains {
+		for _, err := range validation.IsDNS1123Subdomain(domain) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("searchDomains").Index(i), domain, err))
+		}
+	}
+
+	return allErrs
+}
+
+// validMetalLBAddress returns true if addr is a CIDR or a range of IP
+// addresses of the same family, as accepted by the MetalLB IPAddressPool
+func validMetalLBAddress(addr string) bool {
+	if _, _, err := net.ParseCIDR(addr); err == nil {
+		return true
+	}
+
+	from, to, found := strings.Cut(addr, "-")
+	if !found {
+		return false
+	}
+
+	fromIP := net.ParseIP(strings.TrimSpace(from))
+	toIP := net.ParseIP(strings.TrimSpace(to))
+	if fromIP == nil || toIP == nil {
+		return false
+	}
+
+	return (fromIP.To4() == nil) == (toIP.To4() == nil)
+}
+
+// ValidateHetznerPrivateNetwork validates that the Hetzner network is configured
+// if some hosts are reachable only over the private network
+func ValidateHetznerPrivateNetwork(c kubeoneapi.KubeOneCluster, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	h := c.CloudProvider.Hetzner
+	if h == nil || h.NetworkID != "" {
+		return allErrs
+	}
+
+	hosts := append(append([]kubeoneapi.HostConfig{}, c.ControlPlane.Hosts...), c.StaticWorkers.Hosts...)
+	for _, host := range hosts {
+		if host.PrivateNetworkOnly() {
+			// without the network, hcloud-cloud-controller-manager can't
+			// find the private addresses of the nodes
+			allErrs = append(allErrs, field.Required(fldPath, "networkID is required if hosts are reachable only over the private network"))
+
+			break
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateDigitalOceanVPC validates that all control plane and static worker
+// nodes are in the DigitalOcean VPC used by the cluster
+func ValidateDigitalOceanVPC(c kubeoneapi.KubeOneCluster) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	do := c.CloudProvider.DigitalOcean
+	if do == nil || do.VPCIPRange == "" {
+		return allErrs
+	}
+
+	_, vpcNet, err := net.ParseCIDR(do.VPCIPRange)
+	if err != nil {
+		// already reported by ValidateCloudProviderSpec
+		return allErrs
+	}
+
+	validateHosts := func(hosts []kubeoneapi.HostConfig, hostsPath *field.Path) {
+		for i, host := range hosts {
+			ip := net.ParseIP(host.PrivateAddress)
+			if ip == nil || vpcNet.Contains(ip) {
+				continue
+			}
+			allErrs = append(allErrs, field.Invalid(hostsPath.Index(i).Child("privateAddress"), host.PrivateAddress,
+				fmt.Sprintf("private address must be in the VPC IP range %s", do.VPCIPRange)))
+		}
+	}
+	validateHosts(c.ControlPlane.Hosts, field.NewPath("controlPlane", "hosts"))
+	validateHosts(c.StaticWorkers.Hosts, field.NewPath("staticWorkers", "hosts"))
+
+	return allErrs
+}
+
+// ValidateNodeSwap validates the NodeSwap feature against the container runtime, cgroups and Kubernetes version
+func ValidateNodeSwap(n *kubeoneapi.NodeSwap, cr kubeoneapi.ContainerRuntimeConfig, cgroups kubeoneapi.CgroupsConfig, versions kubeoneapi.VersionConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if n == nil || !n.Enable {
+		return allErrs
+	}
+
+	switch n.SwapBehavior {
+	case "", kubeoneapi.SwapBehaviorLimitedSwap, kubeoneapi.SwapBehaviorUnlimitedSwap:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("swapBehavior"), n.SwapBehavior, []string{
+			string(kubeoneapi.SwapBehaviorLimitedSwap),
+			string(kubeoneapi.SwapBehaviorUnlimitedSwap),
+		}))
+	}
+
+	// NodeSwap has graduated to beta in Kubernetes 1.28
+	kubeVer, err := semver.NewVersion(versions.Kubernetes)
+	if err == nil && !gte128Constraint.Check(kubeVer) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "nodeSwap requires kubernetes v1.28 or newer"))
+	}
+
+	if cr.Containerd == nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "nodeSwap is supported only with the containerd container runtime"))
+	}
+
+	if cgroups.Version == kubeoneapi.CgroupVersionV1 {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "nodeSwap is supported only on nodes running cgroup v2"))
+	}
+
+	return allErrs
+}
+
+// ValidateSeccompDefault validates the SeccompDefault structure
+func ValidateSeccompDefault(sd *kubeoneapi.SeccompDefault, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	fileNames := map[string]bool{}
+	for i, profilePath := range sd.ProfilePaths {
+		if profilePath == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("profilePaths").Index(i), "profile path can't be empty"))
+
+			continue
+		}
+
+		fileName := filepath.Base(profilePath)
+		if fileNames[fileName] {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("profilePaths").Index(i), fileName))
+		}
+		fileNames[fileName] = true
+	}
+
+	return allErrs
+}
+
+// ValidateMetricsServer validates the MetricsServer structure
+func ValidateMetricsServer(m *kubeoneapi.MetricsServer, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if m.Replicas != nil && *m.Replicas < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("replicas"), *m.Replicas, "metricsServer replicas cannot be < 1"))
+	}
+
+	if m.Resources != nil {
+		for name, request := range m.Resources.Requests {
+			limit, ok := m.Resources.Limits[name]
+			if ok && request.Cmp(limit) > 0 {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("resources", "requests").Key(string(name)), request.String(), fmt.Sprintf("must be less than or equal to %s limit", name)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// ValidatePodNodeSelectorConfig validates the PodNodeSelectorConfig structure
+func ValidatePodNodeSelectorConfig(n kubeoneapi.PodNodeSelectorConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(n.ConfigFilePath) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("configFilePath"), ".podNodeSelector.config.configFilePath is a required field"))
+	}
+
+	return allErrs
+}
+
+// ValidateStaticAuditLogConfig validates the StaticAuditLogConfig structure
+func ValidateStaticAuditLogConfig(s kubeoneapi.StaticAuditLogConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(s.PolicyFilePath) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("policyFilePath"), ".staticAuditLog.config.policyFilePath is a required field"))
+	}
+	if len(s.LogPath) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("logPath"), ".staticAuditLog.config.logPath is a required field"))
+	}
+	if s.LogMaxAge <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("logMaxAge"), s.LogMaxAge, ".staticAuditLog.config.logMaxAge must be greater than 0"))
+	}
+	if s.LogMaxBackup <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("logMaxBackup"), s.LogMaxBackup, ".staticAuditLog.config.logMaxBackup must be greater than 0"))
+	}
+	if s.LogMaxSize <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("logMaxSize"), s.LogMaxSize, ".staticAuditLog.config.logMaxSize must be greater than 0"))
+	}
+
+	return allErrs
+}
+
+// ValidateOIDCConfig validates the OpenIDConnectConfig structure
+func ValidateOIDCConfig(o kubeoneapi.OpenIDConnectConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(o.IssuerURL) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("issuerURL"), ".openidConnect.config.issuerURL is a required field"))
+	}
+	if len(o.ClientID) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("clientID"), ".openidConnect.config.clientID is a required field"))
+	}
+
+	return allErrs
+}
+
+// ValidateAddons validates the Addons configuration
+func ValidateAddons(o *kubeoneapi.Addons, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if o == nil || !o.Enable {
+		return allErrs
+	}
+	if o.Source != nil {
+		allErrs = append(allErrs, validateAddonsSource(o.Source, fldPath.Child("source"))...)
+
+		if o.Path != "" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("path"), "path can't be set along with source"))
+		}
+	}
+
+	if o.Verification != nil {
+		allErrs = append(allErrs, validateAddonsVerification(o, fldPath.Child("verification"))...)
+	}
+
+	if o.Enable && len(o.Path) == 0 && o.Source == nil {
+		// Addons are enabled, path is empty, and no embedded addon is specified
+		if len(o.Addons) == 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("enable"), o.Enable, ".addons.enable cannot be set to true without specifying either custom addon path or embedded addon"))
+		}
+
+		// Check if only embedded addons are being used; path is not required for embedded addons
+		embeddedAddonsOnly, err := addons.EmbeddedAddonsOnly(o.Addons)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath, "", "failed to read embedded addons directory"))
+		} else if !embeddedAddonsOnly {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("path"), "", ".addons.path must be specified when using non-embedded addon(s)"))
+		}
+	}
+
+	if ref, ok := o.OCIReference(); ok {
+		named, err := reference.ParseNamed(ref)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("path"), o.Path, fmt.Sprintf("invalid OCI reference: %v", err)))
+		} else {
+			_, tagged := named.(reference.Tagged)
+			_, digested := named.(reference.Digested)
+			if !tagged && !digested {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("path"), o.Path, "OCI reference must include a tag or a digest"))
+			}
+		}
+	}
+
+	addonsByName := map[string]kubeoneapi.Addon{}
+	for _, addon := range o.Addons {
+		addonsByName[addon.Name] = addon
+	}
+
+	for i, addon := range o.Addons {
+		addonPath := fldPath.Child("addons").Index(i)
+		allErrs = append(allErrs, validateAddonDependencies(addon, addonsByName, addonPath.Child("dependsOn"))...)
+		allErrs = append(allErrs, validateAddonReadinessChecks(addon.ReadinessChecks, addonPath.Child("readinessChecks"))...)
+		allErrs = append(allErrs, validateAddonPhase(addon, addonsByName, addonPath)...)
+	}
+
+	return allErrs
+}
+
+func validateAddonsSource(src *kubeoneapi.AddonsSource, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch {
+	case src.Git != nil && src.HTTP != nil:
+		allErrs = append(allErrs, field.Forbidden(fldPath, "only one addons source can be set"))
+	case src.Git != nil:
+		gitPath := fldPath.Child("git")
+		if src.Git.URL == "" {
+			allErrs = append(allErrs, field.Required(gitPath.Child("url"), "git repository URL is required"))
+		} else if strings.HasPrefix(src.Git.URL, "-") {
+			allErrs = append(allErrs, field.Invalid(gitPath.Child("url"), src.Git.URL, "URL can't start with a dash"))
+		}
+		if strings.HasPrefix(src.Git.Ref, "-") {
+			allErrs = append(allErrs, field.Invalid(gitPath.Child("ref"), src.Git.Ref, "ref can't start with a dash"))
+		}
+		allErrs = append(allErrs, validateAddonsSourcePath(src.Git.Path, gitPath.Child("path"))...)
+	case src.HTTP != nil:
+		httpPath := fldPath.Child("http")
+		if u, err := url.Parse(src.HTTP.URL); err != nil || u.Scheme != "https" || u.Host == "" {
+			allErrs = append(allErrs, field.Invalid(httpPath.Child("url"), src.HTTP.URL, "must be a valid HTTPS URL"))
+		}
+		if len(src.HTTP.SHA256) != 64 {
+			allErrs = append(allErrs, field.Invalid(httpPath.Child("sha256"), src.HTTP.SHA256, "must be a hex encoded SHA256 checksum"))
+		} else if _, err := hex.DecodeString(src.HTTP.SHA256); err != nil {
+			allErrs = append(allErrs, field.Invalid(httpPath.Child("sha256"), src.HTTP.SHA256, "must be a hex encoded SHA256 checksum"))
+		}
+		allErrs = append(allErrs, validateAddonsSourcePath(src.HTTP.Path, httpPath.Child("path"))...)
+	default:
+		allErrs = append(allErrs, field.Required(fldPath, "either git or http source must be set"))
+	}
+
+	return allErrs
+}
+
+func validateAddonsVerification(o *kubeoneapi.Addons, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	v := o.Verification
+
+	keyless := v.CertificateIdentity != "" || v.CertificateOIDCIssuer != ""
+	switch {
+	case v.PublicKey != "" && keyless:
+		allErrs = append(allErrs, field.Forbidden(fldPath, "publicKey can't be set along with the keyless verification"))
+	case v.PublicKey != "":
+		if block, _ := pem.Decode([]byte(v.PublicKey)); block == nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("publicKey"), "", "must be a PEM encoded public key"))
+		}
+	case keyless:
+		if v.CertificateIdentity == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("certificateIdentity"), "certificateIdentity is required for the keyless verification"))
+		}
+		if v.CertificateOIDCIssuer == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("certificateOIDCIssuer"), "certificateOIDCIssuer is required for the keyless verification"))
+		}
+	default:
+		allErrs = append(allErrs, field.Required(fldPath, "either publicKey or certificateIdentity and certificateOIDCIssuer must be set"))
+	}
+
+	// the addons from the local directory and git repositories have no
+	// signatures to verify
+	_, oci := o.OCIReference()
+	switch {
+	case o.Source != nil && o.Source.Git != nil:
+		allErrs = append(allErrs, field.Forbidden(fldPath, "addons from git repositories can't be verified"))
+	case o.Path != "" && !oci:
+		allErrs = append(allErrs, field.Forbidden(fldPath, "addons from the local directory can't be verified"))
+	}
+
+	return allErrs
+}
+
+// validateAddonsSourcePath validates the path of the addons directory in the
+// fetched source doesn't point outside of it
+func validateAddonsSourcePath(p string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if p != "" && (filepath.IsAbs(p) || !filepath.IsLocal(p)) {
+		allErrs = append(allErrs, field.Invalid(fldPath, p, "must be a relative path inside of the source"))
+	}
+
+	return allErrs
+}
+
+func validateAddonDependencies(addon kubeoneapi.Addon, addonsByName map[string]kubeoneapi.Addon, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if addon.Delete && len(addon.DependsOn) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "dependencies can't be set for the addon marked for deletion"))
+
+		return allErrs
+	}
+
+	seen := sets.New[string]()
+	for i, dep := range addon.DependsOn {
+		switch {
+		case dep == "":
+			allErrs = append(allErrs, field.Required(fldPath.Index(i), "addon name is required"))
+		case dep == addon.Name:
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), dep, "addon can't depend on itself"))
+		case seen.Has(dep):
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), dep))
+		case addonsByName[dep].Delete:
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), dep, "addon can't depend on the addon marked for deletion"))
+		}
+		seen.Insert(dep)
+	}
+
+	if addonDependencyCycle(addon.Name, addonsByName, sets.New[string]()) {
+		allErrs = append(allErrs, field.Invalid(fldPath, addon.DependsOn, "addon dependencies form a cycle"))
+	}
+
+	return allErrs
+}
+
+// addonDependencyCycle returns true if the addon transitively depends on
+// itself. Dependencies on the addons that are not listed in the addons
+// configuration can't form a cycle, as they don't have dependencies.
+func addonDependencyCycle(name string, addonsByName map[string]kubeoneapi.Addon, visiting sets.Set[string]) bool {
+	if visiting.Has(name) {
+		return true
+	}
+
+	visiting.Insert(name)
+	defer visiting.Delete(name)
+
+	for _, dep := range addonsByName[name].DependsOn {
+		if dep != name && addonDependencyCycle(dep, addonsByName, visiting) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validateAddonReadinessChecks(checks []kubeoneapi.AddonReadinessCheck, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, check := range checks {
+		checkPath := fldPath.Index(i)
+
+		switch check.Kind {
+		case kubeoneapi.AddonReadinessCheckKindDeployment,
+			kubeoneapi.AddonReadinessCheckKindStatefulSet,
+			kubeoneapi.AddonReadinessCheckKindDaemonSet,
+			kubeoneapi.AddonReadinessCheckKindJob:
+			if check.Namespace == "" {
+				allErrs = append(allErrs, field.Required(checkPath.Child("namespace"), fmt.Sprintf("namespace is required for %s", check.Kind)))
+			}
+		case kubeoneapi.AddonReadinessCheckKindCustomResourceDefinition:
+			if check.Namespace != "" {
+				allErrs = append(allErrs, field.Forbidden(checkPath.Child("namespace"), "CustomResourceDefinition is not namespaced"))
+			}
+		default:
+			allErrs = append(allErrs, field.NotSupported(checkPath.Child("kind"), check.Kind, []string{
+				string(kubeoneapi.AddonReadinessCheckKindDeployment),
+				string(kubeoneapi.AddonReadinessCheckKindStatefulSet),
+				string(kubeoneapi.AddonReadinessCheckKindDaemonSet),
+				string(kubeoneapi.AddonReadinessCheckKindJob),
+				string(kubeoneapi.AddonReadinessCheckKindCustomResourceDefinition),
+			}))
+		}
+
+		if check.Name == "" {
+			allErrs = append(allErrs, field.Required(checkPath.Child("name"), "name is required"))
+		}
 	}
 
 	return allErrs
 }
 
-// ValidateOIDCConfig validates the OpenIDConnectConfig structure
-func ValidateOIDCConfig(o kubeoneapi.OpenIDConnectConfig, fldPath *field.Path) field.ErrorList {
+// validateAddonPhase validates the lifecycle phase of the addon. The addon is
+// applied along with the other addons of its phase, so it can depend only on
+// the listed addons applied in the same phase.
+func validateAddonPhase(addon kubeoneapi.Addon, addonsByName map[string]kubeoneapi.Addon, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	if len(o.IssuerURL) == 0 {
-		allErrs = append(allErrs, field.Required(fldPath.Child("issuerURL"), ".openidConnect.config.issuerURL is a required field"))
+	switch addon.Phase {
+	case "",
+		kubeoneapi.AddonPhasePreKubeadm,
+		kubeoneapi.AddonPhasePostJoin,
+		kubeoneapi.AddonPhasePostUpgrade,
+		kubeoneapi.AddonPhasePreReset:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("phase"), addon.Phase, []string{
+			string(kubeoneapi.AddonPhasePreKubeadm),
+			string(kubeoneapi.AddonPhasePostJoin),
+			string(kubeoneapi.AddonPhasePostUpgrade),
+			string(kubeoneapi.AddonPhasePreReset),
+		}))
+
+		return allErrs
 	}
-	if len(o.ClientID) == 0 {
-		allErrs = append(allErrs, field.Required(fldPath.Child("clientID"), ".openidConnect.config.clientID is a required field"))
+
+	if addon.Phase != "" && addon.Delete {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("phase"), "phase can't be set for the addon marked for deletion"))
+	}
+
+	for i, dep := range addon.DependsOn {
+		depAddon, ok := addonsByName[dep]
+		if ok && depAddon.Phase != addon.Phase {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("dependsOn").Index(i), dep, "addon can depend only on the addons applied in the same phase"))
+		}
 	}
 
 	return allErrs
 }
 
-// ValidateAddons validates the Addons configuration
-func ValidateAddons(o *kubeoneapi.Addons, fldPath *field.Path) field.ErrorList {
+// validateCNIAddons validates that the addons don't deploy another CNI plugin
+// alongside the configured one
+func validateCNIAddons(cni *kubeoneapi.CNI, addons *kubeoneapi.Addons, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	if o == nil || !o.Enable {
+	if cni == nil || cni.Calico == nil || !addons.Enabled() {
 		return allErrs
 	}
-	if o.Enable && len(o.Path) == 0 {
-		// Addons are enabled, path is empty, and no embedded addon is specified
-		if len(o.Addons) == 0 {
-			allErrs = append(allErrs, field.Invalid(fldPath.Child("enable"), o.Enable, ".addons.enable cannot be set to true without specifying either custom addon path or embedded addon"))
-		}
 
-		// Check if only embedded addons are being used; path is not required for embedded addons
-		embeddedAddonsOnly, err := addons.EmbeddedAddonsOnly(o.Addons)
-		if err != nil {
-			allErrs = append(allErrs, field.Invalid(fldPath, "", "failed to read embedded addons directory"))
-		} else if !embeddedAddonsOnly {
-			allErrs = append(allErrs, field.Invalid(fldPath.Child("path"), "", ".addons.path must be specified when using non-embedded addon(s)"))
+	for i, addon := range addons.Addons {
+		if addon.Name == "calico-vxlan" && !addon.Delete {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Index(i), "the calico-vxlan addon can't be used with the calico cni plugin"))
 		}
 	}
 
@@ -703,17 +2550,42 @@ func ValidateAddons(o *kubeoneapi.Addons, fldPath *field.Path) field.ErrorList {
 func ValidateHelmReleases(helmReleases []kubeoneapi.HelmRelease, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	for _, hr := range helmReleases {
+	releases := map[string]bool{}
+	for i, hr := range helmReleases {
+		hrPath := fldPath.Index(i)
+
 		if hr.Chart == "" {
-			allErrs = append(allErrs, field.Required(fldPath.Child("chart"), hr.Chart))
+			allErrs = append(allErrs, field.Required(hrPath.Child("chart"), hr.Chart))
+		}
+		if strings.HasPrefix(hr.Chart, "oci://") {
+			if hr.RepoURL != "" {
+				allErrs = append(allErrs, field.Forbidden(hrPath.Child("repoURL"), "repoURL can't be used with an OCI chart reference"))
+			}
+			if hr.ChartURL != "" {
+				allErrs = append(allErrs, field.Forbidden(hrPath.Child("chartURL"), "chartURL can't be used with an OCI chart reference"))
+			}
 		}
 
 		if hr.Namespace == "" {
-			allErrs = append(allErrs, field.Required(fldPath.Child("namespace"), hr.Namespace))
+			allErrs = append(allErrs, field.Required(hrPath.Child("namespace"), hr.Namespace))
+		} else {
+			for _, err := range validation.IsDNS1123Label(hr.Namespace) {
+				allErrs = append(allErrs, field.Invalid(hrPath.Child("namespace"), hr.Namespace, err))
+			}
+		}
+
+		// helm limits the release names to 53 characters
+		if errs := validation.IsDNS1123Subdomain(hr.ReleaseName); len(errs) > 0 || len(hr.ReleaseName) > 53 {
+			allErrs = append(allErrs, field.Invalid(hrPath.Child("releaseName"), hr.ReleaseName, "releaseName must be a valid DNS subdomain of at most 53 characters"))
+		}
+		release := hr.Namespace + "/" + hr.ReleaseName
+		if releases[release] {
+			allErrs = append(allErrs, field.Duplicate(hrPath.Child("releaseName"), hr.ReleaseName))
 		}
+		releases[release] = true
 
 		for idx, helmValues := range hr.Values {
-			fldIdentity := fldPath.Child("values").Index(idx)
+			fldIdentity := hrPath.Child("values").Index(idx)
 
 			if helmValues.ValuesFile != "" {
 				err := func() error {
@@ -772,7 +2644,8 @@ func ValidateHostConfig(hosts []kubeoneapi.HostConfig, version kubeoneapi.Versio
 		if (clusterNetwork.IPFamily == kubeoneapi.IPFamilyIPv6 || clusterNetwork.IPFamily == kubeoneapi.IPFamilyIPv4IPv6 || clusterNetwork.IPFamily == kubeoneapi.IPFamilyIPv6IPv4) && len(h.IPv6Addresses) == 0 {
 			allErrs = append(allErrs, field.Required(fldPath, "no IPv6 address given"))
 		}
-		if len(h.PrivateAddress) == 0 {
+		// hosts in IPv6-only clusters are reached using their IPv6 addresses
+		if len(h.PrivateAddress) == 0 && clusterNetwork.IPFamily != kubeoneapi.IPFamilyIPv6 {
 			allErrs = append(allErrs, field.Required(fldPath, "no private IP/address givevn"))
 		}
 		if len(h.SSHPrivateKeyFile) == 0 && len(h.SSHAgentSocket) == 0 {
@@ -793,6 +2666,12 @@ func ValidateHostConfig(hosts []kubeoneapi.HostConfig, version kubeoneapi.Versio
 				allErrs = append(allErrs, field.Invalid(fldPath.Child("labels"), labelValue, "label to remove cannot have value"))
 			}
 		}
+		for _, msg := range validation.IsValidLabelValue(h.Zone) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("zone"), h.Zone, msg))
+		}
+		if h.BMC != nil {
+			allErrs = append(allErrs, validateBMCConfig(*h.BMC, fldPath.Child("bmc"))...)
+		}
 		if gte125Constraint.Check(v) {
 			for _, taint := range h.Taints {
 				if taint.Key == "node-role.kubernetes.io/master" {
@@ -805,6 +2684,48 @@ func ValidateHostConfig(hosts []kubeoneapi.HostConfig, version kubeoneapi.Versio
 	return allErrs
 }
 
+func validateBMCConfig(bmc kubeoneapi.BMCConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch bmc.Protocol {
+	case kubeoneapi.BMCProtocolRedfish:
+		if u, err := url.Parse(bmc.Address); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("address"), bmc.Address, "address must be a http(s) URL for the Redfish protocol"))
+		}
+	case kubeoneapi.BMCProtocolIPMI:
+		if bmc.Address == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("address"), "address of the BMC is required"))
+		}
+		if bmc.BootDevice == kubeoneapi.BMCBootDeviceVirtualMedia {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("bootDevice"), "virtual media is supported only by the Redfish protocol"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("protocol"), bmc.Protocol, []string{string(kubeoneapi.BMCProtocolRedfish), string(kubeoneapi.BMCProtocolIPMI)}))
+	}
+
+	if bmc.Username == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("username"), "username of the BMC is required"))
+	}
+	if bmc.Password == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("password"), "password of the BMC is required"))
+	}
+
+	switch bmc.BootDevice {
+	case kubeoneapi.BMCBootDevicePXE:
+		if bmc.ImageURL != "" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("imageURL"), "imageURL can be used only with the VirtualMedia boot device"))
+		}
+	case kubeoneapi.BMCBootDeviceVirtualMedia:
+		if bmc.ImageURL == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("imageURL"), "imageURL is required for the VirtualMedia boot device"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("bootDevice"), bmc.BootDevice, []string{string(kubeoneapi.BMCBootDevicePXE), string(kubeoneapi.BMCBootDeviceVirtualMedia)}))
+	}
+
+	return allErrs
+}
+
 func ValidateRegistryConfiguration(r *kubeoneapi.RegistryConfiguration, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -819,6 +2740,362 @@ func ValidateRegistryConfiguration(r *kubeoneapi.RegistryConfiguration, fldPath
 	return allErrs
 }
 
+// ValidateSystemPackages validates the SystemPackages structure
+func ValidateSystemPackages(sp *kubeoneapi.SystemPackages, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if sp == nil || sp.Repositories == nil {
+		return allErrs
+	}
+
+	if !sp.ConfigureRepositories {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("repositories"), "repositories require configureRepositories to be enabled"))
+	}
+
+	allErrs = append(allErrs, validatePackageRepository(sp.Repositories.Kubernetes, fldPath.Child("repositories", "kubernetes"))...)
+	allErrs = append(allErrs, validatePackageRepository(sp.Repositories.ContainerRuntime, fldPath.Child("repositories", "containerRuntime"))...)
+
+	return allErrs
+}
+
+func validatePackageRepository(r *kubeoneapi.PackageRepository, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if r == nil {
+		return allErrs
+	}
+
+	if u, err := url.Parse(r.URL); err != nil || (u.Scheme != "https" && u.Scheme != "http" && u.Scheme != "file") {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("url"), r.URL, "url must be a valid http, https or file URL"))
+	}
+	if len(r.Components) > 0 && r.Suite == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("suite"), "suite is required when components are set"))
+	}
+	if r.Suite != "" && len(r.Components) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("components"), "components are required when suite is set"))
+	}
+	if r.GPGKeyURL != "" && r.GPGKey != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("gpgKey"), "only one of gpgKeyURL and gpgKey can be set"))
+	}
+	if r.GPGKey != "" && !strings.Contains(r.GPGKey, "BEGIN PGP PUBLIC KEY BLOCK") {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("gpgKey"), "<redacted>", "gpgKey must be an ASCII-armored GPG public key"))
+	}
+
+	return allErrs
+}
+
+// ValidateControlPlaneComponents validates the ControlPlaneComponents structure
+func ValidateControlPlaneComponents(c *kubeoneapi.ControlPlaneComponents, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if c == nil {
+		return allErrs
+	}
+
+	allErrs = append(allErrs, validateControlPlaneComponentConfig(c.ControllerManager, fldPath.Child("controllerManager"))...)
+	allErrs = append(allErrs, validateControlPlaneComponentConfig(c.Scheduler, fldPath.Child("scheduler"))...)
+	allErrs = append(allErrs, validateControlPlaneComponentConfig(c.APIServer, fldPath.Child("apiServer"))...)
+
+	if c.Patches != nil && c.Patches.Directory == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("patches", "directory"), "directory is required"))
+	}
+
+	return allErrs
+}
+
+func validateControlPlaneComponentConfig(c *kubeoneapi.ControlPlaneComponentConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if c == nil {
+		return allErrs
+	}
+
+	if _, ok := c.Flags["feature-gates"]; ok {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("flags").Key("feature-gates"), "feature-gates can't be set using flags, use featureGates instead"))
+	}
+
+	names := map[string]bool{}
+	for i, vol := range c.ExtraVolumes {
+		volPath := fldPath.Child("extraVolumes").Index(i)
+		if vol.Name == "" {
+			allErrs = append(allErrs, field.Required(volPath.Child("name"), "name is required"))
+		} else if names[vol.Name] {
+			allErrs = append(allErrs, field.Duplicate(volPath.Child("name"), vol.Name))
+		}
+		names[vol.Name] = true
+
+		if vol.HostPath == "" {
+			allErrs = append(allErrs, field.Required(volPath.Child("hostPath"), "hostPath is required"))
+		}
+		if vol.MountPath == "" {
+			allErrs = append(allErrs, field.Required(volPath.Child("mountPath"), "mountPath is required"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateBackupsConfig validates the BackupsConfig structure
+func ValidateBackupsConfig(b *kubeoneapi.BackupsConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if b == nil || b.Etcd == nil || !b.Etcd.Enable {
+		return allErrs
+	}
+
+	etcdPath := fldPath.Child("etcd")
+
+	if b.Etcd.Schedule == "" {
+		allErrs = append(allErrs, field.Required(etcdPath.Child("schedule"), "schedule is required"))
+	}
+	if b.Etcd.Retention < 1 {
+		allErrs = append(allErrs, field.Invalid(etcdPath.Child("retention"), b.Etcd.Retention, "retention must be greater than 0"))
+	}
+
+	targetPath := etcdPath.Child("target")
+	switch {
+	case b.Etcd.Target.Bucket == "":
+		allErrs = append(allErrs, field.Required(targetPath.Child("bucket"), "bucket is required"))
+	case strings.Contains(b.Etcd.Target.Bucket, "/"):
+		allErrs = append(allErrs, field.Invalid(targetPath.Child("bucket"), b.Etcd.Target.Bucket, "bucket must not contain '/', use prefix instead"))
+	}
+	if strings.Contains(b.Etcd.Target.Endpoint, "://") {
+		allErrs = append(allErrs, field.Invalid(targetPath.Child("endpoint"), b.Etcd.Target.Endpoint, "endpoint must not contain the scheme"))
+	}
+
+	return allErrs
+}
+
+// ValidateCertificateAuthorityConfig validates the CertificateAuthorityConfig structure
+func ValidateCertificateAuthorityConfig(ca *kubeoneapi.CertificateAuthorityConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if ca == nil {
+		return allErrs
+	}
+
+	if ca.Kubernetes == nil && ca.Etcd == nil && ca.FrontProxy == nil {
+		allErrs = append(allErrs, field.Required(fldPath, "at least one of kubernetes, etcd or frontProxy must be set"))
+	}
+
+	allErrs = append(allErrs, validateCertificateAuthoritySource(ca.Kubernetes, fldPath.Child("kubernetes"))...)
+	allErrs = append(allErrs, validateCertificateAuthoritySource(ca.Etcd, fldPath.Child("etcd"))...)
+	allErrs = append(allErrs, validateCertificateAuthoritySource(ca.FrontProxy, fldPath.Child("frontProxy"))...)
+
+	return allErrs
+}
+
+func validateCertificateAuthoritySource(source *kubeoneapi.CertificateAuthoritySource, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if source == nil {
+		return allErrs
+	}
+
+	files := source.CertificateFile != "" || source.KeyFile != ""
+
+	switch {
+	case files && source.Vault != nil:
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("vault"), "only one of the files or vault can be set"))
+	case files:
+		if source.CertificateFile == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("certificateFile"), "certificateFile is required together with keyFile"))
+		}
+		if source.KeyFile == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("keyFile"), "keyFile is required together with certificateFile"))
+		}
+	case source.Vault != nil:
+		vaultPath := fldPath.Child("vault")
+		if strings.Trim(source.Vault.Path, "/") == "" {
+			allErrs = append(allErrs, field.Required(vaultPath.Child("path"), "path is required"))
+		}
+		if source.Vault.CertificateKey != "" && source.Vault.CertificateKey == source.Vault.PrivateKeyKey {
+			allErrs = append(allErrs, field.Invalid(vaultPath.Child("privateKeyKey"), source.Vault.PrivateKeyKey, "privateKeyKey must be different from certificateKey"))
+		}
+	default:
+		allErrs = append(allErrs, field.Required(fldPath, "one of the files or vault must be set"))
+	}
+
+	return allErrs
+}
+
+// ValidateUpgradesConfig validates the UpgradesConfig structure
+func ValidateUpgradesConfig(u *kubeoneapi.UpgradesConfig, versions kubeoneapi.VersionConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if u == nil {
+		return allErrs
+	}
+
+	allErrs = append(allErrs, validateIntermediateVersions(u.IntermediateVersions, versions.Kubernetes, fldPath.Child("intermediateVersions"))...)
+	allErrs = append(allErrs, validateMaintenanceWindows(u.MaintenanceWindows, fldPath.Child("maintenanceWindows"))...)
+	allErrs = append(allErrs, validateOperatingSystemUpgrade(u.OperatingSystem, fldPath.Child("operatingSystem"))...)
+	allErrs = append(allErrs, validateUnattendedUpgrades(u.Unattended, fldPath.Child("unattended"))...)
+
+	if u.StaticWorkers != nil && u.StaticWorkers.MaxUnavailable != nil {
+		maxUnavailable := u.StaticWorkers.MaxUnavailable
+		if value, err := intstr.GetScaledValueFromIntOrPercent(maxUnavailable, 100, false); err != nil || value < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("staticWorkers", "maxUnavailable"), maxUnavailable.String(), "maxUnavailable must be a positive number or percentage"))
+		}
+	}
+
+	if u.Canary != nil && u.Canary.SoakTime != nil && u.Canary.SoakTime.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("canary", "soakTime"), u.Canary.SoakTime.Duration.String(), "soakTime must be greater than zero"))
+	}
+
+	if u.Drain == nil {
+		return allErrs
+	}
+
+	drainPath := fldPath.Child("drain")
+
+	if u.Drain.Timeout != nil && u.Drain.Timeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(drainPath.Child("timeout"), u.Drain.Timeout.Duration.String(), "timeout must be greater than zero"))
+	}
+
+	for i, namespace := range u.Drain.SkipNamespaces {
+		for _, err := range validation.IsDNS1123Label(namespace) {
+			allErrs = append(allErrs, field.Invalid(drainPath.Child("skipNamespaces").Index(i), namespace, err))
+		}
+	}
+
+	if u.Drain.SkipPodSelector != "" {
+		if _, err := labels.Parse(u.Drain.SkipPodSelector); err != nil {
+			allErrs = append(allErrs, field.Invalid(drainPath.Child("skipPodSelector"), u.Drain.SkipPodSelector, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+// validateIntermediateVersions validates that the intermediate versions are
+// supported, and that there is at most one version for each minor version
+// lower than the target version
+func validateIntermediateVersions(intermediateVersions []string, target string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	targetVersion, err := semver.NewVersion(target)
+	if err != nil {
+		// the target version is validated by ValidateVersionConfig
+		return allErrs
+	}
+
+	var previous *semver.Version
+	for i, version := range intermediateVersions {
+		idxPath := fldPath.Index(i)
+
+		v, err := semver.NewVersion(version)
+		if err != nil || strings.HasPrefix(version, "v") {
+			allErrs = append(allErrs, field.Invalid(idxPath, version, "intermediate version must be a semver string without a leading 'v'"))
+
+			continue
+		}
+
+		if !lowerConstraint.Check(v) || !upperConstraint.Check(v) {
+			allErrs = append(allErrs, field.Invalid(idxPath, version, fmt.Sprintf("intermediate version must satisfy version constraints '%s' and '%s'", lowerVersionConstraint, upperVersionConstraint)))
+		}
+		if v.Major() != targetVersion.Major() || v.Minor() >= targetVersion.Minor() {
+			allErrs = append(allErrs, field.Invalid(idxPath, version, fmt.Sprintf("intermediate version must be of a lower minor version than %s", target)))
+		}
+		if previous != nil && v.Minor() <= previous.Minor() {
+			allErrs = append(allErrs, field.Invalid(idxPath, version, "intermediate versions must be sorted, with at most one version for each minor version"))
+		}
+		previous = v
+	}
+
+	return allErrs
+}
+
+// validateMaintenanceWindows validates the days, the start time, the
+// duration and the time zone of the maintenance windows
+func validateMaintenanceWindows(windows []kubeoneapi.MaintenanceWindow, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, window := range windows {
+		allErrs = append(allErrs, validateMaintenanceWindow(window, fldPath.Index(i))...)
+	}
+
+	return allErrs
+}
+
+// validateMaintenanceWindow validates the days, the start time, the duration
+// and the time zone of a single maintenance window
+func validateMaintenanceWindow(window kubeoneapi.MaintenanceWindow, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	weekdays := sets.New[string]()
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		weekdays.Insert(day.String())
+	}
+
+	for i, day := range window.Days {
+		if !weekdays.Has(day) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("days").Index(i), day, sets.List(weekdays)))
+		}
+	}
+
+	if _, err := time.Parse("15:04", window.Start); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("start"), window.Start, "start must be a time of the day in the HH:MM format"))
+	}
+
+	if window.Duration.Duration <= 0 || window.Duration.Duration > 24*time.Hour {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("duration"), window.Duration.Duration.String(), "duration must be greater than zero and at most 24h"))
+	}
+
+	if _, err := time.LoadLocation(window.TimeZone); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("timeZone"), window.TimeZone, err.Error()))
+	}
+
+	return allErrs
+}
+
+// validateUnattendedUpgrades validates the reboot window and the names of
+// the packages excluded from the unattended upgrades
+func validateUnattendedUpgrades(u *kubeoneapi.UnattendedUpgradesConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if u == nil {
+		return allErrs
+	}
+
+	if u.RebootWindow != nil {
+		allErrs = append(allErrs, validateMaintenanceWindow(*u.RebootWindow, fldPath.Child("rebootWindow"))...)
+	}
+
+	for i, pkg := range u.PackageBlacklist {
+		if !packageNameRegexp.MatchString(pkg) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("packageBlacklist").Index(i), pkg, "package name must consist of alphanumeric characters, '.', '_', '+', '-' and '*'"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateOperatingSystemUpgrade validates the target Ubuntu release and the
+// MachineDeployment images
+func validateOperatingSystemUpgrade(o *kubeoneapi.OperatingSystemUpgradeConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if o == nil {
+		return allErrs
+	}
+
+	if o.UbuntuRelease != "" && !ubuntuReleaseRegexp.MatchString(o.UbuntuRelease) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ubuntuRelease"), o.UbuntuRelease, "ubuntuRelease must be an Ubuntu release version, e.g. 24.04"))
+	}
+
+	for name, image := range o.MachineDeploymentImages {
+		for _, err := range validation.IsDNS1123Subdomain(name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("machineDeploymentImages"), name, err))
+		}
+		if image == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("machineDeploymentImages").Key(name), "image of the new operating system release is required"))
+		}
+	}
+
+	return allErrs
+}
+
 func ValidateAssetConfiguration(a *kubeoneapi.AssetConfiguration, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
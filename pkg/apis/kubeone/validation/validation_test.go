@@ -18,6 +18,7 @@ package validation
 
 import (
 	"testing"
+	"time"
 
 	"github.com/MakeNowJust/heredoc/v2"
 
@@ -26,6 +27,9 @@ import (
 	"k8c.io/kubeone/pkg/templates/resources"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
@@ -395,6 +399,338 @@ func TestValidateControlPlaneConfig(t *testing.T) {
 			controlPlaneConfig: kubeoneapi.ControlPlaneConfig{},
 			expectedError:      true,
 		},
+		{
+			name: "control plane spread across zones",
+			controlPlaneConfig: kubeoneapi.ControlPlaneConfig{
+				Hosts: []kubeoneapi.HostConfig{
+					{
+						PublicAddress:  "1.1.1.1",
+						PrivateAddress: "10.0.0.1",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+						Zone:           "zone-a",
+					},
+					{
+						PublicAddress:  "1.1.1.2",
+						PrivateAddress: "10.0.0.2",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+						Zone:           "zone-b",
+					},
+					{
+						PublicAddress:  "1.1.1.3",
+						PrivateAddress: "10.0.0.3",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+						Zone:           "zone-c",
+					},
+				},
+				RequireZoneSpread: true,
+			},
+			expectedError: false,
+		},
+		{
+			name: "control plane quorum in a single zone",
+			controlPlaneConfig: kubeoneapi.ControlPlaneConfig{
+				Hosts: []kubeoneapi.HostConfig{
+					{
+						PublicAddress:  "1.1.1.1",
+						PrivateAddress: "10.0.0.1",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+						Zone:           "zone-a",
+					},
+					{
+						PublicAddress:  "1.1.1.2",
+						PrivateAddress: "10.0.0.2",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+						Zone:           "zone-a",
+					},
+					{
+						PublicAddress:  "1.1.1.3",
+						PrivateAddress: "10.0.0.3",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+						Zone:           "zone-b",
+					},
+				},
+				RequireZoneSpread: true,
+			},
+			expectedError: true,
+		},
+		{
+			name: "4 control plane hosts evenly split across 2 zones",
+			controlPlaneConfig: kubeoneapi.ControlPlaneConfig{
+				Hosts: []kubeoneapi.HostConfig{
+					{
+						PublicAddress:  "1.1.1.1",
+						PrivateAddress: "10.0.0.1",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+						Zone:           "zone-a",
+					},
+					{
+						PublicAddress:  "1.1.1.2",
+						PrivateAddress: "10.0.0.2",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+						Zone:           "zone-a",
+					},
+					{
+						PublicAddress:  "1.1.1.3",
+						PrivateAddress: "10.0.0.3",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+						Zone:           "zone-b",
+					},
+					{
+						PublicAddress:  "1.1.1.4",
+						PrivateAddress: "10.0.0.4",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+						Zone:           "zone-b",
+					},
+				},
+				RequireZoneSpread: true,
+			},
+			expectedError: true,
+		},
+		{
+			name: "4 control plane hosts spread across 4 zones",
+			controlPlaneConfig: kubeoneapi.ControlPlaneConfig{
+				Hosts: []kubeoneapi.HostConfig{
+					{
+						PublicAddress:  "1.1.1.1",
+						PrivateAddress: "10.0.0.1",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+						Zone:           "zone-a",
+					},
+					{
+						PublicAddress:  "1.1.1.2",
+						PrivateAddress: "10.0.0.2",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+						Zone:           "zone-b",
+					},
+					{
+						PublicAddress:  "1.1.1.3",
+						PrivateAddress: "10.0.0.3",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+						Zone:           "zone-c",
+					},
+					{
+						PublicAddress:  "1.1.1.4",
+						PrivateAddress: "10.0.0.4",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+						Zone:           "zone-d",
+					},
+				},
+				RequireZoneSpread: true,
+			},
+			expectedError: false,
+		},
+		{
+			name: "6 control plane hosts evenly split across 2 zones",
+			controlPlaneConfig: kubeoneapi.ControlPlaneConfig{
+				Hosts: []kubeoneapi.HostConfig{
+					{
+						PublicAddress:  "1.1.1.1",
+						PrivateAddress: "10.0.0.1",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+						Zone:           "zone-a",
+					},
+					{
+						PublicAddress:  "1.1.1.2",
+						PrivateAddress: "10.0.0.2",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+						Zone:           "zone-a",
+					},
+					{
+						PublicAddress:  "1.1.1.3",
+						PrivateAddress: "10.0.0.3",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+						Zone:           "zone-a",
+					},
+					{
+						PublicAddress:  "1.1.1.4",
+						PrivateAddress: "10.0.0.4",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+						Zone:           "zone-b",
+					},
+					{
+						PublicAddress:  "1.1.1.5",
+						PrivateAddress: "10.0.0.5",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+						Zone:           "zone-b",
+					},
+					{
+						PublicAddress:  "1.1.1.6",
+						PrivateAddress: "10.0.0.6",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+						Zone:           "zone-b",
+					},
+				},
+				RequireZoneSpread: true,
+			},
+			expectedError: true,
+		},
+		{
+			name: "6 control plane hosts evenly split across 3 zones",
+			controlPlaneConfig: kubeoneapi.ControlPlaneConfig{
+				Hosts: []kubeoneapi.HostConfig{
+					{
+						PublicAddress:  "1.1.1.1",
+						PrivateAddress: "10.0.0.1",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+						Zone:           "zone-a",
+					},
+					{
+						PublicAddress:  "1.1.1.2",
+						PrivateAddress: "10.0.0.2",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+						Zone:           "zone-a",
+					},
+					{
+						PublicAddress:  "1.1.1.3",
+						PrivateAddress: "10.0.0.3",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+						Zone:           "zone-b",
+					},
+					{
+						PublicAddress:  "1.1.1.4",
+						PrivateAddress: "10.0.0.4",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+						Zone:           "zone-b",
+					},
+					{
+						PublicAddress:  "1.1.1.5",
+						PrivateAddress: "10.0.0.5",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+						Zone:           "zone-c",
+					},
+					{
+						PublicAddress:  "1.1.1.6",
+						PrivateAddress: "10.0.0.6",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+						Zone:           "zone-c",
+					},
+				},
+				RequireZoneSpread: true,
+			},
+			expectedError: false,
+		},
+		{
+			name: "5 control plane hosts spread across 3 zones",
+			controlPlaneConfig: kubeoneapi.ControlPlaneConfig{
+				Hosts: []kubeoneapi.HostConfig{
+					{
+						PublicAddress:  "1.1.1.1",
+						PrivateAddress: "10.0.0.1",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+						Zone:           "zone-a",
+					},
+					{
+						PublicAddress:  "1.1.1.2",
+						PrivateAddress: "10.0.0.2",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+						Zone:           "zone-a",
+					},
+					{
+						PublicAddress:  "1.1.1.3",
+						PrivateAddress: "10.0.0.3",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+						Zone:           "zone-b",
+					},
+					{
+						PublicAddress:  "1.1.1.4",
+						PrivateAddress: "10.0.0.4",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+						Zone:           "zone-b",
+					},
+					{
+						PublicAddress:  "1.1.1.5",
+						PrivateAddress: "10.0.0.5",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+						Zone:           "zone-c",
+					},
+				},
+				RequireZoneSpread: true,
+			},
+			expectedError: false,
+		},
+		{
+			name: "control plane zone spread without zones",
+			controlPlaneConfig: kubeoneapi.ControlPlaneConfig{
+				Hosts: []kubeoneapi.HostConfig{
+					{
+						PublicAddress:  "1.1.1.1",
+						PrivateAddress: "10.0.0.1",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+						Zone:           "zone-a",
+					},
+					{
+						PublicAddress:  "1.1.1.2",
+						PrivateAddress: "10.0.0.2",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+						Zone:           "zone-b",
+					},
+					{
+						PublicAddress:  "1.1.1.3",
+						PrivateAddress: "10.0.0.3",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+					},
+				},
+				RequireZoneSpread: true,
+			},
+			expectedError: true,
+		},
+		{
+			name: "control plane zone spread with too few hosts",
+			controlPlaneConfig: kubeoneapi.ControlPlaneConfig{
+				Hosts: []kubeoneapi.HostConfig{
+					{
+						PublicAddress:  "1.1.1.1",
+						PrivateAddress: "10.0.0.1",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+						Zone:           "zone-a",
+					},
+					{
+						PublicAddress:  "1.1.1.2",
+						PrivateAddress: "10.0.0.2",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+						Zone:           "zone-b",
+					},
+				},
+				RequireZoneSpread: true,
+			},
+			expectedError: true,
+		},
 	}
 	for _, tc := range tests {
 		tc := tc
@@ -462,19 +798,75 @@ func TestValidateAPIEndpoint(t *testing.T) {
 			},
 			expectedError: true,
 		},
-	}
-	for _, tc := range tests {
-		tc := tc
-		t.Run(tc.name, func(t *testing.T) {
-			errs := ValidateAPIEndpoint(tc.apiEndpoint, nil)
-			if (len(errs) == 0) == tc.expectedError {
-				t.Errorf("test case failed: expected %v, but got %v", tc.expectedError, (len(errs) != 0))
-			}
-		})
-	}
-}
-
-func TestValidateCloudProviderSpec(t *testing.T) {
+		{
+			name: "valid additional endpoints",
+			apiEndpoint: kubeoneapi.APIEndpoint{
+				Host: "lb-a.example.com",
+				Port: 6443,
+				AdditionalEndpoints: []kubeoneapi.AdditionalAPIEndpoint{
+					{Host: "lb-b.example.com", Port: 6443},
+					{Host: "lb-c.example.com", Port: 443, Priority: 1},
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "additional endpoint without host",
+			apiEndpoint: kubeoneapi.APIEndpoint{
+				Host: "lb-a.example.com",
+				Port: 6443,
+				AdditionalEndpoints: []kubeoneapi.AdditionalAPIEndpoint{
+					{Port: 6443},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "additional endpoint with invalid port",
+			apiEndpoint: kubeoneapi.APIEndpoint{
+				Host: "lb-a.example.com",
+				Port: 6443,
+				AdditionalEndpoints: []kubeoneapi.AdditionalAPIEndpoint{
+					{Host: "lb-b.example.com", Port: 65536},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "additional endpoint with negative priority",
+			apiEndpoint: kubeoneapi.APIEndpoint{
+				Host: "lb-a.example.com",
+				Port: 6443,
+				AdditionalEndpoints: []kubeoneapi.AdditionalAPIEndpoint{
+					{Host: "lb-b.example.com", Port: 6443, Priority: -1},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "additional endpoint duplicating the API endpoint",
+			apiEndpoint: kubeoneapi.APIEndpoint{
+				Host: "lb-a.example.com",
+				Port: 6443,
+				AdditionalEndpoints: []kubeoneapi.AdditionalAPIEndpoint{
+					{Host: "lb-a.example.com", Port: 6443, Priority: 1},
+				},
+			},
+			expectedError: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			errs := ValidateAPIEndpoint(tc.apiEndpoint, nil)
+			if (len(errs) == 0) == tc.expectedError {
+				t.Errorf("test case failed: expected %v, but got %v", tc.expectedError, (len(errs) != 0))
+			}
+		})
+	}
+}
+
+func TestValidateCloudProviderSpec(t *testing.T) {
 	tests := []struct {
 		name           string
 		providerConfig kubeoneapi.CloudProviderSpec
@@ -488,6 +880,24 @@ func TestValidateCloudProviderSpec(t *testing.T) {
 			},
 			expectedError: false,
 		},
+		{
+			name: "valid AWS provider config with instance profile credentials",
+			providerConfig: kubeoneapi.CloudProviderSpec{
+				AWS: &kubeoneapi.AWSSpec{
+					CredentialsMode: kubeoneapi.AWSCredentialsModeInstanceProfile,
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "AWS provider config with invalid credentials mode",
+			providerConfig: kubeoneapi.CloudProviderSpec{
+				AWS: &kubeoneapi.AWSSpec{
+					CredentialsMode: "IRSA",
+				},
+			},
+			expectedError: true,
+		},
 		{
 			name: "valid Azure provider config",
 			providerConfig: kubeoneapi.CloudProviderSpec{
@@ -496,6 +906,58 @@ func TestValidateCloudProviderSpec(t *testing.T) {
 			},
 			expectedError: false,
 		},
+		{
+			name: "valid Azure provider config with managed identity",
+			providerConfig: kubeoneapi.CloudProviderSpec{
+				Azure: &kubeoneapi.AzureSpec{
+					CredentialsMode:        kubeoneapi.AzureCredentialsModeManagedIdentity,
+					UserAssignedIdentityID: "00000000-0000-0000-0000-000000000000",
+				},
+				CloudConfig: "cloud-config",
+			},
+			expectedError: false,
+		},
+		{
+			name: "Azure provider config with user-assigned identity without managed identity mode",
+			providerConfig: kubeoneapi.CloudProviderSpec{
+				Azure: &kubeoneapi.AzureSpec{
+					UserAssignedIdentityID: "00000000-0000-0000-0000-000000000000",
+				},
+				CloudConfig: "cloud-config",
+			},
+			expectedError: true,
+		},
+		{
+			name: "Azure provider config with invalid credentials mode",
+			providerConfig: kubeoneapi.CloudProviderSpec{
+				Azure: &kubeoneapi.AzureSpec{
+					CredentialsMode: "WorkloadIdentity",
+				},
+				CloudConfig: "cloud-config",
+			},
+			expectedError: true,
+		},
+		{
+			name: "valid Azure provider config with VM scale sets and availability zones",
+			providerConfig: kubeoneapi.CloudProviderSpec{
+				Azure: &kubeoneapi.AzureSpec{
+					VMType:            kubeoneapi.AzureVMTypeVMSS,
+					AvailabilityZones: true,
+				},
+				CloudConfig: "cloud-config",
+			},
+			expectedError: false,
+		},
+		{
+			name: "Azure provider config with invalid VM type",
+			providerConfig: kubeoneapi.CloudProviderSpec{
+				Azure: &kubeoneapi.AzureSpec{
+					VMType: "scaleset",
+				},
+				CloudConfig: "cloud-config",
+			},
+			expectedError: true,
+		},
 		{
 			name: "valid DigitalOcean provider config",
 			providerConfig: kubeoneapi.CloudProviderSpec{
@@ -510,6 +972,49 @@ func TestValidateCloudProviderSpec(t *testing.T) {
 			},
 			expectedError: false,
 		},
+		{
+			name: "valid GCE provider config with attached service account",
+			providerConfig: kubeoneapi.CloudProviderSpec{
+				GCE: &kubeoneapi.GCESpec{
+					CredentialsMode: kubeoneapi.GCECredentialsModeAttachedServiceAccount,
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "GCE provider config with invalid credentials mode",
+			providerConfig: kubeoneapi.CloudProviderSpec{
+				GCE: &kubeoneapi.GCESpec{
+					CredentialsMode: "WorkloadIdentity",
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "valid GCE provider config with Shared VPC",
+			providerConfig: kubeoneapi.CloudProviderSpec{
+				GCE: &kubeoneapi.GCESpec{
+					SharedVPC: &kubeoneapi.GCESharedVPCSpec{
+						HostProjectID:  "host-project",
+						NetworkName:    "shared-network",
+						SubnetworkName: "shared-subnetwork",
+					},
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "GCE provider config with Shared VPC without host project",
+			providerConfig: kubeoneapi.CloudProviderSpec{
+				GCE: &kubeoneapi.GCESpec{
+					SharedVPC: &kubeoneapi.GCESharedVPCSpec{
+						NetworkName:    "shared-network",
+						SubnetworkName: "shared-subnetwork",
+					},
+				},
+			},
+			expectedError: true,
+		},
 		{
 			name: "valid Hetzner provider config",
 			providerConfig: kubeoneapi.CloudProviderSpec{
@@ -517,13 +1022,126 @@ func TestValidateCloudProviderSpec(t *testing.T) {
 			},
 			expectedError: false,
 		},
+		{
+			name: "valid Hetzner provider config with robot",
+			providerConfig: kubeoneapi.CloudProviderSpec{
+				Hetzner: &kubeoneapi.HetznerSpec{
+					Robot: true,
+				},
+				External: true,
+			},
+			expectedError: false,
+		},
+		{
+			name: "Hetzner provider config with robot without external cloud provider",
+			providerConfig: kubeoneapi.CloudProviderSpec{
+				Hetzner: &kubeoneapi.HetznerSpec{
+					Robot: true,
+				},
+			},
+			expectedError: true,
+		},
 		{
 			name: "valid Nutanix provider config",
+			providerConfig: kubeoneapi.CloudProviderSpec{
+				Nutanix:  &kubeoneapi.NutanixSpec{},
+				External: true,
+			},
+			expectedError: false,
+		},
+		{
+			name: "Nutanix provider config without external",
 			providerConfig: kubeoneapi.CloudProviderSpec{
 				Nutanix: &kubeoneapi.NutanixSpec{},
 			},
+			expectedError: true,
+		},
+		{
+			name: "valid KubeVirt provider config",
+			providerConfig: kubeoneapi.CloudProviderSpec{
+				Kubevirt: &kubeoneapi.KubevirtSpec{
+					InfraNamespace: "tenant-1",
+				},
+				External: true,
+			},
+			expectedError: false,
+		},
+		{
+			name: "KubeVirt provider config without external",
+			providerConfig: kubeoneapi.CloudProviderSpec{
+				Kubevirt: &kubeoneapi.KubevirtSpec{
+					InfraNamespace: "tenant-1",
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "KubeVirt provider config without infra namespace",
+			providerConfig: kubeoneapi.CloudProviderSpec{
+				Kubevirt: &kubeoneapi.KubevirtSpec{},
+				External: true,
+			},
+			expectedError: true,
+		},
+		{
+			name: "valid OCI provider config",
+			providerConfig: kubeoneapi.CloudProviderSpec{
+				OCI: &kubeoneapi.OCISpec{
+					CompartmentID:         "ocid1.compartment.oc1..aaaa",
+					VCNID:                 "ocid1.vcn.oc1.eu-frankfurt-1.aaaa",
+					LoadBalancerSubnetIDs: []string{"ocid1.subnet.oc1.eu-frankfurt-1.aaaa"},
+				},
+				External: true,
+			},
+			expectedError: false,
+		},
+		{
+			name: "OCI provider config without external",
+			providerConfig: kubeoneapi.CloudProviderSpec{
+				OCI: &kubeoneapi.OCISpec{
+					CompartmentID: "ocid1.compartment.oc1..aaaa",
+					VCNID:         "ocid1.vcn.oc1.eu-frankfurt-1.aaaa",
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "OCI provider config without compartment",
+			providerConfig: kubeoneapi.CloudProviderSpec{
+				OCI: &kubeoneapi.OCISpec{
+					VCNID: "ocid1.vcn.oc1.eu-frankfurt-1.aaaa",
+				},
+				External: true,
+			},
+			expectedError: true,
+		},
+		{
+			name: "OCI provider config with too many load balancer subnets",
+			providerConfig: kubeoneapi.CloudProviderSpec{
+				OCI: &kubeoneapi.OCISpec{
+					CompartmentID:         "ocid1.compartment.oc1..aaaa",
+					VCNID:                 "ocid1.vcn.oc1.eu-frankfurt-1.aaaa",
+					LoadBalancerSubnetIDs: []string{"subnet-1", "subnet-2", "subnet-3"},
+				},
+				External: true,
+			},
+			expectedError: true,
+		},
+		{
+			name: "valid Proxmox provider config",
+			providerConfig: kubeoneapi.CloudProviderSpec{
+				Proxmox: &kubeoneapi.ProxmoxSpec{},
+			},
 			expectedError: false,
 		},
+		{
+			name: "Proxmox provider config with external",
+			providerConfig: kubeoneapi.CloudProviderSpec{
+				Proxmox:  &kubeoneapi.ProxmoxSpec{},
+				External: true,
+			},
+			expectedError: true,
+		},
 		{
 			name: "valid OpenStack provider config",
 			providerConfig: kubeoneapi.CloudProviderSpec{
@@ -539,6 +1157,39 @@ func TestValidateCloudProviderSpec(t *testing.T) {
 			},
 			expectedError: false,
 		},
+		{
+			name: "valid Equinix Metal provider config with load balancer",
+			providerConfig: kubeoneapi.CloudProviderSpec{
+				External: true,
+				EquinixMetal: &kubeoneapi.EquinixMetalSpec{
+					LoadBalancer: &kubeoneapi.EquinixMetalLoadBalancerSpec{
+						LocalASN: 65000,
+					},
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "Equinix Metal load balancer without external CCM",
+			providerConfig: kubeoneapi.CloudProviderSpec{
+				EquinixMetal: &kubeoneapi.EquinixMetalSpec{
+					LoadBalancer: &kubeoneapi.EquinixMetalLoadBalancerSpec{},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "Equinix Metal load balancer with invalid local ASN",
+			providerConfig: kubeoneapi.CloudProviderSpec{
+				External: true,
+				EquinixMetal: &kubeoneapi.EquinixMetalSpec{
+					LoadBalancer: &kubeoneapi.EquinixMetalLoadBalancerSpec{
+						LocalASN: -1,
+					},
+				},
+			},
+			expectedError: true,
+		},
 		{
 			name: "valid VMware Cloud Director provider config",
 			providerConfig: kubeoneapi.CloudProviderSpec{
@@ -554,6 +1205,17 @@ func TestValidateCloudProviderSpec(t *testing.T) {
 			},
 			expectedError: false,
 		},
+		{
+			name: "vSphere provider config with both storage policy and datastore URL",
+			providerConfig: kubeoneapi.CloudProviderSpec{
+				Vsphere: &kubeoneapi.VsphereSpec{
+					StoragePolicyName: "policy",
+					DatastoreURL:      "ds:///vmfs/volumes/vsan:1/",
+				},
+				CloudConfig: "cloud-config",
+			},
+			expectedError: true,
+		},
 		{
 			name: "valid None provider config",
 			providerConfig: kubeoneapi.CloudProviderSpec{
@@ -739,40 +1401,85 @@ func TestValidateCloudProviderSpec(t *testing.T) {
 			expectedError: false,
 		},
 		{
-			name: "OpenStack provider config without csiConfig",
+			name: "vSphere provider config with file volumes",
 			providerConfig: kubeoneapi.CloudProviderSpec{
-				Openstack:   &kubeoneapi.OpenstackSpec{},
+				Vsphere: &kubeoneapi.VsphereSpec{
+					FileVolumes: &kubeoneapi.VsphereFileVolumesSpec{
+						NetPermissions: []kubeoneapi.VsphereNetPermission{
+							{Name: "nodes", IPs: "10.0.0.0/16", Permissions: "READ_WRITE"},
+							{Name: "others", IPs: "*", Permissions: "NO_ACCESS"},
+						},
+					},
+				},
+				External:    true,
 				CloudConfig: "test",
+				CSIConfig:   "test",
 			},
 			expectedError: false,
 		},
 		{
-			name: "OpenStack provider config with csiConfig",
+			name: "vSphere provider config with file volumes (external disabled)",
 			providerConfig: kubeoneapi.CloudProviderSpec{
-				Openstack:   &kubeoneapi.OpenstackSpec{},
+				Vsphere: &kubeoneapi.VsphereSpec{
+					FileVolumes: &kubeoneapi.VsphereFileVolumesSpec{},
+				},
+				External:    false,
 				CloudConfig: "test",
 				CSIConfig:   "test",
 			},
 			expectedError: true,
 		},
 		{
-			name:           "no provider specified",
-			providerConfig: kubeoneapi.CloudProviderSpec{},
-			expectedError:  true,
-		},
-		{
-			name: "AWS IPv6+IPv4 external CCM ",
+			name: "vSphere provider config with file volumes (invalid net permissions)",
 			providerConfig: kubeoneapi.CloudProviderSpec{
-				AWS:      &kubeoneapi.AWSSpec{},
-				External: true,
-			},
-			networkConfig: kubeoneapi.ClusterNetworkConfig{
-				IPFamily: kubeoneapi.IPFamilyIPv6IPv4,
+				Vsphere: &kubeoneapi.VsphereSpec{
+					FileVolumes: &kubeoneapi.VsphereFileVolumesSpec{
+						NetPermissions: []kubeoneapi.VsphereNetPermission{
+							{Name: "nodes", IPs: "10.0.0.0", Permissions: "READ_EXECUTE"},
+						},
+					},
+				},
+				External:    true,
+				CloudConfig: "test",
+				CSIConfig:   "test",
 			},
 			expectedError: true,
 		},
 		{
-			name: "AWS IPv4",
+			name: "OpenStack provider config without csiConfig",
+			providerConfig: kubeoneapi.CloudProviderSpec{
+				Openstack:   &kubeoneapi.OpenstackSpec{},
+				CloudConfig: "test",
+			},
+			expectedError: false,
+		},
+		{
+			name: "OpenStack provider config with csiConfig",
+			providerConfig: kubeoneapi.CloudProviderSpec{
+				Openstack:   &kubeoneapi.OpenstackSpec{},
+				CloudConfig: "test",
+				CSIConfig:   "test",
+			},
+			expectedError: true,
+		},
+		{
+			name:           "no provider specified",
+			providerConfig: kubeoneapi.CloudProviderSpec{},
+			expectedError:  true,
+		},
+		{
+			name: "AWS IPv6+IPv4 external CCM ",
+			providerConfig: kubeoneapi.CloudProviderSpec{
+				AWS:      &kubeoneapi.AWSSpec{},
+				External: true,
+			},
+			networkConfig: kubeoneapi.ClusterNetworkConfig{
+				IPFamily: kubeoneapi.IPFamilyIPv6IPv4,
+			},
+			expectedError: true,
+		},
+		{
+			name: "AWS IPv4",
 			providerConfig: kubeoneapi.CloudProviderSpec{
 				AWS:      &kubeoneapi.AWSSpec{},
 				External: true,
@@ -782,6 +1489,44 @@ func TestValidateCloudProviderSpec(t *testing.T) {
 			},
 			expectedError: false,
 		},
+		{
+			name: "Hetzner with custom CCM image and flags",
+			providerConfig: kubeoneapi.CloudProviderSpec{
+				Hetzner:  &kubeoneapi.HetznerSpec{},
+				External: true,
+				CloudControllerManager: &kubeoneapi.CloudControllerManagerConfig{
+					ImageRepository: "registry.example.com/hcloud-cloud-controller-manager",
+					ImageTag:        "v1.18.0",
+					Flags: map[string]string{
+						"route-reconciliation-period": "1m",
+					},
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "Hetzner with custom CCM without external cloud provider",
+			providerConfig: kubeoneapi.CloudProviderSpec{
+				Hetzner: &kubeoneapi.HetznerSpec{},
+				CloudControllerManager: &kubeoneapi.CloudControllerManagerConfig{
+					ImageTag: "v1.18.0",
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "Hetzner with dash-prefixed CCM flag",
+			providerConfig: kubeoneapi.CloudProviderSpec{
+				Hetzner:  &kubeoneapi.HetznerSpec{},
+				External: true,
+				CloudControllerManager: &kubeoneapi.CloudControllerManagerConfig{
+					Flags: map[string]string{
+						"--route-reconciliation-period": "1m",
+					},
+				},
+			},
+			expectedError: true,
+		},
 	}
 	for _, tc := range tests {
 		tc := tc
@@ -1187,6 +1932,42 @@ func TestValidateContainerRuntimeConfig(t *testing.T) {
 			versions:         kubeoneapi.VersionConfig{Kubernetes: "1.21"},
 			expectedError:    false,
 		},
+		{
+			name:             "containerd with pinned version",
+			containerRuntime: kubeoneapi.ContainerRuntimeConfig{Containerd: &kubeoneapi.ContainerRuntimeContainerd{Version: "1.6.24"}},
+			versions:         kubeoneapi.VersionConfig{Kubernetes: "1.27.5"},
+			expectedError:    false,
+		},
+		{
+			name:             "containerd with invalid pinned version",
+			containerRuntime: kubeoneapi.ContainerRuntimeConfig{Containerd: &kubeoneapi.ContainerRuntimeContainerd{Version: "v1.6"}},
+			versions:         kubeoneapi.VersionConfig{Kubernetes: "1.27.5"},
+			expectedError:    true,
+		},
+		{
+			name:             "containerd with unsupported pinned version",
+			containerRuntime: kubeoneapi.ContainerRuntimeConfig{Containerd: &kubeoneapi.ContainerRuntimeContainerd{Version: "1.5.13"}},
+			versions:         kubeoneapi.VersionConfig{Kubernetes: "1.27.5"},
+			expectedError:    true,
+		},
+		{
+			name: "containerd with sandbox image",
+			containerRuntime: kubeoneapi.ContainerRuntimeConfig{
+				Containerd:   &kubeoneapi.ContainerRuntimeContainerd{},
+				SandboxImage: "registry.example.com/pause:3.9",
+			},
+			versions:      kubeoneapi.VersionConfig{Kubernetes: "1.27.5"},
+			expectedError: false,
+		},
+		{
+			name: "containerd with invalid sandbox image",
+			containerRuntime: kubeoneapi.ContainerRuntimeConfig{
+				Containerd:   &kubeoneapi.ContainerRuntimeContainerd{},
+				SandboxImage: "registry.example.com/Pause:3.9",
+			},
+			versions:      kubeoneapi.VersionConfig{Kubernetes: "1.27.5"},
+			expectedError: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -1236,6 +2017,93 @@ func TestValidateClusterNetworkConfig(t *testing.T) {
 			},
 			expectedError: false,
 		},
+		{
+			name: "valid dualstack network config with canal",
+			clusterNetworkConfig: kubeoneapi.ClusterNetworkConfig{
+				PodSubnet:            "10.244.0.0/16",
+				PodSubnetIPv6:        "fd01::/48",
+				ServiceSubnet:        "10.96.0.0/12",
+				ServiceSubnetIPv6:    "fd02::/120",
+				IPFamily:             kubeoneapi.IPFamilyIPv4IPv6,
+				NodeCIDRMaskSizeIPv4: ptr(24),
+				NodeCIDRMaskSizeIPv6: ptr(64),
+				CNI: &kubeoneapi.CNI{
+					Canal: &kubeoneapi.CanalSpec{MTU: 1500},
+				},
+			},
+			provider: kubeoneapi.CloudProviderSpec{
+				None: &kubeoneapi.NoneSpec{},
+			},
+			expectedError: false,
+		},
+		{
+			name: "invalid dualstack network config with weave-net",
+			clusterNetworkConfig: kubeoneapi.ClusterNetworkConfig{
+				PodSubnet:            "10.244.0.0/16",
+				PodSubnetIPv6:        "fd01::/48",
+				ServiceSubnet:        "10.96.0.0/12",
+				ServiceSubnetIPv6:    "fd02::/120",
+				IPFamily:             kubeoneapi.IPFamilyIPv4IPv6,
+				NodeCIDRMaskSizeIPv4: ptr(24),
+				NodeCIDRMaskSizeIPv6: ptr(64),
+				CNI: &kubeoneapi.CNI{
+					WeaveNet: &kubeoneapi.WeaveNetSpec{},
+				},
+			},
+			provider: kubeoneapi.CloudProviderSpec{
+				None: &kubeoneapi.NoneSpec{},
+			},
+			expectedError: true,
+		},
+		{
+			name: "invalid dualstack network config with calico IPIP encapsulation",
+			clusterNetworkConfig: kubeoneapi.ClusterNetworkConfig{
+				PodSubnet:            "10.244.0.0/16",
+				PodSubnetIPv6:        "fd01::/48",
+				ServiceSubnet:        "10.96.0.0/12",
+				ServiceSubnetIPv6:    "fd02::/120",
+				IPFamily:             kubeoneapi.IPFamilyIPv4IPv6,
+				NodeCIDRMaskSizeIPv4: ptr(24),
+				NodeCIDRMaskSizeIPv6: ptr(64),
+				CNI: &kubeoneapi.CNI{
+					Calico: &kubeoneapi.CalicoSpec{
+						EncapsulationMode: kubeoneapi.CalicoEncapsulationModeIPIP,
+					},
+				},
+			},
+			provider: kubeoneapi.CloudProviderSpec{
+				None: &kubeoneapi.NoneSpec{},
+			},
+			expectedError: true,
+		},
+		{
+			name: "invalid dualstack network config with service CIDRs ordered differently than pod CIDRs",
+			clusterNetworkConfig: kubeoneapi.ClusterNetworkConfig{
+				PodSubnet:            "10.244.0.0/16",
+				PodSubnetIPv6:        "fd01::/48",
+				ServiceSubnet:        "fd02::/120,10.96.0.0/12",
+				IPFamily:             kubeoneapi.IPFamilyIPv4IPv6,
+				NodeCIDRMaskSizeIPv4: ptr(24),
+				NodeCIDRMaskSizeIPv6: ptr(64),
+			},
+			provider: kubeoneapi.CloudProviderSpec{
+				None: &kubeoneapi.NoneSpec{},
+			},
+			expectedError: true,
+		},
+		{
+			name: "invalid IPv4 network config with dualstack service CIDRs",
+			clusterNetworkConfig: kubeoneapi.ClusterNetworkConfig{
+				PodSubnet:            "10.244.0.0/16",
+				ServiceSubnet:        "10.96.0.0/12,fd02::/120",
+				IPFamily:             kubeoneapi.IPFamilyIPv4,
+				NodeCIDRMaskSizeIPv4: ptr(24),
+			},
+			provider: kubeoneapi.CloudProviderSpec{
+				None: &kubeoneapi.NoneSpec{},
+			},
+			expectedError: true,
+		},
 		{
 			name:                 "empty network config",
 			clusterNetworkConfig: kubeoneapi.ClusterNetworkConfig{},
@@ -1283,7 +2151,7 @@ func TestValidateClusterNetworkConfig(t *testing.T) {
 			expectedError: true,
 		},
 		{
-			name: "valid ipv6 config (currently forbidden)",
+			name: "valid ipv6 config",
 			clusterNetworkConfig: kubeoneapi.ClusterNetworkConfig{
 				IPFamily:             kubeoneapi.IPFamilyIPv6,
 				PodSubnetIPv6:        "fd01::/48",
@@ -1293,6 +2161,30 @@ func TestValidateClusterNetworkConfig(t *testing.T) {
 			provider: kubeoneapi.CloudProviderSpec{
 				None: &kubeoneapi.NoneSpec{},
 			},
+		},
+		{
+			name: "valid ipv6 config (aws)",
+			clusterNetworkConfig: kubeoneapi.ClusterNetworkConfig{
+				IPFamily:             kubeoneapi.IPFamilyIPv6,
+				PodSubnetIPv6:        "fd01::/48",
+				ServiceSubnetIPv6:    "fd02::/120",
+				NodeCIDRMaskSizeIPv6: ptr(64),
+			},
+			provider: kubeoneapi.CloudProviderSpec{
+				AWS: &kubeoneapi.AWSSpec{},
+			},
+		},
+		{
+			name: "ipv6 config on unsupported provider",
+			clusterNetworkConfig: kubeoneapi.ClusterNetworkConfig{
+				IPFamily:             kubeoneapi.IPFamilyIPv6,
+				PodSubnetIPv6:        "fd01::/48",
+				ServiceSubnetIPv6:    "fd02::/120",
+				NodeCIDRMaskSizeIPv6: ptr(64),
+			},
+			provider: kubeoneapi.CloudProviderSpec{
+				Hetzner: &kubeoneapi.HetznerSpec{},
+			},
 			expectedError: true,
 		},
 		{
@@ -1383,6 +2275,25 @@ func TestValidateClusterNetworkConfig(t *testing.T) {
 			},
 			expectedError: true,
 		},
+		{
+			name: "invalid network config with nftables and canal",
+			clusterNetworkConfig: kubeoneapi.ClusterNetworkConfig{
+				PodSubnet:            "192.168.1.0/16",
+				ServiceSubnet:        "192.168.0.0/16",
+				IPFamily:             kubeoneapi.IPFamilyIPv4,
+				NodeCIDRMaskSizeIPv4: ptr(24),
+				CNI: &kubeoneapi.CNI{
+					Canal: &kubeoneapi.CanalSpec{MTU: 1500},
+				},
+				KubeProxy: &kubeoneapi.KubeProxyConfig{
+					NFTables: &kubeoneapi.NFTables{},
+				},
+			},
+			provider: kubeoneapi.CloudProviderSpec{
+				None: &kubeoneapi.NoneSpec{},
+			},
+			expectedError: true,
+		},
 	}
 	for _, tc := range tests {
 		tc := tc
@@ -1396,76 +2307,88 @@ func TestValidateClusterNetworkConfig(t *testing.T) {
 	}
 }
 
-func TestValidateCNIConfig(t *testing.T) {
+func TestValidateKubeProxy(t *testing.T) {
 	tests := []struct {
 		name          string
-		cniConfig     *kubeoneapi.CNI
+		kubeProxy     *kubeoneapi.KubeProxyConfig
 		expectedError bool
 	}{
 		{
-			name: "valid Canal CNI config",
-			cniConfig: &kubeoneapi.CNI{
-				Canal: &kubeoneapi.CanalSpec{MTU: 1500},
-			},
+			name:          "valid kube-proxy config (default mode)",
+			kubeProxy:     &kubeoneapi.KubeProxyConfig{},
 			expectedError: false,
 		},
 		{
-			name: "valid WeaveNet CNI config",
-			cniConfig: &kubeoneapi.CNI{
-				WeaveNet: &kubeoneapi.WeaveNetSpec{},
+			name: "valid kube-proxy config (iptables)",
+			kubeProxy: &kubeoneapi.KubeProxyConfig{
+				IPTables: &kubeoneapi.IPTables{
+					MasqueradeAll: true,
+					SyncPeriod:    metav1.Duration{Duration: 30 * time.Second},
+					MinSyncPeriod: metav1.Duration{Duration: time.Second},
+				},
 			},
 			expectedError: false,
 		},
 		{
-			name: "valid WeaveNet CNI config with encryption enabled",
-			cniConfig: &kubeoneapi.CNI{
-				WeaveNet: &kubeoneapi.WeaveNetSpec{
-					Encrypted: true,
+			name: "valid kube-proxy config (ipvs)",
+			kubeProxy: &kubeoneapi.KubeProxyConfig{
+				IPVS: &kubeoneapi.IPVSConfig{
+					Scheduler:    "lc",
+					StrictARP:    true,
+					ExcludeCIDRs: []string{"10.0.0.0/24"},
+					TCPTimeout:   metav1.Duration{Duration: 900 * time.Second},
 				},
 			},
 			expectedError: false,
 		},
 		{
-			name: "valid External CNI config",
-			cniConfig: &kubeoneapi.CNI{
-				External: &kubeoneapi.ExternalCNISpec{},
+			name: "valid kube-proxy config (nftables)",
+			kubeProxy: &kubeoneapi.KubeProxyConfig{
+				NFTables: &kubeoneapi.NFTables{},
 			},
 			expectedError: false,
 		},
 		{
-			name: "Canal and WeaveNet specified at the same time",
-			cniConfig: &kubeoneapi.CNI{
-				Canal:    &kubeoneapi.CanalSpec{},
-				WeaveNet: &kubeoneapi.WeaveNetSpec{},
+			name: "invalid kube-proxy config (multiple modes)",
+			kubeProxy: &kubeoneapi.KubeProxyConfig{
+				IPVS:     &kubeoneapi.IPVSConfig{},
+				NFTables: &kubeoneapi.NFTables{},
 			},
 			expectedError: true,
 		},
 		{
-			name: "Canal and External specified at the same time",
-			cniConfig: &kubeoneapi.CNI{
-				Canal:    &kubeoneapi.CanalSpec{},
-				External: &kubeoneapi.ExternalCNISpec{},
+			name: "invalid kube-proxy config (unknown ipvs scheduler)",
+			kubeProxy: &kubeoneapi.KubeProxyConfig{
+				IPVS: &kubeoneapi.IPVSConfig{
+					Scheduler: "random",
+				},
 			},
 			expectedError: true,
 		},
 		{
-			name: "WeaveNet and External specified at the same time",
-			cniConfig: &kubeoneapi.CNI{
-				WeaveNet: &kubeoneapi.WeaveNetSpec{},
-				External: &kubeoneapi.ExternalCNISpec{},
+			name: "invalid kube-proxy config (invalid ipvs excludeCIDRs)",
+			kubeProxy: &kubeoneapi.KubeProxyConfig{
+				IPVS: &kubeoneapi.IPVSConfig{
+					ExcludeCIDRs: []string{"10.0.0.0"},
+				},
 			},
 			expectedError: true,
 		},
 		{
-			name:          "no CNI config specified",
-			cniConfig:     &kubeoneapi.CNI{},
+			name: "invalid kube-proxy config (minSyncPeriod greater than syncPeriod)",
+			kubeProxy: &kubeoneapi.KubeProxyConfig{
+				IPTables: &kubeoneapi.IPTables{
+					SyncPeriod:    metav1.Duration{Duration: time.Second},
+					MinSyncPeriod: metav1.Duration{Duration: 30 * time.Second},
+				},
+			},
 			expectedError: true,
 		},
 	}
 	for _, tc := range tests {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			errs := ValidateCNI(tc.cniConfig, nil)
+			errs := ValidateKubeProxy(tc.kubeProxy, field.NewPath("kubeProxy"))
 			if (len(errs) == 0) == tc.expectedError {
 				t.Errorf("test case failed: expected %v, but got %v", tc.expectedError, (len(errs) != 0))
 			}
@@ -1473,73 +2396,147 @@ func TestValidateCNIConfig(t *testing.T) {
 	}
 }
 
-func TestValidateStaticWorkersConfig(t *testing.T) {
+func TestValidateKubeProxyVersion(t *testing.T) {
 	tests := []struct {
-		name                string
-		staticWorkersConfig kubeoneapi.StaticWorkersConfig
-		networkConfig       kubeoneapi.ClusterNetworkConfig
-		expectedError       bool
+		name          string
+		kubeProxy     *kubeoneapi.KubeProxyConfig
+		versions      kubeoneapi.VersionConfig
+		expectedError bool
 	}{
 		{
-			name: "valid StaticWorkers config",
-			staticWorkersConfig: kubeoneapi.StaticWorkersConfig{
-				Hosts: []kubeoneapi.HostConfig{
-					{
-						PublicAddress:  "1.1.1.1",
-						PrivateAddress: "10.0.0.1",
-						SSHAgentSocket: "env:SSH_AUTH_SOCK",
-						SSHUsername:    "ubuntu",
-					},
-					{
-						PublicAddress:  "1.1.1.2",
-						PrivateAddress: "10.0.0.2",
-						SSHAgentSocket: "env:SSH_AUTH_SOCK",
-						SSHUsername:    "ubuntu",
-					},
-				},
+			name:          "no kube-proxy config",
+			versions:      kubeoneapi.VersionConfig{Kubernetes: "1.28.4"},
+			expectedError: false,
+		},
+		{
+			name: "ipvs on 1.28",
+			kubeProxy: &kubeoneapi.KubeProxyConfig{
+				IPVS: &kubeoneapi.IPVSConfig{},
 			},
+			versions:      kubeoneapi.VersionConfig{Kubernetes: "1.28.4"},
 			expectedError: false,
 		},
 		{
-			name: "no hosts provided",
-			staticWorkersConfig: kubeoneapi.StaticWorkersConfig{
-				Hosts: []kubeoneapi.HostConfig{},
+			name: "nftables on 1.29",
+			kubeProxy: &kubeoneapi.KubeProxyConfig{
+				NFTables: &kubeoneapi.NFTables{},
 			},
+			versions:      kubeoneapi.VersionConfig{Kubernetes: "1.29.0"},
 			expectedError: false,
 		},
 		{
-			name:                "no hosts field present",
-			staticWorkersConfig: kubeoneapi.StaticWorkersConfig{},
-			expectedError:       false,
+			name: "nftables on 1.28",
+			kubeProxy: &kubeoneapi.KubeProxyConfig{
+				NFTables: &kubeoneapi.NFTables{},
+			},
+			versions:      kubeoneapi.VersionConfig{Kubernetes: "1.28.4"},
+			expectedError: true,
 		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			errs := ValidateKubeProxyVersion(tc.kubeProxy, tc.versions, field.NewPath("kubeProxy"))
+			if (len(errs) == 0) == tc.expectedError {
+				t.Errorf("test case failed: expected %v, but got %v", tc.expectedError, (len(errs) != 0))
+			}
+		})
+	}
+}
+
+func TestValidateKubeProxyLoadBalancer(t *testing.T) {
+	tests := []struct {
+		name          string
+		kubeProxy     *kubeoneapi.KubeProxyConfig
+		addons        *kubeoneapi.Addons
+		helmReleases  []kubeoneapi.HelmRelease
+		metalLB       *kubeoneapi.MetalLB
+		expectedError bool
+	}{
 		{
-			name: "invalid host config",
-			staticWorkersConfig: kubeoneapi.StaticWorkersConfig{
-				Hosts: []kubeoneapi.HostConfig{
-					{
-						PublicAddress:  "1.1.1.1",
-						PrivateAddress: "10.0.0.1",
-						SSHAgentSocket: "env:SSH_AUTH_SOCK",
-						SSHUsername:    "ubuntu",
-					},
-					{
-						PublicAddress:  "1.1.1.2",
-						PrivateAddress: "10.0.0.2",
-						SSHAgentSocket: "env:SSH_AUTH_SOCK",
-						SSHUsername:    "",
-					},
-				},
+			name:      "ipvs without metallb",
+			kubeProxy: &kubeoneapi.KubeProxyConfig{IPVS: &kubeoneapi.IPVSConfig{}},
+			addons: &kubeoneapi.Addons{
+				Enable: true,
+				Addons: []kubeoneapi.Addon{{Name: "unattended-upgrades"}},
+			},
+			expectedError: false,
+		},
+		{
+			name:      "ipvs without strictARP and metallb addon",
+			kubeProxy: &kubeoneapi.KubeProxyConfig{IPVS: &kubeoneapi.IPVSConfig{}},
+			addons: &kubeoneapi.Addons{
+				Enable: true,
+				Addons: []kubeoneapi.Addon{{Name: "metallb"}},
+			},
+			expectedError: true,
+		},
+		{
+			name:      "ipvs with strictARP and metallb addon",
+			kubeProxy: &kubeoneapi.KubeProxyConfig{IPVS: &kubeoneapi.IPVSConfig{StrictARP: true}},
+			addons: &kubeoneapi.Addons{
+				Enable: true,
+				Addons: []kubeoneapi.Addon{{Name: "metallb"}},
+			},
+			expectedError: false,
+		},
+		{
+			name:      "ipvs without strictARP and deleted metallb addon",
+			kubeProxy: &kubeoneapi.KubeProxyConfig{IPVS: &kubeoneapi.IPVSConfig{}},
+			addons: &kubeoneapi.Addons{
+				Enable: true,
+				Addons: []kubeoneapi.Addon{{Name: "metallb", Delete: true}},
+			},
+			expectedError: false,
+		},
+		{
+			name:      "ipvs without strictARP and metallb helm release",
+			kubeProxy: &kubeoneapi.KubeProxyConfig{IPVS: &kubeoneapi.IPVSConfig{}},
+			helmReleases: []kubeoneapi.HelmRelease{
+				{Chart: "metallb", RepoURL: "https://metallb.github.io/metallb", Namespace: "metallb-system"},
 			},
 			expectedError: true,
 		},
+		{
+			name:      "ipvs without strictARP and metallb feature in L2 mode",
+			kubeProxy: &kubeoneapi.KubeProxyConfig{IPVS: &kubeoneapi.IPVSConfig{}},
+			metalLB: &kubeoneapi.MetalLB{
+				Enable:       true,
+				AddressPools: []kubeoneapi.MetalLBAddressPool{{Name: "default", Mode: kubeoneapi.MetalLBModeL2}},
+			},
+			expectedError: true,
+		},
+		{
+			name:      "ipvs without strictARP and metallb feature in BGP mode",
+			kubeProxy: &kubeoneapi.KubeProxyConfig{IPVS: &kubeoneapi.IPVSConfig{}},
+			metalLB: &kubeoneapi.MetalLB{
+				Enable:       true,
+				AddressPools: []kubeoneapi.MetalLBAddressPool{{Name: "default", Mode: kubeoneapi.MetalLBModeBGP}},
+			},
+			expectedError: false,
+		},
+		{
+			name:      "ipvs without strictARP and disabled metallb feature",
+			kubeProxy: &kubeoneapi.KubeProxyConfig{IPVS: &kubeoneapi.IPVSConfig{}},
+			metalLB: &kubeoneapi.MetalLB{
+				AddressPools: []kubeoneapi.MetalLBAddressPool{{Name: "default", Mode: kubeoneapi.MetalLBModeL2}},
+			},
+			expectedError: false,
+		},
+		{
+			name:      "iptables and metallb addon",
+			kubeProxy: &kubeoneapi.KubeProxyConfig{IPTables: &kubeoneapi.IPTables{}},
+			addons: &kubeoneapi.Addons{
+				Enable: true,
+				Addons: []kubeoneapi.Addon{{Name: "metallb"}},
+			},
+			expectedError: false,
+		},
 	}
 	for _, tc := range tests {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			version := kubeoneapi.VersionConfig{
-				Kubernetes: "1.26.1",
-			}
-			errs := ValidateStaticWorkersConfig(tc.staticWorkersConfig, version, tc.networkConfig, nil)
+			errs := ValidateKubeProxyLoadBalancer(tc.kubeProxy, tc.addons, tc.helmReleases, tc.metalLB, field.NewPath("kubeProxy"))
 			if (len(errs) == 0) == tc.expectedError {
 				t.Errorf("test case failed: expected %v, but got %v", tc.expectedError, (len(errs) != 0))
 			}
@@ -1547,251 +2544,3986 @@ func TestValidateStaticWorkersConfig(t *testing.T) {
 	}
 }
 
-func TestValidateDynamicWorkerConfig(t *testing.T) {
+func TestValidateHelmReleases(t *testing.T) {
 	tests := []struct {
-		name                string
-		dynamicWorkerConfig []kubeoneapi.DynamicWorkerConfig
-		provider            kubeoneapi.CloudProviderSpec
-		expectedError       bool
+		name          string
+		helmReleases  []kubeoneapi.HelmRelease
+		expectedError bool
 	}{
 		{
-			name: "valid worker config",
-			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{
-				{
-					Name:     "test-1",
-					Replicas: pointer.New(3),
-				},
+			name: "valid repository chart",
+			helmReleases: []kubeoneapi.HelmRelease{
+				{Chart: "metallb", RepoURL: "https://metallb.github.io/metallb", ReleaseName: "metallb", Namespace: "metallb-system"},
+			},
+			expectedError: false,
+		},
+		{
+			name: "valid OCI chart",
+			helmReleases: []kubeoneapi.HelmRelease{
+				{Chart: "oci://registry.example.com/charts/example", Version: "1.0.0", ReleaseName: "example", Namespace: "example"},
+			},
+			expectedError: false,
+		},
+		{
+			name: "valid releases with the same name in different namespaces",
+			helmReleases: []kubeoneapi.HelmRelease{
+				{Chart: "example", RepoURL: "https://charts.example.com", ReleaseName: "example", Namespace: "a"},
+				{Chart: "example", RepoURL: "https://charts.example.com", ReleaseName: "example", Namespace: "b"},
+			},
+			expectedError: false,
+		},
+		{
+			name: "valid inline values",
+			helmReleases: []kubeoneapi.HelmRelease{
 				{
-					Name:     "test-2",
-					Replicas: pointer.New(5),
+					Chart:       "example",
+					RepoURL:     "https://charts.example.com",
+					ReleaseName: "example",
+					Namespace:   "example",
+					Values:      []kubeoneapi.HelmValues{{Inline: []byte(`{"replicas": 2}`)}},
 				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "missing chart",
+			helmReleases: []kubeoneapi.HelmRelease{
+				{ReleaseName: "example", Namespace: "example"},
+			},
+			expectedError: true,
+		},
+		{
+			name: "missing namespace",
+			helmReleases: []kubeoneapi.HelmRelease{
+				{Chart: "example", RepoURL: "https://charts.example.com", ReleaseName: "example"},
+			},
+			expectedError: true,
+		},
+		{
+			name: "OCI chart with repoURL",
+			helmReleases: []kubeoneapi.HelmRelease{
+				{Chart: "oci://registry.example.com/charts/example", RepoURL: "https://charts.example.com", ReleaseName: "example", Namespace: "example"},
+			},
+			expectedError: true,
+		},
+		{
+			name: "invalid release name",
+			helmReleases: []kubeoneapi.HelmRelease{
+				{Chart: "oci://registry.example.com/charts/example", ReleaseName: "oci://registry.example.com/charts/example", Namespace: "example"},
+			},
+			expectedError: true,
+		},
+		{
+			name: "duplicate releases",
+			helmReleases: []kubeoneapi.HelmRelease{
+				{Chart: "example", RepoURL: "https://charts.example.com", ReleaseName: "example", Namespace: "example"},
+				{Chart: "other", RepoURL: "https://charts.example.com", ReleaseName: "example", Namespace: "example"},
+			},
+			expectedError: true,
+		},
+		{
+			name: "missing values file",
+			helmReleases: []kubeoneapi.HelmRelease{
 				{
-					Name:     "test-3",
-					Replicas: pointer.New(0),
+					Chart:       "example",
+					RepoURL:     "https://charts.example.com",
+					ReleaseName: "example",
+					Namespace:   "example",
+					Values:      []kubeoneapi.HelmValues{{ValuesFile: "/nonexistent/values.yaml"}},
 				},
 			},
-			provider: kubeoneapi.CloudProviderSpec{
-				AWS: &kubeoneapi.AWSSpec{},
-			},
+			expectedError: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			errs := ValidateHelmReleases(tc.helmReleases, field.NewPath("helmReleases"))
+			if (len(errs) == 0) == tc.expectedError {
+				t.Errorf("test case failed: expected %v, but got %v (%v)", tc.expectedError, (len(errs) != 0), errs)
+			}
+		})
+	}
+}
+
+func TestValidateMetalLB(t *testing.T) {
+	tests := []struct {
+		name          string
+		metalLB       *kubeoneapi.MetalLB
+		cloudProvider kubeoneapi.CloudProviderSpec
+		expectedError bool
+	}{
+		{
+			name:          "metallb disabled",
+			metalLB:       &kubeoneapi.MetalLB{},
 			expectedError: false,
 		},
 		{
-			name:                "valid worker config (no worker defined)",
-			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{},
-			provider: kubeoneapi.CloudProviderSpec{
-				AWS: &kubeoneapi.AWSSpec{},
+			name: "L2 address pools",
+			metalLB: &kubeoneapi.MetalLB{
+				Enable: true,
+				AddressPools: []kubeoneapi.MetalLBAddressPool{
+					{Name: "default", Addresses: []string{"192.168.10.0/24", "192.168.20.10-192.168.20.20"}, Mode: kubeoneapi.MetalLBModeL2},
+					{Name: "ipv6", Addresses: []string{"fd00:10::/120"}, Mode: kubeoneapi.MetalLBModeL2},
+				},
 			},
 			expectedError: false,
 		},
 		{
-			name: "invalid worker config (replicas not provided)",
-			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{
-				{
-					Name:     "test-1",
-					Replicas: pointer.New(3),
+			name: "BGP address pool with peer",
+			metalLB: &kubeoneapi.MetalLB{
+				Enable: true,
+				AddressPools: []kubeoneapi.MetalLBAddressPool{
+					{Name: "default", Addresses: []string{"192.168.10.0/24"}, Mode: kubeoneapi.MetalLBModeBGP},
 				},
-				{
-					Name: "test-2",
+				BGPPeers: []kubeoneapi.MetalLBBGPPeer{
+					{PeerAddress: "10.0.0.1", PeerASN: 64501, MyASN: 64500, PeerPort: 179},
 				},
 			},
-			provider: kubeoneapi.CloudProviderSpec{
-				AWS: &kubeoneapi.AWSSpec{},
+			expectedError: false,
+		},
+		{
+			name: "no address pools",
+			metalLB: &kubeoneapi.MetalLB{
+				Enable: true,
 			},
 			expectedError: true,
 		},
 		{
-			name: "invalid worker config (no name given)",
-			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{
-				{
-					Replicas: pointer.New(3),
+			name: "duplicate address pool names",
+			metalLB: &kubeoneapi.MetalLB{
+				Enable: true,
+				AddressPools: []kubeoneapi.MetalLBAddressPool{
+					{Name: "default", Addresses: []string{"192.168.10.0/24"}, Mode: kubeoneapi.MetalLBModeL2},
+					{Name: "default", Addresses: []string{"192.168.20.0/24"}, Mode: kubeoneapi.MetalLBModeL2},
 				},
 			},
-			provider: kubeoneapi.CloudProviderSpec{
-				AWS: &kubeoneapi.AWSSpec{},
+			expectedError: true,
+		},
+		{
+			name: "invalid address",
+			metalLB: &kubeoneapi.MetalLB{
+				Enable: true,
+				AddressPools: []kubeoneapi.MetalLBAddressPool{
+					{Name: "default", Addresses: []string{"192.168.10.1"}, Mode: kubeoneapi.MetalLBModeL2},
+				},
 			},
 			expectedError: true,
 		},
 		{
-			name: "only machineAnnotations set",
-			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{
-				{
-					Name:     "test-1",
-					Replicas: pointer.New(3),
-					Config: kubeoneapi.ProviderSpec{
-						MachineAnnotations: map[string]string{"test": "test"},
-					},
+			name: "address range with mixed families",
+			metalLB: &kubeoneapi.MetalLB{
+				Enable: true,
+				AddressPools: []kubeoneapi.MetalLBAddressPool{
+					{Name: "default", Addresses: []string{"192.168.10.1-fd00::10"}, Mode: kubeoneapi.MetalLBModeL2},
 				},
 			},
-			provider: kubeoneapi.CloudProviderSpec{
-				AWS: &kubeoneapi.AWSSpec{},
+			expectedError: true,
+		},
+		{
+			name: "invalid mode",
+			metalLB: &kubeoneapi.MetalLB{
+				Enable: true,
+				AddressPools: []kubeoneapi.MetalLBAddressPool{
+					{Name: "default", Addresses: []string{"192.168.10.0/24"}, Mode: "ARP"},
+				},
 			},
-			expectedError: false,
+			expectedError: true,
 		},
 		{
-			name: "only nodeAnnotations set",
-			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{
-				{
-					Name:     "test-1",
-					Replicas: pointer.New(3),
-					Config: kubeoneapi.ProviderSpec{
-						NodeAnnotations: map[string]string{"test": "test"},
-					},
+			name: "BGP address pool without peers",
+			metalLB: &kubeoneapi.MetalLB{
+				Enable: true,
+				AddressPools: []kubeoneapi.MetalLBAddressPool{
+					{Name: "default", Addresses: []string{"192.168.10.0/24"}, Mode: kubeoneapi.MetalLBModeBGP},
 				},
 			},
-			provider: kubeoneapi.CloudProviderSpec{
-				AWS: &kubeoneapi.AWSSpec{},
+			expectedError: true,
+		},
+		{
+			name: "BGP peer without ASN",
+			metalLB: &kubeoneapi.MetalLB{
+				Enable: true,
+				AddressPools: []kubeoneapi.MetalLBAddressPool{
+					{Name: "default", Addresses: []string{"192.168.10.0/24"}, Mode: kubeoneapi.MetalLBModeBGP},
+				},
+				BGPPeers: []kubeoneapi.MetalLBBGPPeer{
+					{PeerAddress: "10.0.0.1", MyASN: 64500, PeerPort: 179},
+				},
 			},
-			expectedError: false,
+			expectedError: true,
 		},
 		{
-			name: "both machineAnnotations and nodeAnnotations set",
-			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{
-				{
-					Name:     "test-1",
-					Replicas: pointer.New(3),
-					Config: kubeoneapi.ProviderSpec{
-						MachineAnnotations: map[string]string{"test": "test"},
-						NodeAnnotations:    map[string]string{"test": "test"},
+			name: "BGP peer with invalid address",
+			metalLB: &kubeoneapi.MetalLB{
+				Enable: true,
+				AddressPools: []kubeoneapi.MetalLBAddressPool{
+					{Name: "default", Addresses: []string{"192.168.10.0/24"}, Mode: kubeoneapi.MetalLBModeBGP},
+				},
+				BGPPeers: []kubeoneapi.MetalLBBGPPeer{
+					{PeerAddress: "router.local", PeerASN: 64501, MyASN: 64500, PeerPort: 179},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "equinix metal load balancer",
+			metalLB: &kubeoneapi.MetalLB{
+				Enable: true,
+				AddressPools: []kubeoneapi.MetalLBAddressPool{
+					{Name: "default", Addresses: []string{"192.168.10.0/24"}, Mode: kubeoneapi.MetalLBModeL2},
+				},
+			},
+			cloudProvider: kubeoneapi.CloudProviderSpec{
+				EquinixMetal: &kubeoneapi.EquinixMetalSpec{LoadBalancer: &kubeoneapi.EquinixMetalLoadBalancerSpec{}},
+			},
+			expectedError: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			c := kubeoneapi.KubeOneCluster{
+				CloudProvider: tc.cloudProvider,
+				Features:      kubeoneapi.Features{MetalLB: tc.metalLB},
+			}
+			errs := ValidateMetalLB(c, field.NewPath("features", "metalLB"))
+			if (len(errs) == 0) == tc.expectedError {
+				t.Errorf("test case failed: expected %v, but got %v (%v)", tc.expectedError, (len(errs) != 0), errs)
+			}
+		})
+	}
+}
+
+func TestValidateNodeCIDRAllocation(t *testing.T) {
+	canal := &kubeoneapi.CNI{Canal: &kubeoneapi.CanalSpec{}}
+	hosts := func(n int, maxPods *int32) []kubeoneapi.HostConfig {
+		var hosts []kubeoneapi.HostConfig
+		for i := 0; i < n; i++ {
+			hosts = append(hosts, kubeoneapi.HostConfig{Kubelet: kubeoneapi.KubeletConfig{MaxPods: maxPods}})
+		}
+
+		return hosts
+	}
+
+	tests := []struct {
+		name           string
+		clusterNetwork kubeoneapi.ClusterNetworkConfig
+		controlPlane   []kubeoneapi.HostConfig
+		staticWorkers  []kubeoneapi.HostConfig
+		expectedError  bool
+	}{
+		{
+			name: "default node cidr mask size",
+			clusterNetwork: kubeoneapi.ClusterNetworkConfig{
+				CNI:                  canal,
+				IPFamily:             kubeoneapi.IPFamilyIPv4,
+				PodSubnet:            "10.244.0.0/16",
+				NodeCIDRMaskSizeIPv4: pointer.New(24),
+			},
+			controlPlane:  hosts(3, nil),
+			staticWorkers: hosts(3, pointer.New(int32(250))),
+			expectedError: false,
+		},
+		{
+			name: "node cidr too small for default maxPods",
+			clusterNetwork: kubeoneapi.ClusterNetworkConfig{
+				CNI:                  canal,
+				IPFamily:             kubeoneapi.IPFamilyIPv4,
+				PodSubnet:            "10.244.0.0/16",
+				NodeCIDRMaskSizeIPv4: pointer.New(26),
+			},
+			controlPlane:  hosts(3, nil),
+			expectedError: true,
+		},
+		{
+			name: "node cidr large enough for lowered maxPods",
+			clusterNetwork: kubeoneapi.ClusterNetworkConfig{
+				CNI:                  canal,
+				IPFamily:             kubeoneapi.IPFamilyIPv4,
+				PodSubnet:            "10.244.0.0/16",
+				NodeCIDRMaskSizeIPv4: pointer.New(26),
+			},
+			controlPlane:  hosts(3, pointer.New(int32(60))),
+			expectedError: false,
+		},
+		{
+			name: "node cidr too small for raised maxPods",
+			clusterNetwork: kubeoneapi.ClusterNetworkConfig{
+				CNI:                  canal,
+				IPFamily:             kubeoneapi.IPFamilyIPv4,
+				PodSubnet:            "10.244.0.0/16",
+				NodeCIDRMaskSizeIPv4: pointer.New(24),
+			},
+			controlPlane:  hosts(3, nil),
+			staticWorkers: hosts(1, pointer.New(int32(300))),
+			expectedError: true,
+		},
+		{
+			name: "pod subnet too small for static nodes",
+			clusterNetwork: kubeoneapi.ClusterNetworkConfig{
+				CNI:                  canal,
+				IPFamily:             kubeoneapi.IPFamilyIPv4,
+				PodSubnet:            "10.244.0.0/23",
+				NodeCIDRMaskSizeIPv4: pointer.New(24),
+			},
+			controlPlane:  hosts(3, nil),
+			expectedError: true,
+		},
+		{
+			name: "ipv6 node cidr mask size too long for kube-controller-manager",
+			clusterNetwork: kubeoneapi.ClusterNetworkConfig{
+				CNI:                  canal,
+				IPFamily:             kubeoneapi.IPFamilyIPv6,
+				PodSubnetIPv6:        "fd01::/48",
+				NodeCIDRMaskSizeIPv6: pointer.New(80),
+			},
+			controlPlane:  hosts(3, nil),
+			expectedError: true,
+		},
+		{
+			name: "ipv6 node cidr mask size with cilium cluster-pool ipam",
+			clusterNetwork: kubeoneapi.ClusterNetworkConfig{
+				CNI:                  &kubeoneapi.CNI{Cilium: &kubeoneapi.CiliumSpec{IPAM: kubeoneapi.CiliumIPAMModeClusterPool}},
+				IPFamily:             kubeoneapi.IPFamilyIPv6,
+				PodSubnetIPv6:        "fd01::/48",
+				NodeCIDRMaskSizeIPv6: pointer.New(80),
+			},
+			controlPlane:  hosts(3, nil),
+			expectedError: false,
+		},
+		{
+			name: "calico ignores node cidr mask size",
+			clusterNetwork: kubeoneapi.ClusterNetworkConfig{
+				CNI:                  &kubeoneapi.CNI{Calico: &kubeoneapi.CalicoSpec{}},
+				IPFamily:             kubeoneapi.IPFamilyIPv4,
+				PodSubnet:            "10.244.0.0/16",
+				NodeCIDRMaskSizeIPv4: pointer.New(26),
+			},
+			controlPlane:  hosts(3, nil),
+			expectedError: false,
+		},
+		{
+			name: "node cidr allocation disabled with calico",
+			clusterNetwork: kubeoneapi.ClusterNetworkConfig{
+				CNI:                  &kubeoneapi.CNI{Calico: &kubeoneapi.CalicoSpec{}},
+				IPFamily:             kubeoneapi.IPFamilyIPv4,
+				PodSubnet:            "10.244.0.0/16",
+				NodeCIDRMaskSizeIPv4: pointer.New(24),
+				AllocateNodeCIDRs:    pointer.New(false),
+			},
+			controlPlane:  hosts(3, nil),
+			expectedError: false,
+		},
+		{
+			name: "node cidr allocation disabled with canal",
+			clusterNetwork: kubeoneapi.ClusterNetworkConfig{
+				CNI:                  canal,
+				IPFamily:             kubeoneapi.IPFamilyIPv4,
+				PodSubnet:            "10.244.0.0/16",
+				NodeCIDRMaskSizeIPv4: pointer.New(24),
+				AllocateNodeCIDRs:    pointer.New(false),
+			},
+			controlPlane:  hosts(3, nil),
+			expectedError: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			c := kubeoneapi.KubeOneCluster{
+				ClusterNetwork: tc.clusterNetwork,
+				ControlPlane:   kubeoneapi.ControlPlaneConfig{Hosts: tc.controlPlane},
+				StaticWorkers:  kubeoneapi.StaticWorkersConfig{Hosts: tc.staticWorkers},
+			}
+			errs := ValidateNodeCIDRAllocation(c, field.NewPath("clusterNetwork"))
+			if (len(errs) == 0) == tc.expectedError {
+				t.Errorf("test case failed: expected %v, but got %v (%v)", tc.expectedError, (len(errs) != 0), errs)
+			}
+		})
+	}
+}
+
+func TestValidateGatewayAPI(t *testing.T) {
+	ciliumStrict := &kubeoneapi.CNI{Cilium: &kubeoneapi.CiliumSpec{KubeProxyReplacement: kubeoneapi.KubeProxyReplacementStrict}}
+
+	tests := []struct {
+		name          string
+		gatewayAPI    *kubeoneapi.GatewayAPI
+		cni           *kubeoneapi.CNI
+		expectedError bool
+	}{
+		{
+			name:          "gateway api disabled",
+			gatewayAPI:    &kubeoneapi.GatewayAPI{Controller: "nginx"},
+			expectedError: false,
+		},
+		{
+			name:          "cilium controller with cilium kube-proxy replacement",
+			gatewayAPI:    &kubeoneapi.GatewayAPI{Enable: true, Controller: kubeoneapi.GatewayAPIControllerCilium},
+			cni:           ciliumStrict,
+			expectedError: false,
+		},
+		{
+			name:          "no controller with canal",
+			gatewayAPI:    &kubeoneapi.GatewayAPI{Enable: true, Controller: kubeoneapi.GatewayAPIControllerNone},
+			cni:           &kubeoneapi.CNI{Canal: &kubeoneapi.CanalSpec{}},
+			expectedError: false,
+		},
+		{
+			name:          "cilium controller with canal",
+			gatewayAPI:    &kubeoneapi.GatewayAPI{Enable: true, Controller: kubeoneapi.GatewayAPIControllerCilium},
+			cni:           &kubeoneapi.CNI{Canal: &kubeoneapi.CanalSpec{}},
+			expectedError: true,
+		},
+		{
+			name:       "cilium controller without cilium kube-proxy replacement",
+			gatewayAPI: &kubeoneapi.GatewayAPI{Enable: true, Controller: kubeoneapi.GatewayAPIControllerCilium},
+			cni: &kubeoneapi.CNI{Cilium: &kubeoneapi.CiliumSpec{
+				KubeProxyReplacement: kubeoneapi.KubeProxyReplacementDisabled,
+			}},
+			expectedError: true,
+		},
+		{
+			name:          "unsupported controller",
+			gatewayAPI:    &kubeoneapi.GatewayAPI{Enable: true, Controller: "nginx"},
+			cni:           ciliumStrict,
+			expectedError: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			c := kubeoneapi.KubeOneCluster{
+				ClusterNetwork: kubeoneapi.ClusterNetworkConfig{CNI: tc.cni},
+				Features:       kubeoneapi.Features{GatewayAPI: tc.gatewayAPI},
+			}
+			errs := ValidateGatewayAPI(c, field.NewPath("features", "gatewayAPI"))
+			if (len(errs) == 0) == tc.expectedError {
+				t.Errorf("test case failed: expected %v, but got %v (%v)", tc.expectedError, (len(errs) != 0), errs)
+			}
+		})
+	}
+}
+
+func TestValidateClusterDNS(t *testing.T) {
+	tests := []struct {
+		name          string
+		dns           *kubeoneapi.ClusterDNSConfig
+		expectedError bool
+	}{
+		{
+			name:          "empty dns config",
+			dns:           &kubeoneapi.ClusterDNSConfig{},
+			expectedError: false,
+		},
+		{
+			name: "valid upstream servers and search domains",
+			dns: &kubeoneapi.ClusterDNSConfig{
+				UpstreamServers: []string{"1.1.1.1", "2606:4700:4700::1111"},
+				SearchDomains:   []string{"example.com", "corp.example.com"},
+			},
+			expectedError: false,
+		},
+		{
+			name: "invalid upstream server",
+			dns: &kubeoneapi.ClusterDNSConfig{
+				UpstreamServers: []string{"dns.example.com"},
+			},
+			expectedError: true,
+		},
+		{
+			name: "loopback upstream server",
+			dns: &kubeoneapi.ClusterDNSConfig{
+				UpstreamServers: []string{"127.0.0.53"},
+			},
+			expectedError: true,
+		},
+		{
+			name: "too many upstream servers",
+			dns: &kubeoneapi.ClusterDNSConfig{
+				UpstreamServers: []string{"1.1.1.1", "1.0.0.1", "8.8.8.8", "8.8.4.4"},
+			},
+			expectedError: true,
+		},
+		{
+			name: "invalid search domain",
+			dns: &kubeoneapi.ClusterDNSConfig{
+				SearchDomains: []string{"Example_Domain"},
+			},
+			expectedError: true,
+		},
+		{
+			name: "too many search domains",
+			dns: &kubeoneapi.ClusterDNSConfig{
+				SearchDomains: []string{"a.example.com", "b.example.com", "c.example.com", "d.example.com"},
+			},
+			expectedError: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			errs := ValidateClusterDNS(tc.dns, nil)
+			if (len(errs) == 0) == tc.expectedError {
+				t.Errorf("test case failed: expected %v, but got %v", tc.expectedError, (len(errs) != 0))
+			}
+		})
+	}
+}
+
+func TestValidateKubeVIP(t *testing.T) {
+	hosts := []kubeoneapi.HostConfig{
+		{PublicAddress: "1.1.1.1", PrivateAddress: "10.0.0.1"},
+		{PublicAddress: "1.1.1.2", PrivateAddress: "10.0.0.2"},
+	}
+
+	tests := []struct {
+		name          string
+		apiEndpoint   string
+		kubeVIP       *kubeoneapi.KubeVIP
+		expectedError bool
+	}{
+		{
+			name:          "kube-vip not enabled",
+			apiEndpoint:   "api.example.com",
+			expectedError: false,
+		},
+		{
+			name:          "valid virtual IP address",
+			apiEndpoint:   "10.0.0.100",
+			kubeVIP:       &kubeoneapi.KubeVIP{Enable: true, Interface: "eth0"},
+			expectedError: false,
+		},
+		{
+			name:          "hostname api endpoint",
+			apiEndpoint:   "api.example.com",
+			kubeVIP:       &kubeoneapi.KubeVIP{Enable: true},
+			expectedError: true,
+		},
+		{
+			name:          "virtual IP address used by a control plane host",
+			apiEndpoint:   "10.0.0.2",
+			kubeVIP:       &kubeoneapi.KubeVIP{Enable: true},
+			expectedError: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			c := kubeoneapi.KubeOneCluster{
+				APIEndpoint:  kubeoneapi.APIEndpoint{Host: tc.apiEndpoint, Port: 6443},
+				ControlPlane: kubeoneapi.ControlPlaneConfig{Hosts: hosts},
+				Features:     kubeoneapi.Features{KubeVIP: tc.kubeVIP},
+			}
+			errs := ValidateKubeVIP(c, field.NewPath("features", "kubeVIP"))
+			if (len(errs) == 0) == tc.expectedError {
+				t.Errorf("test case failed: expected %v, but got %v", tc.expectedError, (len(errs) != 0))
+			}
+		})
+	}
+}
+
+func TestValidateDefaultDenyNetworkPolicy(t *testing.T) {
+	tests := []struct {
+		name          string
+		ddnp          *kubeoneapi.DefaultDenyNetworkPolicy
+		expectedError bool
+	}{
+		{
+			name:          "default-deny NetworkPolicies not enabled",
+			expectedError: false,
+		},
+		{
+			name:          "valid namespaces",
+			ddnp:          &kubeoneapi.DefaultDenyNetworkPolicy{Enable: true, Namespaces: []string{"default", "apps"}},
+			expectedError: false,
+		},
+		{
+			name:          "invalid namespace name",
+			ddnp:          &kubeoneapi.DefaultDenyNetworkPolicy{Enable: true, Namespaces: []string{"Apps"}},
+			expectedError: true,
+		},
+		{
+			name:          "kube-system namespace",
+			ddnp:          &kubeoneapi.DefaultDenyNetworkPolicy{Enable: true, Namespaces: []string{"default", "kube-system"}},
+			expectedError: true,
+		},
+		{
+			name:          "namespace of an addon deployed by KubeOne",
+			ddnp:          &kubeoneapi.DefaultDenyNetworkPolicy{Enable: true, Namespaces: []string{"metallb-system"}},
+			expectedError: true,
+		},
+		{
+			name:          "duplicate namespaces",
+			ddnp:          &kubeoneapi.DefaultDenyNetworkPolicy{Enable: true, Namespaces: []string{"apps", "apps"}},
+			expectedError: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			errs := ValidateDefaultDenyNetworkPolicy(tc.ddnp, field.NewPath("features", "defaultDenyNetworkPolicy"))
+			if (len(errs) == 0) == tc.expectedError {
+				t.Errorf("test case failed: expected %v, but got %v", tc.expectedError, (len(errs) != 0))
+			}
+		})
+	}
+}
+
+func TestValidateBGP(t *testing.T) {
+	calicoIPIP := &kubeoneapi.CNI{Calico: &kubeoneapi.CalicoSpec{EncapsulationMode: kubeoneapi.CalicoEncapsulationModeIPIP}}
+	cilium := &kubeoneapi.CNI{Cilium: &kubeoneapi.CiliumSpec{}}
+	peers := []kubeoneapi.BGPPeer{{PeerAddress: "192.168.1.1", PeerASN: 65000, PeerPort: 179}}
+	routeReflectors := &kubeoneapi.BGPRouteReflectors{
+		NodeSelector: map[string]string{"node-role.kubernetes.io/control-plane": ""},
+		ClusterID:    "244.0.0.1",
+	}
+	metalLBBGP := &kubeoneapi.MetalLB{
+		Enable:       true,
+		AddressPools: []kubeoneapi.MetalLBAddressPool{{Name: "bgp", Addresses: []string{"192.168.10.0/24"}, Mode: kubeoneapi.MetalLBModeBGP}},
+	}
+	metalLBL2 := &kubeoneapi.MetalLB{
+		Enable:       true,
+		AddressPools: []kubeoneapi.MetalLBAddressPool{{Name: "l2", Addresses: []string{"192.168.10.0/24"}, Mode: kubeoneapi.MetalLBModeL2}},
+	}
+
+	tests := []struct {
+		name          string
+		cni           *kubeoneapi.CNI
+		metalLB       *kubeoneapi.MetalLB
+		bgp           *kubeoneapi.BGPConfig
+		expectedError bool
+	}{
+		{
+			name:          "no BGP",
+			cni:           &kubeoneapi.CNI{Canal: &kubeoneapi.CanalSpec{}},
+			expectedError: false,
+		},
+		{
+			name: "valid calico BGP",
+			cni:  calicoIPIP,
+			bgp: &kubeoneapi.BGPConfig{
+				ASN: 64512,
+				Peers: []kubeoneapi.BGPPeer{
+					{PeerAddress: "192.168.1.1", PeerASN: 65000, PeerPort: 179, NodeSelector: map[string]string{"rack": "a"}},
+					{PeerAddress: "fd00::1", PeerASN: 65000, PeerPort: 1179},
+				},
+				RouteReflectors: routeReflectors,
+				AdvertisedCIDRs: []string{"192.168.20.0/24"},
+			},
+			expectedError: false,
+		},
+		{
+			name:          "valid calico BGP with route reflectors only",
+			cni:           calicoIPIP,
+			bgp:           &kubeoneapi.BGPConfig{ASN: 64512, RouteReflectors: routeReflectors},
+			expectedError: false,
+		},
+		{
+			name: "valid cilium BGP",
+			cni:  cilium,
+			bgp: &kubeoneapi.BGPConfig{
+				ASN:             64512,
+				Peers:           peers,
+				AdvertisedCIDRs: []string{"192.168.20.0/24"},
+			},
+			expectedError: false,
+		},
+		{
+			name:          "valid cilium BGP with MetalLB in the L2 mode",
+			cni:           cilium,
+			metalLB:       metalLBL2,
+			bgp:           &kubeoneapi.BGPConfig{ASN: 64512, Peers: peers},
+			expectedError: false,
+		},
+		{
+			name:          "canal",
+			cni:           &kubeoneapi.CNI{Canal: &kubeoneapi.CanalSpec{}},
+			bgp:           &kubeoneapi.BGPConfig{ASN: 64512, Peers: peers},
+			expectedError: true,
+		},
+		{
+			name:          "calico with VXLAN encapsulation",
+			cni:           &kubeoneapi.CNI{Calico: &kubeoneapi.CalicoSpec{EncapsulationMode: kubeoneapi.CalicoEncapsulationModeVXLAN}},
+			bgp:           &kubeoneapi.BGPConfig{ASN: 64512, Peers: peers},
+			expectedError: true,
+		},
+		{
+			name:          "MetalLB in the BGP mode",
+			cni:           calicoIPIP,
+			metalLB:       metalLBBGP,
+			bgp:           &kubeoneapi.BGPConfig{ASN: 64512, Peers: peers},
+			expectedError: true,
+		},
+		{
+			name:          "cilium advertised CIDRs with MetalLB",
+			cni:           cilium,
+			metalLB:       metalLBL2,
+			bgp:           &kubeoneapi.BGPConfig{ASN: 64512, Peers: peers, AdvertisedCIDRs: []string{"192.168.20.0/24"}},
+			expectedError: true,
+		},
+		{
+			name:          "invalid ASN",
+			cni:           calicoIPIP,
+			bgp:           &kubeoneapi.BGPConfig{ASN: 0, Peers: peers},
+			expectedError: true,
+		},
+		{
+			name:          "no peers",
+			cni:           cilium,
+			bgp:           &kubeoneapi.BGPConfig{ASN: 64512},
+			expectedError: true,
+		},
+		{
+			name: "invalid peer address",
+			cni:  calicoIPIP,
+			bgp: &kubeoneapi.BGPConfig{
+				ASN:   64512,
+				Peers: []kubeoneapi.BGPPeer{{PeerAddress: "router", PeerASN: 65000, PeerPort: 179}},
+			},
+			expectedError: true,
+		},
+		{
+			name: "duplicate peer address",
+			cni:  calicoIPIP,
+			bgp: &kubeoneapi.BGPConfig{
+				ASN: 64512,
+				Peers: []kubeoneapi.BGPPeer{
+					{PeerAddress: "192.168.1.1", PeerASN: 65000, PeerPort: 179},
+					{PeerAddress: "192.168.1.1", PeerASN: 65001, PeerPort: 179},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "invalid peer port",
+			cni:  calicoIPIP,
+			bgp: &kubeoneapi.BGPConfig{
+				ASN:   64512,
+				Peers: []kubeoneapi.BGPPeer{{PeerAddress: "192.168.1.1", PeerASN: 65000, PeerPort: 70000}},
+			},
+			expectedError: true,
+		},
+		{
+			name: "invalid peer node selector",
+			cni:  calicoIPIP,
+			bgp: &kubeoneapi.BGPConfig{
+				ASN:   64512,
+				Peers: []kubeoneapi.BGPPeer{{PeerAddress: "192.168.1.1", PeerASN: 65000, PeerPort: 179, NodeSelector: map[string]string{"rack": "a' || all()"}}},
+			},
+			expectedError: true,
+		},
+		{
+			name: "cilium peer node selector",
+			cni:  cilium,
+			bgp: &kubeoneapi.BGPConfig{
+				ASN:   64512,
+				Peers: []kubeoneapi.BGPPeer{{PeerAddress: "192.168.1.1", PeerASN: 65000, PeerPort: 179, NodeSelector: map[string]string{"rack": "a"}}},
+			},
+			expectedError: true,
+		},
+		{
+			name:          "cilium route reflectors",
+			cni:           cilium,
+			bgp:           &kubeoneapi.BGPConfig{ASN: 64512, Peers: peers, RouteReflectors: routeReflectors},
+			expectedError: true,
+		},
+		{
+			name: "route reflectors without node selector",
+			cni:  calicoIPIP,
+			bgp: &kubeoneapi.BGPConfig{
+				ASN:             64512,
+				RouteReflectors: &kubeoneapi.BGPRouteReflectors{ClusterID: "244.0.0.1"},
+			},
+			expectedError: true,
+		},
+		{
+			name: "invalid route reflector cluster ID",
+			cni:  calicoIPIP,
+			bgp: &kubeoneapi.BGPConfig{
+				ASN: 64512,
+				RouteReflectors: &kubeoneapi.BGPRouteReflectors{
+					NodeSelector: map[string]string{"route-reflector": "true"},
+					ClusterID:    "fd00::1",
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name:          "invalid advertised CIDR",
+			cni:           calicoIPIP,
+			bgp:           &kubeoneapi.BGPConfig{ASN: 64512, Peers: peers, AdvertisedCIDRs: []string{"192.168.20.0"}},
+			expectedError: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			c := kubeoneapi.KubeOneCluster{
+				ClusterNetwork: kubeoneapi.ClusterNetworkConfig{
+					CNI: tc.cni,
+					BGP: tc.bgp,
+				},
+				Features: kubeoneapi.Features{
+					MetalLB: tc.metalLB,
+				},
+			}
+			errs := ValidateBGP(c, field.NewPath("clusterNetwork", "bgp"))
+			if (len(errs) == 0) == tc.expectedError {
+				t.Errorf("test case failed: expected %v, but got %v (%v)", tc.expectedError, (len(errs) != 0), errs)
+			}
+		})
+	}
+}
+
+func TestValidateEgressGateways(t *testing.T) {
+	ciliumKPR := &kubeoneapi.CNI{Cilium: &kubeoneapi.CiliumSpec{KubeProxyReplacement: kubeoneapi.KubeProxyReplacementStrict}}
+	hosts := []kubeoneapi.HostConfig{
+		{PublicAddress: "1.1.1.1", PrivateAddress: "10.0.0.1", Labels: map[string]string{"egress": "a"}},
+		{PublicAddress: "1.1.1.2", PrivateAddress: "10.0.0.2", Labels: map[string]string{"egress": "b"}},
+		{PublicAddress: "1.1.1.3", PrivateAddress: "10.0.0.3", Hostname: "worker-3", Labels: map[string]string{"egress": "b"}},
+	}
+
+	tests := []struct {
+		name           string
+		ipFamily       kubeoneapi.IPFamily
+		cni            *kubeoneapi.CNI
+		gatewayAPI     *kubeoneapi.GatewayAPI
+		egressGateways []kubeoneapi.EgressGateway
+		expectedError  bool
+	}{
+		{
+			name:          "no egress gateways",
+			ipFamily:      kubeoneapi.IPFamilyIPv4,
+			cni:           &kubeoneapi.CNI{Canal: &kubeoneapi.CanalSpec{}},
+			expectedError: false,
+		},
+		{
+			name:     "valid egress gateway with egress IP",
+			ipFamily: kubeoneapi.IPFamilyIPv4,
+			cni:      ciliumKPR,
+			egressGateways: []kubeoneapi.EgressGateway{{
+				Name:             "egress",
+				Namespace:        "default",
+				DestinationCIDRs: []string{"0.0.0.0/0"},
+				ExcludedCIDRs:    []string{"192.168.0.0/16"},
+				NodeSelector:     map[string]string{"egress": "a"},
+				EgressIP:         "10.0.0.100",
+			}},
+			expectedError: false,
+		},
+		{
+			name:     "valid egress gateway with hostname node selector",
+			ipFamily: kubeoneapi.IPFamilyIPv4,
+			cni:      ciliumKPR,
+			egressGateways: []kubeoneapi.EgressGateway{{
+				Name:         "egress",
+				PodSelector:  map[string]string{"app": "test"},
+				NodeSelector: map[string]string{"kubernetes.io/hostname": "worker-3"},
+				EgressIP:     "10.0.0.100",
+			}},
+			expectedError: false,
+		},
+		{
+			name:     "valid egress gateway with interface and multiple gateway nodes",
+			ipFamily: kubeoneapi.IPFamilyIPv4,
+			cni:      ciliumKPR,
+			egressGateways: []kubeoneapi.EgressGateway{{
+				Name:         "egress",
+				PodSelector:  map[string]string{"app": "test"},
+				NodeSelector: map[string]string{"egress": "b"},
+				Interface:    "eth1",
+			}},
+			expectedError: false,
+		},
+		{
+			name:     "egress gateway with canal",
+			ipFamily: kubeoneapi.IPFamilyIPv4,
+			cni:      &kubeoneapi.CNI{Canal: &kubeoneapi.CanalSpec{}},
+			egressGateways: []kubeoneapi.EgressGateway{{
+				Name:         "egress",
+				Namespace:    "default",
+				NodeSelector: map[string]string{"egress": "a"},
+			}},
+			expectedError: true,
+		},
+		{
+			name:     "egress gateway in IPv6 cluster",
+			ipFamily: kubeoneapi.IPFamilyIPv6,
+			cni:      ciliumKPR,
+			egressGateways: []kubeoneapi.EgressGateway{{
+				Name:         "egress",
+				Namespace:    "default",
+				NodeSelector: map[string]string{"egress": "a"},
+			}},
+			expectedError: true,
+		},
+		{
+			name:     "egress gateway with cilium gateway api controller",
+			ipFamily: kubeoneapi.IPFamilyIPv4,
+			cni:      ciliumKPR,
+			gatewayAPI: &kubeoneapi.GatewayAPI{
+				Enable:     true,
+				Controller: kubeoneapi.GatewayAPIControllerCilium,
+			},
+			egressGateways: []kubeoneapi.EgressGateway{{
+				Name:         "egress",
+				Namespace:    "default",
+				NodeSelector: map[string]string{"egress": "a"},
+			}},
+			expectedError: true,
+		},
+		{
+			name:     "egress gateway without pod selection",
+			ipFamily: kubeoneapi.IPFamilyIPv4,
+			cni:      ciliumKPR,
+			egressGateways: []kubeoneapi.EgressGateway{{
+				Name:         "egress",
+				NodeSelector: map[string]string{"egress": "a"},
+			}},
+			expectedError: true,
+		},
+		{
+			name:     "egress gateway without node selector",
+			ipFamily: kubeoneapi.IPFamilyIPv4,
+			cni:      ciliumKPR,
+			egressGateways: []kubeoneapi.EgressGateway{{
+				Name:      "egress",
+				Namespace: "default",
+			}},
+			expectedError: true,
+		},
+		{
+			name:     "egress gateway with IPv6 destination",
+			ipFamily: kubeoneapi.IPFamilyIPv4,
+			cni:      ciliumKPR,
+			egressGateways: []kubeoneapi.EgressGateway{{
+				Name:             "egress",
+				Namespace:        "default",
+				DestinationCIDRs: []string{"::/0"},
+				NodeSelector:     map[string]string{"egress": "a"},
+			}},
+			expectedError: true,
+		},
+		{
+			name:     "egress gateway with egress IP and interface",
+			ipFamily: kubeoneapi.IPFamilyIPv4,
+			cni:      ciliumKPR,
+			egressGateways: []kubeoneapi.EgressGateway{{
+				Name:         "egress",
+				Namespace:    "default",
+				NodeSelector: map[string]string{"egress": "a"},
+				EgressIP:     "10.0.0.100",
+				Interface:    "eth1",
+			}},
+			expectedError: true,
+		},
+		{
+			name:     "egress gateway with egress IP matching multiple hosts",
+			ipFamily: kubeoneapi.IPFamilyIPv4,
+			cni:      ciliumKPR,
+			egressGateways: []kubeoneapi.EgressGateway{{
+				Name:         "egress",
+				Namespace:    "default",
+				NodeSelector: map[string]string{"egress": "b"},
+				EgressIP:     "10.0.0.100",
+			}},
+			expectedError: true,
+		},
+		{
+			name:     "egress gateway with egress IP not matching any host",
+			ipFamily: kubeoneapi.IPFamilyIPv4,
+			cni:      ciliumKPR,
+			egressGateways: []kubeoneapi.EgressGateway{{
+				Name:         "egress",
+				Namespace:    "default",
+				NodeSelector: map[string]string{"egress": "c"},
+				EgressIP:     "10.0.0.100",
+			}},
+			expectedError: true,
+		},
+		{
+			name:     "egress gateways with the same egress IP on different hosts",
+			ipFamily: kubeoneapi.IPFamilyIPv4,
+			cni:      ciliumKPR,
+			egressGateways: []kubeoneapi.EgressGateway{
+				{
+					Name:         "egress-a",
+					Namespace:    "default",
+					NodeSelector: map[string]string{"egress": "a"},
+					EgressIP:     "10.0.0.100",
+				},
+				{
+					Name:         "egress-b",
+					Namespace:    "default",
+					NodeSelector: map[string]string{"kubernetes.io/hostname": "worker-3"},
+					EgressIP:     "10.0.0.100",
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name:     "egress gateways with duplicate names",
+			ipFamily: kubeoneapi.IPFamilyIPv4,
+			cni:      ciliumKPR,
+			egressGateways: []kubeoneapi.EgressGateway{
+				{
+					Name:         "egress",
+					Namespace:    "default",
+					NodeSelector: map[string]string{"egress": "a"},
+				},
+				{
+					Name:         "egress",
+					Namespace:    "test",
+					NodeSelector: map[string]string{"egress": "a"},
+				},
+			},
+			expectedError: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			c := kubeoneapi.KubeOneCluster{
+				ControlPlane:  kubeoneapi.ControlPlaneConfig{Hosts: hosts[:1]},
+				StaticWorkers: kubeoneapi.StaticWorkersConfig{Hosts: hosts[1:]},
+				ClusterNetwork: kubeoneapi.ClusterNetworkConfig{
+					IPFamily:       tc.ipFamily,
+					CNI:            tc.cni,
+					EgressGateways: tc.egressGateways,
+				},
+				Features: kubeoneapi.Features{
+					GatewayAPI: tc.gatewayAPI,
+				},
+			}
+			errs := ValidateEgressGateways(c, field.NewPath("clusterNetwork", "egressGateways"))
+			if (len(errs) == 0) == tc.expectedError {
+				t.Errorf("test case failed: expected %v, but got %v (%v)", tc.expectedError, (len(errs) != 0), errs)
+			}
+		})
+	}
+}
+
+func TestValidateIstioAmbient(t *testing.T) {
+	tests := []struct {
+		name          string
+		ipFamily      kubeoneapi.IPFamily
+		cni           *kubeoneapi.CNI
+		istioAmbient  *kubeoneapi.IstioAmbient
+		expectedError bool
+	}{
+		{
+			name:          "disabled",
+			ipFamily:      kubeoneapi.IPFamilyIPv6,
+			cni:           &kubeoneapi.CNI{Cilium: &kubeoneapi.CiliumSpec{HostRouting: kubeoneapi.CiliumHostRoutingBPF}},
+			istioAmbient:  &kubeoneapi.IstioAmbient{},
+			expectedError: false,
+		},
+		{
+			name:          "enabled with canal",
+			ipFamily:      kubeoneapi.IPFamilyIPv4,
+			cni:           &kubeoneapi.CNI{Canal: &kubeoneapi.CanalSpec{}},
+			istioAmbient:  &kubeoneapi.IstioAmbient{Enable: true},
+			expectedError: false,
+		},
+		{
+			name:     "enabled with cilium kube-proxy replacement",
+			ipFamily: kubeoneapi.IPFamilyIPv4,
+			cni: &kubeoneapi.CNI{Cilium: &kubeoneapi.CiliumSpec{
+				KubeProxyReplacement: kubeoneapi.KubeProxyReplacementStrict,
+				HostRouting:          kubeoneapi.CiliumHostRoutingLegacy,
+			}},
+			istioAmbient:  &kubeoneapi.IstioAmbient{Enable: true},
+			expectedError: false,
+		},
+		{
+			name:     "enabled with cilium bpf host routing",
+			ipFamily: kubeoneapi.IPFamilyIPv4,
+			cni: &kubeoneapi.CNI{Cilium: &kubeoneapi.CiliumSpec{
+				KubeProxyReplacement: kubeoneapi.KubeProxyReplacementStrict,
+				HostRouting:          kubeoneapi.CiliumHostRoutingBPF,
+			}},
+			istioAmbient:  &kubeoneapi.IstioAmbient{Enable: true},
+			expectedError: true,
+		},
+		{
+			name:          "enabled in dual-stack cluster",
+			ipFamily:      kubeoneapi.IPFamilyIPv4IPv6,
+			cni:           &kubeoneapi.CNI{Canal: &kubeoneapi.CanalSpec{}},
+			istioAmbient:  &kubeoneapi.IstioAmbient{Enable: true},
+			expectedError: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			c := kubeoneapi.KubeOneCluster{
+				ClusterNetwork: kubeoneapi.ClusterNetworkConfig{IPFamily: tc.ipFamily, CNI: tc.cni},
+				Features:       kubeoneapi.Features{IstioAmbient: tc.istioAmbient},
+			}
+			errs := ValidateIstioAmbient(c, field.NewPath("features", "istioAmbient"))
+			if (len(errs) == 0) == tc.expectedError {
+				t.Errorf("test case failed: expected %v, but got %v (%v)", tc.expectedError, (len(errs) != 0), errs)
+			}
+		})
+	}
+}
+
+func TestValidateClusterAutoscaler(t *testing.T) {
+	threeReplicas := 3
+
+	tests := []struct {
+		name              string
+		machineController bool
+		clusterAutoscaler *kubeoneapi.ClusterAutoscaler
+		addons            *kubeoneapi.Addons
+		expectedError     bool
+	}{
+		{
+			name:              "disabled",
+			clusterAutoscaler: &kubeoneapi.ClusterAutoscaler{NodeGroups: []kubeoneapi.ClusterAutoscalerNodeGroup{{}}},
+			expectedError:     false,
+		},
+		{
+			name:              "valid node groups",
+			machineController: true,
+			clusterAutoscaler: &kubeoneapi.ClusterAutoscaler{
+				Enable:   true,
+				Expander: "least-waste",
+				NodeGroups: []kubeoneapi.ClusterAutoscalerNodeGroup{
+					{MachineDeployment: "pool1", MinReplicas: 1, MaxReplicas: 5},
+					{MachineDeployment: "pool2", MinReplicas: 2, MaxReplicas: 2},
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "machine-controller not deployed",
+			clusterAutoscaler: &kubeoneapi.ClusterAutoscaler{
+				Enable: true,
+			},
+			expectedError: true,
+		},
+		{
+			name:              "unsupported expander",
+			machineController: true,
+			clusterAutoscaler: &kubeoneapi.ClusterAutoscaler{
+				Enable:   true,
+				Expander: "cheapest",
+			},
+			expectedError: true,
+		},
+		{
+			name:              "duplicate node group",
+			machineController: true,
+			clusterAutoscaler: &kubeoneapi.ClusterAutoscaler{
+				Enable: true,
+				NodeGroups: []kubeoneapi.ClusterAutoscalerNodeGroup{
+					{MachineDeployment: "pool1", MinReplicas: 1, MaxReplicas: 5},
+					{MachineDeployment: "pool1", MinReplicas: 1, MaxReplicas: 5},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name:              "zero minReplicas",
+			machineController: true,
+			clusterAutoscaler: &kubeoneapi.ClusterAutoscaler{
+				Enable: true,
+				NodeGroups: []kubeoneapi.ClusterAutoscalerNodeGroup{
+					{MachineDeployment: "pool1", MinReplicas: 0, MaxReplicas: 5},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name:              "maxReplicas lower than minReplicas",
+			machineController: true,
+			clusterAutoscaler: &kubeoneapi.ClusterAutoscaler{
+				Enable: true,
+				NodeGroups: []kubeoneapi.ClusterAutoscalerNodeGroup{
+					{MachineDeployment: "pool1", MinReplicas: 3, MaxReplicas: 2},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name:              "dynamic worker replicas out of range",
+			machineController: true,
+			clusterAutoscaler: &kubeoneapi.ClusterAutoscaler{
+				Enable: true,
+				NodeGroups: []kubeoneapi.ClusterAutoscalerNodeGroup{
+					{MachineDeployment: "workers", MinReplicas: 1, MaxReplicas: 2},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name:              "cluster-autoscaler addon listed",
+			machineController: true,
+			clusterAutoscaler: &kubeoneapi.ClusterAutoscaler{
+				Enable: true,
+			},
+			addons: &kubeoneapi.Addons{
+				Enable: true,
+				Addons: []kubeoneapi.Addon{{Name: "cluster-autoscaler"}},
+			},
+			expectedError: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			c := kubeoneapi.KubeOneCluster{
+				MachineController: &kubeoneapi.MachineControllerConfig{Deploy: tc.machineController},
+				DynamicWorkers:    []kubeoneapi.DynamicWorkerConfig{{Name: "workers", Replicas: &threeReplicas}},
+				Features:          kubeoneapi.Features{ClusterAutoscaler: tc.clusterAutoscaler},
+				Addons:            tc.addons,
+			}
+			errs := ValidateClusterAutoscaler(c, field.NewPath("features", "clusterAutoscaler"))
+			if (len(errs) == 0) == tc.expectedError {
+				t.Errorf("test case failed: expected %v, but got %v (%v)", tc.expectedError, (len(errs) != 0), errs)
+			}
+		})
+	}
+}
+
+func TestValidateLocalStorage(t *testing.T) {
+	tests := []struct {
+		name          string
+		cloudProvider kubeoneapi.CloudProviderSpec
+		localStorage  *kubeoneapi.LocalStorage
+		expectedError bool
+	}{
+		{
+			name:          "disabled",
+			cloudProvider: kubeoneapi.CloudProviderSpec{AWS: &kubeoneapi.AWSSpec{}},
+			localStorage:  &kubeoneapi.LocalStorage{LocalPath: &kubeoneapi.LocalPathProvisioner{}},
+			expectedError: false,
+		},
+		{
+			name:          "valid local-path",
+			cloudProvider: kubeoneapi.CloudProviderSpec{None: &kubeoneapi.NoneSpec{}},
+			localStorage: &kubeoneapi.LocalStorage{
+				Enable:           true,
+				LocalPath:        &kubeoneapi.LocalPathProvisioner{Path: "/opt/local-path-provisioner"},
+				StorageClassName: "local-path",
+				ReclaimPolicy:    corev1.PersistentVolumeReclaimDelete,
+			},
+			expectedError: false,
+		},
+		{
+			name:          "valid topolvm with bundled CSI drivers disabled",
+			cloudProvider: kubeoneapi.CloudProviderSpec{Vsphere: &kubeoneapi.VsphereSpec{}, DisableBundledCSIDrivers: true},
+			localStorage: &kubeoneapi.LocalStorage{
+				Enable:           true,
+				TopoLVM:          &kubeoneapi.TopoLVMProvisioner{VolumeGroup: "vg-data", FSType: "ext4"},
+				StorageClassName: "topolvm-provisioner",
+				ReclaimPolicy:    corev1.PersistentVolumeReclaimRetain,
+			},
+			expectedError: false,
+		},
+		{
+			name:          "bundled CSI drivers deployed",
+			cloudProvider: kubeoneapi.CloudProviderSpec{AWS: &kubeoneapi.AWSSpec{}},
+			localStorage: &kubeoneapi.LocalStorage{
+				Enable:           true,
+				LocalPath:        &kubeoneapi.LocalPathProvisioner{Path: "/opt/local-path-provisioner"},
+				StorageClassName: "local-path",
+				ReclaimPolicy:    corev1.PersistentVolumeReclaimDelete,
+			},
+			expectedError: true,
+		},
+		{
+			name:          "both provisioners set",
+			cloudProvider: kubeoneapi.CloudProviderSpec{None: &kubeoneapi.NoneSpec{}},
+			localStorage: &kubeoneapi.LocalStorage{
+				Enable:           true,
+				LocalPath:        &kubeoneapi.LocalPathProvisioner{Path: "/opt/local-path-provisioner"},
+				TopoLVM:          &kubeoneapi.TopoLVMProvisioner{VolumeGroup: "vg-data", FSType: "xfs"},
+				StorageClassName: "local-path",
+				ReclaimPolicy:    corev1.PersistentVolumeReclaimDelete,
+			},
+			expectedError: true,
+		},
+		{
+			name:          "relative local-path path",
+			cloudProvider: kubeoneapi.CloudProviderSpec{None: &kubeoneapi.NoneSpec{}},
+			localStorage: &kubeoneapi.LocalStorage{
+				Enable:           true,
+				LocalPath:        &kubeoneapi.LocalPathProvisioner{Path: "local-path-provisioner"},
+				StorageClassName: "local-path",
+				ReclaimPolicy:    corev1.PersistentVolumeReclaimDelete,
+			},
+			expectedError: true,
+		},
+		{
+			name:          "topolvm without volume group",
+			cloudProvider: kubeoneapi.CloudProviderSpec{None: &kubeoneapi.NoneSpec{}},
+			localStorage: &kubeoneapi.LocalStorage{
+				Enable:           true,
+				TopoLVM:          &kubeoneapi.TopoLVMProvisioner{FSType: "xfs"},
+				StorageClassName: "topolvm-provisioner",
+				ReclaimPolicy:    corev1.PersistentVolumeReclaimDelete,
+			},
+			expectedError: true,
+		},
+		{
+			name:          "unsupported topolvm filesystem",
+			cloudProvider: kubeoneapi.CloudProviderSpec{None: &kubeoneapi.NoneSpec{}},
+			localStorage: &kubeoneapi.LocalStorage{
+				Enable:           true,
+				TopoLVM:          &kubeoneapi.TopoLVMProvisioner{VolumeGroup: "vg-data", FSType: "zfs"},
+				StorageClassName: "topolvm-provisioner",
+				ReclaimPolicy:    corev1.PersistentVolumeReclaimDelete,
+			},
+			expectedError: true,
+		},
+		{
+			name:          "invalid StorageClass name",
+			cloudProvider: kubeoneapi.CloudProviderSpec{None: &kubeoneapi.NoneSpec{}},
+			localStorage: &kubeoneapi.LocalStorage{
+				Enable:           true,
+				LocalPath:        &kubeoneapi.LocalPathProvisioner{Path: "/opt/local-path-provisioner"},
+				StorageClassName: "Local_Path",
+				ReclaimPolicy:    corev1.PersistentVolumeReclaimDelete,
+			},
+			expectedError: true,
+		},
+		{
+			name:          "unsupported reclaim policy",
+			cloudProvider: kubeoneapi.CloudProviderSpec{None: &kubeoneapi.NoneSpec{}},
+			localStorage: &kubeoneapi.LocalStorage{
+				Enable:           true,
+				LocalPath:        &kubeoneapi.LocalPathProvisioner{Path: "/opt/local-path-provisioner"},
+				StorageClassName: "local-path",
+				ReclaimPolicy:    corev1.PersistentVolumeReclaimRecycle,
+			},
+			expectedError: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			c := kubeoneapi.KubeOneCluster{
+				CloudProvider: tc.cloudProvider,
+				Features:      kubeoneapi.Features{LocalStorage: tc.localStorage},
+			}
+			errs := ValidateLocalStorage(c, field.NewPath("features", "localStorage"))
+			if (len(errs) == 0) == tc.expectedError {
+				t.Errorf("test case failed: expected %v, but got %v (%v)", tc.expectedError, (len(errs) != 0), errs)
+			}
+		})
+	}
+}
+
+func TestValidateIngressNginx(t *testing.T) {
+	tests := []struct {
+		name          string
+		cloudProvider kubeoneapi.CloudProviderSpec
+		ingressNginx  *kubeoneapi.IngressNginx
+		expectedError bool
+	}{
+		{
+			name:          "disabled",
+			cloudProvider: kubeoneapi.CloudProviderSpec{None: &kubeoneapi.NoneSpec{}},
+			ingressNginx:  &kubeoneapi.IngressNginx{ServiceType: "ClusterIP"},
+			expectedError: false,
+		},
+		{
+			name:          "valid NodePort on baremetal",
+			cloudProvider: kubeoneapi.CloudProviderSpec{None: &kubeoneapi.NoneSpec{}},
+			ingressNginx: &kubeoneapi.IngressNginx{
+				Enable:                true,
+				Replicas:              ptr(int32(2)),
+				ServiceType:           corev1.ServiceTypeNodePort,
+				ExternalTrafficPolicy: corev1.ServiceExternalTrafficPolicyLocal,
+				ProxyProtocol:         ptr(false),
+			},
+			expectedError: false,
+		},
+		{
+			name:          "valid PROXY protocol on AWS",
+			cloudProvider: kubeoneapi.CloudProviderSpec{AWS: &kubeoneapi.AWSSpec{}},
+			ingressNginx: &kubeoneapi.IngressNginx{
+				Enable:                true,
+				ServiceType:           corev1.ServiceTypeLoadBalancer,
+				ExternalTrafficPolicy: corev1.ServiceExternalTrafficPolicyCluster,
+				ProxyProtocol:         ptr(true),
+				ServiceAnnotations: map[string]string{
+					"service.beta.kubernetes.io/aws-load-balancer-internal": "true",
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name:          "valid Hetzner load balancer location",
+			cloudProvider: kubeoneapi.CloudProviderSpec{Hetzner: &kubeoneapi.HetznerSpec{}},
+			ingressNginx: &kubeoneapi.IngressNginx{
+				Enable:                true,
+				ServiceType:           corev1.ServiceTypeLoadBalancer,
+				ExternalTrafficPolicy: corev1.ServiceExternalTrafficPolicyCluster,
+				ProxyProtocol:         ptr(true),
+				ServiceAnnotations: map[string]string{
+					"load-balancer.hetzner.cloud/location": "fsn1",
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name:          "Hetzner load balancer without location",
+			cloudProvider: kubeoneapi.CloudProviderSpec{Hetzner: &kubeoneapi.HetznerSpec{}},
+			ingressNginx: &kubeoneapi.IngressNginx{
+				Enable:                true,
+				ServiceType:           corev1.ServiceTypeLoadBalancer,
+				ExternalTrafficPolicy: corev1.ServiceExternalTrafficPolicyCluster,
+				ProxyProtocol:         ptr(true),
+			},
+			expectedError: true,
+		},
+		{
+			name:          "zero replicas",
+			cloudProvider: kubeoneapi.CloudProviderSpec{None: &kubeoneapi.NoneSpec{}},
+			ingressNginx: &kubeoneapi.IngressNginx{
+				Enable:                true,
+				Replicas:              ptr(int32(0)),
+				ServiceType:           corev1.ServiceTypeNodePort,
+				ExternalTrafficPolicy: corev1.ServiceExternalTrafficPolicyLocal,
+			},
+			expectedError: true,
+		},
+		{
+			name:          "unsupported service type",
+			cloudProvider: kubeoneapi.CloudProviderSpec{None: &kubeoneapi.NoneSpec{}},
+			ingressNginx: &kubeoneapi.IngressNginx{
+				Enable:                true,
+				ServiceType:           corev1.ServiceTypeClusterIP,
+				ExternalTrafficPolicy: corev1.ServiceExternalTrafficPolicyLocal,
+			},
+			expectedError: true,
+		},
+		{
+			name:          "PROXY protocol on unsupported provider",
+			cloudProvider: kubeoneapi.CloudProviderSpec{Openstack: &kubeoneapi.OpenstackSpec{}},
+			ingressNginx: &kubeoneapi.IngressNginx{
+				Enable:                true,
+				ServiceType:           corev1.ServiceTypeLoadBalancer,
+				ExternalTrafficPolicy: corev1.ServiceExternalTrafficPolicyCluster,
+				ProxyProtocol:         ptr(true),
+			},
+			expectedError: true,
+		},
+		{
+			name:          "PROXY protocol with NodePort",
+			cloudProvider: kubeoneapi.CloudProviderSpec{DigitalOcean: &kubeoneapi.DigitalOceanSpec{}},
+			ingressNginx: &kubeoneapi.IngressNginx{
+				Enable:                true,
+				ServiceType:           corev1.ServiceTypeNodePort,
+				ExternalTrafficPolicy: corev1.ServiceExternalTrafficPolicyCluster,
+				ProxyProtocol:         ptr(true),
+			},
+			expectedError: true,
+		},
+		{
+			name:          "invalid annotation",
+			cloudProvider: kubeoneapi.CloudProviderSpec{None: &kubeoneapi.NoneSpec{}},
+			ingressNginx: &kubeoneapi.IngressNginx{
+				Enable:                true,
+				ServiceType:           corev1.ServiceTypeNodePort,
+				ExternalTrafficPolicy: corev1.ServiceExternalTrafficPolicyLocal,
+				ServiceAnnotations:    map[string]string{"invalid annotation": "true"},
+			},
+			expectedError: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			c := kubeoneapi.KubeOneCluster{
+				CloudProvider: tc.cloudProvider,
+				Features:      kubeoneapi.Features{IngressNginx: tc.ingressNginx},
+			}
+			errs := ValidateIngressNginx(c, field.NewPath("features", "ingressNginx"))
+			if (len(errs) == 0) == tc.expectedError {
+				t.Errorf("test case failed: expected %v, but got %v (%v)", tc.expectedError, (len(errs) != 0), errs)
+			}
+		})
+	}
+}
+
+func TestValidateCNIConfig(t *testing.T) {
+	tests := []struct {
+		name          string
+		cniConfig     *kubeoneapi.CNI
+		expectedError bool
+	}{
+		{
+			name: "valid Canal CNI config",
+			cniConfig: &kubeoneapi.CNI{
+				Canal: &kubeoneapi.CanalSpec{MTU: 1500},
+			},
+			expectedError: false,
+		},
+		{
+			name: "valid Canal CNI config with bandwidth plugin",
+			cniConfig: &kubeoneapi.CNI{
+				Canal:                 &kubeoneapi.CanalSpec{MTU: 1500},
+				EnableBandwidthPlugin: ptr(true),
+			},
+			expectedError: false,
+		},
+		{
+			name: "valid Calico CNI config with bandwidth plugin",
+			cniConfig: &kubeoneapi.CNI{
+				Calico:                &kubeoneapi.CalicoSpec{},
+				EnableBandwidthPlugin: ptr(true),
+			},
+			expectedError: false,
+		},
+		{
+			name: "Cilium CNI config with bandwidth plugin",
+			cniConfig: &kubeoneapi.CNI{
+				Cilium:                &kubeoneapi.CiliumSpec{},
+				EnableBandwidthPlugin: ptr(true),
+			},
+			expectedError: true,
+		},
+		{
+			name: "WeaveNet CNI config with bandwidth plugin",
+			cniConfig: &kubeoneapi.CNI{
+				WeaveNet:              &kubeoneapi.WeaveNetSpec{},
+				EnableBandwidthPlugin: ptr(true),
+			},
+			expectedError: true,
+		},
+		{
+			name: "valid WeaveNet CNI config with bandwidth plugin disabled",
+			cniConfig: &kubeoneapi.CNI{
+				WeaveNet:              &kubeoneapi.WeaveNetSpec{},
+				EnableBandwidthPlugin: ptr(false),
+			},
+			expectedError: false,
+		},
+		{
+			name: "valid WeaveNet CNI config",
+			cniConfig: &kubeoneapi.CNI{
+				WeaveNet: &kubeoneapi.WeaveNetSpec{},
+			},
+			expectedError: false,
+		},
+		{
+			name: "valid WeaveNet CNI config with encryption enabled",
+			cniConfig: &kubeoneapi.CNI{
+				WeaveNet: &kubeoneapi.WeaveNetSpec{
+					Encrypted: true,
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "valid External CNI config",
+			cniConfig: &kubeoneapi.CNI{
+				External: &kubeoneapi.ExternalCNISpec{},
+			},
+			expectedError: false,
+		},
+		{
+			name: "valid Cilium CNI config",
+			cniConfig: &kubeoneapi.CNI{
+				Cilium: &kubeoneapi.CiliumSpec{
+					KubeProxyReplacement: kubeoneapi.KubeProxyReplacementStrict,
+					EnableHubble:         true,
+					DisableHubbleUI:      true,
+					RoutingMode:          kubeoneapi.CiliumRoutingModeNative,
+					AutoDirectNodeRoutes: true,
+					IPAM:                 kubeoneapi.CiliumIPAMModeKubernetes,
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "valid Cilium CNI config with geneve tunnel",
+			cniConfig: &kubeoneapi.CNI{
+				Cilium: &kubeoneapi.CiliumSpec{
+					RoutingMode:    kubeoneapi.CiliumRoutingModeTunnel,
+					TunnelProtocol: kubeoneapi.CiliumTunnelProtocolGeneve,
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "Cilium CNI config with invalid routing mode",
+			cniConfig: &kubeoneapi.CNI{
+				Cilium: &kubeoneapi.CiliumSpec{
+					RoutingMode: "bgp",
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "Cilium CNI config with tunnel protocol and native routing",
+			cniConfig: &kubeoneapi.CNI{
+				Cilium: &kubeoneapi.CiliumSpec{
+					RoutingMode:    kubeoneapi.CiliumRoutingModeNative,
+					TunnelProtocol: kubeoneapi.CiliumTunnelProtocolVXLAN,
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "Cilium CNI config with invalid IPAM mode",
+			cniConfig: &kubeoneapi.CNI{
+				Cilium: &kubeoneapi.CiliumSpec{
+					IPAM: "eni",
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "Cilium CNI config with Hubble UI disabled without Hubble",
+			cniConfig: &kubeoneapi.CNI{
+				Cilium: &kubeoneapi.CiliumSpec{
+					DisableHubbleUI: true,
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "valid Cilium CNI config with IPsec encryption",
+			cniConfig: &kubeoneapi.CNI{
+				Cilium: &kubeoneapi.CiliumSpec{
+					Encryption: kubeoneapi.CiliumEncryptionIPsec,
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "Cilium CNI config with invalid encryption",
+			cniConfig: &kubeoneapi.CNI{
+				Cilium: &kubeoneapi.CiliumSpec{
+					Encryption: "openvpn",
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "valid Cilium CNI config with bpf host routing",
+			cniConfig: &kubeoneapi.CNI{
+				Cilium: &kubeoneapi.CiliumSpec{
+					KubeProxyReplacement: kubeoneapi.KubeProxyReplacementStrict,
+					HostRouting:          kubeoneapi.CiliumHostRoutingBPF,
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "Cilium CNI config with bpf host routing without kube-proxy replacement",
+			cniConfig: &kubeoneapi.CNI{
+				Cilium: &kubeoneapi.CiliumSpec{
+					KubeProxyReplacement: kubeoneapi.KubeProxyReplacementDisabled,
+					HostRouting:          kubeoneapi.CiliumHostRoutingBPF,
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "Cilium CNI config with invalid host routing",
+			cniConfig: &kubeoneapi.CNI{
+				Cilium: &kubeoneapi.CiliumSpec{
+					HostRouting: "xdp",
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "Cilium CNI config with negative MTU",
+			cniConfig: &kubeoneapi.CNI{
+				Cilium: &kubeoneapi.CiliumSpec{
+					MTU: -1,
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "valid Calico CNI config",
+			cniConfig: &kubeoneapi.CNI{
+				Calico: &kubeoneapi.CalicoSpec{
+					EncapsulationMode: kubeoneapi.CalicoEncapsulationModeIPIP,
+					CrossSubnet:       true,
+					EnableTypha:       true,
+					MTU:               1400,
+					EnableWireGuard:   true,
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "Calico CNI config with negative MTU",
+			cniConfig: &kubeoneapi.CNI{
+				Calico: &kubeoneapi.CalicoSpec{
+					MTU: -1,
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "Calico CNI config with invalid encapsulation mode",
+			cniConfig: &kubeoneapi.CNI{
+				Calico: &kubeoneapi.CalicoSpec{
+					EncapsulationMode: "Geneve",
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "Calico CNI config with cross subnet and without encapsulation",
+			cniConfig: &kubeoneapi.CNI{
+				Calico: &kubeoneapi.CalicoSpec{
+					EncapsulationMode: kubeoneapi.CalicoEncapsulationModeNone,
+					CrossSubnet:       true,
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "Calico and Canal specified at the same time",
+			cniConfig: &kubeoneapi.CNI{
+				Canal:  &kubeoneapi.CanalSpec{MTU: 1500},
+				Calico: &kubeoneapi.CalicoSpec{},
+			},
+			expectedError: true,
+		},
+		{
+			name: "Canal and WeaveNet specified at the same time",
+			cniConfig: &kubeoneapi.CNI{
+				Canal:    &kubeoneapi.CanalSpec{},
+				WeaveNet: &kubeoneapi.WeaveNetSpec{},
+			},
+			expectedError: true,
+		},
+		{
+			name: "Canal and External specified at the same time",
+			cniConfig: &kubeoneapi.CNI{
+				Canal:    &kubeoneapi.CanalSpec{},
+				External: &kubeoneapi.ExternalCNISpec{},
+			},
+			expectedError: true,
+		},
+		{
+			name: "WeaveNet and External specified at the same time",
+			cniConfig: &kubeoneapi.CNI{
+				WeaveNet: &kubeoneapi.WeaveNetSpec{},
+				External: &kubeoneapi.ExternalCNISpec{},
+			},
+			expectedError: true,
+		},
+		{
+			name:          "no CNI config specified",
+			cniConfig:     &kubeoneapi.CNI{},
+			expectedError: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			errs := ValidateCNI(tc.cniConfig, nil)
+			if (len(errs) == 0) == tc.expectedError {
+				t.Errorf("test case failed: expected %v, but got %v", tc.expectedError, (len(errs) != 0))
+			}
+		})
+	}
+}
+
+func TestValidateStaticWorkersConfig(t *testing.T) {
+	tests := []struct {
+		name                string
+		staticWorkersConfig kubeoneapi.StaticWorkersConfig
+		networkConfig       kubeoneapi.ClusterNetworkConfig
+		expectedError       bool
+	}{
+		{
+			name: "valid StaticWorkers config",
+			staticWorkersConfig: kubeoneapi.StaticWorkersConfig{
+				Hosts: []kubeoneapi.HostConfig{
+					{
+						PublicAddress:  "1.1.1.1",
+						PrivateAddress: "10.0.0.1",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+					},
+					{
+						PublicAddress:  "1.1.1.2",
+						PrivateAddress: "10.0.0.2",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+					},
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "no hosts provided",
+			staticWorkersConfig: kubeoneapi.StaticWorkersConfig{
+				Hosts: []kubeoneapi.HostConfig{},
+			},
+			expectedError: false,
+		},
+		{
+			name:                "no hosts field present",
+			staticWorkersConfig: kubeoneapi.StaticWorkersConfig{},
+			expectedError:       false,
+		},
+		{
+			name: "invalid host config",
+			staticWorkersConfig: kubeoneapi.StaticWorkersConfig{
+				Hosts: []kubeoneapi.HostConfig{
+					{
+						PublicAddress:  "1.1.1.1",
+						PrivateAddress: "10.0.0.1",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "ubuntu",
+					},
+					{
+						PublicAddress:  "1.1.1.2",
+						PrivateAddress: "10.0.0.2",
+						SSHAgentSocket: "env:SSH_AUTH_SOCK",
+						SSHUsername:    "",
+					},
+				},
+			},
+			expectedError: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			version := kubeoneapi.VersionConfig{
+				Kubernetes: "1.26.1",
+			}
+			errs := ValidateStaticWorkersConfig(tc.staticWorkersConfig, version, tc.networkConfig, nil)
+			if (len(errs) == 0) == tc.expectedError {
+				t.Errorf("test case failed: expected %v, but got %v", tc.expectedError, (len(errs) != 0))
+			}
+		})
+	}
+}
+
+func TestValidateDynamicWorkerConfig(t *testing.T) {
+	tests := []struct {
+		name                string
+		dynamicWorkerConfig []kubeoneapi.DynamicWorkerConfig
+		provider            kubeoneapi.CloudProviderSpec
+		expectedError       bool
+	}{
+		{
+			name: "valid worker config",
+			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{
+				{
+					Name:     "test-1",
+					Replicas: pointer.New(3),
+				},
+				{
+					Name:     "test-2",
+					Replicas: pointer.New(5),
+				},
+				{
+					Name:     "test-3",
+					Replicas: pointer.New(0),
+				},
+			},
+			provider: kubeoneapi.CloudProviderSpec{
+				AWS: &kubeoneapi.AWSSpec{},
+			},
+			expectedError: false,
+		},
+		{
+			name: "valid vSphere worker config with distinct datastores",
+			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{
+				{
+					Name:     "test-1",
+					Replicas: pointer.New(3),
+					Config: kubeoneapi.ProviderSpec{
+						CloudProviderSpec: []byte(`{"datastore": "ds1", "resourcePool": "pool1", "cluster": "cl1", "vmAntiAffinity": true}`),
+					},
+				},
+				{
+					Name:     "test-2",
+					Replicas: pointer.New(3),
+					Config: kubeoneapi.ProviderSpec{
+						CloudProviderSpec: []byte(`{"datastore": "", "datastoreCluster": "dsc2", "resourcePool": "pool2"}`),
+					},
+				},
+			},
+			provider: kubeoneapi.CloudProviderSpec{
+				Vsphere: &kubeoneapi.VsphereSpec{},
+			},
+			expectedError: false,
+		},
+		{
+			name: "invalid vSphere worker config (both datastore and datastoreCluster)",
+			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{
+				{
+					Name:     "test-1",
+					Replicas: pointer.New(3),
+					Config: kubeoneapi.ProviderSpec{
+						CloudProviderSpec: []byte(`{"datastore": "ds1", "datastoreCluster": "dsc1"}`),
+					},
+				},
+			},
+			provider: kubeoneapi.CloudProviderSpec{
+				Vsphere: &kubeoneapi.VsphereSpec{},
+			},
+			expectedError: true,
+		},
+		{
+			name: "invalid vSphere worker config (vmAntiAffinity without cluster)",
+			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{
+				{
+					Name:     "test-1",
+					Replicas: pointer.New(3),
+					Config: kubeoneapi.ProviderSpec{
+						CloudProviderSpec: []byte(`{"datastore": "ds1", "vmAntiAffinity": true}`),
+					},
+				},
+			},
+			provider: kubeoneapi.CloudProviderSpec{
+				Vsphere: &kubeoneapi.VsphereSpec{},
+			},
+			expectedError: true,
+		},
+		{
+			name:                "valid worker config (no worker defined)",
+			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{},
+			provider: kubeoneapi.CloudProviderSpec{
+				AWS: &kubeoneapi.AWSSpec{},
+			},
+			expectedError: false,
+		},
+		{
+			name: "invalid worker config (replicas not provided)",
+			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{
+				{
+					Name:     "test-1",
+					Replicas: pointer.New(3),
+				},
+				{
+					Name: "test-2",
+				},
+			},
+			provider: kubeoneapi.CloudProviderSpec{
+				AWS: &kubeoneapi.AWSSpec{},
+			},
+			expectedError: true,
+		},
+		{
+			name: "invalid worker config (no name given)",
+			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{
+				{
+					Replicas: pointer.New(3),
+				},
+			},
+			provider: kubeoneapi.CloudProviderSpec{
+				AWS: &kubeoneapi.AWSSpec{},
+			},
+			expectedError: true,
+		},
+		{
+			name: "only machineAnnotations set",
+			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{
+				{
+					Name:     "test-1",
+					Replicas: pointer.New(3),
+					Config: kubeoneapi.ProviderSpec{
+						MachineAnnotations: map[string]string{"test": "test"},
+					},
+				},
+			},
+			provider: kubeoneapi.CloudProviderSpec{
+				AWS: &kubeoneapi.AWSSpec{},
+			},
+			expectedError: false,
+		},
+		{
+			name: "only nodeAnnotations set",
+			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{
+				{
+					Name:     "test-1",
+					Replicas: pointer.New(3),
+					Config: kubeoneapi.ProviderSpec{
+						NodeAnnotations: map[string]string{"test": "test"},
+					},
+				},
+			},
+			provider: kubeoneapi.CloudProviderSpec{
+				AWS: &kubeoneapi.AWSSpec{},
+			},
+			expectedError: false,
+		},
+		{
+			name: "worker pool on another cloud provider",
+			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{
+				{
+					Name:          "test-1",
+					Replicas:      pointer.New(3),
+					CloudProvider: "hetzner",
+				},
+			},
+			provider: kubeoneapi.CloudProviderSpec{
+				None: &kubeoneapi.NoneSpec{},
+			},
+			expectedError: false,
+		},
+		{
+			name: "worker pool on unsupported cloud provider",
+			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{
+				{
+					Name:          "test-1",
+					Replicas:      pointer.New(3),
+					CloudProvider: "proxmox",
+				},
+			},
+			provider: kubeoneapi.CloudProviderSpec{
+				None: &kubeoneapi.NoneSpec{},
+			},
+			expectedError: true,
+		},
+		{
+			name: "worker pool on another cloud provider with external cloud provider",
+			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{
+				{
+					Name:          "test-1",
+					Replicas:      pointer.New(3),
+					CloudProvider: "aws",
+				},
+			},
+			provider: kubeoneapi.CloudProviderSpec{
+				Hetzner:  &kubeoneapi.HetznerSpec{},
+				External: true,
+			},
+			expectedError: true,
+		},
+		{
+			name: "both machineAnnotations and nodeAnnotations set",
+			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{
+				{
+					Name:     "test-1",
+					Replicas: pointer.New(3),
+					Config: kubeoneapi.ProviderSpec{
+						MachineAnnotations: map[string]string{"test": "test"},
+						NodeAnnotations:    map[string]string{"test": "test"},
+					},
+				},
+			},
+			provider: kubeoneapi.CloudProviderSpec{
+				AWS: &kubeoneapi.AWSSpec{},
+			},
+			expectedError: true,
+		},
+		{
+			name: "ipv4 family (aws)",
+			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{
+				{
+					Name:     "test-1",
+					Replicas: pointer.New(3),
+					Config: kubeoneapi.ProviderSpec{
+						Network: &kubeoneapi.ProviderStaticNetworkConfig{
+							IPFamily: kubeoneapi.IPFamilyIPv4,
+						},
+					},
+				},
+			},
+			provider: kubeoneapi.CloudProviderSpec{
+				AWS: &kubeoneapi.AWSSpec{},
+			},
+			expectedError: false,
+		},
+		{
+			name: "ipv4+ipv6 family (aws)",
+			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{
+				{
+					Name:     "test-1",
+					Replicas: pointer.New(3),
+					Config: kubeoneapi.ProviderSpec{
+						Network: &kubeoneapi.ProviderStaticNetworkConfig{
+							IPFamily: kubeoneapi.IPFamilyIPv4IPv6,
+						},
+					},
+				},
+			},
+			provider: kubeoneapi.CloudProviderSpec{
+				AWS: &kubeoneapi.AWSSpec{},
+			},
+			expectedError: false,
+		},
+		{
+			name: "ipv4+ipv6 family (azure)",
+			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{
+				{
+					Name:     "test-1",
+					Replicas: pointer.New(3),
+					Config: kubeoneapi.ProviderSpec{
+						Network: &kubeoneapi.ProviderStaticNetworkConfig{
+							IPFamily: kubeoneapi.IPFamilyIPv4IPv6,
+						},
+					},
+				},
+			},
+			provider: kubeoneapi.CloudProviderSpec{
+				Azure: &kubeoneapi.AzureSpec{},
+			},
+			expectedError: true,
+		},
+		{
+			name: "ipv6+ipv4 family (aws)",
+			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{
+				{
+					Name:     "test-1",
+					Replicas: pointer.New(3),
+					Config: kubeoneapi.ProviderSpec{
+						Network: &kubeoneapi.ProviderStaticNetworkConfig{
+							IPFamily: kubeoneapi.IPFamilyIPv6IPv4,
+						},
+					},
+				},
+			},
+			provider: kubeoneapi.CloudProviderSpec{
+				AWS: &kubeoneapi.AWSSpec{},
+			},
+			expectedError: true,
+		},
+		{
+			name: "ipv6+ipv4 family (azure)",
+			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{
+				{
+					Name:     "test-1",
+					Replicas: pointer.New(3),
+					Config: kubeoneapi.ProviderSpec{
+						Network: &kubeoneapi.ProviderStaticNetworkConfig{
+							IPFamily: kubeoneapi.IPFamilyIPv6IPv4,
+						},
+					},
+				},
+			},
+			provider: kubeoneapi.CloudProviderSpec{
+				Azure: &kubeoneapi.AzureSpec{},
+			},
+			expectedError: true,
+		},
+		{
+			name: "ipv6 family (aws)",
+			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{
+				{
+					Name:     "test-1",
+					Replicas: pointer.New(3),
+					Config: kubeoneapi.ProviderSpec{
+						Network: &kubeoneapi.ProviderStaticNetworkConfig{
+							IPFamily: kubeoneapi.IPFamilyIPv6,
+						},
+					},
+				},
+			},
+			provider: kubeoneapi.CloudProviderSpec{
+				AWS: &kubeoneapi.AWSSpec{},
+			},
+			expectedError: false,
+		},
+		{
+			name: "ipv6 family (azure)",
+			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{
+				{
+					Name:     "test-1",
+					Replicas: pointer.New(3),
+					Config: kubeoneapi.ProviderSpec{
+						Network: &kubeoneapi.ProviderStaticNetworkConfig{
+							IPFamily: kubeoneapi.IPFamilyIPv6,
+						},
+					},
+				},
+			},
+			provider: kubeoneapi.CloudProviderSpec{
+				Azure: &kubeoneapi.AzureSpec{},
+			},
+			expectedError: true,
+		},
+		{
+			name: "valid upgrade strategy",
+			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{
+				{
+					Name:     "test-1",
+					Replicas: pointer.New(10),
+					UpgradeStrategy: &kubeoneapi.MachineDeploymentUpgradeStrategy{
+						Type:           kubeoneapi.MachineDeploymentUpgradeStrategyReplace,
+						MaxSurge:       pointer.New(intstr.FromString("20%")),
+						MaxUnavailable: pointer.New(intstr.FromInt(2)),
+						SkipEviction:   true,
+					},
+				},
+			},
+			provider: kubeoneapi.CloudProviderSpec{
+				AWS: &kubeoneapi.AWSSpec{},
+			},
+			expectedError: false,
+		},
+		{
+			name: "in-place upgrade strategy",
+			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{
+				{
+					Name:     "test-1",
+					Replicas: pointer.New(3),
+					UpgradeStrategy: &kubeoneapi.MachineDeploymentUpgradeStrategy{
+						Type: kubeoneapi.MachineDeploymentUpgradeStrategyInPlace,
+					},
+				},
+			},
+			provider: kubeoneapi.CloudProviderSpec{
+				AWS: &kubeoneapi.AWSSpec{},
+			},
+			expectedError: true,
+		},
+		{
+			name: "invalid maxSurge percentage",
+			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{
+				{
+					Name:     "test-1",
+					Replicas: pointer.New(3),
+					UpgradeStrategy: &kubeoneapi.MachineDeploymentUpgradeStrategy{
+						MaxSurge: pointer.New(intstr.FromString("twenty")),
+					},
+				},
+			},
+			provider: kubeoneapi.CloudProviderSpec{
+				AWS: &kubeoneapi.AWSSpec{},
+			},
+			expectedError: true,
+		},
+		{
+			name: "maxUnavailable 0 with static network",
+			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{
+				{
+					Name:     "test-1",
+					Replicas: pointer.New(3),
+					UpgradeStrategy: &kubeoneapi.MachineDeploymentUpgradeStrategy{
+						MaxUnavailable: pointer.New(intstr.FromInt(0)),
+					},
+					Config: kubeoneapi.ProviderSpec{
+						Network: &kubeoneapi.ProviderStaticNetworkConfig{
+							CIDR: "192.168.0.10/24",
+						},
+					},
+				},
+			},
+			provider: kubeoneapi.CloudProviderSpec{
+				AWS: &kubeoneapi.AWSSpec{},
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			errs := ValidateDynamicWorkerConfig(tc.dynamicWorkerConfig, tc.provider, nil)
+			if (len(errs) == 0) == tc.expectedError {
+				t.Errorf("test case failed: expected %v, but got %v", tc.expectedError, (len(errs) != 0))
+			}
+		})
+	}
+}
+
+func TestValidateCABundle(t *testing.T) {
+	tests := []struct {
+		name          string
+		caBundle      string
+		expectedError bool
+	}{
+		{
+			name:          "empty",
+			caBundle:      "",
+			expectedError: false,
+		},
+		{
+			name: "correct",
+			caBundle: heredoc.Doc(`
+				## some comments
+
+				GlobalSign Root CA
+				==================
+				-----BEGIN CERTIFICATE-----
+				MIIDdTCCAl2gAwIBAgILBAAAAAABFUtaw5QwDQYJKoZIhvcNAQEFBQAwVzELMAkGA1UEBhMCQkUx
+				GTAXBgNVBAoTEEdsb2JhbFNpZ24gbnYtc2ExEDAOBgNVBAsTB1Jvb3QgQ0ExGzAZBgNVBAMTEkds
+				b2JhbFNpZ24gUm9vdCBDQTAeFw05ODA5MDExMjAwMDBaFw0yODAxMjgxMjAwMDBaMFcxCzAJBgNV
+				BAYTAkJFMRkwFwYDVQQKExBHbG9iYWxTaWduIG52LXNhMRAwDgYDVQQLEwdSb290IENBMRswGQYD
+				VQQDExJHbG9iYWxTaWduIFJvb3QgQ0EwggEiMA0GCSqGSIb3DQEBAQUAA4IBDwAwggEKAoIBAQDa
+				DuaZjc6j40+Kfvvxi4Mla+pIH/EqsLmVEQS98GPR4mdmzxzdzxtIK+6NiY6arymAZavpxy0Sy6sc
+				THAHoT0KMM0VjU/43dSMUBUc71DuxC73/OlS8pF94G3VNTCOXkNz8kHp1Wrjsok6Vjk4bwY8iGlb
+				Kk3Fp1S4bInMm/k8yuX9ifUSPJJ4ltbcdG6TRGHRjcdGsnUOhugZitVtbNV4FpWi6cgKOOvyJBNP
+				c1STE4U6G7weNLWLBYy5d4ux2x8gkasJU26Qzns3dLlwR5EiUWMWea6xrkEmCMgZK9FGqkjWZCrX
+				gzT/LCrBbBlDSgeF59N89iFo7+ryUp9/k5DPAgMBAAGjQjBAMA4GA1UdDwEB/wQEAwIBBjAPBgNV
+				HRMBAf8EBTADAQH/MB0GA1UdDgQWBBRge2YaRQ2XyolQL30EzTSo//z9SzANBgkqhkiG9w0BAQUF
+				AAOCAQEA1nPnfE920I2/7LqivjTFKDK1fPxsnCwrvQmeU79rXqoRSLblCKOzyj1hTdNGCbM+w6Dj
+				Y1Ub8rrvrTnhQ7k4o+YviiY776BQVvnGCv04zcQLcFGUl5gE38NflNUVyRRBnMRddWQVDf9VMOyG
+				j/8N7yy5Y0b2qvzfvGn9LhJIZJrglfCm7ymPAbEVtQwdpf5pLGkkeB6zpxxxYu7KyJesF12KwvhH
+				hm4qxFYxldBniYUr+WymXUadDKqC5JlR3XC321Y9YeRq4VzW9v493kHMB65jUr9TU/Qr6cf9tveC
+				X4XSQRjbgbMEHMUfpIBvFSDJ3gyICh3WZlXi/EjJKSZp4A==
+				-----END CERTIFICATE-----
+			`),
+			expectedError: false,
+		},
+		{
+			name: "no certs but with comments",
+			caBundle: heredoc.Doc(`
+				# leading comment
+				## additional comment
+			`),
+			expectedError: true,
+		},
+		{
+			name:          "incorrect",
+			caBundle:      "garbage",
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			errs := ValidateCABundle(tc.caBundle, field.NewPath("caBundle"))
+			if (len(errs) == 0) == tc.expectedError {
+				t.Logf("failed value:\n%q", tc.caBundle)
+				t.Errorf("test case failed: expected %v, but got %v", tc.expectedError, (len(errs) != 0))
+			}
+		})
+	}
+}
+
+func TestValidateAdditionalTrustedCAs(t *testing.T) {
+	trustedCAs := heredoc.Doc(`
+		## some comments
+
+		GlobalSign Root CA
+		==================
+		-----BEGIN CERTIFICATE-----
+		MIIDdTCCAl2gAwIBAgILBAAAAAABFUtaw5QwDQYJKoZIhvcNAQEFBQAwVzELMAkGA1UEBhMCQkUx
+		GTAXBgNVBAoTEEdsb2JhbFNpZ24gbnYtc2ExEDAOBgNVBAsTB1Jvb3QgQ0ExGzAZBgNVBAMTEkds
+		b2JhbFNpZ24gUm9vdCBDQTAeFw05ODA5MDExMjAwMDBaFw0yODAxMjgxMjAwMDBaMFcxCzAJBgNV
+		BAYTAkJFMRkwFwYDVQQKExBHbG9iYWxTaWduIG52LXNhMRAwDgYDVQQLEwdSb290IENBMRswGQYD
+		VQQDExJHbG9iYWxTaWduIFJvb3QgQ0EwggEiMA0GCSqGSIb3DQEBAQUAA4IBDwAwggEKAoIBAQDa
+		DuaZjc6j40+Kfvvxi4Mla+pIH/EqsLmVEQS98GPR4mdmzxzdzxtIK+6NiY6arymAZavpxy0Sy6sc
+		THAHoT0KMM0VjU/43dSMUBUc71DuxC73/OlS8pF94G3VNTCOXkNz8kHp1Wrjsok6Vjk4bwY8iGlb
+		Kk3Fp1S4bInMm/k8yuX9ifUSPJJ4ltbcdG6TRGHRjcdGsnUOhugZitVtbNV4FpWi6cgKOOvyJBNP
+		c1STE4U6G7weNLWLBYy5d4ux2x8gkasJU26Qzns3dLlwR5EiUWMWea6xrkEmCMgZK9FGqkjWZCrX
+		gzT/LCrBbBlDSgeF59N89iFo7+ryUp9/k5DPAgMBAAGjQjBAMA4GA1UdDwEB/wQEAwIBBjAPBgNV
+		HRMBAf8EBTADAQH/MB0GA1UdDgQWBBRge2YaRQ2XyolQL30EzTSo//z9SzANBgkqhkiG9w0BAQUF
+		AAOCAQEA1nPnfE920I2/7LqivjTFKDK1fPxsnCwrvQmeU79rXqoRSLblCKOzyj1hTdNGCbM+w6Dj
+		Y1Ub8rrvrTnhQ7k4o+YviiY776BQVvnGCv04zcQLcFGUl5gE38NflNUVyRRBnMRddWQVDf9VMOyG
+		j/8N7yy5Y0b2qvzfvGn9LhJIZJrglfCm7ymPAbEVtQwdpf5pLGkkeB6zpxxxYu7KyJesF12KwvhH
+		hm4qxFYxldBniYUr+WymXUadDKqC5JlR3XC321Y9YeRq4VzW9v493kHMB65jUr9TU/Qr6cf9tveC
+		X4XSQRjbgbMEHMUfpIBvFSDJ3gyICh3WZlXi/EjJKSZp4A==
+		-----END CERTIFICATE-----
+	`)
+
+	tests := []struct {
+		name           string
+		trustedCAs     string
+		dynamicWorkers []kubeoneapi.DynamicWorkerConfig
+		expectedError  bool
+	}{
+		{
+			name:          "empty",
+			expectedError: false,
+		},
+		{
+			name:          "trusted CAs without dynamic workers",
+			trustedCAs:    trustedCAs,
+			expectedError: false,
+		},
+		{
+			name:       "trusted CAs with dynamic workers",
+			trustedCAs: trustedCAs,
+			dynamicWorkers: []kubeoneapi.DynamicWorkerConfig{
+				{Name: "pool1"},
+			},
+			expectedError: true,
+		},
+		{
+			name: "dynamic workers without trusted CAs",
+			dynamicWorkers: []kubeoneapi.DynamicWorkerConfig{
+				{Name: "pool1"},
+			},
+			expectedError: false,
+		},
+		{
+			name:          "invalid trusted CAs",
+			trustedCAs:    "garbage",
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			errs := ValidateAdditionalTrustedCAs(tc.trustedCAs, tc.dynamicWorkers, field.NewPath("additionalTrustedCAs"))
+			if (len(errs) == 0) == tc.expectedError {
+				t.Errorf("test case failed: expected %v, but got %v", tc.expectedError, (len(errs) != 0))
+			}
+		})
+	}
+}
+
+func TestValidateFeatures(t *testing.T) {
+	tests := []struct {
+		name          string
+		features      kubeoneapi.Features
+		versions      kubeoneapi.VersionConfig
+		expectedError bool
+	}{
+		{
+			name: "psp and auditing enabled",
+			features: kubeoneapi.Features{
+				PodSecurityPolicy: &kubeoneapi.PodSecurityPolicy{
+					Enable: true,
+				},
+				DynamicAuditLog: &kubeoneapi.DynamicAuditLog{
+					Enable: true,
+				},
+			},
+			versions: kubeoneapi.VersionConfig{
+				Kubernetes: "1.20.2",
+			},
+			expectedError: false,
+		},
+		{
+			name: "psp enabled on 1.25",
+			features: kubeoneapi.Features{
+				PodSecurityPolicy: &kubeoneapi.PodSecurityPolicy{
+					Enable: true,
+				},
+			},
+			versions: kubeoneapi.VersionConfig{
+				Kubernetes: "1.25.5",
+			},
+			expectedError: true,
+		},
+		{
+			name: "psp enabled on 1.26",
+			features: kubeoneapi.Features{
+				PodSecurityPolicy: &kubeoneapi.PodSecurityPolicy{
+					Enable: true,
+				},
+			},
+			versions: kubeoneapi.VersionConfig{
+				Kubernetes: "1.26.0",
+			},
+			expectedError: true,
+		},
+		{
+			name: "metrics server disabled",
+			features: kubeoneapi.Features{
+				MetricsServer: &kubeoneapi.MetricsServer{
+					Enable: false,
+				},
+			},
+			versions: kubeoneapi.VersionConfig{
+				Kubernetes: "1.20.2",
+			},
+			expectedError: false,
+		},
+		{
+			name:     "no feature configured",
+			features: kubeoneapi.Features{},
+			versions: kubeoneapi.VersionConfig{
+				Kubernetes: "1.20.2",
+			},
+			expectedError: false,
+		},
+		{
+			name: "oidc enabled",
+			features: kubeoneapi.Features{
+				OpenIDConnect: &kubeoneapi.OpenIDConnect{
+					Enable: true,
+					Config: kubeoneapi.OpenIDConnectConfig{
+						IssuerURL:     "test.cluster.local",
+						ClientID:      "123",
+						RequiredClaim: "test",
+					},
+				},
+			},
+			versions: kubeoneapi.VersionConfig{
+				Kubernetes: "1.20.2",
+			},
+			expectedError: false,
+		},
+		{
+			name: "invalid staticAudit config",
+			features: kubeoneapi.Features{
+				StaticAuditLog: &kubeoneapi.StaticAuditLog{
+					Enable: true,
+					Config: kubeoneapi.StaticAuditLogConfig{},
+				},
+			},
+			versions: kubeoneapi.VersionConfig{
+				Kubernetes: "1.20.2",
+			},
+			expectedError: true,
+		},
+		{
+			name: "invalid oidc config",
+			features: kubeoneapi.Features{
+				OpenIDConnect: &kubeoneapi.OpenIDConnect{
+					Enable: true,
+					Config: kubeoneapi.OpenIDConnectConfig{},
+				},
+			},
+			versions: kubeoneapi.VersionConfig{
+				Kubernetes: "1.20.2",
+			},
+			expectedError: true,
+		},
+		{
+			name: "invalid podNodeSelector config",
+			features: kubeoneapi.Features{
+				PodNodeSelector: &kubeoneapi.PodNodeSelector{
+					Enable: true,
+					Config: kubeoneapi.PodNodeSelectorConfig{},
+				},
+			},
+			versions: kubeoneapi.VersionConfig{
+				Kubernetes: "1.20.2",
+			},
+			expectedError: true,
+		},
+		{
+			name: "coredns replicas > 0",
+			features: kubeoneapi.Features{
+				CoreDNS: &kubeoneapi.CoreDNS{
+					Replicas: pointer.New(int32(2)),
+				},
+			},
+			versions: kubeoneapi.VersionConfig{
+				Kubernetes: "1.20.2",
+			},
+			expectedError: false,
+		},
+		{
+			name: "coredns replicas = 0",
+			features: kubeoneapi.Features{
+				CoreDNS: &kubeoneapi.CoreDNS{
+					Replicas: pointer.New(int32(0)),
+				},
+			},
+			versions: kubeoneapi.VersionConfig{
+				Kubernetes: "1.20.2",
+			},
+			expectedError: false,
+		},
+		{
+			name: "coredns replicas < 0",
+			features: kubeoneapi.Features{
+				CoreDNS: &kubeoneapi.CoreDNS{
+					Replicas: pointer.New(int32(-1)),
+				},
+			},
+			versions: kubeoneapi.VersionConfig{
+				Kubernetes: "1.20.2",
+			},
+			expectedError: true,
+		},
+		{
+			name: "metrics-server replicas and resources",
+			features: kubeoneapi.Features{
+				MetricsServer: &kubeoneapi.MetricsServer{
+					Enable:   true,
+					Replicas: pointer.New(int32(2)),
+					Resources: &corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceMemory: resource.MustParse("300Mi"),
+						},
+						Limits: corev1.ResourceList{
+							corev1.ResourceMemory: resource.MustParse("1Gi"),
+						},
+					},
+				},
+			},
+			versions: kubeoneapi.VersionConfig{
+				Kubernetes: "1.20.2",
+			},
+			expectedError: false,
+		},
+		{
+			name: "metrics-server replicas = 0",
+			features: kubeoneapi.Features{
+				MetricsServer: &kubeoneapi.MetricsServer{
+					Enable:   true,
+					Replicas: pointer.New(int32(0)),
+				},
+			},
+			versions: kubeoneapi.VersionConfig{
+				Kubernetes: "1.20.2",
+			},
+			expectedError: true,
+		},
+		{
+			name: "metrics-server requests exceed limits",
+			features: kubeoneapi.Features{
+				MetricsServer: &kubeoneapi.MetricsServer{
+					Enable: true,
+					Resources: &corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU: resource.MustParse("2"),
+						},
+						Limits: corev1.ResourceList{
+							corev1.ResourceCPU: resource.MustParse("1"),
+						},
+					},
+				},
+			},
+			versions: kubeoneapi.VersionConfig{
+				Kubernetes: "1.20.2",
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			errs := ValidateFeatures(tc.features, tc.versions, nil)
+			if (len(errs) == 0) == tc.expectedError {
+				t.Errorf("test case failed: expected %v, but got %v", tc.expectedError, (len(errs) != 0))
+			}
+		})
+	}
+}
+
+func TestValidatePodNodeSelectorConfig(t *testing.T) {
+	tests := []struct {
+		name                  string
+		podNodeSelectorConfig kubeoneapi.PodNodeSelectorConfig
+		expectedError         bool
+	}{
+		{
+			name: "valid podNodeSelector config",
+			podNodeSelectorConfig: kubeoneapi.PodNodeSelectorConfig{
+				ConfigFilePath: "./podnodeselector.yaml",
+			},
+			expectedError: false,
+		},
+		{
+			name:                  "invalid podNodeSelector config",
+			podNodeSelectorConfig: kubeoneapi.PodNodeSelectorConfig{},
+			expectedError:         true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			errs := ValidatePodNodeSelectorConfig(tc.podNodeSelectorConfig, nil)
+			if (len(errs) == 0) == tc.expectedError {
+				t.Errorf("test case failed: expected %v, but got %v", tc.expectedError, (len(errs) != 0))
+			}
+		})
+	}
+}
+
+func TestValidateStaticAuditLogConfig(t *testing.T) {
+	tests := []struct {
+		name                 string
+		staticAuditLogConfig kubeoneapi.StaticAuditLogConfig
+		expectedError        bool
+	}{
+		{
+			name: "valid staticAuditLog config",
+			staticAuditLogConfig: kubeoneapi.StaticAuditLogConfig{
+				PolicyFilePath: "/etc/kubernetes/policy.yaml",
+				LogPath:        "/var/log/kubernetes",
+				LogMaxAge:      10,
+				LogMaxBackup:   10,
+				LogMaxSize:     100,
+			},
+			expectedError: false,
+		},
+		{
+			name: "policy file path missing",
+			staticAuditLogConfig: kubeoneapi.StaticAuditLogConfig{
+				LogPath:      "/var/log/kubernetes",
+				LogMaxAge:    10,
+				LogMaxBackup: 10,
+				LogMaxSize:   100,
+			},
+			expectedError: true,
+		},
+		{
+			name: "log file path missing",
+			staticAuditLogConfig: kubeoneapi.StaticAuditLogConfig{
+				PolicyFilePath: "/etc/kubernetes/policy.yaml",
+				LogMaxAge:      10,
+				LogMaxBackup:   10,
+				LogMaxSize:     100,
+			},
+			expectedError: true,
+		},
+		{
+			name: "log max age set to 0",
+			staticAuditLogConfig: kubeoneapi.StaticAuditLogConfig{
+				PolicyFilePath: "/etc/kubernetes/policy.yaml",
+				LogPath:        "/var/log/kubernetes",
+				LogMaxAge:      0,
+				LogMaxBackup:   10,
+				LogMaxSize:     100,
+			},
+			expectedError: true,
+		},
+		{
+			name: "log max backup set to 0",
+			staticAuditLogConfig: kubeoneapi.StaticAuditLogConfig{
+				PolicyFilePath: "/etc/kubernetes/policy.yaml",
+				LogPath:        "/var/log/kubernetes",
+				LogMaxAge:      10,
+				LogMaxBackup:   0,
+				LogMaxSize:     100,
+			},
+			expectedError: true,
+		},
+		{
+			name: "log max size set to 0",
+			staticAuditLogConfig: kubeoneapi.StaticAuditLogConfig{
+				PolicyFilePath: "/etc/kubernetes/policy.yaml",
+				LogPath:        "/var/log/kubernetes",
+				LogMaxAge:      10,
+				LogMaxBackup:   10,
+				LogMaxSize:     0,
+			},
+			expectedError: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			errs := ValidateStaticAuditLogConfig(tc.staticAuditLogConfig, nil)
+			if (len(errs) == 0) == tc.expectedError {
+				t.Errorf("test case failed: expected %v, but got %v", tc.expectedError, (len(errs) != 0))
+			}
+		})
+	}
+}
+
+func TestValidateOIDCConfig(t *testing.T) {
+	tests := []struct {
+		name          string
+		oidcConfig    kubeoneapi.OpenIDConnectConfig
+		expectedError bool
+	}{
+		{
+			name: "valid oidc config",
+			oidcConfig: kubeoneapi.OpenIDConnectConfig{
+				IssuerURL: "test.cluster.local",
+				ClientID:  "test",
+			},
+			expectedError: false,
+		},
+		{
+			name: "no issuer url",
+			oidcConfig: kubeoneapi.OpenIDConnectConfig{
+				ClientID: "test",
+			},
+			expectedError: true,
+		},
+		{
+			name: "no client id",
+			oidcConfig: kubeoneapi.OpenIDConnectConfig{
+				IssuerURL: "test.cluster.local",
+			},
+			expectedError: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			errs := ValidateOIDCConfig(tc.oidcConfig, nil)
+			if (len(errs) == 0) == tc.expectedError {
+				t.Errorf("test case failed: expected %v, but got %v", tc.expectedError, (len(errs) != 0))
+			}
+		})
+	}
+}
+
+func TestValidateAddons(t *testing.T) {
+	tests := []struct {
+		name          string
+		addons        *kubeoneapi.Addons
+		expectedError bool
+	}{
+		{
+			name: "valid addons config (enabled)",
+			addons: &kubeoneapi.Addons{
+				Enable: true,
+				Path:   "./addons",
+			},
+			expectedError: false,
+		},
+		{
+			name: "addons enabled, no path set and no embedded addons specified",
+			addons: &kubeoneapi.Addons{
+				Enable: true,
+				Path:   "",
+			},
+			expectedError: true,
+		},
+		{
+			name: "embedded addon enabled, no path set",
+			addons: &kubeoneapi.Addons{
+				Enable: true,
+				Path:   "",
+				Addons: []kubeoneapi.Addon{
+					{
+						Name: resources.AddonMachineController,
+					},
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "valid OCI addons reference",
+			addons: &kubeoneapi.Addons{
+				Enable: true,
+				Path:   "oci://registry.example.com/addons/monitoring:1.2.3",
+			},
+			expectedError: false,
+		},
+		{
+			name: "valid OCI addons reference with digest",
+			addons: &kubeoneapi.Addons{
+				Enable: true,
+				Path:   "oci://registry.example.com/addons/monitoring:1.2.3@sha256:4f53cda18c2baa0c0354bb5f9a3ecbe5ed12ab4d8e11ba873c2f11161202b945",
+			},
+			expectedError: false,
+		},
+		{
+			name: "valid git addons source",
+			addons: &kubeoneapi.Addons{
+				Enable: true,
+				Source: &kubeoneapi.AddonsSource{
+					Git: &kubeoneapi.AddonsGitSource{
+						URL:  "https://github.com/example/addons.git",
+						Ref:  "v1.2.3",
+						Path: "clusters/production",
+					},
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "valid HTTP addons source",
+			addons: &kubeoneapi.Addons{
+				Enable: true,
+				Source: &kubeoneapi.AddonsSource{
+					HTTP: &kubeoneapi.AddonsHTTPSource{
+						URL:    "https://example.com/addons-1.2.3.tar.gz",
+						SHA256: "4f53cda18c2baa0c0354bb5f9a3ecbe5ed12ab4d8e11ba873c2f11161202b945",
+					},
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "addons source set along with path",
+			addons: &kubeoneapi.Addons{
+				Enable: true,
+				Path:   "./addons",
+				Source: &kubeoneapi.AddonsSource{
+					Git: &kubeoneapi.AddonsGitSource{
+						URL: "https://github.com/example/addons.git",
+					},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "both git and HTTP addons sources",
+			addons: &kubeoneapi.Addons{
+				Enable: true,
+				Source: &kubeoneapi.AddonsSource{
+					Git: &kubeoneapi.AddonsGitSource{
+						URL: "https://github.com/example/addons.git",
+					},
+					HTTP: &kubeoneapi.AddonsHTTPSource{
+						URL:    "https://example.com/addons-1.2.3.tar.gz",
+						SHA256: "4f53cda18c2baa0c0354bb5f9a3ecbe5ed12ab4d8e11ba873c2f11161202b945",
+					},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "git addons source URL starting with a dash",
+			addons: &kubeoneapi.Addons{
+				Enable: true,
+				Source: &kubeoneapi.AddonsSource{
+					Git: &kubeoneapi.AddonsGitSource{
+						URL: "--upload-pack=touch /tmp/pwned",
+					},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "git addons source ref starting with a dash",
+			addons: &kubeoneapi.Addons{
+				Enable: true,
+				Source: &kubeoneapi.AddonsSource{
+					Git: &kubeoneapi.AddonsGitSource{
+						URL: "https://github.com/example/addons.git",
+						Ref: "--upload-pack=touch /tmp/pwned",
+					},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "git addons source path outside of the repository",
+			addons: &kubeoneapi.Addons{
+				Enable: true,
+				Source: &kubeoneapi.AddonsSource{
+					Git: &kubeoneapi.AddonsGitSource{
+						URL:  "https://github.com/example/addons.git",
+						Path: "../addons",
+					},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "HTTP addons source without HTTPS",
+			addons: &kubeoneapi.Addons{
+				Enable: true,
+				Source: &kubeoneapi.AddonsSource{
+					HTTP: &kubeoneapi.AddonsHTTPSource{
+						URL:    "http://example.com/addons-1.2.3.tar.gz",
+						SHA256: "4f53cda18c2baa0c0354bb5f9a3ecbe5ed12ab4d8e11ba873c2f11161202b945",
+					},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "HTTP addons source with invalid checksum",
+			addons: &kubeoneapi.Addons{
+				Enable: true,
+				Source: &kubeoneapi.AddonsSource{
+					HTTP: &kubeoneapi.AddonsHTTPSource{
+						URL:    "https://example.com/addons-1.2.3.tar.gz",
+						SHA256: "1234",
+					},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "valid addons verification with public key",
+			addons: &kubeoneapi.Addons{
+				Enable: true,
+				Path:   "oci://registry.example.com/addons/monitoring:1.2.3",
+				Verification: &kubeoneapi.AddonsVerification{
+					PublicKey: "-----BEGIN PUBLIC KEY-----\nMFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAE\n-----END PUBLIC KEY-----\n",
+					Images:    true,
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "valid keyless addons verification",
+			addons: &kubeoneapi.Addons{
+				Enable: true,
+				Source: &kubeoneapi.AddonsSource{
+					HTTP: &kubeoneapi.AddonsHTTPSource{
+						URL:    "https://example.com/addons-1.2.3.tar.gz",
+						SHA256: "4f53cda18c2baa0c0354bb5f9a3ecbe5ed12ab4d8e11ba873c2f11161202b945",
+					},
+				},
+				Verification: &kubeoneapi.AddonsVerification{
+					CertificateIdentity:   "https://github.com/example/addons/.github/workflows/release.yaml@refs/heads/main",
+					CertificateOIDCIssuer: "https://token.actions.githubusercontent.com",
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "keyless addons verification without OIDC issuer",
+			addons: &kubeoneapi.Addons{
+				Enable: true,
+				Path:   "oci://registry.example.com/addons/monitoring:1.2.3",
+				Verification: &kubeoneapi.AddonsVerification{
+					CertificateIdentity: "release@example.com",
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "addons verification without public key or identity",
+			addons: &kubeoneapi.Addons{
+				Enable:       true,
+				Path:         "oci://registry.example.com/addons/monitoring:1.2.3",
+				Verification: &kubeoneapi.AddonsVerification{},
+			},
+			expectedError: true,
+		},
+		{
+			name: "addons verification with the local addons directory",
+			addons: &kubeoneapi.Addons{
+				Enable: true,
+				Path:   "./addons",
+				Verification: &kubeoneapi.AddonsVerification{
+					PublicKey: "-----BEGIN PUBLIC KEY-----\nMFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAE\n-----END PUBLIC KEY-----\n",
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "OCI addons reference without tag or digest",
+			addons: &kubeoneapi.Addons{
+				Enable: true,
+				Path:   "oci://registry.example.com/addons/monitoring",
+			},
+			expectedError: true,
+		},
+		{
+			name: "invalid OCI addons reference",
+			addons: &kubeoneapi.Addons{
+				Enable: true,
+				Path:   "oci://registry.example.com/Addons/monitoring:1.2.3",
+			},
+			expectedError: true,
+		},
+		{
+			name: "valid addons config (disabled)",
+			addons: &kubeoneapi.Addons{
+				Enable: false,
+			},
+			expectedError: false,
+		},
+		{
+			name:          "valid addons config (empty)",
+			addons:        &kubeoneapi.Addons{},
+			expectedError: false,
+		},
+		{
+			name:          "valid addons config (nil)",
+			addons:        nil,
+			expectedError: false,
+		},
+		{
+			name: "valid addon dependencies and readiness checks",
+			addons: &kubeoneapi.Addons{
+				Enable: true,
+				Path:   "./addons",
+				Addons: []kubeoneapi.Addon{
+					{
+						Name: "cert-manager",
+						ReadinessChecks: []kubeoneapi.AddonReadinessCheck{
+							{Kind: kubeoneapi.AddonReadinessCheckKindCustomResourceDefinition, Name: "certificates.cert-manager.io"},
+							{Kind: kubeoneapi.AddonReadinessCheckKindDeployment, Name: "cert-manager-webhook", Namespace: "cert-manager"},
+						},
+					},
+					{
+						Name:      "issuers",
+						DependsOn: []string{"cert-manager"},
+					},
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "addon depends on itself",
+			addons: &kubeoneapi.Addons{
+				Enable: true,
+				Path:   "./addons",
+				Addons: []kubeoneapi.Addon{
+					{
+						Name:      "issuers",
+						DependsOn: []string{"issuers"},
+					},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "addon dependency cycle",
+			addons: &kubeoneapi.Addons{
+				Enable: true,
+				Path:   "./addons",
+				Addons: []kubeoneapi.Addon{
+					{
+						Name:      "a",
+						DependsOn: []string{"b"},
+					},
+					{
+						Name:      "b",
+						DependsOn: []string{"c"},
+					},
+					{
+						Name:      "c",
+						DependsOn: []string{"a"},
+					},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "addon depends on the deleted addon",
+			addons: &kubeoneapi.Addons{
+				Enable: true,
+				Path:   "./addons",
+				Addons: []kubeoneapi.Addon{
+					{
+						Name:   "cert-manager",
+						Delete: true,
+					},
+					{
+						Name:      "issuers",
+						DependsOn: []string{"cert-manager"},
+					},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "readiness check without namespace",
+			addons: &kubeoneapi.Addons{
+				Enable: true,
+				Path:   "./addons",
+				Addons: []kubeoneapi.Addon{
+					{
+						Name: "cert-manager",
+						ReadinessChecks: []kubeoneapi.AddonReadinessCheck{
+							{Kind: kubeoneapi.AddonReadinessCheckKindDeployment, Name: "cert-manager-webhook"},
+						},
+					},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "readiness check with unsupported kind",
+			addons: &kubeoneapi.Addons{
+				Enable: true,
+				Path:   "./addons",
+				Addons: []kubeoneapi.Addon{
+					{
+						Name: "cert-manager",
+						ReadinessChecks: []kubeoneapi.AddonReadinessCheck{
+							{Kind: "Pod", Name: "cert-manager-startupapicheck", Namespace: "cert-manager"},
+						},
+					},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "valid addon phases",
+			addons: &kubeoneapi.Addons{
+				Enable: true,
+				Path:   "./addons",
+				Addons: []kubeoneapi.Addon{
+					{
+						Name:  "registry-credentials",
+						Phase: kubeoneapi.AddonPhasePreKubeadm,
+					},
+					{
+						Name:      "node-prep",
+						Phase:     kubeoneapi.AddonPhasePreKubeadm,
+						DependsOn: []string{"registry-credentials"},
+					},
+					{
+						Name:  "cleanup",
+						Phase: kubeoneapi.AddonPhasePreReset,
+						ReadinessChecks: []kubeoneapi.AddonReadinessCheck{
+							{Kind: kubeoneapi.AddonReadinessCheckKindJob, Name: "cleanup", Namespace: "kube-system"},
+						},
+					},
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "addon with unsupported phase",
+			addons: &kubeoneapi.Addons{
+				Enable: true,
+				Path:   "./addons",
+				Addons: []kubeoneapi.Addon{
+					{
+						Name:  "cleanup",
+						Phase: "PostReset",
+					},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "addon depends on the addon applied in another phase",
+			addons: &kubeoneapi.Addons{
+				Enable: true,
+				Path:   "./addons",
+				Addons: []kubeoneapi.Addon{
+					{
+						Name:  "registry-credentials",
+						Phase: kubeoneapi.AddonPhasePreKubeadm,
+					},
+					{
+						Name:      "node-prep",
+						Phase:     kubeoneapi.AddonPhasePostJoin,
+						DependsOn: []string{"registry-credentials"},
+					},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "phase set for the deleted addon",
+			addons: &kubeoneapi.Addons{
+				Enable: true,
+				Path:   "./addons",
+				Addons: []kubeoneapi.Addon{
+					{
+						Name:   "cleanup",
+						Delete: true,
+						Phase:  kubeoneapi.AddonPhasePreReset,
+					},
+				},
+			},
+			expectedError: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			errs := ValidateAddons(tc.addons, nil)
+			if (len(errs) == 0) == tc.expectedError {
+				t.Log(errs[0])
+				t.Errorf("test case failed: expected %v, but got %v", tc.expectedError, (len(errs) != 0))
+			}
+		})
+	}
+}
+
+func TestValidateHostConfig(t *testing.T) {
+	tests := []struct {
+		name          string
+		hostConfig    []kubeoneapi.HostConfig
+		networkConfig kubeoneapi.ClusterNetworkConfig
+		versionConfig kubeoneapi.VersionConfig
+		expectedError bool
+	}{
+		{
+			name: "host config with ip addresses",
+			hostConfig: []kubeoneapi.HostConfig{
+				{
+					PublicAddress:     "192.168.1.1",
+					PrivateAddress:    "192.168.0.1",
+					SSHPrivateKeyFile: "test",
+					SSHAgentSocket:    "test",
+					SSHUsername:       "root",
+				},
+			},
+			versionConfig: kubeoneapi.VersionConfig{
+				Kubernetes: "1.26.1",
+			},
+			expectedError: false,
+		},
+		{
+			name: "host config with dns domain",
+			hostConfig: []kubeoneapi.HostConfig{
+				{
+					PublicAddress:     "cluster-test.public.local",
+					PrivateAddress:    "cluster-test.private.local",
+					SSHPrivateKeyFile: "test",
+					SSHAgentSocket:    "test",
+					SSHUsername:       "root",
+				},
+			},
+			versionConfig: kubeoneapi.VersionConfig{
+				Kubernetes: "1.26.1",
+			},
+			expectedError: false,
+		},
+		{
+			name: "host config with redfish bmc",
+			hostConfig: []kubeoneapi.HostConfig{
+				{
+					PublicAddress:     "192.168.1.1",
+					PrivateAddress:    "192.168.0.1",
+					SSHPrivateKeyFile: "test",
+					SSHAgentSocket:    "test",
+					SSHUsername:       "root",
+					BMC: &kubeoneapi.BMCConfig{
+						Protocol:   kubeoneapi.BMCProtocolRedfish,
+						Address:    "https://10.0.0.10",
+						Username:   "admin",
+						Password:   "env:BMC_PASSWORD",
+						BootDevice: kubeoneapi.BMCBootDevicePXE,
+					},
+				},
+			},
+			versionConfig: kubeoneapi.VersionConfig{
+				Kubernetes: "1.26.1",
+			},
+			expectedError: false,
+		},
+		{
+			name: "host config with redfish bmc and virtual media",
+			hostConfig: []kubeoneapi.HostConfig{
+				{
+					PublicAddress:     "192.168.1.1",
+					PrivateAddress:    "192.168.0.1",
+					SSHPrivateKeyFile: "test",
+					SSHAgentSocket:    "test",
+					SSHUsername:       "root",
+					BMC: &kubeoneapi.BMCConfig{
+						Protocol:   kubeoneapi.BMCProtocolRedfish,
+						Address:    "https://10.0.0.10",
+						Username:   "admin",
+						Password:   "password",
+						BootDevice: kubeoneapi.BMCBootDeviceVirtualMedia,
+						ImageURL:   "http://10.0.0.1/ubuntu.iso",
+					},
+				},
+			},
+			versionConfig: kubeoneapi.VersionConfig{
+				Kubernetes: "1.26.1",
+			},
+			expectedError: false,
+		},
+		{
+			name: "host config with ipmi bmc",
+			hostConfig: []kubeoneapi.HostConfig{
+				{
+					PublicAddress:     "192.168.1.1",
+					PrivateAddress:    "192.168.0.1",
+					SSHPrivateKeyFile: "test",
+					SSHAgentSocket:    "test",
+					SSHUsername:       "root",
+					BMC: &kubeoneapi.BMCConfig{
+						Protocol:   kubeoneapi.BMCProtocolIPMI,
+						Address:    "10.0.0.10",
+						Username:   "admin",
+						Password:   "password",
+						BootDevice: kubeoneapi.BMCBootDevicePXE,
+					},
+				},
+			},
+			versionConfig: kubeoneapi.VersionConfig{
+				Kubernetes: "1.26.1",
+			},
+			expectedError: false,
+		},
+		{
+			name: "host config with ipmi bmc and virtual media",
+			hostConfig: []kubeoneapi.HostConfig{
+				{
+					PublicAddress:     "192.168.1.1",
+					PrivateAddress:    "192.168.0.1",
+					SSHPrivateKeyFile: "test",
+					SSHAgentSocket:    "test",
+					SSHUsername:       "root",
+					BMC: &kubeoneapi.BMCConfig{
+						Protocol:   kubeoneapi.BMCProtocolIPMI,
+						Address:    "10.0.0.10",
+						Username:   "admin",
+						Password:   "password",
+						BootDevice: kubeoneapi.BMCBootDeviceVirtualMedia,
+						ImageURL:   "http://10.0.0.1/ubuntu.iso",
+					},
+				},
+			},
+			versionConfig: kubeoneapi.VersionConfig{
+				Kubernetes: "1.26.1",
+			},
+			expectedError: true,
+		},
+		{
+			name: "host config with redfish bmc without url scheme",
+			hostConfig: []kubeoneapi.HostConfig{
+				{
+					PublicAddress:     "192.168.1.1",
+					PrivateAddress:    "192.168.0.1",
+					SSHPrivateKeyFile: "test",
+					SSHAgentSocket:    "test",
+					SSHUsername:       "root",
+					BMC: &kubeoneapi.BMCConfig{
+						Protocol:   kubeoneapi.BMCProtocolRedfish,
+						Address:    "10.0.0.10",
+						Username:   "admin",
+						Password:   "password",
+						BootDevice: kubeoneapi.BMCBootDevicePXE,
+					},
+				},
+			},
+			versionConfig: kubeoneapi.VersionConfig{
+				Kubernetes: "1.26.1",
+			},
+			expectedError: true,
+		},
+		{
+			name: "host config with virtual media bmc without image url",
+			hostConfig: []kubeoneapi.HostConfig{
+				{
+					PublicAddress:     "192.168.1.1",
+					PrivateAddress:    "192.168.0.1",
+					SSHPrivateKeyFile: "test",
+					SSHAgentSocket:    "test",
+					SSHUsername:       "root",
+					BMC: &kubeoneapi.BMCConfig{
+						Protocol:   kubeoneapi.BMCProtocolRedfish,
+						Address:    "https://10.0.0.10",
+						Username:   "admin",
+						Password:   "password",
+						BootDevice: kubeoneapi.BMCBootDeviceVirtualMedia,
+					},
+				},
+			},
+			versionConfig: kubeoneapi.VersionConfig{
+				Kubernetes: "1.26.1",
+			},
+			expectedError: true,
+		},
+		{
+			name: "host config with bmc without credentials",
+			hostConfig: []kubeoneapi.HostConfig{
+				{
+					PublicAddress:     "192.168.1.1",
+					PrivateAddress:    "192.168.0.1",
+					SSHPrivateKeyFile: "test",
+					SSHAgentSocket:    "test",
+					SSHUsername:       "root",
+					BMC: &kubeoneapi.BMCConfig{
+						Protocol:   kubeoneapi.BMCProtocolRedfish,
+						Address:    "https://10.0.0.10",
+						BootDevice: kubeoneapi.BMCBootDevicePXE,
+					},
+				},
+			},
+			versionConfig: kubeoneapi.VersionConfig{
+				Kubernetes: "1.26.1",
+			},
+			expectedError: true,
+		},
+		{
+			name: "host config with unsupported bmc protocol",
+			hostConfig: []kubeoneapi.HostConfig{
+				{
+					PublicAddress:     "192.168.1.1",
+					PrivateAddress:    "192.168.0.1",
+					SSHPrivateKeyFile: "test",
+					SSHAgentSocket:    "test",
+					SSHUsername:       "root",
+					BMC: &kubeoneapi.BMCConfig{
+						Protocol:   "SSH",
+						Address:    "10.0.0.10",
+						Username:   "admin",
+						Password:   "password",
+						BootDevice: kubeoneapi.BMCBootDevicePXE,
+					},
+				},
+			},
+			versionConfig: kubeoneapi.VersionConfig{
+				Kubernetes: "1.26.1",
+			},
+			expectedError: true,
+		},
+		{
+			name: "no public address provided",
+			hostConfig: []kubeoneapi.HostConfig{
+				{
+					PublicAddress:     "",
+					PrivateAddress:    "cluster-test.private.local",
+					SSHPrivateKeyFile: "test",
+					SSHAgentSocket:    "test",
+					SSHUsername:       "root",
+				},
+			},
+			versionConfig: kubeoneapi.VersionConfig{
+				Kubernetes: "1.26.1",
+			},
+			expectedError: true,
+		},
+		{
+			name: "no private address provided",
+			hostConfig: []kubeoneapi.HostConfig{
+				{
+					PublicAddress:     "cluster-test.public.local",
+					PrivateAddress:    "",
+					SSHPrivateKeyFile: "test",
+					SSHAgentSocket:    "test",
+					SSHUsername:       "root",
+				},
+			},
+			versionConfig: kubeoneapi.VersionConfig{
+				Kubernetes: "1.26.1",
+			},
+			expectedError: true,
+		},
+		{
+			name: "no private key file and agent provided",
+			hostConfig: []kubeoneapi.HostConfig{
+				{
+					PublicAddress:     "cluster-test.public.local",
+					PrivateAddress:    "cluster-test.private.local",
+					SSHPrivateKeyFile: "",
+					SSHAgentSocket:    "",
+					SSHUsername:       "root",
+				},
+			},
+			versionConfig: kubeoneapi.VersionConfig{
+				Kubernetes: "1.26.1",
+			},
+			expectedError: true,
+		},
+		{
+			name: "no username provided",
+			hostConfig: []kubeoneapi.HostConfig{
+				{
+					PublicAddress:     "cluster-test.public.local",
+					PrivateAddress:    "cluster-test.private.local",
+					SSHPrivateKeyFile: "test",
+					SSHAgentSocket:    "test",
+					SSHUsername:       "",
+				},
+			},
+			versionConfig: kubeoneapi.VersionConfig{
+				Kubernetes: "1.26.1",
+			},
+			expectedError: true,
+		},
+		{
+			name: "one valid host config and one invalid host config (no username)",
+			hostConfig: []kubeoneapi.HostConfig{
+				{
+					PublicAddress:     "192.168.1.1",
+					PrivateAddress:    "192.168.0.1",
+					SSHPrivateKeyFile: "test",
+					SSHAgentSocket:    "test",
+					SSHUsername:       "root",
+				},
+				{
+					PublicAddress:     "cluster-test.public.local",
+					PrivateAddress:    "cluster-test.private.local",
+					SSHPrivateKeyFile: "test",
+					SSHAgentSocket:    "test",
+					SSHUsername:       "",
+				},
+			},
+			versionConfig: kubeoneapi.VersionConfig{
+				Kubernetes: "1.26.1",
+			},
+			expectedError: true,
+		},
+		{
+			name: "two leaders at the same time",
+			hostConfig: []kubeoneapi.HostConfig{
+				{
+					PublicAddress:     "192.168.1.1",
+					PrivateAddress:    "192.168.0.1",
+					SSHPrivateKeyFile: "test",
+					SSHAgentSocket:    "test",
+					SSHUsername:       "root",
+					IsLeader:          true,
+				},
+				{
+					PublicAddress:     "cluster-test.public.local",
+					PrivateAddress:    "cluster-test.private.local",
+					SSHPrivateKeyFile: "test",
+					SSHAgentSocket:    "test",
+					SSHUsername:       "root",
+					IsLeader:          true,
+				},
+			},
+			versionConfig: kubeoneapi.VersionConfig{
+				Kubernetes: "1.26.1",
+			},
+			expectedError: true,
+		},
+		{
+			name: "valid OS",
+			hostConfig: []kubeoneapi.HostConfig{
+				{
+					PublicAddress:     "192.168.1.1",
+					PrivateAddress:    "192.168.0.1",
+					SSHPrivateKeyFile: "test",
+					SSHAgentSocket:    "test",
+					SSHUsername:       "root",
+					OperatingSystem:   kubeoneapi.OperatingSystemNameCentOS,
+				},
+			},
+			versionConfig: kubeoneapi.VersionConfig{
+				Kubernetes: "1.26.1",
+			},
+			expectedError: false,
+		},
+		{
+			name: "invalid OS",
+			hostConfig: []kubeoneapi.HostConfig{
+				{
+					PublicAddress:     "192.168.1.1",
+					PrivateAddress:    "192.168.0.1",
+					SSHPrivateKeyFile: "test",
+					SSHAgentSocket:    "test",
+					SSHUsername:       "root",
+					OperatingSystem:   kubeoneapi.OperatingSystemName("non-existing"),
+				},
+			},
+			versionConfig: kubeoneapi.VersionConfig{
+				Kubernetes: "1.26.1",
+			},
+			expectedError: true,
+		},
+		{
+			name: "kubelet.maxPods valid",
+			hostConfig: []kubeoneapi.HostConfig{
+				{
+					PublicAddress:     "192.168.1.1",
+					PrivateAddress:    "192.168.0.1",
+					SSHPrivateKeyFile: "test",
+					SSHAgentSocket:    "test",
+					SSHUsername:       "root",
+					Kubelet: kubeoneapi.KubeletConfig{
+						MaxPods: pointer.New(int32(110)),
+					},
+				},
+			},
+			versionConfig: kubeoneapi.VersionConfig{
+				Kubernetes: "1.26.1",
+			},
+			expectedError: false,
+		},
+		{
+			name: "kubelet.maxPods zero (invalid)",
+			hostConfig: []kubeoneapi.HostConfig{
+				{
+					PublicAddress:     "192.168.1.1",
+					PrivateAddress:    "192.168.0.1",
+					SSHPrivateKeyFile: "test",
+					SSHAgentSocket:    "test",
+					SSHUsername:       "root",
+					Kubelet: kubeoneapi.KubeletConfig{
+						MaxPods: pointer.New(int32(0)),
+					},
+				},
+			},
+			versionConfig: kubeoneapi.VersionConfig{
+				Kubernetes: "1.26.1",
+			},
+			expectedError: true,
+		},
+		{
+			name: "kubelet.maxPods negative (invalid)",
+			hostConfig: []kubeoneapi.HostConfig{
+				{
+					PublicAddress:     "192.168.1.1",
+					PrivateAddress:    "192.168.0.1",
+					SSHPrivateKeyFile: "test",
+					SSHAgentSocket:    "test",
+					SSHUsername:       "root",
+					Kubelet: kubeoneapi.KubeletConfig{
+						MaxPods: pointer.New(int32(-10)),
+					},
+				},
+			},
+			versionConfig: kubeoneapi.VersionConfig{
+				Kubernetes: "1.26.1",
+			},
+			expectedError: true,
+		},
+		{
+			name: "incorrect label marked to remove",
+			hostConfig: []kubeoneapi.HostConfig{
+				{
+					PublicAddress:     "192.168.1.1",
+					PrivateAddress:    "192.168.0.1",
+					SSHPrivateKeyFile: "test",
+					SSHAgentSocket:    "test",
+					SSHUsername:       "root",
+					Labels: map[string]string{
+						"label-to-remove-": "this values has to be empty",
+					},
+				},
+			},
+			versionConfig: kubeoneapi.VersionConfig{
+				Kubernetes: "1.26.1",
+			},
+			expectedError: true,
+		},
+		{
+			name: "correct label marked to remove",
+			hostConfig: []kubeoneapi.HostConfig{
+				{
+					PublicAddress:     "192.168.1.1",
+					PrivateAddress:    "192.168.0.1",
+					SSHPrivateKeyFile: "test",
+					SSHAgentSocket:    "test",
+					SSHUsername:       "root",
+					Labels: map[string]string{
+						"label-to-remove-": "",
+					},
+				},
+			},
+			versionConfig: kubeoneapi.VersionConfig{
+				Kubernetes: "1.26.1",
+			},
+			expectedError: false,
+		},
+		{
+			name: "master taint on 1.24",
+			hostConfig: []kubeoneapi.HostConfig{
+				{
+					PublicAddress:     "192.168.1.1",
+					PrivateAddress:    "192.168.0.1",
+					SSHPrivateKeyFile: "test",
+					SSHAgentSocket:    "test",
+					SSHUsername:       "root",
+					Taints: []corev1.Taint{
+						{
+							Key: "node-role.kubernetes.io/control-plane",
+						},
+						{
+							Key: "node-role.kubernetes.io/master",
+						},
+					},
+				},
+			},
+			versionConfig: kubeoneapi.VersionConfig{
+				Kubernetes: "1.24.10",
+			},
+			expectedError: false,
+		},
+		{
+			name: "only control-plane taint on 1.24",
+			hostConfig: []kubeoneapi.HostConfig{
+				{
+					PublicAddress:     "192.168.1.1",
+					PrivateAddress:    "192.168.0.1",
+					SSHPrivateKeyFile: "test",
+					SSHAgentSocket:    "test",
+					SSHUsername:       "root",
+					Taints: []corev1.Taint{
+						{
+							Key: "node-role.kubernetes.io/control-plane",
+						},
+					},
+				},
+			},
+			versionConfig: kubeoneapi.VersionConfig{
+				Kubernetes: "1.24.10",
+			},
+			expectedError: false,
+		},
+		{
+			name: "master taint on 1.25",
+			hostConfig: []kubeoneapi.HostConfig{
+				{
+					PublicAddress:     "192.168.1.1",
+					PrivateAddress:    "192.168.0.1",
+					SSHPrivateKeyFile: "test",
+					SSHAgentSocket:    "test",
+					SSHUsername:       "root",
+					Taints: []corev1.Taint{
+						{
+							Key: "node-role.kubernetes.io/control-plane",
+						},
+						{
+							Key: "node-role.kubernetes.io/master",
+						},
+					},
+				},
+			},
+			versionConfig: kubeoneapi.VersionConfig{
+				Kubernetes: "1.25.6",
+			},
+			expectedError: true,
+		},
+		{
+			name: "only control-plane taint on 1.25",
+			hostConfig: []kubeoneapi.HostConfig{
+				{
+					PublicAddress:     "192.168.1.1",
+					PrivateAddress:    "192.168.0.1",
+					SSHPrivateKeyFile: "test",
+					SSHAgentSocket:    "test",
+					SSHUsername:       "root",
+					Taints: []corev1.Taint{
+						{
+							Key: "node-role.kubernetes.io/control-plane",
+						},
+					},
+				},
+			},
+			versionConfig: kubeoneapi.VersionConfig{
+				Kubernetes: "1.25.6",
+			},
+			expectedError: false,
+		},
+		{
+			name: "master taint on 1.26",
+			hostConfig: []kubeoneapi.HostConfig{
+				{
+					PublicAddress:     "192.168.1.1",
+					PrivateAddress:    "192.168.0.1",
+					SSHPrivateKeyFile: "test",
+					SSHAgentSocket:    "test",
+					SSHUsername:       "root",
+					Taints: []corev1.Taint{
+						{
+							Key: "node-role.kubernetes.io/master",
+						},
 					},
 				},
 			},
-			provider: kubeoneapi.CloudProviderSpec{
-				AWS: &kubeoneapi.AWSSpec{},
+			versionConfig: kubeoneapi.VersionConfig{
+				Kubernetes: "1.26.1",
 			},
 			expectedError: true,
 		},
 		{
-			name: "ipv4 family (aws)",
-			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{
+			name: "ipv6-only host without private address",
+			hostConfig: []kubeoneapi.HostConfig{
 				{
-					Name:     "test-1",
-					Replicas: pointer.New(3),
-					Config: kubeoneapi.ProviderSpec{
-						Network: &kubeoneapi.ProviderStaticNetworkConfig{
-							IPFamily: kubeoneapi.IPFamilyIPv4,
-						},
-					},
+					PublicAddress:     "2001:db8::1",
+					IPv6Addresses:     []string{"fd00::1"},
+					SSHPrivateKeyFile: "test",
+					SSHAgentSocket:    "test",
+					SSHUsername:       "root",
 				},
 			},
-			provider: kubeoneapi.CloudProviderSpec{
-				AWS: &kubeoneapi.AWSSpec{},
+			networkConfig: kubeoneapi.ClusterNetworkConfig{
+				IPFamily: kubeoneapi.IPFamilyIPv6,
+			},
+			versionConfig: kubeoneapi.VersionConfig{
+				Kubernetes: "1.26.1",
 			},
 			expectedError: false,
 		},
 		{
-			name: "ipv4+ipv6 family (aws)",
-			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{
+			name: "ipv6-only host without ipv6 addresses",
+			hostConfig: []kubeoneapi.HostConfig{
 				{
-					Name:     "test-1",
-					Replicas: pointer.New(3),
-					Config: kubeoneapi.ProviderSpec{
-						Network: &kubeoneapi.ProviderStaticNetworkConfig{
-							IPFamily: kubeoneapi.IPFamilyIPv4IPv6,
-						},
-					},
+					PublicAddress:     "2001:db8::1",
+					SSHPrivateKeyFile: "test",
+					SSHAgentSocket:    "test",
+					SSHUsername:       "root",
 				},
 			},
-			provider: kubeoneapi.CloudProviderSpec{
-				AWS: &kubeoneapi.AWSSpec{},
+			networkConfig: kubeoneapi.ClusterNetworkConfig{
+				IPFamily: kubeoneapi.IPFamilyIPv6,
 			},
-			expectedError: false,
+			versionConfig: kubeoneapi.VersionConfig{
+				Kubernetes: "1.26.1",
+			},
+			expectedError: true,
 		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			errs := ValidateHostConfig(tc.hostConfig, tc.versionConfig, tc.networkConfig, nil)
+			if (len(errs) == 0) == tc.expectedError {
+				t.Errorf("test case failed: expected %v, but got %v", tc.expectedError, (len(errs) != 0))
+			}
+		})
+	}
+}
+
+func TestValidateSystemPackages(t *testing.T) {
+	tests := []struct {
+		name           string
+		systemPackages *kubeoneapi.SystemPackages
+		expectedError  bool
+	}{
 		{
-			name: "ipv4+ipv6 family (azure)",
-			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{
-				{
-					Name:     "test-1",
-					Replicas: pointer.New(3),
-					Config: kubeoneapi.ProviderSpec{
-						Network: &kubeoneapi.ProviderStaticNetworkConfig{
-							IPFamily: kubeoneapi.IPFamilyIPv4IPv6,
-						},
+			name:           "valid system packages (nil)",
+			systemPackages: nil,
+			expectedError:  false,
+		},
+		{
+			name: "valid flat kubernetes repository",
+			systemPackages: &kubeoneapi.SystemPackages{
+				ConfigureRepositories: true,
+				Repositories: &kubeoneapi.PackageRepositories{
+					Kubernetes: &kubeoneapi.PackageRepository{
+						URL:       "https://mirror.example.com/kubernetes/deb/",
+						GPGKeyURL: "https://mirror.example.com/kubernetes/deb/Release.key",
 					},
 				},
 			},
-			provider: kubeoneapi.CloudProviderSpec{
-				Azure: &kubeoneapi.AzureSpec{},
-			},
-			expectedError: true,
+			expectedError: false,
 		},
 		{
-			name: "ipv6+ipv4 family (aws)",
-			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{
-				{
-					Name:     "test-1",
-					Replicas: pointer.New(3),
-					Config: kubeoneapi.ProviderSpec{
-						Network: &kubeoneapi.ProviderStaticNetworkConfig{
-							IPFamily: kubeoneapi.IPFamilyIPv6IPv4,
-						},
+			name: "valid container runtime repository with suite and inline key",
+			systemPackages: &kubeoneapi.SystemPackages{
+				ConfigureRepositories: true,
+				Repositories: &kubeoneapi.PackageRepositories{
+					ContainerRuntime: &kubeoneapi.PackageRepository{
+						URL:        "http://10.0.0.10/docker/ubuntu",
+						Suite:      "jammy",
+						Components: []string{"stable"},
+						GPGKey:     "-----BEGIN PGP PUBLIC KEY BLOCK-----\n...\n-----END PGP PUBLIC KEY BLOCK-----",
 					},
 				},
 			},
-			provider: kubeoneapi.CloudProviderSpec{
-				AWS: &kubeoneapi.AWSSpec{},
+			expectedError: false,
+		},
+		{
+			name: "invalid repositories without configureRepositories",
+			systemPackages: &kubeoneapi.SystemPackages{
+				Repositories: &kubeoneapi.PackageRepositories{
+					Kubernetes: &kubeoneapi.PackageRepository{URL: "https://mirror.example.com/kubernetes/rpm/"},
+				},
 			},
 			expectedError: true,
 		},
 		{
-			name: "ipv6+ipv4 family (azure)",
-			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{
-				{
-					Name:     "test-1",
-					Replicas: pointer.New(3),
-					Config: kubeoneapi.ProviderSpec{
-						Network: &kubeoneapi.ProviderStaticNetworkConfig{
-							IPFamily: kubeoneapi.IPFamilyIPv6IPv4,
-						},
-					},
+			name: "invalid repository url",
+			systemPackages: &kubeoneapi.SystemPackages{
+				ConfigureRepositories: true,
+				Repositories: &kubeoneapi.PackageRepositories{
+					Kubernetes: &kubeoneapi.PackageRepository{URL: "mirror.example.com/kubernetes"},
 				},
 			},
-			provider: kubeoneapi.CloudProviderSpec{
-				Azure: &kubeoneapi.AzureSpec{},
-			},
 			expectedError: true,
 		},
 		{
-			name: "ipv6 family (aws)",
-			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{
-				{
-					Name:     "test-1",
-					Replicas: pointer.New(3),
-					Config: kubeoneapi.ProviderSpec{
-						Network: &kubeoneapi.ProviderStaticNetworkConfig{
-							IPFamily: kubeoneapi.IPFamilyIPv6,
-						},
+			name: "invalid components without suite",
+			systemPackages: &kubeoneapi.SystemPackages{
+				ConfigureRepositories: true,
+				Repositories: &kubeoneapi.PackageRepositories{
+					ContainerRuntime: &kubeoneapi.PackageRepository{
+						URL:        "https://mirror.example.com/docker/ubuntu",
+						Components: []string{"stable"},
 					},
 				},
 			},
-			provider: kubeoneapi.CloudProviderSpec{
-				AWS: &kubeoneapi.AWSSpec{},
-			},
 			expectedError: true,
 		},
 		{
-			name: "ipv6 family (azure)",
-			dynamicWorkerConfig: []kubeoneapi.DynamicWorkerConfig{
-				{
-					Name:     "test-1",
-					Replicas: pointer.New(3),
-					Config: kubeoneapi.ProviderSpec{
-						Network: &kubeoneapi.ProviderStaticNetworkConfig{
-							IPFamily: kubeoneapi.IPFamilyIPv6,
-						},
+			name: "invalid both gpg key and gpg key url",
+			systemPackages: &kubeoneapi.SystemPackages{
+				ConfigureRepositories: true,
+				Repositories: &kubeoneapi.PackageRepositories{
+					Kubernetes: &kubeoneapi.PackageRepository{
+						URL:       "https://mirror.example.com/kubernetes/deb/",
+						GPGKeyURL: "https://mirror.example.com/kubernetes/deb/Release.key",
+						GPGKey:    "-----BEGIN PGP PUBLIC KEY BLOCK-----\n...\n-----END PGP PUBLIC KEY BLOCK-----",
 					},
 				},
 			},
-			provider: kubeoneapi.CloudProviderSpec{
-				Azure: &kubeoneapi.AzureSpec{},
-			},
 			expectedError: true,
 		},
 	}
-
 	for _, tc := range tests {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			errs := ValidateDynamicWorkerConfig(tc.dynamicWorkerConfig, tc.provider, nil)
+			t.Parallel()
+
+			errs := ValidateSystemPackages(tc.systemPackages, nil)
 			if (len(errs) == 0) == tc.expectedError {
 				t.Errorf("test case failed: expected %v, but got %v", tc.expectedError, (len(errs) != 0))
 			}
@@ -1799,236 +6531,276 @@ func TestValidateDynamicWorkerConfig(t *testing.T) {
 	}
 }
 
-func TestValidateCABundle(t *testing.T) {
+func TestValidateRegistryConfiguration(t *testing.T) {
 	tests := []struct {
-		name          string
-		caBundle      string
-		expectedError bool
+		name                  string
+		registryConfiguration *kubeoneapi.RegistryConfiguration
+		expectedError         bool
 	}{
 		{
-			name:          "empty",
-			caBundle:      "",
+			name: "valid registry config (overwrite registry)",
+			registryConfiguration: &kubeoneapi.RegistryConfiguration{
+				OverwriteRegistry: "127.0.0.1:5000",
+			},
 			expectedError: false,
 		},
 		{
-			name: "correct",
-			caBundle: heredoc.Doc(`
-				## some comments
-
-				GlobalSign Root CA
-				==================
-				-----BEGIN CERTIFICATE-----
-				MIIDdTCCAl2gAwIBAgILBAAAAAABFUtaw5QwDQYJKoZIhvcNAQEFBQAwVzELMAkGA1UEBhMCQkUx
-				GTAXBgNVBAoTEEdsb2JhbFNpZ24gbnYtc2ExEDAOBgNVBAsTB1Jvb3QgQ0ExGzAZBgNVBAMTEkds
-				b2JhbFNpZ24gUm9vdCBDQTAeFw05ODA5MDExMjAwMDBaFw0yODAxMjgxMjAwMDBaMFcxCzAJBgNV
-				BAYTAkJFMRkwFwYDVQQKExBHbG9iYWxTaWduIG52LXNhMRAwDgYDVQQLEwdSb290IENBMRswGQYD
-				VQQDExJHbG9iYWxTaWduIFJvb3QgQ0EwggEiMA0GCSqGSIb3DQEBAQUAA4IBDwAwggEKAoIBAQDa
-				DuaZjc6j40+Kfvvxi4Mla+pIH/EqsLmVEQS98GPR4mdmzxzdzxtIK+6NiY6arymAZavpxy0Sy6sc
-				THAHoT0KMM0VjU/43dSMUBUc71DuxC73/OlS8pF94G3VNTCOXkNz8kHp1Wrjsok6Vjk4bwY8iGlb
-				Kk3Fp1S4bInMm/k8yuX9ifUSPJJ4ltbcdG6TRGHRjcdGsnUOhugZitVtbNV4FpWi6cgKOOvyJBNP
-				c1STE4U6G7weNLWLBYy5d4ux2x8gkasJU26Qzns3dLlwR5EiUWMWea6xrkEmCMgZK9FGqkjWZCrX
-				gzT/LCrBbBlDSgeF59N89iFo7+ryUp9/k5DPAgMBAAGjQjBAMA4GA1UdDwEB/wQEAwIBBjAPBgNV
-				HRMBAf8EBTADAQH/MB0GA1UdDgQWBBRge2YaRQ2XyolQL30EzTSo//z9SzANBgkqhkiG9w0BAQUF
-				AAOCAQEA1nPnfE920I2/7LqivjTFKDK1fPxsnCwrvQmeU79rXqoRSLblCKOzyj1hTdNGCbM+w6Dj
-				Y1Ub8rrvrTnhQ7k4o+YviiY776BQVvnGCv04zcQLcFGUl5gE38NflNUVyRRBnMRddWQVDf9VMOyG
-				j/8N7yy5Y0b2qvzfvGn9LhJIZJrglfCm7ymPAbEVtQwdpf5pLGkkeB6zpxxxYu7KyJesF12KwvhH
-				hm4qxFYxldBniYUr+WymXUadDKqC5JlR3XC321Y9YeRq4VzW9v493kHMB65jUr9TU/Qr6cf9tveC
-				X4XSQRjbgbMEHMUfpIBvFSDJ3gyICh3WZlXi/EjJKSZp4A==
-				-----END CERTIFICATE-----
-			`),
+			name: "valid registry config (overwrite registry and insecure)",
+			registryConfiguration: &kubeoneapi.RegistryConfiguration{
+				OverwriteRegistry: "127.0.0.1:5000",
+				InsecureRegistry:  true,
+			},
 			expectedError: false,
 		},
 		{
-			name: "no certs but with comments",
-			caBundle: heredoc.Doc(`
-				# leading comment
-				## additional comment
-			`),
-			expectedError: true,
+			name:                  "valid registry config (empty)",
+			registryConfiguration: &kubeoneapi.RegistryConfiguration{},
+			expectedError:         false,
 		},
 		{
-			name:          "incorrect",
-			caBundle:      "garbage",
+			name:                  "valid registry config (nil)",
+			registryConfiguration: nil,
+			expectedError:         false,
+		},
+		{
+			name: "invalid registry config (insecure registry without overwrite registry)",
+			registryConfiguration: &kubeoneapi.RegistryConfiguration{
+				InsecureRegistry: true,
+			},
 			expectedError: true,
 		},
 	}
-
 	for _, tc := range tests {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			errs := ValidateCABundle(tc.caBundle, field.NewPath("caBundle"))
+			errs := ValidateRegistryConfiguration(tc.registryConfiguration, nil)
 			if (len(errs) == 0) == tc.expectedError {
-				t.Logf("failed value:\n%q", tc.caBundle)
+				t.Log(errs[0])
 				t.Errorf("test case failed: expected %v, but got %v", tc.expectedError, (len(errs) != 0))
 			}
 		})
 	}
 }
 
-func TestValidateFeatures(t *testing.T) {
+func TestValidateNvidiaGPU(t *testing.T) {
+	gpuLabels := map[string]string{resources.NvidiaGPUNodeLabel: "true"}
+
 	tests := []struct {
 		name          string
-		features      kubeoneapi.Features
-		versions      kubeoneapi.VersionConfig
+		cluster       kubeoneapi.KubeOneCluster
 		expectedError bool
 	}{
 		{
-			name: "psp and auditing enabled",
-			features: kubeoneapi.Features{
-				PodSecurityPolicy: &kubeoneapi.PodSecurityPolicy{
-					Enable: true,
-				},
-				DynamicAuditLog: &kubeoneapi.DynamicAuditLog{
-					Enable: true,
+			name: "nvidia gpu not configured",
+			cluster: kubeoneapi.KubeOneCluster{
+				ContainerRuntime: kubeoneapi.ContainerRuntimeConfig{
+					Docker: &kubeoneapi.ContainerRuntimeDocker{},
 				},
 			},
-			versions: kubeoneapi.VersionConfig{
-				Kubernetes: "1.20.2",
-			},
 			expectedError: false,
 		},
 		{
-			name: "psp enabled on 1.25",
-			features: kubeoneapi.Features{
-				PodSecurityPolicy: &kubeoneapi.PodSecurityPolicy{
-					Enable: true,
+			name: "nvidia gpu with containerd",
+			cluster: kubeoneapi.KubeOneCluster{
+				ContainerRuntime: kubeoneapi.ContainerRuntimeConfig{
+					Containerd: &kubeoneapi.ContainerRuntimeContainerd{},
+				},
+				Features: kubeoneapi.Features{
+					NvidiaGPU: &kubeoneapi.NvidiaGPU{Enable: true},
 				},
 			},
-			versions: kubeoneapi.VersionConfig{
-				Kubernetes: "1.25.5",
-			},
-			expectedError: true,
+			expectedError: false,
 		},
 		{
-			name: "psp enabled on 1.26",
-			features: kubeoneapi.Features{
-				PodSecurityPolicy: &kubeoneapi.PodSecurityPolicy{
-					Enable: true,
+			name: "nvidia gpu with docker",
+			cluster: kubeoneapi.KubeOneCluster{
+				ContainerRuntime: kubeoneapi.ContainerRuntimeConfig{
+					Docker: &kubeoneapi.ContainerRuntimeDocker{},
+				},
+				Features: kubeoneapi.Features{
+					NvidiaGPU: &kubeoneapi.NvidiaGPU{Enable: true},
 				},
-			},
-			versions: kubeoneapi.VersionConfig{
-				Kubernetes: "1.26.0",
 			},
 			expectedError: true,
 		},
 		{
-			name: "metrics server disabled",
-			features: kubeoneapi.Features{
-				MetricsServer: &kubeoneapi.MetricsServer{
-					Enable: false,
+			name: "gpu static worker on ubuntu",
+			cluster: kubeoneapi.KubeOneCluster{
+				ContainerRuntime: kubeoneapi.ContainerRuntimeConfig{
+					Containerd: &kubeoneapi.ContainerRuntimeContainerd{},
+				},
+				StaticWorkers: kubeoneapi.StaticWorkersConfig{
+					Hosts: []kubeoneapi.HostConfig{
+						{OperatingSystem: kubeoneapi.OperatingSystemNameUbuntu, Labels: gpuLabels},
+					},
+				},
+				Features: kubeoneapi.Features{
+					NvidiaGPU: &kubeoneapi.NvidiaGPU{Enable: true},
 				},
-			},
-			versions: kubeoneapi.VersionConfig{
-				Kubernetes: "1.20.2",
-			},
-			expectedError: false,
-		},
-		{
-			name:     "no feature configured",
-			features: kubeoneapi.Features{},
-			versions: kubeoneapi.VersionConfig{
-				Kubernetes: "1.20.2",
 			},
 			expectedError: false,
 		},
 		{
-			name: "oidc enabled",
-			features: kubeoneapi.Features{
-				OpenIDConnect: &kubeoneapi.OpenIDConnect{
-					Enable: true,
-					Config: kubeoneapi.OpenIDConnectConfig{
-						IssuerURL:     "test.cluster.local",
-						ClientID:      "123",
-						RequiredClaim: "test",
+			name: "gpu static worker on flatcar",
+			cluster: kubeoneapi.KubeOneCluster{
+				ContainerRuntime: kubeoneapi.ContainerRuntimeConfig{
+					Containerd: &kubeoneapi.ContainerRuntimeContainerd{},
+				},
+				StaticWorkers: kubeoneapi.StaticWorkersConfig{
+					Hosts: []kubeoneapi.HostConfig{
+						{OperatingSystem: kubeoneapi.OperatingSystemNameFlatcar, Labels: gpuLabels},
 					},
 				},
+				Features: kubeoneapi.Features{
+					NvidiaGPU: &kubeoneapi.NvidiaGPU{Enable: true},
+				},
 			},
-			versions: kubeoneapi.VersionConfig{
-				Kubernetes: "1.20.2",
-			},
-			expectedError: false,
+			expectedError: true,
 		},
 		{
-			name: "invalid staticAudit config",
-			features: kubeoneapi.Features{
-				StaticAuditLog: &kubeoneapi.StaticAuditLog{
-					Enable: true,
-					Config: kubeoneapi.StaticAuditLogConfig{},
+			name: "gpu dynamic worker",
+			cluster: kubeoneapi.KubeOneCluster{
+				ContainerRuntime: kubeoneapi.ContainerRuntimeConfig{
+					Containerd: &kubeoneapi.ContainerRuntimeContainerd{},
+				},
+				DynamicWorkers: []kubeoneapi.DynamicWorkerConfig{
+					{Name: "gpu", Config: kubeoneapi.ProviderSpec{Labels: gpuLabels}},
+				},
+				Features: kubeoneapi.Features{
+					NvidiaGPU: &kubeoneapi.NvidiaGPU{Enable: true},
 				},
-			},
-			versions: kubeoneapi.VersionConfig{
-				Kubernetes: "1.20.2",
 			},
 			expectedError: true,
 		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			errs := ValidateNvidiaGPU(tc.cluster, field.NewPath("features", "nvidiaGPU"))
+			if (len(errs) == 0) == tc.expectedError {
+				t.Errorf("test case failed: expected %v, but got %v", tc.expectedError, (len(errs) != 0))
+			}
+		})
+	}
+}
+
+func TestValidateHetznerPrivateNetwork(t *testing.T) {
+	privateHost := kubeoneapi.HostConfig{
+		PublicAddress:  "10.0.0.10",
+		PrivateAddress: "10.0.0.10",
+		Bastion:        "1.2.3.4",
+	}
+	publicHost := kubeoneapi.HostConfig{
+		PublicAddress:  "1.2.3.5",
+		PrivateAddress: "10.0.0.11",
+	}
+
+	tests := []struct {
+		name          string
+		cluster       kubeoneapi.KubeOneCluster
+		expectedError bool
+	}{
 		{
-			name: "invalid oidc config",
-			features: kubeoneapi.Features{
-				OpenIDConnect: &kubeoneapi.OpenIDConnect{
-					Enable: true,
-					Config: kubeoneapi.OpenIDConnectConfig{},
-				},
-			},
-			versions: kubeoneapi.VersionConfig{
-				Kubernetes: "1.20.2",
+			name: "public hosts without network",
+			cluster: kubeoneapi.KubeOneCluster{
+				CloudProvider: kubeoneapi.CloudProviderSpec{Hetzner: &kubeoneapi.HetznerSpec{}},
+				ControlPlane:  kubeoneapi.ControlPlaneConfig{Hosts: []kubeoneapi.HostConfig{publicHost}},
 			},
-			expectedError: true,
+			expectedError: false,
 		},
 		{
-			name: "invalid podNodeSelector config",
-			features: kubeoneapi.Features{
-				PodNodeSelector: &kubeoneapi.PodNodeSelector{
-					Enable: true,
-					Config: kubeoneapi.PodNodeSelectorConfig{},
-				},
+			name: "private control plane hosts with network",
+			cluster: kubeoneapi.KubeOneCluster{
+				CloudProvider: kubeoneapi.CloudProviderSpec{Hetzner: &kubeoneapi.HetznerSpec{NetworkID: "kubeone"}},
+				ControlPlane:  kubeoneapi.ControlPlaneConfig{Hosts: []kubeoneapi.HostConfig{privateHost}},
 			},
-			versions: kubeoneapi.VersionConfig{
-				Kubernetes: "1.20.2",
+			expectedError: false,
+		},
+		{
+			name: "private control plane hosts without network",
+			cluster: kubeoneapi.KubeOneCluster{
+				CloudProvider: kubeoneapi.CloudProviderSpec{Hetzner: &kubeoneapi.HetznerSpec{}},
+				ControlPlane:  kubeoneapi.ControlPlaneConfig{Hosts: []kubeoneapi.HostConfig{privateHost}},
 			},
 			expectedError: true,
 		},
 		{
-			name: "coredns replicas > 0",
-			features: kubeoneapi.Features{
-				CoreDNS: &kubeoneapi.CoreDNS{
-					Replicas: pointer.New(int32(2)),
-				},
+			name: "private static workers without network",
+			cluster: kubeoneapi.KubeOneCluster{
+				CloudProvider: kubeoneapi.CloudProviderSpec{Hetzner: &kubeoneapi.HetznerSpec{}},
+				ControlPlane:  kubeoneapi.ControlPlaneConfig{Hosts: []kubeoneapi.HostConfig{publicHost}},
+				StaticWorkers: kubeoneapi.StaticWorkersConfig{Hosts: []kubeoneapi.HostConfig{privateHost}},
 			},
-			versions: kubeoneapi.VersionConfig{
-				Kubernetes: "1.20.2",
+			expectedError: true,
+		},
+		{
+			name: "private hosts on other providers",
+			cluster: kubeoneapi.KubeOneCluster{
+				CloudProvider: kubeoneapi.CloudProviderSpec{AWS: &kubeoneapi.AWSSpec{}},
+				ControlPlane:  kubeoneapi.ControlPlaneConfig{Hosts: []kubeoneapi.HostConfig{privateHost}},
 			},
 			expectedError: false,
 		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			errs := ValidateHetznerPrivateNetwork(tc.cluster, field.NewPath("cloudProvider", "hetzner", "networkID"))
+			if (len(errs) == 0) == tc.expectedError {
+				t.Errorf("test case failed: expected %v, but got %v", tc.expectedError, (len(errs) != 0))
+			}
+		})
+	}
+}
+
+func TestValidateDigitalOceanVPC(t *testing.T) {
+	vpcHost := kubeoneapi.HostConfig{PrivateAddress: "10.110.0.2"}
+	otherHost := kubeoneapi.HostConfig{PrivateAddress: "10.120.0.2"}
+
+	tests := []struct {
+		name          string
+		cluster       kubeoneapi.KubeOneCluster
+		expectedError bool
+	}{
 		{
-			name: "coredns replicas = 0",
-			features: kubeoneapi.Features{
-				CoreDNS: &kubeoneapi.CoreDNS{
-					Replicas: pointer.New(int32(0)),
-				},
+			name: "hosts in the VPC",
+			cluster: kubeoneapi.KubeOneCluster{
+				CloudProvider: kubeoneapi.CloudProviderSpec{DigitalOcean: &kubeoneapi.DigitalOceanSpec{VPCIPRange: "10.110.0.0/20"}},
+				ControlPlane:  kubeoneapi.ControlPlaneConfig{Hosts: []kubeoneapi.HostConfig{vpcHost}},
+				StaticWorkers: kubeoneapi.StaticWorkersConfig{Hosts: []kubeoneapi.HostConfig{vpcHost}},
 			},
-			versions: kubeoneapi.VersionConfig{
-				Kubernetes: "1.20.2",
+			expectedError: false,
+		},
+		{
+			name: "VPC IP range not set",
+			cluster: kubeoneapi.KubeOneCluster{
+				CloudProvider: kubeoneapi.CloudProviderSpec{DigitalOcean: &kubeoneapi.DigitalOceanSpec{}},
+				ControlPlane:  kubeoneapi.ControlPlaneConfig{Hosts: []kubeoneapi.HostConfig{otherHost}},
 			},
 			expectedError: false,
 		},
 		{
-			name: "coredns replicas < 0",
-			features: kubeoneapi.Features{
-				CoreDNS: &kubeoneapi.CoreDNS{
-					Replicas: pointer.New(int32(-1)),
-				},
+			name: "control plane host outside of the VPC",
+			cluster: kubeoneapi.KubeOneCluster{
+				CloudProvider: kubeoneapi.CloudProviderSpec{DigitalOcean: &kubeoneapi.DigitalOceanSpec{VPCIPRange: "10.110.0.0/20"}},
+				ControlPlane:  kubeoneapi.ControlPlaneConfig{Hosts: []kubeoneapi.HostConfig{vpcHost, otherHost}},
 			},
-			versions: kubeoneapi.VersionConfig{
-				Kubernetes: "1.20.2",
+			expectedError: true,
+		},
+		{
+			name: "static worker outside of the VPC",
+			cluster: kubeoneapi.KubeOneCluster{
+				CloudProvider: kubeoneapi.CloudProviderSpec{DigitalOcean: &kubeoneapi.DigitalOceanSpec{VPCIPRange: "10.110.0.0/20"}},
+				ControlPlane:  kubeoneapi.ControlPlaneConfig{Hosts: []kubeoneapi.HostConfig{vpcHost}},
+				StaticWorkers: kubeoneapi.StaticWorkersConfig{Hosts: []kubeoneapi.HostConfig{otherHost}},
 			},
 			expectedError: true,
 		},
 	}
-
 	for _, tc := range tests {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			errs := ValidateFeatures(tc.features, tc.versions, nil)
+			errs := ValidateDigitalOceanVPC(tc.cluster)
 			if (len(errs) == 0) == tc.expectedError {
 				t.Errorf("test case failed: expected %v, but got %v", tc.expectedError, (len(errs) != 0))
 			}
@@ -2036,29 +6808,46 @@ func TestValidateFeatures(t *testing.T) {
 	}
 }
 
-func TestValidatePodNodeSelectorConfig(t *testing.T) {
+func TestValidateSeccompDefault(t *testing.T) {
 	tests := []struct {
-		name                  string
-		podNodeSelectorConfig kubeoneapi.PodNodeSelectorConfig
-		expectedError         bool
+		name           string
+		seccompDefault *kubeoneapi.SeccompDefault
+		expectedError  bool
 	}{
 		{
-			name: "valid podNodeSelector config",
-			podNodeSelectorConfig: kubeoneapi.PodNodeSelectorConfig{
-				ConfigFilePath: "./podnodeselector.yaml",
+			name:           "seccomp default without profiles",
+			seccompDefault: &kubeoneapi.SeccompDefault{Enable: true},
+			expectedError:  false,
+		},
+		{
+			name: "seccomp default with profiles",
+			seccompDefault: &kubeoneapi.SeccompDefault{
+				Enable:       true,
+				ProfilePaths: []string{"./seccomp/audit.json", "/etc/seccomp/restricted.json"},
 			},
 			expectedError: false,
 		},
 		{
-			name:                  "invalid podNodeSelector config",
-			podNodeSelectorConfig: kubeoneapi.PodNodeSelectorConfig{},
-			expectedError:         true,
+			name: "seccomp default with empty profile path",
+			seccompDefault: &kubeoneapi.SeccompDefault{
+				Enable:       true,
+				ProfilePaths: []string{""},
+			},
+			expectedError: true,
+		},
+		{
+			name: "seccomp default with duplicate profile file names",
+			seccompDefault: &kubeoneapi.SeccompDefault{
+				Enable:       true,
+				ProfilePaths: []string{"./a/audit.json", "./b/audit.json"},
+			},
+			expectedError: true,
 		},
 	}
 	for _, tc := range tests {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			errs := ValidatePodNodeSelectorConfig(tc.podNodeSelectorConfig, nil)
+			errs := ValidateSeccompDefault(tc.seccompDefault, field.NewPath("features", "seccompDefault"))
 			if (len(errs) == 0) == tc.expectedError {
 				t.Errorf("test case failed: expected %v, but got %v", tc.expectedError, (len(errs) != 0))
 			}
@@ -2066,81 +6855,85 @@ func TestValidatePodNodeSelectorConfig(t *testing.T) {
 	}
 }
 
-func TestValidateStaticAuditLogConfig(t *testing.T) {
+func TestValidateNodeSwap(t *testing.T) {
 	tests := []struct {
-		name                 string
-		staticAuditLogConfig kubeoneapi.StaticAuditLogConfig
-		expectedError        bool
+		name             string
+		nodeSwap         *kubeoneapi.NodeSwap
+		containerRuntime kubeoneapi.ContainerRuntimeConfig
+		cgroups          kubeoneapi.CgroupsConfig
+		versions         kubeoneapi.VersionConfig
+		expectedError    bool
 	}{
 		{
-			name: "valid staticAuditLog config",
-			staticAuditLogConfig: kubeoneapi.StaticAuditLogConfig{
-				PolicyFilePath: "/etc/kubernetes/policy.yaml",
-				LogPath:        "/var/log/kubernetes",
-				LogMaxAge:      10,
-				LogMaxBackup:   10,
-				LogMaxSize:     100,
+			name:     "node swap not configured",
+			nodeSwap: nil,
+			containerRuntime: kubeoneapi.ContainerRuntimeConfig{
+				Docker: &kubeoneapi.ContainerRuntimeDocker{},
 			},
+			versions:      kubeoneapi.VersionConfig{Kubernetes: "1.27.5"},
 			expectedError: false,
 		},
 		{
-			name: "policy file path missing",
-			staticAuditLogConfig: kubeoneapi.StaticAuditLogConfig{
-				LogPath:      "/var/log/kubernetes",
-				LogMaxAge:    10,
-				LogMaxBackup: 10,
-				LogMaxSize:   100,
+			name:     "node swap with limited swap",
+			nodeSwap: &kubeoneapi.NodeSwap{Enable: true, SwapBehavior: kubeoneapi.SwapBehaviorLimitedSwap},
+			containerRuntime: kubeoneapi.ContainerRuntimeConfig{
+				Containerd: &kubeoneapi.ContainerRuntimeContainerd{},
 			},
-			expectedError: true,
+			cgroups:       kubeoneapi.CgroupsConfig{Version: kubeoneapi.CgroupVersionV2},
+			versions:      kubeoneapi.VersionConfig{Kubernetes: "1.28.2"},
+			expectedError: false,
 		},
 		{
-			name: "log file path missing",
-			staticAuditLogConfig: kubeoneapi.StaticAuditLogConfig{
-				PolicyFilePath: "/etc/kubernetes/policy.yaml",
-				LogMaxAge:      10,
-				LogMaxBackup:   10,
-				LogMaxSize:     100,
+			name:     "node swap with unlimited swap",
+			nodeSwap: &kubeoneapi.NodeSwap{Enable: true, SwapBehavior: kubeoneapi.SwapBehaviorUnlimitedSwap},
+			containerRuntime: kubeoneapi.ContainerRuntimeConfig{
+				Containerd: &kubeoneapi.ContainerRuntimeContainerd{},
 			},
+			versions:      kubeoneapi.VersionConfig{Kubernetes: "1.28.2"},
+			expectedError: false,
+		},
+		{
+			name:     "node swap with invalid swap behavior",
+			nodeSwap: &kubeoneapi.NodeSwap{Enable: true, SwapBehavior: "NoSwap"},
+			containerRuntime: kubeoneapi.ContainerRuntimeConfig{
+				Containerd: &kubeoneapi.ContainerRuntimeContainerd{},
+			},
+			versions:      kubeoneapi.VersionConfig{Kubernetes: "1.28.2"},
 			expectedError: true,
 		},
 		{
-			name: "log max age set to 0",
-			staticAuditLogConfig: kubeoneapi.StaticAuditLogConfig{
-				PolicyFilePath: "/etc/kubernetes/policy.yaml",
-				LogPath:        "/var/log/kubernetes",
-				LogMaxAge:      0,
-				LogMaxBackup:   10,
-				LogMaxSize:     100,
+			name:     "node swap with kubernetes 1.27",
+			nodeSwap: &kubeoneapi.NodeSwap{Enable: true},
+			containerRuntime: kubeoneapi.ContainerRuntimeConfig{
+				Containerd: &kubeoneapi.ContainerRuntimeContainerd{},
 			},
+			versions:      kubeoneapi.VersionConfig{Kubernetes: "1.27.5"},
 			expectedError: true,
 		},
 		{
-			name: "log max backup set to 0",
-			staticAuditLogConfig: kubeoneapi.StaticAuditLogConfig{
-				PolicyFilePath: "/etc/kubernetes/policy.yaml",
-				LogPath:        "/var/log/kubernetes",
-				LogMaxAge:      10,
-				LogMaxBackup:   0,
-				LogMaxSize:     100,
+			name:     "node swap with docker",
+			nodeSwap: &kubeoneapi.NodeSwap{Enable: true},
+			containerRuntime: kubeoneapi.ContainerRuntimeConfig{
+				Docker: &kubeoneapi.ContainerRuntimeDocker{},
 			},
+			versions:      kubeoneapi.VersionConfig{Kubernetes: "1.28.2"},
 			expectedError: true,
 		},
 		{
-			name: "log max size set to 0",
-			staticAuditLogConfig: kubeoneapi.StaticAuditLogConfig{
-				PolicyFilePath: "/etc/kubernetes/policy.yaml",
-				LogPath:        "/var/log/kubernetes",
-				LogMaxAge:      10,
-				LogMaxBackup:   10,
-				LogMaxSize:     0,
+			name:     "node swap with cgroup v1",
+			nodeSwap: &kubeoneapi.NodeSwap{Enable: true},
+			containerRuntime: kubeoneapi.ContainerRuntimeConfig{
+				Containerd: &kubeoneapi.ContainerRuntimeContainerd{},
 			},
+			cgroups:       kubeoneapi.CgroupsConfig{Version: kubeoneapi.CgroupVersionV1},
+			versions:      kubeoneapi.VersionConfig{Kubernetes: "1.28.2"},
 			expectedError: true,
 		},
 	}
 	for _, tc := range tests {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			errs := ValidateStaticAuditLogConfig(tc.staticAuditLogConfig, nil)
+			errs := ValidateNodeSwap(tc.nodeSwap, tc.containerRuntime, tc.cgroups, tc.versions, field.NewPath("features", "nodeSwap"))
 			if (len(errs) == 0) == tc.expectedError {
 				t.Errorf("test case failed: expected %v, but got %v", tc.expectedError, (len(errs) != 0))
 			}
@@ -2148,39 +6941,75 @@ func TestValidateStaticAuditLogConfig(t *testing.T) {
 	}
 }
 
-func TestValidateOIDCConfig(t *testing.T) {
+func TestValidateCgroupsConfig(t *testing.T) {
 	tests := []struct {
 		name          string
-		oidcConfig    kubeoneapi.OpenIDConnectConfig
+		cgroups       kubeoneapi.CgroupsConfig
+		versions      kubeoneapi.VersionConfig
 		expectedError bool
 	}{
 		{
-			name: "valid oidc config",
-			oidcConfig: kubeoneapi.OpenIDConnectConfig{
-				IssuerURL: "test.cluster.local",
-				ClientID:  "test",
+			name:          "cgroups not configured",
+			cgroups:       kubeoneapi.CgroupsConfig{},
+			versions:      kubeoneapi.VersionConfig{Kubernetes: "1.27.5"},
+			expectedError: false,
+		},
+		{
+			name: "systemd driver with cgroup v2",
+			cgroups: kubeoneapi.CgroupsConfig{
+				Driver:  kubeoneapi.CgroupDriverSystemd,
+				Version: kubeoneapi.CgroupVersionV2,
 			},
+			versions:      kubeoneapi.VersionConfig{Kubernetes: "1.27.5"},
 			expectedError: false,
 		},
 		{
-			name: "no issuer url",
-			oidcConfig: kubeoneapi.OpenIDConnectConfig{
-				ClientID: "test",
+			name: "cgroupfs driver with cgroup v1",
+			cgroups: kubeoneapi.CgroupsConfig{
+				Driver:  kubeoneapi.CgroupDriverCgroupfs,
+				Version: kubeoneapi.CgroupVersionV1,
+			},
+			versions:      kubeoneapi.VersionConfig{Kubernetes: "1.27.5"},
+			expectedError: false,
+		},
+		{
+			name: "cgroupfs driver with cgroup v2",
+			cgroups: kubeoneapi.CgroupsConfig{
+				Driver:  kubeoneapi.CgroupDriverCgroupfs,
+				Version: kubeoneapi.CgroupVersionV2,
 			},
+			versions:      kubeoneapi.VersionConfig{Kubernetes: "1.27.5"},
 			expectedError: true,
 		},
 		{
-			name: "no client id",
-			oidcConfig: kubeoneapi.OpenIDConnectConfig{
-				IssuerURL: "test.cluster.local",
+			name: "cgroup v2 with kubernetes 1.24",
+			cgroups: kubeoneapi.CgroupsConfig{
+				Version: kubeoneapi.CgroupVersionV2,
+			},
+			versions:      kubeoneapi.VersionConfig{Kubernetes: "1.24.17"},
+			expectedError: true,
+		},
+		{
+			name: "unsupported cgroup driver",
+			cgroups: kubeoneapi.CgroupsConfig{
+				Driver: "docker",
+			},
+			versions:      kubeoneapi.VersionConfig{Kubernetes: "1.27.5"},
+			expectedError: true,
+		},
+		{
+			name: "unsupported cgroup version",
+			cgroups: kubeoneapi.CgroupsConfig{
+				Version: "v3",
 			},
+			versions:      kubeoneapi.VersionConfig{Kubernetes: "1.27.5"},
 			expectedError: true,
 		},
 	}
 	for _, tc := range tests {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			errs := ValidateOIDCConfig(tc.oidcConfig, nil)
+			errs := ValidateCgroupsConfig(tc.cgroups, tc.versions, field.NewPath("cgroups"))
 			if (len(errs) == 0) == tc.expectedError {
 				t.Errorf("test case failed: expected %v, but got %v", tc.expectedError, (len(errs) != 0))
 			}
@@ -2188,469 +7017,397 @@ func TestValidateOIDCConfig(t *testing.T) {
 	}
 }
 
-func TestValidateAddons(t *testing.T) {
+func TestValidateControlPlaneComponents(t *testing.T) {
 	tests := []struct {
-		name          string
-		addons        *kubeoneapi.Addons
-		expectedError bool
+		name                   string
+		controlPlaneComponents *kubeoneapi.ControlPlaneComponents
+		expectedError          bool
 	}{
 		{
-			name: "valid addons config (enabled)",
-			addons: &kubeoneapi.Addons{
-				Enable: true,
-				Path:   "./addons",
+			name:                   "valid control plane components (nil)",
+			controlPlaneComponents: nil,
+			expectedError:          false,
+		},
+		{
+			name: "valid control plane components (flags, feature gates and volumes)",
+			controlPlaneComponents: &kubeoneapi.ControlPlaneComponents{
+				APIServer: &kubeoneapi.ControlPlaneComponentConfig{
+					Flags: map[string]string{
+						"audit-webhook-config-file": "/etc/kubernetes/audit-webhook.yaml",
+					},
+					FeatureGates: map[string]bool{
+						"TestFeatureGate": true,
+					},
+					ExtraVolumes: []kubeoneapi.HostPathMount{
+						{
+							Name:      "audit-webhook",
+							HostPath:  "/etc/kubernetes/audit-webhook.yaml",
+							MountPath: "/etc/kubernetes/audit-webhook.yaml",
+							ReadOnly:  true,
+						},
+					},
+				},
+				Scheduler: &kubeoneapi.ControlPlaneComponentConfig{
+					Flags: map[string]string{
+						"profiling": "false",
+					},
+				},
 			},
 			expectedError: false,
 		},
 		{
-			name: "addons enabled, no path set and no embedded addons specified",
-			addons: &kubeoneapi.Addons{
-				Enable: true,
-				Path:   "",
+			name: "invalid control plane components (feature-gates flag)",
+			controlPlaneComponents: &kubeoneapi.ControlPlaneComponents{
+				ControllerManager: &kubeoneapi.ControlPlaneComponentConfig{
+					Flags: map[string]string{
+						"feature-gates": "TestFeatureGate=true",
+					},
+				},
 			},
 			expectedError: true,
 		},
 		{
-			name: "embedded addon enabled, no path set",
-			addons: &kubeoneapi.Addons{
-				Enable: true,
-				Path:   "",
-				Addons: []kubeoneapi.Addon{
-					{
-						Name: resources.AddonMachineController,
+			name: "invalid control plane components (volume without hostPath)",
+			controlPlaneComponents: &kubeoneapi.ControlPlaneComponents{
+				APIServer: &kubeoneapi.ControlPlaneComponentConfig{
+					ExtraVolumes: []kubeoneapi.HostPathMount{
+						{
+							Name:      "audit-webhook",
+							MountPath: "/etc/kubernetes/audit-webhook.yaml",
+						},
 					},
 				},
 			},
-			expectedError: false,
+			expectedError: true,
 		},
 		{
-			name: "valid addons config (disabled)",
-			addons: &kubeoneapi.Addons{
-				Enable: false,
+			name: "invalid control plane components (duplicated volume name)",
+			controlPlaneComponents: &kubeoneapi.ControlPlaneComponents{
+				APIServer: &kubeoneapi.ControlPlaneComponentConfig{
+					ExtraVolumes: []kubeoneapi.HostPathMount{
+						{
+							Name:      "config",
+							HostPath:  "/etc/kubernetes/a.yaml",
+							MountPath: "/etc/kubernetes/a.yaml",
+						},
+						{
+							Name:      "config",
+							HostPath:  "/etc/kubernetes/b.yaml",
+							MountPath: "/etc/kubernetes/b.yaml",
+						},
+					},
+				},
 			},
-			expectedError: false,
+			expectedError: true,
 		},
 		{
-			name:          "valid addons config (empty)",
-			addons:        &kubeoneapi.Addons{},
+			name: "valid control plane components (patches)",
+			controlPlaneComponents: &kubeoneapi.ControlPlaneComponents{
+				Patches: &kubeoneapi.KubeadmPatches{
+					Directory: "./patches",
+				},
+			},
 			expectedError: false,
 		},
 		{
-			name:          "valid addons config (nil)",
-			addons:        nil,
-			expectedError: false,
+			name: "invalid control plane components (patches without directory)",
+			controlPlaneComponents: &kubeoneapi.ControlPlaneComponents{
+				Patches: &kubeoneapi.KubeadmPatches{},
+			},
+			expectedError: true,
 		},
 	}
 	for _, tc := range tests {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			errs := ValidateAddons(tc.addons, nil)
+			errs := ValidateControlPlaneComponents(tc.controlPlaneComponents, field.NewPath("controlPlaneComponents"))
 			if (len(errs) == 0) == tc.expectedError {
-				t.Log(errs[0])
 				t.Errorf("test case failed: expected %v, but got %v", tc.expectedError, (len(errs) != 0))
 			}
 		})
 	}
 }
 
-func TestValidateHostConfig(t *testing.T) {
+func TestValidateKernelConfig(t *testing.T) {
 	tests := []struct {
 		name          string
-		hostConfig    []kubeoneapi.HostConfig
-		networkConfig kubeoneapi.ClusterNetworkConfig
-		versionConfig kubeoneapi.VersionConfig
+		kernel        *kubeoneapi.KernelConfig
 		expectedError bool
 	}{
 		{
-			name: "host config with ip addresses",
-			hostConfig: []kubeoneapi.HostConfig{
-				{
-					PublicAddress:     "192.168.1.1",
-					PrivateAddress:    "192.168.0.1",
-					SSHPrivateKeyFile: "test",
-					SSHAgentSocket:    "test",
-					SSHUsername:       "root",
-				},
-			},
-			versionConfig: kubeoneapi.VersionConfig{
-				Kubernetes: "1.26.1",
-			},
+			name:          "no kernel config",
+			kernel:        nil,
 			expectedError: false,
 		},
 		{
-			name: "host config with dns domain",
-			hostConfig: []kubeoneapi.HostConfig{
-				{
-					PublicAddress:     "cluster-test.public.local",
-					PrivateAddress:    "cluster-test.private.local",
-					SSHPrivateKeyFile: "test",
-					SSHAgentSocket:    "test",
-					SSHUsername:       "root",
+			name: "valid kernel config",
+			kernel: &kubeoneapi.KernelConfig{
+				Sysctls: map[string]string{
+					"net.core.somaxconn":              "32768",
+					"net.ipv4.ip_local_port_range":    "1024 65000",
+					"net/ipv4/tcp_congestion_control": "bbr",
 				},
-			},
-			versionConfig: kubeoneapi.VersionConfig{
-				Kubernetes: "1.26.1",
+				Modules: []string{"ip_vs", "nf-conntrack"},
 			},
 			expectedError: false,
 		},
 		{
-			name: "no public address provided",
-			hostConfig: []kubeoneapi.HostConfig{
-				{
-					PublicAddress:     "",
-					PrivateAddress:    "cluster-test.private.local",
-					SSHPrivateKeyFile: "test",
-					SSHAgentSocket:    "test",
-					SSHUsername:       "root",
+			name: "invalid sysctl name",
+			kernel: &kubeoneapi.KernelConfig{
+				Sysctls: map[string]string{
+					"net.core.somaxconn; reboot": "32768",
 				},
 			},
-			versionConfig: kubeoneapi.VersionConfig{
-				Kubernetes: "1.26.1",
-			},
 			expectedError: true,
 		},
 		{
-			name: "no private address provided",
-			hostConfig: []kubeoneapi.HostConfig{
-				{
-					PublicAddress:     "cluster-test.public.local",
-					PrivateAddress:    "",
-					SSHPrivateKeyFile: "test",
-					SSHAgentSocket:    "test",
-					SSHUsername:       "root",
+			name: "empty sysctl value",
+			kernel: &kubeoneapi.KernelConfig{
+				Sysctls: map[string]string{
+					"net.core.somaxconn": " ",
 				},
 			},
-			versionConfig: kubeoneapi.VersionConfig{
-				Kubernetes: "1.26.1",
-			},
 			expectedError: true,
 		},
 		{
-			name: "no private key file and agent provided",
-			hostConfig: []kubeoneapi.HostConfig{
-				{
-					PublicAddress:     "cluster-test.public.local",
-					PrivateAddress:    "cluster-test.private.local",
-					SSHPrivateKeyFile: "",
-					SSHAgentSocket:    "",
-					SSHUsername:       "root",
+			name: "unsafe sysctl value",
+			kernel: &kubeoneapi.KernelConfig{
+				Sysctls: map[string]string{
+					"net.core.somaxconn": "1'; reboot",
 				},
 			},
-			versionConfig: kubeoneapi.VersionConfig{
-				Kubernetes: "1.26.1",
-			},
 			expectedError: true,
 		},
 		{
-			name: "no username provided",
-			hostConfig: []kubeoneapi.HostConfig{
-				{
-					PublicAddress:     "cluster-test.public.local",
-					PrivateAddress:    "cluster-test.private.local",
-					SSHPrivateKeyFile: "test",
-					SSHAgentSocket:    "test",
-					SSHUsername:       "",
-				},
-			},
-			versionConfig: kubeoneapi.VersionConfig{
-				Kubernetes: "1.26.1",
+			name: "invalid kernel module",
+			kernel: &kubeoneapi.KernelConfig{
+				Modules: []string{"ip_vs rr"},
 			},
 			expectedError: true,
 		},
 		{
-			name: "one valid host config and one invalid host config (no username)",
-			hostConfig: []kubeoneapi.HostConfig{
-				{
-					PublicAddress:     "192.168.1.1",
-					PrivateAddress:    "192.168.0.1",
-					SSHPrivateKeyFile: "test",
-					SSHAgentSocket:    "test",
-					SSHUsername:       "root",
-				},
-				{
-					PublicAddress:     "cluster-test.public.local",
-					PrivateAddress:    "cluster-test.private.local",
-					SSHPrivateKeyFile: "test",
-					SSHAgentSocket:    "test",
-					SSHUsername:       "",
-				},
-			},
-			versionConfig: kubeoneapi.VersionConfig{
-				Kubernetes: "1.26.1",
+			name: "duplicate kernel module",
+			kernel: &kubeoneapi.KernelConfig{
+				Modules: []string{"ip_vs", "ip_vs"},
 			},
 			expectedError: true,
 		},
-		{
-			name: "two leaders at the same time",
-			hostConfig: []kubeoneapi.HostConfig{
-				{
-					PublicAddress:     "192.168.1.1",
-					PrivateAddress:    "192.168.0.1",
-					SSHPrivateKeyFile: "test",
-					SSHAgentSocket:    "test",
-					SSHUsername:       "root",
-					IsLeader:          true,
-				},
-				{
-					PublicAddress:     "cluster-test.public.local",
-					PrivateAddress:    "cluster-test.private.local",
-					SSHPrivateKeyFile: "test",
-					SSHAgentSocket:    "test",
-					SSHUsername:       "root",
-					IsLeader:          true,
-				},
-			},
-			versionConfig: kubeoneapi.VersionConfig{
-				Kubernetes: "1.26.1",
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			errs := ValidateKernelConfig(tc.kernel, field.NewPath("kernel"))
+			if (len(errs) == 0) == tc.expectedError {
+				t.Errorf("test case failed: expected %v, but got %v", tc.expectedError, (len(errs) != 0))
+			}
+		})
+	}
+}
+
+func TestValidateBackupsConfig(t *testing.T) {
+	validEtcdBackups := func() *kubeoneapi.EtcdBackupsConfig {
+		return &kubeoneapi.EtcdBackupsConfig{
+			Enable:    true,
+			Schedule:  "@every 30m",
+			Retention: 48,
+			Target: kubeoneapi.EtcdBackupsTarget{
+				Endpoint: "s3.amazonaws.com",
+				Bucket:   "backups",
 			},
-			expectedError: true,
-		},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		backups       func() *kubeoneapi.BackupsConfig
+		expectedError bool
+	}{
 		{
-			name: "valid OS",
-			hostConfig: []kubeoneapi.HostConfig{
-				{
-					PublicAddress:     "192.168.1.1",
-					PrivateAddress:    "192.168.0.1",
-					SSHPrivateKeyFile: "test",
-					SSHAgentSocket:    "test",
-					SSHUsername:       "root",
-					OperatingSystem:   kubeoneapi.OperatingSystemNameCentOS,
-				},
-			},
-			versionConfig: kubeoneapi.VersionConfig{
-				Kubernetes: "1.26.1",
-			},
+			name:          "no backups",
+			backups:       func() *kubeoneapi.BackupsConfig { return nil },
 			expectedError: false,
 		},
 		{
-			name: "invalid OS",
-			hostConfig: []kubeoneapi.HostConfig{
-				{
-					PublicAddress:     "192.168.1.1",
-					PrivateAddress:    "192.168.0.1",
-					SSHPrivateKeyFile: "test",
-					SSHAgentSocket:    "test",
-					SSHUsername:       "root",
-					OperatingSystem:   kubeoneapi.OperatingSystemName("non-existing"),
-				},
-			},
-			versionConfig: kubeoneapi.VersionConfig{
-				Kubernetes: "1.26.1",
+			name: "disabled etcd backups",
+			backups: func() *kubeoneapi.BackupsConfig {
+				return &kubeoneapi.BackupsConfig{Etcd: &kubeoneapi.EtcdBackupsConfig{}}
 			},
-			expectedError: true,
+			expectedError: false,
 		},
 		{
-			name: "kubelet.maxPods valid",
-			hostConfig: []kubeoneapi.HostConfig{
-				{
-					PublicAddress:     "192.168.1.1",
-					PrivateAddress:    "192.168.0.1",
-					SSHPrivateKeyFile: "test",
-					SSHAgentSocket:    "test",
-					SSHUsername:       "root",
-					Kubelet: kubeoneapi.KubeletConfig{
-						MaxPods: pointer.New(int32(110)),
-					},
-				},
-			},
-			versionConfig: kubeoneapi.VersionConfig{
-				Kubernetes: "1.26.1",
+			name: "valid etcd backups",
+			backups: func() *kubeoneapi.BackupsConfig {
+				return &kubeoneapi.BackupsConfig{Etcd: validEtcdBackups()}
 			},
 			expectedError: false,
 		},
 		{
-			name: "kubelet.maxPods zero (invalid)",
-			hostConfig: []kubeoneapi.HostConfig{
-				{
-					PublicAddress:     "192.168.1.1",
-					PrivateAddress:    "192.168.0.1",
-					SSHPrivateKeyFile: "test",
-					SSHAgentSocket:    "test",
-					SSHUsername:       "root",
-					Kubelet: kubeoneapi.KubeletConfig{
-						MaxPods: pointer.New(int32(0)),
-					},
-				},
-			},
-			versionConfig: kubeoneapi.VersionConfig{
-				Kubernetes: "1.26.1",
+			name: "missing bucket",
+			backups: func() *kubeoneapi.BackupsConfig {
+				etcd := validEtcdBackups()
+				etcd.Target.Bucket = ""
+
+				return &kubeoneapi.BackupsConfig{Etcd: etcd}
 			},
 			expectedError: true,
 		},
 		{
-			name: "kubelet.maxPods negative (invalid)",
-			hostConfig: []kubeoneapi.HostConfig{
-				{
-					PublicAddress:     "192.168.1.1",
-					PrivateAddress:    "192.168.0.1",
-					SSHPrivateKeyFile: "test",
-					SSHAgentSocket:    "test",
-					SSHUsername:       "root",
-					Kubelet: kubeoneapi.KubeletConfig{
-						MaxPods: pointer.New(int32(-10)),
-					},
-				},
+			name: "bucket with path",
+			backups: func() *kubeoneapi.BackupsConfig {
+				etcd := validEtcdBackups()
+				etcd.Target.Bucket = "backups/etcd"
+
+				return &kubeoneapi.BackupsConfig{Etcd: etcd}
 			},
-			versionConfig: kubeoneapi.VersionConfig{
-				Kubernetes: "1.26.1",
+			expectedError: true,
+		},
+		{
+			name: "endpoint with scheme",
+			backups: func() *kubeoneapi.BackupsConfig {
+				etcd := validEtcdBackups()
+				etcd.Target.Endpoint = "https://minio.example.com"
+
+				return &kubeoneapi.BackupsConfig{Etcd: etcd}
 			},
 			expectedError: true,
 		},
 		{
-			name: "incorrect label marked to remove",
-			hostConfig: []kubeoneapi.HostConfig{
-				{
-					PublicAddress:     "192.168.1.1",
-					PrivateAddress:    "192.168.0.1",
-					SSHPrivateKeyFile: "test",
-					SSHAgentSocket:    "test",
-					SSHUsername:       "root",
-					Labels: map[string]string{
-						"label-to-remove-": "this values has to be empty",
-					},
-				},
+			name: "invalid retention",
+			backups: func() *kubeoneapi.BackupsConfig {
+				etcd := validEtcdBackups()
+				etcd.Retention = -1
+
+				return &kubeoneapi.BackupsConfig{Etcd: etcd}
 			},
-			versionConfig: kubeoneapi.VersionConfig{
-				Kubernetes: "1.26.1",
+			expectedError: true,
+		},
+		{
+			name: "missing schedule",
+			backups: func() *kubeoneapi.BackupsConfig {
+				etcd := validEtcdBackups()
+				etcd.Schedule = ""
+
+				return &kubeoneapi.BackupsConfig{Etcd: etcd}
 			},
 			expectedError: true,
 		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			errs := ValidateBackupsConfig(tc.backups(), field.NewPath("backups"))
+			if (len(errs) == 0) == tc.expectedError {
+				t.Errorf("test case failed: expected %v, but got %v", tc.expectedError, (len(errs) != 0))
+			}
+		})
+	}
+}
+
+func TestValidateCertificateAuthorityConfig(t *testing.T) {
+	tests := []struct {
+		name          string
+		ca            *kubeoneapi.CertificateAuthorityConfig
+		expectedError bool
+	}{
+		{
+			name:          "no certificate authority",
+			ca:            nil,
+			expectedError: false,
+		},
 		{
-			name: "correct label marked to remove",
-			hostConfig: []kubeoneapi.HostConfig{
-				{
-					PublicAddress:     "192.168.1.1",
-					PrivateAddress:    "192.168.0.1",
-					SSHPrivateKeyFile: "test",
-					SSHAgentSocket:    "test",
-					SSHUsername:       "root",
-					Labels: map[string]string{
-						"label-to-remove-": "",
-					},
+			name: "files",
+			ca: &kubeoneapi.CertificateAuthorityConfig{
+				Kubernetes: &kubeoneapi.CertificateAuthoritySource{
+					CertificateFile: "pki/ca.crt",
+					KeyFile:         "pki/ca.key",
 				},
 			},
-			versionConfig: kubeoneapi.VersionConfig{
-				Kubernetes: "1.26.1",
-			},
 			expectedError: false,
 		},
 		{
-			name: "master taint on 1.24",
-			hostConfig: []kubeoneapi.HostConfig{
-				{
-					PublicAddress:     "192.168.1.1",
-					PrivateAddress:    "192.168.0.1",
-					SSHPrivateKeyFile: "test",
-					SSHAgentSocket:    "test",
-					SSHUsername:       "root",
-					Taints: []corev1.Taint{
-						{
-							Key: "node-role.kubernetes.io/control-plane",
-						},
-						{
-							Key: "node-role.kubernetes.io/master",
-						},
+			name: "vault",
+			ca: &kubeoneapi.CertificateAuthorityConfig{
+				Etcd: &kubeoneapi.CertificateAuthoritySource{
+					Vault: &kubeoneapi.VaultCertificateAuthoritySource{
+						Path:           "secret/data/kubeone/etcd-ca",
+						CertificateKey: "tls.crt",
+						PrivateKeyKey:  "tls.key",
+					},
+				},
+				FrontProxy: &kubeoneapi.CertificateAuthoritySource{
+					Vault: &kubeoneapi.VaultCertificateAuthoritySource{
+						Path:           "secret/data/kubeone/front-proxy-ca",
+						CertificateKey: "tls.crt",
+						PrivateKeyKey:  "tls.key",
 					},
 				},
-			},
-			versionConfig: kubeoneapi.VersionConfig{
-				Kubernetes: "1.24.10",
 			},
 			expectedError: false,
 		},
 		{
-			name: "only control-plane taint on 1.24",
-			hostConfig: []kubeoneapi.HostConfig{
-				{
-					PublicAddress:     "192.168.1.1",
-					PrivateAddress:    "192.168.0.1",
-					SSHPrivateKeyFile: "test",
-					SSHAgentSocket:    "test",
-					SSHUsername:       "root",
-					Taints: []corev1.Taint{
-						{
-							Key: "node-role.kubernetes.io/control-plane",
-						},
-					},
-				},
+			name:          "no certificate authority configured",
+			ca:            &kubeoneapi.CertificateAuthorityConfig{},
+			expectedError: true,
+		},
+		{
+			name: "no source",
+			ca: &kubeoneapi.CertificateAuthorityConfig{
+				Kubernetes: &kubeoneapi.CertificateAuthoritySource{},
 			},
-			versionConfig: kubeoneapi.VersionConfig{
-				Kubernetes: "1.24.10",
+			expectedError: true,
+		},
+		{
+			name: "missing key file",
+			ca: &kubeoneapi.CertificateAuthorityConfig{
+				Kubernetes: &kubeoneapi.CertificateAuthoritySource{
+					CertificateFile: "pki/ca.crt",
+				},
 			},
-			expectedError: false,
+			expectedError: true,
 		},
 		{
-			name: "master taint on 1.25",
-			hostConfig: []kubeoneapi.HostConfig{
-				{
-					PublicAddress:     "192.168.1.1",
-					PrivateAddress:    "192.168.0.1",
-					SSHPrivateKeyFile: "test",
-					SSHAgentSocket:    "test",
-					SSHUsername:       "root",
-					Taints: []corev1.Taint{
-						{
-							Key: "node-role.kubernetes.io/control-plane",
-						},
-						{
-							Key: "node-role.kubernetes.io/master",
-						},
+			name: "files and vault",
+			ca: &kubeoneapi.CertificateAuthorityConfig{
+				Kubernetes: &kubeoneapi.CertificateAuthoritySource{
+					CertificateFile: "pki/ca.crt",
+					KeyFile:         "pki/ca.key",
+					Vault: &kubeoneapi.VaultCertificateAuthoritySource{
+						Path: "secret/data/kubeone/ca",
 					},
 				},
 			},
-			versionConfig: kubeoneapi.VersionConfig{
-				Kubernetes: "1.25.6",
-			},
 			expectedError: true,
 		},
 		{
-			name: "only control-plane taint on 1.25",
-			hostConfig: []kubeoneapi.HostConfig{
-				{
-					PublicAddress:     "192.168.1.1",
-					PrivateAddress:    "192.168.0.1",
-					SSHPrivateKeyFile: "test",
-					SSHAgentSocket:    "test",
-					SSHUsername:       "root",
-					Taints: []corev1.Taint{
-						{
-							Key: "node-role.kubernetes.io/control-plane",
-						},
+			name: "missing vault path",
+			ca: &kubeoneapi.CertificateAuthorityConfig{
+				Kubernetes: &kubeoneapi.CertificateAuthoritySource{
+					Vault: &kubeoneapi.VaultCertificateAuthoritySource{
+						CertificateKey: "tls.crt",
+						PrivateKeyKey:  "tls.key",
 					},
 				},
 			},
-			versionConfig: kubeoneapi.VersionConfig{
-				Kubernetes: "1.25.6",
-			},
-			expectedError: false,
+			expectedError: true,
 		},
 		{
-			name: "master taint on 1.26",
-			hostConfig: []kubeoneapi.HostConfig{
-				{
-					PublicAddress:     "192.168.1.1",
-					PrivateAddress:    "192.168.0.1",
-					SSHPrivateKeyFile: "test",
-					SSHAgentSocket:    "test",
-					SSHUsername:       "root",
-					Taints: []corev1.Taint{
-						{
-							Key: "node-role.kubernetes.io/master",
-						},
+			name: "same vault keys",
+			ca: &kubeoneapi.CertificateAuthorityConfig{
+				Kubernetes: &kubeoneapi.CertificateAuthoritySource{
+					Vault: &kubeoneapi.VaultCertificateAuthoritySource{
+						Path:           "secret/data/kubeone/ca",
+						CertificateKey: "ca",
+						PrivateKeyKey:  "ca",
 					},
 				},
 			},
-			versionConfig: kubeoneapi.VersionConfig{
-				Kubernetes: "1.26.1",
-			},
 			expectedError: true,
 		},
 	}
-
 	for _, tc := range tests {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			errs := ValidateHostConfig(tc.hostConfig, tc.versionConfig, tc.networkConfig, nil)
+			errs := ValidateCertificateAuthorityConfig(tc.ca, field.NewPath("certificateAuthority"))
 			if (len(errs) == 0) == tc.expectedError {
 				t.Errorf("test case failed: expected %v, but got %v", tc.expectedError, (len(errs) != 0))
 			}
@@ -2658,41 +7415,192 @@ func TestValidateHostConfig(t *testing.T) {
 	}
 }
 
-func TestValidateRegistryConfiguration(t *testing.T) {
+func TestValidateUpgradesConfig(t *testing.T) {
 	tests := []struct {
-		name                  string
-		registryConfiguration *kubeoneapi.RegistryConfiguration
-		expectedError         bool
+		name               string
+		drain              *kubeoneapi.DrainConfig
+		canary             *kubeoneapi.CanaryUpgradeConfig
+		intermediate       []string
+		maintenanceWindows []kubeoneapi.MaintenanceWindow
+		operatingSystem    *kubeoneapi.OperatingSystemUpgradeConfig
+		staticWorkers      *kubeoneapi.StaticWorkersUpgradeConfig
+		unattended         *kubeoneapi.UnattendedUpgradesConfig
+		expectedError      bool
 	}{
 		{
-			name: "valid registry config (overwrite registry)",
-			registryConfiguration: &kubeoneapi.RegistryConfiguration{
-				OverwriteRegistry: "127.0.0.1:5000",
+			name:          "no drain config",
+			drain:         nil,
+			expectedError: false,
+		},
+		{
+			name: "valid drain config",
+			drain: &kubeoneapi.DrainConfig{
+				Timeout:         &metav1.Duration{Duration: 10 * time.Minute},
+				ForceEviction:   true,
+				SkipNamespaces:  []string{"monitoring"},
+				SkipPodSelector: "app=etcd-operator,tier!=frontend",
 			},
 			expectedError: false,
 		},
 		{
-			name: "valid registry config (overwrite registry and insecure)",
-			registryConfiguration: &kubeoneapi.RegistryConfiguration{
-				OverwriteRegistry: "127.0.0.1:5000",
-				InsecureRegistry:  true,
+			name: "zero timeout",
+			drain: &kubeoneapi.DrainConfig{
+				Timeout: &metav1.Duration{},
+			},
+			expectedError: true,
+		},
+		{
+			name: "invalid namespace",
+			drain: &kubeoneapi.DrainConfig{
+				SkipNamespaces: []string{"Monitoring"},
+			},
+			expectedError: true,
+		},
+		{
+			name: "invalid pod selector",
+			drain: &kubeoneapi.DrainConfig{
+				SkipPodSelector: "app in (etcd",
 			},
+			expectedError: true,
+		},
+		{
+			name:          "intermediate versions",
+			intermediate:  []string{"1.26.11", "1.27.8"},
 			expectedError: false,
 		},
 		{
-			name:                  "valid registry config (empty)",
-			registryConfiguration: &kubeoneapi.RegistryConfiguration{},
-			expectedError:         false,
+			name:          "intermediate version of the target minor",
+			intermediate:  []string{"1.28.2"},
+			expectedError: true,
 		},
 		{
-			name:                  "valid registry config (nil)",
-			registryConfiguration: nil,
-			expectedError:         false,
+			name:          "unsorted intermediate versions",
+			intermediate:  []string{"1.27.8", "1.26.11"},
+			expectedError: true,
 		},
 		{
-			name: "invalid registry config (insecure registry without overwrite registry)",
-			registryConfiguration: &kubeoneapi.RegistryConfiguration{
-				InsecureRegistry: true,
+			name:          "unsupported intermediate version",
+			intermediate:  []string{"1.24.17"},
+			expectedError: true,
+		},
+		{
+			name: "canary upgrade with soak time",
+			canary: &kubeoneapi.CanaryUpgradeConfig{
+				Enable:   true,
+				SoakTime: &metav1.Duration{Duration: 30 * time.Minute},
+			},
+			expectedError: false,
+		},
+		{
+			name: "canary upgrade with negative soak time",
+			canary: &kubeoneapi.CanaryUpgradeConfig{
+				Enable:   true,
+				SoakTime: &metav1.Duration{Duration: -time.Minute},
+			},
+			expectedError: true,
+		},
+		{
+			name: "valid maintenance windows",
+			maintenanceWindows: []kubeoneapi.MaintenanceWindow{
+				{Days: []string{"Saturday", "Sunday"}, Start: "22:00", Duration: metav1.Duration{Duration: 4 * time.Hour}, TimeZone: "Europe/Berlin"},
+				{Start: "03:30", Duration: metav1.Duration{Duration: time.Hour}},
+			},
+			expectedError: false,
+		},
+		{
+			name: "maintenance window with invalid day",
+			maintenanceWindows: []kubeoneapi.MaintenanceWindow{
+				{Days: []string{"Sat"}, Start: "22:00", Duration: metav1.Duration{Duration: time.Hour}},
+			},
+			expectedError: true,
+		},
+		{
+			name: "maintenance window with invalid start",
+			maintenanceWindows: []kubeoneapi.MaintenanceWindow{
+				{Start: "10pm", Duration: metav1.Duration{Duration: time.Hour}},
+			},
+			expectedError: true,
+		},
+		{
+			name: "maintenance window longer than a day",
+			maintenanceWindows: []kubeoneapi.MaintenanceWindow{
+				{Start: "22:00", Duration: metav1.Duration{Duration: 25 * time.Hour}},
+			},
+			expectedError: true,
+		},
+		{
+			name: "maintenance window with invalid time zone",
+			maintenanceWindows: []kubeoneapi.MaintenanceWindow{
+				{Start: "22:00", Duration: metav1.Duration{Duration: time.Hour}, TimeZone: "Mars/Olympus_Mons"},
+			},
+			expectedError: true,
+		},
+		{
+			name: "valid operating system upgrade",
+			operatingSystem: &kubeoneapi.OperatingSystemUpgradeConfig{
+				UbuntuRelease:           "24.04",
+				MachineDeploymentImages: map[string]string{"pool-1": "ami-0123456789abcdef0"},
+			},
+			expectedError: false,
+		},
+		{
+			name: "invalid ubuntu release",
+			operatingSystem: &kubeoneapi.OperatingSystemUpgradeConfig{
+				UbuntuRelease: "noble",
+			},
+			expectedError: true,
+		},
+		{
+			name: "machine deployment without image",
+			operatingSystem: &kubeoneapi.OperatingSystemUpgradeConfig{
+				MachineDeploymentImages: map[string]string{"pool-1": ""},
+			},
+			expectedError: true,
+		},
+		{
+			name: "static workers upgraded in batches",
+			staticWorkers: &kubeoneapi.StaticWorkersUpgradeConfig{
+				MaxUnavailable: pointer.New(intstr.FromString("20%")),
+			},
+			expectedError: false,
+		},
+		{
+			name: "zero static workers upgraded at a time",
+			staticWorkers: &kubeoneapi.StaticWorkersUpgradeConfig{
+				MaxUnavailable: pointer.New(intstr.FromInt(0)),
+			},
+			expectedError: true,
+		},
+		{
+			name: "unattended upgrades with a reboot window",
+			unattended: &kubeoneapi.UnattendedUpgradesConfig{
+				Enable: true,
+				RebootWindow: &kubeoneapi.MaintenanceWindow{
+					Days:     []string{"Saturday", "Sunday"},
+					Start:    "02:00",
+					Duration: metav1.Duration{Duration: 3 * time.Hour},
+					TimeZone: "Europe/Berlin",
+				},
+				PackageBlacklist: []string{"linux-image-*", "libc6"},
+			},
+			expectedError: false,
+		},
+		{
+			name: "unattended upgrades with an invalid reboot window",
+			unattended: &kubeoneapi.UnattendedUpgradesConfig{
+				Enable: true,
+				RebootWindow: &kubeoneapi.MaintenanceWindow{
+					Start:    "2am",
+					Duration: metav1.Duration{Duration: 3 * time.Hour},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "unattended upgrades with an invalid package name",
+			unattended: &kubeoneapi.UnattendedUpgradesConfig{
+				Enable:           true,
+				PackageBlacklist: []string{"nginx; reboot"},
 			},
 			expectedError: true,
 		},
@@ -2700,9 +7608,20 @@ func TestValidateRegistryConfiguration(t *testing.T) {
 	for _, tc := range tests {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			errs := ValidateRegistryConfiguration(tc.registryConfiguration, nil)
+			errs := ValidateUpgradesConfig(
+				&kubeoneapi.UpgradesConfig{
+					Drain:                tc.drain,
+					Canary:               tc.canary,
+					IntermediateVersions: tc.intermediate,
+					MaintenanceWindows:   tc.maintenanceWindows,
+					OperatingSystem:      tc.operatingSystem,
+					StaticWorkers:        tc.staticWorkers,
+					Unattended:           tc.unattended,
+				},
+				kubeoneapi.VersionConfig{Kubernetes: "1.28.4"},
+				field.NewPath("upgrades"),
+			)
 			if (len(errs) == 0) == tc.expectedError {
-				t.Log(errs[0])
 				t.Errorf("test case failed: expected %v, but got %v", tc.expectedError, (len(errs) != 0))
 			}
 		})
@@ -0,0 +1,95 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bmc
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/fail"
+)
+
+const passwordEnvPrefix = "env:"
+
+// Client manages the power state and the boot device of a host through its
+// baseboard management controller
+type Client interface {
+	// SetOneTimeBoot configures the host to boot from the given device on the
+	// next boot only
+	SetOneTimeBoot(ctx context.Context, device kubeoneapi.BMCBootDevice) error
+
+	// InsertVirtualMedia inserts the ISO image as a virtual CD/DVD
+	InsertVirtualMedia(ctx context.Context, imageURL string) error
+
+	// PowerCycle restarts the host, or powers it on if it's powered off
+	PowerCycle(ctx context.Context) error
+}
+
+// New returns the Client for the protocol configured in the BMCConfig
+func New(cfg kubeoneapi.BMCConfig) (Client, error) {
+	password, err := resolvePassword(cfg.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	switch cfg.Protocol {
+	case kubeoneapi.BMCProtocolRedfish:
+		return newRedfishClient(cfg, password), nil
+	case kubeoneapi.BMCProtocolIPMI:
+		return newIPMIClient(cfg, password), nil
+	}
+
+	return nil, fail.NewConfigError("bmc", "unsupported BMC protocol %q", cfg.Protocol)
+}
+
+// Reprovision configures the host to boot once from the configured boot
+// device, e.g. to re-image it from PXE or from the inserted ISO image, and
+// power cycles it
+func Reprovision(ctx context.Context, cfg kubeoneapi.BMCConfig) error {
+	client, err := New(cfg)
+	if err != nil {
+		return err
+	}
+
+	if cfg.BootDevice == kubeoneapi.BMCBootDeviceVirtualMedia {
+		if err = client.InsertVirtualMedia(ctx, cfg.ImageURL); err != nil {
+			return err
+		}
+	}
+
+	if err = client.SetOneTimeBoot(ctx, cfg.BootDevice); err != nil {
+		return err
+	}
+
+	return client.PowerCycle(ctx)
+}
+
+func resolvePassword(password string) (string, error) {
+	if !strings.HasPrefix(password, passwordEnvPrefix) {
+		return password, nil
+	}
+
+	envName := strings.TrimPrefix(password, passwordEnvPrefix)
+	value := os.Getenv(envName)
+	if value == "" {
+		return "", fail.NewConfigError("bmc", "environment variable %q referenced by the BMC password is empty", envName)
+	}
+
+	return value, nil
+}
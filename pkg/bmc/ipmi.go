@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bmc
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/fail"
+)
+
+// ipmitoolBinary is the ipmitool binary used to talk to IPMI BMCs. It has to
+// be available in the PATH of the machine running KubeOne.
+const ipmitoolBinary = "ipmitool"
+
+type ipmiClient struct {
+	address  string
+	username string
+	password string
+}
+
+func newIPMIClient(cfg kubeoneapi.BMCConfig, password string) *ipmiClient {
+	return &ipmiClient{
+		address:  cfg.Address,
+		username: cfg.Username,
+		password: password,
+	}
+}
+
+func (c *ipmiClient) SetOneTimeBoot(ctx context.Context, device kubeoneapi.BMCBootDevice) error {
+	if device != kubeoneapi.BMCBootDevicePXE {
+		return fail.NewConfigError("bmc", "boot device %q is not supported by IPMI", device)
+	}
+
+	_, err := c.run(ctx, "chassis", "bootdev", "pxe")
+
+	return err
+}
+
+func (c *ipmiClient) InsertVirtualMedia(context.Context, string) error {
+	return fail.NewConfigError("bmc", "virtual media is not supported by IPMI")
+}
+
+func (c *ipmiClient) PowerCycle(ctx context.Context) error {
+	status, err := c.run(ctx, "chassis", "power", "status")
+	if err != nil {
+		return err
+	}
+
+	// ipmitool reports "Chassis Power is on" or "Chassis Power is off"
+	if strings.HasSuffix(strings.TrimSpace(status), "off") {
+		_, err = c.run(ctx, "chassis", "power", "on")
+
+		return err
+	}
+
+	_, err = c.run(ctx, "chassis", "power", "cycle")
+
+	return err
+}
+
+func (c *ipmiClient) run(ctx context.Context, args ...string) (string, error) {
+	// The password is passed via the environment (-E) so it's not visible in
+	// the process list
+	cmdArgs := append([]string{"-I", "lanplus", "-H", c.address, "-U", c.username, "-E"}, args...)
+
+	cmd := exec.CommandContext(ctx, ipmitoolBinary, cmdArgs...)
+	cmd.Env = append(os.Environ(), fmt.Sprintf("IPMI_PASSWORD=%s", c.password))
+
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", fail.Runtime(fmt.Errorf("%w: %s", err, strings.TrimSpace(string(out))), "running ipmitool %s", strings.Join(args, " "))
+	}
+
+	return string(out), nil
+}
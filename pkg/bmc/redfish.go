@@ -0,0 +1,257 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bmc
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/fail"
+)
+
+const (
+	redfishSystemsPath  = "/redfish/v1/Systems"
+	redfishManagersPath = "/redfish/v1/Managers"
+
+	redfishPowerStateOff = "Off"
+)
+
+type redfishLink struct {
+	ID string `json:"@odata.id"`
+}
+
+type redfishCollection struct {
+	Members []redfishLink `json:"Members"`
+}
+
+type redfishSystem struct {
+	PowerState string `json:"PowerState"`
+}
+
+type redfishManager struct {
+	VirtualMedia redfishLink `json:"VirtualMedia"`
+}
+
+type redfishVirtualMedia struct {
+	MediaTypes []string `json:"MediaTypes"`
+	Actions    struct {
+		InsertMedia struct {
+			Target string `json:"target"`
+		} `json:"#VirtualMedia.InsertMedia"`
+	} `json:"Actions"`
+}
+
+type redfishClient struct {
+	endpoint string
+	username string
+	password string
+	systemID string
+	client   *http.Client
+}
+
+func newRedfishClient(cfg kubeoneapi.BMCConfig, password string) *redfishClient {
+	return &redfishClient{
+		endpoint: strings.TrimSuffix(cfg.Address, "/"),
+		username: cfg.Username,
+		password: password,
+		systemID: cfg.SystemID,
+		client: &http.Client{
+			Timeout: 30 * time.Second,
+			Transport: &http.Transport{
+				Proxy: http.ProxyFromEnvironment,
+				TLSClientConfig: &tls.Config{
+					InsecureSkipVerify: cfg.InsecureSkipTLSVerify, //nolint:gosec
+				},
+			},
+		},
+	}
+}
+
+func (c *redfishClient) SetOneTimeBoot(ctx context.Context, device kubeoneapi.BMCBootDevice) error {
+	target := "Pxe"
+	if device == kubeoneapi.BMCBootDeviceVirtualMedia {
+		target = "Cd"
+	}
+
+	systemPath, err := c.systemPath(ctx)
+	if err != nil {
+		return err
+	}
+
+	body := map[string]interface{}{
+		"Boot": map[string]string{
+			"BootSourceOverrideEnabled": "Once",
+			"BootSourceOverrideTarget":  target,
+		},
+	}
+
+	return c.do(ctx, http.MethodPatch, systemPath, body, nil)
+}
+
+func (c *redfishClient) InsertVirtualMedia(ctx context.Context, imageURL string) error {
+	var managers redfishCollection
+	if err := c.do(ctx, http.MethodGet, redfishManagersPath, nil, &managers); err != nil {
+		return err
+	}
+
+	for _, managerLink := range managers.Members {
+		var manager redfishManager
+		if err := c.do(ctx, http.MethodGet, managerLink.ID, nil, &manager); err != nil {
+			return err
+		}
+
+		if manager.VirtualMedia.ID == "" {
+			continue
+		}
+
+		var media redfishCollection
+		if err := c.do(ctx, http.MethodGet, manager.VirtualMedia.ID, nil, &media); err != nil {
+			return err
+		}
+
+		for _, mediaLink := range media.Members {
+			var vm redfishVirtualMedia
+			if err := c.do(ctx, http.MethodGet, mediaLink.ID, nil, &vm); err != nil {
+				return err
+			}
+
+			if !isOpticalMedia(vm.MediaTypes) {
+				continue
+			}
+
+			target := vm.Actions.InsertMedia.Target
+			if target == "" {
+				target = mediaLink.ID + "/Actions/VirtualMedia.InsertMedia"
+			}
+
+			body := map[string]interface{}{
+				"Image":          imageURL,
+				"Inserted":       true,
+				"WriteProtected": true,
+			}
+
+			return c.do(ctx, http.MethodPost, target, body, nil)
+		}
+	}
+
+	return fail.NewRuntimeError("inserting virtual media", "no virtual CD/DVD drive found on %s", c.endpoint)
+}
+
+func (c *redfishClient) PowerCycle(ctx context.Context) error {
+	systemPath, err := c.systemPath(ctx)
+	if err != nil {
+		return err
+	}
+
+	var system redfishSystem
+	if err = c.do(ctx, http.MethodGet, systemPath, nil, &system); err != nil {
+		return err
+	}
+
+	resetType := "ForceRestart"
+	if system.PowerState == redfishPowerStateOff {
+		resetType = "On"
+	}
+
+	body := map[string]string{
+		"ResetType": resetType,
+	}
+
+	return c.do(ctx, http.MethodPost, systemPath+"/Actions/ComputerSystem.Reset", body, nil)
+}
+
+func (c *redfishClient) systemPath(ctx context.Context) (string, error) {
+	if c.systemID != "" {
+		return redfishSystemsPath + "/" + c.systemID, nil
+	}
+
+	var systems redfishCollection
+	if err := c.do(ctx, http.MethodGet, redfishSystemsPath, nil, &systems); err != nil {
+		return "", err
+	}
+
+	if len(systems.Members) == 0 {
+		return "", fail.NewRuntimeError("listing redfish systems", "no systems found on %s", c.endpoint)
+	}
+
+	// Cache the discovered system so it's not looked up on every request
+	c.systemID = strings.TrimPrefix(systems.Members[0].ID, redfishSystemsPath+"/")
+
+	return systems.Members[0].ID, nil
+}
+
+func (c *redfishClient) do(ctx context.Context, method, path string, in, out interface{}) error {
+	var reqBody io.Reader
+	if in != nil {
+		buf, err := json.Marshal(in)
+		if err != nil {
+			return fail.Runtime(err, "marshaling redfish request")
+		}
+		reqBody = bytes.NewReader(buf)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.endpoint+path, reqBody)
+	if err != nil {
+		return fail.Runtime(err, "creating redfish request")
+	}
+
+	req.SetBasicAuth(c.username, c.password)
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fail.Connection(err, c.endpoint)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+
+		return fail.Runtime(fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(msg))), "redfish %s %s", method, path)
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	if err = json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fail.Runtime(err, "decoding redfish response from %s", path)
+	}
+
+	return nil
+}
+
+func isOpticalMedia(mediaTypes []string) bool {
+	for _, mt := range mediaTypes {
+		if mt == "CD" || mt == "DVD" {
+			return true
+		}
+	}
+
+	return false
+}
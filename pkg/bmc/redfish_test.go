@@ -0,0 +1,196 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bmc
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+)
+
+type redfishRequest struct {
+	method string
+	path   string
+	body   map[string]interface{}
+}
+
+func newFakeRedfish(t *testing.T, powerState string) (*httptest.Server, *[]redfishRequest) {
+	t.Helper()
+
+	var requests []redfishRequest
+	responses := map[string]string{
+		"/redfish/v1/Systems":                                `{"Members":[{"@odata.id":"/redfish/v1/Systems/1"}]}`,
+		"/redfish/v1/Systems/1":                              `{"PowerState":"` + powerState + `"}`,
+		"/redfish/v1/Managers":                               `{"Members":[{"@odata.id":"/redfish/v1/Managers/1"}]}`,
+		"/redfish/v1/Managers/1":                             `{"VirtualMedia":{"@odata.id":"/redfish/v1/Managers/1/VirtualMedia"}}`,
+		"/redfish/v1/Managers/1/VirtualMedia":                `{"Members":[{"@odata.id":"/redfish/v1/Managers/1/VirtualMedia/Floppy"},{"@odata.id":"/redfish/v1/Managers/1/VirtualMedia/CD"}]}`,
+		"/redfish/v1/Managers/1/VirtualMedia/Floppy":         `{"MediaTypes":["Floppy","USBStick"]}`,
+		"/redfish/v1/Managers/1/VirtualMedia/CD":             `{"MediaTypes":["CD","DVD"],"Actions":{"#VirtualMedia.InsertMedia":{"target":"/redfish/v1/Managers/1/VirtualMedia/CD/Actions/VirtualMedia.InsertMedia"}}}`,
+		"/redfish/v1/Systems/1/Actions/ComputerSystem.Reset": `{}`,
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); !ok || user != "admin" || pass != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		req := redfishRequest{method: r.Method, path: r.URL.Path}
+		if r.Method != http.MethodGet {
+			buf, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(buf, &req.body); err != nil {
+				t.Errorf("invalid request body: %v", err)
+			}
+			requests = append(requests, req)
+			w.WriteHeader(http.StatusNoContent)
+
+			return
+		}
+
+		resp, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+		_, _ = w.Write([]byte(resp))
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, &requests
+}
+
+func TestRedfishReprovision(t *testing.T) {
+	tests := []struct {
+		name       string
+		powerState string
+		bootDevice kubeoneapi.BMCBootDevice
+		imageURL   string
+		expected   []redfishRequest
+	}{
+		{
+			name:       "pxe boot of powered on host",
+			powerState: "On",
+			bootDevice: kubeoneapi.BMCBootDevicePXE,
+			expected: []redfishRequest{
+				{
+					method: http.MethodPatch,
+					path:   "/redfish/v1/Systems/1",
+					body: map[string]interface{}{
+						"Boot": map[string]interface{}{
+							"BootSourceOverrideEnabled": "Once",
+							"BootSourceOverrideTarget":  "Pxe",
+						},
+					},
+				},
+				{
+					method: http.MethodPost,
+					path:   "/redfish/v1/Systems/1/Actions/ComputerSystem.Reset",
+					body:   map[string]interface{}{"ResetType": "ForceRestart"},
+				},
+			},
+		},
+		{
+			name:       "virtual media boot of powered off host",
+			powerState: "Off",
+			bootDevice: kubeoneapi.BMCBootDeviceVirtualMedia,
+			imageURL:   "http://10.0.0.1/ubuntu.iso",
+			expected: []redfishRequest{
+				{
+					method: http.MethodPost,
+					path:   "/redfish/v1/Managers/1/VirtualMedia/CD/Actions/VirtualMedia.InsertMedia",
+					body: map[string]interface{}{
+						"Image":          "http://10.0.0.1/ubuntu.iso",
+						"Inserted":       true,
+						"WriteProtected": true,
+					},
+				},
+				{
+					method: http.MethodPatch,
+					path:   "/redfish/v1/Systems/1",
+					body: map[string]interface{}{
+						"Boot": map[string]interface{}{
+							"BootSourceOverrideEnabled": "Once",
+							"BootSourceOverrideTarget":  "Cd",
+						},
+					},
+				},
+				{
+					method: http.MethodPost,
+					path:   "/redfish/v1/Systems/1/Actions/ComputerSystem.Reset",
+					body:   map[string]interface{}{"ResetType": "On"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			srv, requests := newFakeRedfish(t, tt.powerState)
+			t.Setenv("KUBEONE_TEST_BMC_PASSWORD", "secret")
+
+			cfg := kubeoneapi.BMCConfig{
+				Protocol:   kubeoneapi.BMCProtocolRedfish,
+				Address:    srv.URL + "/",
+				Username:   "admin",
+				Password:   "env:KUBEONE_TEST_BMC_PASSWORD",
+				BootDevice: tt.bootDevice,
+				ImageURL:   tt.imageURL,
+			}
+
+			if err := Reprovision(context.Background(), cfg); err != nil {
+				t.Fatalf("Reprovision() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(*requests, tt.expected) {
+				t.Errorf("Reprovision() requests = %+v, want %+v", *requests, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRedfishUnauthorized(t *testing.T) {
+	srv, _ := newFakeRedfish(t, "On")
+
+	cfg := kubeoneapi.BMCConfig{
+		Protocol:   kubeoneapi.BMCProtocolRedfish,
+		Address:    srv.URL,
+		Username:   "admin",
+		Password:   "wrong",
+		BootDevice: kubeoneapi.BMCBootDevicePXE,
+	}
+
+	if err := Reprovision(context.Background(), cfg); err == nil {
+		t.Fatal("Reprovision() expected error for invalid credentials")
+	}
+}
+
+func TestResolvePasswordFromEmptyEnv(t *testing.T) {
+	t.Setenv("KUBEONE_TEST_BMC_PASSWORD", "")
+
+	if _, err := resolvePassword("env:KUBEONE_TEST_BMC_PASSWORD"); err == nil {
+		t.Fatal("resolvePassword() expected error for empty environment variable")
+	}
+}
@@ -33,6 +33,11 @@ const (
 	ConfigMapName    = "ca-bundle"
 
 	SSLCertFileENV = "SSL_CERT_FILE"
+
+	// TrustedCAsDir contains the additional trusted CAs bundle installed on all nodes
+	TrustedCAsDir      = "/etc/kubeone/trusted-cas"
+	TrustedCAsFileName = "kubeone-trusted-cas.crt"
+	TrustedCAsFilePath = TrustedCAsDir + "/" + TrustedCAsFileName
 )
 
 func Inject(caBundle string, podTpl *corev1.PodTemplateSpec) {
@@ -82,6 +82,22 @@ func CAKeyPair(config *configupload.Configuration) (*rsa.PrivateKey, *x509.Certi
 	return rsaKey, certs[0], nil
 }
 
+// NewCA generates a self-signed CA certificate with the given common name,
+// and returns the PEM-encoded certificate and its RSA private key
+func NewCA(commonName string) ([]byte, []byte, error) {
+	key, err := newPrivateKey()
+	if err != nil {
+		return nil, nil, fail.Runtime(err, "generating RSA private key")
+	}
+
+	cert, err := certutil.NewSelfSignedCACert(certutil.Config{CommonName: commonName}, key)
+	if err != nil {
+		return nil, nil, fail.Runtime(err, "generating CA certificate")
+	}
+
+	return encodeCertPEM(cert), encodePrivateKeyPEM(key), nil
+}
+
 func NewSignedTLSCert(name, namespace, domain string, caKey crypto.Signer, caCert *x509.Certificate) (map[string]string, error) {
 	serviceCommonName := strings.Join([]string{name, namespace, "svc"}, ".")
 	serviceFQDNCommonName := strings.Join([]string{serviceCommonName, domain, ""}, ".")
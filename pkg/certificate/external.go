@@ -0,0 +1,250 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificate
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"github.com/pkg/errors"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/vault"
+
+	certutil "k8s.io/client-go/util/cert"
+	"k8s.io/client-go/util/keyutil"
+)
+
+const (
+	EtcdCACertPath       = "/etc/kubernetes/pki/etcd/ca.crt"
+	EtcdCAKeyPath        = "/etc/kubernetes/pki/etcd/ca.key"
+	FrontProxyCACertPath = "/etc/kubernetes/pki/front-proxy-ca.crt"
+	FrontProxyCAKeyPath  = "/etc/kubernetes/pki/front-proxy-ca.key"
+)
+
+// ExternalCA is a certificate authority provided in the certificateAuthority
+// block of the KubeOneCluster manifest
+type ExternalCA struct {
+	// Name of the certificate authority, e.g. kubernetes
+	Name string
+
+	// CertPath and KeyPath are the paths of the certificate authority on
+	// the control plane nodes
+	CertPath string
+	KeyPath  string
+
+	// Cert is the PEM-encoded certificate of the certificate authority,
+	// without the chain
+	Cert []byte
+
+	// Key is the PEM-encoded private key of the certificate authority
+	Key []byte
+}
+
+// LoadExternalCAs reads and validates the certificate authorities configured
+// in the certificateAuthority block of the KubeOneCluster manifest. The files
+// are relative to the manifest, and the secrets are read from Vault.
+func LoadExternalCAs(ctx context.Context, config *kubeoneapi.CertificateAuthorityConfig, manifestFilePath string) ([]ExternalCA, error) {
+	if config == nil {
+		return nil, nil
+	}
+
+	externalCAs := []ExternalCA{}
+	for _, ca := range []struct {
+		ExternalCA
+		source     *kubeoneapi.CertificateAuthoritySource
+		requireRSA bool
+	}{
+		{
+			ExternalCA: ExternalCA{Name: "kubernetes", CertPath: KubernetesCACertPath, KeyPath: KubernetesCAKeyPath},
+			source:     config.Kubernetes,
+			// KubeOne signs the certificates of the webhooks with the
+			// kubernetes CA, which is supported only for the RSA keys
+			requireRSA: true,
+		},
+		{
+			ExternalCA: ExternalCA{Name: "etcd", CertPath: EtcdCACertPath, KeyPath: EtcdCAKeyPath},
+			source:     config.Etcd,
+		},
+		{
+			ExternalCA: ExternalCA{Name: "front-proxy", CertPath: FrontProxyCACertPath, KeyPath: FrontProxyCAKeyPath},
+			source:     config.FrontProxy,
+		},
+	} {
+		if ca.source == nil {
+			continue
+		}
+
+		var (
+			certPEM, keyPEM []byte
+			err             error
+		)
+
+		if ca.source.Vault != nil {
+			certPEM, keyPEM, err = readVaultCA(ctx, ca.source.Vault)
+		} else {
+			certPEM, keyPEM, err = readFileCA(ca.source, manifestFilePath)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "loading the %s certificate authority", ca.Name)
+		}
+
+		ca.Cert, ca.Key, err = ValidateCA(certPEM, keyPEM, ca.requireRSA, time.Now())
+		if err != nil {
+			return nil, fail.Config(err, fmt.Sprintf("validating the %s certificate authority", ca.Name))
+		}
+
+		externalCAs = append(externalCAs, ca.ExternalCA)
+	}
+
+	return externalCAs, nil
+}
+
+func readVaultCA(ctx context.Context, source *kubeoneapi.VaultCertificateAuthoritySource) ([]byte, []byte, error) {
+	client, err := vault.Default(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	secret, err := client.ReadKV(ctx, source.Path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cert, found := secret[source.CertificateKey]
+	if !found {
+		return nil, nil, fail.NewConfigError("vault", "the %q secret has no %q key", source.Path, source.CertificateKey)
+	}
+
+	key, found := secret[source.PrivateKeyKey]
+	if !found {
+		return nil, nil, fail.NewConfigError("vault", "the %q secret has no %q key", source.Path, source.PrivateKeyKey)
+	}
+
+	return []byte(cert), []byte(key), nil
+}
+
+func readFileCA(source *kubeoneapi.CertificateAuthoritySource, manifestFilePath string) ([]byte, []byte, error) {
+	certFile := manifestRelativePath(source.CertificateFile, manifestFilePath)
+	keyFile := manifestRelativePath(source.KeyFile, manifestFilePath)
+
+	keyInfo, err := os.Stat(keyFile)
+	if err != nil {
+		return nil, nil, fail.Config(err, "reading the private key")
+	}
+
+	if runtime.GOOS != "windows" && keyInfo.Mode().Perm()&0o077 != 0 {
+		return nil, nil, fail.NewConfigError("reading the private key", "%s is accessible by the group or others, it must have the 0600 or more restrictive permissions", keyFile)
+	}
+
+	cert, err := os.ReadFile(certFile)
+	if err != nil {
+		return nil, nil, fail.Config(err, "reading the certificate")
+	}
+
+	key, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, nil, fail.Config(err, "reading the private key")
+	}
+
+	return cert, key, nil
+}
+
+func manifestRelativePath(path, manifestFilePath string) string {
+	if filepath.IsAbs(path) || manifestFilePath == "" {
+		return path
+	}
+
+	return filepath.Join(filepath.Dir(manifestFilePath), path)
+}
+
+// ValidateCA validates that the first certificate of the PEM-encoded bundle
+// is a certificate authority which is valid at the given time, that the
+// private key matches it, and that the rest of the bundle, if any, is its
+// chain. It returns the PEM-encoded certificate authority without the chain,
+// and the private key.
+func ValidateCA(certPEM, keyPEM []byte, requireRSA bool, now time.Time) ([]byte, []byte, error) {
+	certs, err := certutil.ParseCertsPEM(certPEM)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "parsing the certificate")
+	}
+
+	ca := certs[0]
+
+	if !ca.BasicConstraintsValid || !ca.IsCA {
+		return nil, nil, errors.Errorf("the %q certificate is not a certificate authority", ca.Subject.CommonName)
+	}
+
+	if ca.KeyUsage != 0 && ca.KeyUsage&x509.KeyUsageCertSign == 0 {
+		return nil, nil, errors.Errorf("the %q certificate authority is not allowed to sign certificates", ca.Subject.CommonName)
+	}
+
+	if now.Before(ca.NotBefore) || now.After(ca.NotAfter) {
+		return nil, nil, errors.Errorf("the %q certificate authority is valid only from %s to %s",
+			ca.Subject.CommonName, ca.NotBefore.Format(time.RFC3339), ca.NotAfter.Format(time.RFC3339))
+	}
+
+	for i, parent := range certs[1:] {
+		if err = certs[i].CheckSignatureFrom(parent); err != nil {
+			return nil, nil, errors.Wrapf(err, "verifying the chain of the %q certificate authority", ca.Subject.CommonName)
+		}
+	}
+
+	caPEM := encodeCertPEM(ca)
+
+	if _, err = tls.X509KeyPair(caPEM, keyPEM); err != nil {
+		return nil, nil, errors.Wrap(err, "matching the private key with the certificate")
+	}
+
+	if requireRSA {
+		key, pErr := keyutil.ParsePrivateKeyPEM(keyPEM)
+		if pErr != nil {
+			return nil, nil, errors.Wrap(pErr, "parsing the private key")
+		}
+
+		if _, ok := key.(*rsa.PrivateKey); !ok {
+			return nil, nil, errors.New("the private key must be an RSA private key")
+		}
+	}
+
+	return caPEM, keyPEM, nil
+}
+
+// SameCertificate returns whether the PEM-encoded certificates, or the first
+// certificates of the bundles, are the same
+func SameCertificate(a, b []byte) bool {
+	certsA, err := certutil.ParseCertsPEM(a)
+	if err != nil {
+		return false
+	}
+
+	certsB, err := certutil.ParseCertsPEM(b)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(certsA[0].Raw, certsB[0].Raw)
+}
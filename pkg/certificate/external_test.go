@@ -0,0 +1,259 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificate
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+
+	"k8s.io/client-go/util/keyutil"
+)
+
+type testCert struct {
+	cert    *x509.Certificate
+	key     crypto.Signer
+	certPEM []byte
+	keyPEM  []byte
+}
+
+func newTestCert(t *testing.T, name string, isCA bool, ecdsaKey bool, notAfter time.Time, parent *testCert) *testCert {
+	t.Helper()
+
+	var (
+		key crypto.Signer
+		err error
+	)
+	if ecdsaKey {
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	} else {
+		key, err = newPrivateKey()
+	}
+	if err != nil {
+		t.Fatalf("generating private key: %v", err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              notAfter,
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+	}
+	if isCA {
+		tmpl.KeyUsage |= x509.KeyUsageCertSign
+	}
+
+	parentCert, parentKey := tmpl, key
+	if parent != nil {
+		parentCert, parentKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parentCert, key.Public(), parentKey)
+	if err != nil {
+		t.Fatalf("creating certificate: %v", err)
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("parsing certificate: %v", err)
+	}
+
+	keyPEM, err := keyutil.MarshalPrivateKeyToPEM(key)
+	if err != nil {
+		t.Fatalf("encoding private key: %v", err)
+	}
+
+	return &testCert{cert: cert, key: key, certPEM: encodeCertPEM(cert), keyPEM: keyPEM}
+}
+
+func TestValidateCA(t *testing.T) {
+	validity := time.Now().Add(24 * time.Hour)
+
+	root := newTestCert(t, "root", true, false, validity, nil)
+	intermediate := newTestCert(t, "intermediate", true, false, validity, root)
+	otherRoot := newTestCert(t, "other-root", true, false, validity, nil)
+	ecdsaCA := newTestCert(t, "ecdsa", true, true, validity, nil)
+	leaf := newTestCert(t, "leaf", false, false, validity, root)
+	expired := newTestCert(t, "expired", true, false, time.Now().Add(-time.Minute), nil)
+
+	join := func(pems ...[]byte) []byte {
+		bundle := []byte{}
+		for _, p := range pems {
+			bundle = append(bundle, p...)
+		}
+
+		return bundle
+	}
+
+	tests := []struct {
+		name       string
+		certPEM    []byte
+		keyPEM     []byte
+		requireRSA bool
+		wantErr    bool
+	}{
+		{
+			name:    "root",
+			certPEM: root.certPEM,
+			keyPEM:  root.keyPEM,
+		},
+		{
+			name:       "intermediate with chain",
+			certPEM:    join(intermediate.certPEM, root.certPEM),
+			keyPEM:     intermediate.keyPEM,
+			requireRSA: true,
+		},
+		{
+			name:    "ecdsa",
+			certPEM: ecdsaCA.certPEM,
+			keyPEM:  ecdsaCA.keyPEM,
+		},
+		{
+			name:       "ecdsa when rsa is required",
+			certPEM:    ecdsaCA.certPEM,
+			keyPEM:     ecdsaCA.keyPEM,
+			requireRSA: true,
+			wantErr:    true,
+		},
+		{
+			name:    "not a certificate authority",
+			certPEM: leaf.certPEM,
+			keyPEM:  leaf.keyPEM,
+			wantErr: true,
+		},
+		{
+			name:    "expired",
+			certPEM: expired.certPEM,
+			keyPEM:  expired.keyPEM,
+			wantErr: true,
+		},
+		{
+			name:    "mismatched key",
+			certPEM: intermediate.certPEM,
+			keyPEM:  root.keyPEM,
+			wantErr: true,
+		},
+		{
+			name:    "broken chain",
+			certPEM: join(intermediate.certPEM, otherRoot.certPEM),
+			keyPEM:  intermediate.keyPEM,
+			wantErr: true,
+		},
+		{
+			name:    "no certificate",
+			certPEM: root.keyPEM,
+			keyPEM:  root.keyPEM,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			cert, key, err := ValidateCA(tt.certPEM, tt.keyPEM, tt.requireRSA, time.Now())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateCA() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			// only the certificate authority is installed, without its chain
+			if !SameCertificate(cert, tt.certPEM) || len(cert) > len(tt.certPEM) {
+				t.Errorf("ValidateCA() returned the certificate %q", cert)
+			}
+			if string(key) != string(tt.keyPEM) {
+				t.Errorf("ValidateCA() returned a different private key")
+			}
+		})
+	}
+}
+
+func TestLoadExternalCAsFromFiles(t *testing.T) {
+	ca := newTestCert(t, "kubernetes", true, false, time.Now().Add(24*time.Hour), nil)
+
+	dir := t.TempDir()
+	manifest := filepath.Join(dir, "kubeone.yaml")
+
+	writeFile := func(name string, data []byte, mode os.FileMode) {
+		if err := os.WriteFile(filepath.Join(dir, name), data, mode); err != nil {
+			t.Fatalf("writing %s: %v", name, err)
+		}
+	}
+	writeFile("ca.crt", ca.certPEM, 0o644)
+	writeFile("ca.key", ca.keyPEM, 0o600)
+	writeFile("public.key", ca.keyPEM, 0o644)
+
+	tests := []struct {
+		name    string
+		keyFile string
+		wantErr bool
+	}{
+		{
+			name:    "private key file",
+			keyFile: "ca.key",
+		},
+		{
+			name:    "private key file accessible by others",
+			keyFile: "public.key",
+			wantErr: true,
+		},
+		{
+			name:    "missing private key file",
+			keyFile: "missing.key",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			config := &kubeoneapi.CertificateAuthorityConfig{
+				Kubernetes: &kubeoneapi.CertificateAuthoritySource{
+					CertificateFile: "ca.crt",
+					KeyFile:         tt.keyFile,
+				},
+			}
+
+			cas, err := LoadExternalCAs(context.Background(), config, manifest)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadExternalCAs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if len(cas) != 1 || cas[0].CertPath != KubernetesCACertPath || !SameCertificate(cas[0].Cert, ca.certPEM) {
+				t.Errorf("LoadExternalCAs() = %+v", cas)
+			}
+		})
+	}
+}
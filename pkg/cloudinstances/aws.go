@@ -0,0 +1,160 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudinstances
+
+import (
+	"context"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscredentials "github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/credentials"
+	"k8c.io/kubeone/pkg/fail"
+)
+
+type awsInstances struct {
+	client *ec2.EC2
+}
+
+func newAWS(cluster *kubeoneapi.KubeOneCluster, credentialsFilePath string) (Instances, error) {
+	creds, err := credentials.ProviderCredentials(cluster.CloudProvider, credentialsFilePath, credentials.TypeUniversal)
+	if err != nil {
+		return nil, err
+	}
+
+	region := os.Getenv("AWS_REGION")
+	if region == "" {
+		region = os.Getenv("AWS_DEFAULT_REGION")
+	}
+	if region == "" {
+		return nil, fail.CredentialsError{
+			Op:       "lookup",
+			Provider: "AWS",
+			Err:      errors.New("the region of the instances must be set in the AWS_REGION variable"),
+		}
+	}
+
+	sess, err := session.NewSession(&aws.Config{
+		Region:      aws.String(region),
+		Credentials: awscredentials.NewStaticCredentials(creds[credentials.AWSAccessKeyID], creds[credentials.AWSSecretAccessKey], ""),
+	})
+	if err != nil {
+		return nil, fail.Runtime(err, "creating AWS session")
+	}
+
+	return &awsInstances{client: ec2.New(sess)}, nil
+}
+
+func (a *awsInstances) Stop(ctx context.Context, hosts []kubeoneapi.HostConfig) error {
+	instances, err := a.instances(ctx, hosts)
+	if err != nil {
+		return err
+	}
+
+	ids := instanceIDs(instances)
+	if _, err = a.client.StopInstancesWithContext(ctx, &ec2.StopInstancesInput{InstanceIds: ids}); err != nil {
+		return fail.Runtime(err, "stopping AWS instances")
+	}
+
+	err = a.client.WaitUntilInstanceStoppedWithContext(ctx, &ec2.DescribeInstancesInput{InstanceIds: ids})
+
+	return fail.Runtime(err, "waiting for AWS instances to stop")
+}
+
+func (a *awsInstances) Start(ctx context.Context, hosts []kubeoneapi.HostConfig) (map[string]string, error) {
+	instances, err := a.instances(ctx, hosts)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := instanceIDs(instances)
+	if _, err = a.client.StartInstancesWithContext(ctx, &ec2.StartInstancesInput{InstanceIds: ids}); err != nil {
+		return nil, fail.Runtime(err, "starting AWS instances")
+	}
+
+	if err = a.client.WaitUntilInstanceRunningWithContext(ctx, &ec2.DescribeInstancesInput{InstanceIds: ids}); err != nil {
+		return nil, fail.Runtime(err, "waiting for AWS instances to start")
+	}
+
+	// the public addresses are assigned when the instances are started
+	instances, err = a.instances(ctx, hosts)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := map[string]string{}
+	for _, instance := range instances {
+		addresses[aws.StringValue(instance.PrivateIpAddress)] = aws.StringValue(instance.PublicIpAddress)
+	}
+
+	return addresses, nil
+}
+
+// instances returns the instances of the hosts, failing if an instance of
+// any host is not found
+func (a *awsInstances) instances(ctx context.Context, hosts []kubeoneapi.HostConfig) ([]*ec2.Instance, error) {
+	addresses := make([]*string, 0, len(hosts))
+	for _, host := range hosts {
+		addresses = append(addresses, aws.String(host.PrivateAddress))
+	}
+
+	input := &ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{
+			{Name: aws.String("private-ip-address"), Values: addresses},
+			{Name: aws.String("instance-state-name"), Values: aws.StringSlice([]string{"pending", "running", "stopping", "stopped"})},
+		},
+	}
+
+	found := map[string]*ec2.Instance{}
+	err := a.client.DescribeInstancesPagesWithContext(ctx, input, func(page *ec2.DescribeInstancesOutput, _ bool) bool {
+		for _, reservation := range page.Reservations {
+			for _, instance := range reservation.Instances {
+				found[aws.StringValue(instance.PrivateIpAddress)] = instance
+			}
+		}
+
+		return true
+	})
+	if err != nil {
+		return nil, fail.Runtime(err, "getting AWS instances")
+	}
+
+	instances := make([]*ec2.Instance, 0, len(hosts))
+	for _, host := range hosts {
+		instance, ok := found[host.PrivateAddress]
+		if !ok {
+			return nil, fail.Runtime(errors.Errorf("instance with the private address %q not found", host.PrivateAddress), "getting AWS instances")
+		}
+		instances = append(instances, instance)
+	}
+
+	return instances, nil
+}
+
+func instanceIDs(instances []*ec2.Instance) []*string {
+	ids := make([]*string, 0, len(instances))
+	for _, instance := range instances {
+		ids = append(ids, instance.InstanceId)
+	}
+
+	return ids
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cloudinstances stops and starts the instances of the control plane
+// and static worker nodes through the API of the cloud provider, which is
+// used to hibernate the cluster.
+package cloudinstances
+
+import (
+	"context"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/fail"
+)
+
+// Instances stops and starts the instances of the hosts. The instances are
+// looked up by the private addresses of the hosts, which are kept by the
+// stopped instances.
+type Instances interface {
+	// Stop stops the instances of the hosts and waits until they're stopped
+	Stop(ctx context.Context, hosts []kubeoneapi.HostConfig) error
+
+	// Start starts the instances of the hosts and waits until they're
+	// running. The public addresses of the started instances, which can
+	// change when an instance is stopped, are returned by the private
+	// addresses of the hosts.
+	Start(ctx context.Context, hosts []kubeoneapi.HostConfig) (map[string]string, error)
+}
+
+// New returns Instances for the cloud provider of the cluster
+func New(cluster *kubeoneapi.KubeOneCluster, credentialsFilePath string) (Instances, error) {
+	if cluster.CloudProvider.AWS != nil {
+		return newAWS(cluster, credentialsFilePath)
+	}
+
+	return nil, fail.NewConfigError("hibernation", "stopping the instances is not supported for the %q cloud provider", cluster.CloudProvider.CloudProviderName())
+}
@@ -21,6 +21,7 @@ import (
 	"crypto/tls"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 
 	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
@@ -30,7 +31,8 @@ import (
 )
 
 const (
-	healthzEndpoint = "https://%s:6443/healthz"
+	healthzEndpoint = "https://%s/healthz"
+	apiserverPort   = "6443"
 )
 
 type Report struct {
@@ -47,7 +49,7 @@ func Get(s *state.State, node kubeoneapi.HostConfig) (*Report, error) {
 		}, err
 	}
 
-	health, err := apiserverHealth(s.Context, roundTripper, node.PrivateAddress)
+	health, err := apiserverHealth(s.Context, roundTripper, s.Cluster.ClusterNetwork.NodeIP(node))
 	if err != nil {
 		return &Report{
 			Health: false,
@@ -59,7 +61,7 @@ func Get(s *state.State, node kubeoneapi.HostConfig) (*Report, error) {
 
 // apiserverHealth checks is API server healthy
 func apiserverHealth(ctx context.Context, t http.RoundTripper, nodeAddress string) (bool, error) {
-	endpoint := fmt.Sprintf(healthzEndpoint, nodeAddress)
+	endpoint := fmt.Sprintf(healthzEndpoint, net.JoinHostPort(nodeAddress, apiserverPort))
 	request, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return false, fail.Runtime(err, "apiserver status request")
@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"strconv"
 	"strings"
@@ -35,8 +36,8 @@ import (
 )
 
 const (
-	healthEndpointFmt = "https://%s:2379/health"
-	clientEndpointFmt = "%s:2379"
+	healthEndpointFmt = "https://%s/health"
+	etcdClientPort    = "2379"
 )
 
 // Report describes status of the etcd cluster
@@ -50,7 +51,7 @@ func MemberList(s *state.State) (*clientv3.MemberListResponse, error) {
 	if err != nil {
 		return nil, err
 	}
-	etcdEndpoints := []string{fmt.Sprintf(clientEndpointFmt, leader.PrivateAddress)}
+	etcdEndpoints := []string{net.JoinHostPort(s.Cluster.ClusterNetwork.NodeIP(leader), etcdClientPort)}
 
 	etcdcfg, err := etcdutil.NewClientConfig(s, leader)
 	if err != nil {
@@ -90,7 +91,7 @@ func Get(s *state.State, node kubeoneapi.HostConfig, etcdRing *clientv3.MemberLi
 	}
 
 	// Check etcd member health
-	health, err := memberHealth(s.Context, roundTripper, node.PrivateAddress)
+	health, err := memberHealth(s.Context, roundTripper, s.Cluster.ClusterNetwork.NodeIP(node))
 	if err != nil {
 		return nil, err
 	}
@@ -112,7 +113,7 @@ func Get(s *state.State, node kubeoneapi.HostConfig, etcdRing *clientv3.MemberLi
 
 // memberHealth returns health for a requested etcd member
 func memberHealth(ctx context.Context, t http.RoundTripper, nodeAddress string) (bool, error) {
-	endpoint := fmt.Sprintf(healthEndpointFmt, nodeAddress)
+	endpoint := fmt.Sprintf(healthEndpointFmt, net.JoinHostPort(nodeAddress, etcdClientPort))
 
 	request, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
 	if err != nil {
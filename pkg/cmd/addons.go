@@ -17,10 +17,14 @@ limitations under the License.
 package cmd
 
 import (
+	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
 	"k8c.io/kubeone/pkg/addons"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/state"
+	"k8c.io/kubeone/pkg/tasks"
 )
 
 func addonsCmd(rootFlags *pflag.FlagSet) *cobra.Command {
@@ -31,6 +35,7 @@ func addonsCmd(rootFlags *pflag.FlagSet) *cobra.Command {
 
 	cmd.AddCommand(
 		addonsListCmd(rootFlags),
+		addonsDiffCmd(rootFlags),
 	)
 
 	return cmd
@@ -73,3 +78,51 @@ func addonsListCmd(rootFlags *pflag.FlagSet) *cobra.Command {
 
 	return cmd
 }
+
+func addonsDiffCmd(rootFlags *pflag.FlagSet) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Show the changes applying the addons would make",
+		Long: heredoc.Doc(`
+			Render the addons deployed by KubeOne and the user addons, and show the changes applying them would make
+			to the cluster, as reported by 'kubectl diff' on the leader control plane node. The objects of the deleted
+			addons and the objects that would be pruned are listed as well. The cluster is not changed.
+
+			The webhook certificates are generated on each apply, so the Secrets with the certificates are always
+			shown as changed.
+		`),
+		SilenceErrors: true,
+		Example:       `kubeone -m mycluster.yaml -t terraformoutput.json addons diff`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gopts, err := persistentGlobalOptions(rootFlags)
+			if err != nil {
+				return err
+			}
+
+			s, err := gopts.BuildState()
+			if err != nil {
+				return err
+			}
+
+			return runAddonsDiff(s)
+		},
+	}
+
+	return cmd
+}
+
+func runAddonsDiff(s *state.State) error {
+	// Probe the cluster for the actual state
+	probbing := tasks.WithHostnameOS(nil)
+	probbing = tasks.WithProbes(probbing)
+
+	if err := probbing.Run(s); err != nil {
+		return err
+	}
+
+	if !s.LiveCluster.IsProvisioned() {
+		return fail.NewRuntimeError("diffing addons", "the target cluster is not provisioned")
+	}
+
+	return tasks.WithAddonsDiff(nil).Run(s)
+}
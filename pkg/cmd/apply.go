@@ -19,6 +19,7 @@ package cmd
 import (
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/Masterminds/semver/v3"
@@ -27,6 +28,7 @@ import (
 	"github.com/spf13/pflag"
 
 	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/progress"
 	"k8c.io/kubeone/pkg/state"
 	"k8c.io/kubeone/pkg/tasks"
 
@@ -37,6 +39,7 @@ import (
 type applyOpts struct {
 	globalOptions
 	AutoApprove bool `longflag:"auto-approve" shortflag:"y"`
+	DryRun      bool `longflag:"dry-run"`
 	// Install flags
 	BackupFile   string `longflag:"backup" shortflag:"b"`
 	NoInit       bool   `longflag:"no-init"`
@@ -46,6 +49,8 @@ type applyOpts struct {
 	UpgradeMachineDeployments bool `longflag:"upgrade-machine-deployments"`
 	CreateMachineDeployments  bool `longflag:"create-machine-deployments"`
 	RotateEncryptionKey       bool `longflag:"rotate-encryption-key"`
+	IgnoreMaintenanceWindows  bool `longflag:"ignore-maintenance-windows"`
+	ReconcileDrift            bool `longflag:"reconcile-drift"`
 }
 
 func (opts *applyOpts) BuildState() (*state.State, error) {
@@ -59,6 +64,9 @@ func (opts *applyOpts) BuildState() (*state.State, error) {
 	s.ForceUpgrade = opts.ForceUpgrade
 	s.UpgradeMachineDeployments = opts.UpgradeMachineDeployments
 	s.CreateMachineDeployments = opts.CreateMachineDeployments
+	s.Confirm = func() (bool, error) {
+		return confirmCommand(opts.AutoApprove)
+	}
 
 	return s, initBackup(s.BackupFile)
 }
@@ -75,6 +83,10 @@ func applyCmd(rootFlags *pflag.FlagSet) *cobra.Command {
 
 			This command takes KubeOne manifest which contains information about hosts and how the cluster should be provisioned.
 			It's possible to source information about hosts from Terraform output, using the '--tfjson' flag.
+
+			The progress of the apply is published to the cluster: the status of the running or the last operation is
+			stored in the kube-system/kubeone-operation-status ConfigMap, and an Event involving that ConfigMap is
+			emitted for each task.
 		`),
 		SilenceErrors: true,
 		Example:       `kubeone apply -m mycluster.yaml -t terraformoutput.json`,
@@ -90,7 +102,7 @@ func applyCmd(rootFlags *pflag.FlagSet) *cobra.Command {
 				return err
 			}
 
-			return runApply(st, opts)
+			return progress.OperationFinished(st, runApply(st, opts))
 		},
 	}
 
@@ -101,6 +113,12 @@ func applyCmd(rootFlags *pflag.FlagSet) *cobra.Command {
 		false,
 		"auto approve plan")
 
+	cmd.Flags().BoolVar(
+		&opts.DryRun,
+		longFlagName(opts, "DryRun"),
+		false,
+		"only print the plan and the changes to the addons, without applying anything")
+
 	cmd.Flags().StringVarP(
 		&opts.BackupFile,
 		longFlagName(opts, "BackupFile"),
@@ -144,6 +162,18 @@ func applyCmd(rootFlags *pflag.FlagSet) *cobra.Command {
 		false,
 		"rotate Encryption Provider encryption key")
 
+	cmd.Flags().BoolVar(
+		&opts.IgnoreMaintenanceWindows,
+		longFlagName(opts, "IgnoreMaintenanceWindows"),
+		false,
+		"perform the disruptive actions even outside of the maintenance windows")
+
+	cmd.Flags().BoolVar(
+		&opts.ReconcileDrift,
+		longFlagName(opts, "ReconcileDrift"),
+		false,
+		"rewrite the files managed by KubeOne that were changed out of band, by re-running the upgrade at the current version")
+
 	return cmd
 }
 
@@ -302,6 +332,12 @@ func runApplyInstall(s *state.State, opts *applyOpts) error { // Print the expec
 	}
 
 	fmt.Println()
+	if opts.DryRun {
+		s.Logger.Println("Dry run, no changes applied.")
+
+		return nil
+	}
+
 	confirm, err := confirmCommand(opts.AutoApprove)
 	if err != nil {
 		return err
@@ -313,11 +349,13 @@ func runApplyInstall(s *state.State, opts *applyOpts) error { // Print the expec
 		return nil
 	}
 
+	s.Operation = "apply"
+
 	if opts.NoInit {
 		return tasks.WithBinariesOnly(nil).Run(s)
 	}
 
-	return tasks.WithFullInstall(nil).Run(s)
+	return tasks.WithManagedFilesRecord(tasks.WithFullInstall(nil)).Run(s)
 }
 
 func runApplyUpgradeIfNeeded(s *state.State, opts *applyOpts) error {
@@ -334,10 +372,76 @@ func runApplyUpgradeIfNeeded(s *state.State, opts *applyOpts) error {
 	}
 
 	operations := []string{}
+	upgradeSteps := []string{}
+
+	driftHosts := tasks.ConfigurationDriftHosts(s)
+	for _, node := range driftHosts {
+		for _, change := range node.ConfigurationDrift {
+			fmt.Printf("\t! configuration drift on node %q (%s): %s\n", node.Config.Hostname, node.Config.PrivateAddress, change)
+		}
+	}
+
+	reconcileDrift := len(driftHosts) > 0 && opts.ReconcileDrift
+	if reconcileDrift && !upgradeNeeded {
+		// the upgrade at the current version rewrites the managed files
+		s.ForceUpgrade = true
+	} else if len(driftHosts) > 0 && !opts.ReconcileDrift {
+		fmt.Println("\t! the files changed out of band are rewritten by running apply with --reconcile-drift")
+	}
+
+	upgradeRequested := upgradeNeeded || opts.ForceUpgrade || reconcileDrift
+	containerdUpgradeHosts := tasks.ContainerdUpgradeHosts(s)
+	osUpgradeHosts := tasks.OperatingSystemUpgradeHosts(s)
+
+	machineDeploymentImageUpgrades, err := tasks.MachineDeploymentImageUpgrades(s)
+	if err != nil {
+		return err
+	}
 
 	var tasksToRun tasks.Tasks
 
-	if upgradeNeeded || opts.ForceUpgrade {
+	disruptive := upgradeRequested || len(containerdUpgradeHosts) > 0 || len(osUpgradeHosts) > 0 || len(machineDeploymentImageUpgrades) > 0
+	if disruptive && maintenanceWindowClosed(s, opts) {
+		deferred := []string{}
+		if upgradeRequested {
+			deferred = append(deferred, fmt.Sprintf("upgrade all nodes to %s", s.Cluster.Versions.Kubernetes))
+		}
+		for _, node := range containerdUpgradeHosts {
+			deferred = append(deferred, fmt.Sprintf("upgrade containerd on node %q (%s)", node.Config.Hostname, node.Config.PrivateAddress))
+		}
+		for _, node := range osUpgradeHosts {
+			deferred = append(deferred, fmt.Sprintf("upgrade Ubuntu on node %q (%s)", node.Config.Hostname, node.Config.PrivateAddress))
+		}
+		for _, name := range machineDeploymentImageUpgrades {
+			deferred = append(deferred, fmt.Sprintf("re-provision MachineDeployment %q", name))
+		}
+		printDeferred(s, deferred)
+
+		// the manifest describes the cluster at the new Kubernetes version,
+		// so the remaining changes are deferred together with the upgrade
+		if upgradeRequested {
+			return nil
+		}
+
+		containerdUpgradeHosts = nil
+		osUpgradeHosts = nil
+		machineDeploymentImageUpgrades = nil
+	}
+
+	if len(containerdUpgradeHosts) > 0 {
+		tasksToRun = tasks.WithContainerdUpgrade(tasksToRun)
+	}
+
+	if upgradeRequested {
+		upgradeSteps, err = tasks.UpgradeSteps(s)
+		if err != nil {
+			return err
+		}
+
+		for _, version := range upgradeSteps[:len(upgradeSteps)-1] {
+			operations = append(operations, fmt.Sprintf("upgrade all nodes to the intermediate version %s", version))
+		}
+
 		// disable case, we do this as early as possible.
 		if s.ShouldDisableEncryption() {
 			tasksToRun = tasks.WithDisableEncryptionProviders(tasksToRun, s.LiveCluster.EncryptionConfiguration.Custom)
@@ -366,9 +470,15 @@ func runApplyUpgradeIfNeeded(s *state.State, opts *applyOpts) error {
 			}
 		}
 
+		if reconcileDrift {
+			for _, node := range driftHosts {
+				operations = append(operations, fmt.Sprintf("reconcile configuration drift on node %q (%s)", node.Config.Hostname, node.Config.PrivateAddress))
+			}
+		}
+
 		for _, node := range s.LiveCluster.ControlPlane {
 			forceFlag := ""
-			if opts.ForceUpgrade {
+			if s.ForceUpgrade {
 				forceFlag = "force "
 			}
 
@@ -383,7 +493,7 @@ func runApplyUpgradeIfNeeded(s *state.State, opts *applyOpts) error {
 
 		for _, node := range s.LiveCluster.StaticWorkers {
 			forceFlag := ""
-			if opts.ForceUpgrade {
+			if s.ForceUpgrade {
 				forceFlag = "force "
 			}
 			operations = append(operations,
@@ -395,7 +505,38 @@ func runApplyUpgradeIfNeeded(s *state.State, opts *applyOpts) error {
 					s.Cluster.Versions.Kubernetes))
 		}
 	} else {
-		tasksToRun = tasks.WithResources(nil)
+		tasksToRun = tasks.WithResources(tasks.WithTrustedCAs(tasksToRun))
+	}
+
+	for _, node := range containerdUpgradeHosts {
+		operations = append(operations,
+			fmt.Sprintf("upgrade containerd on node %q (%s): %s -> %s",
+				node.Config.Hostname,
+				node.Config.PrivateAddress,
+				node.ContainerRuntimeContainerd.Version,
+				s.Cluster.ContainerRuntime.ContainerdVersion()))
+	}
+
+	// the operating system is upgraded after Kubernetes, so the packages of
+	// the new Kubernetes version are kept during the release upgrade
+	if len(osUpgradeHosts) > 0 || len(machineDeploymentImageUpgrades) > 0 {
+		tasksToRun = tasks.WithOperatingSystemUpgrade(tasksToRun)
+	}
+
+	for _, node := range osUpgradeHosts {
+		operations = append(operations,
+			fmt.Sprintf("upgrade Ubuntu on node %q (%s): %s -> %s",
+				node.Config.Hostname,
+				node.Config.PrivateAddress,
+				node.OperatingSystemRelease,
+				s.Cluster.OperatingSystemUpgrade().UbuntuRelease))
+	}
+
+	for _, name := range machineDeploymentImageUpgrades {
+		operations = append(operations,
+			fmt.Sprintf("re-provision machines of MachineDeployment %q with image %q",
+				name,
+				s.Cluster.OperatingSystemUpgrade().MachineDeploymentImages[name]))
 	}
 
 	fmt.Println()
@@ -408,6 +549,17 @@ func runApplyUpgradeIfNeeded(s *state.State, opts *applyOpts) error {
 	}
 
 	fmt.Println()
+	if opts.DryRun {
+		// the addons are applied by every apply of the provisioned cluster
+		if err = tasks.WithAddonsDiff(nil).Run(s); err != nil {
+			return err
+		}
+
+		s.Logger.Println("Dry run, no changes applied.")
+
+		return nil
+	}
+
 	confirm, err := confirmCommand(opts.AutoApprove)
 	if err != nil {
 		return err
@@ -419,6 +571,49 @@ func runApplyUpgradeIfNeeded(s *state.State, opts *applyOpts) error {
 		return nil
 	}
 
+	s.Operation = "apply"
+
+	// the drifted files are not recorded, so that the drift is reported
+	// until it's reconciled
+	if len(driftHosts) == 0 || reconcileDrift {
+		tasksToRun = tasks.WithManagedFilesRecord(tasksToRun)
+	}
+
+	if len(upgradeSteps) > 1 {
+		err = runUpgradeSteps(s, upgradeSteps, tasksToRun)
+	} else {
+		err = tasksToRun.Run(s)
+	}
+
+	if err != nil && upgradeRequested {
+		s.Logger.Warnln("If the upgrade failed after the control plane upgrade started, the control plane can be " +
+			"rolled back to the backup taken before the upgrade by running 'kubeone rollback'.")
+	}
+
+	return err
+}
+
+// runUpgradeSteps upgrades the cluster through the intermediate versions, one
+// minor version at a time, before running the tasks upgrading it to the
+// target version. Each intermediate version is a checkpoint: if an upgrade
+// fails, the next apply continues from the last upgraded version.
+func runUpgradeSteps(s *state.State, steps []string, tasksToRun tasks.Tasks) error {
+	target := s.Cluster.Versions.Kubernetes
+
+	for _, version := range steps[:len(steps)-1] {
+		s.Logger.Infof("Upgrading the cluster to the intermediate version %s...", version)
+		s.Cluster.Versions.Kubernetes = version
+
+		if err := tasks.WithUpgrade(nil).Run(s); err != nil {
+			return err
+		}
+
+		s.Logger.Infof("Checkpoint: the cluster is upgraded to %s", version)
+	}
+
+	s.Logger.Infof("Upgrading the cluster to %s...", target)
+	s.Cluster.Versions.Kubernetes = target
+
 	return tasksToRun.Run(s)
 }
 
@@ -436,6 +631,13 @@ func runApplyRotateKey(s *state.State, opts *applyOpts) error {
 
 	fmt.Println("The following actions will be taken: ")
 	fmt.Println("Run with --verbose flag for more information.")
+
+	if maintenanceWindowClosed(s, opts) {
+		printDeferred(s, []string{"rotate Encryption Provider encryption key"})
+
+		return nil
+	}
+
 	tasksToRun := tasks.WithRotateKey(nil)
 
 	for _, op := range tasksToRun.Descriptions(s) {
@@ -443,6 +645,12 @@ func runApplyRotateKey(s *state.State, opts *applyOpts) error {
 	}
 
 	fmt.Println()
+	if opts.DryRun {
+		s.Logger.Println("Dry run, no changes applied.")
+
+		return nil
+	}
+
 	confirm, err := confirmCommand(opts.AutoApprove)
 	if err != nil {
 		return err
@@ -454,9 +662,27 @@ func runApplyRotateKey(s *state.State, opts *applyOpts) error {
 		return nil
 	}
 
+	s.Operation = "apply"
+
 	return tasksToRun.Run(s)
 }
 
+// maintenanceWindowClosed returns true if the disruptive actions have to be
+// deferred until the next maintenance window
+func maintenanceWindowClosed(s *state.State, opts *applyOpts) bool {
+	return !opts.IgnoreMaintenanceWindows && !s.Cluster.InMaintenanceWindow(time.Now())
+}
+
+func printDeferred(s *state.State, deferred []string) {
+	next := s.Cluster.NextMaintenanceWindow(time.Now())
+
+	for _, op := range deferred {
+		fmt.Printf("\t! deferred until the next maintenance window at %s: %s\n", next.Format(time.RFC1123), op)
+	}
+
+	s.Logger.Warnln("Disruptive actions are deferred outside of the maintenance windows, use --ignore-maintenance-windows to perform them now.")
+}
+
 func printHostInformation(host state.Host) {
 	containerdCR := host.ContainerRuntimeContainerd
 	dockerCR := host.ContainerRuntimeDocker
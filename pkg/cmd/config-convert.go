@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"k8c.io/kubeone/pkg/apis/kubeone/config"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/templates/clusterapi"
+)
+
+const convertFormatCAPI = "capi"
+
+type configConvertOpts struct {
+	globalOptions
+	To        string `longflag:"to"`
+	Namespace string `longflag:"namespace" shortflag:"n"`
+}
+
+func configConvertCmd(rootFlags *pflag.FlagSet) *cobra.Command {
+	opts := &configConvertOpts{}
+
+	cmd := &cobra.Command{
+		Use:   "convert",
+		Short: "Convert the KubeOneCluster manifest to other formats",
+		Long: heredoc.Doc(`
+			Convert the KubeOneCluster manifest, merged with the Terraform output, to other formats.
+			The converted manifest is printed on the standard output.
+
+			The following formats are supported:
+			  * capi - Cluster API Cluster, KubeadmControlPlane, MachineDeployment and KubeadmConfigTemplate objects.
+			    The infrastructure provider objects (e.g. AWSCluster and AWSMachineTemplate) are referenced by
+			    the cluster and MachineDeployment names, but have to be created separately. Static workers
+			    are not converted.
+		`),
+		Example:       `kubeone config convert --to capi -m kubeone.yaml -t tf.json`,
+		SilenceErrors: true,
+		RunE: func(*cobra.Command, []string) error {
+			gopts, err := persistentGlobalOptions(rootFlags)
+			if err != nil {
+				return err
+			}
+
+			opts.globalOptions = *gopts
+
+			return runConvert(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(
+		&opts.To,
+		longFlagName(opts, "To"),
+		convertFormatCAPI,
+		"format to convert the manifest to, one of the [capi]")
+
+	cmd.Flags().StringVarP(
+		&opts.Namespace,
+		longFlagName(opts, "Namespace"),
+		shortFlagName(opts, "Namespace"),
+		"default",
+		"namespace of the converted objects")
+
+	return cmd
+}
+
+func runConvert(opts *configConvertOpts) error {
+	if opts.To != convertFormatCAPI {
+		return fail.NewConfigError("checking to flag", "--to can be only one of [%s]", convertFormatCAPI)
+	}
+
+	logger := newLogger(opts.Verbose, opts.LogFormat)
+
+	cluster, err := config.LoadKubeOneCluster(opts.ManifestFile, opts.ValuesFiles, opts.TerraformState, opts.CredentialsFile, logger)
+	if err != nil {
+		return err
+	}
+
+	if len(cluster.StaticWorkers.Hosts) > 0 {
+		logger.Warnf("Static workers can't be converted to Cluster API and are skipped")
+	}
+
+	manifest, err := clusterapi.Manifest(cluster, opts.Namespace)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(manifest)
+
+	return nil
+}
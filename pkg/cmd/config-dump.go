@@ -18,7 +18,6 @@ package cmd
 
 import (
 	"fmt"
-	"os"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -28,6 +27,7 @@ import (
 	kubeonescheme "k8c.io/kubeone/pkg/apis/kubeone/scheme"
 	kubeonev1beta1 "k8c.io/kubeone/pkg/apis/kubeone/v1beta1"
 	kubeonev1beta2 "k8c.io/kubeone/pkg/apis/kubeone/v1beta2"
+	kubeonev1beta3 "k8c.io/kubeone/pkg/apis/kubeone/v1beta3"
 	"k8c.io/kubeone/pkg/fail"
 	"k8c.io/kubeone/pkg/templates"
 
@@ -70,9 +70,9 @@ func dumpConfig(opts *configDumpOpts) error {
 	// NB: We can't always convert to the latest API version because we might
 	// lose information (e.g. the AssetConfiguration API has been removed in
 	// the v1beta2 API).
-	manifest, err := os.ReadFile(opts.ManifestFile)
+	manifest, err := config.ReadManifest(opts.ManifestFile, opts.ValuesFiles)
 	if err != nil {
-		return fail.Runtime(err, "reading KubeOneCluster manifest file")
+		return err
 	}
 
 	typeMeta := runtime.TypeMeta{}
@@ -84,7 +84,7 @@ func dumpConfig(opts *configDumpOpts) error {
 	// This merges the provided manifest with the Terraform output, defaults
 	// the merged manifest, converts it to the internal representations, and
 	// then validates it.
-	cluster, err := config.LoadKubeOneCluster(opts.ManifestFile, opts.TerraformState, opts.CredentialsFile, logger)
+	cluster, err := config.LoadKubeOneCluster(opts.ManifestFile, opts.ValuesFiles, opts.TerraformState, opts.CredentialsFile, logger)
 	if err != nil {
 		return err
 	}
@@ -107,6 +107,13 @@ func dumpConfig(opts *configDumpOpts) error {
 			return fail.Config(cErr, fmt.Sprintf("converting %s to internal object", versionedCluster.GroupVersionKind()))
 		}
 
+		objs = append(objs, versionedCluster)
+	case kubeonev1beta3.SchemeGroupVersion.String():
+		versionedCluster := kubeonev1beta3.NewKubeOneCluster()
+		if cErr := kubeonescheme.Scheme.Convert(cluster, versionedCluster, nil); cErr != nil {
+			return fail.Config(cErr, fmt.Sprintf("converting %s to internal object", versionedCluster.GroupVersionKind()))
+		}
+
 		objs = append(objs, versionedCluster)
 	default:
 		return fail.ConfigError{
@@ -25,6 +25,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
+	"k8c.io/kubeone/pkg/apis/kubeone/config"
 	kubeonev1beta1 "k8c.io/kubeone/pkg/apis/kubeone/v1beta1"
 	"k8c.io/kubeone/pkg/fail"
 	"k8c.io/kubeone/pkg/templates/images"
@@ -33,9 +34,10 @@ import (
 )
 
 type listImagesOpts struct {
-	ManifestFile      string `longflag:"manifest" shortflag:"m"`
-	Filter            string `longflag:"filter"`
-	KubernetesVersion string `longflag:"kubernetes-version" shortflag:"k"`
+	ManifestFile      string   `longflag:"manifest" shortflag:"m"`
+	ValuesFiles       []string `longflag:"values"`
+	Filter            string   `longflag:"filter"`
+	KubernetesVersion string   `longflag:"kubernetes-version" shortflag:"k"`
 }
 
 func configImagesCmd(rootFlags *pflag.FlagSet) *cobra.Command {
@@ -78,6 +80,12 @@ func listImagesCmd(rootFlags *pflag.FlagSet) *cobra.Command {
 			}
 			opts.ManifestFile = manifestFile
 
+			valuesFiles, err := rootFlags.GetStringArray(longFlagName(opts, "ValuesFiles"))
+			if err != nil {
+				return fail.Runtime(err, "getting ValuesFiles flag")
+			}
+			opts.ValuesFiles = valuesFiles
+
 			return listImages(opts)
 		},
 	}
@@ -116,8 +124,13 @@ func listImages(opts *listImagesOpts) error {
 	var resolveropts []images.Opt
 
 	// FOR FUTURE READER: we only attempt to read the ManifestFile, but if it's not there, we don't care.
-	configBuf, configErr := os.ReadFile(opts.ManifestFile)
+	_, configErr := os.Stat(opts.ManifestFile)
 	if configErr == nil {
+		configBuf, err := config.ReadManifest(opts.ManifestFile, opts.ValuesFiles)
+		if err != nil {
+			return err
+		}
+
 		// Custom loading of the config is needed to avoid "normal" validation process, but we here don't care about
 		// validity of the config, the only part that's needed is `.RegistryConfiguration`
 		var conf kubeonev1beta1.KubeOneCluster
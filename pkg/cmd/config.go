@@ -31,7 +31,7 @@ import (
 	yaml "gopkg.in/yaml.v2"
 
 	"k8c.io/kubeone/pkg/apis/kubeone/config"
-	kubeonev1beta2 "k8c.io/kubeone/pkg/apis/kubeone/v1beta2"
+	kubeonev1beta3 "k8c.io/kubeone/pkg/apis/kubeone/v1beta3"
 	"k8c.io/kubeone/pkg/containerruntime"
 	"k8c.io/kubeone/pkg/fail"
 	"k8c.io/kubeone/pkg/templates/machinecontroller"
@@ -71,7 +71,6 @@ type printOpts struct {
 	NoProxy    string `longflag:"proxy-no-proxy"`
 
 	EnablePodNodeSelector     bool `longflag:"enable-pod-node-selector"`
-	EnablePodSecurityPolicy   bool `longflag:"enable-pod-security-policy"`
 	EnableStaticAuditLog      bool `longflag:"enable-static-audit-log"`
 	EnableDynamicAuditLog     bool `longflag:"enable-dynamic-audit-log"`
 	EnableMetricsServer       bool `longflag:"enable-metrics-server"`
@@ -93,6 +92,7 @@ func configCmd(rootFlags *pflag.FlagSet) *cobra.Command {
 
 	cmd.AddCommand(configPrintCmd())
 	cmd.AddCommand(configDumpCmd(rootFlags))
+	cmd.AddCommand(configConvertCmd(rootFlags))
 	cmd.AddCommand(configMigrateCmd(rootFlags))
 	cmd.AddCommand(configMachinedeploymentsCmd(rootFlags))
 	cmd.AddCommand(configImagesCmd(rootFlags))
@@ -169,7 +169,6 @@ func configPrintCmd() *cobra.Command {
 
 	// Features
 	cmd.Flags().BoolVar(&opts.EnablePodNodeSelector, longFlagName(opts, "EnablePodNodeSelector"), false, "enable PodNodeSelector admission plugin")
-	cmd.Flags().BoolVar(&opts.EnablePodSecurityPolicy, longFlagName(opts, "EnablePodSecurityPolicy"), false, "enable PodSecurityPolicy")
 	cmd.Flags().BoolVar(&opts.EnableStaticAuditLog, longFlagName(opts, "EnableStaticAuditLog"), false, "enable StaticAuditLog")
 	cmd.Flags().BoolVar(&opts.EnableDynamicAuditLog, longFlagName(opts, "EnableDynamicAuditLog"), false, "enable DynamicAuditLog")
 	cmd.Flags().BoolVar(&opts.EnableMetricsServer, longFlagName(opts, "EnableMetricsServer"), true, "enable metrics-server")
@@ -199,9 +198,9 @@ func configPrintCmd() *cobra.Command {
 func configMigrateCmd(rootFlags *pflag.FlagSet) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "migrate",
-		Short: "Migrate the v1alpha1 KubeOneCluster manifest to the v1beta1 version",
+		Short: "Migrate the v1beta1 or v1beta2 KubeOneCluster manifest to the v1beta3 version",
 		Long: `
-Migrate the v1beta1 KubeOneCluster manifest to the v1beta2 version.
+Migrate the v1beta1 or v1beta2 KubeOneCluster manifest to the v1beta3 version.
 The v1beta1 version of the KubeOneCluster manifest is deprecated and will be
 removed in one of the next versions.
 The new manifest is printed on the standard output.
@@ -275,7 +274,7 @@ func runPrint(printOptions *printOpts) error {
 			return fail.Runtime(err, "executing example-manifest template")
 		}
 
-		cfg := kubeonev1beta2.NewKubeOneCluster()
+		cfg := kubeonev1beta3.NewKubeOneCluster()
 		err = kyaml.UnmarshalStrict(buffer.Bytes(), &cfg)
 		if err != nil {
 			return fail.Runtime(err, "testing marshal/unmarshal")
@@ -293,7 +292,7 @@ func createAndPrintManifest(printOptions *printOpts) error {
 	cfg := &yamled.Document{}
 
 	// API data
-	cfg.Set(yamled.Path{"apiVersion"}, "kubeone.k8c.io/v1beta2")
+	cfg.Set(yamled.Path{"apiVersion"}, kubeonev1beta3.SchemeGroupVersion.String())
 	cfg.Set(yamled.Path{"kind"}, "KubeOneCluster")
 
 	// Cluster name
@@ -393,17 +392,14 @@ func createAndPrintManifest(printOptions *printOpts) error {
 	cfg.Set(yamled.Path{"loggingConfig", "containerLogMaxFiles"}, printOptions.ContainerLogMaxFiles)
 
 	// Print the manifest
-	return validateAndPrintConfig(cfg)
+	return validateAndPrintConfig(cfg, kubeonev1beta3.NewKubeOneCluster())
 }
 
 func printFeatures(cfg *yamled.Document, printOptions *printOpts) {
 	if printOptions.EnablePodNodeSelector {
-		cfg.Set(yamled.Path{"features", "podNodeSelector", "enable"}, printOptions.EnablePodSecurityPolicy)
+		cfg.Set(yamled.Path{"features", "podNodeSelector", "enable"}, printOptions.EnablePodNodeSelector)
 		cfg.Set(yamled.Path{"features", "podNodeSelector", "config", "configFilePath"}, "")
 	}
-	if printOptions.EnablePodSecurityPolicy {
-		cfg.Set(yamled.Path{"features", "podSecurityPolicy", "enable"}, printOptions.EnablePodSecurityPolicy)
-	}
 	if printOptions.EnableDynamicAuditLog {
 		cfg.Set(yamled.Path{"features", "dynamicAuditLog", "enable"}, printOptions.EnableDynamicAuditLog)
 	}
@@ -464,12 +460,12 @@ func parseControlPlaneHosts(cfg *yamled.Document, hostList string) error {
 // runMigrate migrates the KubeOneCluster manifest from v1alpha1 to v1beta1
 func runMigrate(opts *globalOptions) error {
 	// Convert old config yaml to new config yaml
-	newConfigYAML, err := config.MigrateOldConfig(opts.ManifestFile)
+	newConfigYAML, err := config.MigrateOldConfig(opts.ManifestFile, opts.ValuesFiles)
 	if err != nil {
 		return err
 	}
 
-	return validateAndPrintConfig(newConfigYAML)
+	return validateAndPrintConfig(newConfigYAML, kubeonev1beta3.NewKubeOneCluster())
 }
 
 // runGenerateMachineDeployments generates the MachineDeployments manifest
@@ -489,8 +485,8 @@ func runGenerateMachineDeployments(opts *globalOptions) error {
 	return nil
 }
 
-func validateAndPrintConfig(cfgYaml interface{}) error {
-	// Validate new config by unmarshaling
+func validateAndPrintConfig(cfgYaml interface{}, versionedCluster interface{}) error {
+	// Validate new config by unmarshaling into the versioned KubeOneCluster object
 	var buffer bytes.Buffer
 
 	err := yaml.NewEncoder(&buffer).Encode(cfgYaml)
@@ -498,8 +494,7 @@ func validateAndPrintConfig(cfgYaml interface{}) error {
 		return fail.Runtime(err, "marshalling new config as YAML")
 	}
 
-	cfg := kubeonev1beta2.NewKubeOneCluster()
-	err = kyaml.UnmarshalStrict(buffer.Bytes(), &cfg)
+	err = kyaml.UnmarshalStrict(buffer.Bytes(), versionedCluster)
 	if err != nil {
 		return fail.Runtime(err, "testing marshal/unmarshal")
 	}
@@ -514,7 +509,7 @@ func validateAndPrintConfig(cfgYaml interface{}) error {
 }
 
 const exampleManifest = `
-apiVersion: kubeone.k8c.io/v1beta2
+apiVersion: kubeone.k8c.io/v1beta3
 kind: KubeOneCluster
 name: {{ .ClusterName }}
 
@@ -530,6 +525,46 @@ clusterNetwork:
   serviceDomainName: "{{ .ServiceDNS }}"
   # a nodePort range to reserve for services (default: 30000-32767)
   nodePortRange: "{{ .NodePortRange }}"
+  # the mask size of the pod CIDRs allocated to the nodes from the pod subnet
+  # (default: 24 for IPv4, 64 for IPv6). Each node needs at least maxPods + 2
+  # IP addresses, e.g. 26 is enough for maxPods up to 62.
+  # nodeCIDRMaskSizeIPv4: 24
+  # nodeCIDRMaskSizeIPv6: 64
+  # allocateNodeCIDRs can be disabled if the CNI plugin allocates the pod IP
+  # addresses on its own, e.g. Calico (default: true)
+  # allocateNodeCIDRs: true
+  # egressGateways route the traffic of the selected pods leaving the cluster
+  # through the gateway nodes (requires Cilium with kubeProxyReplacement: strict)
+  # egressGateways:
+  # - name: egress
+  #   namespace: default
+  #   podSelector:
+  #     app: example
+  #   destinationCIDRs: ["0.0.0.0/0"]
+  #   nodeSelector:
+  #     kubernetes.io/hostname: worker-0
+  #   # egressIP is assigned to the static host matched by the nodeSelector
+  #   egressIP: 10.0.0.100
+  # dns configures the upstream DNS servers and the search domains used by
+  # CoreDNS and kubelet instead of the ones configured on the hosts
+  # dns:
+  #   upstreamServers: ["1.1.1.1", "8.8.8.8"]
+  #   searchDomains: ["example.com"]
+  # bgp configures the BGP peering of the CNI plugin (Calico with the IPIP or
+  # None encapsulation mode, or Cilium) with the routers of the node network
+  # bgp:
+  #   asn: 64512
+  #   peers:
+  #   - peerAddress: 192.168.1.1
+  #     peerASN: 65000
+  #     # nodeSelector is supported only with Calico
+  #     nodeSelector:
+  #       rack: a
+  #   # routeReflectors are supported only with Calico
+  #   routeReflectors:
+  #     nodeSelector:
+  #       node-role.kubernetes.io/control-plane: ""
+  #   advertisedCIDRs: ["192.168.20.0/24"]
   # kube-proxy configurations
   kubeProxy:
     # skipInstallation will skip the installation of kube-proxy
@@ -549,15 +584,31 @@ clusterNetwork:
       tcpFinTimeout: "0"
       udpTimeout: "0"
       excludeCIDRs: []
+      # syncPeriod and minSyncPeriod control how often IPVS rules are
+      # refreshed, "0" uses kube-proxy defaults
+      syncPeriod: "0"
+      minSyncPeriod: "0"
     # if mode is by default
-    iptables: {}
-  # CNI plugin of choice. CNI can not be changed later at upgrade time.
+    iptables:
+      masqueradeAll: false
+      syncPeriod: "0"
+      minSyncPeriod: "0"
+    # if this set, kube-proxy mode will be set to nftables. Requires
+    # Kubernetes 1.29 or newer and cilium or external CNI.
+    # nftables:
+    #   masqueradeAll: false
+    #   syncPeriod: "0"
+    #   minSyncPeriod: "0"
+    # Changes to the kube-proxy configuration are rolled out on
+    # "kubeone apply" by restarting kube-proxy pods one node at a time.
+  # CNI plugin of choice. CNI can be changed only by running "kubeone migrate cni".
   cni:
     # Only one CNI plugin can be defined at the same time
     # Supported CNI plugins:
     # * canal
     # * weave-net
     # * cilium
+    # * calico
     # * external - The CNI plugin can be installed as an addon or manually
     canal:
       # MTU represents the maximum transmission unit.
@@ -573,26 +624,142 @@ clusterNetwork:
     #   enableHubble: true
     #   # kubeProxyReplacement defines weather cilium relies on underlying Kernel support to replace kube-proxy functionality by eBPF (strict),
     #   # or disables a subset of those features so cilium does not bail out if the kernel support is missing (disabled).
+    #   # kube-proxy is not installed if set to "strict".
     #   kubeProxyReplacement: "disabled"
+    #   # disableHubbleUI deploys only the Hubble relay if enableHubble is set
+    #   disableHubbleUI: false
+    #   # routingMode can be "tunnel" (default) or "native"
+    #   routingMode: "tunnel"
+    #   # tunnelProtocol used with the "tunnel" routing mode, can be "vxlan" (default) or "geneve"
+    #   tunnelProtocol: "vxlan"
+    #   # autoDirectNodeRoutes installs the routes to the pod CIDRs of other nodes, requires a shared L2 network
+    #   autoDirectNodeRoutes: false
+    #   # ipam can be "cluster-pool" (default, pod CIDRs of the nodes are allocated by the Cilium operator)
+    #   # or "kubernetes" (pod CIDRs of the nodes are allocated by kube-controller-manager)
+    #   ipam: "cluster-pool"
+    #   # mtu of the underlying network, detected based on the provider
+    #   # (AWS 9001, GCE 1460, Hetzner and OpenStack 1450) or autodetected by Cilium
+    #   mtu: 0
+    #   # encryption of the pod traffic between the nodes, can be "wireguard" or "ipsec"
+    #   encryption: ""
+    #   # hostRouting can be "legacy" (default) or "bpf", which requires kubeProxyReplacement "strict"
+    #   # and Linux kernel 5.10 or newer with BTF on all nodes
+    #   hostRouting: "legacy"
+    # calico:
+    #   # encapsulationMode can be "VXLAN" (default), "IPIP" or "None" (pod traffic is routed using BGP)
+    #   encapsulationMode: "VXLAN"
+    #   # crossSubnet encapsulates the pod traffic only between nodes in different subnets
+    #   crossSubnet: false
+    #   # enableTypha deploys Typha, recommended for clusters with more than 50 nodes
+    #   enableTypha: false
+    #   # mtu of the workload interfaces, detected based on the provider reduced by the
+    #   # encapsulation overhead (VXLAN 50 bytes, 70 bytes with IPv6, IPIP 20 bytes)
+    #   # or autodetected by Calico
+    #   mtu: 0
+    #   # enableWireGuard encrypts the pod traffic between the nodes using WireGuard
+    #   enableWireGuard: false
     # weaveNet:
     #   # When true is set, secret will be automatically generated and
     #   # referenced in appropriate manifests. Currently only weave-net
     #   # supports encryption.
     #   encrypted: true
     # external: {}
+    # enableBandwidthPlugin chains the bandwidth CNI plugin, so that the
+    # kubernetes.io/ingress-bandwidth and kubernetes.io/egress-bandwidth pod
+    # annotations are honored (default: true for canal and calico, supported
+    # only by canal and calico)
+    # enableBandwidthPlugin: true
 
 cloudProvider:
   # Only one cloud provider can be defined at the same time.
   # Possible values:
-  # aws: {}
-  # azure: {}
+  # aws:
+  #   # Static (default) or InstanceProfile. With InstanceProfile, CCM and the
+  #   # EBS CSI driver use the IAM instance profile of the nodes instead of
+  #   # static access keys. machine-controller still requires static keys.
+  #   credentialsMode: Static
+  # azure:
+  #   # ClientSecret (default) or ManagedIdentity. With ManagedIdentity, CCM
+  #   # and CSI drivers use the managed identity of the control plane VMs
+  #   # instead of the client secret. machine-controller still requires it.
+  #   credentialsMode: ClientSecret
+  #   # Client ID of the user-assigned identity, system-assigned if empty.
+  #   userAssignedIdentityID: ""
+  #   # standard or vmss, set as vmType in the cloud config. With vmss, nodes
+  #   # on VM scale set instances are supported in addition to standalone VMs.
+  #   vmType: ""
+  #   # Set when the nodes are spread across availability zones, so the
+  #   # azuredisk-csi StorageClass binds volumes in the zone of the pod.
+  #   availabilityZones: false
   # digitalocean: {}
-  # gce: {}
+  # gce:
+  #   # ServiceAccountKey (default) or AttachedServiceAccount. With
+  #   # AttachedServiceAccount, the cloud controller and the CSI driver use the
+  #   # service account attached to the control plane instances instead of the
+  #   # key. machine-controller still requires the key.
+  #   credentialsMode: ServiceAccountKey
+  #   # Use the network from a Shared VPC host project. The cloud config is
+  #   # generated from these values if cloudConfig is empty.
+  #   sharedVPC:
+  #     hostProjectID: ""
+  #     networkName: ""
+  #     subnetworkName: ""
   # hetzner:
   #   networkID: ""
+  # kubevirt:
+  #   # Requires external: true, the KubeVirt CCM and CSI driver are deployed
+  #   # by KubeOne. The infrastructure cluster kubeconfig is taken from the
+  #   # KUBEVIRT_KUBECONFIG credential.
+  #   infraNamespace: ""
+  #   # StorageClass of the infrastructure cluster used by the default
+  #   # storage class, defaults to the infrastructure cluster default.
+  #   infraStorageClassName: ""
+  # nutanix:
+  #   # Requires external: true, the Nutanix CCM is deployed by KubeOne.
+  #   # Storage container, filesystem and iSCSI network used by the default
+  #   # storage class. Prism Central endpoint and credentials are taken from
+  #   # the NUTANIX_* credentials.
+  #   storageContainer: "Default"
+  #   fsType: "xfs"
+  #   isSegmentedIscsiNetwork: false
+  # oci:
+  #   # Requires external: true, the OCI CCM and the OCI block volume CSI
+  #   # driver are deployed by KubeOne. machine-controller doesn't support
+  #   # OCI, so only static workers can be used. Tenancy, user, API key and
+  #   # region are taken from the OCI_* credentials.
+  #   compartmentID: ""
+  #   vcnID: ""
+  #   loadBalancerSubnetIDs: []
+  # If cloudConfig is not set for openstack, it's generated from the OS_*
+  # credentials, either application credentials (OS_APPLICATION_CREDENTIAL_ID
+  # and OS_APPLICATION_CREDENTIAL_SECRET) or user credentials.
   # openstack: {}
-  # equinixmetal: {}
-  # vsphere: {}
+  # machine-controller doesn't support Proxmox VE, so only static workers can
+  # be used with proxmox. The external cloud provider isn't supported.
+  # proxmox: {}
+  # equinixmetal:
+  #   # Requires external: true. Enables BGP on the project and devices, and
+  #   # deploys MetalLB to provide LoadBalancer Services using Elastic IPs.
+  #   loadBalancer:
+  #     localASN: 65000
+  # vsphere:
+  #   # Storage policy or datastore URL used by the default vsphere-csi
+  #   # storage class, only one of them can be set. Useful when worker pools
+  #   # use distinct datastores.
+  #   storagePolicyName: ""
+  #   datastoreURL: ""
+  #   # ReadWriteMany volumes backed by vSAN File Service, provided using
+  #   # the vsphere-csi-file storage class. Requires the external cloud provider.
+  #   fileVolumes:
+  #     storageClassName: "vsphere-csi-file"
+  #     datastoreURL: ""
+  #     # Access to the file volumes from the client networks, rendered as the
+  #     # NetPermissions sections of the csiConfig.
+  #     netPermissions:
+  #     - name: "nodes"
+  #       ips: "10.0.0.0/16"
+  #       permissions: "READ_WRITE"
+  #       rootSquash: false
   # none: {}
   {{ .CloudProviderName }}: {}
   # Set the kubelet flag '--cloud-provider=external' and deploy the external CCM for supported providers
@@ -602,23 +769,25 @@ cloudProvider:
   # CSIConfig is configuration passed to the CSI driver.
   # This is currently used only for vSphere clusters.
   csiConfig: ""
+  # Customize the external CCM deployed by KubeOne. Used only if 'external' is true.
+  # cloudControllerManager:
+  #   # Overrides the CCM image repository, e.g. to use a private mirror.
+  #   imageRepository: ""
+  #   # Overrides the CCM image tag, e.g. to roll forward a hotfix release.
+  #   imageTag: ""
+  #   # Additional flags appended to the CCM flags set by KubeOne.
+  #   flags:
+  #     concurrent-service-syncs: "5"
 
 # Controls which container runtime will be installed on instances.
-# By default:
-# * Docker will be installed for Kubernetes clusters up to 1.20
-# * containerd will be installed for Kubernetes clusters 1.21+
-# Currently, it's not possible to migrate existing clusters from one to another
-# container runtime, however, migration from Docker to containerd is planned
-# for one of the upcoming KubeOne releases.
-# Only one container runtime can be present at the time.
-#
-# Note: Kubernetes has announced deprecation of Docker (dockershim) support.
-# It's expected that the Docker support will be removed in Kubernetes 1.24.
-# It's highly advised to use containerd for all newly created clusters.
+# containerd is the only supported container runtime.
 containerRuntime:
   # Installs containerd container runtime.
-  # Default for 1.21+ Kubernetes clusters.
   # containerd:
+  #   # version pins the containerd version. When it's raised, apply upgrades
+  #   # containerd one node at a time, draining each node first. Newer
+  #   # versions already installed on the nodes are not downgraded.
+  #   version: "1.6.24"
   #   registries:
   #     registry.k8s.io:
   #       mirrors:
@@ -637,10 +806,10 @@ containerRuntime:
   #     "*":
   #       mirrors:
   #       - https://secure.tld
-  # Installs Docker container runtime.
-  # Default for Kubernetes clusters up to 1.20.
-  # This option will be removed once Kubernetes 1.23 reaches EOL.
-  # docker: {}
+  # Full reference of the sandbox (pause) image used by the container runtime
+  # and kubelet. Useful for air-gapped clusters. Defaulted based on the
+  # Kubernetes version if empty.
+  # sandboxImage: "registry.example.com/pause:3.9"
 
 features:
   # Configure the CoreDNS deployment
@@ -660,6 +829,124 @@ features:
   nodeLocalDNS:
     deploy: true
 
+  # nvidiaGPU configures the nvidia container runtime and deploys the NVIDIA
+  # device plugin. nvidia-container-toolkit is installed on control plane and
+  # static worker hosts labeled with nvidia.com/gpu.present: "true". NVIDIA
+  # drivers are expected to be preinstalled on such hosts. Flatcar hosts and
+  # dynamic workers (MachineDeployments) are not supported.
+  nvidiaGPU:
+    enable: false
+
+  # seccompDefault makes RuntimeDefault the default seccomp profile for all
+  # workloads. Custom profiles from profilePaths are copied to
+  # /var/lib/kubelet/seccomp/profiles on control plane and static worker nodes.
+  seccompDefault:
+    enable: false
+    # profilePaths:
+    # - ./seccomp/audit.json
+
+  # nodeSwap allows nodes to use swap memory. When enabled, swap is not
+  # disabled on static nodes and the NodeSwap kubelet feature gate is enabled.
+  # Swap itself must be provisioned on the nodes. Requires cgroup v2.
+  nodeSwap:
+    enable: false
+    # swapBehavior can be LimitedSwap or UnlimitedSwap.
+    swapBehavior: LimitedSwap
+
+  # metalLB deploys MetalLB to provide LoadBalancer Services on clusters without
+  # a cloud load balancer, such as baremetal clusters using the "none" provider.
+  # If kube-proxy runs in the IPVS mode, address pools in the L2 mode require
+  # clusterNetwork.kubeProxy.ipvs.strictARP to be enabled.
+  metalLB:
+    enable: false
+    # addressPools:
+    # - name: default
+    #   # addresses can be given as CIDRs or as ranges of IP addresses
+    #   addresses:
+    #   - 192.168.10.100-192.168.10.200
+    #   # mode can be L2 (default) or BGP
+    #   mode: L2
+    # bgpPeers are required by address pools in the BGP mode
+    # bgpPeers:
+    # - peerAddress: 192.168.10.1
+    #   peerASN: 64501
+    #   myASN: 64500
+    #   peerPort: 179
+
+  # gatewayAPI installs the Gateway API CRDs, which are upgraded together with
+  # KubeOne. The cilium controller is used by default with the Cilium CNI and
+  # kubeProxyReplacement set to strict, otherwise only the CRDs are installed.
+  gatewayAPI:
+    enable: false
+    # controller can be cilium or none.
+    # controller: cilium
+
+  # istioAmbient installs Istio in the ambient mode. The istio-cni plugin is
+  # chained to the CNI plugin deployed by KubeOne. Supported only in IPv4
+  # clusters and not with the bpf host routing of Cilium.
+  istioAmbient:
+    enable: false
+
+  # kubeVIP deploys kube-vip on the control plane nodes, announcing
+  # apiEndpoint.host as the virtual IP address using ARP. The apiEndpoint.host
+  # must be an unused IP address in the subnet of the control plane nodes.
+  kubeVIP:
+    enable: false
+    # interface: eth0
+
+  # defaultDenyNetworkPolicy deploys NetworkPolicies denying all traffic of
+  # the pods in the listed namespaces right after the CNI plugin, allowing
+  # only DNS, the Kubernetes API and the ingress from kube-system.
+  defaultDenyNetworkPolicy:
+    enable: false
+    # namespaces:
+    # - default
+
+  # clusterAutoscaler deploys cluster-autoscaler matching the Kubernetes minor
+  # version and annotates the listed MachineDeployments with their minimum and
+  # maximum number of replicas. Requires machine-controller.
+  clusterAutoscaler:
+    enable: false
+    # nodeGroups:
+    # - machineDeployment: my-cluster-pool1
+    #   minReplicas: 1
+    #   maxReplicas: 5
+    # skipNodesWithLocalStorage: true
+    # scaleDownUnneededTime: 10m
+    # expander: random
+
+  # localStorage deploys a local storage provisioner with the default
+  # StorageClass on clusters without a cloud CSI driver (the none cloud
+  # provider, or with disableBundledCSIDrivers). local-path-provisioner is used
+  # by default, while TopoLVM requires the LVM volume group on the nodes.
+  localStorage:
+    enable: false
+    # localPath:
+    #   path: /opt/local-path-provisioner
+    # topolvm:
+    #   volumeGroup: vg-data
+    #   fsType: xfs
+    #   spareGB: 10
+    # storageClassName: local-path
+    # defaultStorageClass: true
+    # reclaimPolicy: Delete
+
+  # ingressNginx deploys the ingress-nginx controller. The controller Service
+  # is configured for the cloud provider, e.g. using the Network Load Balancer
+  # on AWS. On Hetzner, the load balancer location must be set using the
+  # load-balancer.hetzner.cloud/location annotation.
+  ingressNginx:
+    enable: false
+    # replicas: 2
+    # defaultIngressClass: true
+    # serviceType: LoadBalancer
+    # externalTrafficPolicy: Local
+    # proxyProtocol: false
+    # serviceAnnotations:
+    #   load-balancer.hetzner.cloud/location: fsn1
+    # config:
+    #   proxy-body-size: 8m
+
   # Enable the PodNodeSelector admission plugin in API server.
   # More info: https://kubernetes.io/docs/reference/access-authn-authz/admission-controllers/#podnodeselector
   podNodeSelector:
@@ -670,11 +957,6 @@ features:
       # configFilePath is is a required field.
       # More info: https://kubernetes.io/docs/reference/access-authn-authz/admission-controllers/#configuration-file-format-1
       configFilePath: ""
-  # Enables PodSecurityPolicy admission plugin in API server, as well as creates
-  # default 'privileged' PodSecurityPolicy, plus RBAC rules to authorize
-  # 'kube-system' namespace pods to 'use' it.
-  podSecurityPolicy:
-    enable: {{ .EnablePodSecurityPolicy }}
   # Enables and configures audit log backend.
   # More info: https://kubernetes.io/docs/tasks/debug-application-cluster/audit/#log-backend
   staticAuditLog:
@@ -704,6 +986,17 @@ features:
   metricsServer:
     # enabled by default
     enable: {{ .EnableMetricsServer }}
+    # replicas: 1
+    # verify the kubelet serving certificates instead of --kubelet-insecure-tls
+    # secureKubeletTLS: false
+    # resources:
+    #   requests:
+    #     cpu: 100m
+    #     memory: 200Mi
+    #   limits:
+    #     cpu: 1
+    #     memory: 512Mi
+    # hostNetwork: false
   # Enable OpenID-Connect support in API server
   # More info: https://kubernetes.io/docs/reference/access-authn-authz/authentication/#openid-connect-tokens
   openidConnect:
@@ -761,6 +1054,23 @@ caBundle: ""
 systemPackages:
   # will add Docker and Kubernetes repositories to OS package manager
   configureRepositories: true # it's true by default
+  # repositories are used instead of the upstream repositories, e.g. to
+  # install the packages from a mirror in the air-gapped environments. The
+  # repositories without gpgKeyURL or gpgKey are trusted without verifying
+  # the signatures.
+  # repositories:
+  #   kubernetes:
+  #     url: "https://mirror.example.com/kubernetes/deb/"
+  #     gpgKeyURL: "https://mirror.example.com/kubernetes/deb/Release.key"
+  #   containerRuntime:
+  #     url: "https://mirror.example.com/docker/ubuntu"
+  #     suite: "jammy"
+  #     components:
+  #     - stable
+  #     gpgKey: |
+  #       -----BEGIN PGP PUBLIC KEY BLOCK-----
+  #       ...
+  #       -----END PGP PUBLIC KEY BLOCK-----
 
 # registryConfiguration controls how images used for components deployed by
 # KubeOne and kubeadm are pulled from an image registry
@@ -777,14 +1087,165 @@ registryConfiguration:
   # to the worker nodes managed by machine-controller and/or KubeOne.
   insecureRegistry: false
 
+# controlPlaneComponents configures the Kubernetes control plane components
+controlPlaneComponents:
+  # patches are kubeadm patches applied to the control plane components static
+  # pods. Patch files must be named "target[suffix][+patchtype].extension", e.g.
+  # "kube-apiserver+strategic.yaml" or "etcd0+json.json". Relative path is
+  # relative to this manifest.
+  # patches:
+  #   directory: "./patches"
+
+# backups configures backups managed by KubeOne
+backups:
+  # etcd periodically takes etcd snapshots together with the etcd and
+  # Kubernetes PKI, and uploads them encrypted to the S3-compatible object
+  # storage using Restic. Backups can be restored using "kubeone restore".
+  # Bucket credentials are sourced from ETCD_BACKUP_AWS_ACCESS_KEY_ID and
+  # ETCD_BACKUP_AWS_SECRET_ACCESS_KEY, falling back to AWS_ACCESS_KEY_ID and
+  # AWS_SECRET_ACCESS_KEY. The encryption password is sourced from
+  # ETCD_BACKUP_RESTIC_PASSWORD, falling back to RESTIC_PASSWORD.
+  etcd:
+    enable: false
+    # schedule in the cron format
+    schedule: "@every 30m"
+    # retention is a number of the most recent backups to keep
+    retention: 48
+    target:
+      endpoint: "s3.amazonaws.com"
+      bucket: ""
+      prefix: ""
+      region: ""
+
+# upgrades configures how the control plane and static worker nodes are
+# upgraded
+upgrades:
+  # drain evicts the pods from the nodes respecting their PodDisruptionBudgets.
+  # The pods not evicted within the timeout are reported, and deleted if
+  # forceEviction is enabled. Otherwise the drain fails after the timeout,
+  # instead of waiting for the evictions indefinitely as before.
+  drain:
+    timeout: 5m
+    forceEviction: false
+    # skipNamespaces:
+    # - monitoring
+    # skipPodSelector: "app=etcd-operator"
+  # canary upgrades the leader control plane node first and verifies the API
+  # server and etcd health, and that the pods can be scheduled on it. The
+  # remaining nodes are upgraded after the confirmation, or after soakTime if
+  # it's set.
+  canary:
+    enable: false
+    # soakTime: 30m
+  # intermediateVersions are used when upgrading across more than one minor
+  # version, as the cluster is upgraded one minor version at a time. The
+  # latest patch release is used for the minor versions not listed here.
+  # intermediateVersions:
+  # - 1.26.11
+  # - 1.27.8
+  # maintenanceWindows restrict when apply upgrades Kubernetes or containerd,
+  # or rotates the encryption key. Outside of the windows those actions are
+  # deferred, unless apply is run with --ignore-maintenance-windows.
+  # maintenanceWindows:
+  # - days: ["Saturday", "Sunday"]
+  #   start: "22:00"
+  #   duration: 4h
+  #   timeZone: Europe/Berlin
+  # operatingSystem upgrades the operating system release of the nodes, one
+  # node at a time. The control plane and static worker nodes running an older
+  # Ubuntu release are upgraded in place, while the machines of the listed
+  # MachineDeployments are re-provisioned with the new image.
+  # operatingSystem:
+  #   ubuntuRelease: "24.04"
+  #   machineDeploymentImages:
+  #     pool-1: ami-0123456789abcdef0
+  # staticWorkers upgrades the static worker nodes in batches of maxUnavailable
+  # nodes (or a percentage of the nodes), instead of one node at a time. The
+  # drain still respects the PodDisruptionBudgets.
+  # staticWorkers:
+  #   maxUnavailable: 25%
+  # unattended deploys the unattended-upgrades addon, which installs the
+  # unattended security updates on all nodes, and reboots the nodes one at a
+  # time in the rebootWindow when an update requires it. The Kubernetes and
+  # container runtime packages managed by KubeOne are never updated.
+  # unattended:
+  #   enable: true
+  #   rebootWindow:
+  #     days: ["Saturday", "Sunday"]
+  #     start: "02:00"
+  #     duration: 3h
+  #     timeZone: Europe/Berlin
+  #   packageBlacklist:
+  #     - linux-image-*
+  # etcdDefragmentation defragments the etcd members one at a time, after each
+  # upgrade and/or on a schedule, to release the space freed by the etcd
+  # compaction. The members can also be defragmented using 'kubeone etcd defrag'.
+  # etcdDefragmentation:
+  #   afterUpgrade: true
+  #   schedule: "0 3 * * 0"
+
+# certificateAuthority provides the certificate authorities, e.g. intermediate
+# CAs issued by the root CA of the organization, used by kubeadm to sign the
+# cluster PKI instead of generating self-signed CAs. The CAs are installed when
+# the cluster is provisioned. The kubernetes CA of an existing cluster can be
+# replaced using 'kubeone rotate-ca'. The certificate files can include the
+# chain, which is only validated, and the key files must have 0600 permissions.
+# The Vault secrets are read using the same Vault configuration as the
+# credentials, see 'kubeone --help'.
+# certificateAuthority:
+#   kubernetes:
+#     certificateFile: pki/kubernetes-ca.crt
+#     keyFile: pki/kubernetes-ca.key
+#   etcd:
+#     vault:
+#       path: secret/data/kubeone/etcd-ca
+#       certificateKey: tls.crt
+#       privateKeyKey: tls.key
+#   frontProxy:
+#     certificateFile: pki/front-proxy-ca.crt
+#     keyFile: pki/front-proxy-ca.key
+
 # Addons are Kubernetes manifests to be deployed after provisioning the cluster
+# The objects applied by each addon are tracked in the kubeone-addons-inventory
+# ConfigMap in the kube-system namespace. The objects removed from an addon, as
+# well as the objects of removed or renamed addons, are deleted on apply.
 addons:
   enable: false
   # In case when the relative path is provided, the path is relative
   # to the KubeOne configuration file.
   # This path is required only if you want to provide custom addons or override
   # embedded addons.
+  # The path can also reference an OCI artifact, e.g.
+  # oci://registry.example.com/addons/monitoring:1.2.3, optionally pinned
+  # to a digest (oci://registry.example.com/addons/monitoring:1.2.3@sha256:...).
   path: "./addons"
+  # source can be used instead of path to fetch the addons directory from a git
+  # repository, or from a gzipped tarball downloaded over HTTPS and verified
+  # using its SHA256 checksum. The fetched addons are cached locally.
+  # source:
+  #   git:
+  #     url: "https://github.com/example/addons.git"
+  #     ref: "v1.2.3"
+  #     path: "clusters/production"
+  #   http:
+  #     url: "https://example.com/addons-1.2.3.tar.gz"
+  #     sha256: ""
+  #     path: "addons"
+  # verification configures verifying the signatures of the addons pulled from
+  # an OCI artifact or downloaded from a tarball using cosign, which must be
+  # installed on the machine running KubeOne. Either the publicKey, or the
+  # certificateIdentity and certificateOIDCIssuer for the keyless verification,
+  # must be set. The tarball signature is read from the cosign bundle
+  # at source.http.bundleURL (defaults to the tarball URL with .bundle suffix).
+  # verification:
+  #   publicKey: |
+  #     -----BEGIN PUBLIC KEY-----
+  #     ...
+  #     -----END PUBLIC KEY-----
+  #   certificateIdentity: "https://github.com/example/addons/.github/workflows/release.yml@refs/heads/main"
+  #   certificateOIDCIssuer: "https://token.actions.githubusercontent.com"
+  #   # verify the signatures of the overridden addon images as well
+  #   images: false
   # globalParams is a key-value map of values passed to the addons templating engine,
   # to be used in the addons' manifests. The values defined here are passed to all
   # addons.
@@ -803,9 +1264,43 @@ addons:
       delete: false
       # params is a key-value map of values passed to the addons templating engine,
       # to be used in the addon's manifests. Values defined here override the values
-      # defined in globalParams.
+      # defined in globalParams. If the addon directory contains the
+      # params.schema.json file with the JSON schema of the params, the params
+      # (merged with globalParams) are validated against it before applying.
       params:
         key: value
+      # dependsOn is a list of addons that must be applied, and become ready,
+      # before this addon is applied.
+      dependsOn: []
+      # readinessChecks is a list of objects that must become ready after the
+      # addon is applied. Supported kinds are Deployment, StatefulSet,
+      # DaemonSet, Job and CustomResourceDefinition.
+      readinessChecks: []
+      # - kind: Deployment
+      #   name: example
+      #   namespace: kube-system
+      # phase of the cluster lifecycle in which the addon is applied, one of
+      # PreKubeadm, PostJoin, PostUpgrade or PreReset. The addons without the
+      # phase are applied along with the other addons.
+      # phase: PreReset
+
+# helmReleases are Helm charts installed or upgraded after the addons are
+# applied. The releases deployed by KubeOne are uninstalled after they are
+# removed from the list.
+# helmReleases:
+#   - chart: metallb
+#     repoURL: https://metallb.github.io/metallb
+#     # chart can also be an OCI reference without repoURL, e.g.
+#     # oci://registry.example.com/charts/example
+#     version: "0.13.12"
+#     # releaseName defaults to the last element of chart
+#     releaseName: metallb
+#     namespace: metallb-system
+#     values:
+#       - valuesFile: ./metallb-values.yaml
+#       - inline:
+#           speaker:
+#             logLevel: info
 
 # The list of nodes can be overwritten by providing Terraform output.
 # You are strongly encouraged to provide an odd number of nodes and
@@ -825,6 +1320,8 @@ addons:
 #     # You usually want to configure either a private key OR an
 #     # agent socket, but never both. The socket value can be
 #     # prefixed with "env:" to refer to an environment variable.
+#     # The private key can be read from HashiCorp Vault using the
+#     # 'vault://<path>#<key>' format.
 #     sshPrivateKeyFile: '/home/me/.ssh/id_rsa'
 #     sshAgentSocket: 'env:SSH_AUTH_SOCK'
 #     # Optional ssh host public key for verification of the connection to the control plane host
@@ -843,6 +1340,8 @@ addons:
 #       "new-custom-label": "custom-value"
 #       # to delete existing label (use minus symbol with empty value)
 #       "node.kubernetes.io/exclude-from-external-load-balancers-": ""
+#     # zone is used as the value of the topology.kubernetes.io/zone label
+#     zone: "eu-central-1a"
 #     # kubelet is used to control kubelet configuration
 #     # uncomment the following to set those kubelet parameters. More into at:
 #     # https://kubernetes.io/docs/tasks/administer-cluster/reserve-compute-resources/#
@@ -855,6 +1354,25 @@ addons:
 #     #     memory: 300Mi
 #     #   evictionHard: {}
 #     #   maxPods: 110
+#     # bmc configures the baseboard management controller used by
+#     # "kubeone reset --reprovision" to re-image the host from PXE or an ISO.
+#     # The password can be prefixed with "env:" to refer to an environment variable.
+#     # bmc:
+#     #   protocol: Redfish  # or IPMI, requires ipmitool to be installed
+#     #   address: 'https://10.0.0.10'
+#     #   username: 'admin'
+#     #   password: 'env:BMC_PASSWORD'
+#     #   bootDevice: PXE  # or VirtualMedia, together with imageURL
+#   # Validate that control plane hosts are spread across zones so that a
+#   # failure of a single zone doesn't cause the loss of etcd quorum.
+#   requireZoneSpread: false
+#   # kernel configures sysctls and kernel modules on all control plane hosts.
+#   # The configuration is applied and verified on every apply.
+#   kernel:
+#     sysctls:
+#       net.core.somaxconn: "32768"
+#     modules:
+#     - ip_vs
 
 # A list of static workers, not managed by MachineController.
 # The list of nodes can be overwritten by providing Terraform output.
@@ -871,6 +1389,8 @@ addons:
 #     # You usually want to configure either a private key OR an
 #     # agent socket, but never both. The socket value can be
 #     # prefixed with "env:" to refer to an environment variable.
+#     # The private key can be read from HashiCorp Vault using the
+#     # 'vault://<path>#<key>' format.
 #     sshPrivateKeyFile: '/home/me/.ssh/id_rsa'
 #     sshAgentSocket: 'env:SSH_AUTH_SOCK'
 #     # Optional ssh host public key for verification of the connection to the static worker host
@@ -892,6 +1412,13 @@ addons:
 #     #     memory: 300Mi
 #     #   evictionHard: {}
 #     #   maxPods: 110
+#   # kernel configures sysctls and kernel modules on all static worker hosts.
+#   # The configuration is applied and verified on every apply.
+#   kernel:
+#     sysctls:
+#       vm.max_map_count: "262144"
+#     modules:
+#     - br_netfilter
 
 # The API server can also be overwritten by Terraform. Provide the
 # external address of your load balancer or the public addresses of
@@ -900,6 +1427,13 @@ addons:
 #   host: '{{ .APIEndpointHost }}'
 #   port: {{ .APIEndpointPort }}
 #   alternativeNames: {{ .APIEndpointAlternativeNames }}
+#   # Additional endpoints (e.g. load balancers in other availability zones)
+#   # KubeOne fails over to, in order of priority, if the endpoint above is
+#   # not reachable. Kubelets use only the endpoint above.
+#   additionalEndpoints:
+#   - host: 'lb-2.example.com'
+#     port: 6443
+#     priority: 1
 
 # If the cluster runs on bare metal or an unsupported cloud provider,
 # you can disable the machine-controller deployment entirely. In this
@@ -951,6 +1485,14 @@ machineController:
 #       distUpgradeOnBoot: true
 # - name: fra1-b
 #   replicas: 1
+#   # upgradeStrategy configures how machine-controller replaces the machines
+#   # when the MachineDeployment is upgraded. Only Replace is supported.
+#   upgradeStrategy:
+#     type: Replace
+#     maxSurge: '25%'
+#     maxUnavailable: 0
+#     # delete the replaced nodes without evicting the pods first
+#     skipEviction: false
 #   providerSpec:
 #     labels:
 #       mylabel: 'fra1-b'
@@ -977,4 +1519,14 @@ machineController:
 loggingConfig:
   containerLogMaxSize: "{{ .ContainerLogMaxSize }}"
   containerLogMaxFiles: {{ .ContainerLogMaxFiles }}
+
+# cgroups configures the cgroup driver used by the kubelet and the container
+# runtime, and optionally enforces the cgroup version on all control plane and
+# static worker nodes.
+cgroups:
+  # systemd (default) or cgroupfs. cgroupfs can't be used with cgroup v2 and
+  # the driver can't be changed on already provisioned nodes.
+  driver: systemd
+  # v1 or v2. If set, nodes using a different cgroup version are rejected.
+  # version: v2
 `
@@ -0,0 +1,125 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/progress"
+	"k8c.io/kubeone/pkg/tasks"
+)
+
+func etcdCmd(rootFlags *pflag.FlagSet) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "etcd",
+		Short: "Manage etcd",
+	}
+
+	cmd.AddCommand(
+		etcdDefragCmd(rootFlags),
+	)
+
+	return cmd
+}
+
+type etcdDefragOpts struct {
+	globalOptions
+	AutoApprove bool `longflag:"auto-approve" shortflag:"y"`
+}
+
+func etcdDefragCmd(rootFlags *pflag.FlagSet) *cobra.Command {
+	opts := &etcdDefragOpts{}
+
+	cmd := &cobra.Command{
+		Use:   "defrag",
+		Short: "Defragment the etcd members one at a time",
+		Long: heredoc.Doc(`
+			This command defragments the etcd members, which releases the space freed by the compaction of the
+			etcd database back to the filesystem. The members are defragmented one at a time, and all members
+			have to be healthy before the next member is defragmented.
+
+			A member doesn't serve any requests while it's being defragmented, which takes up to a few seconds
+			per gigabyte of the database size.
+
+			The members can also be defragmented after each upgrade, or on a schedule, using the
+			.upgrades.etcdDefragmentation block of the KubeOneCluster manifest.
+		`),
+		SilenceErrors: true,
+		Example:       `kubeone etcd defrag -m mycluster.yaml -t terraformoutput.json`,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			gopts, err := persistentGlobalOptions(rootFlags)
+			if err != nil {
+				return err
+			}
+
+			opts.globalOptions = *gopts
+
+			return runEtcdDefrag(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(
+		&opts.AutoApprove,
+		longFlagName(opts, "AutoApprove"),
+		shortFlagName(opts, "AutoApprove"),
+		false,
+		"auto approve plan")
+
+	return cmd
+}
+
+func runEtcdDefrag(opts *etcdDefragOpts) error {
+	s, err := opts.globalOptions.BuildState()
+	if err != nil {
+		return err
+	}
+
+	probbing := tasks.WithHostnameOS(nil)
+	probbing = tasks.WithProbes(probbing)
+
+	if err = probbing.Run(s); err != nil {
+		return err
+	}
+
+	if !s.LiveCluster.IsProvisioned() {
+		return fail.RuntimeError{
+			Op:  "defragmenting etcd",
+			Err: errors.New("the target cluster is not provisioned"),
+		}
+	}
+
+	s.Logger.Warnln("This command will defragment the etcd members one at a time.")
+
+	confirm, err := confirmCommand(opts.AutoApprove)
+	if err != nil {
+		return err
+	}
+
+	if !confirm {
+		s.Logger.Println("Operation canceled.")
+
+		return nil
+	}
+
+	s.Operation = "etcd defrag"
+
+	return progress.OperationFinished(s, tasks.WithEtcdDefragmentation(nil).Run(s))
+}
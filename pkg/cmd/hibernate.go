@@ -0,0 +1,132 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"k8c.io/kubeone/pkg/cloudinstances"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/tasks"
+)
+
+type hibernateOpts struct {
+	globalOptions
+	AutoApprove bool `longflag:"auto-approve" shortflag:"y"`
+}
+
+func hibernateCmd(rootFlags *pflag.FlagSet) *cobra.Command {
+	opts := &hibernateOpts{}
+
+	cmd := &cobra.Command{
+		Use:   "hibernate",
+		Short: "Scale down the worker nodes and stop the instances of the cluster",
+		Long: heredoc.Doc(`
+			This command hibernates the cluster to cut the costs of the clusters not used all the time, e.g. the
+			development clusters outside the working hours. The cluster is hibernated in the following order:
+
+			  * All MachineDeployments are scaled to zero replicas, and the command waits for machine-controller
+			    to delete their machines. The original replicas are recorded in the
+			    kubeone.io/hibernated-replicas annotation of each MachineDeployment.
+			  * The cluster is stopped the same way as by 'kubeone stop'.
+			  * The instances of the control plane and static worker nodes are stopped (not terminated) using
+			    the API of the cloud provider.
+
+			The hibernated cluster is resumed using the 'kubeone resume' command.
+
+			Only the AWS cloud provider is currently supported. The region of the instances is set in the
+			AWS_REGION environment variable. The instances are looked up by the private addresses of the
+			hosts.
+		`),
+		SilenceErrors: true,
+		Example:       `kubeone hibernate -m mycluster.yaml -t terraformoutput.json`,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			gopts, err := persistentGlobalOptions(rootFlags)
+			if err != nil {
+				return err
+			}
+
+			opts.globalOptions = *gopts
+
+			return runHibernate(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(
+		&opts.AutoApprove,
+		longFlagName(opts, "AutoApprove"),
+		shortFlagName(opts, "AutoApprove"),
+		false,
+		"auto approve plan")
+
+	return cmd
+}
+
+func runHibernate(opts *hibernateOpts) error {
+	s, err := opts.globalOptions.BuildState()
+	if err != nil {
+		return err
+	}
+
+	// fail early if the instances of the cloud provider can't be stopped
+	if _, err = cloudinstances.New(s.Cluster, s.CredentialsFilePath); err != nil {
+		return err
+	}
+
+	probbing := tasks.WithHostnameOS(nil)
+	probbing = tasks.WithProbes(probbing)
+
+	if err = probbing.Run(s); err != nil {
+		return err
+	}
+
+	if !s.LiveCluster.IsProvisioned() {
+		return fail.RuntimeError{
+			Op:  "hibernating cluster",
+			Err: errors.New("the target cluster is not provisioned"),
+		}
+	}
+
+	tasksToRun := tasks.WithHibernate(nil)
+
+	s.Logger.Warnln("This command will:")
+	for _, op := range tasksToRun.Descriptions(s) {
+		s.Logger.Warnf("\t* %s", op)
+	}
+
+	confirm, err := confirmCommand(opts.AutoApprove)
+	if err != nil {
+		return err
+	}
+
+	if !confirm {
+		s.Logger.Println("Operation canceled.")
+
+		return nil
+	}
+
+	if err = tasksToRun.Run(s); err != nil {
+		return err
+	}
+
+	s.Logger.Infoln("The cluster is hibernated, run 'kubeone resume' to resume it.")
+
+	return nil
+}
@@ -164,6 +164,9 @@ var (
 		      enableHubble: {{ .EnableHubble }}
 		      kubeProxyReplacement: {{ .KubeProxyReplacement }}
 		  {{- end -}}
+		  {{- with .ClusterNetwork.CNI.Calico }}
+		    calico: {}
+		  {{- end -}}
 		  {{- with .ClusterNetwork.CNI.External }}
 		    external: {}
 		  {{- end -}}
@@ -259,8 +262,13 @@ func genKubeOneClusterYAML(params *GenerateOpts) ([]byte, error) {
 		return nil, err
 	}
 
-	if cluster.CloudProvider.None != nil {
+	// there's no CSI driver and therefore no default StorageClass for none and Proxmox
+	if cluster.CloudProvider.None != nil || cluster.CloudProvider.Proxmox != nil {
 		cluster.Addons = nil
+	}
+
+	// machine-controller doesn't support OCI and Proxmox, so only static workers can be used
+	if cluster.CloudProvider.None != nil || cluster.CloudProvider.OCI != nil || cluster.CloudProvider.Proxmox != nil {
 		cluster.MachineController = &kubeonev1beta2.MachineControllerConfig{
 			Deploy: false,
 		}
@@ -309,6 +317,10 @@ func clusterAdditionalParams(cluster *kubeonev1beta2.KubeOneCluster, generateOpt
 		cluster.ClusterNetwork.KubeProxy = &kubeonev1beta2.KubeProxyConfig{
 			SkipInstallation: true,
 		}
+	case cniCalicoValue:
+		cluster.ClusterNetwork.CNI = &kubeonev1beta2.CNI{
+			Calico: &kubeonev1beta2.CalicoSpec{},
+		}
 	case cniExternalValue:
 		cluster.ClusterNetwork.CNI = &kubeonev1beta2.CNI{
 			External: &kubeonev1beta2.ExternalCNISpec{},
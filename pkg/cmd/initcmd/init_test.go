@@ -103,6 +103,10 @@ func TestGenKubeOneClusterYAML(t *testing.T) {
 			name: "default with cilium replacement",
 			opts: genOpts(withCNI(cniCiliumReplacementValue)),
 		},
+		{
+			name: "default with calico",
+			opts: genOpts(withCNI(cniCalicoValue)),
+		},
 		{
 			name: "default with external",
 			opts: genOpts(withCNI(cniExternalValue)),
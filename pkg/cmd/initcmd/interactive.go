@@ -170,12 +170,9 @@ func (opts *interactiveOpts) parseInteractiveOpts() (*GenerateOpts, error) {
 		return nil, err
 	}
 
-	cpKey, cp := cloudProviderForSelectedOption(opts.cluster.CloudProvider)
-
-	providerName := cp.alternativeName
-	if providerName == "" {
-		providerName = cpKey
-	}
+	// The init provider name is used, it's mapped to the cloud provider name
+	// (if different) when the manifest is generated
+	providerName, cp := cloudProviderForSelectedOption(opts.cluster.CloudProvider)
 
 	gOpts := NewGenerateOpts(path, providerName, opts.cluster.ClusterName, opts.cluster.KubernetesVersion, opts.generateTerraform)
 
@@ -187,6 +184,8 @@ func (opts *interactiveOpts) parseInteractiveOpts() (*GenerateOpts, error) {
 		gOpts.cni = cniCiliumValue
 	case cniCiliumReplacement:
 		gOpts.cni = cniCiliumReplacementValue
+	case cniCalico:
+		gOpts.cni = cniCalicoValue
 	case cniExternal:
 		gOpts.cni = cniExternalValue
 	}
@@ -18,6 +18,8 @@ package initcmd
 
 import (
 	"github.com/MakeNowJust/heredoc/v2"
+
+	"k8c.io/kubeone/pkg/fail"
 )
 
 type initProvider struct {
@@ -173,6 +175,29 @@ var (
 				},
 			},
 		},
+		"kubevirt": {
+			title:         "KubeVirt",
+			terraformPath: "terraform/kubevirt",
+			external:      true,
+			requiredTFVars: []terraformVariable{
+				{
+					Name:        "infra_namespace",
+					Description: "Namespace in the infrastructure cluster in which the VMs will be created",
+				},
+			},
+		},
+		"libvirt": {
+			title:           "libvirt/KVM (local development)",
+			alternativeName: "none",
+			terraformPath:   "terraform/libvirt",
+			optionalTFVars: []terraformVariable{
+				{
+					Name:         "image_url",
+					Description:  "URL or local path of the cloud-init enabled qcow2 cloud image",
+					DefaultValue: "https://cloud-images.ubuntu.com/jammy/current/jammy-server-cloudimg-amd64.img",
+				},
+			},
+		},
 		"none": {
 			title:         "None (e.g. baremetal)",
 			terraformPath: "",
@@ -180,6 +205,7 @@ var (
 		"nutanix": {
 			title:         "Nutanix",
 			terraformPath: "terraform/nutanix",
+			external:      true,
 			requiredTFVars: []terraformVariable{
 				{
 					Name:        "nutanix_cluster_name",
@@ -207,6 +233,17 @@ var (
 				},
 			},
 		},
+		"oci": {
+			title:         "Oracle Cloud Infrastructure (OCI)",
+			terraformPath: "terraform/oci",
+			external:      true,
+			requiredTFVars: []terraformVariable{
+				{
+					Name:        "compartment_ocid",
+					Description: "OCID of the compartment in which the cluster will be created",
+				},
+			},
+		},
 		"openstack": {
 			title:         "OpenStack",
 			terraformPath: "terraform/openstack",
@@ -233,18 +270,28 @@ var (
 					Choices:      []terraformVariableChoice{osUbuntu, osCentos, osRockyLinux, osRHEL, osFlatcar, osAmazonLinux2},
 				},
 			},
-			cloudConfig: heredoc.Doc(`
-				[Global]
-				auth-url=<KEYSTONE-URL>
-				username=<USER>
-				password=<PASSWORD>
-				tenant-id=<TENANT-ID>
-				domain-name=DEFAULT
-				region=<REGION>
-
-				[LoadBalancer]
-				[BlockStorage]
-			`),
+		},
+		"proxmox": {
+			title:         "Proxmox VE",
+			terraformPath: "terraform/proxmox",
+			requiredTFVars: []terraformVariable{
+				{
+					Name:        "proxmox_node",
+					Description: "Name of the Proxmox VE node on which the VMs will be created",
+				},
+				{
+					Name:        "template_vm_id",
+					Description: "ID of the cloud-init enabled VM template to clone",
+				},
+			},
+			optionalTFVars: []terraformVariable{
+				{
+					Name:         "os",
+					Description:  "Operating system of the VM template",
+					DefaultValue: osUbuntu.Name,
+					Choices:      []terraformVariableChoice{osUbuntu, osRockyLinux},
+				},
+			},
 		},
 		"vmware-cloud-director": {
 			title:           "VMware Cloud Director",
@@ -410,3 +457,26 @@ var (
 		},
 	}
 )
+
+// TerraformConfigsPath returns the path of the example Terraform configs, in
+// the embedded examples, for the given provider name. The name can be either
+// the init provider name (e.g. vsphere/flatcar) or the cloud provider name
+// used in the KubeOneCluster manifest (e.g. vmwareCloudDirector).
+func TerraformConfigsPath(providerName string) (string, error) {
+	prov, found := ValidProviders[providerName]
+	if !found {
+		for _, v := range ValidProviders {
+			if v.alternativeName == providerName {
+				prov, found = v, true
+
+				break
+			}
+		}
+	}
+
+	if !found || prov.terraformPath == "" {
+		return "", fail.NewConfigError("looking up terraform configs", "there are no example terraform configs for the %q provider", providerName)
+	}
+
+	return prov.terraformPath, nil
+}
@@ -28,6 +28,8 @@ const (
 	cniCiliumValue            = "cilium"
 	cniCiliumReplacement      = "Cilium (with kube-proxy replacement)"
 	cniCiliumReplacementValue = "cilium-ebpf"
+	cniCalico                 = "Calico"
+	cniCalicoValue            = "calico"
 	cniExternal               = "External/Bring Your Own"
 	cniExternalValue          = "external"
 
@@ -78,6 +80,7 @@ var (
 						cniCanal,
 						cniCilium,
 						cniCiliumReplacement,
+						cniCalico,
 						cniExternal,
 					},
 					Default: cniCanal,
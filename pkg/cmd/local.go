@@ -65,7 +65,7 @@ func (opts *localOpts) BuildState() (*state.State, error) {
 	}
 
 	if haveManifest {
-		cluster, err = loadClusterConfig(opts.ManifestFile, "", "", logger)
+		cluster, err = loadClusterConfig(opts.ManifestFile, opts.ValuesFiles, "", "", logger)
 		if err != nil {
 			return nil, err
 		}
@@ -85,6 +85,14 @@ func (opts *localOpts) BuildState() (*state.State, error) {
 	s.Logger = logger
 	s.Cluster = cluster
 
+	if err = addons.PullOCIAddons(s.Context, s.Logger, s.Cluster); err != nil {
+		return nil, err
+	}
+
+	if err = addons.FetchRemoteAddons(s.Context, s.Logger, s.Cluster); err != nil {
+		return nil, err
+	}
+
 	// Validate Addons path if provided
 	if s.Cluster.Addons.Enabled() {
 		addonsPath, err := s.Cluster.Addons.RelativePath(s.ManifestFilePath)
@@ -106,6 +114,10 @@ func (opts *localOpts) BuildState() (*state.State, error) {
 		}
 	}
 
+	if err = addons.ValidateParams(s.Cluster, opts.ManifestFile); err != nil {
+		return nil, err
+	}
+
 	s.ManifestFilePath = opts.ManifestFile
 	s.Verbose = opts.Verbose
 	s.BackupFile = defaultBackupPath(opts.BackupFile, opts.ManifestFile, s.Cluster.Name)
@@ -34,6 +34,7 @@ func migrateCmd(fs *pflag.FlagSet) *cobra.Command {
 	}
 	cmd.AddCommand(migrateToContainerdCmd(fs))
 	cmd.AddCommand(migrateToCCMCSICmd(fs))
+	cmd.AddCommand(migrateCNICmd(fs))
 
 	return cmd
 }
@@ -123,6 +124,11 @@ func migrateToCCMCSICmd(fs *pflag.FlagSet) *cobra.Command {
 
 			Migration is currently available for OpenStack and vSphere. Other providers will be added in future KubeOne releases.
 
+			Nutanix doesn't have an in-tree cloud provider, so for Nutanix clusters created without .cloudProvider.external,
+			this command reconfigures kubelets to use the external cloud provider and deploys the Nutanix CCM. There's no
+			CSI migration for Nutanix, but MachineDeployments must be rolled out, and the migration completed with the
+			"--complete" flag, the same as for other providers.
+
 			The migration is done in two phases:
 
 			  * Phase 1: deploy external CCM and CSI plugin, while leaving in-tree provider enabled.
@@ -225,3 +231,110 @@ func runMigrateToCCMCSI(opts *migrateCCMOptions) error {
 
 	return tasks.WithCCMCSIMigration(nil).Run(s)
 }
+
+type migrateCNIOptions struct {
+	globalOptions
+	AutoApprove bool `longflag:"auto-approve" shortflag:"y"`
+}
+
+func migrateCNICmd(fs *pflag.FlagSet) *cobra.Command {
+	opts := &migrateCNIOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "cni",
+		Short: "Migrate live cluster to the CNI plugin configured in the KubeOneCluster manifest",
+		Long: heredoc.Doc(`
+			This command migrates the cluster from the CNI plugin deployed by KubeOne (e.g. Canal) to another
+			CNI plugin configured in the KubeOneCluster manifest (e.g. Cilium). "kubeone apply" refuses to
+			change the CNI plugin, so the CNI plugin must be changed by running this command.
+
+			The migration is done in the following steps:
+
+			  * Preflight validation: the cluster must be healthy, and the CNI plugin can't be migrated from or to
+			    the external CNI plugin, or between CNI plugins with and without kube-proxy.
+			  * The addon of the previous CNI plugin is deleted and the addon of the new CNI plugin is deployed.
+			  * The control plane and static worker nodes are migrated one by one: the node is drained, the CNI
+			    configuration, network interfaces and iptables chains of the previous CNI plugin are removed,
+			    and the remaining pods on the node are recreated using the new CNI plugin.
+			  * MachineDeployments are rolled out to replace the worker nodes managed by machine-controller.
+			  * The new CNI plugin is verified to be ready on all nodes, and the pod network connectivity is
+			    verified by restarting CoreDNS.
+
+			Pods on the nodes that are not migrated yet can't reach pods on the migrated nodes, so the workloads
+			are disrupted until the migration is done. If the migration is interrupted, it's resumed by running
+			this command again.
+		`),
+		RunE: func(_ *cobra.Command, _ []string) error {
+			gopts, err := persistentGlobalOptions(fs)
+			if err != nil {
+				return err
+			}
+
+			opts.globalOptions = *gopts
+
+			return runMigrateCNI(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(
+		&opts.AutoApprove,
+		longFlagName(opts, "AutoApprove"),
+		shortFlagName(opts, "AutoApprove"),
+		false,
+		"auto approve plan")
+
+	return cmd
+}
+
+func runMigrateCNI(opts *migrateCNIOptions) error {
+	s, err := opts.globalOptions.BuildState()
+	if err != nil {
+		return err
+	}
+
+	// Validate credentials
+	if err = validateCredentials(s, opts.CredentialsFile); err != nil {
+		return err
+	}
+
+	// Probe the cluster for the actual state and the needed tasks.
+	probbing := tasks.WithHostnameOS(nil)
+	probbing = tasks.WithProbes(probbing)
+
+	if err = probbing.Run(s); err != nil {
+		return err
+	}
+
+	if !s.LiveCluster.IsProvisioned() {
+		return fail.RuntimeError{
+			Op:  "migrating CNI",
+			Err: errors.New("the target cluster is not provisioned"),
+		}
+	}
+
+	if !s.LiveCluster.Healthy() {
+		return fail.RuntimeError{
+			Op:  "migrating CNI",
+			Err: errors.New("the target cluster is not healthy, please run 'kubeone apply' first"),
+		}
+	}
+
+	s.Logger.Warnln("This command will migrate your cluster to the CNI plugin configured in the KubeOneCluster manifest.")
+	s.Logger.Warnln("All nodes will be drained one by one, and the pod network will be disrupted until all nodes are migrated.")
+	if s.Cluster.MachineController.Deploy {
+		s.Logger.Warnln("All MachineDeployments will be rolled out to replace the worker nodes managed by machine-controller.")
+	}
+
+	confirm, err := confirmCommand(opts.AutoApprove)
+	if err != nil {
+		return err
+	}
+
+	if !confirm {
+		s.Logger.Println("Operation canceled.")
+
+		return nil
+	}
+
+	return tasks.WithCNIMigration(nil).Run(s)
+}
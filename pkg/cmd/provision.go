@@ -0,0 +1,244 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"k8c.io/kubeone/examples"
+	"k8c.io/kubeone/pkg/apis/kubeone/config"
+	"k8c.io/kubeone/pkg/cmd/initcmd"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/terraform"
+)
+
+type provisionOpts struct {
+	globalOptions
+	AutoApprove  bool     `longflag:"auto-approve" shortflag:"y"`
+	TerraformDir string   `longflag:"terraform-dir"`
+	Provider     string   `longflag:"provider"`
+	Vars         []string `longflag:"var"`
+	PlanOnly     bool     `longflag:"plan-only"`
+	Apply        bool     `longflag:"apply"`
+}
+
+func provisionCmd(rootFlags *pflag.FlagSet) *cobra.Command {
+	opts := &provisionOpts{}
+
+	cmd := &cobra.Command{
+		Use:   "provision",
+		Short: "Provision the infrastructure using the example Terraform configs",
+		Long: heredoc.Doc(`
+			Provision the infrastructure for the cluster using the example Terraform configs bundled with KubeOne.
+
+			If the Terraform directory doesn't contain any Terraform configs, the example configs for the cloud provider
+			from the KubeOneCluster manifest are copied to it. The Terraform variables derived from the manifest (e.g.
+			cluster_name) are written to the kubeone.auto.tfvars.json file in the Terraform directory, and take precedence
+			over the variables set in terraform.tfvars. Other variables, e.g. the ones required by the provider, can be set
+			in terraform.tfvars or using the '--var' flag.
+
+			The command runs 'terraform init', 'terraform plan' and, after confirmation, 'terraform apply'. With the
+			'--apply' flag, 'kubeone apply' is run on the provisioned infrastructure afterwards.
+		`),
+		Example:       `kubeone provision -m mycluster.yaml --terraform-dir ./terraform --var ssh_public_key_file=~/.ssh/id_ed25519.pub --apply`,
+		SilenceErrors: true,
+		RunE: func(*cobra.Command, []string) error {
+			gopts, err := persistentGlobalOptions(rootFlags)
+			if err != nil {
+				return err
+			}
+
+			opts.globalOptions = *gopts
+
+			return runProvision(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(
+		&opts.AutoApprove,
+		longFlagName(opts, "AutoApprove"),
+		shortFlagName(opts, "AutoApprove"),
+		false,
+		"auto approve plan")
+
+	cmd.Flags().StringVar(
+		&opts.TerraformDir,
+		longFlagName(opts, "TerraformDir"),
+		"./terraform",
+		"directory with the Terraform configs, created from the examples if empty")
+
+	cmd.Flags().StringVar(
+		&opts.Provider,
+		longFlagName(opts, "Provider"),
+		"",
+		"example Terraform configs to use, as in 'kubeone init --provider' (default: cloud provider from the manifest)")
+
+	cmd.Flags().StringArrayVar(
+		&opts.Vars,
+		longFlagName(opts, "Vars"),
+		nil,
+		"Terraform variable in the key=value format, can be used multiple times")
+
+	cmd.Flags().BoolVar(
+		&opts.PlanOnly,
+		longFlagName(opts, "PlanOnly"),
+		false,
+		"only show the Terraform plan, without applying it")
+
+	cmd.Flags().BoolVar(
+		&opts.Apply,
+		longFlagName(opts, "Apply"),
+		false,
+		"run 'kubeone apply' after the infrastructure is provisioned")
+
+	return cmd
+}
+
+func runProvision(opts *provisionOpts) error {
+	if opts.ManifestFile == "" {
+		return fail.NewConfigError("checking manifest flag", "--manifest is required")
+	}
+
+	if opts.TerraformState != "" {
+		return fail.NewConfigError("checking tfjson flag", "--tfjson can't be used, the Terraform output is read from --terraform-dir")
+	}
+
+	overrides, err := parseTerraformVars(opts.Vars)
+	if err != nil {
+		return err
+	}
+
+	logger := newLogger(opts.Verbose, opts.LogFormat)
+
+	manifest, err := config.ReadManifest(opts.ManifestFile, opts.ValuesFiles)
+	if err != nil {
+		return err
+	}
+
+	cluster, err := config.DecodeKubeOneCluster(manifest)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(opts.TerraformDir, 0755); err != nil {
+		return fail.Runtime(err, "creating terraform directory")
+	}
+
+	tfConfigs, err := filepath.Glob(filepath.Join(opts.TerraformDir, "*.tf"))
+	if err != nil {
+		return fail.Runtime(err, "looking up terraform configs")
+	}
+
+	if len(tfConfigs) == 0 {
+		provider := opts.Provider
+		if provider == "" {
+			provider = cluster.CloudProvider.CloudProviderName()
+		}
+
+		tfPath, pathErr := initcmd.TerraformConfigsPath(provider)
+		if pathErr != nil {
+			return pathErr
+		}
+
+		logger.Infof("Copying the %s Terraform configs to %s...", provider, opts.TerraformDir)
+		if err = examples.CopyTo(opts.TerraformDir, tfPath); err != nil {
+			return fail.Runtime(err, "copying terraform configuration")
+		}
+	}
+
+	if err = terraform.WriteVarsFile(opts.TerraformDir, terraform.ProvisionVars(cluster, overrides)); err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	tfBinary, err := terraform.Detect(ctx)
+	if err != nil {
+		return err
+	}
+
+	logger.Infof("Provisioning the infrastructure using %s %s...", tfBinary.Flavor, tfBinary.Version)
+
+	if err = tfBinary.Run(ctx, opts.TerraformDir, os.Stdout, os.Stderr, "init", "-input=false"); err != nil {
+		return err
+	}
+
+	if err = tfBinary.Run(ctx, opts.TerraformDir, os.Stdout, os.Stderr, "plan", "-input=false", "-out="+terraform.PlanFileName); err != nil {
+		return err
+	}
+
+	if opts.PlanOnly {
+		return nil
+	}
+
+	confirm, err := confirmCommand(opts.AutoApprove)
+	if err != nil {
+		return err
+	}
+
+	if !confirm {
+		logger.Println("Operation canceled.")
+
+		return nil
+	}
+
+	if err = tfBinary.Run(ctx, opts.TerraformDir, os.Stdout, os.Stderr, "apply", "-input=false", terraform.PlanFileName); err != nil {
+		return err
+	}
+
+	if !opts.Apply {
+		logger.Infof("The infrastructure is provisioned. Run 'kubeone apply -m %s -t %s' to provision the cluster.", opts.ManifestFile, opts.TerraformDir)
+
+		return nil
+	}
+
+	aopts := &applyOpts{
+		globalOptions:            opts.globalOptions,
+		AutoApprove:              opts.AutoApprove,
+		CreateMachineDeployments: true,
+	}
+	aopts.TerraformState = opts.TerraformDir
+
+	st, err := aopts.BuildState()
+	if err != nil {
+		return err
+	}
+
+	return runApply(st, aopts)
+}
+
+func parseTerraformVars(vars []string) (map[string]string, error) {
+	result := map[string]string{}
+
+	for _, v := range vars {
+		key, value, found := strings.Cut(v, "=")
+		if !found || key == "" {
+			return nil, fail.NewConfigError("parsing var flag", "%q is not in the key=value format", v)
+		}
+
+		result[key] = value
+	}
+
+	return result, nil
+}
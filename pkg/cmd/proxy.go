@@ -17,8 +17,10 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"time"
 
@@ -28,6 +30,7 @@ import (
 	"github.com/spf13/pflag"
 
 	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/kubeconfig"
 	"k8c.io/kubeone/pkg/state"
 )
 
@@ -72,6 +75,8 @@ func setupProxyTunnel(opts *proxyOpts) error {
 		return err
 	}
 
+	dial := kubeconfig.TunnelDialer(s)
+
 	server := &http.Server{
 		Addr: opts.ListenAddr,
 		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -81,7 +86,7 @@ func setupProxyTunnel(opts *proxyOpts) error {
 				return
 			}
 
-			if terr := handleTunneling(w, r, s); terr != nil {
+			if terr := handleTunneling(w, r, s, dial); terr != nil {
 				code := http.StatusInternalServerError
 				var errHTTP *httpError
 				if errors.As(terr, &errHTTP) {
@@ -108,17 +113,9 @@ func (e *httpError) Error() string {
 	return fmt.Sprintf("error: %s, code: %d", e.err, e.code)
 }
 
-func handleTunneling(w http.ResponseWriter, r *http.Request, s *state.State) error {
-	// Check if we can authenticate via ssh
-	tunn, err := s.Executor.Tunnel(s.Cluster.RandomHost())
-	if err != nil {
-		return &httpError{err: err, code: http.StatusServiceUnavailable}
-	}
-
-	destConn, err := tunn.TunnelTo(s.Context, "tcp4", r.Host)
+func handleTunneling(w http.ResponseWriter, r *http.Request, s *state.State, dial func(ctx context.Context, network, address string) (net.Conn, error)) error {
+	destConn, err := dial(s.Context, "tcp", r.Host)
 	if err != nil {
-		tunn.Close()
-
 		return &httpError{err: err, code: http.StatusServiceUnavailable}
 	}
 
@@ -0,0 +1,117 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"k8c.io/kubeone/pkg/progress"
+	"k8c.io/kubeone/pkg/tasks"
+)
+
+type replaceNodeOpts struct {
+	globalOptions
+	AutoApprove bool          `longflag:"auto-approve" shortflag:"y"`
+	WaitTimeout time.Duration `longflag:"wait-timeout"`
+}
+
+func replaceNodeCmd(rootFlags *pflag.FlagSet) *cobra.Command {
+	opts := &replaceNodeOpts{}
+
+	cmd := &cobra.Command{
+		Use:   "replace-node <node-name>",
+		Short: "Replace a control plane node",
+		Long: heredoc.Doc(`
+			This command replaces a failed control plane node with a new host. The node is given by the name of
+			its Node object, which is also the name of its etcd member.
+
+			Before running this command, the replaced host has to be removed from the KubeOne configuration
+			manifest (or the Terraform output), and the replacement host has to be added instead. The replacement
+			host can have a different address than the replaced host.
+
+			The node is replaced in the following steps:
+
+			  * The etcd member of the node is removed, if the remaining etcd members keep the quorum.
+			  * The Node object is deleted.
+			  * The command waits for all control plane hosts, including the replacement host, to become reachable
+			    over SSH.
+			  * The replacement host is joined to the cluster the same way as by 'kubeone apply'.
+
+			The replaced host must not be running the control plane anymore, e.g. it's terminated or reset.
+		`),
+		Args:          cobra.ExactArgs(1),
+		SilenceErrors: true,
+		Example:       `kubeone replace-node ip-172-31-10-20.eu-west-3.compute.internal -m mycluster.yaml -t terraformoutput.json`,
+		RunE: func(_ *cobra.Command, args []string) error {
+			gopts, err := persistentGlobalOptions(rootFlags)
+			if err != nil {
+				return err
+			}
+
+			opts.globalOptions = *gopts
+
+			return runReplaceNode(opts, args[0])
+		},
+	}
+
+	cmd.Flags().BoolVarP(
+		&opts.AutoApprove,
+		longFlagName(opts, "AutoApprove"),
+		shortFlagName(opts, "AutoApprove"),
+		false,
+		"auto approve plan")
+
+	cmd.Flags().DurationVar(
+		&opts.WaitTimeout,
+		longFlagName(opts, "WaitTimeout"),
+		30*time.Minute,
+		"how long to wait for the replacement host to become reachable")
+
+	return cmd
+}
+
+func runReplaceNode(opts *replaceNodeOpts, nodeName string) error {
+	s, err := opts.globalOptions.BuildState()
+	if err != nil {
+		return err
+	}
+
+	if err = validateCredentials(s, opts.CredentialsFile); err != nil {
+		return err
+	}
+
+	s.Logger.Warnf("This command will remove the %q control plane node from the cluster, and join the replacement host.", nodeName)
+
+	confirm, err := confirmCommand(opts.AutoApprove)
+	if err != nil {
+		return err
+	}
+
+	if !confirm {
+		s.Logger.Println("Operation canceled.")
+
+		return nil
+	}
+
+	s.Operation = "replace-node"
+
+	return progress.OperationFinished(s, tasks.WithControlPlaneNodeReplacement(nil, nodeName, opts.WaitTimeout).Run(s))
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
 	"k8c.io/kubeone/pkg/kubeconfig"
 	"k8c.io/kubeone/pkg/state"
 	"k8c.io/kubeone/pkg/tasks"
@@ -35,6 +36,7 @@ type resetOpts struct {
 	AutoApprove    bool `longflag:"auto-approve" shortflag:"y"`
 	DestroyWorkers bool `longflag:"destroy-workers"`
 	RemoveBinaries bool `longflag:"remove-binaries"`
+	Reprovision    bool `longflag:"reprovision"`
 }
 
 func (opts *resetOpts) BuildState() (*state.State, error) {
@@ -45,6 +47,7 @@ func (opts *resetOpts) BuildState() (*state.State, error) {
 
 	s.DestroyWorkers = opts.DestroyWorkers
 	s.RemoveBinaries = opts.RemoveBinaries
+	s.ReprovisionHosts = opts.Reprovision
 
 	return s, nil
 }
@@ -94,6 +97,12 @@ func resetCmd(rootFlags *pflag.FlagSet) *cobra.Command {
 		false,
 		"remove kubernetes binaries after resetting the cluster")
 
+	cmd.Flags().BoolVar(
+		&opts.Reprovision,
+		longFlagName(opts, "Reprovision"),
+		false,
+		"power cycle hosts with a configured BMC into PXE or virtual media boot after resetting the cluster")
+
 	return cmd
 }
 
@@ -124,6 +133,17 @@ func runReset(opts *resetOpts) error {
 		fmt.Printf("\t- reset static worker nodes %q (%s)\n", node.Hostname, node.PrivateAddress)
 	}
 
+	if opts.Reprovision {
+		fmt.Printf("\nThe following hosts will be re-provisioned using their BMC:\n")
+		for _, hosts := range [][]kubeoneapi.HostConfig{s.Cluster.ControlPlane.Hosts, s.Cluster.StaticWorkers.Hosts} {
+			for _, node := range hosts {
+				if node.BMC != nil {
+					fmt.Printf("\t- %q (%s) from %s\n", node.Hostname, node.BMC.Address, node.BMC.BootDevice)
+				}
+			}
+		}
+	}
+
 	if opts.DestroyWorkers {
 		// Gather information about machine-controller managed nodes
 		machines := clusterv1alpha1.MachineList{}
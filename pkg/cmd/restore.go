@@ -0,0 +1,123 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/state"
+	"k8c.io/kubeone/pkg/tasks"
+)
+
+type restoreOpts struct {
+	globalOptions
+	AutoApprove bool   `longflag:"auto-approve" shortflag:"y"`
+	SnapshotID  string `longflag:"snapshot"`
+}
+
+func (opts *restoreOpts) BuildState() (*state.State, error) {
+	s, err := opts.globalOptions.BuildState()
+	if err != nil {
+		return nil, err
+	}
+
+	s.EtcdSnapshotID = opts.SnapshotID
+
+	return s, nil
+}
+
+// restoreCmd setups restore command
+func restoreCmd(rootFlags *pflag.FlagSet) *cobra.Command {
+	opts := &restoreOpts{}
+
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Restore etcd from the backup",
+		Long: heredoc.Doc(`
+			Restore etcd on all control plane nodes from the snapshot taken by the KubeOne managed etcd backups.
+
+			Etcd backups must be enabled in the KubeOne manifest ('backups.etcd'). The requested snapshot is resolved
+			once on the leader node, then downloaded from the configured object storage on each control plane node,
+			and the existing etcd data directory is replaced with the restored one. etcd and kube-apiserver are
+			unavailable while the restore is in progress.
+		`),
+		Example: `kubeone restore -m mycluster.yaml -t terraformoutput.json --snapshot latest`,
+		RunE: func(_ *cobra.Command, args []string) error {
+			gopts, err := persistentGlobalOptions(rootFlags)
+			if err != nil {
+				return err
+			}
+
+			opts.globalOptions = *gopts
+
+			return runRestore(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(
+		&opts.AutoApprove,
+		longFlagName(opts, "AutoApprove"),
+		shortFlagName(opts, "AutoApprove"),
+		false,
+		"auto approve restore")
+
+	cmd.Flags().StringVar(
+		&opts.SnapshotID,
+		longFlagName(opts, "SnapshotID"),
+		"latest",
+		"ID of the Restic snapshot to restore, or \"latest\" for the most recent one")
+
+	return cmd
+}
+
+// runRestore restores etcd on all control plane nodes
+func runRestore(opts *restoreOpts) error {
+	s, err := opts.BuildState()
+	if err != nil {
+		return err
+	}
+
+	if !s.Cluster.EtcdBackupsEnabled() {
+		return fail.ConfigValidation(fmt.Errorf("etcd backups must be enabled to restore etcd"))
+	}
+
+	s.Logger.Warnln("This command will replace etcd data on the following control plane nodes:")
+
+	for _, node := range s.Cluster.ControlPlane.Hosts {
+		fmt.Printf("\t- restore etcd on control plane node %q (%s)\n", node.Hostname, node.PrivateAddress)
+	}
+
+	fmt.Printf("\nThe current etcd data directory is kept as a backup in /var/lib/etcd.<timestamp>.bak.\n")
+
+	confirm, err := confirmCommand(opts.AutoApprove)
+	if err != nil {
+		return err
+	}
+
+	if !confirm {
+		s.Logger.Println("Operation canceled.")
+
+		return nil
+	}
+
+	return tasks.WithEtcdRestore(nil).Run(s)
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"k8c.io/kubeone/pkg/cloudinstances"
+	"k8c.io/kubeone/pkg/tasks"
+)
+
+type resumeOpts struct {
+	globalOptions
+	AutoApprove bool `longflag:"auto-approve" shortflag:"y"`
+}
+
+func resumeCmd(rootFlags *pflag.FlagSet) *cobra.Command {
+	opts := &resumeOpts{}
+
+	cmd := &cobra.Command{
+		Use:   "resume",
+		Short: "Resume the cluster hibernated by 'kubeone hibernate'",
+		Long: heredoc.Doc(`
+			This command resumes the cluster hibernated by the 'kubeone hibernate' command. The cluster is resumed
+			in the following order:
+
+			  * The instances of the control plane and static worker nodes are started using the API of the
+			    cloud provider.
+			  * The cluster is started the same way as by 'kubeone start'.
+			  * The MachineDeployments are scaled back to their original replicas.
+
+			The public addresses of the instances without Elastic IPs change when the instances are stopped.
+			This command connects to the new public addresses, but the Terraform output has to be refreshed
+			before running the other commands, e.g. using 'terraform apply -refresh-only'.
+		`),
+		SilenceErrors: true,
+		Example:       `kubeone resume -m mycluster.yaml -t terraformoutput.json`,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			gopts, err := persistentGlobalOptions(rootFlags)
+			if err != nil {
+				return err
+			}
+
+			opts.globalOptions = *gopts
+
+			return runResume(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(
+		&opts.AutoApprove,
+		longFlagName(opts, "AutoApprove"),
+		shortFlagName(opts, "AutoApprove"),
+		false,
+		"auto approve plan")
+
+	return cmd
+}
+
+func runResume(opts *resumeOpts) error {
+	s, err := opts.globalOptions.BuildState()
+	if err != nil {
+		return err
+	}
+
+	if _, err = cloudinstances.New(s.Cluster, s.CredentialsFilePath); err != nil {
+		return err
+	}
+
+	// The probes can't be run, since the instances of the hibernated cluster
+	// are stopped
+	s.Logger.Warnln("This command will start the instances of the cluster, start the cluster and scale up the MachineDeployments.")
+
+	confirm, err := confirmCommand(opts.AutoApprove)
+	if err != nil {
+		return err
+	}
+
+	if !confirm {
+		s.Logger.Println("Operation canceled.")
+
+		return nil
+	}
+
+	return tasks.WithResume(nil).Run(s)
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"k8c.io/kubeone/pkg/progress"
+	"k8c.io/kubeone/pkg/scripts"
+	"k8c.io/kubeone/pkg/state"
+	"k8c.io/kubeone/pkg/tasks"
+)
+
+type rollbackOpts struct {
+	globalOptions
+	AutoApprove bool `longflag:"auto-approve" shortflag:"y"`
+}
+
+func (opts *rollbackOpts) BuildState() (*state.State, error) {
+	return opts.globalOptions.BuildState()
+}
+
+// rollbackCmd setups rollback command
+func rollbackCmd(rootFlags *pflag.FlagSet) *cobra.Command {
+	opts := &rollbackOpts{}
+
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Roll back the control plane to the version before the last upgrade",
+		Long: heredoc.Doc(`
+			Roll back the control plane to the backup taken right before the last upgrade of the control plane.
+
+			Before upgrading the leader control plane node, 'kubeone apply' takes an etcd snapshot on the leader and
+			archives it together with the static pod manifests, the kubelet configuration and the kubeadm configuration
+			on each control plane node. This command restores etcd from that snapshot on all control plane nodes, restores
+			the static pod manifests and the kubelet configuration, and installs the Kubernetes binaries of the previous
+			version.
+
+			The changes made to the cluster after the backup was taken are lost. The rollback is refused if the static
+			worker nodes are already upgraded. Set versions.kubernetes in the manifest back to the previous version before
+			running 'kubeone apply' again.
+		`),
+		Example: `kubeone rollback -m mycluster.yaml -t terraformoutput.json`,
+		RunE: func(_ *cobra.Command, args []string) error {
+			gopts, err := persistentGlobalOptions(rootFlags)
+			if err != nil {
+				return err
+			}
+
+			opts.globalOptions = *gopts
+
+			return runRollback(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(
+		&opts.AutoApprove,
+		longFlagName(opts, "AutoApprove"),
+		shortFlagName(opts, "AutoApprove"),
+		false,
+		"auto approve rollback")
+
+	return cmd
+}
+
+// runRollback rolls back the control plane from the backup taken before the
+// last upgrade
+func runRollback(opts *rollbackOpts) error {
+	s, err := opts.BuildState()
+	if err != nil {
+		return err
+	}
+
+	s.Logger.Warnln("This command will roll back the following control plane nodes to the backup taken before the last upgrade:")
+
+	for _, node := range s.Cluster.ControlPlane.Hosts {
+		fmt.Printf("\t- roll back control plane node %q (%s)\n", node.Hostname, node.PrivateAddress)
+	}
+
+	fmt.Printf("\nThe changes made to the cluster since the backup in %s was taken are lost.\n", scripts.UpgradeBackupDir)
+	fmt.Printf("The current etcd data directory is kept as a backup in /var/lib/etcd.<timestamp>.bak.\n")
+
+	confirm, err := confirmCommand(opts.AutoApprove)
+	if err != nil {
+		return err
+	}
+
+	if !confirm {
+		s.Logger.Println("Operation canceled.")
+
+		return nil
+	}
+
+	s.Operation = "rollback"
+
+	err = tasks.WithManagedFilesRecord(tasks.WithUpgradeRollback(nil)).Run(s)
+	if err = progress.OperationFinished(s, err); err != nil {
+		return err
+	}
+
+	s.Logger.Infof("The control plane is rolled back to Kubernetes %s.", s.Cluster.Versions.Kubernetes)
+
+	return nil
+}
@@ -91,17 +91,22 @@ func newRoot() *cobra.Command {
 		"./kubeone.yaml",
 		"Path to the KubeOne config")
 
+	fs.StringArrayVar(&opts.ValuesFiles,
+		longFlagName(opts, "ValuesFiles"),
+		nil,
+		"Path to the YAML values file used to render the KubeOne config as a Go template (can be repeated, later files take precedence)")
+
 	fs.StringVarP(&opts.TerraformState,
 		longFlagName(opts, "TerraformState"),
 		shortFlagName(opts, "TerraformState"),
 		"",
-		"Source for terraform output in JSON - to read from stdin. If path is a file, contents will be used. If path is a dictionary, `terraform output -json` is executed in this path")
+		"Source for terraform output in JSON - to read from stdin. If path is a file, contents will be used. If path is a dictionary, `terraform output -json` is executed in this path. OpenTofu (`tofu`) is used if terraform is not found, the binary can be set with the KUBEONE_TERRAFORM_BINARY environment variable")
 
 	fs.StringVarP(&opts.CredentialsFile,
 		longFlagName(opts, "CredentialsFile"),
 		shortFlagName(opts, "CredentialsFile"),
 		"",
-		"File to source credentials and secrets from")
+		"File to source credentials and secrets from, or a vault://<path> reference to a secret in the KV secrets engine of HashiCorp Vault. Vault is configured using the VAULT_ADDR environment variable, and authenticated using VAULT_TOKEN, VAULT_ROLE_ID and VAULT_SECRET_ID (AppRole), or VAULT_KUBERNETES_ROLE (Kubernetes auth)")
 
 	fs.BoolVarP(&opts.Verbose,
 		longFlagName(opts, "Verbose"),
@@ -127,14 +132,25 @@ func newRoot() *cobra.Command {
 		completionCmd(rootCmd),
 		configCmd(fs),
 		documentCmd(rootCmd),
+		etcdCmd(fs),
+		hibernateCmd(fs),
 		initCmd(),
 		installCmd(fs),
 		kubeconfigCmd(fs),
 		localCmd(fs),
 		migrateCmd(fs),
+		provisionCmd(fs),
 		proxyCmd(fs),
+		replaceNodeCmd(fs),
 		resetCmd(fs),
+		restoreCmd(fs),
+		resumeCmd(fs),
+		rollbackCmd(fs),
+		rotateCACmd(fs),
+		rotateCNIKeysCmd(fs),
+		startCmd(fs),
 		statusCmd(fs),
+		stopCmd(fs),
 		upgradeCmd(fs),
 		versionCmd(),
 	)
@@ -0,0 +1,137 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/progress"
+	"k8c.io/kubeone/pkg/tasks"
+)
+
+type rotateCAOptions struct {
+	globalOptions
+	AutoApprove bool `longflag:"auto-approve" shortflag:"y"`
+}
+
+func rotateCACmd(fs *pflag.FlagSet) *cobra.Command {
+	opts := &rotateCAOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "rotate-ca",
+		Short: "Rotate the cluster CA and renew all certificates signed by it",
+		Long: heredoc.Doc(`
+			This command replaces the cluster CA (/etc/kubernetes/pki/ca.crt) with a newly generated CA, and renews
+			all certificates signed by it, following the kubeadm procedure for the manual rotation of the CA. The
+			etcd and the front-proxy CAs are not rotated, but their certificates are renewed as well.
+
+			If .certificateAuthority.kubernetes is set in the KubeOneCluster manifest, the configured CA is used
+			as the new cluster CA instead of generating one, e.g. to switch an existing cluster to an intermediate
+			CA issued by the root CA of the organization.
+
+			The CA is rotated in the following steps, one control plane node at a time, so the cluster stays
+			available:
+
+			  * All components, kubelets and kubeconfig files on the control plane and static worker nodes are
+			    configured to trust both the old and the new CA.
+			  * The Deployments and DaemonSets in the kube-system namespace are restarted once the
+			    kube-root-ca.crt ConfigMaps trust both CAs, and the MachineDeployments are rolled out.
+			  * The certificates and the kubeconfig files of the control plane are renewed with the new CA.
+			  * kubelet on each control plane and static worker node bootstraps new client and serving
+			    certificates, and the MachineDeployments are rolled out again.
+			  * The old CA is no longer trusted.
+
+			The old and the new CA are kept in /etc/kubernetes/pki/ca-rotation on the nodes until the rotation is
+			finished. If the rotation fails, it's resumed with the same new CA by running this command again.
+
+			The workloads outside of the kube-system namespace that load the CA of the service account only on
+			start have to be restarted after the first step. The kubeconfig files used to access the cluster,
+			such as the one downloaded by 'kubeone kubeconfig', have to be downloaded again.
+		`),
+		Example: `kubeone rotate-ca -m mycluster.yaml -t terraformoutput.json`,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			gopts, err := persistentGlobalOptions(fs)
+			if err != nil {
+				return err
+			}
+
+			opts.globalOptions = *gopts
+
+			return runRotateCA(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(
+		&opts.AutoApprove,
+		longFlagName(opts, "AutoApprove"),
+		shortFlagName(opts, "AutoApprove"),
+		false,
+		"auto approve plan")
+
+	return cmd
+}
+
+func runRotateCA(opts *rotateCAOptions) error {
+	s, err := opts.globalOptions.BuildState()
+	if err != nil {
+		return err
+	}
+
+	// Probe the cluster for the actual state and the needed tasks.
+	probbing := tasks.WithHostnameOS(nil)
+	probbing = tasks.WithProbes(probbing)
+
+	if err = probbing.Run(s); err != nil {
+		return err
+	}
+
+	if !s.LiveCluster.IsProvisioned() {
+		return fail.RuntimeError{
+			Op:  "rotating cluster CA",
+			Err: errors.New("the target cluster is not provisioned"),
+		}
+	}
+
+	if !s.LiveCluster.Healthy() {
+		return fail.RuntimeError{
+			Op:  "rotating cluster CA",
+			Err: errors.New("the target cluster is not healthy, please run 'kubeone apply' first"),
+		}
+	}
+
+	s.Logger.Warnln("This command will rotate the cluster CA and renew all certificates signed by it.")
+	s.Logger.Warnln("The nodes managed by machine-controller will be replaced twice.")
+
+	confirm, err := confirmCommand(opts.AutoApprove)
+	if err != nil {
+		return err
+	}
+
+	if !confirm {
+		s.Logger.Println("Operation canceled.")
+
+		return nil
+	}
+
+	s.Operation = "rotate-ca"
+
+	return progress.OperationFinished(s, tasks.WithCARotation(nil).Run(s))
+}
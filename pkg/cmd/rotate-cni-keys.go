@@ -0,0 +1,121 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/tasks"
+)
+
+type rotateCNIKeysOptions struct {
+	globalOptions
+	AutoApprove bool `longflag:"auto-approve" shortflag:"y"`
+}
+
+func rotateCNIKeysCmd(fs *pflag.FlagSet) *cobra.Command {
+	opts := &rotateCNIKeysOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "rotate-cni-keys",
+		Short: "Rotate the keys used by the CNI plugin to encrypt the pod traffic between the nodes",
+		Long: heredoc.Doc(`
+			This command rotates the keys used by the CNI plugin to encrypt the pod traffic between the nodes.
+			Currently, only the IPsec key of the Cilium CNI plugin (.clusterNetwork.cni.cilium.encryption set to
+			"ipsec") can be rotated. The WireGuard keys are generated on each node by the CNI plugin.
+
+			The key is rotated in the following steps:
+
+			  * The Cilium agents on all nodes are verified to use the current key.
+			  * A new key with the next key ID is stored in the kube-system/cilium-ipsec-keys Secret.
+			  * The Cilium agents pick up the new key one node at a time, and this command waits until the
+			    agents on all nodes report the new key in their CiliumNode objects.
+
+			The agents keep the previous key until all nodes switch to the new key, so the pod traffic is not
+			disrupted during the rotation. The previous key is removed after the key rotation duration of
+			Cilium (5 minutes by default), and the key must not be rotated again before that.
+		`),
+		Example: `kubeone rotate-cni-keys -m mycluster.yaml -t terraformoutput.json`,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			gopts, err := persistentGlobalOptions(fs)
+			if err != nil {
+				return err
+			}
+
+			opts.globalOptions = *gopts
+
+			return runRotateCNIKeys(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(
+		&opts.AutoApprove,
+		longFlagName(opts, "AutoApprove"),
+		shortFlagName(opts, "AutoApprove"),
+		false,
+		"auto approve plan")
+
+	return cmd
+}
+
+func runRotateCNIKeys(opts *rotateCNIKeysOptions) error {
+	s, err := opts.globalOptions.BuildState()
+	if err != nil {
+		return err
+	}
+
+	// Probe the cluster for the actual state and the needed tasks.
+	probbing := tasks.WithHostnameOS(nil)
+	probbing = tasks.WithProbes(probbing)
+
+	if err = probbing.Run(s); err != nil {
+		return err
+	}
+
+	if !s.LiveCluster.IsProvisioned() {
+		return fail.RuntimeError{
+			Op:  "rotating CNI keys",
+			Err: errors.New("the target cluster is not provisioned"),
+		}
+	}
+
+	if !s.LiveCluster.Healthy() {
+		return fail.RuntimeError{
+			Op:  "rotating CNI keys",
+			Err: errors.New("the target cluster is not healthy, please run 'kubeone apply' first"),
+		}
+	}
+
+	s.Logger.Warnln("This command will rotate the keys used by the CNI plugin to encrypt the pod traffic between the nodes.")
+
+	confirm, err := confirmCommand(opts.AutoApprove)
+	if err != nil {
+		return err
+	}
+
+	if !confirm {
+		s.Logger.Println("Operation canceled.")
+
+		return nil
+	}
+
+	return tasks.WithCNIKeyRotation(nil).Run(s)
+}
@@ -50,12 +50,13 @@ var (
 const yes = "yes"
 
 type globalOptions struct {
-	ManifestFile    string `longflag:"manifest" shortflag:"m"`
-	TerraformState  string `longflag:"tfjson" shortflag:"t"`
-	CredentialsFile string `longflag:"credentials" shortflag:"c"`
-	Verbose         bool   `longflag:"verbose" shortflag:"v"`
-	Debug           bool   `longflag:"debug" shortflag:"d"`
-	LogFormat       string `longflag:"log-format" shortflag:"l"`
+	ManifestFile    string   `longflag:"manifest" shortflag:"m"`
+	ValuesFiles     []string `longflag:"values"`
+	TerraformState  string   `longflag:"tfjson" shortflag:"t"`
+	CredentialsFile string   `longflag:"credentials" shortflag:"c"`
+	Verbose         bool     `longflag:"verbose" shortflag:"v"`
+	Debug           bool     `longflag:"debug" shortflag:"d"`
+	LogFormat       string   `longflag:"log-format" shortflag:"l"`
 }
 
 func (opts *globalOptions) BuildState() (*state.State, error) {
@@ -67,7 +68,7 @@ func (opts *globalOptions) BuildState() (*state.State, error) {
 
 	s.Logger = newLogger(opts.Verbose, opts.LogFormat)
 
-	cluster, err := loadClusterConfig(opts.ManifestFile, opts.TerraformState, opts.CredentialsFile, s.Logger)
+	cluster, err := loadClusterConfig(opts.ManifestFile, opts.ValuesFiles, opts.TerraformState, opts.CredentialsFile, s.Logger)
 	if err != nil {
 		return nil, err
 	}
@@ -77,6 +78,14 @@ func (opts *globalOptions) BuildState() (*state.State, error) {
 	s.CredentialsFilePath = opts.CredentialsFile
 	s.Verbose = opts.Verbose
 
+	if err = addons.PullOCIAddons(s.Context, s.Logger, s.Cluster); err != nil {
+		return nil, err
+	}
+
+	if err = addons.FetchRemoteAddons(s.Context, s.Logger, s.Cluster); err != nil {
+		return nil, err
+	}
+
 	// Validate Addons path if provided
 	if s.Cluster.Addons.Enabled() {
 		addonsPath, err := s.Cluster.Addons.RelativePath(s.ManifestFilePath)
@@ -98,6 +107,10 @@ func (opts *globalOptions) BuildState() (*state.State, error) {
 		}
 	}
 
+	if err = addons.ValidateParams(s.Cluster, s.ManifestFilePath); err != nil {
+		return nil, err
+	}
+
 	return s, nil
 }
 
@@ -127,6 +140,12 @@ func persistentGlobalOptions(fs *pflag.FlagSet) (*globalOptions, error) {
 	}
 	gf.ManifestFile = manifestFile
 
+	valuesFiles, err := fs.GetStringArray(longFlagName(gf, "ValuesFiles"))
+	if err != nil {
+		return nil, fail.Runtime(err, "getting global flags")
+	}
+	gf.ValuesFiles = valuesFiles
+
 	verbose, err := fs.GetBool(longFlagName(gf, "Verbose"))
 	if err != nil {
 		return nil, fail.Runtime(err, "getting global flags")
@@ -178,8 +197,8 @@ func newLogger(verbose bool, format string) *logrus.Logger {
 	return logger
 }
 
-func loadClusterConfig(filename, terraformOutputPath, credentialsFilePath string, logger logrus.FieldLogger) (*kubeoneapi.KubeOneCluster, error) {
-	cls, err := config.LoadKubeOneCluster(filename, terraformOutputPath, credentialsFilePath, logger)
+func loadClusterConfig(filename string, valuesFiles []string, terraformOutputPath, credentialsFilePath string, logger logrus.FieldLogger) (*kubeoneapi.KubeOneCluster, error) {
+	cls, err := config.LoadKubeOneCluster(filename, valuesFiles, terraformOutputPath, credentialsFilePath, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -228,6 +247,10 @@ func validateCredentials(s *state.State, credentialsFile string) error {
 		fallthrough
 	case ccmErr == nil && mcErr != nil && universalErr != nil: // CCM credentials found, but no MC or universal credentials
 		return fail.ConfigValidation(universalErr)
+	case mcErr != nil && universalErr == nil:
+		// AWS instance profile is used for CCM, but machine-controller still
+		// requires static credentials
+		return fail.ConfigValidation(mcErr)
 	default:
 		return nil
 	}
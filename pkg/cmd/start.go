@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"k8c.io/kubeone/pkg/tasks"
+)
+
+type startOpts struct {
+	globalOptions
+	AutoApprove bool `longflag:"auto-approve" shortflag:"y"`
+}
+
+func startCmd(rootFlags *pflag.FlagSet) *cobra.Command {
+	opts := &startOpts{}
+
+	cmd := &cobra.Command{
+		Use:   "start",
+		Short: "Start the cluster stopped by 'kubeone stop'",
+		Long: heredoc.Doc(`
+			This command starts the cluster stopped by the 'kubeone stop' command. The cluster is started in the
+			following order:
+
+			  * kubelet is started on all control plane nodes at once, since etcd needs the quorum of its members.
+			  * The command waits for kube-apiserver and etcd on all control plane nodes to become healthy.
+			  * kubelet is started on the static worker nodes.
+			  * The command waits for the control plane and static worker nodes to become ready.
+			  * The worker nodes cordoned by 'kubeone stop' are uncordoned.
+		`),
+		SilenceErrors: true,
+		Example:       `kubeone start -m mycluster.yaml -t terraformoutput.json`,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			gopts, err := persistentGlobalOptions(rootFlags)
+			if err != nil {
+				return err
+			}
+
+			opts.globalOptions = *gopts
+
+			return runStart(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(
+		&opts.AutoApprove,
+		longFlagName(opts, "AutoApprove"),
+		shortFlagName(opts, "AutoApprove"),
+		false,
+		"auto approve plan")
+
+	return cmd
+}
+
+func runStart(opts *startOpts) error {
+	s, err := opts.globalOptions.BuildState()
+	if err != nil {
+		return err
+	}
+
+	// The probes can't be run, since the control plane of the stopped cluster
+	// is not running
+	s.Logger.Warnln("This command will start the control plane and the static worker nodes of the cluster.")
+
+	confirm, err := confirmCommand(opts.AutoApprove)
+	if err != nil {
+		return err
+	}
+
+	if !confirm {
+		s.Logger.Println("Operation canceled.")
+
+		return nil
+	}
+
+	return tasks.WithClusterStart(nil).Run(s)
+}
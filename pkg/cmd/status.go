@@ -24,8 +24,15 @@ import (
 	"k8c.io/kubeone/pkg/tasks"
 )
 
+type statusOpts struct {
+	globalOptions
+	Drift bool `longflag:"drift"`
+}
+
 // statusCmd returns the structure for declaring the "status" subcommand.
 func statusCmd(rootFlags *pflag.FlagSet) *cobra.Command {
+	opts := &statusOpts{}
+
 	cmd := &cobra.Command{
 		Use:   "status",
 		Short: "Status of the cluster",
@@ -34,6 +41,11 @@ func statusCmd(rootFlags *pflag.FlagSet) *cobra.Command {
 
 			This command takes KubeOne manifest which contains information about hosts. It's possible to source information about
 			hosts from Terraform output, using the '--tfjson' flag.
+
+			With the '--drift' flag, the files managed by KubeOne on each node (the kubeadm configuration, the static pod
+			manifests, the containerd configuration and the kubelet configuration and flags) are compared with the hashes
+			recorded by the last 'kubeone apply', and the files modified, removed or added out of band are reported.
+			'kubeone apply --reconcile-drift' rewrites them.
 		`),
 		Example:       `kubeone status -m mycluster.yaml -t terraformoutput.json`,
 		SilenceErrors: true,
@@ -43,19 +55,31 @@ func statusCmd(rootFlags *pflag.FlagSet) *cobra.Command {
 				return err
 			}
 
-			return runStatus(gopts)
+			opts.globalOptions = *gopts
+
+			return runStatus(opts)
 		},
 	}
 
+	cmd.Flags().BoolVar(
+		&opts.Drift,
+		longFlagName(opts, "Drift"),
+		false,
+		"report the files managed by KubeOne that were changed out of band since the last apply")
+
 	return cmd
 }
 
 // runStatus gets cluster status
-func runStatus(opts *globalOptions) error {
+func runStatus(opts *statusOpts) error {
 	s, err := opts.BuildState()
 	if err != nil {
 		return err
 	}
 
+	if opts.Drift {
+		return tasks.WithConfigurationDrift(nil).Run(s)
+	}
+
 	return tasks.WithClusterStatus(nil).Run(s)
 }
@@ -0,0 +1,119 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/tasks"
+)
+
+type stopOpts struct {
+	globalOptions
+	AutoApprove bool `longflag:"auto-approve" shortflag:"y"`
+}
+
+func stopCmd(rootFlags *pflag.FlagSet) *cobra.Command {
+	opts := &stopOpts{}
+
+	cmd := &cobra.Command{
+		Use:   "stop",
+		Short: "Gracefully stop the cluster",
+		Long: heredoc.Doc(`
+			This command gracefully stops the cluster, e.g. before a planned power outage, so that the nodes can be
+			powered off. The cluster is stopped in the following order:
+
+			  * All worker nodes are cordoned, including the nodes managed by machine-controller.
+			  * kubelet and the workloads are stopped on the static worker nodes.
+			  * kubelet, the control plane components and etcd are stopped on the control plane nodes, one node
+			    at a time. etcd is stopped last on each node, so it can shut down cleanly.
+
+			kubelet is disabled on the stopped nodes, so the containers are not started again when a node is
+			booted. The cluster is started again using the 'kubeone start' command.
+
+			The nodes managed by machine-controller can't be stopped by KubeOne. They keep running cordoned,
+			and they are uncordoned by 'kubeone start'.
+		`),
+		SilenceErrors: true,
+		Example:       `kubeone stop -m mycluster.yaml -t terraformoutput.json`,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			gopts, err := persistentGlobalOptions(rootFlags)
+			if err != nil {
+				return err
+			}
+
+			opts.globalOptions = *gopts
+
+			return runStop(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(
+		&opts.AutoApprove,
+		longFlagName(opts, "AutoApprove"),
+		shortFlagName(opts, "AutoApprove"),
+		false,
+		"auto approve plan")
+
+	return cmd
+}
+
+func runStop(opts *stopOpts) error {
+	s, err := opts.globalOptions.BuildState()
+	if err != nil {
+		return err
+	}
+
+	probbing := tasks.WithHostnameOS(nil)
+	probbing = tasks.WithProbes(probbing)
+
+	if err = probbing.Run(s); err != nil {
+		return err
+	}
+
+	if !s.LiveCluster.IsProvisioned() {
+		return fail.RuntimeError{
+			Op:  "stopping cluster",
+			Err: errors.New("the target cluster is not provisioned"),
+		}
+	}
+
+	s.Logger.Warnln("This command will stop all workloads and the control plane of the cluster.")
+
+	confirm, err := confirmCommand(opts.AutoApprove)
+	if err != nil {
+		return err
+	}
+
+	if !confirm {
+		s.Logger.Println("Operation canceled.")
+
+		return nil
+	}
+
+	if err = tasks.WithClusterStop(nil).Run(s); err != nil {
+		return err
+	}
+
+	s.Logger.Infoln("The cluster is stopped, run 'kubeone start' to start it again.")
+
+	return nil
+}
@@ -39,6 +39,9 @@ func (opts *upgradeOpts) BuildState() (*state.State, error) {
 
 	s.ForceUpgrade = opts.ForceUpgrade
 	s.UpgradeMachineDeployments = opts.UpgradeMachineDeployments
+	s.Confirm = func() (bool, error) {
+		return confirmCommand(false)
+	}
 
 	return s, nil
 }
@@ -20,3 +20,8 @@ const (
 	DefaultContainerLogMaxFiles = 5
 	DefaultContainerLogMaxSize  = "100Mi"
 )
+
+const (
+	// nvidiaContainerRuntimeBinary is installed by the nvidia-container-toolkit
+	nvidiaContainerRuntimeBinary = "/usr/bin/nvidia-container-runtime"
+)
@@ -23,6 +23,7 @@ import (
 	"github.com/BurntSushi/toml"
 
 	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/certificate/cabundle"
 	"k8c.io/kubeone/pkg/fail"
 )
 
@@ -55,6 +56,11 @@ type containerdCRIRuncOptions struct {
 	SystemdCgroup bool
 }
 
+type containerdCRINvidiaOptions struct {
+	BinaryName    string
+	SystemdCgroup bool
+}
+
 type containerdCRIRegistry struct {
 	Mirrors map[string]containerdRegistryMirror `toml:"mirrors"`
 	Configs map[string]containerdRegistryConfig `toml:"configs"`
@@ -77,11 +83,12 @@ type containerdRegistryAuth struct {
 }
 
 type containerdRegistryTLSConfig struct {
-	InsecureSkipVerify bool `toml:"insecure_skip_verify"`
+	InsecureSkipVerify bool   `toml:"insecure_skip_verify"`
+	CAFile             string `toml:"ca_file,omitempty"`
 }
 
 func marshalContainerdConfig(cluster *kubeoneapi.KubeOneCluster) (string, error) {
-	sandboxImage, serr := cluster.Versions.SandboxImage(cluster.RegistryConfiguration.ImageRegistry)
+	sandboxImage, serr := cluster.SandboxImage()
 	if serr != nil {
 		return "", serr
 	}
@@ -93,7 +100,7 @@ func marshalContainerdConfig(cluster *kubeoneapi.KubeOneCluster) (string, error)
 				"runc": {
 					RuntimeType: "io.containerd.runc.v2",
 					Options: containerdCRIRuncOptions{
-						SystemdCgroup: true,
+						SystemdCgroup: cluster.Cgroups.SystemdCgroup(),
 					},
 				},
 			},
@@ -107,6 +114,16 @@ func marshalContainerdConfig(cluster *kubeoneapi.KubeOneCluster) (string, error)
 		},
 	}
 
+	if cluster.Features.NvidiaGPU != nil && cluster.Features.NvidiaGPU.Enable {
+		criPlugin.Containerd.Runtimes["nvidia"] = containerdCRIRuntime{
+			RuntimeType: "io.containerd.runc.v2",
+			Options: containerdCRINvidiaOptions{
+				BinaryName:    nvidiaContainerRuntimeBinary,
+				SystemdCgroup: cluster.Cgroups.SystemdCgroup(),
+			},
+		}
+	}
+
 	if cluster.RegistryConfiguration != nil {
 		insecureRegistry := cluster.RegistryConfiguration.InsecureRegistryAddress()
 		if insecureRegistry != "" {
@@ -135,6 +152,15 @@ func marshalContainerdConfig(cluster *kubeoneapi.KubeOneCluster) (string, error)
 				}
 			}
 
+			if cluster.AdditionalTrustedCAs != "" {
+				regConfig := criPlugin.Registry.Configs[registryName]
+				if regConfig.TLS == nil {
+					regConfig.TLS = &containerdRegistryTLSConfig{}
+				}
+				regConfig.TLS.CAFile = cabundle.TrustedCAsFilePath
+				criPlugin.Registry.Configs[registryName] = regConfig
+			}
+
 			if registry.Auth != nil {
 				regConfig := criPlugin.Registry.Configs[registryName]
 				regConfig.Auth = &containerdRegistryAuth{
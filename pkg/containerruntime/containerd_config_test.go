@@ -66,6 +66,29 @@ func Test_marshalContainerdConfig(t *testing.T) {
 				},
 			})),
 		},
+		{
+			name: "registry with additional trusted CAs",
+			cluster: genCluster(
+				withContainerdRegistry(map[string]kubeoneapi.ContainerdRegistry{
+					"registry.example.com": {
+						Mirrors: []string{"https://registry.example.com"},
+					},
+				}),
+				withAdditionalTrustedCAs("-----BEGIN CERTIFICATE-----"),
+			),
+		},
+		{
+			name:    "nvidia gpu",
+			cluster: genCluster(withNvidiaGPU()),
+		},
+		{
+			name:    "cgroupfs driver",
+			cluster: genCluster(withCgroupDriver(kubeoneapi.CgroupDriverCgroupfs)),
+		},
+		{
+			name:    "custom sandbox image",
+			cluster: genCluster(withSandboxImage("registry.example.com/k8s/pause:3.9")),
+		},
 	}
 
 	for _, tt := range tests {
@@ -112,3 +135,27 @@ func withContainerdRegistry(regCfg map[string]kubeoneapi.ContainerdRegistry) clu
 		cls.ContainerRuntime.Containerd.Registries = regCfg
 	}
 }
+
+func withNvidiaGPU() clusterOpts {
+	return func(cls *kubeoneapi.KubeOneCluster) {
+		cls.Features.NvidiaGPU = &kubeoneapi.NvidiaGPU{Enable: true}
+	}
+}
+
+func withAdditionalTrustedCAs(cas string) clusterOpts {
+	return func(cls *kubeoneapi.KubeOneCluster) {
+		cls.AdditionalTrustedCAs = cas
+	}
+}
+
+func withCgroupDriver(driver kubeoneapi.CgroupDriver) clusterOpts {
+	return func(cls *kubeoneapi.KubeOneCluster) {
+		cls.Cgroups.Driver = driver
+	}
+}
+
+func withSandboxImage(image string) clusterOpts {
+	return func(cls *kubeoneapi.KubeOneCluster) {
+		cls.ContainerRuntime.SandboxImage = image
+	}
+}
@@ -18,6 +18,7 @@ package containerruntime
 
 import (
 	"encoding/json"
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -42,7 +43,7 @@ func marshalDockerConfig(cluster *kubeoneapi.KubeOneCluster) (string, error) {
 	logSize = strings.ReplaceAll(logSize, "gi", "g")
 
 	cfg := dockerConfig{
-		ExecOpts:      []string{"native.cgroupdriver=systemd"},
+		ExecOpts:      []string{fmt.Sprintf("native.cgroupdriver=%s", cluster.Cgroups.CgroupDriver())},
 		StorageDriver: "overlay2",
 		LogDriver:     "json-file",
 		LogOpts: map[string]string{
@@ -38,6 +38,7 @@ func UpdateDataMap(cluster *kubeoneapi.KubeOneCluster, inputMap map[string]inter
 	inputMap["CONTAINER_RUNTIME_CONFIG_PATH"] = cluster.ContainerRuntime.ConfigPath()
 	inputMap["CONTAINER_RUNTIME_CONFIG"] = crConfig
 	inputMap["CONTAINER_RUNTIME_SOCKET"] = cluster.ContainerRuntime.CRISocket()
+	inputMap["CONTAINERD_VERSION"] = cluster.ContainerRuntime.ContainerdVersion()
 
 	return nil
 }
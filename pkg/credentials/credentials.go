@@ -17,6 +17,7 @@ limitations under the License.
 package credentials
 
 import (
+	"context"
 	"encoding/base64"
 	"os"
 	"strings"
@@ -27,16 +28,18 @@ import (
 
 	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
 	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/vault"
 )
 
 // Type is a type of credentials that should be fetched
 type Type string
 
 const (
-	TypeUniversal Type = ""
-	TypeCCM       Type = "CCM"
-	TypeMC        Type = "MC"
-	TypeOSM       Type = "OSM"
+	TypeUniversal  Type = ""
+	TypeCCM        Type = "CCM"
+	TypeMC         Type = "MC"
+	TypeOSM        Type = "OSM"
+	TypeEtcdBackup Type = "ETCD_BACKUP"
 )
 
 // The environment variable names with credential in them
@@ -51,6 +54,9 @@ const (
 	DigitalOceanTokenKey                 = "DIGITALOCEAN_TOKEN"
 	GoogleServiceAccountKey              = "GOOGLE_CREDENTIALS"
 	HetznerTokenKey                      = "HCLOUD_TOKEN"
+	HetznerRobotUser                     = "HETZNER_ROBOT_USER"
+	HetznerRobotPassword                 = "HETZNER_ROBOT_PASSWORD" //nolint:gosec
+	KubevirtKubeconfig                   = "KUBEVIRT_KUBECONFIG"
 	NutanixEndpoint                      = "NUTANIX_ENDPOINT"
 	NutanixPort                          = "NUTANIX_PORT"
 	NutanixUsername                      = "NUTANIX_USERNAME"
@@ -61,6 +67,12 @@ const (
 	NutanixPEEndpoint                    = "NUTANIX_PE_ENDPOINT"
 	NutanixPEUsername                    = "NUTANIX_PE_USERNAME"
 	NutanixPEPassword                    = "NUTANIX_PE_PASSWORD" //nolint:gosec
+	OCITenancyOCID                       = "OCI_TENANCY_OCID"
+	OCIUserOCID                          = "OCI_USER_OCID"
+	OCIFingerprint                       = "OCI_FINGERPRINT"
+	OCIPrivateKey                        = "OCI_PRIVATE_KEY"            //nolint:gosec
+	OCIPrivateKeyPassphrase              = "OCI_PRIVATE_KEY_PASSPHRASE" //nolint:gosec
+	OCIRegion                            = "OCI_REGION"
 	OpenStackAuthURL                     = "OS_AUTH_URL"
 	OpenStackDomainName                  = "OS_DOMAIN_NAME"
 	OpenStackPassword                    = "OS_PASSWORD"
@@ -86,6 +98,8 @@ const (
 	VMwareCloudDirectorURL          = "VCD_URL"
 	VMwareCloudDirectorVDC          = "VCD_VDC"
 	VMwareCloudDirectorSkipTLS      = "VCD_ALLOW_UNVERIFIED_SSL"
+	// Restic password used to encrypt etcd backups
+	ResticPassword = "RESTIC_PASSWORD" //nolint:gosec
 
 	// Variables that machine-controller expects
 	AzureClientIDMC           = "AZURE_CLIENT_ID"
@@ -95,6 +109,8 @@ const (
 	DigitalOceanTokenKeyMC    = "DO_TOKEN"
 	GoogleServiceAccountKeyMC = "GOOGLE_SERVICE_ACCOUNT"
 	HetznerTokenKeyMC         = "HZ_TOKEN"
+	HetznerRobotUserMC        = "ROBOT_USER"
+	HetznerRobotPasswordMC    = "ROBOT_PASSWORD" //nolint:gosec
 	OpenStackUserNameMC       = "OS_USER_NAME"
 	VSphereAddressMC          = "VSPHERE_ADDRESS"
 	VSphereUsernameMC         = "VSPHERE_USERNAME"
@@ -110,6 +126,9 @@ var allKeys = []string{
 	DigitalOceanTokenKey,
 	GoogleServiceAccountKey,
 	HetznerTokenKey,
+	HetznerRobotUser,
+	HetznerRobotPassword,
+	KubevirtKubeconfig,
 	NutanixEndpoint,
 	NutanixPort,
 	NutanixUsername,
@@ -120,6 +139,12 @@ var allKeys = []string{
 	NutanixPEEndpoint,
 	NutanixPEUsername,
 	NutanixPEPassword,
+	OCITenancyOCID,
+	OCIUserOCID,
+	OCIFingerprint,
+	OCIPrivateKey,
+	OCIPrivateKeyPassphrase,
+	OCIRegion,
 	OpenStackAuthURL,
 	OpenStackDomainName,
 	OpenStackPassword,
@@ -127,6 +152,8 @@ var allKeys = []string{
 	OpenStackTenantID,
 	OpenStackTenantName,
 	OpenStackUserName,
+	OpenStackApplicationCredentialID,
+	OpenStackApplicationCredentialSecret,
 	EquinixMetalAuthToken,
 	EquinixMetalProjectID,
 	PacketAPIKey,
@@ -176,6 +203,34 @@ func Any(credentialsFilePath string) (map[string]string, error) {
 	return creds, nil
 }
 
+// EtcdBackups returns credentials used by Restic to access and encrypt etcd
+// backups. ETCD_BACKUP_ prefixed variables take precedence over the
+// non-prefixed ones.
+func EtcdBackups(credentialsFilePath string) (map[string]string, error) {
+	credentialsFinderStore, err := newCredentialsFinder(withYAMLFile(credentialsFilePath), withType(TypeEtcdBackup))
+	if err != nil {
+		return nil, err
+	}
+
+	credentialsFinder := credentialsFinderStore.lookupFunc()
+
+	creds, err := credentialsFinder.aws()
+	if err != nil {
+		return nil, err
+	}
+
+	password := credentialsFinder(ResticPassword)
+	if password == "" {
+		return nil, fail.CredentialsError{
+			Op:  "lookup",
+			Err: errors.Errorf("etcd backups encryption password is required, set it in the %s_%s or the %s variable", TypeEtcdBackup, ResticPassword, ResticPassword),
+		}
+	}
+	creds[ResticPassword] = password
+
+	return creds, nil
+}
+
 // ProviderCredentials implements fetching credentials for each supported provider
 func ProviderCredentials(cloudProvider kubeoneapi.CloudProviderSpec, credentialsFilePath string, credentialsType Type) (map[string]string, error) {
 	credentialsFinderStore, err := newCredentialsFinder(withYAMLFile(credentialsFilePath), withType(credentialsType))
@@ -186,8 +241,25 @@ func ProviderCredentials(cloudProvider kubeoneapi.CloudProviderSpec, credentials
 	credentialsFinder := credentialsFinderStore.lookupFunc()
 	switch {
 	case cloudProvider.AWS != nil:
+		if cloudProvider.AWS.CredentialsMode == kubeoneapi.AWSCredentialsModeInstanceProfile &&
+			(credentialsType == TypeUniversal || credentialsType == TypeCCM) {
+			// CCM and CSI driver use the IAM instance profile attached to the
+			// nodes, so static keys are not needed
+			return map[string]string{}, nil
+		}
+
 		return credentialsFinder.aws()
 	case cloudProvider.Azure != nil:
+		if cloudProvider.Azure.CredentialsMode == kubeoneapi.AzureCredentialsModeManagedIdentity &&
+			(credentialsType == TypeUniversal || credentialsType == TypeCCM) {
+			// CCM and CSI drivers use the managed identity assigned to the
+			// VMs, so only the tenant and the subscription are needed
+			return credentialsFinder.parseCredentialVariables([]ProviderEnvironmentVariable{
+				{Name: AzureTenantID, MachineControllerName: AzureTenantIDMC},
+				{Name: AzureSubscriptionID, MachineControllerName: AzureSubscriptionIDMC},
+			}, defaultValidationFunc)
+		}
+
 		return credentialsFinder.parseCredentialVariables([]ProviderEnvironmentVariable{
 			{Name: AzureClientID, MachineControllerName: AzureClientIDMC},
 			{Name: AzureClientSecret, MachineControllerName: AzureClientSecretMC},
@@ -199,6 +271,13 @@ func ProviderCredentials(cloudProvider kubeoneapi.CloudProviderSpec, credentials
 			{Name: DigitalOceanTokenKey, MachineControllerName: DigitalOceanTokenKeyMC},
 		}, defaultValidationFunc)
 	case cloudProvider.GCE != nil:
+		if cloudProvider.GCE.CredentialsMode == kubeoneapi.GCECredentialsModeAttachedServiceAccount &&
+			(credentialsType == TypeUniversal || credentialsType == TypeCCM) {
+			// cloud controller and CSI driver use the service account
+			// attached to the instances, so the key is not needed
+			return map[string]string{}, nil
+		}
+
 		gsa, err := credentialsFinder.parseCredentialVariables([]ProviderEnvironmentVariable{
 			{Name: GoogleServiceAccountKey, MachineControllerName: GoogleServiceAccountKeyMC},
 		}, defaultValidationFunc)
@@ -214,8 +293,24 @@ func ProviderCredentials(cloudProvider kubeoneapi.CloudProviderSpec, credentials
 
 		return gsa, nil
 	case cloudProvider.Hetzner != nil:
-		return credentialsFinder.parseCredentialVariables([]ProviderEnvironmentVariable{
+		envVars := []ProviderEnvironmentVariable{
 			{Name: HetznerTokenKey, MachineControllerName: HetznerTokenKeyMC},
+		}
+		if cloudProvider.Hetzner.Robot {
+			// the Robot webservice credentials are used by the CCM to
+			// look up the dedicated servers
+			envVars = append(envVars,
+				ProviderEnvironmentVariable{Name: HetznerRobotUser, MachineControllerName: HetznerRobotUserMC},
+				ProviderEnvironmentVariable{Name: HetznerRobotPassword, MachineControllerName: HetznerRobotPasswordMC},
+			)
+		}
+
+		return credentialsFinder.parseCredentialVariables(envVars, defaultValidationFunc)
+	case cloudProvider.Kubevirt != nil:
+		// the kubeconfig of the infrastructure cluster is used by
+		// machine-controller, the KubeVirt CCM and the KubeVirt CSI driver
+		return credentialsFinder.parseCredentialVariables([]ProviderEnvironmentVariable{
+			{Name: KubevirtKubeconfig},
 		}, defaultValidationFunc)
 	case cloudProvider.Nutanix != nil:
 		return credentialsFinder.parseCredentialVariables([]ProviderEnvironmentVariable{
@@ -230,6 +325,15 @@ func ProviderCredentials(cloudProvider kubeoneapi.CloudProviderSpec, credentials
 			{Name: NutanixPEUsername},
 			{Name: NutanixPEPassword},
 		}, nutanixValidationFunc)
+	case cloudProvider.OCI != nil:
+		return credentialsFinder.parseCredentialVariables([]ProviderEnvironmentVariable{
+			{Name: OCITenancyOCID},
+			{Name: OCIUserOCID},
+			{Name: OCIFingerprint},
+			{Name: OCIPrivateKey},
+			{Name: OCIPrivateKeyPassphrase},
+			{Name: OCIRegion},
+		}, ociValidationFunc)
 	case cloudProvider.Openstack != nil:
 		return credentialsFinder.parseCredentialVariables([]ProviderEnvironmentVariable{
 			{Name: OpenStackAuthURL},
@@ -267,7 +371,9 @@ func ProviderCredentials(cloudProvider kubeoneapi.CloudProviderSpec, credentials
 		vscreds[VSphereAddressMC] = "https://" + vscreds[VSphereAddressMC]
 
 		return vscreds, nil
-	case cloudProvider.None != nil:
+	case cloudProvider.Proxmox != nil, cloudProvider.None != nil:
+		// Proxmox VE credentials are used only by Terraform, there's no
+		// component deployed by KubeOne that talks to the Proxmox VE API
 		return map[string]string{}, nil
 	}
 
@@ -278,15 +384,73 @@ func ProviderCredentials(cloudProvider kubeoneapi.CloudProviderSpec, credentials
 	}
 }
 
+// WorkersCredentials returns the credentials for the cluster cloud provider and the cloud providers of the worker
+// pools. Each provider uses its own environment variables, so the credentials of all providers are merged.
+func WorkersCredentials(cluster *kubeoneapi.KubeOneCluster, credentialsFilePath string, credentialsType Type) (map[string]string, error) {
+	creds, err := ProviderCredentials(cluster.CloudProvider, credentialsFilePath, credentialsType)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, workerset := range cluster.DynamicWorkers {
+		if workerset.CloudProvider == "" {
+			continue
+		}
+
+		cloudProvider, err := cluster.CloudProvider.WorkerCloudProvider(workerset)
+		if err != nil {
+			return nil, err
+		}
+
+		workerCreds, err := ProviderCredentials(cloudProvider, credentialsFilePath, credentialsType)
+		if err != nil {
+			return nil, err
+		}
+
+		for k, v := range workerCreds {
+			creds[k] = v
+		}
+	}
+
+	return creds, nil
+}
+
+// ReadFile returns the content of the credentials file. The credentials can
+// also be read from a secret stored in the KV secrets engine of HashiCorp
+// Vault, referenced in the vault://<path> format instead of the path to the
+// file, in which case the secret is returned encoded as the credentials file.
+func ReadFile(filePath string) ([]byte, error) {
+	if !vault.IsReference(filePath) {
+		buf, err := os.ReadFile(filePath)
+		if err != nil {
+			return nil, fail.Runtime(err, "reading credentials file")
+		}
+
+		return buf, nil
+	}
+
+	secret, err := vault.ReadSecret(context.Background(), filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	buf, err := yaml.Marshal(secret)
+	if err != nil {
+		return nil, fail.Runtime(err, "marshalling credentials from vault")
+	}
+
+	return buf, nil
+}
+
 func withYAMLFile(filePath string) func(*credentialsFinder) error {
 	return func(cf *credentialsFinder) error {
 		if filePath == "" {
 			return nil
 		}
 
-		buf, err := os.ReadFile(filePath)
+		buf, err := ReadFile(filePath)
 		if err != nil {
-			return fail.Runtime(err, "reading credentials file")
+			return err
 		}
 
 		if err = yaml.Unmarshal(buf, &cf.static); err != nil {
@@ -509,6 +673,28 @@ func nutanixValidationFunc(creds map[string]string) error {
 	return nil
 }
 
+func ociValidationFunc(creds map[string]string) error {
+	alwaysRequired := []string{
+		OCITenancyOCID,
+		OCIUserOCID,
+		OCIFingerprint,
+		OCIPrivateKey,
+		OCIRegion,
+	}
+
+	for _, key := range alwaysRequired {
+		if v, ok := creds[key]; !ok || len(v) == 0 {
+			return fail.CredentialsError{
+				Op:       "validating",
+				Provider: "OCI",
+				Err:      errors.Errorf("key %v is required but is not present", key),
+			}
+		}
+	}
+
+	return nil
+}
+
 func openstackValidationFunc(creds map[string]string) error {
 	alwaysRequired := []string{OpenStackAuthURL, OpenStackRegionName}
 
@@ -18,9 +18,14 @@ package credentials
 
 import (
 	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
 	"testing"
 
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
 	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/vault"
 )
 
 func TestOpenstackValidationFunc(t *testing.T) {
@@ -340,3 +345,200 @@ func TestCredentialsFinder(t *testing.T) {
 		})
 	}
 }
+
+func TestEtcdBackups(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name: "prefixed credentials take precedence",
+			env: map[string]string{
+				"AWS_ACCESS_KEY_ID":                 "aws-id",
+				"AWS_SECRET_ACCESS_KEY":             "aws-secret",
+				"ETCD_BACKUP_AWS_ACCESS_KEY_ID":     "backup-id",
+				"ETCD_BACKUP_AWS_SECRET_ACCESS_KEY": "backup-secret",
+				"ETCD_BACKUP_RESTIC_PASSWORD":       "backup-password",
+			},
+			want: map[string]string{
+				"AWS_ACCESS_KEY_ID":     "backup-id",
+				"AWS_SECRET_ACCESS_KEY": "backup-secret",
+				"RESTIC_PASSWORD":       "backup-password",
+			},
+		},
+		{
+			name: "fallback to non-prefixed credentials",
+			env: map[string]string{
+				"AWS_ACCESS_KEY_ID":     "aws-id",
+				"AWS_SECRET_ACCESS_KEY": "aws-secret",
+				"RESTIC_PASSWORD":       "password",
+			},
+			want: map[string]string{
+				"AWS_ACCESS_KEY_ID":     "aws-id",
+				"AWS_SECRET_ACCESS_KEY": "aws-secret",
+				"RESTIC_PASSWORD":       "password",
+			},
+		},
+		{
+			name: "missing password",
+			env: map[string]string{
+				"AWS_ACCESS_KEY_ID":     "aws-id",
+				"AWS_SECRET_ACCESS_KEY": "aws-secret",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tcase := range tests {
+		t.Run(tcase.name, func(t *testing.T) {
+			for _, key := range []string{
+				AWSAccessKeyID,
+				AWSSecretAccessKey,
+				ResticPassword,
+				"ETCD_BACKUP_" + AWSAccessKeyID,
+				"ETCD_BACKUP_" + AWSSecretAccessKey,
+				"ETCD_BACKUP_" + ResticPassword,
+			} {
+				t.Setenv(key, tcase.env[key])
+			}
+
+			got, err := EtcdBackups("")
+			if (err != nil) != tcase.wantErr {
+				t.Fatalf("EtcdBackups() error = %v, wantErr %v", err, tcase.wantErr)
+			}
+
+			if !tcase.wantErr && !reflect.DeepEqual(got, tcase.want) {
+				t.Errorf("EtcdBackups() = %v, want %v", got, tcase.want)
+			}
+		})
+	}
+}
+
+func TestProviderCredentialsAWSInstanceProfile(t *testing.T) {
+	cloudProvider := kubeoneapi.CloudProviderSpec{
+		AWS: &kubeoneapi.AWSSpec{
+			CredentialsMode: kubeoneapi.AWSCredentialsModeInstanceProfile,
+		},
+	}
+
+	tests := []struct {
+		name            string
+		credentialsType Type
+		env             map[string]string
+		want            map[string]string
+		wantErr         bool
+	}{
+		{
+			name:            "CCM credentials are not required",
+			credentialsType: TypeCCM,
+			want:            map[string]string{},
+		},
+		{
+			name:            "universal credentials are not required",
+			credentialsType: TypeUniversal,
+			env: map[string]string{
+				AWSAccessKeyID:     "aws-id",
+				AWSSecretAccessKey: "aws-secret",
+			},
+			want: map[string]string{},
+		},
+		{
+			name:            "machine-controller credentials are still required",
+			credentialsType: TypeMC,
+			wantErr:         true,
+		},
+		{
+			name:            "machine-controller credentials",
+			credentialsType: TypeMC,
+			env: map[string]string{
+				AWSAccessKeyID:     "aws-id",
+				AWSSecretAccessKey: "aws-secret",
+			},
+			want: map[string]string{
+				AWSAccessKeyID:     "aws-id",
+				AWSSecretAccessKey: "aws-secret",
+			},
+		},
+	}
+
+	for _, tcase := range tests {
+		t.Run(tcase.name, func(t *testing.T) {
+			for _, key := range []string{
+				AWSAccessKeyID,
+				AWSSecretAccessKey,
+				"MC_" + AWSAccessKeyID,
+				"MC_" + AWSSecretAccessKey,
+				"AWS_PROFILE",
+			} {
+				t.Setenv(key, tcase.env[key])
+			}
+
+			got, err := ProviderCredentials(cloudProvider, "", tcase.credentialsType)
+			if (err != nil) != tcase.wantErr {
+				t.Fatalf("ProviderCredentials() error = %v, wantErr %v", err, tcase.wantErr)
+			}
+
+			if !tcase.wantErr && !reflect.DeepEqual(got, tcase.want) {
+				t.Errorf("ProviderCredentials() = %v, want %v", got, tcase.want)
+			}
+		})
+	}
+}
+
+func TestProviderCredentialsGCEAttachedServiceAccount(t *testing.T) {
+	cloudProvider := kubeoneapi.CloudProviderSpec{
+		GCE: &kubeoneapi.GCESpec{
+			CredentialsMode: kubeoneapi.GCECredentialsModeAttachedServiceAccount,
+		},
+	}
+
+	t.Setenv(GoogleServiceAccountKey, "")
+
+	for _, credentialsType := range []Type{TypeUniversal, TypeCCM} {
+		got, err := ProviderCredentials(cloudProvider, "", credentialsType)
+		if err != nil {
+			t.Fatalf("ProviderCredentials(%q) unexpected error = %v", credentialsType, err)
+		}
+		if len(got) != 0 {
+			t.Errorf("ProviderCredentials(%q) = %v, want no credentials", credentialsType, got)
+		}
+	}
+
+	if _, err := ProviderCredentials(cloudProvider, "", TypeMC); err == nil {
+		t.Errorf("ProviderCredentials(%q) expected error for missing service account key", TypeMC)
+	}
+}
+
+func TestProviderCredentialsFromVault(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "s.token" || r.URL.Path != "/v1/secret/data/kubeone" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"data":{"data":{"HCLOUD_TOKEN":"vault-token","RESTIC_PASSWORD":"vault-password"},"metadata":{"version":3}}}`))
+	}))
+	defer server.Close()
+
+	t.Setenv(vault.AddressEnv, server.URL)
+	t.Setenv(vault.TokenEnv, "s.token")
+	t.Setenv(HetznerTokenKey, "env-token")
+
+	got, err := ProviderCredentials(kubeoneapi.CloudProviderSpec{Hetzner: &kubeoneapi.HetznerSpec{}}, "vault://secret/data/kubeone", TypeMC)
+	if err != nil {
+		t.Fatalf("ProviderCredentials() error = %v", err)
+	}
+
+	// the credentials read from Vault take precedence over the environment
+	want := map[string]string{HetznerTokenKeyMC: "vault-token"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ProviderCredentials() = %v, want %v", got, want)
+	}
+
+	if _, err = ProviderCredentials(kubeoneapi.CloudProviderSpec{Hetzner: &kubeoneapi.HetznerSpec{}}, "vault://secret/data/missing", TypeMC); err == nil {
+		t.Errorf("ProviderCredentials() succeeded for a missing secret")
+	}
+}
@@ -18,17 +18,20 @@ package credentials
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"sort"
 	"strings"
 	"text/template"
 
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
 	"k8c.io/kubeone/pkg/clientutil"
 	"k8c.io/kubeone/pkg/fail"
 	"k8c.io/kubeone/pkg/state"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
 )
 
 const (
@@ -56,8 +59,9 @@ const (
 
 // Ensure creates/updates the credentials secret
 func Ensure(s *state.State) error {
-	if s.Cluster.CloudProvider.None != nil {
-		s.Logger.Info("Skipping creating credentials secret because cloud provider is none.")
+	noProviderCredentials := s.Cluster.CloudProvider.None != nil || s.Cluster.CloudProvider.Proxmox != nil
+	if noProviderCredentials && len(s.Cluster.WorkerCloudProviders()) == 0 {
+		s.Logger.Infof("Skipping creating credentials secret because cloud provider is %s.", s.Cluster.CloudProvider.CloudProviderName())
 
 		return nil
 	}
@@ -88,7 +92,7 @@ func Ensure(s *state.State) error {
 	if s.Cluster.MachineController.Deploy {
 		s.Logger.Infoln("Creating machine-controller credentials secret...")
 
-		providerCreds, err := ProviderCredentials(s.Cluster.CloudProvider, s.CredentialsFilePath, TypeMC)
+		providerCreds, err := WorkersCredentials(s.Cluster, s.CredentialsFilePath, TypeMC)
 		if err != nil {
 			return err
 		}
@@ -100,7 +104,7 @@ func Ensure(s *state.State) error {
 	}
 
 	if s.Cluster.OperatingSystemManager.Deploy {
-		osmCreds, err := ProviderCredentials(s.Cluster.CloudProvider, s.CredentialsFilePath, TypeOSM)
+		osmCreds, err := WorkersCredentials(s.Cluster, s.CredentialsFilePath, TypeOSM)
 		if err != nil {
 			return err
 		}
@@ -122,6 +126,20 @@ func Ensure(s *state.State) error {
 			return err
 		}
 
+		if azure := s.Cluster.CloudProvider.Azure; azure != nil && azure.CredentialsMode == kubeoneapi.AzureCredentialsModeManagedIdentity {
+			cloudConfig, err = azureManagedIdentityCloudConfig(cloudConfig, azure)
+			if err != nil {
+				return err
+			}
+		}
+
+		if azure := s.Cluster.CloudProvider.Azure; azure != nil && azure.VMType != "" {
+			cloudConfig, err = azureVMTypeCloudConfig(cloudConfig, azure.VMType)
+			if err != nil {
+				return err
+			}
+		}
+
 		s.Cluster.CloudProvider.CloudConfig = cloudConfig
 
 		cloudCfgSecret := cloudConfigSecret(cloudConfig)
@@ -165,6 +183,39 @@ func renderCloudConfig(input string, credentials map[string]string) (string, err
 	return result.String(), fail.Config(err, "cloudConfig render")
 }
 
+// azureManagedIdentityCloudConfig configures the Azure cloud config to
+// authenticate using the managed identity instead of the client secret
+func azureManagedIdentityCloudConfig(cloudConfig string, azure *kubeoneapi.AzureSpec) (string, error) {
+	cfg := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(cloudConfig), &cfg); err != nil {
+		return "", fail.Config(err, "cloudConfig parsing")
+	}
+
+	delete(cfg, "aadClientId")
+	delete(cfg, "aadClientSecret")
+	cfg["useManagedIdentityExtension"] = true
+	cfg["userAssignedIdentityID"] = azure.UserAssignedIdentityID
+
+	buf, err := json.MarshalIndent(cfg, "", "    ")
+
+	return string(buf), fail.Config(err, "cloudConfig marshalling")
+}
+
+// azureVMTypeCloudConfig sets the type of the VMs backing the nodes in the
+// Azure cloud config
+func azureVMTypeCloudConfig(cloudConfig string, vmType kubeoneapi.AzureVMType) (string, error) {
+	cfg := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(cloudConfig), &cfg); err != nil {
+		return "", fail.Config(err, "cloudConfig parsing")
+	}
+
+	cfg["vmType"] = string(vmType)
+
+	buf, err := json.MarshalIndent(cfg, "", "    ")
+
+	return string(buf), fail.Config(err, "cloudConfig marshalling")
+}
+
 func EnvVarBindings(secretName string, creds map[string]string) []corev1.EnvVar {
 	var (
 		envVars   []corev1.EnvVar
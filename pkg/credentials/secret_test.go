@@ -16,7 +16,11 @@ limitations under the License.
 
 package credentials
 
-import "testing"
+import (
+	"testing"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+)
 
 func Test_renderCloudConfig(t *testing.T) {
 	tests := []struct {
@@ -54,3 +58,95 @@ func Test_renderCloudConfig(t *testing.T) {
 		})
 	}
 }
+
+func Test_azureManagedIdentityCloudConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		cloudConfig string
+		azure       *kubeoneapi.AzureSpec
+		want        string
+		wantErr     bool
+	}{
+		{
+			name:        "system-assigned identity",
+			cloudConfig: `{"tenantId": "tenant", "aadClientId": "id", "aadClientSecret": "secret", "useManagedIdentityExtension": false}`,
+			azure:       &kubeoneapi.AzureSpec{},
+			want: `{
+    "tenantId": "tenant",
+    "useManagedIdentityExtension": true,
+    "userAssignedIdentityID": ""
+}`,
+		},
+		{
+			name:        "user-assigned identity",
+			cloudConfig: "tenantId: tenant\nresourceGroup: rg\n",
+			azure:       &kubeoneapi.AzureSpec{UserAssignedIdentityID: "identity"},
+			want: `{
+    "resourceGroup": "rg",
+    "tenantId": "tenant",
+    "useManagedIdentityExtension": true,
+    "userAssignedIdentityID": "identity"
+}`,
+		},
+		{
+			name:        "broken cloud config",
+			cloudConfig: `{"tenantId": `,
+			azure:       &kubeoneapi.AzureSpec{},
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := azureManagedIdentityCloudConfig(tt.cloudConfig, tt.azure)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("azureManagedIdentityCloudConfig() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+			if got != tt.want {
+				t.Errorf("azureManagedIdentityCloudConfig() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_azureVMTypeCloudConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		cloudConfig string
+		vmType      kubeoneapi.AzureVMType
+		want        string
+		wantErr     bool
+	}{
+		{
+			name:        "vmss",
+			cloudConfig: `{"tenantId": "tenant", "vmType": "standard"}`,
+			vmType:      kubeoneapi.AzureVMTypeVMSS,
+			want: `{
+    "tenantId": "tenant",
+    "vmType": "vmss"
+}`,
+		},
+		{
+			name:        "broken cloud config",
+			cloudConfig: `{"tenantId": `,
+			vmType:      kubeoneapi.AzureVMTypeVMSS,
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := azureVMTypeCloudConfig(tt.cloudConfig, tt.vmType)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("azureVMTypeCloudConfig() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+			if got != tt.want {
+				t.Errorf("azureVMTypeCloudConfig() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ChangeType is the way a managed file was changed out of band
+type ChangeType string
+
+const (
+	ChangeModified ChangeType = "modified"
+	ChangeRemoved  ChangeType = "removed"
+	ChangeAdded    ChangeType = "added"
+)
+
+// Change is a managed file changed since its hash was recorded
+type Change struct {
+	Path string
+	Type ChangeType
+}
+
+func (c Change) String() string {
+	return fmt.Sprintf("%s %s", c.Type, c.Path)
+}
+
+// Compare returns the changes between the recorded and the current hashes of
+// the managed files, both in the sha256sum output format, sorted by the path
+func Compare(recorded, current string) []Change {
+	recordedHashes := parseHashes(recorded)
+	currentHashes := parseHashes(current)
+
+	var changes []Change
+
+	for path, hash := range recordedHashes {
+		currentHash, ok := currentHashes[path]
+		switch {
+		case !ok:
+			changes = append(changes, Change{Path: path, Type: ChangeRemoved})
+		case currentHash != hash:
+			changes = append(changes, Change{Path: path, Type: ChangeModified})
+		}
+	}
+
+	for path := range currentHashes {
+		if _, ok := recordedHashes[path]; !ok {
+			changes = append(changes, Change{Path: path, Type: ChangeAdded})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+
+	return changes
+}
+
+// parseHashes parses the "<hash>  <path>" lines printed by sha256sum
+func parseHashes(hashes string) map[string]string {
+	result := map[string]string{}
+
+	for _, line := range strings.Split(hashes, "\n") {
+		hash, path, ok := strings.Cut(strings.TrimSpace(line), " ")
+		if !ok {
+			continue
+		}
+
+		// the path is prefixed with '*' for the files read in binary mode
+		result[strings.TrimPrefix(strings.TrimSpace(path), "*")] = hash
+	}
+
+	return result
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+)
+
+func TestCompare(t *testing.T) {
+	recorded := heredoc.Doc(`
+		1111  /etc/containerd/config.toml
+		2222  /etc/kubernetes/manifests/kube-apiserver.yaml
+		3333  /etc/kubernetes/manifests/etcd.yaml
+		4444  /var/lib/kubelet/kubeadm-flags.env
+	`)
+
+	tests := []struct {
+		name    string
+		current string
+		want    []Change
+	}{
+		{
+			name:    "no drift",
+			current: recorded,
+		},
+		{
+			name: "modified, removed and added files",
+			current: heredoc.Doc(`
+				1111  /etc/containerd/config.toml
+				2223  /etc/kubernetes/manifests/kube-apiserver.yaml
+				4444  /var/lib/kubelet/kubeadm-flags.env
+				5555  /etc/kubernetes/manifests/debug.yaml
+			`),
+			want: []Change{
+				{Path: "/etc/kubernetes/manifests/debug.yaml", Type: ChangeAdded},
+				{Path: "/etc/kubernetes/manifests/etcd.yaml", Type: ChangeRemoved},
+				{Path: "/etc/kubernetes/manifests/kube-apiserver.yaml", Type: ChangeModified},
+			},
+		},
+		{
+			name: "binary mode",
+			current: heredoc.Doc(`
+				1111 */etc/containerd/config.toml
+				2222 */etc/kubernetes/manifests/kube-apiserver.yaml
+				3333 */etc/kubernetes/manifests/etcd.yaml
+				4444 */var/lib/kubelet/kubeadm-flags.env
+			`),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Compare(recorded, tt.current); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Compare() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"crypto/x509"
 	"fmt"
 	"io/fs"
+	"net"
 	"time"
 
 	clientv3 "go.etcd.io/etcd/client/v3"
@@ -52,15 +53,10 @@ func NewClientConfig(s *state.State, host kubeoneapi.HostConfig) (*clientv3.Conf
 		return nil, fail.Etcd(err, "TLS config creating")
 	}
 
-	var endpoints []string
-	if s.Cluster.ClusterNetwork.IPFamily.IsIPv6Primary() {
-		if len(host.IPv6Addresses) == 0 {
-			return nil, fmt.Errorf("no ipv6 addresses")
-		}
-		endpoints = []string{fmt.Sprintf("[%s]:2379", host.IPv6Addresses[0])}
-	} else {
-		endpoints = []string{fmt.Sprintf("%s:2379", host.PrivateAddress)}
+	if s.Cluster.ClusterNetwork.IPFamily.IsIPv6Primary() && len(host.IPv6Addresses) == 0 {
+		return nil, fmt.Errorf("no ipv6 addresses")
 	}
+	endpoints := []string{net.JoinHostPort(s.Cluster.ClusterNetwork.NodeIP(host), "2379")}
 
 	return &clientv3.Config{
 		Endpoints:   endpoints,
@@ -0,0 +1,118 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeconfig
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// unhealthyEndpointTimeout is the time for which an API endpoint that failed
+// to accept a connection is tried only after all other endpoints
+const unhealthyEndpointTimeout = 30 * time.Second
+
+type dialFunc func(ctx context.Context, network, address string) (net.Conn, error)
+
+// endpointFailover fails over the connections to the API endpoint between the
+// additional API endpoints. The endpoints are tried in order of their
+// priority, but the endpoints that recently failed to accept a connection are
+// tried last.
+type endpointFailover struct {
+	logger    logrus.FieldLogger
+	addresses []string
+	now       func() time.Time
+
+	lock     sync.Mutex
+	failedAt map[string]time.Time
+}
+
+func newEndpointFailover(logger logrus.FieldLogger, addresses []string) *endpointFailover {
+	return &endpointFailover{
+		logger:    logger,
+		addresses: addresses,
+		now:       time.Now,
+		failedAt:  map[string]time.Time{},
+	}
+}
+
+// dialer wraps the dial function, so the connections to the first address
+// fail over to the other addresses
+func (f *endpointFailover) dialer(dial dialFunc) dialFunc {
+	return func(ctx context.Context, network, address string) (net.Conn, error) {
+		if len(f.addresses) < 2 || address != f.addresses[0] {
+			return dial(ctx, network, address)
+		}
+
+		var errs []error
+		for _, addr := range f.candidates() {
+			conn, err := dial(ctx, network, addr)
+			if err == nil {
+				f.markHealthy(addr)
+
+				return conn, nil
+			}
+
+			f.markUnhealthy(addr, err)
+			errs = append(errs, err)
+		}
+
+		return nil, errors.Join(errs...)
+	}
+}
+
+// candidates returns the healthy addresses followed by the unhealthy ones,
+// both in order of their priority
+func (f *endpointFailover) candidates() []string {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	var healthy, unhealthy []string
+	for _, addr := range f.addresses {
+		if failedAt, ok := f.failedAt[addr]; ok && f.now().Sub(failedAt) < unhealthyEndpointTimeout {
+			unhealthy = append(unhealthy, addr)
+		} else {
+			healthy = append(healthy, addr)
+		}
+	}
+
+	return append(healthy, unhealthy...)
+}
+
+func (f *endpointFailover) markHealthy(addr string) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if _, ok := f.failedAt[addr]; ok {
+		f.logger.Infof("API endpoint %s is reachable again", addr)
+		delete(f.failedAt, addr)
+	}
+}
+
+func (f *endpointFailover) markUnhealthy(addr string, err error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if _, ok := f.failedAt[addr]; !ok {
+		f.logger.Warnf("API endpoint %s is not reachable, trying the other API endpoints: %v", addr, err)
+	}
+	f.failedAt[addr] = f.now()
+}
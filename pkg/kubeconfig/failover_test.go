@@ -0,0 +1,113 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeconfig
+
+import (
+	"context"
+	"errors"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+func TestEndpointFailoverDialer(t *testing.T) {
+	t.Parallel()
+
+	addresses := []string{"lb-a:6443", "lb-b:6443", "lb-c:6443"}
+
+	tests := []struct {
+		name        string
+		address     string
+		unreachable map[string]bool
+		failedAt    map[string]time.Duration
+		expected    []string
+		expectedErr bool
+	}{
+		{
+			name:     "API endpoint reachable",
+			address:  "lb-a:6443",
+			expected: []string{"lb-a:6443"},
+		},
+		{
+			name:        "fail over to the next endpoint",
+			address:     "lb-a:6443",
+			unreachable: map[string]bool{"lb-a:6443": true},
+			expected:    []string{"lb-a:6443", "lb-b:6443"},
+		},
+		{
+			name:     "recently failed endpoint is tried last",
+			address:  "lb-a:6443",
+			failedAt: map[string]time.Duration{"lb-a:6443": 10 * time.Second},
+			expected: []string{"lb-b:6443"},
+		},
+		{
+			name:     "endpoint is tried again after the timeout",
+			address:  "lb-a:6443",
+			failedAt: map[string]time.Duration{"lb-a:6443": time.Minute},
+			expected: []string{"lb-a:6443"},
+		},
+		{
+			name:        "all endpoints unreachable",
+			address:     "lb-a:6443",
+			unreachable: map[string]bool{"lb-a:6443": true, "lb-b:6443": true, "lb-c:6443": true},
+			expected:    []string{"lb-a:6443", "lb-b:6443", "lb-c:6443"},
+			expectedErr: true,
+		},
+		{
+			name:        "other addresses are not failed over",
+			address:     "10.0.0.1:443",
+			unreachable: map[string]bool{"10.0.0.1:443": true},
+			expected:    []string{"10.0.0.1:443"},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			now := time.Now()
+			failover := newEndpointFailover(logrus.New(), addresses)
+			failover.now = func() time.Time { return now }
+			for addr, ago := range tc.failedAt {
+				failover.failedAt[addr] = now.Add(-ago)
+			}
+
+			var dialed []string
+			dial := failover.dialer(func(_ context.Context, _, address string) (net.Conn, error) {
+				dialed = append(dialed, address)
+				if tc.unreachable[address] {
+					return nil, errors.New("connection refused")
+				}
+
+				return &net.TCPConn{}, nil
+			})
+
+			_, err := dial(context.Background(), "tcp", tc.address)
+			if (err != nil) != tc.expectedErr {
+				t.Errorf("expected error %v, but got %v", tc.expectedErr, err)
+			}
+			if !reflect.DeepEqual(dialed, tc.expected) {
+				t.Errorf("dialed %v, expected %v", dialed, tc.expected)
+			}
+		})
+	}
+}
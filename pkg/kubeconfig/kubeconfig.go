@@ -18,6 +18,7 @@ package kubeconfig
 
 import (
 	"context"
+	"fmt"
 	"io/fs"
 	"net"
 	"os"
@@ -46,7 +47,53 @@ func Download(s *state.State) ([]byte, error) {
 		return nil, err
 	}
 
-	return catKubernetesAdminConf(conn)
+	kubeconfig, err := catKubernetesAdminConf(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(s.Cluster.APIEndpoint.AdditionalEndpoints) == 0 {
+		return kubeconfig, nil
+	}
+
+	return addAdditionalEndpoints(kubeconfig, s.Cluster.APIEndpoint)
+}
+
+// addAdditionalEndpoints adds a cluster and a context for each additional API
+// endpoint to the kubeconfig. The current context is left unchanged.
+func addAdditionalEndpoints(kubeconfig []byte, endpoint kubeoneapi.APIEndpoint) ([]byte, error) {
+	config, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return nil, fail.KubeClient(err, "parsing kubeconfig")
+	}
+
+	currentContext, ok := config.Contexts[config.CurrentContext]
+	if !ok {
+		return nil, fail.KubeClient(fmt.Errorf("context %q not found", config.CurrentContext), "parsing kubeconfig")
+	}
+
+	currentCluster, ok := config.Clusters[currentContext.Cluster]
+	if !ok {
+		return nil, fail.KubeClient(fmt.Errorf("cluster %q not found", currentContext.Cluster), "parsing kubeconfig")
+	}
+
+	// the first address is the API endpoint itself
+	for _, address := range endpoint.Addresses()[1:] {
+		host, _, _ := net.SplitHostPort(address)
+
+		cluster := currentCluster.DeepCopy()
+		cluster.Server = fmt.Sprintf("https://%s", address)
+		clusterName := fmt.Sprintf("%s-%s", currentContext.Cluster, host)
+		config.Clusters[clusterName] = cluster
+
+		kubeContext := currentContext.DeepCopy()
+		kubeContext.Cluster = clusterName
+		config.Contexts[fmt.Sprintf("%s-%s", config.CurrentContext, host)] = kubeContext
+	}
+
+	kubeconfig, err = clientcmd.Write(*config)
+
+	return kubeconfig, fail.KubeClient(err, "writing kubeconfig")
 }
 
 func catKubernetesAdminConf(conn executor.Interface) ([]byte, error) {
@@ -87,13 +134,22 @@ func TunnelRestConfig(s *state.State, rc *rest.Config) error {
 		Deduplicate: true,
 	})
 
-	rc.Dial = func(ctx context.Context, network, address string) (net.Conn, error) {
+	rc.Dial = TunnelDialer(s)
+
+	return nil
+}
+
+// TunnelDialer returns the dial function tunneling the connections through a
+// random control plane host. The connections to the API endpoint fail over
+// between the additional API endpoints.
+func TunnelDialer(s *state.State) func(ctx context.Context, network, address string) (net.Conn, error) {
+	failover := newEndpointFailover(s.Logger, s.Cluster.APIEndpoint.Addresses())
+
+	return failover.dialer(func(ctx context.Context, network, address string) (net.Conn, error) {
 		dial := TunnelDialerFactory(s.Executor, s.Cluster.RandomHost())
 
 		return dial(ctx, network, address)
-	}
-
-	return nil
+	})
 }
 
 func TunnelDialerFactory(adapter executor.Adapter, host kubeoneapi.HostConfig) func(ctx context.Context, network, address string) (net.Conn, error) {
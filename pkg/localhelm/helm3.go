@@ -169,7 +169,9 @@ func Deploy(st *state.State) error {
 func releasesFilterFn(helmReleases []kubeoneapi.HelmRelease, logger logrus.FieldLogger) func(rel *helmrelease.Release) bool {
 	return func(rel *helmrelease.Release) bool {
 		for _, hr := range helmReleases {
-			if rel.Name == hr.ReleaseName && rel.Namespace == hr.Namespace && rel.Chart.Name() == hr.Chart {
+			// the release names are unique within a namespace, while the name of
+			// the chart doesn't have to match chart (e.g. for the OCI references)
+			if rel.Name == hr.ReleaseName && rel.Namespace == hr.Namespace {
 				return false
 			}
 		}
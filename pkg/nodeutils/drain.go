@@ -18,42 +18,81 @@ package nodeutils
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/sirupsen/logrus"
 
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
 	"k8c.io/kubeone/pkg/fail"
 
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/kubectl/pkg/drain"
 )
 
+// defaultDrainTimeout is used if the drain timeout is not configured. The
+// drain used to wait for the evictions indefinitely.
+const defaultDrainTimeout = 5 * time.Minute
+
 type Drainer interface {
 	Drain(ctx context.Context, nodeName string) error
 	Cordon(ctx context.Context, nodeName string, state bool) error
 }
 
-func NewDrainer(restconfig *rest.Config, logger logrus.FieldLogger) Drainer {
+func NewDrainer(restconfig *rest.Config, logger logrus.FieldLogger, config kubeoneapi.DrainConfig) Drainer {
 	return &drainer{
 		logger:     logger,
 		restconfig: restconfig,
+		config:     config,
 	}
 }
 
 type drainer struct {
 	logger     logrus.FieldLogger
 	restconfig *rest.Config
+	config     kubeoneapi.DrainConfig
 }
 
+// Drain evicts the pods from the node respecting their PodDisruptionBudgets.
+// The pods that couldn't be evicted within the timeout are deleted if the
+// force eviction is enabled, otherwise the drain fails listing them.
 func (dr *drainer) Drain(ctx context.Context, nodeName string) error {
 	drainerHelper, err := dr.drainHelper(ctx)
 	if err != nil {
 		return err
 	}
 
-	return fail.KubeClient(drain.RunNodeDrain(drainerHelper, nodeName), "draining %q node", nodeName)
+	drainErr := drain.RunNodeDrain(drainerHelper, nodeName)
+	if drainErr == nil {
+		return nil
+	}
+
+	blocked, err := blockingPods(ctx, drainerHelper, nodeName)
+	if err != nil {
+		dr.logger.Warnf("failed to find pods blocking the drain of %q node: %v", nodeName, err)
+	}
+	if len(blocked) == 0 {
+		return fail.KubeClient(drainErr, "draining %q node", nodeName)
+	}
+
+	if !dr.config.ForceEviction {
+		return fail.KubeClient(
+			fmt.Errorf("pods not evicted within %s: %s", drainerHelper.Timeout, strings.Join(blocked, ", ")),
+			"draining %q node", nodeName)
+	}
+
+	dr.logger.Warnf("Deleting pods not evicted within %s: %s", drainerHelper.Timeout, strings.Join(blocked, ", "))
+	drainerHelper.DisableEviction = true
+
+	return fail.KubeClient(drain.RunNodeDrain(drainerHelper, nodeName), "force draining %q node", nodeName)
 }
 
 func (dr *drainer) Cordon(ctx context.Context, nodeName string, desired bool) error {
@@ -81,6 +120,16 @@ func (dr *drainer) drainHelper(ctx context.Context) (*drain.Helper, error) {
 		return nil, fail.KubeClient(err, "initializing new kubernetes clientset")
 	}
 
+	timeout := defaultDrainTimeout
+	if dr.config.Timeout != nil {
+		timeout = dr.config.Timeout.Duration
+	}
+
+	skipFilter, err := skipPodsFilter(dr.config)
+	if err != nil {
+		return nil, err
+	}
+
 	return &drain.Helper{
 		Ctx:    ctx,
 		Client: kubeClinet,
@@ -90,6 +139,8 @@ func (dr *drainer) drainHelper(ctx context.Context) (*drain.Helper, error) {
 		GracePeriodSeconds:  -1,
 		IgnoreAllDaemonSets: true,
 		DeleteEmptyDirData:  true,
+		Timeout:             timeout,
+		AdditionalFilters:   []drain.PodFilter{skipFilter},
 		Out:                 loggerIoWriter(dr.logger.Infof),
 		ErrOut:              loggerIoWriter(dr.logger.Errorf),
 		OnPodDeletedOrEvicted: func(pod *corev1.Pod, usingEviction bool) {
@@ -102,6 +153,78 @@ func (dr *drainer) drainHelper(ctx context.Context) (*drain.Helper, error) {
 	}, nil
 }
 
+// skipPodsFilter skips the pods in the skipped namespaces and the pods
+// matching the skip selector
+func skipPodsFilter(config kubeoneapi.DrainConfig) (drain.PodFilter, error) {
+	namespaces := sets.New(config.SkipNamespaces...)
+
+	selector := labels.Nothing()
+	if config.SkipPodSelector != "" {
+		var err error
+		if selector, err = labels.Parse(config.SkipPodSelector); err != nil {
+			return nil, fail.Config(err, "parsing drain skip pod selector")
+		}
+	}
+
+	return func(pod corev1.Pod) drain.PodDeleteStatus {
+		if namespaces.Has(pod.Namespace) || selector.Matches(labels.Set(pod.Labels)) {
+			return drain.MakePodDeleteStatusSkip()
+		}
+
+		return drain.MakePodDeleteStatusOkay()
+	}, nil
+}
+
+// blockingPods returns the pods left on the node that are not being deleted,
+// along with the PodDisruptionBudgets not allowing their eviction
+func blockingPods(ctx context.Context, drainerHelper *drain.Helper, nodeName string) ([]string, error) {
+	podList, errs := drainerHelper.GetPodsForDeletion(nodeName)
+	if len(errs) > 0 {
+		return nil, fail.KubeClient(utilerrors.NewAggregate(errs), "listing pods on %q node", nodeName)
+	}
+
+	// PodDisruptionBudgets are listed once per namespace
+	namespacePDBs := map[string][]policyv1.PodDisruptionBudget{}
+
+	blocked := []string{}
+	for _, pod := range podList.Pods() {
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+
+		pdbs, ok := namespacePDBs[pod.Namespace]
+		if !ok {
+			pdbList, err := drainerHelper.Client.PolicyV1().PodDisruptionBudgets(pod.Namespace).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				return nil, fail.KubeClient(err, "listing PodDisruptionBudgets in %q namespace", pod.Namespace)
+			}
+			pdbs = pdbList.Items
+			namespacePDBs[pod.Namespace] = pdbs
+		}
+
+		blockedBy := []string{}
+		for _, pdb := range pdbs {
+			if pdb.Status.DisruptionsAllowed > 0 {
+				continue
+			}
+
+			selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+			if err != nil || !selector.Matches(labels.Set(pod.Labels)) {
+				continue
+			}
+			blockedBy = append(blockedBy, pdb.Name)
+		}
+
+		desc := pod.Namespace + "/" + pod.Name
+		if len(blockedBy) > 0 {
+			desc += fmt.Sprintf(" (PodDisruptionBudget %s)", strings.Join(blockedBy, ", "))
+		}
+		blocked = append(blocked, desc)
+	}
+
+	return blocked, nil
+}
+
 type loggerIoWriter func(format string, args ...interface{})
 
 func (lw loggerIoWriter) Write(p []byte) (n int, err error) {
@@ -0,0 +1,174 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package progress publishes the progress of the KubeOne operations to the
+// cluster, so it can be observed without the access to the CLI output. The
+// status of the running or the last operation is stored in the
+// kube-system/kubeone-operation-status ConfigMap, and an Event is emitted for
+// each task, involving that ConfigMap.
+//
+// Publishing is best-effort: the errors are logged, but they never fail the
+// operation.
+package progress
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8c.io/kubeone/pkg/clientutil"
+	"k8c.io/kubeone/pkg/state"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	dynclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// StatusConfigMapName is the name of the ConfigMap in the kube-system
+	// namespace with the status of the running or the last operation
+	StatusConfigMapName = "kubeone-operation-status"
+
+	StatusRunning   = "Running"
+	StatusSucceeded = "Succeeded"
+	StatusFailed    = "Failed"
+
+	ReasonTaskStarted        = "TaskStarted"
+	ReasonTaskSucceeded      = "TaskSucceeded"
+	ReasonTaskFailed         = "TaskFailed"
+	ReasonOperationSucceeded = "OperationSucceeded"
+	ReasonOperationFailed    = "OperationFailed"
+
+	// component is the source of the Events
+	component = "kubeone"
+
+	// publishTimeout limits how long publishing the progress can delay the
+	// operation, e.g. when the API server is restarted
+	publishTimeout = 10 * time.Second
+)
+
+// TaskStarted publishes that the task of the running operation started
+func TaskStarted(s *state.State, task string) {
+	publish(s, StatusRunning, task, "", corev1.EventTypeNormal, ReasonTaskStarted)
+}
+
+// TaskFinished publishes that the task of the running operation succeeded,
+// or failed with the given error
+func TaskFinished(s *state.State, task string, err error) {
+	if err != nil {
+		publish(s, StatusRunning, task, err.Error(), corev1.EventTypeWarning, ReasonTaskFailed)
+
+		return
+	}
+
+	publish(s, StatusRunning, task, "", corev1.EventTypeNormal, ReasonTaskSucceeded)
+}
+
+// OperationFinished publishes that the running operation succeeded, or failed
+// with the given error, and returns the error. Nothing is published if no
+// task of the operation was published, e.g. if the operation was canceled.
+func OperationFinished(s *state.State, err error) error {
+	if s == nil || s.Operation == "" || s.DynamicClient == nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), publishTimeout)
+	defer cancel()
+
+	cm := corev1.ConfigMap{}
+	key := dynclient.ObjectKey{Name: StatusConfigMapName, Namespace: metav1.NamespaceSystem}
+	if gerr := s.DynamicClient.Get(ctx, key, &cm); gerr != nil {
+		return err
+	}
+
+	if cm.Data["operation"] != s.Operation || cm.Data["status"] != StatusRunning {
+		return err
+	}
+
+	task := cm.Data["task"]
+	if err != nil {
+		publish(s, StatusFailed, task, err.Error(), corev1.EventTypeWarning, ReasonOperationFailed)
+
+		return err
+	}
+
+	publish(s, StatusSucceeded, task, "", corev1.EventTypeNormal, ReasonOperationSucceeded)
+
+	return nil
+}
+
+func publish(s *state.State, status, task, message, eventType, reason string) {
+	if s.Operation == "" || s.DynamicClient == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), publishTimeout)
+	defer cancel()
+
+	now := time.Now().UTC()
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      StatusConfigMapName,
+			Namespace: metav1.NamespaceSystem,
+		},
+		Data: map[string]string{
+			"operation": s.Operation,
+			"status":    status,
+			"task":      task,
+			"message":   message,
+			"updatedAt": now.Format(time.RFC3339),
+		},
+	}
+
+	if err := clientutil.CreateOrReplace(ctx, s.DynamicClient, cm); err != nil {
+		s.Logger.Debugf("Failed to publish the operation status: %v", err)
+
+		return
+	}
+
+	eventMessage := fmt.Sprintf("%s: %s", s.Operation, task)
+	if message != "" {
+		eventMessage = fmt.Sprintf("%s: %s", eventMessage, message)
+	}
+
+	event := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: StatusConfigMapName + ".",
+			Namespace:    metav1.NamespaceSystem,
+		},
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion:      "v1",
+			Kind:            "ConfigMap",
+			Name:            cm.Name,
+			Namespace:       cm.Namespace,
+			UID:             cm.UID,
+			ResourceVersion: cm.ResourceVersion,
+		},
+		Reason:         reason,
+		Message:        eventMessage,
+		Type:           eventType,
+		Action:         task,
+		Source:         corev1.EventSource{Component: component},
+		FirstTimestamp: metav1.NewTime(now),
+		LastTimestamp:  metav1.NewTime(now),
+		Count:          1,
+	}
+
+	if err := s.DynamicClient.Create(ctx, event); err != nil {
+		s.Logger.Debugf("Failed to publish the %s event: %v", reason, err)
+	}
+}
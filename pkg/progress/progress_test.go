@@ -0,0 +1,125 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package progress
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+
+	"k8c.io/kubeone/pkg/state"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	dynclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestPublish(t *testing.T) {
+	errTask := errors.New("task failed")
+
+	tests := []struct {
+		name       string
+		operation  string
+		taskErr    error
+		wantStatus string
+		wantEvents []string
+	}{
+		{
+			name:       "operation succeeded",
+			operation:  "apply",
+			wantStatus: StatusSucceeded,
+			wantEvents: []string{ReasonTaskStarted, ReasonTaskSucceeded, ReasonOperationSucceeded},
+		},
+		{
+			name:       "operation failed",
+			operation:  "apply",
+			taskErr:    errTask,
+			wantStatus: StatusFailed,
+			wantEvents: []string{ReasonTaskStarted, ReasonTaskFailed, ReasonOperationFailed},
+		},
+		{
+			name:    "operation not confirmed",
+			taskErr: errTask,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			client := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+			s := &state.State{
+				Logger:        logrus.New(),
+				DynamicClient: client,
+				Operation:     tt.operation,
+			}
+
+			TaskStarted(s, "upgrading leader control plane")
+			TaskFinished(s, "upgrading leader control plane", tt.taskErr)
+			if err := OperationFinished(s, tt.taskErr); !errors.Is(err, tt.taskErr) {
+				t.Fatalf("OperationFinished() = %v, want %v", err, tt.taskErr)
+			}
+
+			cm := corev1.ConfigMap{}
+			key := dynclient.ObjectKey{Name: StatusConfigMapName, Namespace: metav1.NamespaceSystem}
+			err := client.Get(context.Background(), key, &cm)
+			if tt.wantStatus == "" {
+				if err == nil {
+					t.Fatalf("status ConfigMap published for an operation that wasn't confirmed")
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("getting status ConfigMap: %v", err)
+			}
+
+			if cm.Data["status"] != tt.wantStatus {
+				t.Errorf("status = %q, want %q", cm.Data["status"], tt.wantStatus)
+			}
+			if cm.Data["task"] != "upgrading leader control plane" {
+				t.Errorf("task = %q, want the last task", cm.Data["task"])
+			}
+
+			events := corev1.EventList{}
+			if err = client.List(context.Background(), &events, dynclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+				t.Fatalf("listing events: %v", err)
+			}
+
+			reasons := []string{}
+			for _, event := range events.Items {
+				if event.InvolvedObject.Name != StatusConfigMapName {
+					t.Errorf("event %q involves %q, want %q", event.Reason, event.InvolvedObject.Name, StatusConfigMapName)
+				}
+				reasons = append(reasons, event.Reason)
+			}
+
+			// the events are listed sorted by the generated names
+			sort.Strings(reasons)
+			want := append([]string{}, tt.wantEvents...)
+			sort.Strings(want)
+			if !reflect.DeepEqual(reasons, want) {
+				t.Errorf("event reasons = %v, want %v", reasons, tt.wantEvents)
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package removedapis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Masterminds/semver/v3"
+
+	"k8c.io/kubeone/pkg/fail"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// RemovedAPI is an API version of a kind that is no longer served since the
+// given Kubernetes release
+type RemovedAPI struct {
+	APIVersion string
+	Kind       string
+	RemovedIn  *semver.Version
+
+	// Replacement is the API version to migrate to, or empty if the kind was
+	// removed without a replacement
+	Replacement string
+}
+
+// removedAPIs are the API versions removed from Kubernetes, as listed in the
+// deprecated API migration guide
+var removedAPIs = []RemovedAPI{
+	{APIVersion: "admissionregistration.k8s.io/v1beta1", Kind: "MutatingWebhookConfiguration", RemovedIn: semver.MustParse("1.22"), Replacement: "admissionregistration.k8s.io/v1"},
+	{APIVersion: "admissionregistration.k8s.io/v1beta1", Kind: "ValidatingWebhookConfiguration", RemovedIn: semver.MustParse("1.22"), Replacement: "admissionregistration.k8s.io/v1"},
+	{APIVersion: "apiextensions.k8s.io/v1beta1", Kind: "CustomResourceDefinition", RemovedIn: semver.MustParse("1.22"), Replacement: "apiextensions.k8s.io/v1"},
+	{APIVersion: "apiregistration.k8s.io/v1beta1", Kind: "APIService", RemovedIn: semver.MustParse("1.22"), Replacement: "apiregistration.k8s.io/v1"},
+	{APIVersion: "certificates.k8s.io/v1beta1", Kind: "CertificateSigningRequest", RemovedIn: semver.MustParse("1.22"), Replacement: "certificates.k8s.io/v1"},
+	{APIVersion: "coordination.k8s.io/v1beta1", Kind: "Lease", RemovedIn: semver.MustParse("1.22"), Replacement: "coordination.k8s.io/v1"},
+	{APIVersion: "extensions/v1beta1", Kind: "Ingress", RemovedIn: semver.MustParse("1.22"), Replacement: "networking.k8s.io/v1"},
+	{APIVersion: "networking.k8s.io/v1beta1", Kind: "Ingress", RemovedIn: semver.MustParse("1.22"), Replacement: "networking.k8s.io/v1"},
+	{APIVersion: "networking.k8s.io/v1beta1", Kind: "IngressClass", RemovedIn: semver.MustParse("1.22"), Replacement: "networking.k8s.io/v1"},
+	{APIVersion: "rbac.authorization.k8s.io/v1beta1", Kind: "ClusterRole", RemovedIn: semver.MustParse("1.22"), Replacement: "rbac.authorization.k8s.io/v1"},
+	{APIVersion: "rbac.authorization.k8s.io/v1beta1", Kind: "ClusterRoleBinding", RemovedIn: semver.MustParse("1.22"), Replacement: "rbac.authorization.k8s.io/v1"},
+	{APIVersion: "rbac.authorization.k8s.io/v1beta1", Kind: "Role", RemovedIn: semver.MustParse("1.22"), Replacement: "rbac.authorization.k8s.io/v1"},
+	{APIVersion: "rbac.authorization.k8s.io/v1beta1", Kind: "RoleBinding", RemovedIn: semver.MustParse("1.22"), Replacement: "rbac.authorization.k8s.io/v1"},
+	{APIVersion: "scheduling.k8s.io/v1beta1", Kind: "PriorityClass", RemovedIn: semver.MustParse("1.22"), Replacement: "scheduling.k8s.io/v1"},
+	{APIVersion: "storage.k8s.io/v1beta1", Kind: "CSIDriver", RemovedIn: semver.MustParse("1.22"), Replacement: "storage.k8s.io/v1"},
+	{APIVersion: "storage.k8s.io/v1beta1", Kind: "CSINode", RemovedIn: semver.MustParse("1.22"), Replacement: "storage.k8s.io/v1"},
+	{APIVersion: "storage.k8s.io/v1beta1", Kind: "StorageClass", RemovedIn: semver.MustParse("1.22"), Replacement: "storage.k8s.io/v1"},
+	{APIVersion: "storage.k8s.io/v1beta1", Kind: "VolumeAttachment", RemovedIn: semver.MustParse("1.22"), Replacement: "storage.k8s.io/v1"},
+	{APIVersion: "batch/v1beta1", Kind: "CronJob", RemovedIn: semver.MustParse("1.25"), Replacement: "batch/v1"},
+	{APIVersion: "discovery.k8s.io/v1beta1", Kind: "EndpointSlice", RemovedIn: semver.MustParse("1.25"), Replacement: "discovery.k8s.io/v1"},
+	{APIVersion: "autoscaling/v2beta1", Kind: "HorizontalPodAutoscaler", RemovedIn: semver.MustParse("1.25"), Replacement: "autoscaling/v2"},
+	{APIVersion: "node.k8s.io/v1beta1", Kind: "RuntimeClass", RemovedIn: semver.MustParse("1.25"), Replacement: "node.k8s.io/v1"},
+	{APIVersion: "policy/v1beta1", Kind: "PodDisruptionBudget", RemovedIn: semver.MustParse("1.25"), Replacement: "policy/v1"},
+	{APIVersion: "policy/v1beta1", Kind: "PodSecurityPolicy", RemovedIn: semver.MustParse("1.25")},
+	{APIVersion: "autoscaling/v2beta2", Kind: "HorizontalPodAutoscaler", RemovedIn: semver.MustParse("1.26"), Replacement: "autoscaling/v2"},
+	{APIVersion: "flowcontrol.apiserver.k8s.io/v1beta1", Kind: "FlowSchema", RemovedIn: semver.MustParse("1.26"), Replacement: "flowcontrol.apiserver.k8s.io/v1beta3"},
+	{APIVersion: "flowcontrol.apiserver.k8s.io/v1beta1", Kind: "PriorityLevelConfiguration", RemovedIn: semver.MustParse("1.26"), Replacement: "flowcontrol.apiserver.k8s.io/v1beta3"},
+	{APIVersion: "storage.k8s.io/v1beta1", Kind: "CSIStorageCapacity", RemovedIn: semver.MustParse("1.27"), Replacement: "storage.k8s.io/v1"},
+	{APIVersion: "flowcontrol.apiserver.k8s.io/v1beta2", Kind: "FlowSchema", RemovedIn: semver.MustParse("1.29"), Replacement: "flowcontrol.apiserver.k8s.io/v1"},
+	{APIVersion: "flowcontrol.apiserver.k8s.io/v1beta2", Kind: "PriorityLevelConfiguration", RemovedIn: semver.MustParse("1.29"), Replacement: "flowcontrol.apiserver.k8s.io/v1"},
+}
+
+// Between returns the APIs removed in the Kubernetes releases newer than the
+// minor version of from, up to and including the minor version of to
+func Between(from, to *semver.Version) []RemovedAPI {
+	var apis []RemovedAPI
+
+	for _, api := range removedAPIs {
+		if minorGreater(api.RemovedIn, from) && !minorGreater(api.RemovedIn, to) {
+			apis = append(apis, api)
+		}
+	}
+
+	return apis
+}
+
+// Find returns the removed API of the given API version and kind, if it's
+// removed after from, up to and including to
+func Find(apiVersion, kind string, from, to *semver.Version) (RemovedAPI, bool) {
+	for _, api := range Between(from, to) {
+		if api.APIVersion == apiVersion && api.Kind == kind {
+			return api, true
+		}
+	}
+
+	return RemovedAPI{}, false
+}
+
+func minorGreater(a, b *semver.Version) bool {
+	return a.Major() > b.Major() || (a.Major() == b.Major() && a.Minor() > b.Minor())
+}
+
+// Finding is an object using a removed API
+type Finding struct {
+	RemovedAPI
+
+	Namespace string
+	Name      string
+
+	// Source describes where the removed API is used
+	Source string
+}
+
+func (f Finding) String() string {
+	name := f.Name
+	if f.Namespace != "" {
+		name = f.Namespace + "/" + f.Name
+	}
+
+	replacement := "no replacement available"
+	if f.Replacement != "" {
+		replacement = "migrate to " + f.Replacement
+	}
+
+	return fmt.Sprintf("%s %s (%s): %s is removed in %d.%d, %s",
+		f.Kind, name, f.Source, f.APIVersion, f.RemovedIn.Major(), f.RemovedIn.Minor(), replacement)
+}
+
+// ScanCluster finds the objects in the cluster that were last written using
+// the APIs removed after from, up to and including to. The objects are listed
+// using the API versions served by the cluster, while the removed API versions
+// are taken from the managed fields and the last applied configuration, which
+// are recorded in the API versions the clients used. Those clients, such as
+// controllers, Helm charts and CI pipelines, have to be migrated before the
+// upgrade.
+func ScanCluster(ctx context.Context, client dynclient.Client, from, to *semver.Version) ([]Finding, error) {
+	var findings []Finding
+
+	for _, api := range Between(from, to) {
+		if api.Replacement == "" {
+			// the objects can't be listed anymore
+			continue
+		}
+
+		groupKind := schema.FromAPIVersionAndKind(api.Replacement, api.Kind).GroupKind()
+		mapping, err := client.RESTMapper().RESTMapping(groupKind)
+		if err != nil {
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+
+			return nil, fail.KubeClient(err, "getting REST mapping of %s", groupKind)
+		}
+
+		list := &metav1unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(mapping.GroupVersionKind.GroupVersion().WithKind(api.Kind + "List"))
+		if err = client.List(ctx, list); err != nil {
+			return nil, fail.KubeClient(err, "listing %s", groupKind)
+		}
+
+		for _, obj := range list.Items {
+			if source, ok := removedAPIUsage(obj, api.APIVersion); ok {
+				findings = append(findings, Finding{
+					RemovedAPI: api,
+					Namespace:  obj.GetNamespace(),
+					Name:       obj.GetName(),
+					Source:     source,
+				})
+			}
+		}
+	}
+
+	return findings, nil
+}
+
+// removedAPIUsage returns who wrote the object using the given API version
+func removedAPIUsage(obj metav1unstructured.Unstructured, apiVersion string) (string, bool) {
+	for _, entry := range obj.GetManagedFields() {
+		if entry.APIVersion == apiVersion {
+			return fmt.Sprintf("written by %q", entry.Manager), true
+		}
+	}
+
+	lastApplied, ok := obj.GetAnnotations()[corev1.LastAppliedConfigAnnotation]
+	if !ok {
+		return "", false
+	}
+
+	var typeMeta struct {
+		APIVersion string `json:"apiVersion"`
+	}
+	if err := json.Unmarshal([]byte(lastApplied), &typeMeta); err == nil && typeMeta.APIVersion == apiVersion {
+		return "last applied with kubectl", true
+	}
+
+	return "", false
+}
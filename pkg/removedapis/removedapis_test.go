@@ -0,0 +1,154 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package removedapis
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestFind(t *testing.T) {
+	tests := []struct {
+		name       string
+		apiVersion string
+		kind       string
+		from       string
+		to         string
+		want       bool
+	}{
+		{
+			name:       "removed in the target release",
+			apiVersion: "flowcontrol.apiserver.k8s.io/v1beta1",
+			kind:       "FlowSchema",
+			from:       "1.25.16",
+			to:         "1.26.11",
+			want:       true,
+		},
+		{
+			name:       "removed in an intermediate release",
+			apiVersion: "batch/v1beta1",
+			kind:       "CronJob",
+			from:       "1.24.17",
+			to:         "1.26.11",
+			want:       true,
+		},
+		{
+			name:       "removed in the current release",
+			apiVersion: "batch/v1beta1",
+			kind:       "CronJob",
+			from:       "1.25.0",
+			to:         "1.26.11",
+			want:       false,
+		},
+		{
+			name:       "removed after the target release",
+			apiVersion: "flowcontrol.apiserver.k8s.io/v1beta2",
+			kind:       "FlowSchema",
+			from:       "1.27.8",
+			to:         "1.28.4",
+			want:       false,
+		},
+		{
+			name:       "served API",
+			apiVersion: "apps/v1",
+			kind:       "Deployment",
+			from:       "1.21.0",
+			to:         "1.28.4",
+			want:       false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			_, got := Find(tt.apiVersion, tt.kind, semver.MustParse(tt.from), semver.MustParse(tt.to))
+			if got != tt.want {
+				t.Errorf("Find() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScanCluster(t *testing.T) {
+	mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{networkingv1.SchemeGroupVersion, policyv1.SchemeGroupVersion})
+	mapper.Add(networkingv1.SchemeGroupVersion.WithKind("Ingress"), meta.RESTScopeNamespace)
+	mapper.Add(policyv1.SchemeGroupVersion.WithKind("PodDisruptionBudget"), meta.RESTScopeNamespace)
+
+	client := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithRESTMapper(mapper).
+		WithObjects(
+			&networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "legacy",
+					ManagedFields: []metav1.ManagedFieldsEntry{
+						{Manager: "helm", APIVersion: "networking.k8s.io/v1beta1", Operation: metav1.ManagedFieldsOperationUpdate},
+					},
+				},
+			},
+			&networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "migrated",
+					ManagedFields: []metav1.ManagedFieldsEntry{
+						{Manager: "helm", APIVersion: "networking.k8s.io/v1", Operation: metav1.ManagedFieldsOperationUpdate},
+					},
+				},
+			},
+			&policyv1.PodDisruptionBudget{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "kube-system",
+					Name:      "coredns",
+					Annotations: map[string]string{
+						corev1.LastAppliedConfigAnnotation: `{"apiVersion":"policy/v1beta1","kind":"PodDisruptionBudget"}`,
+					},
+				},
+			},
+		).
+		Build()
+
+	findings, err := ScanCluster(context.Background(), client, semver.MustParse("1.21.14"), semver.MustParse("1.25.16"))
+	if err != nil {
+		t.Fatalf("ScanCluster() error = %v", err)
+	}
+
+	var got []string
+	for _, finding := range findings {
+		got = append(got, finding.String())
+	}
+
+	want := []string{
+		`Ingress default/legacy (written by "helm"): networking.k8s.io/v1beta1 is removed in 1.22, migrate to networking.k8s.io/v1`,
+		`PodDisruptionBudget kube-system/coredns (last applied with kubectl): policy/v1beta1 is removed in 1.25, migrate to policy/v1`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ScanCluster() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scripts
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+
+	"k8c.io/kubeone/pkg/fail"
+)
+
+// CARotationDir is the directory on the nodes holding the old and the new
+// cluster CA while the CA is rotated
+const CARotationDir = "/etc/kubernetes/pki/ca-rotation"
+
+var (
+	caRotationInstallCATemplate = heredoc.Doc(`
+		pki=/etc/kubernetes/pki
+		dir={{ .ROTATION_DIR }}
+
+		# the certificates signed by any of the bundled CAs are trusted, and
+		# kubeadm and kube-controller-manager sign with the first one
+		sudo cat{{ range .CERTS }} "$dir/{{ . }}"{{ end }} | sudo tee "$pki/ca.crt.new" >/dev/null
+		sudo chmod 600 "$pki/ca.crt.new"
+		sudo mv "$pki/ca.crt.new" "$pki/ca.crt"
+		{{- if .KEY }}
+		sudo install -m 0600 "$dir/{{ .KEY }}" "$pki/ca.key"
+		{{- end }}
+		{{- if .RENEW_CERTS }}
+
+		sudo kubeadm {{ .VERBOSE }} certs renew all
+		{{- end }}
+
+		# the kubeconfig files embed the CA certificates, so they have to trust
+		# the same CAs as the node
+		for kubeconfig in admin.conf super-admin.conf controller-manager.conf scheduler.conf kubelet.conf; do
+			sudo test -f "/etc/kubernetes/$kubeconfig" || continue
+			for cluster in $(sudo kubectl config get-clusters --kubeconfig="/etc/kubernetes/$kubeconfig" | tail -n +2); do
+				sudo kubectl config set-cluster "$cluster" \
+					--kubeconfig="/etc/kubernetes/$kubeconfig" \
+					--certificate-authority="$pki/ca.crt" \
+					--embed-certs=true >/dev/null
+			done
+		done
+
+		sudo systemctl restart kubelet
+		{{- if .CONTROL_PLANE }}
+
+		# the control plane components load the CA certificates and their
+		# kubeconfig files on start, and kubelet starts them again once stopped
+		for component in kube-apiserver kube-controller-manager kube-scheduler; do
+			for id in $(sudo crictl ps --name="^${component}\$" -q); do
+				sudo crictl stop "$id"
+			done
+		done
+		{{- end }}
+	`)
+
+	caRotationBootstrapTokenTemplate = heredoc.Doc(`
+		# the token is created again if the task is retried
+		sudo kubeadm token delete {{ .TOKEN }} >/dev/null 2>&1 || true
+		sudo kubeadm {{ .VERBOSE }} token create {{ .TOKEN }} --ttl {{ .TOKEN_DURATION }}
+	`)
+
+	caRotationBootstrapKubeletTemplate = heredoc.Doc(`
+		kubeconfig=/etc/kubernetes/kubelet.conf
+		bootstrap=/etc/kubernetes/bootstrap-kubelet.conf
+		pki=/var/lib/kubelet/pki
+		backup={{ .ROTATION_DIR }}/kubelet
+
+		sudo mkdir -p "$backup"
+		# kubelet.conf is missing if a previous run failed while kubelet was
+		# bootstrapping, in which case its backup is used
+		if sudo test -f "$kubeconfig"; then
+			sudo cp "$kubeconfig" "$backup/kubelet.conf"
+		fi
+		server=$(sudo kubectl config view --raw --kubeconfig="$backup/kubelet.conf" \
+			-o jsonpath='{.clusters[0].cluster.server}')
+
+		sudo rm -f "$bootstrap"
+		sudo kubectl config set-cluster default-cluster \
+			--kubeconfig="$bootstrap" \
+			--server="$server" \
+			--certificate-authority=/etc/kubernetes/pki/ca.crt \
+			--embed-certs=true >/dev/null
+		sudo kubectl config set-credentials tls-bootstrap-token-user \
+			--kubeconfig="$bootstrap" \
+			--token={{ .TOKEN }} >/dev/null
+		sudo kubectl config set-context tls-bootstrap-token-user@kubernetes \
+			--kubeconfig="$bootstrap" \
+			--cluster=default-cluster \
+			--user=tls-bootstrap-token-user >/dev/null
+		sudo kubectl config use-context tls-bootstrap-token-user@kubernetes \
+			--kubeconfig="$bootstrap" >/dev/null
+		sudo chmod 600 "$bootstrap"
+
+		# without kubelet.conf, kubelet bootstraps a new client certificate,
+		# and it requests a new serving certificate without the old one
+		sudo systemctl stop kubelet
+		sudo rm -f "$kubeconfig"
+		sudo find "$pki" -maxdepth 1 \( -name 'kubelet-client-*' -o -name 'kubelet-server-*' \) \
+			-exec mv -f -t "$backup" {} +
+		sudo systemctl start kubelet
+
+		for i in $(seq 60); do
+			sudo test -f "$kubeconfig" && break
+			sleep 5
+		done
+
+		if ! sudo test -f "$kubeconfig"; then
+			# the old certificates are restored, so the node keeps working
+			sudo systemctl stop kubelet
+			sudo find "$backup" -maxdepth 1 -name 'kubelet-*' -exec mv -f -t "$pki" {} +
+			sudo cp "$backup/kubelet.conf" "$kubeconfig"
+			sudo rm -f "$bootstrap"
+			sudo systemctl start kubelet
+			echo "kubelet failed to bootstrap a new client certificate" >&2
+			exit 1
+		fi
+
+		sudo rm -rf "$bootstrap" "$backup"
+	`)
+
+	caRotationCleanupTemplate = heredoc.Doc(`
+		sudo rm -rf {{ .ROTATION_DIR }}
+	`)
+)
+
+// CARotationParams are parameters used to install the cluster CA certificates
+// on a node while the CA is rotated
+type CARotationParams struct {
+	// Certs are the names of the CA certificates in CARotationDir bundled
+	// into ca.crt, the first one is used to sign the certificates
+	Certs []string
+	// Key is the name of the CA key in CARotationDir installed as ca.key,
+	// matching the first of the Certs. The key is installed only on the
+	// control plane nodes.
+	Key string
+	// RenewCerts renews all certificates signed by the cluster CA with kubeadm
+	RenewCerts bool
+	// ControlPlane restarts the control plane components
+	ControlPlane bool
+	// VerboseFlag is passed to kubeadm
+	VerboseFlag string
+}
+
+// CARotationInstallCA installs the bundle of the CA certificates as the
+// cluster CA, updates the kubeconfig files to trust it, and restarts kubelet
+// and the control plane components to load it
+func CARotationInstallCA(params CARotationParams) (string, error) {
+	result, err := Render(caRotationInstallCATemplate, Data{
+		"ROTATION_DIR":  CARotationDir,
+		"CERTS":         params.Certs,
+		"KEY":           params.Key,
+		"RENEW_CERTS":   params.RenewCerts,
+		"CONTROL_PLANE": params.ControlPlane,
+		"VERBOSE":       params.VerboseFlag,
+	})
+
+	return result, fail.Runtime(err, "rendering caRotationInstallCATemplate script")
+}
+
+// CARotationBootstrapToken creates the bootstrap token used by kubelet to
+// bootstrap its new client certificate
+func CARotationBootstrapToken(token, tokenTTL, verboseFlag string) (string, error) {
+	result, err := Render(caRotationBootstrapTokenTemplate, Data{
+		"TOKEN":          token,
+		"TOKEN_DURATION": tokenTTL,
+		"VERBOSE":        verboseFlag,
+	})
+
+	return result, fail.Runtime(err, "rendering caRotationBootstrapTokenTemplate script")
+}
+
+// CARotationBootstrapKubelet makes kubelet bootstrap a new client
+// certificate using the bootstrap token, and request a new serving
+// certificate. The old certificates are restored if kubelet fails to
+// bootstrap.
+func CARotationBootstrapKubelet(token string) (string, error) {
+	result, err := Render(caRotationBootstrapKubeletTemplate, Data{
+		"ROTATION_DIR": CARotationDir,
+		"TOKEN":        token,
+	})
+
+	return result, fail.Runtime(err, "rendering caRotationBootstrapKubeletTemplate script")
+}
+
+// CARotationCleanup removes CARotationDir once the CA is rotated
+func CARotationCleanup() (string, error) {
+	result, err := Render(caRotationCleanupTemplate, Data{
+		"ROTATION_DIR": CARotationDir,
+	})
+
+	return result, fail.Runtime(err, "rendering caRotationCleanupTemplate script")
+}
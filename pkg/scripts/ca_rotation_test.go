@@ -0,0 +1,78 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scripts
+
+import (
+	"testing"
+
+	"k8c.io/kubeone/pkg/testhelper"
+)
+
+func TestCARotationInstallCA(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		params CARotationParams
+	}{
+		{
+			name: "control plane",
+			params: CARotationParams{
+				Certs:        []string{"new-ca.crt", "old-ca.crt"},
+				Key:          "new-ca.key",
+				RenewCerts:   true,
+				ControlPlane: true,
+				VerboseFlag:  "--v=6",
+			},
+		},
+		{
+			name: "worker",
+			params: CARotationParams{
+				Certs: []string{"new-ca.crt", "old-ca.crt"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := CARotationInstallCA(tt.params)
+			if err != nil {
+				t.Errorf("CARotationInstallCA() error = %v", err)
+
+				return
+			}
+
+			testhelper.DiffOutput(t, testhelper.FSGoldenName(t), got, *updateFlag)
+		})
+	}
+}
+
+func TestCARotationBootstrapKubelet(t *testing.T) {
+	t.Parallel()
+
+	got, err := CARotationBootstrapKubelet("abcdef.0123456789abcdef")
+	if err != nil {
+		t.Errorf("CARotationBootstrapKubelet() error = %v", err)
+
+		return
+	}
+
+	testhelper.DiffOutput(t, testhelper.FSGoldenName(t), got, *updateFlag)
+}
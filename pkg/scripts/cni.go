@@ -0,0 +1,59 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scripts
+
+import (
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+
+	"k8c.io/kubeone/pkg/fail"
+)
+
+var (
+	cniCleanupTemplate = heredoc.Doc(`
+		{{- range .FILES }}
+		sudo rm -f /etc/cni/net.d/{{ . }}
+		{{- end }}
+		{{- range .LINKS }}
+		if ip link show {{ . }} >/dev/null 2>&1; then
+			sudo ip link delete {{ . }}
+		fi
+		{{- end }}
+		{{- if .CHAINS }}
+		for iptables in iptables ip6tables; do
+			if command -v ${iptables}-save >/dev/null 2>&1; then
+				sudo ${iptables}-save | grep -v -E '{{ .CHAINS }}' | sudo ${iptables}-restore
+			fi
+		done
+		{{- end }}
+	`)
+)
+
+// CNICleanup removes the CNI configuration files, network interfaces and
+// iptables chains left on the node by the previously used CNI plugin. Files
+// are relative to /etc/cni/net.d and chains are prefixes of the iptables
+// chain names.
+func CNICleanup(files, links, chains []string) (string, error) {
+	result, err := Render(cniCleanupTemplate, Data{
+		"FILES":  files,
+		"LINKS":  links,
+		"CHAINS": strings.Join(chains, "|"),
+	})
+
+	return result, fail.Runtime(err, "rendering cniCleanup script")
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scripts
+
+import (
+	"testing"
+
+	"k8c.io/kubeone/pkg/testhelper"
+)
+
+func TestCNICleanup(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		files  []string
+		links  []string
+		chains []string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:   "canal",
+			files:  []string{"10-canal.conflist", "calico-kubeconfig"},
+			links:  []string{"flannel.1", "flannel-v6.1"},
+			chains: []string{"cali-", "FLANNEL"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := CNICleanup(tt.files, tt.links, tt.chains)
+			if err != nil {
+				t.Errorf("CNICleanup() error = %v", err)
+
+				return
+			}
+
+			testhelper.DiffOutput(t, testhelper.FSGoldenName(t), got, *updateFlag)
+		})
+	}
+}
@@ -23,6 +23,15 @@ import (
 	"k8c.io/kubeone/pkg/fail"
 )
 
+// seccompProfilesDir is the directory where custom seccomp profiles are stored,
+// relative to which kubelet resolves localhost seccomp profiles. The directory
+// is managed by KubeOne, profiles not listed in the manifest are removed.
+const seccompProfilesDir = "/var/lib/kubelet/seccomp/profiles"
+
+// KubeadmPatchesDir is the directory on control plane nodes where kubeadm
+// patches are stored and from which kubeadm applies them
+const KubeadmPatchesDir = "/etc/kubernetes/kubeone-patches"
+
 var (
 	cloudConfigScriptTemplate = heredoc.Doc(`
 		sudo mkdir -p /etc/systemd/system/kubelet.service.d/ /etc/kubernetes
@@ -49,12 +58,63 @@ var (
 		fi
 	`)
 
+	seccompProfilesTemplate = heredoc.Doc(`
+		if sudo test -d "{{ .WORK_DIR }}/cfg/seccomp"; then
+			sudo mkdir -p {{ .SECCOMP_PROFILES_DIR }}
+			sudo install -m 0644 -o root -g root {{ .WORK_DIR }}/cfg/seccomp/* {{ .SECCOMP_PROFILES_DIR }}/
+			for profile in {{ .SECCOMP_PROFILES_DIR }}/*; do
+				if [ ! -e "{{ .WORK_DIR }}/cfg/seccomp/$(basename "$profile")" ]; then
+					sudo rm -f "$profile"
+				fi
+			done
+			rm -rf {{ .WORK_DIR }}/cfg/seccomp
+		else
+			sudo rm -rf {{ .SECCOMP_PROFILES_DIR }}
+		fi
+	`)
+
+	kubeadmPatchesTemplate = heredoc.Doc(`
+		sudo rm -rf {{ .KUBEADM_PATCHES_DIR }}
+		if sudo test -d "{{ .WORK_DIR }}/cfg/patches"; then
+			sudo mkdir -p {{ .KUBEADM_PATCHES_DIR }}
+			sudo install -m 0600 -o root -g root {{ .WORK_DIR }}/cfg/patches/* {{ .KUBEADM_PATCHES_DIR }}/
+			rm -rf {{ .WORK_DIR }}/cfg/patches
+		fi
+	`)
+
 	caBundleTemplate = heredoc.Doc(`
 		sudo mkdir -p {{ .CA_CERTS_DIR }}
 		sudo mv {{ .WORK_DIR }}/ca-certs/{{ .CA_BUNDLE_FILENAME }} {{ .CA_CERTS_DIR }}
 		sudo chown -R root:root {{ .CA_CERTS_DIR }}
 	`)
 
+	trustedCAsTemplate = heredoc.Doc(`
+		sudo mkdir -p {{ .TRUSTED_CAS_DIR }}
+		if ! sudo cmp -s {{ .WORK_DIR }}/trusted-cas/{{ .TRUSTED_CAS_FILENAME }} {{ .TRUSTED_CAS_DIR }}/{{ .TRUSTED_CAS_FILENAME }}; then
+			sudo install -m 0644 -o root -g root {{ .WORK_DIR }}/trusted-cas/{{ .TRUSTED_CAS_FILENAME }} {{ .TRUSTED_CAS_DIR }}/{{ .TRUSTED_CAS_FILENAME }}
+			sudo mkdir -p {{ .TRUST_ANCHORS_DIR }}
+			sudo install -m 0644 -o root -g root {{ .TRUSTED_CAS_DIR }}/{{ .TRUSTED_CAS_FILENAME }} {{ .TRUST_ANCHORS_DIR }}/{{ .TRUST_ANCHOR_FILENAME }}
+			sudo {{ .UPDATE_TRUST_STORE }}
+
+			if sudo systemctl is-active --quiet containerd; then
+				sudo systemctl restart containerd
+			fi
+		fi
+		rm -f {{ .WORK_DIR }}/trusted-cas/{{ .TRUSTED_CAS_FILENAME }}
+	`)
+
+	removeTrustedCAsTemplate = heredoc.Doc(`
+		if sudo test -f {{ .TRUSTED_CAS_DIR }}/{{ .TRUSTED_CAS_FILENAME }}; then
+			sudo rm -f {{ .TRUST_ANCHORS_DIR }}/{{ .TRUST_ANCHOR_FILENAME }}
+			sudo rm -f {{ .TRUSTED_CAS_DIR }}/{{ .TRUSTED_CAS_FILENAME }}
+			sudo {{ .UPDATE_TRUST_STORE }}
+
+			if sudo systemctl is-active --quiet containerd; then
+				sudo systemctl restart containerd
+			fi
+		fi
+	`)
+
 	encryptionProvidersConfigTemplate = heredoc.Doc(`
 		if sudo test -f "{{ .WORK_DIR }}/cfg/{{ .FILE_NAME }}"; then
 			sudo mkdir -p /etc/kubernetes/encryption-providers/
@@ -85,6 +145,24 @@ func SaveAuditPolicyConfig(workdir string) (string, error) {
 	return result, fail.Runtime(err, "rendering auditPolicyScriptTemplate script")
 }
 
+func SaveSeccompProfiles(workdir string) (string, error) {
+	result, err := Render(seccompProfilesTemplate, Data{
+		"WORK_DIR":             workdir,
+		"SECCOMP_PROFILES_DIR": seccompProfilesDir,
+	})
+
+	return result, fail.Runtime(err, "rendering seccompProfilesTemplate script")
+}
+
+func SaveKubeadmPatches(workdir string) (string, error) {
+	result, err := Render(kubeadmPatchesTemplate, Data{
+		"WORK_DIR":            workdir,
+		"KUBEADM_PATCHES_DIR": KubeadmPatchesDir,
+	})
+
+	return result, fail.Runtime(err, "rendering kubeadmPatchesTemplate script")
+}
+
 func SavePodNodeSelectorConfig(workdir string) (string, error) {
 	result, err := Render(podNodeSelectorConfigTemplate, Data{
 		"WORK_DIR": workdir,
@@ -115,3 +193,44 @@ func SaveCABundle(workdir string) (string, error) {
 
 	return result, fail.Runtime(err, "rendering caBundleTemplate script")
 }
+
+func SaveTrustedCAsDebian(workdir string) (string, error) {
+	return renderTrustedCAs(trustedCAsTemplate, workdir, "/usr/local/share/ca-certificates", cabundle.TrustedCAsFileName, "update-ca-certificates")
+}
+
+func SaveTrustedCAsCentOS(workdir string) (string, error) {
+	return renderTrustedCAs(trustedCAsTemplate, workdir, "/etc/pki/ca-trust/source/anchors", cabundle.TrustedCAsFileName, "update-ca-trust extract")
+}
+
+func SaveTrustedCAsFlatcar(workdir string) (string, error) {
+	// Flatcar only picks up certificates with the .pem extension from /etc/ssl/certs
+	return renderTrustedCAs(trustedCAsTemplate, workdir, "/etc/ssl/certs", "kubeone-trusted-cas.pem", "update-ca-certificates")
+}
+
+// RemoveTrustedCAsDebian removes the KubeOne managed trusted CAs bundle, if present
+func RemoveTrustedCAsDebian(workdir string) (string, error) {
+	return renderTrustedCAs(removeTrustedCAsTemplate, workdir, "/usr/local/share/ca-certificates", cabundle.TrustedCAsFileName, "update-ca-certificates --fresh")
+}
+
+// RemoveTrustedCAsCentOS removes the KubeOne managed trusted CAs bundle, if present
+func RemoveTrustedCAsCentOS(workdir string) (string, error) {
+	return renderTrustedCAs(removeTrustedCAsTemplate, workdir, "/etc/pki/ca-trust/source/anchors", cabundle.TrustedCAsFileName, "update-ca-trust extract")
+}
+
+// RemoveTrustedCAsFlatcar removes the KubeOne managed trusted CAs bundle, if present
+func RemoveTrustedCAsFlatcar(workdir string) (string, error) {
+	return renderTrustedCAs(removeTrustedCAsTemplate, workdir, "/etc/ssl/certs", "kubeone-trusted-cas.pem", "update-ca-certificates")
+}
+
+func renderTrustedCAs(tpl, workdir, trustAnchorsDir, trustAnchorFileName, updateTrustStore string) (string, error) {
+	result, err := Render(tpl, Data{
+		"TRUSTED_CAS_DIR":       cabundle.TrustedCAsDir,
+		"TRUSTED_CAS_FILENAME":  cabundle.TrustedCAsFileName,
+		"TRUST_ANCHORS_DIR":     trustAnchorsDir,
+		"TRUST_ANCHOR_FILENAME": trustAnchorFileName,
+		"UPDATE_TRUST_STORE":    updateTrustStore,
+		"WORK_DIR":              workdir,
+	})
+
+	return result, fail.Runtime(err, "rendering trustedCAsTemplate script")
+}
@@ -78,3 +78,91 @@ func TestSaveAuditPolicyConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestSaveSeccompProfiles(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		workdir string
+		err     error
+	}{
+		{name: "kubeone1", workdir: "test-dir1"},
+		{name: "kubeone2", workdir: "./subdir/test"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := SaveSeccompProfiles(tt.workdir)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("SaveSeccompProfiles() error = %v, wantErr %v", err, tt.err)
+
+				return
+			}
+
+			testhelper.DiffOutput(t, testhelper.FSGoldenName(t), got, *updateFlag)
+		})
+	}
+}
+
+func TestSaveKubeadmPatches(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		workdir string
+		err     error
+	}{
+		{name: "kubeone1", workdir: "test-dir1"},
+		{name: "kubeone2", workdir: "./subdir/test"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := SaveKubeadmPatches(tt.workdir)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("SaveKubeadmPatches() error = %v, wantErr %v", err, tt.err)
+
+				return
+			}
+
+			testhelper.DiffOutput(t, testhelper.FSGoldenName(t), got, *updateFlag)
+		})
+	}
+}
+
+func TestSaveTrustedCAs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		script func(workdir string) (string, error)
+	}{
+		{name: "debian", script: SaveTrustedCAsDebian},
+		{name: "centos", script: SaveTrustedCAsCentOS},
+		{name: "flatcar", script: SaveTrustedCAsFlatcar},
+		{name: "remove-debian", script: RemoveTrustedCAsDebian},
+		{name: "remove-centos", script: RemoveTrustedCAsCentOS},
+		{name: "remove-flatcar", script: RemoveTrustedCAsFlatcar},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.script("test-dir")
+			if err != nil {
+				t.Errorf("SaveTrustedCAs() error = %v", err)
+
+				return
+			}
+
+			testhelper.DiffOutput(t, testhelper.FSGoldenName(t), got, *updateFlag)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scripts
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/containerruntime"
+	"k8c.io/kubeone/pkg/fail"
+)
+
+var upgradeContainerdScriptTemplate = heredoc.Doc(`
+	source /etc/kubeone/proxy-env
+
+	{{ if .IS_DEBIAN -}}
+	{{ template "apt-containerd" . }}
+	{{- else if .IS_AMAZON -}}
+	{{ template "yum-containerd-amzn" . }}
+	{{- else -}}
+	{{ template "yum-containerd" . }}
+	{{- end }}
+`)
+
+// UpgradeContainerd installs the pinned containerd version on the node and
+// restarts containerd
+func UpgradeContainerd(cluster *kubeoneapi.KubeOneCluster, node *kubeoneapi.HostConfig) (string, error) {
+	data := Data{
+		"IS_DEBIAN":                    node.OperatingSystem == kubeoneapi.OperatingSystemNameDebian || node.OperatingSystem == kubeoneapi.OperatingSystemNameUbuntu,
+		"IS_AMAZON":                    node.OperatingSystem == kubeoneapi.OperatingSystemNameAmazon,
+		"CONFIGURE_REPOSITORIES":       cluster.SystemPackages.ConfigureRepositories,
+		"CONTAINER_RUNTIME_REPOSITORY": cluster.ContainerRuntimeRepository(),
+	}
+
+	if err := containerruntime.UpdateDataMap(cluster, data); err != nil {
+		return "", err
+	}
+
+	result, err := Render(upgradeContainerdScriptTemplate, data)
+
+	return result, fail.Runtime(err, "rendering upgradeContainerdScriptTemplate script")
+}
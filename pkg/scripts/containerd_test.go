@@ -0,0 +1,71 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scripts
+
+import (
+	"testing"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/testhelper"
+)
+
+func withContainerdVersion(ver string) genClusterOpts {
+	return func(cls *kubeoneapi.KubeOneCluster) {
+		cls.ContainerRuntime.Containerd = &kubeoneapi.ContainerRuntimeContainerd{Version: ver}
+		cls.ContainerRuntime.Docker = nil
+	}
+}
+
+func TestUpgradeContainerd(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		osName kubeoneapi.OperatingSystemName
+	}{
+		{
+			name:   "ubuntu",
+			osName: kubeoneapi.OperatingSystemNameUbuntu,
+		},
+		{
+			name:   "rockylinux",
+			osName: kubeoneapi.OperatingSystemNameRockyLinux,
+		},
+		{
+			name:   "amzn",
+			osName: kubeoneapi.OperatingSystemNameAmazon,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cls := genCluster(withContainerdVersion("1.6.24"))
+
+			got, err := UpgradeContainerd(&cls, &kubeoneapi.HostConfig{OperatingSystem: tt.osName})
+			if err != nil {
+				t.Errorf("UpgradeContainerd() error = %v", err)
+
+				return
+			}
+
+			testhelper.DiffOutput(t, testhelper.FSGoldenName(t), got, *updateFlag)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scripts
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/templates/resources"
+)
+
+// The nameservers and the search domains not set in the cluster DNS config
+// are taken from the host. With systemd-resolved, /etc/resolv.conf points to
+// the stub resolver on the loopback address, which is not reachable from the
+// pods, so the resolv.conf with the actual upstream servers is used instead.
+var resolvConfTemplate = heredoc.Doc(`
+	{{- if .DNS }}
+	host_resolv_conf=/etc/resolv.conf
+	if systemctl is-active --quiet systemd-resolved && [ -f /run/systemd/resolve/resolv.conf ]; then
+		host_resolv_conf=/run/systemd/resolve/resolv.conf
+	fi
+
+	sudo mkdir -p "$(dirname {{ .RESOLV_CONF }})"
+	{
+	{{- range .DNS.UpstreamServers }}
+		echo "nameserver {{ . }}"
+	{{- else }}
+		grep -E '^nameserver[[:space:]]' "${host_resolv_conf}" | grep -vE '[[:space:]](127\.|::1$)' || true
+	{{- end }}
+	{{- if .DNS.SearchDomains }}
+		echo "search {{ .DNS.SearchDomains | join " " }}"
+	{{- else }}
+		grep -E '^search[[:space:]]' "${host_resolv_conf}" || true
+	{{- end }}
+		grep -E '^options[[:space:]]' "${host_resolv_conf}" || true
+	} | sudo tee {{ .RESOLV_CONF }} >/dev/null
+	{{- else }}
+	sudo rm -f {{ .RESOLV_CONF }}
+	{{- end }}
+`)
+
+// ResolvConf generates the resolv.conf file used by kubelet from the cluster
+// DNS config, or removes it if the cluster DNS is not configured
+func ResolvConf(dns *kubeoneapi.ClusterDNSConfig) (string, error) {
+	result, err := Render(resolvConfTemplate, Data{
+		"DNS":         dns,
+		"RESOLV_CONF": resources.KubeletResolvConf,
+	})
+
+	return result, fail.Runtime(err, "rendering resolvConfTemplate script")
+}
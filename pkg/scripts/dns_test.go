@@ -0,0 +1,64 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scripts
+
+import (
+	"testing"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/testhelper"
+)
+
+func TestResolvConf(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		dns  *kubeoneapi.ClusterDNSConfig
+	}{
+		{
+			name: "not configured",
+		},
+		{
+			name: "host nameservers and search domains",
+			dns:  &kubeoneapi.ClusterDNSConfig{},
+		},
+		{
+			name: "upstream servers and search domains",
+			dns: &kubeoneapi.ClusterDNSConfig{
+				UpstreamServers: []string{"1.1.1.1", "8.8.8.8"},
+				SearchDomains:   []string{"example.com", "corp.example.com"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ResolvConf(tt.dns)
+			if err != nil {
+				t.Errorf("ResolvConf() error = %v", err)
+
+				return
+			}
+
+			testhelper.DiffOutput(t, testhelper.FSGoldenName(t), got, *updateFlag)
+		})
+	}
+}
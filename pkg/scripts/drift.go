@@ -0,0 +1,100 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scripts
+
+import (
+	"path"
+
+	"github.com/MakeNowJust/heredoc/v2"
+
+	"k8c.io/kubeone/pkg/fail"
+)
+
+// ManagedFilesHashesFile is where the hashes of the files managed by KubeOne
+// are recorded on each node
+const ManagedFilesHashesFile = "/etc/kubeone/managed-files.sha256"
+
+var (
+	managedFilesHashesTemplate = heredoc.Doc(`
+		{
+			for file in {{ range .FILES }}{{ . }} {{ end }}{{ .WORK_DIR }}/cfg/*.yaml; do
+				if sudo test -f "${file}"; then
+					sudo sha256sum "${file}"
+				fi
+			done
+			if sudo test -d /etc/kubernetes/manifests; then
+				sudo find /etc/kubernetes/manifests -maxdepth 1 -type f -name '*.yaml' -exec sha256sum {} +
+			fi
+		} | sort -k 2
+	`)
+
+	recordManagedFilesHashesTemplate = heredoc.Doc(`
+		sudo mkdir -p {{ .HASHES_DIR }}
+		managed_files_hashes="$(
+	`) + managedFilesHashesTemplate + heredoc.Doc(`
+		)"
+		echo "${managed_files_hashes}" | sudo tee {{ .HASHES_FILE }} >/dev/null
+	`)
+)
+
+// ManagedFilesParams are parameters used to hash the files managed by KubeOne
+type ManagedFilesParams struct {
+	// WorkDir is the directory with the kubeadm configuration files
+	WorkDir string
+	// ContainerRuntimeConfig is the path of the container runtime
+	// configuration file
+	ContainerRuntimeConfig string
+}
+
+// ManagedFilesHashes prints the sha256sum of the files managed by KubeOne:
+// the kubeadm configuration files, the static pod manifests, the container
+// runtime configuration and the kubelet configuration and flags
+func ManagedFilesHashes(params ManagedFilesParams) (string, error) {
+	result, err := Render(managedFilesHashesTemplate, Data{
+		"WORK_DIR": params.WorkDir,
+		"FILES":    managedFiles(params),
+	})
+
+	return result, fail.Runtime(err, "rendering managedFilesHashesTemplate script")
+}
+
+// RecordManagedFilesHashes records the sha256sum of the files managed by
+// KubeOne, which are compared by the drift detection
+func RecordManagedFilesHashes(params ManagedFilesParams) (string, error) {
+	result, err := Render(recordManagedFilesHashesTemplate, Data{
+		"WORK_DIR":    params.WorkDir,
+		"FILES":       managedFiles(params),
+		"HASHES_DIR":  path.Dir(ManagedFilesHashesFile),
+		"HASHES_FILE": ManagedFilesHashesFile,
+	})
+
+	return result, fail.Runtime(err, "rendering recordManagedFilesHashesTemplate script")
+}
+
+func managedFiles(params ManagedFilesParams) []string {
+	return []string{
+		params.ContainerRuntimeConfig,
+		"/var/lib/kubelet/config.yaml",
+		"/var/lib/kubelet/kubeadm-flags.env",
+	}
+}
+
+// RecordedManagedFilesHashes prints the recorded hashes of the files managed
+// by KubeOne, or nothing if the hashes are not recorded
+func RecordedManagedFilesHashes() string {
+	return "if sudo test -f " + ManagedFilesHashesFile + "; then sudo cat " + ManagedFilesHashesFile + "; fi"
+}
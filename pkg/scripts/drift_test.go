@@ -0,0 +1,55 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scripts
+
+import (
+	"testing"
+
+	"k8c.io/kubeone/pkg/testhelper"
+)
+
+func TestManagedFilesHashes(t *testing.T) {
+	t.Parallel()
+
+	got, err := ManagedFilesHashes(ManagedFilesParams{
+		WorkDir:                "test-wd",
+		ContainerRuntimeConfig: "/etc/containerd/config.toml",
+	})
+	if err != nil {
+		t.Errorf("ManagedFilesHashes() error = %v", err)
+
+		return
+	}
+
+	testhelper.DiffOutput(t, testhelper.FSGoldenName(t), got, *updateFlag)
+}
+
+func TestRecordManagedFilesHashes(t *testing.T) {
+	t.Parallel()
+
+	got, err := RecordManagedFilesHashes(ManagedFilesParams{
+		WorkDir:                "test-wd",
+		ContainerRuntimeConfig: "/etc/containerd/config.toml",
+	})
+	if err != nil {
+		t.Errorf("RecordManagedFilesHashes() error = %v", err)
+
+		return
+	}
+
+	testhelper.DiffOutput(t, testhelper.FSGoldenName(t), got, *updateFlag)
+}
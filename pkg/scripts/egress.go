@@ -0,0 +1,84 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scripts
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+
+	"k8c.io/kubeone/pkg/fail"
+)
+
+const egressIPsUnit = "/etc/systemd/system/kubeone-egress-ips.service"
+
+// The unit is stopped before it's rewritten, so that the addresses removed
+// from the configuration are unassigned by the ExecStop of the previous unit.
+var egressIPsTemplate = heredoc.Doc(`
+	if [ -f {{ .UNIT }} ]; then
+		sudo systemctl stop kubeone-egress-ips.service
+	fi
+	{{- if .EGRESS_IPS }}
+
+	ip_bin="$(command -v ip)"
+	iface="$(ip -4 route show default | awk '{print $5; exit}')"
+	if [ -z "${iface}" ]; then
+		echo "failed to find the interface of the default route to assign the egress IPs to"
+		exit 1
+	fi
+
+	sudo mkdir -p /etc/systemd/system
+	cat <<EOF | sudo tee {{ .UNIT }} >/dev/null
+	[Unit]
+	Description=Egress gateway IP addresses managed by KubeOne
+	Wants=network-online.target
+	After=network-online.target
+
+	[Service]
+	Type=oneshot
+	RemainAfterExit=yes
+	{{- range .EGRESS_IPS }}
+	ExecStart=${ip_bin} addr replace {{ . }}/32 dev ${iface}
+	{{- end }}
+	{{- range .EGRESS_IPS }}
+	ExecStop=-${ip_bin} addr del {{ . }}/32 dev ${iface}
+	{{- end }}
+
+	[Install]
+	WantedBy=multi-user.target
+	EOF
+	sudo systemctl daemon-reload
+	sudo systemctl enable --now kubeone-egress-ips.service
+	{{- else }}
+
+	if [ -f {{ .UNIT }} ]; then
+		sudo systemctl disable kubeone-egress-ips.service
+		sudo rm -f {{ .UNIT }}
+		sudo systemctl daemon-reload
+	fi
+	{{- end }}
+`)
+
+// EgressIPs persistently assigns the given egress gateway IPs to the
+// interface of the default route. The addresses assigned by the previous
+// runs are removed, and the unit is deleted if there are no egress IPs.
+func EgressIPs(egressIPs []string) (string, error) {
+	result, err := Render(egressIPsTemplate, Data{
+		"EGRESS_IPS": egressIPs,
+		"UNIT":       egressIPsUnit,
+	})
+
+	return result, fail.Runtime(err, "rendering egressIPsTemplate script")
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scripts
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+
+	"k8c.io/kubeone/pkg/fail"
+)
+
+const (
+	etcdRestoreDir = "/var/lib/kubeone-etcd-restore"
+)
+
+var (
+	etcdRestoreStopTemplate = heredoc.Doc(`
+		sudo mkdir -p {{ .RESTORE_DIR }}/manifests
+		for manifest in kube-apiserver etcd; do
+			if sudo test -f /etc/kubernetes/manifests/${manifest}.yaml; then
+				sudo mv /etc/kubernetes/manifests/${manifest}.yaml {{ .RESTORE_DIR }}/manifests/
+			fi
+		done
+
+		for i in $(seq 1 60); do
+			if [ -z "$(sudo crictl ps --name='^etcd$' -q)" ]; then
+				exit 0
+			fi
+			sleep 5
+		done
+
+		echo "timed out waiting for etcd to stop"
+		exit 1
+	`)
+
+	etcdRestoreSnapshotsTemplate = heredoc.Doc(`
+		sudo mkdir -p {{ .RESTORE_DIR }}
+
+		sudo install -m 600 /dev/null {{ .RESTORE_DIR }}/restic.env
+		cat <<'EOF' | sudo tee {{ .RESTORE_DIR }}/restic.env >/dev/null
+		RESTIC_REPOSITORY={{ .RESTIC_REPOSITORY }}
+		{{- range $key, $value := .RESTIC_ENV }}
+		{{ $key }}={{ $value }}
+		{{- end }}
+		EOF
+
+		sudo ctr --namespace k8s.io images pull {{ .RESTIC_IMAGE }} >/dev/null
+		sudo ctr --namespace k8s.io run --rm --net-host \
+			--env-file {{ .RESTORE_DIR }}/restic.env \
+			{{ .RESTIC_IMAGE }} kubeone-etcd-restore-snapshots \
+			restic snapshots --json --tag etcd
+		sudo rm -f {{ .RESTORE_DIR }}/restic.env
+	`)
+
+	etcdRestoreTemplate = heredoc.Doc(`
+		sudo rm -rf {{ .RESTORE_DIR }}/snapshot {{ .RESTORE_DIR }}/etcd
+		sudo mkdir -p {{ .RESTORE_DIR }}/snapshot
+
+		sudo install -m 600 /dev/null {{ .RESTORE_DIR }}/restic.env
+		cat <<'EOF' | sudo tee {{ .RESTORE_DIR }}/restic.env >/dev/null
+		RESTIC_REPOSITORY={{ .RESTIC_REPOSITORY }}
+		{{- range $key, $value := .RESTIC_ENV }}
+		{{ $key }}={{ $value }}
+		{{- end }}
+		EOF
+
+		sudo ctr --namespace k8s.io images pull {{ .RESTIC_IMAGE }} >/dev/null
+		sudo ctr --namespace k8s.io run --rm --net-host \
+			--env-file {{ .RESTORE_DIR }}/restic.env \
+			--mount type=bind,src={{ .RESTORE_DIR }}/snapshot,dst=/restore,options=rbind:rw \
+			{{ .RESTIC_IMAGE }} kubeone-etcd-restore-download \
+			restic restore {{ .SNAPSHOT_ID }} --include /backup/etcd-snapshot.db --target /restore
+		sudo rm -f {{ .RESTORE_DIR }}/restic.env
+
+		sudo ctr --namespace k8s.io images pull {{ .ETCD_IMAGE }} >/dev/null
+		sudo ctr --namespace k8s.io run --rm --net-host \
+			--mount type=bind,src={{ .RESTORE_DIR }},dst=/restore,options=rbind:rw \
+			{{ .ETCD_IMAGE }} kubeone-etcd-restore-snapshot \
+			etcdutl snapshot restore /restore/snapshot/backup/etcd-snapshot.db \
+			--name {{ .NAME }} \
+			--initial-cluster {{ .INITIAL_CLUSTER }} \
+			--initial-cluster-token kubeone-etcd-restore \
+			--initial-advertise-peer-urls {{ .PEER_URL }} \
+			--data-dir /restore/etcd
+
+		if sudo test -d /var/lib/etcd; then
+			sudo mv /var/lib/etcd /var/lib/etcd.$(date +%s).bak
+		fi
+		sudo mv {{ .RESTORE_DIR }}/etcd /var/lib/etcd
+		sudo rm -rf {{ .RESTORE_DIR }}/snapshot
+	`)
+
+	etcdRestoreStartTemplate = heredoc.Doc(`
+		for manifest in etcd kube-apiserver; do
+			if sudo test -f {{ .RESTORE_DIR }}/manifests/${manifest}.yaml; then
+				sudo mv {{ .RESTORE_DIR }}/manifests/${manifest}.yaml /etc/kubernetes/manifests/
+			fi
+		done
+		sudo rm -rf {{ .RESTORE_DIR }}
+	`)
+)
+
+// EtcdRestoreParams are parameters used to restore the etcd snapshot on a
+// control plane node
+type EtcdRestoreParams struct {
+	// Name of the etcd member
+	Name string
+	// PeerURL of the etcd member
+	PeerURL string
+	// InitialCluster is a list of all etcd members in the name=peerURL format
+	InitialCluster string
+	// SnapshotID is the full Restic snapshot ID to restore
+	SnapshotID string
+	// ResticRepository where snapshots are stored
+	ResticRepository string
+	// ResticEnv are additional environment variables (credentials) used by Restic
+	ResticEnv map[string]string
+	// ResticImage is the image used to download the snapshot
+	ResticImage string
+	// EtcdImage is the image used to restore the snapshot
+	EtcdImage string
+}
+
+// EtcdRestoreStop stops etcd and kube-apiserver static pods
+func EtcdRestoreStop() (string, error) {
+	result, err := Render(etcdRestoreStopTemplate, Data{
+		"RESTORE_DIR": etcdRestoreDir,
+	})
+
+	return result, fail.Runtime(err, "rendering etcdRestoreStopTemplate script")
+}
+
+// EtcdRestoreSnapshots lists etcd snapshots stored in the Restic repository
+// in the JSON format. Only the Restic related parameters are used.
+func EtcdRestoreSnapshots(params EtcdRestoreParams) (string, error) {
+	result, err := Render(etcdRestoreSnapshotsTemplate, Data{
+		"RESTORE_DIR":       etcdRestoreDir,
+		"RESTIC_REPOSITORY": params.ResticRepository,
+		"RESTIC_ENV":        params.ResticEnv,
+		"RESTIC_IMAGE":      params.ResticImage,
+	})
+
+	return result, fail.Runtime(err, "rendering etcdRestoreSnapshotsTemplate script")
+}
+
+// EtcdRestore downloads the etcd snapshot and restores it as etcd data directory
+func EtcdRestore(params EtcdRestoreParams) (string, error) {
+	result, err := Render(etcdRestoreTemplate, Data{
+		"RESTORE_DIR":       etcdRestoreDir,
+		"NAME":              params.Name,
+		"PEER_URL":          params.PeerURL,
+		"INITIAL_CLUSTER":   params.InitialCluster,
+		"SNAPSHOT_ID":       params.SnapshotID,
+		"RESTIC_REPOSITORY": params.ResticRepository,
+		"RESTIC_ENV":        params.ResticEnv,
+		"RESTIC_IMAGE":      params.ResticImage,
+		"ETCD_IMAGE":        params.EtcdImage,
+	})
+
+	return result, fail.Runtime(err, "rendering etcdRestoreTemplate script")
+}
+
+// EtcdRestoreStart starts etcd and kube-apiserver static pods stopped by
+// EtcdRestoreStop
+func EtcdRestoreStart() (string, error) {
+	result, err := Render(etcdRestoreStartTemplate, Data{
+		"RESTORE_DIR": etcdRestoreDir,
+	})
+
+	return result, fail.Runtime(err, "rendering etcdRestoreStartTemplate script")
+}
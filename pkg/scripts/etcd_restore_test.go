@@ -0,0 +1,96 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scripts
+
+import (
+	"testing"
+
+	"k8c.io/kubeone/pkg/testhelper"
+)
+
+func TestEtcdRestoreStop(t *testing.T) {
+	t.Parallel()
+
+	got, err := EtcdRestoreStop()
+	if err != nil {
+		t.Errorf("EtcdRestoreStop() error = %v", err)
+
+		return
+	}
+
+	testhelper.DiffOutput(t, testhelper.FSGoldenName(t), got, *updateFlag)
+}
+
+func TestEtcdRestoreSnapshots(t *testing.T) {
+	t.Parallel()
+
+	got, err := EtcdRestoreSnapshots(EtcdRestoreParams{
+		ResticRepository: "s3:s3.amazonaws.com/backups/etcd",
+		ResticEnv: map[string]string{
+			"AWS_ACCESS_KEY_ID":     "access-key-id",
+			"AWS_SECRET_ACCESS_KEY": "secret-access-key",
+			"RESTIC_PASSWORD":       "password",
+		},
+		ResticImage: "docker.io/restic/restic:0.16.0",
+	})
+	if err != nil {
+		t.Errorf("EtcdRestoreSnapshots() error = %v", err)
+
+		return
+	}
+
+	testhelper.DiffOutput(t, testhelper.FSGoldenName(t), got, *updateFlag)
+}
+
+func TestEtcdRestore(t *testing.T) {
+	t.Parallel()
+
+	got, err := EtcdRestore(EtcdRestoreParams{
+		Name:             "cp-0",
+		PeerURL:          "https://192.168.1.10:2380",
+		InitialCluster:   "cp-0=https://192.168.1.10:2380,cp-1=https://192.168.1.11:2380,cp-2=https://192.168.1.12:2380",
+		SnapshotID:       "4bba301e2fbd0dd2b5cdac1ba1cb1b6bd7b18ab1a75b2e3ffd3dd0b5fd07ef1a",
+		ResticRepository: "s3:s3.amazonaws.com/backups/etcd",
+		ResticEnv: map[string]string{
+			"AWS_ACCESS_KEY_ID":     "access-key-id",
+			"AWS_SECRET_ACCESS_KEY": "secret-access-key",
+			"RESTIC_PASSWORD":       "password",
+		},
+		ResticImage: "docker.io/restic/restic:0.16.0",
+		EtcdImage:   "gcr.io/etcd-development/etcd:v3.5.11",
+	})
+	if err != nil {
+		t.Errorf("EtcdRestore() error = %v", err)
+
+		return
+	}
+
+	testhelper.DiffOutput(t, testhelper.FSGoldenName(t), got, *updateFlag)
+}
+
+func TestEtcdRestoreStart(t *testing.T) {
+	t.Parallel()
+
+	got, err := EtcdRestoreStart()
+	if err != nil {
+		t.Errorf("EtcdRestoreStart() error = %v", err)
+
+		return
+	}
+
+	testhelper.DiffOutput(t, testhelper.FSGoldenName(t), got, *updateFlag)
+}
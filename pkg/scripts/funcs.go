@@ -81,6 +81,46 @@ var (
 		EOF
 		sudo systemctl force-reload systemd-journald
 		{{ end }}
+
+		{{ define "apt-repository" }}
+		{{- $keyring := printf "/etc/apt/keyrings/%s.gpg" .NAME }}
+		sudo install -m 0755 -d /etc/apt/keyrings
+		{{- if .REPOSITORY.GPGKeyURL }}
+		curl -fsSL {{ .REPOSITORY.GPGKeyURL }} | sudo gpg --dearmor --yes -o {{ $keyring }}
+		{{- else if .REPOSITORY.GPGKey }}
+		cat <<'EOF' | sudo gpg --dearmor --yes -o {{ $keyring }}
+		{{ .REPOSITORY.GPGKey }}
+		EOF
+		{{- end }}
+		echo "deb [{{ if or .REPOSITORY.GPGKeyURL .REPOSITORY.GPGKey }}signed-by={{ $keyring }}{{ else }}trusted=yes{{ end }}] {{ .REPOSITORY.URL }} {{ if .REPOSITORY.Suite }}{{ .REPOSITORY.Suite }} {{ join " " .REPOSITORY.Components }}{{ else }}/{{ end }}" | sudo tee /etc/apt/sources.list.d/{{ .NAME }}.list
+		{{- end }}
+
+		{{ define "yum-repository" }}
+		{{- $gpgkey := printf "/etc/pki/rpm-gpg/RPM-GPG-KEY-%s" .NAME }}
+		{{- if .REPOSITORY.GPGKey }}
+		sudo mkdir -p /etc/pki/rpm-gpg
+		cat <<'EOF' | sudo tee {{ $gpgkey }}
+		{{ .REPOSITORY.GPGKey }}
+		EOF
+		{{- end }}
+		cat <<'EOF' | sudo tee /etc/yum.repos.d/{{ .NAME }}.repo
+		[{{ .NAME }}]
+		name={{ .NAME }}
+		baseurl={{ .REPOSITORY.URL }}
+		enabled=1
+		module_hotfixes=true
+		{{- if .REPOSITORY.GPGKeyURL }}
+		gpgcheck=1
+		gpgkey={{ .REPOSITORY.GPGKeyURL }}
+		{{- else if .REPOSITORY.GPGKey }}
+		gpgcheck=1
+		gpgkey=file://{{ $gpgkey }}
+		{{- else }}
+		gpgcheck=0
+		{{- end }}
+		EOF
+		sudo yum --disablerepo='*' --enablerepo={{ .NAME }} clean metadata
+		{{- end }}
 	`)
 )
 
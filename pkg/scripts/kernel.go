@@ -0,0 +1,193 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scripts
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+
+	"k8c.io/kubeone/pkg/fail"
+)
+
+const (
+	kernelModulesFile = "/etc/modules-load.d/kubeone.conf"
+	kernelSysctlsFile = "/etc/sysctl.d/99-kubeone.conf"
+	ciliumSysctlsFile = "/etc/sysctl.d/99-zzz-override_cilium.conf"
+	bpffsMountUnit    = "/etc/systemd/system/sys-fs-bpf.mount"
+)
+
+var (
+	kernelConfigTemplate = heredoc.Doc(`
+		{{- if .MODULES }}
+		sudo mkdir -p /etc/modules-load.d
+		cat <<'EOF' | sudo tee {{ .MODULES_FILE }} >/dev/null
+		{{- range .MODULES }}
+		{{ . }}
+		{{- end }}
+		EOF
+		{{- range .MODULES }}
+		sudo modprobe {{ . }}
+		{{- end }}
+		{{- else }}
+		sudo rm -f {{ .MODULES_FILE }}
+		{{- end }}
+
+		{{ if .SYSCTLS -}}
+		sudo mkdir -p /etc/sysctl.d
+		cat <<'EOF' | sudo tee {{ .SYSCTLS_FILE }} >/dev/null
+		{{- range $name, $value := .SYSCTLS }}
+		{{ $name }} = {{ $value }}
+		{{- end }}
+		EOF
+		sudo sysctl -p {{ .SYSCTLS_FILE }}
+		{{- else -}}
+		sudo rm -f {{ .SYSCTLS_FILE }}
+		{{- end }}
+	`)
+
+	verifyKernelConfigTemplate = heredoc.Doc(`
+		failed=""
+		{{- range .MODULES }}
+		if [ ! -d /sys/module/{{ . | replace "-" "_" }} ]; then
+			failed="${failed} module:{{ . }}"
+		fi
+		{{- end }}
+		{{- range $name, $value := .SYSCTLS }}
+		if [ "$(sudo sysctl -n {{ $name }} | xargs)" != "$(echo '{{ $value }}' | xargs)" ]; then
+			failed="${failed} sysctl:{{ $name }}"
+		fi
+		{{- end }}
+		if [ -n "${failed}" ]; then
+			echo "kernel configuration is not applied:${failed}"
+			exit 1
+		fi
+	`)
+
+	loadKernelModulesTemplate = heredoc.Doc(`
+		missing=""
+		{{- range .MODULES }}
+		if ! sudo modprobe {{ . }}; then
+			missing="${missing} {{ . }}"
+		fi
+		{{- end }}
+		if [ -n "${missing}" ]; then
+			echo "kernel modules required by {{ .FEATURE }} are not available:${missing}"
+			exit 1
+		fi
+	`)
+
+	ciliumEBPFPrerequisitesTemplate = heredoc.Doc(`
+		kernel="$(uname -r)"
+		failed=""
+		if [ "$(printf '%s\n' "{{ .MIN_KERNEL }}" "${kernel%%-*}" | sort -V | head -n1)" != "{{ .MIN_KERNEL }}" ]; then
+			failed="${failed}\n  - kernel ${kernel} is older than {{ .MIN_KERNEL }}, upgrade the kernel (e.g. install the HWE kernel on Ubuntu) and reboot the host"
+		fi
+		{{- if .REQUIRE_BTF }}
+		if [ ! -f /sys/kernel/btf/vmlinux ]; then
+			failed="${failed}\n  - BTF is not available (/sys/kernel/btf/vmlinux is missing), use a kernel built with CONFIG_DEBUG_INFO_BTF=y"
+		fi
+		{{- end }}
+		if [ -n "${failed}" ]; then
+			printf "host doesn't meet the requirements of {{ .FEATURE }}:%b\n" "${failed}"
+			exit 1
+		fi
+
+		sudo mkdir -p /etc/systemd/system
+		cat <<'EOF' | sudo tee {{ .MOUNT_UNIT }} >/dev/null
+		[Unit]
+		Description=BPF filesystem mount required by Cilium
+		DefaultDependencies=no
+		Before=local-fs.target umount.target
+		After=swap.target
+
+		[Mount]
+		What=bpffs
+		Where=/sys/fs/bpf
+		Type=bpf
+		Options=rw,nosuid,nodev,noexec,relatime,mode=700
+
+		[Install]
+		WantedBy=multi-user.target
+		EOF
+		sudo systemctl daemon-reload
+		if ! grep -q ' /sys/fs/bpf bpf ' /proc/mounts; then
+			sudo systemctl enable --now sys-fs-bpf.mount
+		else
+			sudo systemctl enable sys-fs-bpf.mount
+		fi
+
+		sudo mkdir -p /etc/sysctl.d
+		cat <<'EOF' | sudo tee {{ .SYSCTLS_FILE }} >/dev/null
+		net.ipv4.conf.all.rp_filter = 0
+		-net.ipv4.conf.lxc*.rp_filter = 0
+		-net.ipv4.conf.cilium_*.rp_filter = 0
+		EOF
+		sudo systemctl restart systemd-sysctl
+	`)
+)
+
+// KernelConfig persists and applies the given sysctls and kernel modules.
+// Files written by the previous runs are removed if there are no sysctls or
+// kernel modules to apply.
+func KernelConfig(sysctls map[string]string, modules []string) (string, error) {
+	result, err := Render(kernelConfigTemplate, Data{
+		"MODULES":      modules,
+		"MODULES_FILE": kernelModulesFile,
+		"SYSCTLS":      sysctls,
+		"SYSCTLS_FILE": kernelSysctlsFile,
+	})
+
+	return result, fail.Runtime(err, "rendering kernelConfigTemplate script")
+}
+
+// VerifyKernelConfig verifies that the given sysctls and kernel modules are
+// applied on the host
+func VerifyKernelConfig(sysctls map[string]string, modules []string) (string, error) {
+	result, err := Render(verifyKernelConfigTemplate, Data{
+		"MODULES": modules,
+		"SYSCTLS": sysctls,
+	})
+
+	return result, fail.Runtime(err, "rendering verifyKernelConfigTemplate script")
+}
+
+// LoadKernelModules loads the given kernel modules and fails if any of them is
+// not available on the host. The feature is used in the error message.
+func LoadKernelModules(feature string, modules []string) (string, error) {
+	result, err := Render(loadKernelModulesTemplate, Data{
+		"FEATURE": feature,
+		"MODULES": modules,
+	})
+
+	return result, fail.Runtime(err, "rendering loadKernelModulesTemplate script")
+}
+
+// CiliumEBPFPrerequisites verifies that the kernel of the host is at least
+// minKernel and, if requireBTF is set, that it exposes BTF, failing with
+// the remediation hints otherwise. It then persistently mounts the BPF
+// filesystem and disables the reverse path filtering that would drop the
+// traffic redirected by eBPF. The feature is used in the error message.
+func CiliumEBPFPrerequisites(feature, minKernel string, requireBTF bool) (string, error) {
+	result, err := Render(ciliumEBPFPrerequisitesTemplate, Data{
+		"FEATURE":      feature,
+		"MIN_KERNEL":   minKernel,
+		"REQUIRE_BTF":  requireBTF,
+		"MOUNT_UNIT":   bpffsMountUnit,
+		"SYSCTLS_FILE": ciliumSysctlsFile,
+	})
+
+	return result, fail.Runtime(err, "rendering ciliumEBPFPrerequisitesTemplate script")
+}
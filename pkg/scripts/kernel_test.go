@@ -0,0 +1,129 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scripts
+
+import (
+	"testing"
+
+	"k8c.io/kubeone/pkg/testhelper"
+)
+
+func TestKernelConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		sysctls map[string]string
+		modules []string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "sysctls and modules",
+			sysctls: map[string]string{
+				"net.core.somaxconn":           "32768",
+				"net.ipv4.ip_local_port_range": "1024 65000",
+			},
+			modules: []string{"ip_vs", "nf-conntrack"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := KernelConfig(tt.sysctls, tt.modules)
+			if err != nil {
+				t.Errorf("KernelConfig() error = %v", err)
+
+				return
+			}
+
+			testhelper.DiffOutput(t, testhelper.FSGoldenName(t), got, *updateFlag)
+		})
+	}
+}
+
+func TestVerifyKernelConfig(t *testing.T) {
+	t.Parallel()
+
+	got, err := VerifyKernelConfig(map[string]string{
+		"net.core.somaxconn":           "32768",
+		"net.ipv4.ip_local_port_range": "1024 65000",
+	}, []string{"ip_vs", "nf-conntrack"})
+	if err != nil {
+		t.Errorf("VerifyKernelConfig() error = %v", err)
+
+		return
+	}
+
+	testhelper.DiffOutput(t, testhelper.FSGoldenName(t), got, *updateFlag)
+}
+
+func TestLoadKernelModules(t *testing.T) {
+	t.Parallel()
+
+	got, err := LoadKernelModules("IPsec encryption", []string{"xfrm_user", "esp4", "esp6"})
+	if err != nil {
+		t.Errorf("LoadKernelModules() error = %v", err)
+
+		return
+	}
+
+	testhelper.DiffOutput(t, testhelper.FSGoldenName(t), got, *updateFlag)
+}
+
+func TestCiliumEBPFPrerequisites(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		feature    string
+		minKernel  string
+		requireBTF bool
+	}{
+		{
+			name:      "kube-proxy replacement",
+			feature:   "Cilium kube-proxy replacement",
+			minKernel: "4.19.57",
+		},
+		{
+			name:       "bpf host routing",
+			feature:    "Cilium eBPF host routing",
+			minKernel:  "5.10",
+			requireBTF: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := CiliumEBPFPrerequisites(tt.feature, tt.minKernel, tt.requireBTF)
+			if err != nil {
+				t.Errorf("CiliumEBPFPrerequisites() error = %v", err)
+
+				return
+			}
+
+			testhelper.DiffOutput(t, testhelper.FSGoldenName(t), got, *updateFlag)
+		})
+	}
+}
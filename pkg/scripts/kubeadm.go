@@ -66,7 +66,7 @@ var (
 	`)
 
 	kubeadmUpgradeScriptTemplate = heredoc.Doc(`
-		sudo {{ .KUBEADM_UPGRADE }}{{ if .LEADER }} --config={{ .WORK_DIR }}/cfg/master_{{ .NODE_ID }}.yaml{{ end }}
+		sudo {{ .KUBEADM_UPGRADE }}{{ if .LEADER }} --config={{ .WORK_DIR }}/cfg/master_{{ .NODE_ID }}.yaml{{ end }}{{ if .PATCHES_DIR }} --patches={{ .PATCHES_DIR }}{{ end }}
 		sudo find /etc/kubernetes/pki/ -name *.crt -exec chmod 600 {} \;
 	`)
 
@@ -129,12 +129,13 @@ func KubeadmReset(verboseFlag, workdir string) (string, error) {
 	return result, fail.Runtime(err, "rendering kubeadmResetScriptTemplate script")
 }
 
-func KubeadmUpgrade(kubeadmCmd, workdir string, leader bool, nodeID int) (string, error) {
+func KubeadmUpgrade(kubeadmCmd, workdir string, leader bool, nodeID int, patchesDir string) (string, error) {
 	result, err := Render(kubeadmUpgradeScriptTemplate, map[string]interface{}{
 		"KUBEADM_UPGRADE": kubeadmCmd,
 		"WORK_DIR":        workdir,
 		"NODE_ID":         nodeID,
 		"LEADER":          leader,
+		"PATCHES_DIR":     patchesDir,
 	})
 
 	return result, fail.Runtime(err, "rendering kubeadmUpgradeScriptTemplate script")
@@ -263,6 +263,7 @@ func TestKubeadmUpgrade(t *testing.T) {
 		kubeadmCmd string
 		workdir    string
 		leader     bool
+		patchesDir string
 	}
 	tests := []struct {
 		name string
@@ -284,13 +285,38 @@ func TestKubeadmUpgrade(t *testing.T) {
 				leader:     true,
 			},
 		},
+		{
+			name: "leader with patches",
+			args: args{
+				workdir:    "some",
+				kubeadmCmd: "kubeadm upgrade apply -y --certificate-renewal=true v1.1.1",
+				leader:     true,
+				patchesDir: "/etc/kubernetes/kubeone-patches",
+			},
+		},
+		{
+			name: "static worker with patches",
+			args: args{
+				workdir:    "some",
+				kubeadmCmd: "kubeadm upgrade node",
+				patchesDir: "/etc/kubernetes/kubeone-patches",
+			},
+		},
+		{
+			name: "follower with patches",
+			args: args{
+				workdir:    "some",
+				kubeadmCmd: "kubeadm upgrade node",
+				patchesDir: "/etc/kubernetes/kubeone-patches",
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			got, err := KubeadmUpgrade(tt.args.kubeadmCmd, tt.args.workdir, tt.args.leader, 0)
+			got, err := KubeadmUpgrade(tt.args.kubeadmCmd, tt.args.workdir, tt.args.leader, 0, tt.args.patchesDir)
 			if !errors.Is(err, tt.err) {
 				t.Errorf("KubeadmUpgradeLeader() error = %v, wantErr %v", err, tt.err)
 
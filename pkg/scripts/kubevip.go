@@ -0,0 +1,50 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scripts
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+
+	"k8c.io/kubeone/pkg/fail"
+)
+
+// The bootstrap manifest is installed only on the leader that is not
+// initialized yet, while the regular manifest is installed only on the
+// control plane nodes that have already joined the cluster, as kubeadm join
+// requires an empty static pods manifests directory.
+var kubeVIPScriptTemplate = heredoc.Doc(`
+	{{- if .BOOTSTRAP }}
+	[[ -f /etc/kubernetes/admin.conf ]] && exit 0
+	{{- else }}
+	[[ -f /etc/kubernetes/admin.conf ]] || exit 0
+	{{- end }}
+
+	sudo mkdir -p "$(dirname {{ .MANIFEST_PATH }})"
+	sudo install -m 0600 {{ .WORK_DIR }}/cfg/kube-vip.yaml {{ .MANIFEST_PATH }}
+`)
+
+// KubeVIP installs the kube-vip static pod manifest uploaded to the work
+// directory
+func KubeVIP(workdir, manifestPath string, bootstrap bool) (string, error) {
+	result, err := Render(kubeVIPScriptTemplate, Data{
+		"WORK_DIR":      workdir,
+		"MANIFEST_PATH": manifestPath,
+		"BOOTSTRAP":     bootstrap,
+	})
+
+	return result, fail.Runtime(err, "rendering kubeVIPScriptTemplate script")
+}
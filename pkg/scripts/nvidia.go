@@ -0,0 +1,85 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scripts
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/containerruntime"
+	"k8c.io/kubeone/pkg/fail"
+)
+
+// The containerd config is rewritten so that nodes provisioned before the
+// NvidiaGPU feature was enabled get the nvidia runtime registered as well.
+// containerd is restarted only if the toolkit got installed or the config
+// changed, so the script is safe to run on every apply.
+var (
+	nvidiaContainerToolkitDebianScriptTemplate = heredoc.Doc(`
+		source /etc/kubeone/proxy-env
+
+		toolkit_installed=false
+		if ! command -v nvidia-container-runtime &>/dev/null; then
+			sudo install -m 0755 -d /etc/apt/keyrings
+			curl -fsSL https://nvidia.github.io/libnvidia-container/gpgkey | sudo gpg --dearmor --yes -o /etc/apt/keyrings/nvidia-container-toolkit-keyring.gpg
+			curl -fsSL https://nvidia.github.io/libnvidia-container/stable/deb/nvidia-container-toolkit.list | \
+				sed 's#deb https://#deb [signed-by=/etc/apt/keyrings/nvidia-container-toolkit-keyring.gpg] https://#g' | \
+				sudo tee /etc/apt/sources.list.d/nvidia-container-toolkit.list
+
+			sudo apt-get update
+			sudo DEBIAN_FRONTEND=noninteractive apt-get install -y --no-install-recommends nvidia-container-toolkit
+			toolkit_installed=true
+		fi
+
+		{{ template "nvidia-containerd-config" . }}
+	`)
+
+	nvidiaContainerToolkitCentOSScriptTemplate = heredoc.Doc(`
+		source /etc/kubeone/proxy-env
+
+		toolkit_installed=false
+		if ! command -v nvidia-container-runtime &>/dev/null; then
+			curl -fsSL https://nvidia.github.io/libnvidia-container/stable/rpm/nvidia-container-toolkit.repo | \
+				sudo tee /etc/yum.repos.d/nvidia-container-toolkit.repo
+
+			sudo yum install -y nvidia-container-toolkit
+			toolkit_installed=true
+		fi
+
+		{{ template "nvidia-containerd-config" . }}
+	`)
+)
+
+func NvidiaContainerToolkitDebian(cluster *kubeoneapi.KubeOneCluster) (string, error) {
+	return renderNvidiaContainerToolkit(cluster, nvidiaContainerToolkitDebianScriptTemplate)
+}
+
+func NvidiaContainerToolkitCentOS(cluster *kubeoneapi.KubeOneCluster) (string, error) {
+	return renderNvidiaContainerToolkit(cluster, nvidiaContainerToolkitCentOSScriptTemplate)
+}
+
+func renderNvidiaContainerToolkit(cluster *kubeoneapi.KubeOneCluster, tpl string) (string, error) {
+	data := Data{}
+
+	if err := containerruntime.UpdateDataMap(cluster, data); err != nil {
+		return "", err
+	}
+
+	result, err := Render(tpl, data)
+
+	return result, fail.Runtime(err, "rendering nvidia-container-toolkit script")
+}
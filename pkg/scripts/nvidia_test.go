@@ -0,0 +1,58 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scripts
+
+import (
+	"testing"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/testhelper"
+)
+
+func withNvidiaGPU(cls *kubeoneapi.KubeOneCluster) {
+	cls.Features.NvidiaGPU = &kubeoneapi.NvidiaGPU{Enable: true}
+}
+
+func TestNvidiaContainerToolkitDebian(t *testing.T) {
+	t.Parallel()
+
+	cls := genCluster(withContainerd, withNvidiaGPU)
+
+	got, err := NvidiaContainerToolkitDebian(&cls)
+	if err != nil {
+		t.Errorf("NvidiaContainerToolkitDebian() error = %v", err)
+
+		return
+	}
+
+	testhelper.DiffOutput(t, testhelper.FSGoldenName(t), got, *updateFlag)
+}
+
+func TestNvidiaContainerToolkitCentOS(t *testing.T) {
+	t.Parallel()
+
+	cls := genCluster(withContainerd, withNvidiaGPU)
+
+	got, err := NvidiaContainerToolkitCentOS(&cls)
+	if err != nil {
+		t.Errorf("NvidiaContainerToolkitCentOS() error = %v", err)
+
+		return
+	}
+
+	testhelper.DiffOutput(t, testhelper.FSGoldenName(t), got, *updateFlag)
+}
@@ -63,7 +63,7 @@ func MigrateToContainerd(cluster *kubeoneapi.KubeOneCluster, node *kubeoneapi.Ho
 }
 
 func installISCSIAndNFS(cluster *kubeoneapi.KubeOneCluster) bool {
-	return cluster.CloudProvider.Nutanix != nil
+	return cluster.CloudProvider.Nutanix != nil || cluster.CloudProvider.OCI != nil
 }
 
 func criToolsVersion(cluster *kubeoneapi.KubeOneCluster) string {
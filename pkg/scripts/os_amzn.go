@@ -24,8 +24,10 @@ import (
 
 const (
 	kubeadmAmazonLinuxTemplate = `
+{{- if not .NODE_SWAP }}
 sudo swapoff -a
 sudo sed -i '/.*swap.*/d' /etc/fstab
+{{- end }}
 sudo setenforce 0 || true
 [ -f /etc/selinux/config ] && sudo sed -i 's/SELINUX=enforcing/SELINUX=permissive/g' /etc/selinux/config
 sudo systemctl disable --now firewalld || true
@@ -53,6 +55,9 @@ if sudo grep -q "packages.cloud.google.com" /etc/yum.repos.d/kubernetes.repo; th
   repo_migration_needed=true
 fi
 
+{{ if .KUBERNETES_REPOSITORY -}}
+{{ template "yum-repository" (dict "NAME" "kubernetes" "REPOSITORY" .KUBERNETES_REPOSITORY) }}
+{{- else -}}
 cat <<EOF | sudo tee /etc/yum.repos.d/kubernetes.repo
 [kubernetes]
 name=Kubernetes
@@ -61,6 +66,7 @@ enabled=1
 gpgcheck=1
 gpgkey=https://pkgs.k8s.io/core:/stable:/{{ .KUBERNETES_MAJOR_MINOR }}/rpm/repodata/repomd.xml.key
 EOF
+{{- end }}
 
 if [[ $repo_migration_needed == "true" ]]; then
   sudo yum clean all
@@ -229,12 +235,14 @@ func KubeadmAmazonLinux(cluster *kubeoneapi.KubeOneCluster, force bool) (string,
 		"KUBERNETES_CNI_VERSION": defaultKubernetesCNIVersion,
 		"CRITOOLS_VERSION":       criToolsVersion(cluster),
 		"CONFIGURE_REPOSITORIES": cluster.SystemPackages.ConfigureRepositories,
+		"KUBERNETES_REPOSITORY":  cluster.KubernetesRepository(),
 		"PROXY":                  proxy,
 		"FORCE":                  force,
 		"INSTALL_DOCKER":         cluster.ContainerRuntime.Docker,
 		"INSTALL_CONTAINERD":     cluster.ContainerRuntime.Containerd,
 		"USE_KUBERNETES_REPO":    cluster.AssetConfiguration.NodeBinaries.URL == "",
 		"IPV6_ENABLED":           cluster.ClusterNetwork.HasIPv6(),
+		"NODE_SWAP":              cluster.Features.SwapEnabled(),
 	}
 
 	if err := containerruntime.UpdateDataMap(cluster, data); err != nil {
@@ -268,11 +276,13 @@ func UpgradeKubeadmAndCNIAmazonLinux(cluster *kubeoneapi.KubeOneCluster) (string
 		"KUBERNETES_CNI_VERSION": defaultKubernetesCNIVersion,
 		"CRITOOLS_VERSION":       criToolsVersion(cluster),
 		"CONFIGURE_REPOSITORIES": cluster.SystemPackages.ConfigureRepositories,
+		"KUBERNETES_REPOSITORY":  cluster.KubernetesRepository(),
 		"PROXY":                  proxy,
 		"INSTALL_DOCKER":         cluster.ContainerRuntime.Docker,
 		"INSTALL_CONTAINERD":     cluster.ContainerRuntime.Containerd,
 		"USE_KUBERNETES_REPO":    cluster.AssetConfiguration.NodeBinaries.URL == "",
 		"IPV6_ENABLED":           cluster.ClusterNetwork.HasIPv6(),
+		"NODE_SWAP":              cluster.Features.SwapEnabled(),
 	}
 
 	if err := containerruntime.UpdateDataMap(cluster, data); err != nil {
@@ -301,11 +311,13 @@ func UpgradeKubeletAndKubectlAmazonLinux(cluster *kubeoneapi.KubeOneCluster) (st
 		"KUBERNETES_CNI_VERSION": defaultKubernetesCNIVersion,
 		"CRITOOLS_VERSION":       criToolsVersion(cluster),
 		"CONFIGURE_REPOSITORIES": cluster.SystemPackages.ConfigureRepositories,
+		"KUBERNETES_REPOSITORY":  cluster.KubernetesRepository(),
 		"PROXY":                  proxy,
 		"INSTALL_DOCKER":         cluster.ContainerRuntime.Docker,
 		"INSTALL_CONTAINERD":     cluster.ContainerRuntime.Containerd,
 		"USE_KUBERNETES_REPO":    cluster.AssetConfiguration.NodeBinaries.URL == "",
 		"IPV6_ENABLED":           cluster.ClusterNetwork.HasIPv6(),
+		"NODE_SWAP":              cluster.Features.SwapEnabled(),
 	}
 
 	if err := containerruntime.UpdateDataMap(cluster, data); err != nil {
@@ -24,8 +24,10 @@ import (
 
 const (
 	kubeadmCentOSTemplate = `
+{{- if not .NODE_SWAP }}
 sudo swapoff -a
 sudo sed -i '/.*swap.*/d' /etc/fstab
+{{- end }}
 sudo setenforce 0 || true
 [ -f /etc/selinux/config ] && sudo sed -i 's/SELINUX=enforcing/SELINUX=permissive/g' /etc/selinux/config
 sudo systemctl disable --now firewalld || true
@@ -53,6 +55,9 @@ if sudo grep -q "packages.cloud.google.com" /etc/yum.repos.d/kubernetes.repo; th
   repo_migration_needed=true
 fi
 
+{{ if .KUBERNETES_REPOSITORY -}}
+{{ template "yum-repository" (dict "NAME" "kubernetes" "REPOSITORY" .KUBERNETES_REPOSITORY) }}
+{{- else -}}
 cat <<EOF | sudo tee /etc/yum.repos.d/kubernetes.repo
 [kubernetes]
 name=Kubernetes
@@ -61,6 +66,7 @@ enabled=1
 gpgcheck=1
 gpgkey=https://pkgs.k8s.io/core:/stable:/{{ .KUBERNETES_MAJOR_MINOR }}/rpm/repodata/repomd.xml.key
 EOF
+{{- end }}
 
 source /etc/os-release
 if [ "$ID" == "centos" ] && [ "$VERSION_ID" == "8" ]; then
@@ -152,20 +158,23 @@ func KubeadmCentOS(cluster *kubeoneapi.KubeOneCluster, force bool) (string, erro
 	}
 
 	data := Data{
-		"KUBELET":                true,
-		"KUBEADM":                true,
-		"KUBECTL":                true,
-		"KUBERNETES_VERSION":     cluster.Versions.Kubernetes,
-		"KUBERNETES_MAJOR_MINOR": cluster.Versions.KubernetesMajorMinorVersion(),
-		"KUBERNETES_CNI_VERSION": defaultKubernetesCNIVersion,
-		"CRITOOLS_VERSION":       criToolsVersion(cluster),
-		"CONFIGURE_REPOSITORIES": cluster.SystemPackages.ConfigureRepositories,
-		"PROXY":                  proxy,
-		"FORCE":                  force,
-		"INSTALL_DOCKER":         cluster.ContainerRuntime.Docker,
-		"INSTALL_CONTAINERD":     cluster.ContainerRuntime.Containerd,
-		"INSTALL_ISCSI_AND_NFS":  installISCSIAndNFS(cluster),
-		"IPV6_ENABLED":           cluster.ClusterNetwork.HasIPv6(),
+		"KUBELET":                      true,
+		"KUBEADM":                      true,
+		"KUBECTL":                      true,
+		"KUBERNETES_VERSION":           cluster.Versions.Kubernetes,
+		"KUBERNETES_MAJOR_MINOR":       cluster.Versions.KubernetesMajorMinorVersion(),
+		"KUBERNETES_CNI_VERSION":       defaultKubernetesCNIVersion,
+		"CRITOOLS_VERSION":             criToolsVersion(cluster),
+		"CONFIGURE_REPOSITORIES":       cluster.SystemPackages.ConfigureRepositories,
+		"KUBERNETES_REPOSITORY":        cluster.KubernetesRepository(),
+		"CONTAINER_RUNTIME_REPOSITORY": cluster.ContainerRuntimeRepository(),
+		"PROXY":                        proxy,
+		"FORCE":                        force,
+		"INSTALL_DOCKER":               cluster.ContainerRuntime.Docker,
+		"INSTALL_CONTAINERD":           cluster.ContainerRuntime.Containerd,
+		"INSTALL_ISCSI_AND_NFS":        installISCSIAndNFS(cluster),
+		"IPV6_ENABLED":                 cluster.ClusterNetwork.HasIPv6(),
+		"NODE_SWAP":                    cluster.Features.SwapEnabled(),
 	}
 
 	if err := containerruntime.UpdateDataMap(cluster, data); err != nil {
@@ -190,18 +199,21 @@ func UpgradeKubeadmAndCNICentOS(cluster *kubeoneapi.KubeOneCluster) (string, err
 	}
 
 	data := Data{
-		"UPGRADE":                true,
-		"KUBEADM":                true,
-		"KUBERNETES_VERSION":     cluster.Versions.Kubernetes,
-		"KUBERNETES_MAJOR_MINOR": cluster.Versions.KubernetesMajorMinorVersion(),
-		"KUBERNETES_CNI_VERSION": defaultKubernetesCNIVersion,
-		"CRITOOLS_VERSION":       criToolsVersion(cluster),
-		"CONFIGURE_REPOSITORIES": cluster.SystemPackages.ConfigureRepositories,
-		"PROXY":                  proxy,
-		"INSTALL_DOCKER":         cluster.ContainerRuntime.Docker,
-		"INSTALL_CONTAINERD":     cluster.ContainerRuntime.Containerd,
-		"INSTALL_ISCSI_AND_NFS":  installISCSIAndNFS(cluster),
-		"IPV6_ENABLED":           cluster.ClusterNetwork.HasIPv6(),
+		"UPGRADE":                      true,
+		"KUBEADM":                      true,
+		"KUBERNETES_VERSION":           cluster.Versions.Kubernetes,
+		"KUBERNETES_MAJOR_MINOR":       cluster.Versions.KubernetesMajorMinorVersion(),
+		"KUBERNETES_CNI_VERSION":       defaultKubernetesCNIVersion,
+		"CRITOOLS_VERSION":             criToolsVersion(cluster),
+		"CONFIGURE_REPOSITORIES":       cluster.SystemPackages.ConfigureRepositories,
+		"KUBERNETES_REPOSITORY":        cluster.KubernetesRepository(),
+		"CONTAINER_RUNTIME_REPOSITORY": cluster.ContainerRuntimeRepository(),
+		"PROXY":                        proxy,
+		"INSTALL_DOCKER":               cluster.ContainerRuntime.Docker,
+		"INSTALL_CONTAINERD":           cluster.ContainerRuntime.Containerd,
+		"INSTALL_ISCSI_AND_NFS":        installISCSIAndNFS(cluster),
+		"IPV6_ENABLED":                 cluster.ClusterNetwork.HasIPv6(),
+		"NODE_SWAP":                    cluster.Features.SwapEnabled(),
 	}
 
 	if err := containerruntime.UpdateDataMap(cluster, data); err != nil {
@@ -220,19 +232,22 @@ func UpgradeKubeletAndKubectlCentOS(cluster *kubeoneapi.KubeOneCluster) (string,
 	}
 
 	data := Data{
-		"UPGRADE":                true,
-		"KUBELET":                true,
-		"KUBECTL":                true,
-		"KUBERNETES_VERSION":     cluster.Versions.Kubernetes,
-		"KUBERNETES_MAJOR_MINOR": cluster.Versions.KubernetesMajorMinorVersion(),
-		"KUBERNETES_CNI_VERSION": defaultKubernetesCNIVersion,
-		"CRITOOLS_VERSION":       criToolsVersion(cluster),
-		"CONFIGURE_REPOSITORIES": cluster.SystemPackages.ConfigureRepositories,
-		"PROXY":                  proxy,
-		"INSTALL_DOCKER":         cluster.ContainerRuntime.Docker,
-		"INSTALL_CONTAINERD":     cluster.ContainerRuntime.Containerd,
-		"INSTALL_ISCSI_AND_NFS":  installISCSIAndNFS(cluster),
-		"IPV6_ENABLED":           cluster.ClusterNetwork.HasIPv6(),
+		"UPGRADE":                      true,
+		"KUBELET":                      true,
+		"KUBECTL":                      true,
+		"KUBERNETES_VERSION":           cluster.Versions.Kubernetes,
+		"KUBERNETES_MAJOR_MINOR":       cluster.Versions.KubernetesMajorMinorVersion(),
+		"KUBERNETES_CNI_VERSION":       defaultKubernetesCNIVersion,
+		"CRITOOLS_VERSION":             criToolsVersion(cluster),
+		"CONFIGURE_REPOSITORIES":       cluster.SystemPackages.ConfigureRepositories,
+		"KUBERNETES_REPOSITORY":        cluster.KubernetesRepository(),
+		"CONTAINER_RUNTIME_REPOSITORY": cluster.ContainerRuntimeRepository(),
+		"PROXY":                        proxy,
+		"INSTALL_DOCKER":               cluster.ContainerRuntime.Docker,
+		"INSTALL_CONTAINERD":           cluster.ContainerRuntime.Containerd,
+		"INSTALL_ISCSI_AND_NFS":        installISCSIAndNFS(cluster),
+		"IPV6_ENABLED":                 cluster.ClusterNetwork.HasIPv6(),
+		"NODE_SWAP":                    cluster.Features.SwapEnabled(),
 	}
 
 	if err := containerruntime.UpdateDataMap(cluster, data); err != nil {
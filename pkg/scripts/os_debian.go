@@ -24,8 +24,10 @@ import (
 
 const (
 	kubeadmDebianTemplate = `
+{{- if not .NODE_SWAP }}
 sudo swapoff -a
 sudo sed -i '/.*swap.*/d' /etc/fstab
+{{- end }}
 sudo systemctl disable --now ufw || true
 
 source /etc/kubeone/proxy-env
@@ -61,11 +63,15 @@ sudo systemctl enable --now iscsid
 {{- end }}
 
 {{- if .CONFIGURE_REPOSITORIES }}
+{{- if .KUBERNETES_REPOSITORY }}
+{{ template "apt-repository" (dict "NAME" "kubernetes" "REPOSITORY" .KUBERNETES_REPOSITORY) }}
+{{- else }}
 sudo install -m 0755 -d /etc/apt/keyrings
 
 curl -fsSL https://pkgs.k8s.io/core:/stable:/{{ .KUBERNETES_MAJOR_MINOR }}/deb/Release.key | sudo gpg --dearmor --yes -o /etc/apt/keyrings/kubernetes-apt-keyring.gpg
 
 echo "deb [signed-by=/etc/apt/keyrings/kubernetes-apt-keyring.gpg] https://pkgs.k8s.io/core:/stable:/{{ .KUBERNETES_MAJOR_MINOR }}/deb/ /" | sudo tee /etc/apt/sources.list.d/kubernetes.list
+{{- end }}
 
 sudo apt-get update
 {{- end }}
@@ -130,21 +136,24 @@ sudo systemctl daemon-reload
 
 func KubeadmDebian(cluster *kubeoneapi.KubeOneCluster, force bool) (string, error) {
 	data := Data{
-		"KUBELET":                true,
-		"KUBEADM":                true,
-		"KUBECTL":                true,
-		"KUBERNETES_VERSION":     cluster.Versions.Kubernetes,
-		"KUBERNETES_MAJOR_MINOR": cluster.Versions.KubernetesMajorMinorVersion(),
-		"KUBERNETES_CNI_VERSION": defaultKubernetesCNIVersion,
-		"CRITOOLS_VERSION":       criToolsVersion(cluster),
-		"CONFIGURE_REPOSITORIES": cluster.SystemPackages.ConfigureRepositories,
-		"HTTP_PROXY":             cluster.Proxy.HTTP,
-		"HTTPS_PROXY":            cluster.Proxy.HTTPS,
-		"FORCE":                  force,
-		"INSTALL_DOCKER":         cluster.ContainerRuntime.Docker,
-		"INSTALL_CONTAINERD":     cluster.ContainerRuntime.Containerd,
-		"INSTALL_ISCSI_AND_NFS":  installISCSIAndNFS(cluster),
-		"IPV6_ENABLED":           cluster.ClusterNetwork.HasIPv6(),
+		"KUBELET":                      true,
+		"KUBEADM":                      true,
+		"KUBECTL":                      true,
+		"KUBERNETES_VERSION":           cluster.Versions.Kubernetes,
+		"KUBERNETES_MAJOR_MINOR":       cluster.Versions.KubernetesMajorMinorVersion(),
+		"KUBERNETES_CNI_VERSION":       defaultKubernetesCNIVersion,
+		"CRITOOLS_VERSION":             criToolsVersion(cluster),
+		"CONFIGURE_REPOSITORIES":       cluster.SystemPackages.ConfigureRepositories,
+		"KUBERNETES_REPOSITORY":        cluster.KubernetesRepository(),
+		"CONTAINER_RUNTIME_REPOSITORY": cluster.ContainerRuntimeRepository(),
+		"HTTP_PROXY":                   cluster.Proxy.HTTP,
+		"HTTPS_PROXY":                  cluster.Proxy.HTTPS,
+		"FORCE":                        force,
+		"INSTALL_DOCKER":               cluster.ContainerRuntime.Docker,
+		"INSTALL_CONTAINERD":           cluster.ContainerRuntime.Containerd,
+		"INSTALL_ISCSI_AND_NFS":        installISCSIAndNFS(cluster),
+		"IPV6_ENABLED":                 cluster.ClusterNetwork.HasIPv6(),
+		"NODE_SWAP":                    cluster.Features.SwapEnabled(),
 	}
 
 	if err := containerruntime.UpdateDataMap(cluster, data); err != nil {
@@ -164,19 +173,22 @@ func RemoveBinariesDebian() (string, error) {
 
 func UpgradeKubeadmAndCNIDebian(cluster *kubeoneapi.KubeOneCluster) (string, error) {
 	data := Data{
-		"UPGRADE":                true,
-		"KUBEADM":                true,
-		"KUBERNETES_VERSION":     cluster.Versions.Kubernetes,
-		"KUBERNETES_MAJOR_MINOR": cluster.Versions.KubernetesMajorMinorVersion(),
-		"KUBERNETES_CNI_VERSION": defaultKubernetesCNIVersion,
-		"CRITOOLS_VERSION":       criToolsVersion(cluster),
-		"CONFIGURE_REPOSITORIES": cluster.SystemPackages.ConfigureRepositories,
-		"HTTP_PROXY":             cluster.Proxy.HTTP,
-		"HTTPS_PROXY":            cluster.Proxy.HTTPS,
-		"INSTALL_DOCKER":         cluster.ContainerRuntime.Docker,
-		"INSTALL_CONTAINERD":     cluster.ContainerRuntime.Containerd,
-		"INSTALL_ISCSI_AND_NFS":  installISCSIAndNFS(cluster),
-		"IPV6_ENABLED":           cluster.ClusterNetwork.HasIPv6(),
+		"UPGRADE":                      true,
+		"KUBEADM":                      true,
+		"KUBERNETES_VERSION":           cluster.Versions.Kubernetes,
+		"KUBERNETES_MAJOR_MINOR":       cluster.Versions.KubernetesMajorMinorVersion(),
+		"KUBERNETES_CNI_VERSION":       defaultKubernetesCNIVersion,
+		"CRITOOLS_VERSION":             criToolsVersion(cluster),
+		"CONFIGURE_REPOSITORIES":       cluster.SystemPackages.ConfigureRepositories,
+		"KUBERNETES_REPOSITORY":        cluster.KubernetesRepository(),
+		"CONTAINER_RUNTIME_REPOSITORY": cluster.ContainerRuntimeRepository(),
+		"HTTP_PROXY":                   cluster.Proxy.HTTP,
+		"HTTPS_PROXY":                  cluster.Proxy.HTTPS,
+		"INSTALL_DOCKER":               cluster.ContainerRuntime.Docker,
+		"INSTALL_CONTAINERD":           cluster.ContainerRuntime.Containerd,
+		"INSTALL_ISCSI_AND_NFS":        installISCSIAndNFS(cluster),
+		"IPV6_ENABLED":                 cluster.ClusterNetwork.HasIPv6(),
+		"NODE_SWAP":                    cluster.Features.SwapEnabled(),
 	}
 
 	if err := containerruntime.UpdateDataMap(cluster, data); err != nil {
@@ -190,20 +202,23 @@ func UpgradeKubeadmAndCNIDebian(cluster *kubeoneapi.KubeOneCluster) (string, err
 
 func UpgradeKubeletAndKubectlDebian(cluster *kubeoneapi.KubeOneCluster) (string, error) {
 	data := Data{
-		"UPGRADE":                true,
-		"KUBELET":                true,
-		"KUBECTL":                true,
-		"KUBERNETES_VERSION":     cluster.Versions.Kubernetes,
-		"KUBERNETES_MAJOR_MINOR": cluster.Versions.KubernetesMajorMinorVersion(),
-		"KUBERNETES_CNI_VERSION": defaultKubernetesCNIVersion,
-		"CRITOOLS_VERSION":       criToolsVersion(cluster),
-		"CONFIGURE_REPOSITORIES": cluster.SystemPackages.ConfigureRepositories,
-		"HTTP_PROXY":             cluster.Proxy.HTTP,
-		"HTTPS_PROXY":            cluster.Proxy.HTTPS,
-		"INSTALL_DOCKER":         cluster.ContainerRuntime.Docker,
-		"INSTALL_CONTAINERD":     cluster.ContainerRuntime.Containerd,
-		"INSTALL_ISCSI_AND_NFS":  installISCSIAndNFS(cluster),
-		"IPV6_ENABLED":           cluster.ClusterNetwork.HasIPv6(),
+		"UPGRADE":                      true,
+		"KUBELET":                      true,
+		"KUBECTL":                      true,
+		"KUBERNETES_VERSION":           cluster.Versions.Kubernetes,
+		"KUBERNETES_MAJOR_MINOR":       cluster.Versions.KubernetesMajorMinorVersion(),
+		"KUBERNETES_CNI_VERSION":       defaultKubernetesCNIVersion,
+		"CRITOOLS_VERSION":             criToolsVersion(cluster),
+		"CONFIGURE_REPOSITORIES":       cluster.SystemPackages.ConfigureRepositories,
+		"KUBERNETES_REPOSITORY":        cluster.KubernetesRepository(),
+		"CONTAINER_RUNTIME_REPOSITORY": cluster.ContainerRuntimeRepository(),
+		"HTTP_PROXY":                   cluster.Proxy.HTTP,
+		"HTTPS_PROXY":                  cluster.Proxy.HTTPS,
+		"INSTALL_DOCKER":               cluster.ContainerRuntime.Docker,
+		"INSTALL_CONTAINERD":           cluster.ContainerRuntime.Containerd,
+		"INSTALL_ISCSI_AND_NFS":        installISCSIAndNFS(cluster),
+		"IPV6_ENABLED":                 cluster.ClusterNetwork.HasIPv6(),
+		"NODE_SWAP":                    cluster.Features.SwapEnabled(),
 	}
 
 	if err := containerruntime.UpdateDataMap(cluster, data); err != nil {
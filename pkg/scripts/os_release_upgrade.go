@@ -0,0 +1,93 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scripts
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+
+	"k8c.io/kubeone/pkg/fail"
+)
+
+var (
+	ubuntuReleaseUpgradePrepareTemplate = heredoc.Doc(`
+		source /etc/kubeone/proxy-env
+
+		sudo apt-get update
+		sudo DEBIAN_FRONTEND=noninteractive apt-get install \
+			--option "Dpkg::Options::=--force-confold" \
+			--no-install-recommends \
+			-y \
+			ubuntu-release-upgrader-core
+		sudo DEBIAN_FRONTEND=noninteractive apt-get dist-upgrade \
+			--option "Dpkg::Options::=--force-confold" \
+			-y
+	`)
+
+	ubuntuReleaseUpgradeTemplate = heredoc.Doc(`
+		source /etc/kubeone/proxy-env
+
+		previous_codename=$(. /etc/os-release && echo "${VERSION_CODENAME}")
+
+		sudo sed -i 's/^Prompt=.*/Prompt={{ .PROMPT }}/' /etc/update-manager/release-upgrades
+		sudo DEBIAN_FRONTEND=noninteractive do-release-upgrade \
+			--frontend DistUpgradeViewNonInteractive \
+			--quiet
+
+		codename=$(. /etc/os-release && echo "${VERSION_CODENAME}")
+
+		# do-release-upgrade disables the third-party repositories, such as the
+		# Kubernetes and the containerd repositories, which are enabled again
+		# for the new release. The held packages are kept at their versions.
+		for file in /etc/apt/sources.list.d/*.list; do
+			sudo test -f "${file}" || continue
+			sudo sed -i -E \
+				-e 's/^# ?(deb .*) # disabled on upgrade to .*/\1/' \
+				-e "s/ ${previous_codename} / ${codename} /" \
+				"${file}"
+		done
+		sudo apt-get update
+	`)
+)
+
+// UbuntuReleaseUpgradePrepare installs the pending updates of the current
+// Ubuntu release, as do-release-upgrade upgrades only an up-to-date system
+func UbuntuReleaseUpgradePrepare() (string, error) {
+	result, err := Render(ubuntuReleaseUpgradePrepareTemplate, nil)
+
+	return result, fail.Runtime(err, "rendering ubuntuReleaseUpgradePrepareTemplate script")
+}
+
+// UbuntuReleaseUpgrade upgrades Ubuntu to the next release towards the
+// target release, only going through the LTS releases if the target is an
+// LTS release. The node has to be rebooted afterwards.
+func UbuntuReleaseUpgrade(targetRelease string) (string, error) {
+	// the LTS releases are the April releases of the even years
+	prompt := "normal"
+	year, month, _ := strings.Cut(targetRelease, ".")
+	if y, err := strconv.Atoi(year); err == nil && y%2 == 0 && month == "04" {
+		prompt = "lts"
+	}
+
+	result, err := Render(ubuntuReleaseUpgradeTemplate, Data{
+		"PROMPT": prompt,
+	})
+
+	return result, fail.Runtime(err, "rendering ubuntuReleaseUpgradeTemplate script")
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scripts
+
+import (
+	"testing"
+
+	"k8c.io/kubeone/pkg/testhelper"
+)
+
+func TestUbuntuReleaseUpgradePrepare(t *testing.T) {
+	t.Parallel()
+
+	got, err := UbuntuReleaseUpgradePrepare()
+	if err != nil {
+		t.Errorf("UbuntuReleaseUpgradePrepare() error = %v", err)
+
+		return
+	}
+
+	testhelper.DiffOutput(t, testhelper.FSGoldenName(t), got, *updateFlag)
+}
+
+func TestUbuntuReleaseUpgrade(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		targetRelease string
+	}{
+		{
+			name:          "lts",
+			targetRelease: "24.04",
+		},
+		{
+			name:          "interim",
+			targetRelease: "23.10",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := UbuntuReleaseUpgrade(tt.targetRelease)
+			if err != nil {
+				t.Errorf("UbuntuReleaseUpgrade() error = %v", err)
+
+				return
+			}
+
+			testhelper.DiffOutput(t, testhelper.FSGoldenName(t), got, *updateFlag)
+		})
+	}
+}
@@ -54,6 +54,13 @@ func withCiliumCNI(cls *kubeoneapi.KubeOneCluster) {
 	}
 }
 
+func withNodeSwap(cls *kubeoneapi.KubeOneCluster) {
+	cls.Features.NodeSwap = &kubeoneapi.NodeSwap{
+		Enable:       true,
+		SwapBehavior: kubeoneapi.SwapBehaviorLimitedSwap,
+	}
+}
+
 func withProxy(proxy string) genClusterOpts {
 	return func(cls *kubeoneapi.KubeOneCluster) {
 		cls.Proxy.HTTPS = proxy
@@ -78,6 +85,21 @@ func withInsecureRegistry(registry string) genClusterOpts {
 	}
 }
 
+func withPackageRepositories(cls *kubeoneapi.KubeOneCluster) {
+	cls.SystemPackages.Repositories = &kubeoneapi.PackageRepositories{
+		Kubernetes: &kubeoneapi.PackageRepository{
+			URL:       "https://mirror.example.com/kubernetes/",
+			GPGKeyURL: "https://mirror.example.com/kubernetes/Release.key",
+		},
+		ContainerRuntime: &kubeoneapi.PackageRepository{
+			URL:        "http://10.0.0.10/docker/",
+			Suite:      "jammy",
+			Components: []string{"stable"},
+			GPGKey:     "-----BEGIN PGP PUBLIC KEY BLOCK-----\n\nmQINBFit2ioBEADhWpZ8\n-----END PGP PUBLIC KEY BLOCK-----",
+		},
+	}
+}
+
 func withDefaultAssetConfiguration(cls *kubeoneapi.KubeOneCluster) {
 	cls.AssetConfiguration = kubeoneapi.AssetConfiguration{
 		Kubernetes: kubeoneapi.ImageAsset{
@@ -174,6 +196,18 @@ func TestKubeadmDebian(t *testing.T) {
 				cluster: genCluster(withCiliumCNI),
 			},
 		},
+		{
+			name: "with node swap",
+			args: args{
+				cluster: genCluster(withContainerd, withNodeSwap),
+			},
+		},
+		{
+			name: "with package repositories",
+			args: args{
+				cluster: genCluster(withContainerd, withPackageRepositories),
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -303,6 +337,12 @@ func TestKubeadmCentOS(t *testing.T) {
 				cluster: genCluster(withCiliumCNI),
 			},
 		},
+		{
+			name: "with package repositories",
+			args: args{
+				cluster: genCluster(withContainerd, withPackageRepositories),
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -45,6 +45,16 @@ var (
 			{{- end }}
 		`),
 
+		"nvidia-containerd-config": heredoc.Doc(`
+			config_before="$(sudo sha256sum {{ .CONTAINER_RUNTIME_CONFIG_PATH }} 2>/dev/null || true)"
+			{{ template "container-runtime-daemon-config" . }}
+			config_after="$(sudo sha256sum {{ .CONTAINER_RUNTIME_CONFIG_PATH }})"
+
+			if [[ "$toolkit_installed" == "true" || "$config_before" != "$config_after" ]]; then
+				sudo systemctl restart containerd
+			fi
+		`),
+
 		"containerd-systemd-setup": heredoc.Doc(`
 			sudo systemctl daemon-reload
 			sudo systemctl enable containerd
@@ -53,6 +63,9 @@ var (
 
 		"apt-docker-ce": heredoc.Docf(`
 			{{ if .CONFIGURE_REPOSITORIES }}
+			{{- if .CONTAINER_RUNTIME_REPOSITORY }}
+			{{ template "apt-repository" (dict "NAME" "docker" "REPOSITORY" .CONTAINER_RUNTIME_REPOSITORY) }}
+			{{- else }}
 			sudo install -m 0755 -d /etc/apt/keyrings
 			curl -fsSL https://download.docker.com/linux/ubuntu/gpg | sudo gpg --dearmor -o /etc/apt/keyrings/docker.gpg
 			# Docker provides two different apt repos for ubuntu, bionic and focal. The focal repo currently
@@ -60,6 +73,7 @@ var (
 			# Therefore, we use bionic repo which has all Docker versions.
 			echo "deb [signed-by=/etc/apt/keyrings/docker.gpg] https://download.docker.com/linux/ubuntu bionic stable" |
 				sudo tee /etc/apt/sources.list.d/docker.list
+			{{- end }}
 			sudo apt-get update
 			{{ end }}
 
@@ -112,10 +126,14 @@ var (
 
 		"yum-docker-ce": heredoc.Docf(`
 			{{- if .CONFIGURE_REPOSITORIES }}
+			{{- if .CONTAINER_RUNTIME_REPOSITORY }}
+			{{ template "yum-repository" (dict "NAME" "docker-ce" "REPOSITORY" .CONTAINER_RUNTIME_REPOSITORY) }}
+			{{- else }}
 			sudo yum install -y yum-utils
 			sudo yum-config-manager --add-repo=https://download.docker.com/linux/centos/docker-ce.repo
 			sudo yum-config-manager --save --setopt=docker-ce-stable.module_hotfixes=true >/dev/null
 			{{- end }}
+			{{- end }}
 
 			sudo yum versionlock delete docker-ce docker-ce-cli containerd.io || true
 
@@ -140,11 +158,16 @@ var (
 
 		"apt-containerd": heredoc.Docf(`
 			{{ if .CONFIGURE_REPOSITORIES }}
+			{{- if .CONTAINER_RUNTIME_REPOSITORY }}
+			{{ template "apt-repository" (dict "NAME" "docker" "REPOSITORY" .CONTAINER_RUNTIME_REPOSITORY) }}
+			sudo apt-get update
+			{{- else }}
 			sudo apt-get update
 			sudo apt-get install -y apt-transport-https ca-certificates curl software-properties-common lsb-release
 			curl -fsSL https://download.docker.com/linux/$(lsb_release -si | tr '[:upper:]' '[:lower:]')/gpg |
 				sudo apt-key add -
 			sudo add-apt-repository "deb https://download.docker.com/linux/$(lsb_release -si | tr '[:upper:]' '[:lower:]') $(lsb_release -cs) stable"
+			{{- end }}
 			{{ end }}
 
 			sudo apt-mark unhold containerd.io || true
@@ -155,7 +178,7 @@ var (
 				--allow-downgrades \
 				{{- end }}
 				-y \
-				containerd.io=%s
+				containerd.io={{ with .CONTAINERD_VERSION }}'{{ . }}-*'{{ else }}%s{{ end }}
 			sudo apt-mark hold containerd.io
 
 			{{ template "container-runtime-daemon-config" . }}
@@ -166,6 +189,9 @@ var (
 
 		"yum-containerd": heredoc.Docf(`
 			{{ if .CONFIGURE_REPOSITORIES }}
+			{{- if .CONTAINER_RUNTIME_REPOSITORY }}
+			{{ template "yum-repository" (dict "NAME" "docker-ce" "REPOSITORY" .CONTAINER_RUNTIME_REPOSITORY) }}
+			{{- else }}
 			sudo yum install -y yum-utils
 			sudo yum-config-manager --add-repo=https://download.docker.com/linux/centos/docker-ce.repo
 			{{- /*
@@ -173,10 +199,11 @@ var (
 			More info at: https://bugzilla.redhat.com/show_bug.cgi?id=1756473
 			*/}}
 			sudo yum-config-manager --save --setopt=docker-ce-stable.module_hotfixes=true
+			{{- end }}
 			{{ end }}
 
 			sudo yum versionlock delete containerd.io || true
-			sudo yum install -y containerd.io-%s
+			sudo yum install -y containerd.io-{{ with .CONTAINERD_VERSION }}{{ . }}{{ else }}%s{{ end }}
 			sudo yum versionlock add containerd.io
 
 			{{ template "container-runtime-daemon-config" . }}
@@ -187,7 +214,7 @@ var (
 
 		"yum-containerd-amzn": heredoc.Docf(`
 			sudo yum versionlock delete containerd || true
-			sudo yum install -y containerd-%s
+			sudo yum install -y containerd-{{ with .CONTAINERD_VERSION }}{{ . }}{{ else }}%s{{ end }}
 			sudo yum versionlock add containerd
 
 			{{ template "container-runtime-daemon-config" . }}
@@ -0,0 +1,69 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scripts
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+
+	"k8c.io/kubeone/pkg/fail"
+)
+
+var (
+	stopNodeTemplate = heredoc.Doc(`
+		# kubelet is disabled, so it doesn't restart the containers when the
+		# node is booted before the cluster is started again
+		sudo systemctl disable --now kubelet
+
+		# the workloads and the control plane components are stopped first,
+		# and etcd is stopped last, so it can shut down cleanly
+		etcd_ids=" $(sudo crictl ps --name='^etcd$' -q | tr '\n' ' ') "
+		for id in $(sudo crictl ps -q); do
+			case "$etcd_ids" in
+				*" $id "*) continue ;;
+			esac
+			sudo crictl stop --timeout {{ .TIMEOUT }} "$id" || true
+		done
+
+		for id in $etcd_ids; do
+			sudo crictl stop --timeout {{ .TIMEOUT }} "$id"
+		done
+
+		for id in $(sudo crictl pods -q); do
+			sudo crictl stopp "$id" || true
+		done
+	`)
+
+	startNodeTemplate = heredoc.Doc(`
+		sudo systemctl enable --now kubelet
+	`)
+)
+
+// StopNode stops kubelet and all containers on the node, etcd last. Each
+// container is given the timeout in seconds to stop gracefully.
+func StopNode(timeout int) (string, error) {
+	result, err := Render(stopNodeTemplate, Data{
+		"TIMEOUT": timeout,
+	})
+
+	return result, fail.Runtime(err, "rendering stopNodeTemplate script")
+}
+
+// StartNode starts kubelet stopped by StopNode, which starts the containers
+// on the node again
+func StartNode() string {
+	return startNodeTemplate
+}
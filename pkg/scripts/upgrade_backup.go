@@ -0,0 +1,156 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scripts
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+
+	"k8c.io/kubeone/pkg/fail"
+)
+
+const (
+	// UpgradeBackupDir is where the control plane backup taken before the
+	// upgrade is kept on each control plane node
+	UpgradeBackupDir = "/var/lib/kubeone-upgrade-backup"
+
+	// UpgradeBackupSnapshot is the etcd snapshot in the backup being taken
+	UpgradeBackupSnapshot = UpgradeBackupDir + ".new/etcd-snapshot.db"
+)
+
+var (
+	upgradeBackupEtcdSnapshotTemplate = heredoc.Doc(`
+		sudo rm -rf {{ .BACKUP_DIR }}.new
+		sudo mkdir -p {{ .BACKUP_DIR }}.new
+
+		sudo ctr --namespace k8s.io images pull {{ .ETCD_IMAGE }} >/dev/null
+		sudo ctr --namespace k8s.io run --rm --net-host \
+			--mount type=bind,src=/etc/kubernetes/pki/etcd,dst=/etc/kubernetes/pki/etcd,options=rbind:ro \
+			--mount type=bind,src={{ .BACKUP_DIR }}.new,dst=/backup,options=rbind:rw \
+			{{ .ETCD_IMAGE }} kubeone-upgrade-backup-snapshot \
+			etcdctl \
+			--endpoints=https://127.0.0.1:2379 \
+			--cacert=/etc/kubernetes/pki/etcd/ca.crt \
+			--cert=/etc/kubernetes/pki/etcd/healthcheck-client.crt \
+			--key=/etc/kubernetes/pki/etcd/healthcheck-client.key \
+			snapshot save /backup/etcd-snapshot.db
+	`)
+
+	upgradeBackupTemplate = heredoc.Doc(`
+		sudo mkdir -p {{ .BACKUP_DIR }}.new/kubelet
+		sudo cp -a /etc/kubernetes/manifests {{ .BACKUP_DIR }}.new/manifests
+		for file in config.yaml kubeadm-flags.env; do
+			if sudo test -f /var/lib/kubelet/${file}; then
+				sudo cp -a /var/lib/kubelet/${file} {{ .BACKUP_DIR }}.new/kubelet/
+			fi
+		done
+
+		sudo KUBECONFIG=/etc/kubernetes/admin.conf \
+			kubectl --namespace kube-system get configmap kubeadm-config --output yaml \
+			| sudo tee {{ .BACKUP_DIR }}.new/kubeadm-config.yaml >/dev/null
+		echo "{{ .KUBERNETES_VERSION }}" | sudo tee {{ .BACKUP_DIR }}.new/kubernetes-version >/dev/null
+
+		sudo rm -rf {{ .BACKUP_DIR }}
+		sudo mv {{ .BACKUP_DIR }}.new {{ .BACKUP_DIR }}
+	`)
+
+	upgradeRollbackTemplate = heredoc.Doc(`
+		sudo rm -rf {{ .RESTORE_DIR }}/etcd
+		sudo mkdir -p {{ .RESTORE_DIR }}
+
+		sudo ctr --namespace k8s.io images pull {{ .ETCD_IMAGE }} >/dev/null
+		sudo ctr --namespace k8s.io run --rm --net-host \
+			--mount type=bind,src={{ .BACKUP_DIR }},dst=/backup,options=rbind:ro \
+			--mount type=bind,src={{ .RESTORE_DIR }},dst=/restore,options=rbind:rw \
+			{{ .ETCD_IMAGE }} kubeone-upgrade-rollback-snapshot \
+			etcdutl snapshot restore /backup/etcd-snapshot.db \
+			--name {{ .NAME }} \
+			--initial-cluster {{ .INITIAL_CLUSTER }} \
+			--initial-cluster-token kubeone-upgrade-rollback \
+			--initial-advertise-peer-urls {{ .PEER_URL }} \
+			--data-dir /restore/etcd
+
+		if sudo test -d /var/lib/etcd; then
+			sudo mv /var/lib/etcd /var/lib/etcd.$(date +%s).bak
+		fi
+		sudo mv {{ .RESTORE_DIR }}/etcd /var/lib/etcd
+
+		sudo cp -a {{ .BACKUP_DIR }}/kubelet/. /var/lib/kubelet/
+		sudo rm -f /etc/kubernetes/manifests/*.yaml
+		sudo cp -a {{ .BACKUP_DIR }}/manifests/. /etc/kubernetes/manifests/
+		sudo rm -rf {{ .RESTORE_DIR }}
+	`)
+)
+
+// UpgradeBackupParams are parameters used to back up the control plane before
+// the upgrade and to roll it back
+type UpgradeBackupParams struct {
+	// KubernetesVersion is the version before the upgrade
+	KubernetesVersion string
+	// Name of the etcd member
+	Name string
+	// PeerURL of the etcd member
+	PeerURL string
+	// InitialCluster is a comma-separated list of the etcd members
+	InitialCluster string
+	// EtcdImage is an image with etcdctl and etcdutl
+	EtcdImage string
+}
+
+// UpgradeBackupEtcdSnapshot starts a new backup with the etcd snapshot, taken
+// from the local etcd member
+func UpgradeBackupEtcdSnapshot(params UpgradeBackupParams) (string, error) {
+	result, err := Render(upgradeBackupEtcdSnapshotTemplate, Data{
+		"BACKUP_DIR": UpgradeBackupDir,
+		"ETCD_IMAGE": params.EtcdImage,
+	})
+
+	return result, fail.Runtime(err, "rendering upgradeBackupEtcdSnapshotTemplate script")
+}
+
+// UpgradeBackup adds the static pod manifests, the kubelet configuration and
+// the kubeadm configuration to the new backup holding the etcd snapshot, and
+// replaces the previous backup with it
+func UpgradeBackup(params UpgradeBackupParams) (string, error) {
+	result, err := Render(upgradeBackupTemplate, Data{
+		"BACKUP_DIR":         UpgradeBackupDir,
+		"KUBERNETES_VERSION": params.KubernetesVersion,
+	})
+
+	return result, fail.Runtime(err, "rendering upgradeBackupTemplate script")
+}
+
+// UpgradeBackupVersion prints the Kubernetes version of the backup
+func UpgradeBackupVersion() string {
+	return "sudo cat " + UpgradeBackupDir + "/kubernetes-version"
+}
+
+// UpgradeRollback restores the etcd snapshot, the kubelet configuration and
+// the static pod manifests from the backup. etcd and kube-apiserver have to
+// be stopped by EtcdRestoreStop before, and they're started again with the
+// restored manifests.
+func UpgradeRollback(params UpgradeBackupParams) (string, error) {
+	result, err := Render(upgradeRollbackTemplate, Data{
+		"BACKUP_DIR":      UpgradeBackupDir,
+		"RESTORE_DIR":     etcdRestoreDir,
+		"NAME":            params.Name,
+		"PEER_URL":        params.PeerURL,
+		"INITIAL_CLUSTER": params.InitialCluster,
+		"ETCD_IMAGE":      params.EtcdImage,
+	})
+
+	return result, fail.Runtime(err, "rendering upgradeRollbackTemplate script")
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scripts
+
+import (
+	"testing"
+
+	"k8c.io/kubeone/pkg/testhelper"
+)
+
+func TestUpgradeBackupEtcdSnapshot(t *testing.T) {
+	t.Parallel()
+
+	got, err := UpgradeBackupEtcdSnapshot(UpgradeBackupParams{
+		EtcdImage: "gcr.io/etcd-development/etcd:v3.5.11",
+	})
+	if err != nil {
+		t.Errorf("UpgradeBackupEtcdSnapshot() error = %v", err)
+
+		return
+	}
+
+	testhelper.DiffOutput(t, testhelper.FSGoldenName(t), got, *updateFlag)
+}
+
+func TestUpgradeBackup(t *testing.T) {
+	t.Parallel()
+
+	got, err := UpgradeBackup(UpgradeBackupParams{
+		KubernetesVersion: "1.27.8",
+	})
+	if err != nil {
+		t.Errorf("UpgradeBackup() error = %v", err)
+
+		return
+	}
+
+	testhelper.DiffOutput(t, testhelper.FSGoldenName(t), got, *updateFlag)
+}
+
+func TestUpgradeRollback(t *testing.T) {
+	t.Parallel()
+
+	got, err := UpgradeRollback(UpgradeBackupParams{
+		Name:           "cp-0",
+		PeerURL:        "https://192.168.1.10:2380",
+		InitialCluster: "cp-0=https://192.168.1.10:2380,cp-1=https://192.168.1.11:2380,cp-2=https://192.168.1.12:2380",
+		EtcdImage:      "gcr.io/etcd-development/etcd:v3.5.11",
+	})
+	if err != nil {
+		t.Errorf("UpgradeRollback() error = %v", err)
+
+		return
+	}
+
+	testhelper.DiffOutput(t, testhelper.FSGoldenName(t), got, *updateFlag)
+}
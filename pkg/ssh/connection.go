@@ -34,6 +34,7 @@ import (
 
 	"k8c.io/kubeone/pkg/executor"
 	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/vault"
 )
 
 const socketEnvPrefix = "env:"
@@ -74,6 +75,21 @@ func validateOptions(o Opts) (Opts, error) {
 		return o, fail.ConfigValidation(errors.New("must specify at least one of password, private key, keyfile or agent socket"))
 	}
 
+	if vault.IsReference(o.KeyFile) {
+		ctx := o.Context
+		if ctx == nil {
+			ctx = context.Background()
+		}
+
+		key, err := vault.ReadValue(ctx, o.KeyFile)
+		if err != nil {
+			return o, err
+		}
+
+		o.PrivateKey = key
+		o.KeyFile = ""
+	}
+
 	if len(o.KeyFile) > 0 {
 		content, err := os.ReadFile(o.KeyFile)
 		if err != nil {
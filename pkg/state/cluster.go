@@ -24,6 +24,7 @@ import (
 	"github.com/Masterminds/semver/v3"
 
 	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/drift"
 	"k8c.io/kubeone/pkg/fail"
 
 	apiserverconfigv1 "k8s.io/apiserver/pkg/apis/config/v1"
@@ -38,6 +39,7 @@ type Cluster struct {
 	EncryptionConfiguration *EncryptionConfiguration
 	CCMClusterName          string
 	CCMStatus               *CCMStatus
+	CNIStatus               *CNIStatus
 	Lock                    sync.Mutex
 }
 
@@ -54,12 +56,32 @@ type CCMStatus struct {
 	CSIMigrationEnabled             bool
 }
 
+type CNIStatus struct {
+	// Addon is the name of the CNI addon deployed in the cluster, empty if
+	// the CNI plugin is not managed by KubeOne
+	Addon string
+	// MigratingFrom is the name of the CNI addon that nodes are still being
+	// migrated from, empty if there's no CNI migration in progress
+	MigratingFrom string
+}
+
 type Host struct {
 	Config *kubeoneapi.HostConfig
 
 	ContainerRuntimeDocker     ComponentStatus
 	ContainerRuntimeContainerd ComponentStatus
 	Kubelet                    ComponentStatus
+	CgroupVersion              kubeoneapi.CgroupVersion
+	CgroupDriver               kubeoneapi.CgroupDriver
+	OperatingSystemRelease     string
+	HasDefaultRoute            bool
+	GCEServiceAccountScopes    []string
+
+	// ManagedFilesRecorded is true if the hashes of the files managed by
+	// KubeOne are recorded on the host, and ConfigurationDrift are the
+	// managed files changed out of band since they were recorded
+	ManagedFilesRecorded bool
+	ConfigurationDrift   []drift.Change
 
 	// Applicable only for CP nodes
 	APIServer ContainerStatus
@@ -108,6 +108,7 @@ type State struct {
 	BackupFile                string
 	DestroyWorkers            bool
 	RemoveBinaries            bool
+	ReprovisionHosts          bool
 	ForceUpgrade              bool
 	ForceInstall              bool
 	UpgradeMachineDeployments bool
@@ -117,6 +118,16 @@ type State struct {
 	CredentialsFilePath       string
 	ManifestFilePath          string
 	PauseImage                string
+	EtcdSnapshotID            string
+
+	// Operation is the name of the running operation, e.g. apply, whose
+	// progress is published to the cluster. It's set once the operation is
+	// confirmed, and the progress is not published if it's empty.
+	Operation string
+
+	// Confirm asks the user to confirm the next step of the running
+	// operation. It's nil if the command can't ask for the confirmation.
+	Confirm func() (bool, error)
 }
 
 func (s *State) KubeadmVerboseFlag() string {
@@ -0,0 +1,168 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/credentials"
+	"k8c.io/kubeone/pkg/executor"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/state"
+
+	"sigs.k8s.io/yaml"
+)
+
+// azurePermissionsCMD obtains a token for the managed identity from the
+// instance metadata service and lists the permissions granted to the identity
+// on the cluster resource group
+const azurePermissionsCMD = `
+token=$(curl -sSf -H Metadata:true "http://169.254.169.254/metadata/identity/oauth2/token?%s" | sed -n 's/.*"access_token":"\([^"]*\)".*/\1/p')
+[ -n "${token}" ] || { echo "unable to obtain a token for the managed identity" >&2; exit 1; }
+curl -sSf -H "Authorization: Bearer ${token}" "https://management.azure.com/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Authorization/permissions?api-version=2022-04-01"
+`
+
+// azureRequiredActions is a list of actions required by the Azure CCM and the
+// Azure Disk CSI driver
+var azureRequiredActions = []string{
+	"Microsoft.Compute/virtualMachines/read",
+	"Microsoft.Compute/virtualMachines/write",
+	"Microsoft.Compute/disks/read",
+	"Microsoft.Compute/disks/write",
+	"Microsoft.Compute/disks/delete",
+	"Microsoft.Network/loadBalancers/read",
+	"Microsoft.Network/loadBalancers/write",
+	"Microsoft.Network/publicIPAddresses/read",
+	"Microsoft.Network/publicIPAddresses/write",
+	"Microsoft.Network/networkSecurityGroups/read",
+	"Microsoft.Network/networkSecurityGroups/write",
+	"Microsoft.Network/networkInterfaces/read",
+	"Microsoft.Network/networkInterfaces/write",
+}
+
+type azurePermission struct {
+	Actions    []string `json:"actions"`
+	NotActions []string `json:"notActions"`
+}
+
+type azurePermissionList struct {
+	Value []azurePermission `json:"value"`
+}
+
+// verifyAzureManagedIdentity ensures that the managed identity used by the
+// CCM and the CSI drivers is assigned to the control plane VMs and has the
+// role assignments needed to manage the cluster resources.
+func verifyAzureManagedIdentity(s *state.State) error {
+	azure := s.Cluster.CloudProvider.Azure
+	if azure == nil || azure.CredentialsMode != kubeoneapi.AzureCredentialsModeManagedIdentity {
+		return nil
+	}
+
+	creds, err := credentials.ProviderCredentials(s.Cluster.CloudProvider, s.CredentialsFilePath, credentials.TypeCCM)
+	if err != nil {
+		return err
+	}
+
+	var cloudConfig struct {
+		ResourceGroup string `json:"resourceGroup"`
+	}
+	if err = yaml.Unmarshal([]byte(s.Cluster.CloudProvider.CloudConfig), &cloudConfig); err != nil {
+		return fail.Config(err, "cloudConfig parsing")
+	}
+
+	tokenQuery := url.Values{}
+	tokenQuery.Set("api-version", "2018-02-01")
+	tokenQuery.Set("resource", "https://management.azure.com/")
+	if azure.UserAssignedIdentityID != "" {
+		tokenQuery.Set("client_id", azure.UserAssignedIdentityID)
+	}
+
+	cmd := fmt.Sprintf(azurePermissionsCMD,
+		tokenQuery.Encode(),
+		url.PathEscape(creds[credentials.AzureSubscriptionIDMC]),
+		url.PathEscape(cloudConfig.ResourceGroup),
+	)
+
+	return s.RunTaskOnLeader(func(s *state.State, node *kubeoneapi.HostConfig, conn executor.Interface) error {
+		s.Logger.Infoln("Verifying Azure managed identity permissions...")
+
+		out, stderr, _, err := conn.Exec(cmd)
+		if err != nil {
+			s.Logger.Errorf("Unable to list permissions of the managed identity: %s", strings.TrimSpace(stderr))
+			s.Logger.Warnf("Make sure that the managed identity is assigned to all control plane VMs and has a role assignment on the %q resource group.", cloudConfig.ResourceGroup)
+
+			return fail.RuntimeError{
+				Err: errors.WithStack(err),
+				Op:  "verifying azure managed identity",
+			}
+		}
+
+		var perms azurePermissionList
+		if err = json.Unmarshal([]byte(out), &perms); err != nil {
+			return fail.Runtime(err, "parsing azure managed identity permissions")
+		}
+
+		var missing []string
+		for _, action := range azureRequiredActions {
+			if !azureActionAllowed(perms.Value, action) {
+				missing = append(missing, action)
+			}
+		}
+
+		if len(missing) > 0 {
+			s.Logger.Errorf("The managed identity is missing %d permission(s) on the %q resource group: %s", len(missing), cloudConfig.ResourceGroup, missing)
+			s.Logger.Warnf("Assign the Contributor role, or a custom role granting those permissions, to the managed identity.")
+
+			return fail.RuntimeError{
+				Err: errors.New("managed identity is missing required permissions"),
+				Op:  "verifying azure managed identity",
+			}
+		}
+
+		return nil
+	})
+}
+
+// azureActionAllowed checks if the action is granted by any of the
+// permissions, taking wildcards and notActions into account
+func azureActionAllowed(perms []azurePermission, action string) bool {
+	for _, perm := range perms {
+		if azureActionsMatch(perm.Actions, action) && !azureActionsMatch(perm.NotActions, action) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func azureActionsMatch(patterns []string, action string) bool {
+	for _, pattern := range patterns {
+		expr := "(?i)^" + strings.ReplaceAll(regexp.QuoteMeta(pattern), `\*`, ".*") + "$"
+		if regexp.MustCompile(expr).MatchString(action) {
+			return true
+		}
+	}
+
+	return false
+}
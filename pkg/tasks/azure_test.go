@@ -0,0 +1,81 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import "testing"
+
+func TestAzureActionAllowed(t *testing.T) {
+	tests := []struct {
+		name   string
+		perms  []azurePermission
+		action string
+		want   bool
+	}{
+		{
+			name:   "contributor",
+			perms:  []azurePermission{{Actions: []string{"*"}, NotActions: []string{"Microsoft.Authorization/*/Delete", "Microsoft.Authorization/*/Write"}}},
+			action: "Microsoft.Network/loadBalancers/write",
+			want:   true,
+		},
+		{
+			name:   "provider wildcard",
+			perms:  []azurePermission{{Actions: []string{"Microsoft.Network/*"}}},
+			action: "Microsoft.Network/loadBalancers/write",
+			want:   true,
+		},
+		{
+			name:   "case insensitive",
+			perms:  []azurePermission{{Actions: []string{"microsoft.compute/virtualmachines/read"}}},
+			action: "Microsoft.Compute/virtualMachines/read",
+			want:   true,
+		},
+		{
+			name:   "excluded by notActions",
+			perms:  []azurePermission{{Actions: []string{"Microsoft.Network/*"}, NotActions: []string{"Microsoft.Network/*/write"}}},
+			action: "Microsoft.Network/loadBalancers/write",
+			want:   false,
+		},
+		{
+			name: "granted by another role assignment",
+			perms: []azurePermission{
+				{Actions: []string{"Microsoft.Network/*"}, NotActions: []string{"Microsoft.Network/*/write"}},
+				{Actions: []string{"Microsoft.Network/loadBalancers/*"}},
+			},
+			action: "Microsoft.Network/loadBalancers/write",
+			want:   true,
+		},
+		{
+			name:   "reader",
+			perms:  []azurePermission{{Actions: []string{"*/read"}}},
+			action: "Microsoft.Compute/disks/write",
+			want:   false,
+		},
+		{
+			name:   "no permissions",
+			action: "Microsoft.Compute/disks/read",
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := azureActionAllowed(tt.perms, tt.action); got != tt.want {
+				t.Errorf("azureActionAllowed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,578 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"time"
+
+	"github.com/pkg/errors"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/certificate"
+	"k8c.io/kubeone/pkg/clusterstatus/apiserverstatus"
+	"k8c.io/kubeone/pkg/executor"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/kubeconfig"
+	"k8c.io/kubeone/pkg/scripts"
+	"k8c.io/kubeone/pkg/state"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/util/retry"
+	bootstrapapi "k8s.io/cluster-bootstrap/token/api"
+	dynclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1alpha1 "github.com/kubermatic/machine-controller/pkg/apis/cluster/v1alpha1"
+)
+
+const (
+	// caRotationAnnotation is set on the machine templates of the
+	// MachineDeployments rolled out while the CA is rotated. The value
+	// identifies the rotation and its phase, so the MachineDeployments are
+	// not rolled out again when an interrupted rotation is resumed.
+	caRotationAnnotation = "kubeone.io/ca-rotation"
+
+	// caRotationTimeout is how long to wait for kube-apiserver to become
+	// healthy after it's restarted, and for kube-controller-manager to
+	// publish the CA bundle
+	caRotationTimeout = 5 * time.Minute
+
+	// the files in scripts.CARotationDir
+	caRotationOldCert = "old-ca.crt"
+	caRotationOldKey  = "old-ca.key"
+	caRotationNewCert = "new-ca.crt"
+	caRotationNewKey  = "new-ca.key"
+)
+
+// caRotationFiles are the files in scripts.CARotationDir uploaded to the
+// control plane nodes. The new key is written last, so the files are
+// complete once it's present.
+var caRotationFiles = []string{caRotationOldCert, caRotationOldKey, caRotationNewCert, caRotationNewKey}
+
+// caRotationPhase describes which CAs are trusted and which CA signs the
+// certificates in a phase of the CA rotation
+type caRotationPhase struct {
+	name string
+	// certs are bundled into ca.crt, the first one signs the certificates
+	certs []string
+	key   string
+	// renewCerts renews the certificates signed by the cluster CA
+	renewCerts bool
+}
+
+var (
+	// caRotationTrust makes all components trust both CAs, while the
+	// certificates are still signed by the old CA
+	caRotationTrust = caRotationPhase{
+		name:  "trust",
+		certs: []string{caRotationOldCert, caRotationNewCert},
+		key:   caRotationOldKey,
+	}
+
+	// caRotationSign renews the certificates with the new CA, while both CAs
+	// are still trusted
+	caRotationSign = caRotationPhase{
+		name:       "sign",
+		certs:      []string{caRotationNewCert, caRotationOldCert},
+		key:        caRotationNewKey,
+		renewCerts: true,
+	}
+
+	// caRotationFinalize removes the trust of the old CA
+	caRotationFinalize = caRotationPhase{
+		name:  "finalize",
+		certs: []string{caRotationNewCert},
+		key:   caRotationNewKey,
+	}
+)
+
+func caRotationPath(name string) string {
+	return path.Join(scripts.CARotationDir, name)
+}
+
+// prepareCARotation generates the new cluster CA and uploads it along with
+// the old CA to all nodes. The CA of a previous rotation that didn't finish
+// is reused, so the rotation can be resumed by running it again.
+func prepareCARotation(s *state.State) error {
+	if err := s.RunTaskOnControlPlane(downloadCARotationFiles, state.RunSequentially); err != nil {
+		return err
+	}
+
+	if _, found := s.Configuration.KubernetesPKI[caRotationPath(caRotationNewKey)]; found {
+		s.Logger.Warnln("Resuming the previous CA rotation that didn't finish...")
+	} else if err := generateCARotationFiles(s); err != nil {
+		return err
+	}
+
+	if err := s.RunTaskOnControlPlane(uploadCARotationFiles(caRotationFiles...), state.RunParallel); err != nil {
+		return err
+	}
+
+	// the CA keys are kept only on the control plane nodes
+	return s.RunTaskOnStaticWorkers(uploadCARotationFiles(caRotationOldCert, caRotationNewCert), state.RunParallel)
+}
+
+func downloadCARotationFiles(s *state.State, node *kubeoneapi.HostConfig, _ executor.Interface) error {
+	if _, found := s.Configuration.KubernetesPKI[caRotationPath(caRotationNewKey)]; found {
+		return nil
+	}
+
+	if _, _, err := s.Runner.RunRaw(fmt.Sprintf("sudo test -f %s", caRotationPath(caRotationNewKey))); err != nil {
+		// no rotation to resume on this node
+		return nil
+	}
+
+	sshfs := s.Runner.NewFS()
+	files := map[string][]byte{}
+	for _, name := range caRotationFiles {
+		buf, err := fs.ReadFile(sshfs, caRotationPath(name))
+		if err != nil {
+			return err
+		}
+		files[caRotationPath(name)] = buf
+	}
+
+	for fname, buf := range files {
+		s.Configuration.KubernetesPKI[fname] = buf
+	}
+
+	return nil
+}
+
+// generateCARotationFiles generates the new cluster CA, and keeps the current
+// cluster CA as the old CA
+func generateCARotationFiles(s *state.State) error {
+	if err := s.RunTaskOnLeader(certificate.DownloadKubePKI); err != nil {
+		return err
+	}
+
+	oldCert := s.Configuration.KubernetesPKI[certificate.KubernetesCACertPath]
+	oldKey := s.Configuration.KubernetesPKI[certificate.KubernetesCAKeyPath]
+
+	if n := bytes.Count(oldCert, []byte("-----BEGIN CERTIFICATE-----")); n != 1 {
+		return fail.RuntimeError{
+			Op:  "checking cluster CA",
+			Err: errors.Errorf("%s bundles %d certificates, expected a single CA certificate", certificate.KubernetesCACertPath, n),
+		}
+	}
+
+	if _, err := tls.X509KeyPair(oldCert, oldKey); err != nil {
+		return fail.Runtime(err, "checking cluster CA")
+	}
+
+	newCert, newKey, err := newClusterCA(s, oldCert)
+	if err != nil {
+		return err
+	}
+
+	s.Configuration.KubernetesPKI[caRotationPath(caRotationOldCert)] = oldCert
+	s.Configuration.KubernetesPKI[caRotationPath(caRotationOldKey)] = oldKey
+	s.Configuration.KubernetesPKI[caRotationPath(caRotationNewCert)] = newCert
+	s.Configuration.KubernetesPKI[caRotationPath(caRotationNewKey)] = newKey
+
+	return nil
+}
+
+// newClusterCA returns the kubernetes certificate authority configured in
+// the certificateAuthority block of the manifest, or generates a new
+// self-signed certificate authority if none is configured
+func newClusterCA(s *state.State, oldCert []byte) ([]byte, []byte, error) {
+	externalCAs, err := certificate.LoadExternalCAs(s.Context, s.Cluster.CertificateAuthority, s.ManifestFilePath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, ca := range externalCAs {
+		if ca.CertPath != certificate.KubernetesCACertPath {
+			continue
+		}
+
+		if certificate.SameCertificate(ca.Cert, oldCert) {
+			return nil, nil, fail.RuntimeError{
+				Op:  "checking cluster CA",
+				Err: errors.New("the kubernetes certificate authority configured in the manifest is already the cluster CA"),
+			}
+		}
+
+		s.Logger.Infoln("Using the kubernetes certificate authority configured in the manifest as the new cluster CA...")
+
+		return ca.Cert, ca.Key, nil
+	}
+
+	s.Logger.Infoln("Generating new cluster CA...")
+
+	return certificate.NewCA("kubernetes")
+}
+
+func uploadCARotationFiles(names ...string) state.NodeTask {
+	return func(s *state.State, _ *kubeoneapi.HostConfig, _ executor.Interface) error {
+		sshfs := s.Runner.NewFS()
+
+		if err := sshfs.MkdirAll(scripts.CARotationDir, 0700); err != nil {
+			return err
+		}
+
+		for _, name := range names {
+			if err := uploadPKIFile(sshfs, caRotationPath(name), s.Configuration.KubernetesPKI[caRotationPath(name)]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func uploadPKIFile(sshfs executor.MkdirFS, fname string, buf []byte) error {
+	f, err := sshfs.Open(fname)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	fw, _ := f.(executor.ExtendedFile)
+
+	if err = fw.Truncate(0); err != nil {
+		return err
+	}
+
+	if err = fw.Chmod(0600); err != nil {
+		return err
+	}
+
+	_, err = io.Copy(fw, bytes.NewBuffer(buf))
+
+	return err
+}
+
+// caRotationBundle returns the bundle of the CA certificates trusted in the
+// phase
+func caRotationBundle(s *state.State, phase caRotationPhase) []byte {
+	bundle := []byte{}
+	for _, name := range phase.certs {
+		bundle = append(bundle, s.Configuration.KubernetesPKI[caRotationPath(name)]...)
+	}
+
+	return bundle
+}
+
+// caRotationID identifies the rotation by the fingerprint of the new CA
+func caRotationID(s *state.State) string {
+	sum := sha256.Sum256(s.Configuration.KubernetesPKI[caRotationPath(caRotationNewCert)])
+
+	return hex.EncodeToString(sum[:])[:16]
+}
+
+// applyCARotationPhase installs the CA bundle of the phase on all control
+// plane and static worker nodes. The control plane nodes are updated one at a
+// time, so kube-apiserver stays available.
+func applyCARotationPhase(s *state.State, phase caRotationPhase) error {
+	s.Logger.Infof("Rotating cluster CA, %s phase...", phase.name)
+
+	err := s.RunTaskOnControlPlane(func(s *state.State, node *kubeoneapi.HostConfig, _ executor.Interface) error {
+		cmd, err := scripts.CARotationInstallCA(scripts.CARotationParams{
+			Certs:        phase.certs,
+			Key:          phase.key,
+			RenewCerts:   phase.renewCerts,
+			ControlPlane: true,
+			VerboseFlag:  s.KubeadmVerboseFlag(),
+		})
+		if err != nil {
+			return err
+		}
+
+		if _, _, err = s.Runner.RunRaw(cmd); err != nil {
+			return fail.SSH(err, "installing cluster CA")
+		}
+
+		return waitForKubeAPIServerHealthy(s, *node)
+	}, state.RunSequentially)
+	if err != nil {
+		return err
+	}
+
+	err = s.RunTaskOnStaticWorkers(func(s *state.State, _ *kubeoneapi.HostConfig, _ executor.Interface) error {
+		cmd, err := scripts.CARotationInstallCA(scripts.CARotationParams{
+			Certs: phase.certs,
+		})
+		if err != nil {
+			return err
+		}
+
+		_, _, err = s.Runner.RunRaw(cmd)
+
+		return fail.SSH(err, "installing cluster CA")
+	}, state.RunParallel)
+	if err != nil {
+		return err
+	}
+
+	// admin.conf is updated to trust the CA bundle of the phase, and the
+	// client certificate could have been renewed
+	s.DynamicClient = nil
+	if err = kubeconfig.BuildKubernetesClientset(s); err != nil {
+		return err
+	}
+
+	return updateClusterInfoCA(s, caRotationBundle(s, phase))
+}
+
+func waitForKubeAPIServerHealthy(s *state.State, node kubeoneapi.HostConfig) error {
+	// give kubelet time to stop the old kube-apiserver container
+	time.Sleep(10 * time.Second)
+
+	err := wait.PollUntilContextTimeout(s.Context, 5*time.Second, caRotationTimeout, true, func(context.Context) (bool, error) {
+		apiserverStatus, err := apiserverstatus.Get(s, node)
+		if err != nil {
+			return false, nil
+		}
+
+		return apiserverStatus.Health, nil
+	})
+
+	return fail.Runtime(err, "waiting for kube-apiserver on %q to become healthy", node.Hostname)
+}
+
+// updateClusterInfoCA updates the CA bundle in the cluster-info ConfigMap,
+// which is used by the joining nodes, including the nodes managed by
+// machine-controller, to trust the cluster
+func updateClusterInfoCA(s *state.State, bundle []byte) error {
+	key := dynclient.ObjectKey{Name: bootstrapapi.ConfigMapClusterInfo, Namespace: metav1.NamespacePublic}
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm := corev1.ConfigMap{}
+		if err := s.DynamicClient.Get(s.Context, key, &cm); err != nil {
+			return err
+		}
+
+		config, err := clientcmd.Load([]byte(cm.Data[bootstrapapi.KubeConfigKey]))
+		if err != nil {
+			return err
+		}
+
+		for _, cluster := range config.Clusters {
+			cluster.CertificateAuthorityData = bundle
+		}
+
+		buf, err := clientcmd.Write(*config)
+		if err != nil {
+			return err
+		}
+		cm.Data[bootstrapapi.KubeConfigKey] = string(buf)
+
+		return s.DynamicClient.Update(s.Context, &cm)
+	})
+
+	return fail.KubeClient(err, "updating %T %s", corev1.ConfigMap{}, key)
+}
+
+// restartKubeSystemWorkloads waits for kube-controller-manager to publish the
+// CA bundle to the kube-root-ca.crt ConfigMaps, and restarts the Deployments
+// and DaemonSets in the kube-system namespace, so they trust the new CA
+// before the certificates are signed by it
+func restartKubeSystemWorkloads(s *state.State) error {
+	newCert := bytes.TrimSpace(s.Configuration.KubernetesPKI[caRotationPath(caRotationNewCert)])
+	key := dynclient.ObjectKey{Name: "kube-root-ca.crt", Namespace: metav1.NamespaceSystem}
+
+	s.Logger.Infoln("Waiting for the CA bundle to be published...")
+
+	err := wait.PollUntilContextTimeout(s.Context, 5*time.Second, caRotationTimeout, true, func(ctx context.Context) (bool, error) {
+		cm := corev1.ConfigMap{}
+		if err := s.DynamicClient.Get(ctx, key, &cm); err != nil {
+			return false, nil
+		}
+
+		return bytes.Contains([]byte(cm.Data["ca.crt"]), newCert), nil
+	})
+	if err != nil {
+		return fail.KubeClient(err, "waiting for %T %s to trust the new CA", corev1.ConfigMap{}, key)
+	}
+
+	restartedAt := time.Now().Format(time.RFC3339)
+
+	deployments := appsv1.DeploymentList{}
+	if err = s.DynamicClient.List(s.Context, &deployments, dynclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+		return fail.KubeClient(err, "getting %T", deployments)
+	}
+
+	for _, dep := range deployments.Items {
+		s.Logger.Infof("Restarting Deployment %s...", dep.Name)
+
+		depKey := dynclient.ObjectKey{Name: dep.Name, Namespace: dep.Namespace}
+		err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			d := appsv1.Deployment{}
+			if getErr := s.DynamicClient.Get(s.Context, depKey, &d); getErr != nil {
+				return getErr
+			}
+
+			if d.Spec.Template.Annotations == nil {
+				d.Spec.Template.Annotations = map[string]string{}
+			}
+			d.Spec.Template.Annotations[restartedAtAnnotation] = restartedAt
+
+			return s.DynamicClient.Update(s.Context, &d)
+		})
+		if err != nil {
+			return fail.KubeClient(err, "restarting %T %s", dep, depKey)
+		}
+	}
+
+	daemonSets := appsv1.DaemonSetList{}
+	if err = s.DynamicClient.List(s.Context, &daemonSets, dynclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+		return fail.KubeClient(err, "getting %T", daemonSets)
+	}
+
+	for _, ds := range daemonSets.Items {
+		s.Logger.Infof("Restarting DaemonSet %s...", ds.Name)
+
+		dsKey := dynclient.ObjectKey{Name: ds.Name, Namespace: ds.Namespace}
+		err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			d := appsv1.DaemonSet{}
+			if getErr := s.DynamicClient.Get(s.Context, dsKey, &d); getErr != nil {
+				return getErr
+			}
+
+			if d.Spec.Template.Annotations == nil {
+				d.Spec.Template.Annotations = map[string]string{}
+			}
+			d.Spec.Template.Annotations[restartedAtAnnotation] = restartedAt
+
+			return s.DynamicClient.Update(s.Context, &d)
+		})
+		if err != nil {
+			return fail.KubeClient(err, "restarting %T %s", ds, dsKey)
+		}
+	}
+
+	return nil
+}
+
+// rolloutMachineDeploymentsForCARotation rolls out all MachineDeployments and
+// waits for them to be re-provisioned, so the nodes managed by
+// machine-controller join with the CA bundle and the certificates of the
+// phase
+func rolloutMachineDeploymentsForCARotation(s *state.State, phase caRotationPhase) error {
+	machineDeployments := clusterv1alpha1.MachineDeploymentList{}
+	if err := s.DynamicClient.List(s.Context, &machineDeployments, dynclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil
+		}
+
+		return fail.KubeClient(err, "getting %T", machineDeployments)
+	}
+
+	rotation := fmt.Sprintf("%s-%s", caRotationID(s), phase.name)
+	keys := []dynclient.ObjectKey{}
+
+	for _, md := range machineDeployments.Items {
+		key := dynclient.ObjectKey{Name: md.Name, Namespace: md.Namespace}
+		keys = append(keys, key)
+
+		if md.Spec.Template.Annotations[caRotationAnnotation] == rotation {
+			continue
+		}
+
+		s.Logger.Infof("Rolling out MachineDeployment %s...", md.Name)
+
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			m := clusterv1alpha1.MachineDeployment{}
+			if getErr := s.DynamicClient.Get(s.Context, key, &m); getErr != nil {
+				return getErr
+			}
+
+			if m.Spec.Template.Annotations == nil {
+				m.Spec.Template.Annotations = map[string]string{}
+			}
+			m.Spec.Template.Annotations[caRotationAnnotation] = rotation
+
+			return s.DynamicClient.Update(s.Context, &m)
+		})
+		if err != nil {
+			return fail.KubeClient(err, "rolling out %T %s", md, key)
+		}
+	}
+
+	for _, key := range keys {
+		if err := waitForMachineDeploymentRollout(s, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rebootstrapKubelets makes kubelet on the control plane and static worker
+// nodes, one node at a time, bootstrap new client and serving certificates
+// signed by the new CA
+func rebootstrapKubelets(s *state.State) error {
+	err := s.RunTaskOnLeader(func(s *state.State, _ *kubeoneapi.HostConfig, _ executor.Interface) error {
+		cmd, err := scripts.CARotationBootstrapToken(s.JoinToken, time.Hour.String(), s.KubeadmVerboseFlag())
+		if err != nil {
+			return err
+		}
+
+		_, _, err = s.Runner.RunRaw(cmd)
+
+		return fail.SSH(err, "creating bootstrap token")
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.RunTaskOnAllNodes(rebootstrapKubelet, state.RunSequentially)
+}
+
+func rebootstrapKubelet(s *state.State, node *kubeoneapi.HostConfig, conn executor.Interface) error {
+	s.Logger.Infoln("Bootstrapping kubelet certificates...")
+
+	cmd, err := scripts.CARotationBootstrapKubelet(s.JoinToken)
+	if err != nil {
+		return err
+	}
+
+	if _, _, err = s.Runner.RunRaw(cmd); err != nil {
+		return fail.SSH(err, "bootstrapping kubelet certificates")
+	}
+
+	return approvePendingCSR(s, node, conn)
+}
+
+// cleanupCARotation removes the CA rotation files from all nodes
+func cleanupCARotation(s *state.State) error {
+	return s.RunTaskOnAllNodes(func(s *state.State, _ *kubeoneapi.HostConfig, _ executor.Interface) error {
+		cmd, err := scripts.CARotationCleanup()
+		if err != nil {
+			return err
+		}
+
+		_, _, err = s.Runner.RunRaw(cmd)
+
+		return fail.SSH(err, "removing CA rotation files")
+	}, state.RunParallel)
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+
+	"k8c.io/kubeone/pkg/configupload"
+	"k8c.io/kubeone/pkg/state"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	bootstrapapi "k8s.io/cluster-bootstrap/token/api"
+	dynclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestUpdateClusterInfoCA(t *testing.T) {
+	t.Parallel()
+
+	oldCert := []byte("old-ca\n")
+	newCert := []byte("new-ca\n")
+
+	config := clientcmdapi.NewConfig()
+	config.Clusters[""] = &clientcmdapi.Cluster{
+		Server:                   "https://10.0.0.1:6443",
+		CertificateAuthorityData: oldCert,
+	}
+	kubeconfig, err := clientcmd.Write(*config)
+	if err != nil {
+		t.Fatalf("writing kubeconfig: %v", err)
+	}
+
+	client := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      bootstrapapi.ConfigMapClusterInfo,
+			Namespace: metav1.NamespacePublic,
+		},
+		Data: map[string]string{
+			bootstrapapi.KubeConfigKey: string(kubeconfig),
+		},
+	}).Build()
+
+	s := &state.State{
+		Context:       context.Background(),
+		Logger:        logrus.New(),
+		DynamicClient: client,
+		Configuration: configupload.NewConfiguration(),
+	}
+	s.Configuration.KubernetesPKI[caRotationPath(caRotationOldCert)] = oldCert
+	s.Configuration.KubernetesPKI[caRotationPath(caRotationNewCert)] = newCert
+
+	phases := []struct {
+		phase caRotationPhase
+		want  []byte
+	}{
+		{phase: caRotationTrust, want: []byte("old-ca\nnew-ca\n")},
+		{phase: caRotationSign, want: []byte("new-ca\nold-ca\n")},
+		{phase: caRotationFinalize, want: newCert},
+	}
+
+	for _, tt := range phases {
+		if err = updateClusterInfoCA(s, caRotationBundle(s, tt.phase)); err != nil {
+			t.Fatalf("updateClusterInfoCA() %s phase error = %v", tt.phase.name, err)
+		}
+
+		cm := corev1.ConfigMap{}
+		key := dynclient.ObjectKey{Name: bootstrapapi.ConfigMapClusterInfo, Namespace: metav1.NamespacePublic}
+		if err = client.Get(context.Background(), key, &cm); err != nil {
+			t.Fatalf("getting cluster-info ConfigMap: %v", err)
+		}
+
+		got, loadErr := clientcmd.Load([]byte(cm.Data[bootstrapapi.KubeConfigKey]))
+		if loadErr != nil {
+			t.Fatalf("parsing cluster-info kubeconfig: %v", loadErr)
+		}
+
+		cluster := got.Clusters[""]
+		if !bytes.Equal(cluster.CertificateAuthorityData, tt.want) {
+			t.Errorf("%s phase CA data = %q, want %q", tt.phase.name, cluster.CertificateAuthorityData, tt.want)
+		}
+		if cluster.Server != "https://10.0.0.1:6443" {
+			t.Errorf("%s phase server = %q, want it unchanged", tt.phase.name, cluster.Server)
+		}
+	}
+}
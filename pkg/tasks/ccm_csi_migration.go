@@ -49,10 +49,20 @@ func ccmMigrationValidateConfig(s *state.State) error {
 		return fail.NewConfigError("validation", ".cloudProvider.external must be enabled to start the migration")
 	}
 
-	if !s.Cluster.CSIMigrationSupported() {
+	if !s.Cluster.CCMMigrationSupported() {
 		return fail.NewConfigError("validation", "ccm/csi migration is not supported for the specified provider")
 	}
 
+	if s.Cluster.CloudProvider.Nutanix != nil {
+		// Nutanix doesn't have an in-tree cloud provider, so the migration is
+		// considered started as soon as the Nutanix CCM is deployed
+		if s.LiveCluster.CCMStatus.ExternalCCMDeployed && !s.CCMMigrationComplete {
+			return fail.NewConfigError("validation", "the ccm migration is currently in progress or already done, run command with --complete to finish it")
+		}
+
+		return nil
+	}
+
 	if !s.LiveCluster.CCMStatus.InTreeCloudProviderEnabled {
 		return fail.NewConfigError("validation", "the cluster is already running external ccm")
 	} else if s.LiveCluster.CCMStatus.ExternalCCMDeployed && !s.CCMMigrationComplete {
@@ -101,7 +111,7 @@ func ccmMigrationRegenerateControlPlaneManifestsAndKubeletConfigInternal(s *stat
 	logger := s.Logger.WithField("node", node.PublicAddress)
 	logger.Info("Starting CCM/CSI migration...")
 
-	drainer := nodeutils.NewDrainer(s.RESTConfig, logger)
+	drainer := nodeutils.NewDrainer(s.RESTConfig, logger, s.Cluster.DrainConfig())
 
 	logger.Infoln("Cordoning node...")
 	if err := drainer.Cordon(s.Context, node.Hostname, true); err != nil {
@@ -164,7 +174,7 @@ func ccmMigrationUpdateStaticWorkersKubeletConfigInternal(s *state.State, node *
 	logger := s.Logger.WithField("node", node.PublicAddress)
 	logger.Info("Updating config and restarting Kubelet...")
 
-	drainer := nodeutils.NewDrainer(s.RESTConfig, logger)
+	drainer := nodeutils.NewDrainer(s.RESTConfig, logger, s.Cluster.DrainConfig())
 
 	logger.Infoln("Cordoning node...")
 	if err := drainer.Cordon(s.Context, node.Hostname, true); err != nil {
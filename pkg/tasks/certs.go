@@ -197,6 +197,89 @@ func saveCABundleOnControlPlane(s *state.State, _ *kubeoneapi.HostConfig, conn e
 	return fail.SSH(err, "save CABundle")
 }
 
+// ensureTrustedCAs installs or updates the additional trusted CAs bundle on
+// all nodes, or removes the previously installed bundle if the additional
+// trusted CAs are removed from the manifest.
+func ensureTrustedCAs(s *state.State) error {
+	if s.Cluster.AdditionalTrustedCAs == "" {
+		s.Logger.Infoln("Removing additional trusted CAs, if present...")
+
+		return s.RunTaskOnAllNodes(removeTrustedCAsOnNode, state.RunParallel)
+	}
+
+	s.Logger.Infoln("Installing additional trusted CAs...")
+	s.Configuration.AddFile("trusted-cas/"+cabundle.TrustedCAsFileName, s.Cluster.AdditionalTrustedCAs)
+
+	return s.RunTaskOnAllNodes(ensureTrustedCAsOnNode, state.RunParallel)
+}
+
+func removeTrustedCAsOnNode(s *state.State, node *kubeoneapi.HostConfig, _ executor.Interface) error {
+	return runOnOS(s, node.OperatingSystem, map[kubeoneapi.OperatingSystemName]runOnOSFn{
+		kubeoneapi.OperatingSystemNameAmazon:     removeTrustedCAsCentOS,
+		kubeoneapi.OperatingSystemNameCentOS:     removeTrustedCAsCentOS,
+		kubeoneapi.OperatingSystemNameDebian:     removeTrustedCAsDebian,
+		kubeoneapi.OperatingSystemNameFlatcar:    removeTrustedCAsFlatcar,
+		kubeoneapi.OperatingSystemNameRHEL:       removeTrustedCAsCentOS,
+		kubeoneapi.OperatingSystemNameRockyLinux: removeTrustedCAsCentOS,
+		kubeoneapi.OperatingSystemNameUbuntu:     removeTrustedCAsDebian,
+	})
+}
+
+func ensureTrustedCAsOnNode(s *state.State, node *kubeoneapi.HostConfig, conn executor.Interface) error {
+	if err := s.Configuration.UploadTo(conn, s.WorkDir); err != nil {
+		return err
+	}
+
+	return runOnOS(s, node.OperatingSystem, map[kubeoneapi.OperatingSystemName]runOnOSFn{
+		kubeoneapi.OperatingSystemNameAmazon:     saveTrustedCAsCentOS,
+		kubeoneapi.OperatingSystemNameCentOS:     saveTrustedCAsCentOS,
+		kubeoneapi.OperatingSystemNameDebian:     saveTrustedCAsDebian,
+		kubeoneapi.OperatingSystemNameFlatcar:    saveTrustedCAsFlatcar,
+		kubeoneapi.OperatingSystemNameRHEL:       saveTrustedCAsCentOS,
+		kubeoneapi.OperatingSystemNameRockyLinux: saveTrustedCAsCentOS,
+		kubeoneapi.OperatingSystemNameUbuntu:     saveTrustedCAsDebian,
+	})
+}
+
+func saveTrustedCAsDebian(s *state.State) error {
+	return runSaveTrustedCAs(s, scripts.SaveTrustedCAsDebian)
+}
+
+func saveTrustedCAsCentOS(s *state.State) error {
+	return runSaveTrustedCAs(s, scripts.SaveTrustedCAsCentOS)
+}
+
+func saveTrustedCAsFlatcar(s *state.State) error {
+	return runSaveTrustedCAs(s, scripts.SaveTrustedCAsFlatcar)
+}
+
+func removeTrustedCAsDebian(s *state.State) error {
+	return runTrustedCAsScript(s, scripts.RemoveTrustedCAsDebian, "removing additional trusted CAs")
+}
+
+func removeTrustedCAsCentOS(s *state.State) error {
+	return runTrustedCAsScript(s, scripts.RemoveTrustedCAsCentOS, "removing additional trusted CAs")
+}
+
+func removeTrustedCAsFlatcar(s *state.State) error {
+	return runTrustedCAsScript(s, scripts.RemoveTrustedCAsFlatcar, "removing additional trusted CAs")
+}
+
+func runSaveTrustedCAs(s *state.State, scriptFn func(workdir string) (string, error)) error {
+	return runTrustedCAsScript(s, scriptFn, "installing additional trusted CAs")
+}
+
+func runTrustedCAsScript(s *state.State, scriptFn func(workdir string) (string, error), op string) error {
+	cmd, err := scriptFn(s.WorkDir)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = s.Runner.RunRaw(cmd)
+
+	return fail.SSH(err, op)
+}
+
 func restartKubelet(s *state.State, node *kubeoneapi.HostConfig, _ executor.Interface) error {
 	s.Logger.WithField("node", node.PublicAddress).Debug("Restarting Kubelet to force regenerating CSRs...")
 
@@ -0,0 +1,81 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/executor"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/scripts"
+	"k8c.io/kubeone/pkg/state"
+)
+
+// ciliumEBPF returns the name of the eBPF datapath feature enabled for the
+// Cilium CNI plugin, the minimal kernel version it requires and whether it
+// requires BTF, or an empty name if no such feature is enabled
+func ciliumEBPF(cluster *kubeoneapi.KubeOneCluster) (string, string, bool) {
+	cni := cluster.ClusterNetwork.CNI
+	if cni == nil || cni.Cilium == nil {
+		return "", "", false
+	}
+
+	switch {
+	case cni.Cilium.HostRouting == kubeoneapi.CiliumHostRoutingBPF:
+		return "Cilium eBPF host routing", "5.10", true
+	case cni.Cilium.KubeProxyReplacement == kubeoneapi.KubeProxyReplacementStrict:
+		return "Cilium kube-proxy replacement", "4.19.57", false
+	}
+
+	return "", "", false
+}
+
+// ensureCiliumEBPFPrerequisites verifies that the kernel on all nodes
+// supports the eBPF datapath features enabled for Cilium, and prepares the
+// BPF filesystem mount and sysctls they rely on, so that the installation
+// fails early instead of ending up with a broken datapath
+func ensureCiliumEBPFPrerequisites(s *state.State) error {
+	feature, minKernel, requireBTF := ciliumEBPF(s.Cluster)
+
+	s.Logger.Infof("Verifying prerequisites for %s...", feature)
+
+	return s.RunTaskOnAllNodes(func(s *state.State, node *kubeoneapi.HostConfig, _ executor.Interface) error {
+		cmd, err := scripts.CiliumEBPFPrerequisites(feature, minKernel, requireBTF)
+		if err != nil {
+			return err
+		}
+
+		_, _, err = s.Runner.RunRaw(cmd)
+
+		return fail.SSH(err, "verifying prerequisites for %s on %s", feature, node.PublicAddress)
+	}, state.RunParallel)
+}
+
+// ciliumEBPFPrerequisitesTask runs the eBPF prerequisites checks only if an
+// eBPF datapath feature is enabled for Cilium. It's used both before the
+// cluster is initialized, to fail as early as possible, and on the later
+// applies, to cover the newly added nodes and the changed configuration.
+func ciliumEBPFPrerequisitesTask() Task {
+	return Task{
+		Fn:        ensureCiliumEBPFPrerequisites,
+		Operation: "verifying prerequisites for the Cilium eBPF datapath",
+		Predicate: func(s *state.State) bool {
+			feature, _, _ := ciliumEBPF(s.Cluster)
+
+			return feature != ""
+		},
+	}
+}
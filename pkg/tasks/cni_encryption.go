@@ -0,0 +1,70 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/executor"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/scripts"
+	"k8c.io/kubeone/pkg/state"
+)
+
+// cniEncryption returns the name of the transparent encryption enabled for
+// the CNI plugin and the kernel modules it requires, or an empty name if the
+// encryption is not enabled
+func cniEncryption(cluster *kubeoneapi.KubeOneCluster) (string, []string) {
+	cni := cluster.ClusterNetwork.CNI
+	if cni == nil {
+		return "", nil
+	}
+
+	switch {
+	case cni.Cilium != nil && cni.Cilium.Encryption == kubeoneapi.CiliumEncryptionWireGuard,
+		cni.Calico != nil && cni.Calico.EnableWireGuard:
+		return "WireGuard encryption", []string{"wireguard"}
+	case cni.Cilium != nil && cni.Cilium.Encryption == kubeoneapi.CiliumEncryptionIPsec:
+		modules := []string{"xfrm_user", "esp4"}
+		if cluster.ClusterNetwork.HasIPv6() {
+			modules = append(modules, "esp6")
+		}
+
+		return "IPsec encryption", modules
+	}
+
+	return "", nil
+}
+
+// ensureCNIEncryptionKernelSupport verifies that the kernel modules required
+// by the encryption of the CNI plugin can be loaded on all nodes, so that the
+// CNI plugin doesn't end up crashlooping on nodes with unsupported kernels
+func ensureCNIEncryptionKernelSupport(s *state.State) error {
+	feature, modules := cniEncryption(s.Cluster)
+
+	s.Logger.Infof("Verifying kernel support for %s...", feature)
+
+	return s.RunTaskOnAllNodes(func(s *state.State, node *kubeoneapi.HostConfig, _ executor.Interface) error {
+		cmd, err := scripts.LoadKernelModules(feature, modules)
+		if err != nil {
+			return err
+		}
+
+		_, _, err = s.Runner.RunRaw(cmd)
+
+		return fail.SSH(err, "verifying kernel support for %s on %s", feature, node.PublicAddress)
+	}, state.RunParallel)
+}
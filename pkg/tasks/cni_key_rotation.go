@@ -0,0 +1,76 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"github.com/pkg/errors"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/state"
+	"k8c.io/kubeone/pkg/templates/cilium"
+)
+
+// cniKeyRotationValidateConfig verifies that the CNI plugin uses the
+// encryption with the keys managed by KubeOne
+func cniKeyRotationValidateConfig(s *state.State) error {
+	cni := s.Cluster.ClusterNetwork.CNI
+
+	switch {
+	case cni != nil && cni.Cilium != nil && cni.Cilium.Encryption == kubeoneapi.CiliumEncryptionIPsec:
+		return nil
+	case cni != nil && cni.Cilium != nil && cni.Cilium.Encryption == kubeoneapi.CiliumEncryptionWireGuard,
+		cni != nil && cni.Calico != nil && cni.Calico.EnableWireGuard:
+		return fail.ConfigValidation(errors.New("the WireGuard keys are generated on each node by the CNI plugin, only the Cilium IPsec key can be rotated"))
+	default:
+		return fail.ConfigValidation(errors.New("the encryption of the CNI plugin is not enabled"))
+	}
+}
+
+// rotateCiliumIPsecKey replaces the Cilium IPsec key and waits for the Cilium
+// agents on all nodes to switch to the new key. The agents keep decrypting the
+// traffic encrypted with the previous key until all nodes switch, so the pod
+// traffic is not disrupted.
+func rotateCiliumIPsecKey(s *state.State) error {
+	keyID, err := cilium.IPsecKeyID(s)
+	if err != nil {
+		return err
+	}
+
+	// the key can't be rotated again while the nodes are still switching to
+	// the current key, e.g. if the previous rotation was interrupted
+	s.Logger.Infof("Waiting for all nodes to use the current IPsec key %d...", keyID)
+	if err = cilium.WaitForIPsecKey(s, keyID); err != nil {
+		return err
+	}
+
+	s.Logger.Info("Rotating Cilium IPsec key...")
+	newKeyID, err := cilium.RotateIPsecKey(s)
+	if err != nil {
+		return err
+	}
+
+	s.Logger.Infof("Waiting for all nodes to switch to the IPsec key %d...", newKeyID)
+	if err = cilium.WaitForIPsecKey(s, newKeyID); err != nil {
+		return err
+	}
+
+	s.Logger.Warn("The Cilium agents remove the previous IPsec key after the key rotation duration (5 minutes by default).")
+	s.Logger.Warn("Don't rotate the key again before that, or the traffic between the nodes might be disrupted.")
+
+	return nil
+}
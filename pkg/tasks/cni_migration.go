@@ -0,0 +1,568 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"k8c.io/kubeone/pkg/addons"
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/executor"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/nodeutils"
+	"k8c.io/kubeone/pkg/scripts"
+	"k8c.io/kubeone/pkg/state"
+	"k8c.io/kubeone/pkg/templates/resources"
+
+	clusterv1alpha1 "github.com/kubermatic/machine-controller/pkg/apis/cluster/v1alpha1"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	dynclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// cniMigrationAnnotation marks the control plane nodes, static worker
+	// nodes and MachineDeployments that are not migrated to the new CNI
+	// plugin yet. The value is the name of the previous CNI addon.
+	cniMigrationAnnotation = "kubeone.io/cni-migration-from"
+
+	// restartedAtAnnotation is set on the pod and machine templates to roll
+	// them out
+	restartedAtAnnotation = "kubeone.io/restartedAt"
+
+	cniMigrationNodeTimeout   = 5 * time.Minute
+	cniMigrationVerifyTimeout = 10 * time.Minute
+)
+
+// cniPlugin describes a CNI plugin deployed by a KubeOne addon
+type cniPlugin struct {
+	addon     string
+	daemonSet string
+
+	// files are the CNI configuration files in /etc/cni/net.d
+	files []string
+	// links are the network interfaces created on the nodes
+	links []string
+	// chains are the prefixes of the iptables chains
+	chains []string
+}
+
+var cniPlugins = []cniPlugin{
+	{
+		addon:     resources.AddonCNICanal,
+		daemonSet: "canal",
+		files:     []string{"10-canal.conflist", "calico-kubeconfig"},
+		links:     []string{"flannel.1", "flannel-v6.1"},
+		chains:    []string{"cali-", "FLANNEL"},
+	},
+	{
+		addon:     resources.AddonCNICilium,
+		daemonSet: "cilium",
+		files:     []string{"05-cilium.conflist"},
+		links:     []string{"cilium_host", "cilium_net", "cilium_vxlan", "cilium_geneve"},
+		chains:    []string{"CILIUM_"},
+	},
+	{
+		addon:     resources.AddonCNICalico,
+		daemonSet: "calico-node",
+		files:     []string{"10-calico.conflist", "calico-kubeconfig"},
+		links:     []string{"vxlan.calico", "vxlan-v6.calico"},
+		chains:    []string{"cali-"},
+	},
+	{
+		addon:     resources.AddonCNIWeavenet,
+		daemonSet: "weave-net",
+		files:     []string{"10-weave.conflist"},
+		links:     []string{"weave", "datapath", "vxlan-6784"},
+		chains:    []string{"WEAVE"},
+	},
+}
+
+func cniPluginByAddon(addon string) (cniPlugin, bool) {
+	for _, plugin := range cniPlugins {
+		if plugin.addon == addon {
+			return plugin, true
+		}
+	}
+
+	return cniPlugin{}, false
+}
+
+// detectCNIStatus detects the CNI plugin deployed in the cluster, and the
+// CNI plugin the nodes are being migrated from
+func detectCNIStatus(s *state.State, nodes []corev1.Node) (*state.CNIStatus, error) {
+	status := &state.CNIStatus{}
+	desired := addons.CNIAddonName(s.Cluster.ClusterNetwork.CNI)
+
+	for _, plugin := range cniPlugins {
+		ds := appsv1.DaemonSet{}
+		key := dynclient.ObjectKey{Name: plugin.daemonSet, Namespace: metav1.NamespaceSystem}
+
+		if err := s.DynamicClient.Get(s.Context, key, &ds); err != nil {
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+
+			return nil, fail.KubeClient(err, "getting %T %s", ds, key)
+		}
+
+		// Prefer the configured CNI plugin if multiple CNI plugins are deployed
+		if status.Addon == "" || plugin.addon == desired {
+			status.Addon = plugin.addon
+		}
+	}
+
+	for _, node := range nodes {
+		if from := node.Annotations[cniMigrationAnnotation]; from != "" {
+			status.MigratingFrom = from
+
+			return status, nil
+		}
+	}
+
+	machineDeployments := clusterv1alpha1.MachineDeploymentList{}
+	err := s.DynamicClient.List(s.Context, &machineDeployments, dynclient.InNamespace(metav1.NamespaceSystem))
+	if err != nil {
+		if meta.IsNoMatchError(err) {
+			return status, nil
+		}
+
+		return nil, fail.KubeClient(err, "getting %T", machineDeployments)
+	}
+
+	for _, md := range machineDeployments.Items {
+		if from := md.Annotations[cniMigrationAnnotation]; from != "" {
+			status.MigratingFrom = from
+
+			break
+		}
+	}
+
+	return status, nil
+}
+
+func cniMigrationValidateConfig(s *state.State) error {
+	st := s.LiveCluster.CNIStatus
+	desired := addons.CNIAddonName(s.Cluster.ClusterNetwork.CNI)
+
+	if desired == "" {
+		return fail.NewConfigError("validation", "the CNI plugin can be migrated only to canal, cilium, calico or weaveNet")
+	}
+
+	if st == nil {
+		return fail.RuntimeError{
+			Op:  "detecting CNI plugin",
+			Err: errors.New("the CNI plugin deployed in the cluster is unknown"),
+		}
+	}
+
+	if st.MigratingFrom != "" {
+		// Resume the migration that was interrupted
+		if st.MigratingFrom == desired || (st.Addon != desired && st.Addon != st.MigratingFrom) {
+			return fail.NewConfigError("validation", "the migration from %q is in progress, it must be finished using the CNI plugin it was started with", st.MigratingFrom)
+		}
+
+		return nil
+	}
+
+	if st.Addon == "" {
+		return fail.NewConfigError("validation", "only CNI plugins deployed by KubeOne can be migrated, but no such CNI plugin is deployed in the cluster")
+	}
+
+	if st.Addon == desired {
+		return fail.NewConfigError("validation", "the cluster is already running the %q CNI plugin", desired)
+	}
+
+	kubeProxyDeployed := true
+	kubeProxyDs := appsv1.DaemonSet{}
+	if err := s.DynamicClient.Get(s.Context, KubeProxyObjectKey, &kubeProxyDs); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return fail.KubeClient(err, "getting kube-proxy daemonset")
+		}
+		kubeProxyDeployed = false
+	}
+
+	skipKubeProxy := s.Cluster.ClusterNetwork.KubeProxy != nil && s.Cluster.ClusterNetwork.KubeProxy.SkipInstallation
+
+	switch {
+	case skipKubeProxy && kubeProxyDeployed:
+		return fail.NewConfigError("validation", "migrating to a CNI plugin replacing kube-proxy is not supported, kube-proxy must stay deployed")
+	case !skipKubeProxy && !kubeProxyDeployed:
+		return fail.NewConfigError("validation", "migrating from a CNI plugin replacing kube-proxy is not supported, kube-proxy is not deployed")
+	}
+
+	return nil
+}
+
+// cniMigrationMarkNodes marks all nodes and MachineDeployments to be migrated
+// from the currently deployed CNI plugin, so the migration can be resumed if
+// it gets interrupted
+func cniMigrationMarkNodes(s *state.State) error {
+	st := s.LiveCluster.CNIStatus
+	if st.MigratingFrom != "" {
+		return nil
+	}
+
+	s.Logger.Infof("Marking nodes for the migration from %s...", st.Addon)
+
+	for _, hosts := range [][]kubeoneapi.HostConfig{s.Cluster.ControlPlane.Hosts, s.Cluster.StaticWorkers.Hosts} {
+		for _, host := range hosts {
+			if err := setNodeCNIMigrationAnnotation(s, host.Hostname, st.Addon); err != nil {
+				return err
+			}
+		}
+	}
+
+	if s.Cluster.MachineController.Deploy {
+		machineDeployments := clusterv1alpha1.MachineDeploymentList{}
+		if err := s.DynamicClient.List(s.Context, &machineDeployments, dynclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+			return fail.KubeClient(err, "getting %T", machineDeployments)
+		}
+
+		for i := range machineDeployments.Items {
+			md := machineDeployments.Items[i]
+			key := dynclient.ObjectKeyFromObject(&md)
+
+			err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+				if err := s.DynamicClient.Get(s.Context, key, &md); err != nil {
+					return err
+				}
+
+				if md.Annotations == nil {
+					md.Annotations = map[string]string{}
+				}
+				md.Annotations[cniMigrationAnnotation] = st.Addon
+
+				return s.DynamicClient.Update(s.Context, &md)
+			})
+			if err != nil {
+				return fail.KubeClient(err, "marking %T %s", md, key)
+			}
+		}
+	}
+
+	st.MigratingFrom = st.Addon
+
+	return nil
+}
+
+// cniMigrationReplaceAddon deletes the addon of the previous CNI plugin and
+// deploys the addon of the configured CNI plugin
+func cniMigrationReplaceAddon(s *state.State) error {
+	st := s.LiveCluster.CNIStatus
+
+	// The previous CNI addon might be already deleted if the migration is
+	// being resumed
+	if st.Addon == st.MigratingFrom {
+		if err := addons.DeleteCNIAddon(s, st.MigratingFrom); err != nil {
+			return err
+		}
+	}
+
+	if err := addons.EnsureCNI(s); err != nil {
+		return err
+	}
+
+	st.Addon = addons.CNIAddonName(s.Cluster.ClusterNetwork.CNI)
+
+	return nil
+}
+
+func cniMigrationMigrateNodes(s *state.State) error {
+	if err := s.RunTaskOnControlPlane(cniMigrateNode, state.RunSequentially); err != nil {
+		return err
+	}
+
+	return s.RunTaskOnStaticWorkers(cniMigrateNode, state.RunSequentially)
+}
+
+// cniMigrateNode drains the node, removes leftovers of the previous CNI plugin
+// and recreates pods on the node, so they are attached to the new pod network
+func cniMigrateNode(s *state.State, node *kubeoneapi.HostConfig, _ executor.Interface) error {
+	logger := s.Logger.WithField("node", node.PublicAddress)
+
+	nodeObj := corev1.Node{}
+	if err := s.DynamicClient.Get(s.Context, dynclient.ObjectKey{Name: node.Hostname}, &nodeObj); err != nil {
+		return fail.KubeClient(err, "getting %T %s", nodeObj, node.Hostname)
+	}
+
+	from := nodeObj.Annotations[cniMigrationAnnotation]
+	if from == "" {
+		logger.Debugln("Node is already migrated to the new CNI plugin.")
+
+		return nil
+	}
+
+	previous, ok := cniPluginByAddon(from)
+	if !ok {
+		return fail.RuntimeError{
+			Op:  "migrating CNI plugin",
+			Err: errors.Errorf("unknown CNI addon %q", from),
+		}
+	}
+	current, _ := cniPluginByAddon(s.LiveCluster.CNIStatus.Addon)
+
+	drainer := nodeutils.NewDrainer(s.RESTConfig, logger, s.Cluster.DrainConfig())
+
+	logger.Infoln("Cordoning node...")
+	if err := drainer.Cordon(s.Context, node.Hostname, true); err != nil {
+		return err
+	}
+
+	logger.Infoln("Draining node...")
+	if err := drainer.Drain(s.Context, node.Hostname); err != nil {
+		return err
+	}
+
+	logger.Infof("Removing leftovers of the %s addon...", from)
+	cmd, err := scripts.CNICleanup(
+		sets.NewString(previous.files...).Difference(sets.NewString(current.files...)).List(),
+		sets.NewString(previous.links...).Difference(sets.NewString(current.links...)).List(),
+		sets.NewString(previous.chains...).Difference(sets.NewString(current.chains...)).List(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if _, _, err = s.Runner.RunRaw(cmd); err != nil {
+		return fail.SSH(err, "removing leftovers of the %s addon", from)
+	}
+
+	logger.Infoln("Recreating pods attached to the pod network...")
+	if err = recreatePodNetworkPods(s, node.Hostname); err != nil {
+		return err
+	}
+
+	logger.Infoln("Waiting for the new CNI plugin to become ready...")
+	if err = waitForCNIReady(s, current.daemonSet, node.Hostname); err != nil {
+		return err
+	}
+
+	logger.Infoln("Uncordoning node...")
+	if err = drainer.Cordon(s.Context, node.Hostname, false); err != nil {
+		return err
+	}
+
+	return setNodeCNIMigrationAnnotation(s, node.Hostname, "")
+}
+
+// setNodeCNIMigrationAnnotation sets the CNI migration annotation on the
+// node, or removes it if the value is empty
+func setNodeCNIMigrationAnnotation(s *state.State, nodeName, value string) error {
+	key := dynclient.ObjectKey{Name: nodeName}
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		node := corev1.Node{}
+		if err := s.DynamicClient.Get(s.Context, key, &node); err != nil {
+			return err
+		}
+
+		if value == "" {
+			delete(node.Annotations, cniMigrationAnnotation)
+		} else {
+			if node.Annotations == nil {
+				node.Annotations = map[string]string{}
+			}
+			node.Annotations[cniMigrationAnnotation] = value
+		}
+
+		return s.DynamicClient.Update(s.Context, &node)
+	})
+
+	return fail.KubeClient(err, "annotating node %q with %q", nodeName, cniMigrationAnnotation)
+}
+
+// recreatePodNetworkPods deletes pods on the node that are not using the host
+// network. Pods that are not evicted by the drain, e.g. DaemonSet pods, are
+// recreated this way with the new CNI plugin.
+func recreatePodNetworkPods(s *state.State, nodeName string) error {
+	pods := corev1.PodList{}
+	if err := s.DynamicClient.List(s.Context, &pods, dynclient.MatchingFields{"spec.nodeName": nodeName}); err != nil {
+		return fail.KubeClient(err, "getting %T on node %q", pods, nodeName)
+	}
+
+	for i := range pods.Items {
+		pod := pods.Items[i]
+		if pod.Spec.HostNetwork || pod.DeletionTimestamp != nil {
+			continue
+		}
+
+		if err := s.DynamicClient.Delete(s.Context, &pod); dynclient.IgnoreNotFound(err) != nil {
+			return fail.KubeClient(err, "deleting %T %s", pod, dynclient.ObjectKeyFromObject(&pod))
+		}
+	}
+
+	return nil
+}
+
+// waitForCNIReady waits for the CNI plugin pod on the node and the node to
+// become ready
+func waitForCNIReady(s *state.State, daemonSet, nodeName string) error {
+	ds := appsv1.DaemonSet{}
+	dsKey := dynclient.ObjectKey{Name: daemonSet, Namespace: metav1.NamespaceSystem}
+	if err := s.DynamicClient.Get(s.Context, dsKey, &ds); err != nil {
+		return fail.KubeClient(err, "getting %T %s", ds, dsKey)
+	}
+
+	err := wait.PollUntilContextTimeout(s.Context, 5*time.Second, cniMigrationNodeTimeout, true, func(ctx context.Context) (bool, error) {
+		pods := corev1.PodList{}
+		err := s.DynamicClient.List(ctx, &pods,
+			dynclient.InNamespace(metav1.NamespaceSystem),
+			dynclient.MatchingLabels(ds.Spec.Selector.MatchLabels),
+			dynclient.MatchingFields{"spec.nodeName": nodeName},
+		)
+		if err != nil || len(pods.Items) == 0 || !podReady(&pods.Items[0]) {
+			return false, nil
+		}
+
+		node := corev1.Node{}
+		if err = s.DynamicClient.Get(ctx, dynclient.ObjectKey{Name: nodeName}, &node); err != nil {
+			return false, nil
+		}
+
+		for _, cond := range node.Status.Conditions {
+			if cond.Type == corev1.NodeReady {
+				return cond.Status == corev1.ConditionTrue, nil
+			}
+		}
+
+		return false, nil
+	})
+
+	return fail.KubeClient(err, "waiting for %s to become ready on node %q", daemonSet, nodeName)
+}
+
+func podReady(pod *corev1.Pod) bool {
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodReady {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+// cniMigrationRolloutMachineDeployments rolls out the MachineDeployments, so
+// worker nodes managed by machine-controller are replaced with nodes using
+// the new CNI plugin
+func cniMigrationRolloutMachineDeployments(s *state.State) error {
+	machineDeployments := clusterv1alpha1.MachineDeploymentList{}
+	if err := s.DynamicClient.List(s.Context, &machineDeployments, dynclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+		return fail.KubeClient(err, "getting %T", machineDeployments)
+	}
+
+	for i := range machineDeployments.Items {
+		md := machineDeployments.Items[i]
+		if md.Annotations[cniMigrationAnnotation] == "" {
+			continue
+		}
+
+		s.Logger.Infof("Rolling out MachineDeployment %s...", md.Name)
+		key := dynclient.ObjectKeyFromObject(&md)
+
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			if err := s.DynamicClient.Get(s.Context, key, &md); err != nil {
+				return err
+			}
+
+			if md.Spec.Template.Annotations == nil {
+				md.Spec.Template.Annotations = map[string]string{}
+			}
+			md.Spec.Template.Annotations[restartedAtAnnotation] = time.Now().Format(time.RFC3339)
+			delete(md.Annotations, cniMigrationAnnotation)
+
+			return s.DynamicClient.Update(s.Context, &md)
+		})
+		if err != nil {
+			return fail.KubeClient(err, "rolling out %T %s", md, key)
+		}
+	}
+
+	return nil
+}
+
+// cniMigrationVerify verifies that the new CNI plugin is ready on all nodes,
+// and that pods can reach the Kubernetes API over the pod network by
+// restarting CoreDNS
+func cniMigrationVerify(s *state.State) error {
+	current, _ := cniPluginByAddon(s.LiveCluster.CNIStatus.Addon)
+	dsKey := dynclient.ObjectKey{Name: current.daemonSet, Namespace: metav1.NamespaceSystem}
+
+	s.Logger.Infof("Waiting for %s to become ready on all nodes...", current.daemonSet)
+	err := wait.PollUntilContextTimeout(s.Context, 5*time.Second, cniMigrationVerifyTimeout, true, func(ctx context.Context) (bool, error) {
+		ds := appsv1.DaemonSet{}
+		if err := s.DynamicClient.Get(ctx, dsKey, &ds); err != nil {
+			return false, nil
+		}
+
+		return ds.Status.ObservedGeneration >= ds.Generation &&
+			ds.Status.UpdatedNumberScheduled == ds.Status.DesiredNumberScheduled &&
+			ds.Status.NumberReady == ds.Status.DesiredNumberScheduled, nil
+	})
+	if err != nil {
+		return fail.KubeClient(err, "waiting for %T %s to become ready", appsv1.DaemonSet{}, dsKey)
+	}
+
+	s.Logger.Infoln("Verifying pod network connectivity by restarting CoreDNS...")
+	depKey := dynclient.ObjectKey{Name: "coredns", Namespace: metav1.NamespaceSystem}
+
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		dep := appsv1.Deployment{}
+		if err = s.DynamicClient.Get(s.Context, depKey, &dep); err != nil {
+			return err
+		}
+
+		if dep.Spec.Template.Annotations == nil {
+			dep.Spec.Template.Annotations = map[string]string{}
+		}
+		dep.Spec.Template.Annotations[restartedAtAnnotation] = time.Now().Format(time.RFC3339)
+
+		return s.DynamicClient.Update(s.Context, &dep)
+	})
+	if err != nil {
+		return fail.KubeClient(err, "restarting %T %s", appsv1.Deployment{}, depKey)
+	}
+
+	err = wait.PollUntilContextTimeout(s.Context, 5*time.Second, cniMigrationVerifyTimeout, true, func(ctx context.Context) (bool, error) {
+		dep := appsv1.Deployment{}
+		if err := s.DynamicClient.Get(ctx, depKey, &dep); err != nil {
+			return false, nil
+		}
+
+		replicas := int32(1)
+		if dep.Spec.Replicas != nil {
+			replicas = *dep.Spec.Replicas
+		}
+
+		return dep.Status.ObservedGeneration >= dep.Generation &&
+			dep.Status.UpdatedReplicas == replicas &&
+			dep.Status.AvailableReplicas == replicas &&
+			dep.Status.Replicas == replicas, nil
+	})
+
+	return fail.KubeClient(err, "waiting for %T %s to become available", appsv1.Deployment{}, depKey)
+}
@@ -21,9 +21,12 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/Masterminds/semver/v3"
+
 	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
 	"k8c.io/kubeone/pkg/executor"
 	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/nodeutils"
 	"k8c.io/kubeone/pkg/scripts"
 	"k8c.io/kubeone/pkg/state"
 
@@ -160,3 +163,85 @@ func migrateToContainerdTask(s *state.State, node *kubeoneapi.HostConfig, _ exec
 
 	return fail.KubeClient(err, "waiting all pods on %q to became Ready...", node.Hostname)
 }
+
+// ContainerdUpgradeHosts returns the control plane and static worker nodes
+// running a containerd version older than the pinned version
+func ContainerdUpgradeHosts(s *state.State) []state.Host {
+	pinned, err := semver.NewVersion(s.Cluster.ContainerRuntime.ContainerdVersion())
+	if err != nil {
+		// the version is not pinned
+		return nil
+	}
+
+	var hosts []state.Host
+	for _, nodes := range [][]state.Host{s.LiveCluster.ControlPlane, s.LiveCluster.StaticWorkers} {
+		for _, host := range nodes {
+			// Flatcar ships containerd with the OS, and newer versions are
+			// not downgraded
+			installed := host.ContainerRuntimeContainerd.Version
+			if host.Config.OperatingSystem != kubeoneapi.OperatingSystemNameFlatcar && installed != nil && installed.LessThan(pinned) {
+				hosts = append(hosts, host)
+			}
+		}
+	}
+
+	return hosts
+}
+
+func containerdUpgradeNeeded(s *state.State) bool {
+	return len(ContainerdUpgradeHosts(s)) > 0
+}
+
+// upgradeContainerd upgrades containerd to the pinned version, one node at a
+// time to minimize cluster disruption
+func upgradeContainerd(s *state.State) error {
+	outdated := map[string]bool{}
+	for _, host := range ContainerdUpgradeHosts(s) {
+		outdated[host.Config.Hostname] = true
+	}
+
+	return s.RunTaskOnAllNodes(func(s *state.State, node *kubeoneapi.HostConfig, conn executor.Interface) error {
+		if !outdated[node.Hostname] {
+			return nil
+		}
+
+		return upgradeContainerdExecutor(s, node, conn)
+	}, state.RunSequentially)
+}
+
+func upgradeContainerdExecutor(s *state.State, node *kubeoneapi.HostConfig, _ executor.Interface) error {
+	logger := s.Logger.WithField("node", node.PublicAddress)
+
+	drainer := nodeutils.NewDrainer(s.RESTConfig, logger, s.Cluster.DrainConfig())
+
+	logger.Infoln("Cordoning node...")
+	if err := drainer.Cordon(s.Context, node.Hostname, true); err != nil {
+		return err
+	}
+
+	logger.Infoln("Draining node...")
+	if err := drainer.Drain(s.Context, node.Hostname); err != nil {
+		return err
+	}
+
+	if err := setupProxy(logger, s); err != nil {
+		return err
+	}
+
+	logger.Infof("Upgrading containerd to %s...", s.Cluster.ContainerRuntime.ContainerdVersion())
+	cmd, err := scripts.UpgradeContainerd(s.Cluster, node)
+	if err != nil {
+		return err
+	}
+
+	if _, _, err = s.Runner.RunRaw(cmd); err != nil {
+		return fail.SSH(err, "upgrading containerd")
+	}
+
+	logger.Infof("Waiting %v to ensure all components are up...", timeoutNodeUpgrade)
+	time.Sleep(timeoutNodeUpgrade)
+
+	logger.Infoln("Uncordoning node...")
+
+	return drainer.Cordon(s.Context, node.Hostname, false)
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/state"
+)
+
+func containerdHost(name string, osName kubeoneapi.OperatingSystemName, version string) state.Host {
+	host := state.Host{
+		Config: &kubeoneapi.HostConfig{Hostname: name, OperatingSystem: osName},
+	}
+	if version != "" {
+		host.ContainerRuntimeContainerd.Version = semver.MustParse(version)
+	}
+
+	return host
+}
+
+func TestContainerdUpgradeHosts(t *testing.T) {
+	tests := []struct {
+		name          string
+		pinned        string
+		controlPlane  []state.Host
+		staticWorkers []state.Host
+		want          []string
+	}{
+		{
+			name: "version not pinned",
+			controlPlane: []state.Host{
+				containerdHost("cp-0", kubeoneapi.OperatingSystemNameUbuntu, "1.6.20"),
+			},
+		},
+		{
+			name:   "older versions are upgraded",
+			pinned: "1.6.24",
+			controlPlane: []state.Host{
+				containerdHost("cp-0", kubeoneapi.OperatingSystemNameUbuntu, "1.6.24"),
+				containerdHost("cp-1", kubeoneapi.OperatingSystemNameUbuntu, "1.6.20"),
+			},
+			staticWorkers: []state.Host{
+				containerdHost("worker-0", kubeoneapi.OperatingSystemNameRockyLinux, "1.6.21"),
+			},
+			want: []string{"cp-1", "worker-0"},
+		},
+		{
+			name:   "newer versions, flatcar and docker nodes are skipped",
+			pinned: "1.6.24",
+			controlPlane: []state.Host{
+				containerdHost("cp-0", kubeoneapi.OperatingSystemNameUbuntu, "1.7.0"),
+				containerdHost("cp-1", kubeoneapi.OperatingSystemNameFlatcar, "1.6.16"),
+				containerdHost("cp-2", kubeoneapi.OperatingSystemNameUbuntu, ""),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			s := &state.State{
+				Cluster: &kubeoneapi.KubeOneCluster{
+					ContainerRuntime: kubeoneapi.ContainerRuntimeConfig{
+						Containerd: &kubeoneapi.ContainerRuntimeContainerd{Version: tt.pinned},
+					},
+				},
+				LiveCluster: &state.Cluster{
+					ControlPlane:  tt.controlPlane,
+					StaticWorkers: tt.staticWorkers,
+				},
+			}
+
+			var got []string
+			for _, host := range ContainerdUpgradeHosts(s) {
+				got = append(got, host.Config.Hostname)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ContainerdUpgradeHosts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
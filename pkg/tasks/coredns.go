@@ -18,6 +18,8 @@ package tasks
 
 import (
 	"context"
+	"regexp"
+	"strings"
 
 	"k8c.io/kubeone/pkg/fail"
 	"k8c.io/kubeone/pkg/state"
@@ -28,6 +30,16 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	corefileKey          = "Corefile"
+	corefileRootZone     = ".:53 {"
+	corefileHostUpstream = "/etc/resolv.conf"
+)
+
+// corefileForwardRegexp matches the forward plugin directive, capturing the
+// beginning of the directive and the opening of its options block
+var corefileForwardRegexp = regexp.MustCompile(`(?m)^([ \t]*forward \.) [^{\n]*?( \{)?$`)
+
 func patchCoreDNS(s *state.State) error {
 	s.Logger.Infoln("Patching CoreDNS...")
 
@@ -76,6 +88,55 @@ func patchCoreDNS(s *state.State) error {
 	}
 
 	err = s.DynamicClient.Update(ctx, &dep)
+	if err != nil {
+		return fail.KubeClient(err, "updating %T %s", dep, key)
+	}
+
+	return patchCorefile(s)
+}
+
+// patchCorefile points the forward plugin of the CoreDNS root zone to the
+// upstream DNS servers from the cluster DNS config, or to the resolv.conf of
+// the CoreDNS pods (i.e. the resolv.conf used by kubelet) if not configured.
+// CoreDNS reloads the Corefile on its own.
+func patchCorefile(s *state.State) error {
+	upstream := corefileHostUpstream
+	if dns := s.Cluster.ClusterNetwork.DNS; dns != nil && len(dns.UpstreamServers) > 0 {
+		upstream = strings.Join(dns.UpstreamServers, " ")
+	}
+
+	cm := corev1.ConfigMap{}
+	key := client.ObjectKey{
+		Name:      "coredns",
+		Namespace: metav1.NamespaceSystem,
+	}
+
+	if err := s.DynamicClient.Get(s.Context, key, &cm); err != nil {
+		return fail.KubeClient(err, "getting %T %s", cm, key)
+	}
+
+	corefile := setCorefileUpstream(cm.Data[corefileKey], upstream)
+	if corefile == cm.Data[corefileKey] {
+		return nil
+	}
+	cm.Data[corefileKey] = corefile
+
+	return fail.KubeClient(s.DynamicClient.Update(s.Context, &cm), "updating %T %s", cm, key)
+}
+
+// setCorefileUpstream replaces the upstream servers of the forward plugin in
+// the root zone of the Corefile, keeping the other zones untouched
+func setCorefileUpstream(corefile, upstream string) string {
+	root := strings.Index(corefile, corefileRootZone)
+	if root < 0 {
+		return corefile
+	}
+
+	loc := corefileForwardRegexp.FindStringSubmatchIndex(corefile[root:])
+	if loc == nil {
+		return corefile
+	}
+	forward := corefileForwardRegexp.ExpandString(nil, "${1} "+upstream+"${2}", corefile[root:], loc)
 
-	return fail.KubeClient(err, "updating %T %s", dep, key)
+	return corefile[:root+loc[0]] + string(forward) + corefile[root+loc[1]:]
 }
@@ -0,0 +1,91 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_setCorefileUpstream(t *testing.T) {
+	// kubeadm generated Corefile with an additional zone
+	corefile := `.:53 {
+    errors
+    health {
+       lameduck 5s
+    }
+    ready
+    kubernetes cluster.local in-addr.arpa ip6.arpa {
+       pods insecure
+       fallthrough in-addr.arpa ip6.arpa
+       ttl 30
+    }
+    prometheus :9153
+    forward . /etc/resolv.conf {
+       max_concurrent 1000
+    }
+    cache 30
+    loop
+    reload
+    loadbalance
+}
+example.com:53 {
+    forward . 10.0.0.10
+}
+`
+
+	tests := []struct {
+		name     string
+		corefile string
+		upstream string
+		want     string
+	}{
+		{
+			name:     "upstream servers",
+			corefile: corefile,
+			upstream: "1.1.1.1 8.8.8.8",
+			want:     strings.Replace(corefile, "forward . /etc/resolv.conf {", "forward . 1.1.1.1 8.8.8.8 {", 1),
+		},
+		{
+			name:     "unchanged upstream",
+			corefile: corefile,
+			upstream: corefileHostUpstream,
+			want:     corefile,
+		},
+		{
+			name:     "forward without options block",
+			corefile: ".:53 {\n    forward . 1.1.1.1\n}\n",
+			upstream: corefileHostUpstream,
+			want:     ".:53 {\n    forward . /etc/resolv.conf\n}\n",
+		},
+		{
+			name:     "no root zone",
+			corefile: "example.com:53 {\n    forward . 10.0.0.10\n}\n",
+			upstream: "1.1.1.1",
+			want:     "example.com:53 {\n    forward . 10.0.0.10\n}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if got := setCorefileUpstream(tt.corefile, tt.upstream); got != tt.want {
+				t.Errorf("setCorefileUpstream() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
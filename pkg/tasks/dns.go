@@ -0,0 +1,209 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"time"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/executor"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/scripts"
+	"k8c.io/kubeone/pkg/state"
+	"k8c.io/kubeone/pkg/templates/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	kubeletconfigv1beta1 "k8s.io/kubelet/config/v1beta1"
+)
+
+const (
+	// systemdResolvedResolvConf is the resolv.conf used by kubeadm for
+	// kubelet on the hosts running systemd-resolved
+	systemdResolvedResolvConf = "/run/systemd/resolve/resolv.conf"
+
+	systemdResolvedActiveCMD = `systemctl is-active --quiet systemd-resolved`
+)
+
+// writeResolvConf generates the resolv.conf used by kubelet on all nodes. It
+// runs before the cluster is initialized, as kubelet fails to create the pod
+// sandboxes if the configured resolv.conf doesn't exist.
+func writeResolvConf(s *state.State) error {
+	s.Logger.Infoln("Generating resolv.conf for kubelet...")
+
+	return s.RunTaskOnAllNodes(func(s *state.State, node *kubeoneapi.HostConfig, _ executor.Interface) error {
+		return runResolvConfScript(s, node)
+	}, state.RunParallel)
+}
+
+// ensureClusterDNS reconfigures kubelets on already provisioned nodes if the
+// cluster DNS config has been added or removed since the last apply, and
+// regenerates the resolv.conf used by kubelet from the current config
+func ensureClusterDNS(s *state.State) error {
+	if err := ensureKubeletConfigMapResolverConfig(s); err != nil {
+		return err
+	}
+
+	s.Logger.Infoln("Reconciling resolv.conf for kubelet...")
+
+	return s.RunTaskOnAllNodes(ensureClusterDNSOnNode, state.RunParallel)
+}
+
+func runResolvConfScript(s *state.State, node *kubeoneapi.HostConfig) error {
+	cmd, err := scripts.ResolvConf(s.Cluster.ClusterNetwork.DNS)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = s.Runner.RunRaw(cmd)
+
+	return fail.SSH(err, "generating resolv.conf on %s", node.PublicAddress)
+}
+
+// ensureKubeletConfigMapResolverConfig updates the kubelet configuration used
+// by kubeadm when joining new nodes. If the cluster DNS is not configured
+// anymore, the resolverConfig is unset, so that kubeadm detects it again.
+func ensureKubeletConfigMapResolverConfig(s *state.State) error {
+	if s.DynamicClient == nil {
+		return fail.NoKubeClient()
+	}
+
+	cm := corev1.ConfigMap{}
+	if err := s.DynamicClient.Get(s.Context, kubeletConfigMapObjectKey, &cm); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+
+		return fail.KubeClient(err, "getting %T %s", cm, kubeletConfigMapObjectKey)
+	}
+
+	kubeletConfig, err := unmarshalKubeletConfig([]byte(cm.Data[kubeletConfigMapKey]))
+	if err != nil {
+		return err
+	}
+
+	if !setKubeletResolverConfig(kubeletConfig, s.Cluster.ClusterNetwork.DNS != nil, "") {
+		return nil
+	}
+
+	buf, err := marshalKubeletConfig(kubeletConfig)
+	if err != nil {
+		return err
+	}
+	cm.Data[kubeletConfigMapKey] = string(buf)
+
+	return fail.KubeClient(s.DynamicClient.Update(s.Context, &cm), "updating %T %s", cm, kubeletConfigMapObjectKey)
+}
+
+func ensureClusterDNSOnNode(s *state.State, node *kubeoneapi.HostConfig, conn executor.Interface) error {
+	dnsConfigured := s.Cluster.ClusterNetwork.DNS != nil
+
+	// the resolv.conf must exist before kubelet is pointed to it, and can be
+	// removed only after kubelet stops using it
+	if dnsConfigured {
+		if err := runResolvConfScript(s, node); err != nil {
+			return err
+		}
+	}
+
+	if err := updateKubeletResolverConfig(s, node, conn, dnsConfigured); err != nil {
+		return err
+	}
+
+	if !dnsConfigured {
+		return runResolvConfScript(s, node)
+	}
+
+	return nil
+}
+
+func updateKubeletResolverConfig(s *state.State, node *kubeoneapi.HostConfig, conn executor.Interface, dnsConfigured bool) error {
+	// nodes that are not provisioned yet get the kubelet configuration from
+	// the kubelet-config ConfigMap when joining the cluster
+	_, _, exitcode, err := conn.Exec(kubeletConfigExistsCMD)
+	if err != nil && exitcode <= 0 {
+		return err
+	}
+	if exitcode != 0 {
+		return nil
+	}
+
+	// restore the resolverConfig kubeadm would set for the host
+	fallback := ""
+	if !dnsConfigured {
+		_, _, exitcode, err = conn.Exec(systemdResolvedActiveCMD)
+		if err != nil && exitcode <= 0 {
+			return err
+		}
+		if exitcode == 0 {
+			fallback = systemdResolvedResolvConf
+		}
+	}
+
+	changed := false
+	err = updateRemoteFile(s, kubeletConfigFile, func(content []byte) ([]byte, error) {
+		kubeletConfig, uErr := unmarshalKubeletConfig(content)
+		if uErr != nil {
+			return nil, uErr
+		}
+
+		changed = setKubeletResolverConfig(kubeletConfig, dnsConfigured, fallback)
+		if !changed {
+			return content, nil
+		}
+
+		return marshalKubeletConfig(kubeletConfig)
+	})
+	if err != nil || !changed {
+		return err
+	}
+
+	logger := s.Logger.WithField("node", node.PublicAddress)
+	logger.Info("Restarting Kubelet to apply the resolverConfig setting...")
+
+	if _, _, err = s.Runner.RunRaw(scripts.RestartKubelet()); err != nil {
+		return fail.SSH(err, "restarting kubelet")
+	}
+
+	return waitForKubeletReady(conn, 2*time.Minute)
+}
+
+// setKubeletResolverConfig points kubelet to the resolv.conf generated by
+// KubeOne if the cluster DNS is configured. Otherwise, the resolverConfig
+// pointing to the generated resolv.conf is replaced with the fallback, or
+// unset if the fallback is empty. It returns true if it has been changed.
+func setKubeletResolverConfig(kubeletConfig *kubeletconfigv1beta1.KubeletConfiguration, dnsConfigured bool, fallback string) bool {
+	current := ""
+	if kubeletConfig.ResolverConfig != nil {
+		current = *kubeletConfig.ResolverConfig
+	}
+
+	switch {
+	case dnsConfigured && current != resources.KubeletResolvConf:
+		resolvConf := resources.KubeletResolvConf
+		kubeletConfig.ResolverConfig = &resolvConf
+	case !dnsConfigured && current == resources.KubeletResolvConf:
+		kubeletConfig.ResolverConfig = nil
+		if fallback != "" {
+			kubeletConfig.ResolverConfig = &fallback
+		}
+	default:
+		return false
+	}
+
+	return true
+}
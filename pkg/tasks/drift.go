@@ -0,0 +1,160 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"fmt"
+	"os"
+	"sync"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/drift"
+	"k8c.io/kubeone/pkg/executor"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/scripts"
+	"k8c.io/kubeone/pkg/state"
+	"k8c.io/kubeone/pkg/tabwriter"
+)
+
+func managedFilesParams(s *state.State) scripts.ManagedFilesParams {
+	return scripts.ManagedFilesParams{
+		WorkDir:                s.WorkDir,
+		ContainerRuntimeConfig: s.Cluster.ContainerRuntime.ConfigPath(),
+	}
+}
+
+// recordManagedFilesHashes records the hashes of the files managed by
+// KubeOne on all nodes, after apply has written them
+func recordManagedFilesHashes(s *state.State) error {
+	return s.RunTaskOnAllNodes(func(s *state.State, _ *kubeoneapi.HostConfig, _ executor.Interface) error {
+		cmd, err := scripts.RecordManagedFilesHashes(managedFilesParams(s))
+		if err != nil {
+			return err
+		}
+
+		_, _, err = s.Runner.RunRaw(cmd)
+
+		return fail.SSH(err, "recording hashes of managed files")
+	}, state.RunParallel)
+}
+
+// configurationDrift compares the recorded hashes of the managed files with
+// their current hashes. It returns false if the hashes are not recorded, as
+// the node was provisioned by an older KubeOne version.
+func configurationDrift(s *state.State, conn executor.Interface) ([]drift.Change, bool, error) {
+	recorded, _, _, err := conn.Exec(scripts.RecordedManagedFilesHashes())
+	if err != nil {
+		return nil, false, fail.SSH(err, "reading recorded hashes of managed files")
+	}
+	if recorded == "" {
+		return nil, false, nil
+	}
+
+	cmd, err := scripts.ManagedFilesHashes(managedFilesParams(s))
+	if err != nil {
+		return nil, false, err
+	}
+
+	current, _, _, err := conn.Exec(cmd)
+	if err != nil {
+		return nil, false, fail.SSH(err, "hashing managed files")
+	}
+
+	return drift.Compare(recorded, current), true, nil
+}
+
+// detectConfigurationDrift finds the managed files changed out of band on
+// an initialized node
+func detectConfigurationDrift(s *state.State, host *state.Host, conn executor.Interface) error {
+	changes, recorded, err := configurationDrift(s, conn)
+	if err != nil {
+		return err
+	}
+
+	host.ManagedFilesRecorded = recorded
+	host.ConfigurationDrift = changes
+
+	return nil
+}
+
+// ConfigurationDriftHosts returns the nodes with managed files changed out
+// of band since the last apply
+func ConfigurationDriftHosts(s *state.State) []state.Host {
+	var hosts []state.Host
+
+	for _, nodes := range [][]state.Host{s.LiveCluster.ControlPlane, s.LiveCluster.StaticWorkers} {
+		for _, host := range nodes {
+			if len(host.ConfigurationDrift) > 0 {
+				hosts = append(hosts, host)
+			}
+		}
+	}
+
+	return hosts
+}
+
+// printConfigurationDrift prints the managed files changed out of band on
+// each node since the last apply
+func printConfigurationDrift(s *state.State) error {
+	var (
+		lock    sync.Mutex
+		changes = map[string][]drift.Change{}
+		missing = map[string]bool{}
+	)
+
+	err := s.RunTaskOnAllNodes(func(s *state.State, node *kubeoneapi.HostConfig, conn executor.Interface) error {
+		nodeChanges, recorded, err := configurationDrift(s, conn)
+		if err != nil {
+			return err
+		}
+
+		lock.Lock()
+		defer lock.Unlock()
+
+		changes[node.Hostname] = nodeChanges
+		missing[node.Hostname] = !recorded
+
+		return nil
+	}, state.RunParallel)
+	if err != nil {
+		return err
+	}
+
+	printer := tabwriter.New(os.Stdout)
+	defer printer.Flush()
+
+	fmt.Fprintln(printer, "NODE\tFILE\tDRIFT\t")
+
+	hosts := []kubeoneapi.HostConfig{}
+	hosts = append(hosts, s.Cluster.ControlPlane.Hosts...)
+	hosts = append(hosts, s.Cluster.StaticWorkers.Hosts...)
+
+	for _, host := range hosts {
+		switch {
+		case missing[host.Hostname]:
+			fmt.Fprintf(printer, "%s\t-\tnot recorded, run 'kubeone apply' to record the managed files\t\n", host.Hostname)
+		case len(changes[host.Hostname]) == 0:
+			fmt.Fprintf(printer, "%s\t-\tnone\t\n", host.Hostname)
+		default:
+			for _, change := range changes[host.Hostname] {
+				fmt.Fprintf(printer, "%s\t%s\t%s\t\n", host.Hostname, change.Path, change.Type)
+			}
+		}
+	}
+
+	return nil
+}
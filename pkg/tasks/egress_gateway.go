@@ -0,0 +1,43 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/executor"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/scripts"
+	"k8c.io/kubeone/pkg/state"
+)
+
+// ensureEgressIPs assigns the egress IPs of the egress gateways to the
+// gateway nodes. It runs on all nodes, so that the egress IPs are removed
+// from the nodes which are not selected by the egress gateways anymore.
+func ensureEgressIPs(s *state.State) error {
+	s.Logger.Infoln("Configuring egress gateway IPs...")
+
+	return s.RunTaskOnAllNodes(func(s *state.State, node *kubeoneapi.HostConfig, _ executor.Interface) error {
+		cmd, err := scripts.EgressIPs(s.Cluster.EgressIPs(*node))
+		if err != nil {
+			return err
+		}
+
+		_, _, err = s.Runner.RunRaw(cmd)
+
+		return fail.SSH(err, "configuring egress gateway IPs on %s", node.PublicAddress)
+	}, state.RunParallel)
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/clusterstatus/etcdstatus"
+	"k8c.io/kubeone/pkg/etcdutil"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/state"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	// etcdDefragTimeout is how long defragmenting a single etcd member can
+	// take. The member doesn't serve any requests in the meantime.
+	etcdDefragTimeout = 5 * time.Minute
+
+	// etcdHealthyTimeout is how long to wait for all etcd members to become
+	// healthy after a member is defragmented
+	etcdHealthyTimeout = 2 * time.Minute
+)
+
+// defragmentEtcd defragments the etcd members one at a time, and waits for
+// all members to become healthy before defragmenting the next member
+func defragmentEtcd(s *state.State) error {
+	etcdRing, err := etcdstatus.MemberList(s)
+	if err != nil {
+		return err
+	}
+
+	if err = waitForEtcdHealthy(s, etcdRing); err != nil {
+		return err
+	}
+
+	for _, host := range s.Cluster.ControlPlane.Hosts {
+		if err = defragmentEtcdMember(s, host); err != nil {
+			return err
+		}
+
+		if err = waitForEtcdHealthy(s, etcdRing); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func defragmentEtcdMember(s *state.State, host kubeoneapi.HostConfig) error {
+	etcdcfg, err := etcdutil.NewClientConfig(s, host)
+	if err != nil {
+		return err
+	}
+
+	etcdcli, err := clientv3.New(*etcdcfg)
+	if err != nil {
+		return fail.Etcd(err, "initializing new clientv3")
+	}
+	defer etcdcli.Close()
+
+	endpoint := etcdcfg.Endpoints[0]
+
+	before, err := etcdcli.Status(s.Context, endpoint)
+	if err != nil {
+		return fail.Etcd(err, "getting %s etcd member status", host.Hostname)
+	}
+
+	s.Logger.Infof("Defragmenting etcd member %q...", host.Hostname)
+
+	ctx, cancel := context.WithTimeout(s.Context, etcdDefragTimeout)
+	defer cancel()
+
+	if _, err = etcdcli.Defragment(ctx, endpoint); err != nil {
+		return fail.Etcd(err, "defragmenting %s etcd member", host.Hostname)
+	}
+
+	after, err := etcdcli.Status(s.Context, endpoint)
+	if err != nil {
+		return fail.Etcd(err, "getting %s etcd member status", host.Hostname)
+	}
+
+	s.Logger.Infof("Database size of etcd member %q reduced from %d MiB to %d MiB",
+		host.Hostname, before.DbSize/(1<<20), after.DbSize/(1<<20))
+
+	return nil
+}
+
+// waitForEtcdHealthy waits until the etcd members on all control plane nodes
+// are healthy
+func waitForEtcdHealthy(s *state.State, etcdRing *clientv3.MemberListResponse) error {
+	var lastErr error
+
+	err := wait.PollUntilContextTimeout(s.Context, 5*time.Second, etcdHealthyTimeout, true, func(context.Context) (bool, error) {
+		for _, host := range s.Cluster.ControlPlane.Hosts {
+			etcdStatus, err := etcdstatus.Get(s, host, etcdRing)
+			if err != nil {
+				lastErr = err
+
+				return false, nil
+			}
+			if !etcdStatus.Health || !etcdStatus.Member {
+				lastErr = fmt.Errorf("etcd on %q is not healthy", host.Hostname)
+
+				return false, nil
+			}
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		if lastErr != nil {
+			err = lastErr
+		}
+
+		return fail.Etcd(err, "waiting for etcd members to become healthy")
+	}
+
+	return nil
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/credentials"
+	"k8c.io/kubeone/pkg/executor"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/scripts"
+	"k8c.io/kubeone/pkg/state"
+	"k8c.io/kubeone/pkg/templates/images"
+)
+
+// resticSnapshot is a subset of the snapshot object printed by the
+// `restic snapshots --json` command
+type resticSnapshot struct {
+	ID      string    `json:"id"`
+	ShortID string    `json:"short_id"`
+	Time    time.Time `json:"time"`
+}
+
+// resolveEtcdSnapshot resolves the requested snapshot (or "latest") to the
+// full Restic snapshot ID once, so that all control plane nodes restore
+// exactly the same snapshot.
+func resolveEtcdSnapshot(s *state.State) error {
+	if !s.Cluster.EtcdBackupsEnabled() {
+		return fail.ConfigValidation(fmt.Errorf("etcd backups are not enabled"))
+	}
+
+	backups := s.Cluster.Backups.Etcd
+
+	creds, err := credentials.EtcdBackups(s.CredentialsFilePath)
+	if err != nil {
+		return err
+	}
+
+	return s.RunTaskOnLeaderWithMutator(func(s *state.State, _ *kubeoneapi.HostConfig, _ executor.Interface) error {
+		cmd, err := scripts.EtcdRestoreSnapshots(scripts.EtcdRestoreParams{
+			ResticRepository: backups.Target.ResticRepository(),
+			ResticEnv:        creds,
+			ResticImage:      s.Images.Get(images.EtcdBackupsRestic),
+		})
+		if err != nil {
+			return err
+		}
+
+		stdout, _, err := s.Runner.RunRaw(cmd)
+		if err != nil {
+			return fail.SSH(err, "listing etcd snapshots")
+		}
+
+		snapshotID, err := selectResticSnapshot(stdout, s.EtcdSnapshotID)
+		if err != nil {
+			return err
+		}
+
+		s.Logger.Infof("Resolved etcd snapshot %q to %q", s.EtcdSnapshotID, snapshotID)
+		s.EtcdSnapshotID = snapshotID
+
+		return nil
+	}, func(original *state.State, tmp *state.State) {
+		original.EtcdSnapshotID = tmp.EtcdSnapshotID
+	})
+}
+
+// selectResticSnapshot finds the requested snapshot in the output of the
+// `restic snapshots --json` command. The snapshot can be requested by its
+// full or short ID, or as "latest".
+func selectResticSnapshot(snapshotsJSON string, requested string) (string, error) {
+	var snapshots []resticSnapshot
+	if err := json.Unmarshal([]byte(snapshotsJSON), &snapshots); err != nil {
+		return "", fail.Runtime(err, "parsing etcd snapshots list")
+	}
+
+	if len(snapshots) == 0 {
+		return "", fail.RuntimeError{
+			Op:  "selecting etcd snapshot",
+			Err: fmt.Errorf("no etcd snapshots found in the backups repository"),
+		}
+	}
+
+	if requested == "" || requested == "latest" {
+		latest := snapshots[0]
+		for _, snapshot := range snapshots[1:] {
+			if snapshot.Time.After(latest.Time) {
+				latest = snapshot
+			}
+		}
+
+		return latest.ID, nil
+	}
+
+	for _, snapshot := range snapshots {
+		if snapshot.ID == requested || snapshot.ShortID == requested {
+			return snapshot.ID, nil
+		}
+	}
+
+	return "", fail.RuntimeError{
+		Op:  "selecting etcd snapshot",
+		Err: fmt.Errorf("etcd snapshot %q not found in the backups repository", requested),
+	}
+}
+
+func stopControlPlaneForEtcdRestore(s *state.State) error {
+	s.Logger.Infoln("Stopping etcd and kube-apiserver on control plane nodes...")
+
+	return s.RunTaskOnControlPlane(func(s *state.State, _ *kubeoneapi.HostConfig, _ executor.Interface) error {
+		cmd, err := scripts.EtcdRestoreStop()
+		if err != nil {
+			return err
+		}
+
+		_, _, err = s.Runner.RunRaw(cmd)
+
+		return fail.SSH(err, "stopping etcd and kube-apiserver")
+	}, state.RunParallel)
+}
+
+func restoreEtcdSnapshot(s *state.State) error {
+	if !s.Cluster.EtcdBackupsEnabled() {
+		return fail.ConfigValidation(fmt.Errorf("etcd backups are not enabled"))
+	}
+
+	if s.EtcdSnapshotID == "" || s.EtcdSnapshotID == "latest" {
+		return fail.RuntimeError{
+			Op:  "restoring etcd snapshot",
+			Err: fmt.Errorf("etcd snapshot ID is not resolved"),
+		}
+	}
+
+	backups := s.Cluster.Backups.Etcd
+
+	creds, err := credentials.EtcdBackups(s.CredentialsFilePath)
+	if err != nil {
+		return err
+	}
+
+	initialCluster := []string{}
+	for _, host := range s.Cluster.ControlPlane.Hosts {
+		initialCluster = append(initialCluster, fmt.Sprintf("%s=%s", host.Hostname, etcdPeerURL(s.Cluster, host)))
+	}
+
+	s.Logger.Infof("Restoring etcd snapshot %q on control plane nodes...", s.EtcdSnapshotID)
+
+	return s.RunTaskOnControlPlane(func(s *state.State, node *kubeoneapi.HostConfig, _ executor.Interface) error {
+		cmd, err := scripts.EtcdRestore(scripts.EtcdRestoreParams{
+			Name:             node.Hostname,
+			PeerURL:          etcdPeerURL(s.Cluster, *node),
+			InitialCluster:   strings.Join(initialCluster, ","),
+			SnapshotID:       s.EtcdSnapshotID,
+			ResticRepository: backups.Target.ResticRepository(),
+			ResticEnv:        creds,
+			ResticImage:      s.Images.Get(images.EtcdBackupsRestic),
+			EtcdImage:        s.Images.Get(images.EtcdBackupsEtcdctl),
+		})
+		if err != nil {
+			return err
+		}
+
+		_, _, err = s.Runner.RunRaw(cmd)
+
+		return fail.SSH(err, "restoring etcd snapshot")
+	}, state.RunParallel)
+}
+
+func startControlPlaneAfterEtcdRestore(s *state.State) error {
+	s.Logger.Infoln("Starting etcd and kube-apiserver on control plane nodes...")
+
+	return s.RunTaskOnControlPlane(func(s *state.State, _ *kubeoneapi.HostConfig, _ executor.Interface) error {
+		cmd, err := scripts.EtcdRestoreStart()
+		if err != nil {
+			return err
+		}
+
+		_, _, err = s.Runner.RunRaw(cmd)
+
+		return fail.SSH(err, "starting etcd and kube-apiserver")
+	}, state.RunParallel)
+}
+
+func etcdPeerURL(cluster *kubeoneapi.KubeOneCluster, host kubeoneapi.HostConfig) string {
+	return "https://" + net.JoinHostPort(cluster.ClusterNetwork.NodeIP(host), "2380")
+}
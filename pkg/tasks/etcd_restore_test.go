@@ -0,0 +1,91 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+)
+
+const testResticSnapshots = `[
+  {"time":"2024-01-10T10:00:00Z","tags":["etcd"],"id":"1111111111111111111111111111111111111111111111111111111111111111","short_id":"11111111"},
+  {"time":"2024-01-10T11:00:00Z","tags":["etcd"],"id":"3333333333333333333333333333333333333333333333333333333333333333","short_id":"33333333"},
+  {"time":"2024-01-10T10:30:00Z","tags":["etcd"],"id":"2222222222222222222222222222222222222222222222222222222222222222","short_id":"22222222"}
+]`
+
+func Test_selectResticSnapshot(t *testing.T) {
+	tests := []struct {
+		name      string
+		snapshots string
+		requested string
+		want      string
+		wantErr   bool
+	}{
+		{
+			name:      "latest",
+			snapshots: testResticSnapshots,
+			requested: "latest",
+			want:      "3333333333333333333333333333333333333333333333333333333333333333",
+		},
+		{
+			name:      "empty defaults to latest",
+			snapshots: testResticSnapshots,
+			want:      "3333333333333333333333333333333333333333333333333333333333333333",
+		},
+		{
+			name:      "short ID",
+			snapshots: testResticSnapshots,
+			requested: "22222222",
+			want:      "2222222222222222222222222222222222222222222222222222222222222222",
+		},
+		{
+			name:      "full ID",
+			snapshots: testResticSnapshots,
+			requested: "1111111111111111111111111111111111111111111111111111111111111111",
+			want:      "1111111111111111111111111111111111111111111111111111111111111111",
+		},
+		{
+			name:      "unknown ID",
+			snapshots: testResticSnapshots,
+			requested: "44444444",
+			wantErr:   true,
+		},
+		{
+			name:      "no snapshots",
+			snapshots: "[]",
+			requested: "latest",
+			wantErr:   true,
+		},
+		{
+			name:      "invalid output",
+			snapshots: "not json",
+			requested: "latest",
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := selectResticSnapshot(tt.snapshots, tt.requested)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("selectResticSnapshot() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("selectResticSnapshot() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
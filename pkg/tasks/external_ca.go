@@ -0,0 +1,82 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+
+	"github.com/pkg/errors"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/certificate"
+	"k8c.io/kubeone/pkg/executor"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/state"
+)
+
+// installExternalCAs installs the certificate authorities configured in the
+// certificateAuthority block of the manifest on the leader, so that kubeadm
+// signs the cluster PKI with them instead of generating self-signed
+// certificate authorities
+func installExternalCAs(s *state.State) error {
+	externalCAs, err := certificate.LoadExternalCAs(s.Context, s.Cluster.CertificateAuthority, s.ManifestFilePath)
+	if err != nil {
+		return err
+	}
+
+	return s.RunTaskOnLeader(func(s *state.State, _ *kubeoneapi.HostConfig, _ executor.Interface) error {
+		sshfs := s.Runner.NewFS()
+
+		for _, ca := range externalCAs {
+			if _, _, err := s.Runner.RunRaw(fmt.Sprintf("sudo test -f %s", ca.CertPath)); err == nil {
+				current, rerr := fs.ReadFile(sshfs, ca.CertPath)
+				if rerr != nil {
+					return rerr
+				}
+
+				if certificate.SameCertificate(current, ca.Cert) {
+					continue
+				}
+
+				return fail.RuntimeError{
+					Op:  "installing external certificate authorities",
+					Err: errors.Errorf("the leader already has a different %s certificate authority in %s, it can't be replaced by provisioning the cluster, see `kubeone rotate-ca`", ca.Name, ca.CertPath),
+				}
+			}
+
+			s.Logger.Infof("Installing the %s certificate authority...", ca.Name)
+
+			if err := sshfs.MkdirAll(path.Dir(ca.CertPath), 0700); err != nil {
+				return err
+			}
+
+			// the private key is uploaded first, so that kubeadm never finds
+			// the certificate without its private key
+			if err := uploadPKIFile(sshfs, ca.KeyPath, ca.Key); err != nil {
+				return err
+			}
+
+			if err := uploadPKIFile(sshfs, ca.CertPath, ca.Cert); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/executor"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/state"
+)
+
+// gceSharedVPCPermissionsCMD obtains a token for the attached service account
+// from the metadata server and tests its permissions on the Shared VPC
+// subnetwork in the region of the instance
+const gceSharedVPCPermissionsCMD = `
+metadata="http://metadata.google.internal/computeMetadata/v1/instance"
+token=$(curl -sSf -H Metadata-Flavor:Google "${metadata}/service-accounts/default/token" | sed -n 's/.*"access_token":"\([^"]*\)".*/\1/p')
+[ -n "${token}" ] || { echo "unable to obtain a token for the service account" >&2; exit 1; }
+zone=$(curl -sSf -H Metadata-Flavor:Google "${metadata}/zone")
+region=$(basename "${zone}" | sed 's/-[a-z]$//')
+curl -sSf -X POST -H "Authorization: Bearer ${token}" -H "Content-Type: application/json" \
+	-d '%s' "https://compute.googleapis.com/compute/v1/projects/%s/regions/${region}/subnetworks/%s/testIamPermissions"
+`
+
+// gceSharedVPCRequiredPermissions is a list of permissions on the Shared VPC
+// subnetwork required by the cloud provider to manage the cluster resources
+var gceSharedVPCRequiredPermissions = []string{
+	"compute.subnetworks.get",
+	"compute.subnetworks.use",
+}
+
+type gcePermissions struct {
+	Permissions []string `json:"permissions"`
+}
+
+// verifyGCESharedVPC ensures that the service account attached to the
+// control plane instances can use the Shared VPC subnetwork from the host
+// project.
+func verifyGCESharedVPC(s *state.State) error {
+	gce := s.Cluster.CloudProvider.GCE
+	if gce == nil || gce.SharedVPC == nil || gce.CredentialsMode != kubeoneapi.GCECredentialsModeAttachedServiceAccount {
+		return nil
+	}
+
+	request, err := json.Marshal(gcePermissions{Permissions: gceSharedVPCRequiredPermissions})
+	if err != nil {
+		return fail.Runtime(err, "marshaling gce permissions request")
+	}
+
+	cmd := fmt.Sprintf(gceSharedVPCPermissionsCMD,
+		request,
+		url.PathEscape(gce.SharedVPC.HostProjectID),
+		url.PathEscape(gce.SharedVPC.SubnetworkName),
+	)
+
+	return s.RunTaskOnLeader(func(s *state.State, node *kubeoneapi.HostConfig, conn executor.Interface) error {
+		s.Logger.Infoln("Verifying GCE Shared VPC permissions...")
+
+		out, stderr, _, err := conn.Exec(cmd)
+		if err != nil {
+			s.Logger.Errorf("Unable to test permissions on the Shared VPC subnetwork: %s", strings.TrimSpace(stderr))
+			s.Logger.Warnf("Make sure that the %q subnetwork exists in the %q host project and is shared with the service project.", gce.SharedVPC.SubnetworkName, gce.SharedVPC.HostProjectID)
+
+			return fail.RuntimeError{
+				Err: errors.WithStack(err),
+				Op:  "verifying gce shared vpc",
+			}
+		}
+
+		var granted gcePermissions
+		if err = json.Unmarshal([]byte(out), &granted); err != nil {
+			return fail.Runtime(err, "parsing gce shared vpc permissions")
+		}
+
+		var missing []string
+		for _, perm := range gceSharedVPCRequiredPermissions {
+			found := false
+			for _, g := range granted.Permissions {
+				if g == perm {
+					found = true
+
+					break
+				}
+			}
+			if !found {
+				missing = append(missing, perm)
+			}
+		}
+
+		if len(missing) > 0 {
+			s.Logger.Errorf("The service account is missing %d permission(s) on the %q Shared VPC subnetwork: %s", len(missing), gce.SharedVPC.SubnetworkName, missing)
+			s.Logger.Warnf("Grant the Compute Network User role on the subnetwork or the %q host project to the service account.", gce.SharedVPC.HostProjectID)
+
+			return fail.RuntimeError{
+				Err: errors.New("service account is missing required shared vpc permissions"),
+				Op:  "verifying gce shared vpc",
+			}
+		}
+
+		return nil
+	})
+}
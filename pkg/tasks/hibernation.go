@@ -0,0 +1,241 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/cloudinstances"
+	"k8c.io/kubeone/pkg/executor"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/pointer"
+	"k8c.io/kubeone/pkg/state"
+
+	clusterv1alpha1 "github.com/kubermatic/machine-controller/pkg/apis/cluster/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	dynclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// hibernatedReplicasAnnotation records the number of replicas of a
+	// MachineDeployment scaled down by 'kubeone hibernate', which are
+	// restored by 'kubeone resume'
+	hibernatedReplicasAnnotation = "kubeone.io/hibernated-replicas"
+
+	// machineDeploymentsScaleDownTimeout is how long to wait for
+	// machine-controller to delete the machines of the MachineDeployments
+	machineDeploymentsScaleDownTimeout = 20 * time.Minute
+
+	// instancesTimeout is how long to wait for the instances to stop or start
+	instancesTimeout = 15 * time.Minute
+)
+
+// hibernationHosts returns the hosts whose instances are stopped by
+// 'kubeone hibernate'
+func hibernationHosts(s *state.State) []kubeoneapi.HostConfig {
+	hosts := append([]kubeoneapi.HostConfig{}, s.Cluster.ControlPlane.Hosts...)
+
+	return append(hosts, s.Cluster.StaticWorkers.Hosts...)
+}
+
+// scaleDownMachineDeployments scales all MachineDeployments to zero
+// replicas, recording the original replicas, and waits for machine-controller
+// to delete their machines
+func scaleDownMachineDeployments(s *state.State) error {
+	if s.DynamicClient == nil {
+		return fail.NoKubeClient()
+	}
+
+	machineDeployments := clusterv1alpha1.MachineDeploymentList{}
+	if err := s.DynamicClient.List(s.Context, &machineDeployments, dynclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil
+		}
+
+		return fail.KubeClient(err, "getting %T", machineDeployments)
+	}
+
+	for _, md := range machineDeployments.Items {
+		key := dynclient.ObjectKey{Name: md.Name, Namespace: md.Namespace}
+
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			machineDeployment := clusterv1alpha1.MachineDeployment{}
+			if err := s.DynamicClient.Get(s.Context, key, &machineDeployment); err != nil {
+				return err
+			}
+
+			if _, hibernated := machineDeployment.Annotations[hibernatedReplicasAnnotation]; hibernated {
+				// scaled down by a previous run that failed
+				return nil
+			}
+
+			replicas := int32(0)
+			if machineDeployment.Spec.Replicas != nil {
+				replicas = *machineDeployment.Spec.Replicas
+			}
+
+			s.Logger.Infof("Scaling down MachineDeployment %q from %d replicas...", key.Name, replicas)
+
+			if machineDeployment.Annotations == nil {
+				machineDeployment.Annotations = map[string]string{}
+			}
+			machineDeployment.Annotations[hibernatedReplicasAnnotation] = strconv.Itoa(int(replicas))
+			machineDeployment.Spec.Replicas = pointer.New(int32(0))
+
+			return s.DynamicClient.Update(s.Context, &machineDeployment)
+		})
+		if err != nil {
+			return fail.KubeClient(err, "scaling down %T %s", md, key)
+		}
+	}
+
+	s.Logger.Infoln("Waiting for the machines of the MachineDeployments to be deleted...")
+
+	err := wait.PollUntilContextTimeout(s.Context, 10*time.Second, machineDeploymentsScaleDownTimeout, true, func(ctx context.Context) (bool, error) {
+		machines := clusterv1alpha1.MachineList{}
+		if err := s.DynamicClient.List(ctx, &machines, dynclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+			return false, nil
+		}
+
+		return len(machines.Items) == 0, nil
+	})
+
+	return fail.KubeClient(err, "waiting for the machines to be deleted")
+}
+
+// scaleUpMachineDeployments restores the replicas of the MachineDeployments
+// scaled down by scaleDownMachineDeployments
+func scaleUpMachineDeployments(s *state.State) error {
+	if s.DynamicClient == nil {
+		return fail.NoKubeClient()
+	}
+
+	machineDeployments := clusterv1alpha1.MachineDeploymentList{}
+	if err := s.DynamicClient.List(s.Context, &machineDeployments, dynclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil
+		}
+
+		return fail.KubeClient(err, "getting %T", machineDeployments)
+	}
+
+	for _, md := range machineDeployments.Items {
+		key := dynclient.ObjectKey{Name: md.Name, Namespace: md.Namespace}
+
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			machineDeployment := clusterv1alpha1.MachineDeployment{}
+			if err := s.DynamicClient.Get(s.Context, key, &machineDeployment); err != nil {
+				return err
+			}
+
+			hibernatedReplicas, hibernated := machineDeployment.Annotations[hibernatedReplicasAnnotation]
+			if !hibernated {
+				return nil
+			}
+
+			replicas, err := strconv.ParseInt(hibernatedReplicas, 10, 32)
+			if err != nil || replicas < 0 {
+				replicas = 0
+			}
+
+			s.Logger.Infof("Scaling up MachineDeployment %q to %d replicas...", key.Name, replicas)
+
+			delete(machineDeployment.Annotations, hibernatedReplicasAnnotation)
+			machineDeployment.Spec.Replicas = pointer.New(int32(replicas))
+
+			return s.DynamicClient.Update(s.Context, &machineDeployment)
+		})
+		if err != nil {
+			return fail.KubeClient(err, "scaling up %T %s", md, key)
+		}
+	}
+
+	return nil
+}
+
+// stopInstances stops the instances of the control plane and static worker
+// nodes through the API of the cloud provider
+func stopInstances(s *state.State) error {
+	instances, err := cloudinstances.New(s.Cluster, s.CredentialsFilePath)
+	if err != nil {
+		return err
+	}
+
+	s.Logger.Infoln("Stopping the instances of the nodes...")
+
+	ctx, cancel := context.WithTimeout(s.Context, instancesTimeout)
+	defer cancel()
+
+	return instances.Stop(ctx, hibernationHosts(s))
+}
+
+// startInstances starts the instances stopped by stopInstances, and updates
+// the public addresses of the hosts, which can change when an instance is
+// stopped
+func startInstances(s *state.State) error {
+	instances, err := cloudinstances.New(s.Cluster, s.CredentialsFilePath)
+	if err != nil {
+		return err
+	}
+
+	s.Logger.Infoln("Starting the instances of the nodes...")
+
+	ctx, cancel := context.WithTimeout(s.Context, instancesTimeout)
+	defer cancel()
+
+	addresses, err := instances.Start(ctx, hibernationHosts(s))
+	if err != nil {
+		return err
+	}
+
+	updatePublicAddresses(s.Cluster.ControlPlane.Hosts, addresses)
+	updatePublicAddresses(s.Cluster.StaticWorkers.Hosts, addresses)
+
+	return nil
+}
+
+// updatePublicAddresses updates the public addresses of the hosts connected
+// to through their public addresses
+func updatePublicAddresses(hosts []kubeoneapi.HostConfig, addresses map[string]string) {
+	for i := range hosts {
+		publicAddress := addresses[hosts[i].PrivateAddress]
+		if publicAddress == "" || hosts[i].PublicAddress == "" || hosts[i].PublicAddress == hosts[i].PrivateAddress {
+			continue
+		}
+
+		hosts[i].PublicAddress = publicAddress
+	}
+}
+
+// waitForSSH waits for the SSH on all nodes to become available after the
+// instances are started
+func waitForSSH(s *state.State) error {
+	s.Logger.Infoln("Waiting for SSH on the nodes...")
+
+	return s.RunTaskOnAllNodes(func(s *state.State, _ *kubeoneapi.HostConfig, _ executor.Interface) error {
+		_, _, err := s.Runner.RunRaw("true")
+
+		return fail.SSH(err, "connecting to node")
+	}, state.RunParallel)
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"reflect"
+	"testing"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+)
+
+func TestUpdatePublicAddresses(t *testing.T) {
+	t.Parallel()
+
+	hosts := []kubeoneapi.HostConfig{
+		{PublicAddress: "3.120.1.1", PrivateAddress: "10.0.0.1"},
+		{PublicAddress: "10.0.0.2", PrivateAddress: "10.0.0.2"},
+		{PrivateAddress: "10.0.0.3"},
+		{PublicAddress: "3.120.1.4", PrivateAddress: "10.0.0.4"},
+	}
+
+	updatePublicAddresses(hosts, map[string]string{
+		"10.0.0.1": "3.120.2.1",
+		"10.0.0.2": "3.120.2.2",
+		"10.0.0.3": "3.120.2.3",
+		"10.0.0.4": "",
+	})
+
+	want := []string{"3.120.2.1", "10.0.0.2", "", "3.120.1.4"}
+	got := []string{}
+	for _, host := range hosts {
+		got = append(got, host.PublicAddress)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("public addresses = %v, want %v", got, want)
+	}
+}
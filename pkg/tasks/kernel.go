@@ -0,0 +1,67 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/executor"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/scripts"
+	"k8c.io/kubeone/pkg/state"
+)
+
+func ensureKernelConfig(s *state.State) error {
+	s.Logger.Infoln("Configuring sysctls and kernel modules...")
+
+	if err := s.RunTaskOnControlPlane(kernelConfigTask(s.Cluster.ControlPlane.Kernel), state.RunParallel); err != nil {
+		return err
+	}
+
+	return s.RunTaskOnStaticWorkers(kernelConfigTask(s.Cluster.StaticWorkers.Kernel), state.RunParallel)
+}
+
+func kernelConfigTask(kernel *kubeoneapi.KernelConfig) state.NodeTask {
+	var (
+		sysctls map[string]string
+		modules []string
+	)
+
+	if kernel != nil {
+		sysctls = kernel.Sysctls
+		modules = kernel.Modules
+	}
+
+	return func(s *state.State, node *kubeoneapi.HostConfig, _ executor.Interface) error {
+		cmd, err := scripts.KernelConfig(sysctls, modules)
+		if err != nil {
+			return err
+		}
+
+		if _, _, err = s.Runner.RunRaw(cmd); err != nil {
+			return fail.SSH(err, "configuring sysctls and kernel modules")
+		}
+
+		cmd, err = scripts.VerifyKernelConfig(sysctls, modules)
+		if err != nil {
+			return err
+		}
+
+		_, _, err = s.Runner.RunRaw(cmd)
+
+		return fail.SSH(err, "verifying sysctls and kernel modules on %s", node.PublicAddress)
+	}
+}
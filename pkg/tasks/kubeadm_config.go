@@ -28,6 +28,17 @@ import (
 )
 
 func determinePauseImage(s *state.State) error {
+	if s.Cluster.ContainerRuntime.SandboxImage != "" || s.Cluster.AssetConfiguration.Pause.ImageRepository != "" {
+		// the sandbox image is explicitly configured, so there's no need to ask kubeadm
+		pauseImage, err := s.Cluster.SandboxImage()
+		if err != nil {
+			return err
+		}
+		s.PauseImage = pauseImage
+
+		return nil
+	}
+
 	s.Logger.Infoln("Determining Kubernetes pause image...")
 
 	return s.RunTaskOnLeaderWithMutator(determinePauseImageExecutor, func(original *state.State, tmp *state.State) {
@@ -29,7 +29,7 @@ func upgradeLeaderControlPlane(s *state.State, nodeID int) error {
 		return err
 	}
 
-	cmd, err := scripts.KubeadmUpgrade(kadm.UpgradeLeaderCommand(), s.WorkDir, true, nodeID)
+	cmd, err := scripts.KubeadmUpgrade(kadm.UpgradeLeaderCommand(), s.WorkDir, true, nodeID, kubeadmPatchesDir(s))
 	if err != nil {
 		return err
 	}
@@ -45,7 +45,7 @@ func upgradeFollowerControlPlane(s *state.State, nodeID int) error {
 		return err
 	}
 
-	cmd, err := scripts.KubeadmUpgrade(kadm.UpgradeFollowerCommand(), s.WorkDir, false, nodeID)
+	cmd, err := scripts.KubeadmUpgrade(kadm.UpgradeFollowerCommand(), s.WorkDir, false, nodeID, kubeadmPatchesDir(s))
 	if err != nil {
 		return err
 	}
@@ -61,7 +61,23 @@ func upgradeStaticWorker(s *state.State) error {
 		return err
 	}
 
-	_, _, err = s.Runner.Run(`sudo `+kadm.UpgradeStaticWorkerCommand(), nil)
+	cmd, err := scripts.KubeadmUpgrade(kadm.UpgradeStaticWorkerCommand(), s.WorkDir, false, 0, kubeadmPatchesDir(s))
+	if err != nil {
+		return err
+	}
+
+	_, _, err = s.Runner.RunRaw(cmd)
 
 	return fail.SSH(err, "running kubeadm upgrade on static worker")
 }
+
+// kubeadmPatchesDir returns the directory with kubeadm patches on the control
+// plane and static worker nodes, or an empty string if kubeadm patches are not
+// configured
+func kubeadmPatchesDir(s *state.State) string {
+	if s.Cluster.KubeadmPatchesEnabled() {
+		return scripts.KubeadmPatchesDir
+	}
+
+	return ""
+}
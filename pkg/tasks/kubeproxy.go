@@ -0,0 +1,201 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/state"
+	"k8c.io/kubeone/pkg/templates/kubernetesconfigs"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	kubeProxyConfigKey            = "config.conf"
+	kubeProxyConfigHashAnnotation = "kubeone.k8c.io/kube-proxy-config-hash"
+)
+
+// kubeProxyManagedFields are the top-level kube-proxy configuration fields
+// managed by KubeOne, all other fields are preserved as set by kubeadm
+var kubeProxyManagedFields = []string{"mode", "featureGates", "iptables", "ipvs", "nftables"}
+
+// ensureKubeProxyConfig reconfigures kube-proxy if the kube-proxy configuration
+// in the KubeOne manifest has been changed since the last apply. kube-proxy
+// pods are restarted by the DaemonSet rolling update, one node at a time.
+func ensureKubeProxyConfig(s *state.State) error {
+	if s.DynamicClient == nil {
+		return fail.NoKubeClient()
+	}
+
+	cm := corev1.ConfigMap{}
+	if err := s.DynamicClient.Get(s.Context, KubeProxyObjectKey, &cm); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+
+		return fail.KubeClient(err, "getting %T %s", cm, KubeProxyObjectKey)
+	}
+
+	desiredObj, err := kubernetesconfigs.NewKubeProxyConfiguration(s.Cluster)
+	if err != nil {
+		return err
+	}
+
+	desiredBuf, err := json.Marshal(desiredObj)
+	if err != nil {
+		return fail.Runtime(err, "marshalling kube-proxy configuration")
+	}
+
+	desiredHash := fmt.Sprintf("%x", sha256.Sum256(desiredBuf))
+	previousHash, tracked := cm.Annotations[kubeProxyConfigHashAnnotation]
+	if previousHash == desiredHash {
+		return nil
+	}
+
+	desired := map[string]interface{}{}
+	if err = yaml.Unmarshal(desiredBuf, &desired); err != nil {
+		return fail.Runtime(err, "unmarshalling kube-proxy configuration")
+	}
+
+	current := map[string]interface{}{}
+	if err = yaml.Unmarshal([]byte(cm.Data[kubeProxyConfigKey]), &current); err != nil {
+		return fail.Runtime(err, "unmarshalling kube-proxy configuration from %T %s", cm, KubeProxyObjectKey)
+	}
+
+	if cm.Annotations == nil {
+		cm.Annotations = map[string]string{}
+	}
+	cm.Annotations[kubeProxyConfigHashAnnotation] = desiredHash
+
+	currentMode, desiredMode := kubeProxyMode(current), kubeProxyMode(desired)
+
+	// kube-proxy configuration that is not tracked yet has been created by
+	// kubeadm from the KubeOne manifest, so it's adopted as long as the
+	// managed fields are unchanged
+	if !tracked && currentMode == desiredMode && kubeProxyConfigMatches(current, desired) {
+		err = s.DynamicClient.Update(s.Context, &cm)
+
+		return fail.KubeClient(err, "updating %T %s", cm, KubeProxyObjectKey)
+	}
+
+	s.Logger.Infoln("Reconfiguring kube-proxy...")
+	if currentMode != desiredMode {
+		s.Logger.Warnf("Switching kube-proxy mode from %q to %q, rules created by the previous mode might remain on nodes until they are rebooted", currentMode, desiredMode)
+	}
+
+	for _, field := range kubeProxyManagedFields {
+		if value, ok := desired[field]; ok {
+			current[field] = value
+		} else {
+			delete(current, field)
+		}
+	}
+
+	config, err := yaml.Marshal(current)
+	if err != nil {
+		return fail.Runtime(err, "marshalling kube-proxy configuration")
+	}
+	cm.Data[kubeProxyConfigKey] = string(config)
+
+	if err = s.DynamicClient.Update(s.Context, &cm); err != nil {
+		return fail.KubeClient(err, "updating %T %s", cm, KubeProxyObjectKey)
+	}
+
+	// changing the pod template triggers the rolling restart of kube-proxy
+	ds := appsv1.DaemonSet{}
+	if err = s.DynamicClient.Get(s.Context, KubeProxyObjectKey, &ds); err != nil {
+		return fail.KubeClient(err, "getting %T %s", ds, KubeProxyObjectKey)
+	}
+
+	if ds.Spec.Template.Annotations == nil {
+		ds.Spec.Template.Annotations = map[string]string{}
+	}
+	ds.Spec.Template.Annotations[kubeProxyConfigHashAnnotation] = desiredHash
+
+	err = s.DynamicClient.Update(s.Context, &ds)
+
+	return fail.KubeClient(err, "updating %T %s", ds, KubeProxyObjectKey)
+}
+
+// kubeProxyConfigMatches reports whether the KubeOne managed fields of the
+// current kube-proxy configuration match the desired configuration. Unset and
+// zero duration values of the desired configuration are skipped because they
+// are defaulted by kubeadm and kube-proxy.
+func kubeProxyConfigMatches(current, desired map[string]interface{}) bool {
+	for _, field := range kubeProxyManagedFields {
+		if field == "mode" {
+			if kubeProxyMode(current) != kubeProxyMode(desired) {
+				return false
+			}
+
+			continue
+		}
+
+		if !kubeProxyValueMatches(current[field], desired[field]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func kubeProxyValueMatches(current, desired interface{}) bool {
+	switch desiredValue := desired.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		currentMap, _ := current.(map[string]interface{})
+		for key, value := range desiredValue {
+			if !kubeProxyValueMatches(currentMap[key], value) {
+				return false
+			}
+		}
+
+		return true
+	case string:
+		if desiredValue == "0s" {
+			return true
+		}
+		currentValue, _ := current.(string)
+
+		return currentValue == desiredValue
+	case bool:
+		currentValue, _ := current.(bool)
+
+		return currentValue == desiredValue
+	default:
+		return reflect.DeepEqual(current, desired)
+	}
+}
+
+// kubeProxyMode returns the proxy mode from the kube-proxy configuration,
+// defaulting to iptables as kube-proxy does on Linux
+func kubeProxyMode(config map[string]interface{}) string {
+	if mode, ok := config["mode"].(string); ok && mode != "" {
+		return mode
+	}
+
+	return "iptables"
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+
+	"sigs.k8s.io/yaml"
+)
+
+func Test_kubeProxyConfigMatches(t *testing.T) {
+	// kubeadm defaulted kube-proxy configuration
+	current := `
+mode: ipvs
+iptables:
+  masqueradeAll: false
+  masqueradeBit: 14
+  minSyncPeriod: 1s
+  syncPeriod: 30s
+ipvs:
+  excludeCIDRs: null
+  minSyncPeriod: 0s
+  scheduler: rr
+  strictARP: true
+  syncPeriod: 30s
+  tcpFinTimeout: 0s
+  tcpTimeout: 0s
+  udpTimeout: 0s
+`
+
+	tests := []struct {
+		name    string
+		desired string
+		want    bool
+	}{
+		{
+			name: "same configuration with defaulted fields",
+			desired: `
+mode: ipvs
+iptables:
+  masqueradeAll: false
+  masqueradeBit: null
+  minSyncPeriod: 0s
+  syncPeriod: 0s
+ipvs:
+  excludeCIDRs: null
+  minSyncPeriod: 0s
+  scheduler: rr
+  strictARP: true
+  syncPeriod: 0s
+  tcpFinTimeout: 0s
+  tcpTimeout: 0s
+  udpTimeout: 0s
+`,
+			want: true,
+		},
+		{
+			name: "changed strictARP",
+			desired: `
+mode: ipvs
+ipvs:
+  scheduler: rr
+  strictARP: false
+`,
+			want: false,
+		},
+		{
+			name: "changed scheduler",
+			desired: `
+mode: ipvs
+ipvs:
+  scheduler: lc
+  strictARP: true
+`,
+			want: false,
+		},
+		{
+			name: "changed syncPeriod",
+			desired: `
+mode: ipvs
+ipvs:
+  scheduler: rr
+  strictARP: true
+  syncPeriod: 1m0s
+`,
+			want: false,
+		},
+		{
+			name: "changed masqueradeAll",
+			desired: `
+mode: ipvs
+iptables:
+  masqueradeAll: true
+ipvs:
+  scheduler: rr
+  strictARP: true
+`,
+			want: false,
+		},
+		{
+			name: "changed mode",
+			desired: `
+mode: iptables
+`,
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			currentMap := map[string]interface{}{}
+			if err := yaml.Unmarshal([]byte(current), &currentMap); err != nil {
+				t.Fatal(err)
+			}
+
+			desiredMap := map[string]interface{}{}
+			if err := yaml.Unmarshal([]byte(tt.desired), &desiredMap); err != nil {
+				t.Fatal(err)
+			}
+
+			if got := kubeProxyConfigMatches(currentMap, desiredMap); got != tt.want {
+				t.Errorf("kubeProxyConfigMatches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
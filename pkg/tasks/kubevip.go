@@ -0,0 +1,98 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"github.com/Masterminds/semver/v3"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/executor"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/scripts"
+	"k8c.io/kubeone/pkg/state"
+	"k8c.io/kubeone/pkg/templates/images"
+	"k8c.io/kubeone/pkg/templates/kubevip"
+)
+
+const kubeVIPManifestFile = "cfg/kube-vip.yaml"
+
+// ensureKubeVIPBootstrap deploys kube-vip on the leader before the cluster is
+// initialized, as kubeadm init reaches the API server using the virtual IP
+// address. The admin.conf of Kubernetes 1.29+ gets its permissions only once
+// kubeadm init finishes, so kube-vip uses the super-admin.conf until it's
+// redeployed by ensureKubeVIP.
+func ensureKubeVIPBootstrap(s *state.State) error {
+	ver, err := semver.NewVersion(s.Cluster.Versions.Kubernetes)
+	if err != nil {
+		return fail.Runtime(err, "parsing kubernetes version")
+	}
+
+	kubeconfig := kubevip.AdminKubeconfig
+	if ver.Compare(semver.MustParse("1.29.0")) >= 0 {
+		kubeconfig = kubevip.SuperAdminKubeconfig
+	}
+
+	if err := addKubeVIPManifest(s, kubeconfig); err != nil {
+		return err
+	}
+
+	s.Logger.Infoln("Deploying kube-vip on the leader...")
+
+	return s.RunTaskOnLeader(kubeVIPTask(true))
+}
+
+// ensureKubeVIP deploys kube-vip on the control plane nodes that have joined
+// the cluster. The joining control plane nodes reach the API server using the
+// virtual IP address announced by the other nodes, as kubeadm join requires
+// an empty static pods manifests directory.
+func ensureKubeVIP(s *state.State) error {
+	if err := addKubeVIPManifest(s, kubevip.AdminKubeconfig); err != nil {
+		return err
+	}
+
+	s.Logger.Infoln("Deploying kube-vip...")
+
+	return s.RunTaskOnControlPlane(kubeVIPTask(false), state.RunParallel)
+}
+
+func addKubeVIPManifest(s *state.State, kubeconfig string) error {
+	manifest, err := kubevip.Manifest(s.Cluster, s.Images.Get(images.KubeVIP), kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	s.Configuration.AddFile(kubeVIPManifestFile, manifest)
+
+	return nil
+}
+
+func kubeVIPTask(bootstrap bool) state.NodeTask {
+	return func(s *state.State, node *kubeoneapi.HostConfig, conn executor.Interface) error {
+		if err := s.Configuration.UploadTo(conn, s.WorkDir); err != nil {
+			return err
+		}
+
+		cmd, err := scripts.KubeVIP(s.WorkDir, kubevip.ManifestPath, bootstrap)
+		if err != nil {
+			return err
+		}
+
+		_, _, err = s.Runner.RunRaw(cmd)
+
+		return fail.SSH(err, "deploying kube-vip on %s", node.PublicAddress)
+	}
+}
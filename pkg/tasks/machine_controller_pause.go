@@ -0,0 +1,146 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"strconv"
+
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/pointer"
+	"k8c.io/kubeone/pkg/state"
+	"k8c.io/kubeone/pkg/templates/resources"
+
+	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/retry"
+	dynclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// pausedReplicasAnnotation records the number of replicas of a paused
+// controller Deployment, which are restored when the controller is resumed
+const pausedReplicasAnnotation = "kubeone.io/paused-replicas"
+
+// pausedControllers returns the Deployments of the controllers managing the
+// worker nodes, which are paused while the control plane is upgraded
+func pausedControllers(s *state.State) []dynclient.ObjectKey {
+	controllers := []dynclient.ObjectKey{}
+
+	if s.Cluster.MachineController.Deploy {
+		controllers = append(controllers, dynclient.ObjectKey{
+			Name:      resources.MachineControllerName,
+			Namespace: resources.MachineControllerNameSpace,
+		})
+	}
+
+	if s.Cluster.OperatingSystemManager.Deploy {
+		controllers = append(controllers, dynclient.ObjectKey{
+			Name:      resources.OperatingSystemManagerName,
+			Namespace: resources.OperatingSystemManagerNamespace,
+		})
+	}
+
+	return controllers
+}
+
+// pauseMachineControllers scales machine-controller and
+// operating-system-manager down to zero replicas, so that the
+// MachineDeployments are not rolled out against a partially upgraded control
+// plane
+func pauseMachineControllers(s *state.State) error {
+	if s.DynamicClient == nil {
+		return fail.NoKubeClient()
+	}
+
+	for _, key := range pausedControllers(s) {
+		s.Logger.Infof("Pausing %s...", key.Name)
+
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			dep := appsv1.Deployment{}
+			if err := s.DynamicClient.Get(s.Context, key, &dep); err != nil {
+				return err
+			}
+
+			replicas := int32(1)
+			if dep.Spec.Replicas != nil {
+				replicas = *dep.Spec.Replicas
+			}
+
+			if _, paused := dep.Annotations[pausedReplicasAnnotation]; paused && replicas == 0 {
+				// paused by a previous run that failed before resuming it
+				return nil
+			}
+
+			if dep.Annotations == nil {
+				dep.Annotations = map[string]string{}
+			}
+			dep.Annotations[pausedReplicasAnnotation] = strconv.Itoa(int(replicas))
+			dep.Spec.Replicas = pointer.New(int32(0))
+
+			return s.DynamicClient.Update(s.Context, &dep)
+		})
+		if k8serrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return fail.KubeClient(err, "pausing %T %s", appsv1.Deployment{}, key)
+		}
+	}
+
+	return nil
+}
+
+// resumeMachineControllers restores the replicas of machine-controller and
+// operating-system-manager paused by pauseMachineControllers
+func resumeMachineControllers(s *state.State) error {
+	if s.DynamicClient == nil {
+		return fail.NoKubeClient()
+	}
+
+	for _, key := range pausedControllers(s) {
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			dep := appsv1.Deployment{}
+			if err := s.DynamicClient.Get(s.Context, key, &dep); err != nil {
+				return err
+			}
+
+			pausedReplicas, paused := dep.Annotations[pausedReplicasAnnotation]
+			if !paused {
+				return nil
+			}
+
+			s.Logger.Infof("Resuming %s...", key.Name)
+
+			replicas, err := strconv.ParseInt(pausedReplicas, 10, 32)
+			if err != nil || replicas < 0 {
+				replicas = 1
+			}
+
+			delete(dep.Annotations, pausedReplicasAnnotation)
+			dep.Spec.Replicas = pointer.New(int32(replicas))
+
+			return s.DynamicClient.Update(s.Context, &dep)
+		})
+		if k8serrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return fail.KubeClient(err, "resuming %T %s", appsv1.Deployment{}, key)
+		}
+	}
+
+	return nil
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package tasks
 
 import (
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
 	"k8c.io/kubeone/pkg/fail"
 	"k8c.io/kubeone/pkg/state"
 	"k8c.io/kubeone/pkg/templates/machinecontroller"
@@ -69,6 +70,11 @@ func upgradeMachineDeployments(s *state.State) error {
 		return fail.KubeClient(err, "getting %T", machineDeployments)
 	}
 
+	workersets := map[string]kubeoneapi.DynamicWorkerConfig{}
+	for _, workerset := range s.Cluster.DynamicWorkers {
+		workersets[workerset.Name] = workerset
+	}
+
 	for _, md := range machineDeployments.Items {
 		machineKey := dynclient.ObjectKey{Name: md.Name, Namespace: md.Namespace}
 
@@ -79,6 +85,9 @@ func upgradeMachineDeployments(s *state.State) error {
 			}
 
 			machine.Spec.Template.Spec.Versions.Kubelet = s.Cluster.Versions.Kubernetes
+			if workerset, ok := workersets[machine.Name]; ok {
+				machinecontroller.ApplyUpgradeStrategy(&machine, workerset)
+			}
 
 			return s.DynamicClient.Update(s.Context, &machine)
 		})
@@ -131,6 +131,10 @@ func labelNodes(s *state.State) error {
 
 			node.Labels["v1.kubeone.io/operating-system"] = string(host.OperatingSystem)
 
+			if host.Zone != "" {
+				node.Labels[corev1.LabelTopologyZone] = host.Zone
+			}
+
 			for labKey, labVal := range host.Labels {
 				if strings.HasSuffix(labKey, "-") {
 					// drop minus from the suffix
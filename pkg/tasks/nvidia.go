@@ -0,0 +1,89 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"fmt"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/executor"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/scripts"
+	"k8c.io/kubeone/pkg/state"
+	"k8c.io/kubeone/pkg/templates/resources"
+)
+
+func ensureNvidiaContainerToolkit(s *state.State) error {
+	var gpuHosts []kubeoneapi.HostConfig
+
+	for _, hosts := range [][]kubeoneapi.HostConfig{s.Cluster.ControlPlane.Hosts, s.Cluster.StaticWorkers.Hosts} {
+		for _, host := range hosts {
+			if host.Labels[resources.NvidiaGPUNodeLabel] == "true" {
+				gpuHosts = append(gpuHosts, host)
+			}
+		}
+	}
+
+	if len(gpuHosts) == 0 {
+		return nil
+	}
+
+	s.Logger.Infoln("Ensuring nvidia-container-toolkit...")
+
+	return s.RunTaskOnNodes(gpuHosts, installNvidiaContainerToolkit, state.RunParallel, nil)
+}
+
+func installNvidiaContainerToolkit(s *state.State, node *kubeoneapi.HostConfig, _ executor.Interface) error {
+	return runOnOS(s, node.OperatingSystem, map[kubeoneapi.OperatingSystemName]runOnOSFn{
+		kubeoneapi.OperatingSystemNameAmazon:     installNvidiaContainerToolkitCentOS,
+		kubeoneapi.OperatingSystemNameCentOS:     installNvidiaContainerToolkitCentOS,
+		kubeoneapi.OperatingSystemNameDebian:     installNvidiaContainerToolkitDebian,
+		kubeoneapi.OperatingSystemNameFlatcar:    installNvidiaContainerToolkitFlatcar,
+		kubeoneapi.OperatingSystemNameRHEL:       installNvidiaContainerToolkitCentOS,
+		kubeoneapi.OperatingSystemNameRockyLinux: installNvidiaContainerToolkitCentOS,
+		kubeoneapi.OperatingSystemNameUbuntu:     installNvidiaContainerToolkitDebian,
+	})
+}
+
+func installNvidiaContainerToolkitDebian(s *state.State) error {
+	cmd, err := scripts.NvidiaContainerToolkitDebian(s.Cluster)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = s.Runner.RunRaw(cmd)
+
+	return fail.SSH(err, "installing nvidia-container-toolkit")
+}
+
+func installNvidiaContainerToolkitCentOS(s *state.State) error {
+	cmd, err := scripts.NvidiaContainerToolkitCentOS(s.Cluster)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = s.Runner.RunRaw(cmd)
+
+	return fail.SSH(err, "installing nvidia-container-toolkit")
+}
+
+func installNvidiaContainerToolkitFlatcar(*state.State) error {
+	return fail.RuntimeError{
+		Op:  "installing nvidia-container-toolkit",
+		Err: fmt.Errorf("nvidia-container-toolkit is not supported on %s", kubeoneapi.OperatingSystemNameFlatcar),
+	}
+}
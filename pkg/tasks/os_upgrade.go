@@ -0,0 +1,445 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/executor"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/nodeutils"
+	"k8c.io/kubeone/pkg/scripts"
+	"k8c.io/kubeone/pkg/state"
+
+	clusterv1alpha1 "github.com/kubermatic/machine-controller/pkg/apis/cluster/v1alpha1"
+	providerconfigtypes "github.com/kubermatic/machine-controller/pkg/providerconfig/types"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	dynclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// osUpgradeRebootTimeout is how long to wait for a node to be reachable
+	// over SSH again after the reboot
+	osUpgradeRebootTimeout = 15 * time.Minute
+	// osUpgradeRejoinTimeout is how long to wait for the upgraded node to
+	// rejoin the cluster
+	osUpgradeRejoinTimeout = 10 * time.Minute
+	// osUpgradeMachineDeploymentTimeout is how long to wait for all machines
+	// of a MachineDeployment to be re-provisioned
+	osUpgradeMachineDeploymentTimeout = 60 * time.Minute
+
+	bootIDCMD         = "cat /proc/sys/kernel/random/boot_id"
+	rebootCMD         = "sudo systemctl reboot"
+	rebootRequiredCMD = "test -f /var/run/reboot-required"
+)
+
+// machineDeploymentImageFields are the fields of the cloud provider specs
+// holding the image the machines are provisioned from
+var machineDeploymentImageFields = map[providerconfigtypes.CloudProvider]string{
+	providerconfigtypes.CloudProviderAWS:       "ami",
+	providerconfigtypes.CloudProviderAzure:     "imageID",
+	providerconfigtypes.CloudProviderGoogle:    "customImage",
+	providerconfigtypes.CloudProviderHetzner:   "image",
+	providerconfigtypes.CloudProviderNutanix:   "imageName",
+	providerconfigtypes.CloudProviderOpenstack: "image",
+	providerconfigtypes.CloudProviderVsphere:   "templateVMName",
+}
+
+// OperatingSystemUpgradeHosts returns the control plane and static worker
+// nodes running an Ubuntu release older than the target release
+func OperatingSystemUpgradeHosts(s *state.State) []state.Host {
+	target := s.Cluster.OperatingSystemUpgrade().UbuntuRelease
+	if target == "" {
+		return nil
+	}
+
+	var hosts []state.Host
+	for _, nodes := range [][]state.Host{s.LiveCluster.ControlPlane, s.LiveCluster.StaticWorkers} {
+		for _, host := range nodes {
+			if host.Config.OperatingSystem == kubeoneapi.OperatingSystemNameUbuntu && ubuntuReleaseOlder(host.OperatingSystemRelease, target) {
+				hosts = append(hosts, host)
+			}
+		}
+	}
+
+	return hosts
+}
+
+// ubuntuReleaseOlder returns true if the release is older than the target
+// release. Unknown releases are never upgraded.
+func ubuntuReleaseOlder(release, target string) bool {
+	releaseVersion, err := semver.NewVersion(release)
+	if err != nil {
+		return false
+	}
+
+	targetVersion, err := semver.NewVersion(target)
+	if err != nil {
+		return false
+	}
+
+	return releaseVersion.LessThan(targetVersion)
+}
+
+func operatingSystemUpgradeNeeded(s *state.State) bool {
+	return len(OperatingSystemUpgradeHosts(s)) > 0
+}
+
+func machineDeploymentsImageUpgradeNeeded(s *state.State) bool {
+	return len(s.Cluster.OperatingSystemUpgrade().MachineDeploymentImages) > 0
+}
+
+// upgradeOperatingSystem upgrades the Ubuntu release of the nodes, one node
+// at a time to minimize cluster disruption
+func upgradeOperatingSystem(s *state.State) error {
+	outdated := map[string]bool{}
+	for _, host := range OperatingSystemUpgradeHosts(s) {
+		outdated[host.Config.Hostname] = true
+	}
+
+	return s.RunTaskOnAllNodes(func(s *state.State, node *kubeoneapi.HostConfig, conn executor.Interface) error {
+		if !outdated[node.Hostname] {
+			return nil
+		}
+
+		return upgradeOperatingSystemExecutor(s, node, conn)
+	}, state.RunSequentially)
+}
+
+func upgradeOperatingSystemExecutor(s *state.State, node *kubeoneapi.HostConfig, conn executor.Interface) error {
+	logger := s.Logger.WithField("node", node.PublicAddress)
+	target := s.Cluster.OperatingSystemUpgrade().UbuntuRelease
+
+	drainer := nodeutils.NewDrainer(s.RESTConfig, logger, s.Cluster.DrainConfig())
+
+	logger.Infoln("Cordoning node...")
+	if err := drainer.Cordon(s.Context, node.Hostname, true); err != nil {
+		return err
+	}
+
+	logger.Infoln("Draining node...")
+	if err := drainer.Drain(s.Context, node.Hostname); err != nil {
+		return err
+	}
+
+	logger.Infoln("Installing pending updates...")
+	cmd, err := scripts.UbuntuReleaseUpgradePrepare()
+	if err != nil {
+		return err
+	}
+
+	if _, _, err = s.Runner.RunRaw(cmd); err != nil {
+		return fail.SSH(err, "installing pending updates")
+	}
+
+	// do-release-upgrade refuses to run until the updates requiring a reboot
+	// are activated
+	if _, _, _, err = conn.Exec(rebootRequiredCMD); err == nil {
+		if conn, err = rebootNode(s, node, conn); err != nil {
+			return err
+		}
+	}
+
+	// each run of do-release-upgrade upgrades to the next release
+	previous := ""
+	for {
+		release, err := operatingSystemRelease(conn)
+		if err != nil {
+			return fail.SSH(err, "reading operating system release")
+		}
+
+		if release == previous {
+			return fail.RuntimeError{
+				Op:  "upgrading operating system",
+				Err: fmt.Errorf("do-release-upgrade didn't upgrade Ubuntu %s on node %q", release, node.Hostname),
+			}
+		}
+
+		if !ubuntuReleaseOlder(release, target) {
+			break
+		}
+		previous = release
+
+		logger.Infof("Upgrading Ubuntu %s towards %s...", release, target)
+		cmd, err = scripts.UbuntuReleaseUpgrade(target)
+		if err != nil {
+			return err
+		}
+
+		if _, _, err = s.Runner.RunRaw(cmd); err != nil {
+			return fail.SSH(err, "upgrading Ubuntu release")
+		}
+
+		if conn, err = rebootNode(s, node, conn); err != nil {
+			return err
+		}
+	}
+
+	logger.Infoln("Waiting for the node to rejoin the cluster...")
+	if err = waitForNodeRejoin(s, node.Hostname, target); err != nil {
+		return err
+	}
+
+	if isControlPlaneNode(s, node) {
+		logger.Infoln("Verifying API server and etcd health...")
+		var unhealthy error
+		err = wait.PollUntilContextTimeout(s.Context, 5*time.Second, osUpgradeRejoinTimeout, true, func(context.Context) (bool, error) {
+			unhealthy = controlPlaneHealth(s)
+
+			return unhealthy == nil, nil
+		})
+		if err != nil {
+			if unhealthy != nil {
+				err = unhealthy
+			}
+
+			return fail.Runtime(err, "verifying control plane %q after the operating system upgrade", node.Hostname)
+		}
+	}
+
+	logger.Infoln("Uncordoning node...")
+
+	return drainer.Cordon(s.Context, node.Hostname, false)
+}
+
+func isControlPlaneNode(s *state.State, node *kubeoneapi.HostConfig) bool {
+	for _, host := range s.Cluster.ControlPlane.Hosts {
+		if host.Hostname == node.Hostname {
+			return true
+		}
+	}
+
+	return false
+}
+
+// rebootNode reboots the node and waits until it's reachable over SSH again
+// with a new boot ID. The returned connection replaces the connection used
+// before the reboot, which is closed.
+func rebootNode(s *state.State, node *kubeoneapi.HostConfig, conn executor.Interface) (executor.Interface, error) {
+	bootID, _, _, err := conn.Exec(bootIDCMD)
+	if err != nil {
+		return nil, fail.SSH(err, "reading boot ID")
+	}
+
+	s.Logger.Infoln("Rebooting node...")
+
+	// Intentionally ignore error because rebooting the node drops the
+	// connection
+	_, _, _, _ = conn.Exec(rebootCMD)
+	conn.Close()
+
+	var rebooted executor.Interface
+	err = wait.PollUntilContextTimeout(s.Context, 10*time.Second, osUpgradeRebootTimeout, false, func(context.Context) (bool, error) {
+		newConn, err := s.Executor.Open(*node)
+		if err != nil {
+			return false, nil
+		}
+
+		newBootID, _, _, err := newConn.Exec(bootIDCMD)
+		if err != nil {
+			newConn.Close()
+
+			return false, nil
+		}
+
+		if newBootID == bootID {
+			// the node is still shutting down
+			return false, nil
+		}
+
+		rebooted = newConn
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, fail.Runtime(err, "waiting for node %q to reboot", node.Hostname)
+	}
+
+	s.Runner.Executor = rebooted
+
+	return rebooted, nil
+}
+
+// waitForNodeRejoin waits for the node to become ready, reporting the new
+// operating system release
+func waitForNodeRejoin(s *state.State, nodeName, release string) error {
+	err := wait.PollUntilContextTimeout(s.Context, 5*time.Second, osUpgradeRejoinTimeout, true, func(ctx context.Context) (bool, error) {
+		node := corev1.Node{}
+		if err := s.DynamicClient.Get(ctx, dynclient.ObjectKey{Name: nodeName}, &node); err != nil {
+			return false, nil
+		}
+
+		if !strings.Contains(node.Status.NodeInfo.OSImage, release) {
+			return false, nil
+		}
+
+		for _, cond := range node.Status.Conditions {
+			if cond.Type == corev1.NodeReady {
+				return cond.Status == corev1.ConditionTrue, nil
+			}
+		}
+
+		return false, nil
+	})
+
+	return fail.KubeClient(err, "waiting for node %q to rejoin the cluster with Ubuntu %s", nodeName, release)
+}
+
+// MachineDeploymentImageUpgrades returns the names of the MachineDeployments
+// that don't use the image of the new operating system release yet
+func MachineDeploymentImageUpgrades(s *state.State) ([]string, error) {
+	images := s.Cluster.OperatingSystemUpgrade().MachineDeploymentImages
+
+	names := make([]string, 0, len(images))
+	for name := range images {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var outdated []string
+	for _, name := range names {
+		md := clusterv1alpha1.MachineDeployment{}
+		key := dynclient.ObjectKey{Name: name, Namespace: metav1.NamespaceSystem}
+		if err := s.DynamicClient.Get(s.Context, key, &md); err != nil {
+			return nil, fail.KubeClient(err, "getting %T %s", md, key)
+		}
+
+		changed, err := setMachineDeploymentImage(&md, images[name])
+		if err != nil {
+			return nil, err
+		}
+
+		if changed {
+			outdated = append(outdated, name)
+		}
+	}
+
+	return outdated, nil
+}
+
+// upgradeMachineDeploymentsImage sets the images of the new operating system
+// release in the MachineDeployments, and waits for their machines to be
+// re-provisioned, one MachineDeployment at a time
+func upgradeMachineDeploymentsImage(s *state.State) error {
+	outdated, err := MachineDeploymentImageUpgrades(s)
+	if err != nil {
+		return err
+	}
+
+	images := s.Cluster.OperatingSystemUpgrade().MachineDeploymentImages
+
+	for _, name := range outdated {
+		key := dynclient.ObjectKey{Name: name, Namespace: metav1.NamespaceSystem}
+
+		s.Logger.Infof("Re-provisioning machines of MachineDeployment %s with image %q...", name, images[name])
+		err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			md := clusterv1alpha1.MachineDeployment{}
+			if err := s.DynamicClient.Get(s.Context, key, &md); err != nil {
+				return err
+			}
+
+			if _, err := setMachineDeploymentImage(&md, images[name]); err != nil {
+				return err
+			}
+
+			return s.DynamicClient.Update(s.Context, &md)
+		})
+		if err != nil {
+			return fail.KubeClient(err, "updating image of %T %s", clusterv1alpha1.MachineDeployment{}, key)
+		}
+
+		if err = waitForMachineDeploymentRollout(s, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setMachineDeploymentImage sets the image in the cloud provider spec of the
+// MachineDeployment, and returns true if the image is changed
+func setMachineDeploymentImage(md *clusterv1alpha1.MachineDeployment, image string) (bool, error) {
+	if md.Spec.Template.Spec.ProviderSpec.Value == nil {
+		return false, fail.Config(fmt.Errorf("MachineDeployment %s has no provider spec", md.Name), "setting MachineDeployment image")
+	}
+
+	providerConfig := map[string]interface{}{}
+	if err := json.Unmarshal(md.Spec.Template.Spec.ProviderSpec.Value.Raw, &providerConfig); err != nil {
+		return false, fail.Runtime(err, "decoding providerconfig of MachineDeployment %s", md.Name)
+	}
+
+	cloudProvider, _ := providerConfig["cloudProvider"].(string)
+	field, ok := machineDeploymentImageFields[providerconfigtypes.CloudProvider(cloudProvider)]
+	if !ok {
+		return false, fail.Config(fmt.Errorf("re-provisioning MachineDeployment %s with a new image is not supported on the %q cloud provider", md.Name, cloudProvider), "setting MachineDeployment image")
+	}
+
+	cloudProviderSpec, ok := providerConfig["cloudProviderSpec"].(map[string]interface{})
+	if !ok {
+		cloudProviderSpec = map[string]interface{}{}
+	}
+
+	if current, _ := cloudProviderSpec[field].(string); current == image {
+		return false, nil
+	}
+
+	cloudProviderSpec[field] = image
+	providerConfig["cloudProviderSpec"] = cloudProviderSpec
+
+	raw, err := json.Marshal(providerConfig)
+	if err != nil {
+		return false, fail.Runtime(err, "encoding providerconfig of MachineDeployment %s", md.Name)
+	}
+	md.Spec.Template.Spec.ProviderSpec.Value.Raw = raw
+
+	return true, nil
+}
+
+// waitForMachineDeploymentRollout waits for all machines of the
+// MachineDeployment to be replaced and available
+func waitForMachineDeploymentRollout(s *state.State, key dynclient.ObjectKey) error {
+	err := wait.PollUntilContextTimeout(s.Context, 10*time.Second, osUpgradeMachineDeploymentTimeout, true, func(ctx context.Context) (bool, error) {
+		md := clusterv1alpha1.MachineDeployment{}
+		if err := s.DynamicClient.Get(ctx, key, &md); err != nil {
+			return false, nil
+		}
+
+		replicas := int32(1)
+		if md.Spec.Replicas != nil {
+			replicas = *md.Spec.Replicas
+		}
+
+		return md.Status.ObservedGeneration >= md.Generation &&
+			md.Status.UpdatedReplicas == replicas &&
+			md.Status.AvailableReplicas == replicas &&
+			md.Status.Replicas == replicas, nil
+	})
+
+	return fail.KubeClient(err, "waiting for %T %s to be re-provisioned", clusterv1alpha1.MachineDeployment{}, key)
+}
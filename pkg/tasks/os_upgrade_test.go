@@ -0,0 +1,151 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"reflect"
+	"testing"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/state"
+
+	clusterv1alpha1 "github.com/kubermatic/machine-controller/pkg/apis/cluster/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func osUpgradeHost(name string, osName kubeoneapi.OperatingSystemName, release string) state.Host {
+	return state.Host{
+		Config:                 &kubeoneapi.HostConfig{Hostname: name, OperatingSystem: osName},
+		OperatingSystemRelease: release,
+	}
+}
+
+func TestOperatingSystemUpgradeHosts(t *testing.T) {
+	tests := []struct {
+		name          string
+		release       string
+		controlPlane  []state.Host
+		staticWorkers []state.Host
+		want          []string
+	}{
+		{
+			name: "release not set",
+			controlPlane: []state.Host{
+				osUpgradeHost("cp-0", kubeoneapi.OperatingSystemNameUbuntu, "22.04"),
+			},
+		},
+		{
+			name:    "older releases are upgraded",
+			release: "24.04",
+			controlPlane: []state.Host{
+				osUpgradeHost("cp-0", kubeoneapi.OperatingSystemNameUbuntu, "24.04"),
+				osUpgradeHost("cp-1", kubeoneapi.OperatingSystemNameUbuntu, "22.04"),
+			},
+			staticWorkers: []state.Host{
+				osUpgradeHost("worker-0", kubeoneapi.OperatingSystemNameUbuntu, "20.04"),
+			},
+			want: []string{"cp-1", "worker-0"},
+		},
+		{
+			name:    "other operating systems and unknown releases are skipped",
+			release: "24.04",
+			controlPlane: []state.Host{
+				osUpgradeHost("cp-0", kubeoneapi.OperatingSystemNameRockyLinux, "8.9"),
+				osUpgradeHost("cp-1", kubeoneapi.OperatingSystemNameUbuntu, ""),
+				osUpgradeHost("cp-2", kubeoneapi.OperatingSystemNameUbuntu, "24.10"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			s := &state.State{
+				Cluster: &kubeoneapi.KubeOneCluster{
+					Upgrades: &kubeoneapi.UpgradesConfig{
+						OperatingSystem: &kubeoneapi.OperatingSystemUpgradeConfig{UbuntuRelease: tt.release},
+					},
+				},
+				LiveCluster: &state.Cluster{
+					ControlPlane:  tt.controlPlane,
+					StaticWorkers: tt.staticWorkers,
+				},
+			}
+
+			var got []string
+			for _, host := range OperatingSystemUpgradeHosts(s) {
+				got = append(got, host.Config.Hostname)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("OperatingSystemUpgradeHosts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetMachineDeploymentImage(t *testing.T) {
+	tests := []struct {
+		name           string
+		providerConfig string
+		image          string
+		wantChanged    bool
+		wantConfig     string
+		wantErr        bool
+	}{
+		{
+			name:           "image changed",
+			providerConfig: `{"cloudProvider":"aws","cloudProviderSpec":{"ami":"ami-old","instanceType":"t3.medium"},"operatingSystem":"ubuntu"}`,
+			image:          "ami-new",
+			wantChanged:    true,
+			wantConfig:     `{"cloudProvider":"aws","cloudProviderSpec":{"ami":"ami-new","instanceType":"t3.medium"},"operatingSystem":"ubuntu"}`,
+		},
+		{
+			name:           "image already used",
+			providerConfig: `{"cloudProvider":"hetzner","cloudProviderSpec":{"image":"ubuntu-24.04"},"operatingSystem":"ubuntu"}`,
+			image:          "ubuntu-24.04",
+			wantConfig:     `{"cloudProvider":"hetzner","cloudProviderSpec":{"image":"ubuntu-24.04"},"operatingSystem":"ubuntu"}`,
+		},
+		{
+			name:           "unsupported cloud provider",
+			providerConfig: `{"cloudProvider":"digitalocean","cloudProviderSpec":{},"operatingSystem":"ubuntu"}`,
+			image:          "ubuntu-24-04-x64",
+			wantConfig:     `{"cloudProvider":"digitalocean","cloudProviderSpec":{},"operatingSystem":"ubuntu"}`,
+			wantErr:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			md := &clusterv1alpha1.MachineDeployment{}
+			md.Name = "pool-1"
+			md.Spec.Template.Spec.ProviderSpec.Value = &runtime.RawExtension{Raw: []byte(tt.providerConfig)}
+
+			changed, err := setMachineDeploymentImage(md, tt.image)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("setMachineDeploymentImage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if changed != tt.wantChanged {
+				t.Errorf("setMachineDeploymentImage() changed = %v, want %v", changed, tt.wantChanged)
+			}
+			if got := string(md.Spec.Template.Spec.ProviderSpec.Value.Raw); got != tt.wantConfig {
+				t.Errorf("setMachineDeploymentImage() providerConfig = %s, want %s", got, tt.wantConfig)
+			}
+		})
+	}
+}
@@ -29,7 +29,10 @@ import (
 	"k8c.io/kubeone/pkg/fail"
 	"k8c.io/kubeone/pkg/state"
 
+	clusterv1alpha1 "github.com/kubermatic/machine-controller/pkg/apis/cluster/v1alpha1"
+
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	dynclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -70,6 +73,15 @@ func runPreflightChecks(s *state.State) error {
 		}
 	}
 
+	s.Logger.Infoln("Verifying the version skew of the worker pools...")
+	if err := verifyWorkerPoolsVersionSkew(s); err != nil {
+		if !s.ForceUpgrade {
+			return err
+		}
+
+		s.Logger.Warningf("worker pools version skew check failed: %v", err)
+	}
+
 	return nil
 }
 
@@ -210,3 +222,152 @@ func checkVersionSkew(reqVer, currVer *semver.Version, diff uint64) error {
 
 	return nil
 }
+
+// workerPool describes the kubelet versions of a MachineDeployment, as
+// declared in its spec and as detected on the nodes of its machines
+type workerPool struct {
+	name     string
+	declared *semver.Version
+	detected []*semver.Version
+}
+
+// maxKubeletSkew returns how many minor versions kubelet can be older than
+// the given kube-apiserver version
+// https://kubernetes.io/docs/setup/release/version-skew-policy/#kubelet
+func maxKubeletSkew(apiserverVer *semver.Version) uint64 {
+	if apiserverVer.Minor() >= 28 {
+		return 3
+	}
+
+	return 2
+}
+
+// checkWorkerPoolsVersionSkew returns a report line for each worker pool
+// that would violate the kubelet version skew policy once the control plane
+// is upgraded to the requested version
+func checkWorkerPoolsVersionSkew(reqVer *semver.Version, pools []workerPool) []string {
+	report := []string{}
+	maxSkew := maxKubeletSkew(reqVer)
+
+	for _, pool := range pools {
+		versions := pool.detected
+		if pool.declared != nil {
+			versions = append([]*semver.Version{pool.declared}, versions...)
+		}
+		if len(versions) == 0 {
+			continue
+		}
+
+		oldest, newest := versions[0], versions[0]
+		for _, ver := range versions[1:] {
+			if ver.LessThan(oldest) {
+				oldest = ver
+			}
+			if ver.GreaterThan(newest) {
+				newest = ver
+			}
+		}
+
+		if reqVer.Minor() > oldest.Minor() && reqVer.Minor()-oldest.Minor() > maxSkew {
+			report = append(report, fmt.Sprintf(
+				"MachineDeployment %q is running kubelet v%s, it must be upgraded to at least v1.%d before the control plane is upgraded to v%s",
+				pool.name, oldest, reqVer.Minor()-maxSkew, reqVer))
+		}
+
+		if newest.Minor() > reqVer.Minor() {
+			report = append(report, fmt.Sprintf(
+				"MachineDeployment %q is running kubelet v%s, which is newer than the requested version v%s",
+				pool.name, newest, reqVer))
+		}
+	}
+
+	return report
+}
+
+// verifyWorkerPoolsVersionSkew ensures that the control plane upgrade
+// doesn't violate the kubelet version skew policy for the MachineDeployments,
+// based on the kubelet versions declared in the MachineDeployments and on the
+// kubelet versions of their nodes
+func verifyWorkerPoolsVersionSkew(s *state.State) error {
+	reqVer, err := semver.NewVersion(s.Cluster.Versions.Kubernetes)
+	if err != nil {
+		return fail.ConfigValidation(err)
+	}
+
+	machineDeployments := clusterv1alpha1.MachineDeploymentList{}
+	if err = s.DynamicClient.List(s.Context, &machineDeployments, dynclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil
+		}
+
+		return fail.KubeClient(err, "getting %T", machineDeployments)
+	}
+
+	if len(machineDeployments.Items) == 0 {
+		return nil
+	}
+
+	machines := clusterv1alpha1.MachineList{}
+	if err = s.DynamicClient.List(s.Context, &machines, dynclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+		return fail.KubeClient(err, "getting %T", machines)
+	}
+
+	nodes := corev1.NodeList{}
+	if err = s.DynamicClient.List(s.Context, &nodes); err != nil {
+		return fail.KubeClient(err, "getting %T", nodes)
+	}
+
+	kubeletVersions := map[string]string{}
+	for _, node := range nodes.Items {
+		kubeletVersions[node.Name] = node.Status.NodeInfo.KubeletVersion
+	}
+
+	pools := []workerPool{}
+	for _, md := range machineDeployments.Items {
+		pool := workerPool{name: md.Name}
+
+		if kubelet := md.Spec.Template.Spec.Versions.Kubelet; kubelet != "" {
+			if pool.declared, err = semver.NewVersion(kubelet); err != nil {
+				return fail.Runtime(err, "parsing %q MachineDeployment kubelet version", md.Name)
+			}
+		}
+
+		selector, selectorErr := metav1.LabelSelectorAsSelector(&md.Spec.Selector)
+		if selectorErr != nil {
+			return fail.Runtime(selectorErr, "parsing %q MachineDeployment selector", md.Name)
+		}
+
+		for _, machine := range machines.Items {
+			if machine.Status.NodeRef == nil || !selector.Matches(labels.Set(machine.Labels)) {
+				continue
+			}
+
+			kubelet, found := kubeletVersions[machine.Status.NodeRef.Name]
+			if !found {
+				continue
+			}
+
+			ver, kubeletErr := semver.NewVersion(kubelet)
+			if kubeletErr != nil {
+				return fail.Runtime(kubeletErr, "parsing %q node kubelet version", machine.Status.NodeRef.Name)
+			}
+			pool.detected = append(pool.detected, ver)
+		}
+
+		pools = append(pools, pool)
+	}
+
+	report := checkWorkerPoolsVersionSkew(reqVer, pools)
+	if len(report) == 0 {
+		return nil
+	}
+
+	for _, line := range report {
+		s.Logger.Warnln(line)
+	}
+
+	return fail.RuntimeError{
+		Op:  "checking worker pools version skew policy",
+		Err: errors.Errorf("%d worker pool(s) must be upgraded before the control plane", len(report)),
+	}
+}
@@ -267,3 +267,97 @@ func TestCheckVersionSkewInvalid(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckWorkerPoolsVersionSkew(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name           string
+		desiredVersion *semver.Version
+		pools          []workerPool
+		expectedReport int
+	}{
+		{
+			name:           "pools within the skew",
+			desiredVersion: semver.MustParse("1.27.4"),
+			pools: []workerPool{
+				{
+					name:     "pool1",
+					declared: semver.MustParse("1.26.7"),
+					detected: []*semver.Version{semver.MustParse("1.25.12"), semver.MustParse("1.26.7")},
+				},
+				{
+					name:     "pool2",
+					declared: semver.MustParse("1.27.4"),
+				},
+			},
+		},
+		{
+			name:           "pool declaring a version too old",
+			desiredVersion: semver.MustParse("1.27.4"),
+			pools: []workerPool{
+				{
+					name:     "pool1",
+					declared: semver.MustParse("1.24.16"),
+				},
+				{
+					name:     "pool2",
+					declared: semver.MustParse("1.26.7"),
+				},
+			},
+			expectedReport: 1,
+		},
+		{
+			name:           "pool with machines running a version too old",
+			desiredVersion: semver.MustParse("1.27.4"),
+			pools: []workerPool{
+				{
+					name:     "pool1",
+					declared: semver.MustParse("1.26.7"),
+					detected: []*semver.Version{semver.MustParse("1.24.16"), semver.MustParse("1.26.7")},
+				},
+			},
+			expectedReport: 1,
+		},
+		{
+			name:           "larger skew allowed since 1.28",
+			desiredVersion: semver.MustParse("1.28.1"),
+			pools: []workerPool{
+				{
+					name:     "pool1",
+					detected: []*semver.Version{semver.MustParse("1.25.12")},
+				},
+			},
+		},
+		{
+			name:           "pool newer than the control plane",
+			desiredVersion: semver.MustParse("1.27.4"),
+			pools: []workerPool{
+				{
+					name:     "pool1",
+					declared: semver.MustParse("1.28.1"),
+				},
+			},
+			expectedReport: 1,
+		},
+		{
+			name:           "pool without versions",
+			desiredVersion: semver.MustParse("1.27.4"),
+			pools: []workerPool{
+				{
+					name: "pool1",
+				},
+			},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			report := checkWorkerPoolsVersionSkew(tc.desiredVersion, tc.pools)
+			if len(report) != tc.expectedReport {
+				t.Fatalf("expected %d report lines, but got %v", tc.expectedReport, report)
+			}
+		})
+	}
+}
@@ -18,6 +18,9 @@ package tasks
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
 	"time"
 
 	"github.com/MakeNowJust/heredoc/v2"
@@ -36,6 +39,9 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// kubeadmPatchFileRegexp matches the kubeadm patch file name format: target[suffix][+patchtype].extension
+var kubeadmPatchFileRegexp = regexp.MustCompile(`^(etcd|kube-apiserver|kube-controller-manager|kube-scheduler|kubeletconfiguration)[^+.]*(\+(strategic|merge|json))?\.(json|yaml)$`)
+
 func installPrerequisites(s *state.State) error {
 	s.Logger.Infoln("Installing prerequisites...")
 
@@ -88,6 +94,11 @@ func generateConfigurationFiles(s *state.State) error {
 			return err
 		}
 	}
+	if s.Cluster.KubeadmPatchesEnabled() {
+		if err := addKubeadmPatches(s); err != nil {
+			return err
+		}
+	}
 	if s.Cluster.Features.PodNodeSelector != nil && s.Cluster.Features.PodNodeSelector.Enable {
 		admissionCfg, err := admissionconfig.NewAdmissionConfig(s.Cluster.Versions.Kubernetes, s.Cluster.Features.PodNodeSelector)
 		if err != nil {
@@ -123,6 +134,40 @@ func generateConfigurationFiles(s *state.State) error {
 	return nil
 }
 
+// addKubeadmPatches adds patch files from the configured kubeadm patches
+// directory to the configuration files uploaded to the nodes
+func addKubeadmPatches(s *state.State) error {
+	patchesDir := s.Cluster.ControlPlaneComponents.Patches.Directory
+	if !filepath.IsAbs(patchesDir) && s.ManifestFilePath != "" {
+		manifestAbsPath, err := filepath.Abs(filepath.Dir(s.ManifestFilePath))
+		if err != nil {
+			return fail.Runtime(err, "getting absolute path to the manifest file")
+		}
+		patchesDir = filepath.Join(manifestAbsPath, patchesDir)
+	}
+
+	entries, err := os.ReadDir(patchesDir)
+	if err != nil {
+		return fail.Runtime(err, "reading kubeadm patches directory")
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		if !kubeadmPatchFileRegexp.MatchString(entry.Name()) {
+			return fail.Config(fmt.Errorf("file %q must be named \"target[suffix][+patchtype].extension\"", entry.Name()), "validating kubeadm patches")
+		}
+
+		if err := s.Configuration.AddFilePath("cfg/patches/"+entry.Name(), filepath.Join(patchesDir, entry.Name()), ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func installPrerequisitesOnNode(s *state.State, node *kubeoneapi.HostConfig, _ executor.Interface) error {
 	logger := s.Logger.WithField("os", node.OperatingSystem)
 
@@ -280,6 +325,15 @@ func uploadConfigurationFilesToNode(s *state.State, _ *kubeoneapi.HostConfig, co
 		return fail.SSH(err, "saving audit-policy")
 	}
 
+	cmd, err = scripts.SaveKubeadmPatches(s.WorkDir)
+	if err != nil {
+		return err
+	}
+	_, _, err = s.Runner.RunRaw(cmd)
+	if err != nil {
+		return fail.SSH(err, "saving kubeadm patches")
+	}
+
 	cmd, err = scripts.SavePodNodeSelectorConfig(s.WorkDir)
 	if err != nil {
 		return err
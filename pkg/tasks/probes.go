@@ -26,6 +26,7 @@ import (
 	"github.com/pkg/errors"
 	"gopkg.in/yaml.v2"
 
+	"k8c.io/kubeone/pkg/addons"
 	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
 	"k8c.io/kubeone/pkg/clusterstatus/apiserverstatus"
 	"k8c.io/kubeone/pkg/clusterstatus/etcdstatus"
@@ -53,9 +54,21 @@ const (
 
 	kubeletInitializedCMD = `test -f /etc/kubernetes/kubelet.conf`
 
+	cgroupFilesystemTypeCMD = `stat -fc %T /sys/fs/cgroup/`
+	cgroupV2FilesystemType  = "cgroup2fs"
+	kubeletCgroupDriverCMD  = `sudo sed -n 's/^cgroupDriver: *//p' /var/lib/kubelet/config.yaml 2>/dev/null || true`
+
+	defaultRouteCMD = `ip -4 route show default`
+
+	gceServiceAccountScopesCMD = `curl -sSf -H Metadata-Flavor:Google http://metadata.google.internal/computeMetadata/v1/instance/service-accounts/default/scopes || true`
+	gceComputeScope            = "https://www.googleapis.com/auth/compute"
+	gceCloudPlatformScope      = "https://www.googleapis.com/auth/cloud-platform"
+
 	k8sAppLabel               = "k8s-app"
 	openstackCCMAppLabelValue = "openstack-cloud-controller-manager"
 
+	cloudProviderUninitializedTaint = "node.cloudprovider.kubernetes.io/uninitialized"
+
 	nodeRoleMaster       = "node-role.kubernetes.io/master"
 	nodeRoleControlPlane = "node-role.kubernetes.io/control-plane"
 
@@ -137,6 +150,16 @@ func safeguard(s *state.State) error {
 					Op:  ".cloudProvider.external is enabled",
 				}
 			}
+
+			// Nutanix doesn't have an in-tree provider, but nodes of the
+			// clusters created without the external CCM are neither
+			// initialized by the CCM, nor waiting to be initialized
+			if s.Cluster.CloudProvider.Nutanix != nil && !st.ExternalCCMDeployed && !anyNodeUsesExternalCloudProvider(nodes.Items) {
+				return fail.RuntimeError{
+					Err: errors.New("cluster is running without the nutanix ccm. run ccm migration by running 'kubeone migrate to-ccm-csi'"),
+					Op:  ".cloudProvider.external is enabled",
+				}
+			}
 		} else {
 			if st.ExternalCCMDeployed {
 				// Block disabling .cloudProvider.external
@@ -148,6 +171,10 @@ func safeguard(s *state.State) error {
 		}
 	}
 
+	if err := safeguardCNI(s); err != nil {
+		return err
+	}
+
 	if err := safeguardNodeTaints(s); err != nil {
 		return err
 	}
@@ -159,6 +186,34 @@ func safeguard(s *state.State) error {
 	return safeguardFlatcarMachineDeployments(s)
 }
 
+// safeguardCNI blocks kubeone apply if the configured CNI plugin is different
+// than the CNI plugin deployed in the cluster, or if the migration to another
+// CNI plugin is not finished. The CNI plugin can be changed only by running
+// the CNI migration.
+func safeguardCNI(s *state.State) error {
+	st := s.LiveCluster.CNIStatus
+	if st == nil {
+		return nil
+	}
+
+	if st.MigratingFrom != "" {
+		return fail.RuntimeError{
+			Err: errors.Errorf("migration from %q is not finished, finish it by running 'kubeone migrate cni'", st.MigratingFrom),
+			Op:  "checking CNI migration",
+		}
+	}
+
+	desired := addons.CNIAddonName(s.Cluster.ClusterNetwork.CNI)
+	if st.Addon != "" && desired != "" && st.Addon != desired {
+		return fail.RuntimeError{
+			Err: errors.Errorf("cluster is running %q, but %q is configured. run the CNI migration by running 'kubeone migrate cni'", st.Addon, desired),
+			Op:  ".clusterNetwork.cni",
+		}
+	}
+
+	return nil
+}
+
 // safeguardNodeTaints ensures that there are no Nodes running Kubernetes 1.25
 // that have the "node-role.kubernetes.io/master" taint as it's removed in
 // Kubernetes 1.25.
@@ -360,6 +415,26 @@ func runProbes(s *state.State) error {
 		return err
 	}
 
+	if err := verifyCgroupVersion(s); err != nil {
+		return err
+	}
+
+	if err := verifyPrivateNetworkEgress(s); err != nil {
+		return err
+	}
+
+	if err := verifyAzureManagedIdentity(s); err != nil {
+		return err
+	}
+
+	if err := verifyGCEServiceAccount(s); err != nil {
+		return err
+	}
+
+	if err := verifyGCESharedVPC(s); err != nil {
+		return err
+	}
+
 	if s.LiveCluster.IsProvisioned() {
 		if err := investigateCluster(s); err != nil {
 			return err
@@ -473,6 +548,36 @@ func investigateHost(s *state.State, node *kubeoneapi.HostConfig, conn executor.
 		return err
 	}
 
+	if err = detectCgroupVersion(foundHost, conn); err != nil {
+		return err
+	}
+
+	if err = detectOperatingSystemRelease(foundHost, conn); err != nil {
+		return err
+	}
+
+	if foundHost.Initialized() {
+		if err = detectKubeletCgroupDriver(foundHost, conn); err != nil {
+			return err
+		}
+
+		if err = detectConfigurationDrift(s, foundHost, conn); err != nil {
+			return err
+		}
+	}
+
+	if s.Cluster.CloudProvider.Hetzner != nil && foundHost.Config.PrivateNetworkOnly() {
+		if err = detectDefaultRoute(foundHost, conn); err != nil {
+			return err
+		}
+	}
+
+	if gce := s.Cluster.CloudProvider.GCE; gce != nil && gce.CredentialsMode == kubeoneapi.GCECredentialsModeAttachedServiceAccount && controlPlane {
+		if err = detectGCEServiceAccountScopes(foundHost, conn); err != nil {
+			return err
+		}
+	}
+
 	if foundHost.Initialized() && controlPlane {
 		foundHost.EarliestCertExpiry, err = earliestCertExpiry(conn)
 		if err != nil {
@@ -614,9 +719,35 @@ func investigateCluster(s *state.State) error {
 		s.LiveCluster.Lock.Unlock()
 	}
 
+	cniStatus, err := detectCNIStatus(s, nodes.Items)
+	if err != nil {
+		return err
+	}
+	s.LiveCluster.Lock.Lock()
+	s.LiveCluster.CNIStatus = cniStatus
+	s.LiveCluster.Lock.Unlock()
+
 	return nil
 }
 
+// anyNodeUsesExternalCloudProvider returns true if any of the given nodes is
+// initialized by the external CCM, or waits to be initialized by it
+func anyNodeUsesExternalCloudProvider(nodes []corev1.Node) bool {
+	for _, node := range nodes {
+		if node.Spec.ProviderID != "" {
+			return true
+		}
+
+		for _, taint := range node.Spec.Taints {
+			if taint.Key == cloudProviderUninitializedTaint {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 type systemdUnitInfoOpt func(component *state.ComponentStatus, conn executor.Interface) error
 
 func systemdUnitInfo(name string, conn executor.Interface, opts ...systemdUnitInfoOpt) (state.ComponentStatus, error) {
@@ -700,6 +831,193 @@ func detectKubeletInitialized(host *state.Host, conn executor.Interface) error {
 	return nil
 }
 
+func detectCgroupVersion(host *state.Host, conn executor.Interface) error {
+	out, _, _, err := conn.Exec(cgroupFilesystemTypeCMD)
+	if err != nil {
+		return err
+	}
+
+	host.CgroupVersion = kubeoneapi.CgroupVersionV1
+	if strings.TrimSpace(out) == cgroupV2FilesystemType {
+		host.CgroupVersion = kubeoneapi.CgroupVersionV2
+	}
+
+	return nil
+}
+
+// detectOperatingSystemRelease reads the release version of the operating
+// system, e.g. 22.04 on Ubuntu
+func detectOperatingSystemRelease(host *state.Host, conn executor.Interface) error {
+	release, err := operatingSystemRelease(conn)
+	if err != nil {
+		return err
+	}
+
+	host.OperatingSystemRelease = release
+
+	return nil
+}
+
+// detectKubeletCgroupDriver reads the cgroup driver used by the kubelet on an
+// already provisioned node
+func detectKubeletCgroupDriver(host *state.Host, conn executor.Interface) error {
+	out, _, _, err := conn.Exec(kubeletCgroupDriverCMD)
+	if err != nil {
+		return err
+	}
+
+	host.CgroupDriver = kubeoneapi.CgroupDriver(strings.TrimSpace(out))
+
+	return nil
+}
+
+// detectDefaultRoute checks if the host has an IPv4 default route
+func detectDefaultRoute(host *state.Host, conn executor.Interface) error {
+	out, _, _, err := conn.Exec(defaultRouteCMD)
+	if err != nil {
+		return err
+	}
+
+	host.HasDefaultRoute = strings.TrimSpace(out) != ""
+
+	return nil
+}
+
+// verifyPrivateNetworkEgress ensures that Hetzner hosts reachable only over
+// the private network can reach the Internet, which is needed to install
+// packages and pull images.
+func verifyPrivateNetworkEgress(s *state.State) error {
+	if s.Cluster.CloudProvider.Hetzner == nil {
+		return nil
+	}
+
+	var noEgressNodes []string
+	hosts := append(append([]state.Host{}, s.LiveCluster.ControlPlane...), s.LiveCluster.StaticWorkers...)
+	for _, host := range hosts {
+		if host.Config.PrivateNetworkOnly() && !host.HasDefaultRoute {
+			noEgressNodes = append(noEgressNodes, host.Config.Hostname)
+		}
+	}
+
+	if len(noEgressNodes) > 0 {
+		s.Logger.Errorf("Found %d node(s) without public interfaces and without a default route: %s", len(noEgressNodes), noEgressNodes)
+		s.Logger.Warnf("Add a 0.0.0.0/0 route to the Hetzner network pointing to a NAT gateway and route the traffic of those nodes through the network gateway (the first IP of the network). See examples/terraform/hetzner-private-network for an example.")
+
+		return fail.RuntimeError{
+			Err: errors.New("some nodes reachable only over the private network can't reach the Internet"),
+			Op:  "checking private network egress",
+		}
+	}
+
+	return nil
+}
+
+// detectGCEServiceAccountScopes reads the scopes of the service account
+// attached to the instance from the metadata server
+func detectGCEServiceAccountScopes(host *state.Host, conn executor.Interface) error {
+	out, _, _, err := conn.Exec(gceServiceAccountScopesCMD)
+	if err != nil {
+		return err
+	}
+
+	host.GCEServiceAccountScopes = strings.Fields(out)
+
+	return nil
+}
+
+// verifyGCEServiceAccount ensures that all control plane instances have a
+// service account attached with a scope that allows managing compute
+// resources, which is needed when the service account key is not used.
+func verifyGCEServiceAccount(s *state.State) error {
+	gce := s.Cluster.CloudProvider.GCE
+	if gce == nil || gce.CredentialsMode != kubeoneapi.GCECredentialsModeAttachedServiceAccount {
+		return nil
+	}
+
+	var missingScopeNodes []string
+	for _, host := range s.LiveCluster.ControlPlane {
+		scopes := sets.NewString(host.GCEServiceAccountScopes...)
+		if !scopes.Has(gceComputeScope) && !scopes.Has(gceCloudPlatformScope) {
+			missingScopeNodes = append(missingScopeNodes, host.Config.Hostname)
+		}
+	}
+
+	if len(missingScopeNodes) > 0 {
+		s.Logger.Errorf("Found %d control plane node(s) without a service account with the compute or cloud-platform scope: %s", len(missingScopeNodes), missingScopeNodes)
+		s.Logger.Warnf("Attach a service account with the %q or %q scope to those instances, or use the ServiceAccountKey credentials mode.", gceComputeScope, gceCloudPlatformScope)
+
+		return fail.RuntimeError{
+			Err: errors.New("some control plane nodes don't have a service account with the required scopes"),
+			Op:  "checking gce service account",
+		}
+	}
+
+	return nil
+}
+
+// verifyCgroupVersion ensures that all control plane and static worker nodes
+// are using the cgroup version requested in the KubeOneCluster manifest, that
+// the cgroupfs driver is not used with cgroup v2, and that the cgroup driver is
+// not changed on already provisioned nodes.
+func verifyCgroupVersion(s *state.State) error {
+	requiredVersion := s.Cluster.Cgroups.Version
+	if requiredVersion == "" && s.Cluster.Features.SwapEnabled() {
+		// swap support in kubelet works only with cgroup v2
+		requiredVersion = kubeoneapi.CgroupVersionV2
+	}
+	driver := s.Cluster.Cgroups.CgroupDriver()
+
+	var mismatchedNodes, cgroupfsV2Nodes, driverChangedNodes []string
+	hosts := append(append([]state.Host{}, s.LiveCluster.ControlPlane...), s.LiveCluster.StaticWorkers...)
+	for _, host := range hosts {
+		if requiredVersion != "" && host.CgroupVersion != requiredVersion {
+			mismatchedNodes = append(mismatchedNodes, host.Config.Hostname)
+		}
+		if driver == kubeoneapi.CgroupDriverCgroupfs && host.CgroupVersion == kubeoneapi.CgroupVersionV2 {
+			cgroupfsV2Nodes = append(cgroupfsV2Nodes, host.Config.Hostname)
+		}
+		if host.CgroupDriver != "" && host.CgroupDriver != driver {
+			driverChangedNodes = append(driverChangedNodes, host.Config.Hostname)
+		}
+	}
+
+	if len(mismatchedNodes) > 0 {
+		unifiedHierarchy := "1"
+		if requiredVersion == kubeoneapi.CgroupVersionV1 {
+			unifiedHierarchy = "0"
+		}
+
+		s.Logger.Errorf("Found %d node(s) that are not using cgroup %s: %s", len(mismatchedNodes), requiredVersion, mismatchedNodes)
+		s.Logger.Warnf("Add \"systemd.unified_cgroup_hierarchy=%s\" to the kernel command line and reboot those nodes, or adjust .cgroups.version, before proceeding.", unifiedHierarchy)
+
+		return fail.RuntimeError{
+			Err: errors.Errorf("some nodes are not using cgroup %s", requiredVersion),
+			Op:  "checking cgroup version",
+		}
+	}
+
+	if len(cgroupfsV2Nodes) > 0 {
+		s.Logger.Errorf("Found %d node(s) using cgroup v2 that can't be used with the cgroupfs driver: %s", len(cgroupfsV2Nodes), cgroupfsV2Nodes)
+
+		return fail.RuntimeError{
+			Err: errors.New("cgroupfs cgroup driver is not supported with cgroup v2, use the systemd driver instead"),
+			Op:  "checking cgroup version",
+		}
+	}
+
+	if len(driverChangedNodes) > 0 {
+		s.Logger.Errorf("Found %d provisioned node(s) using a cgroup driver different than %q: %s", len(driverChangedNodes), driver, driverChangedNodes)
+		s.Logger.Warnf("The cgroup driver can't be changed on provisioned nodes. Revert .cgroups.driver, or reset and reprovision those nodes.")
+
+		return fail.RuntimeError{
+			Err: errors.Errorf("changing the cgroup driver to %q on provisioned nodes is not supported", driver),
+			Op:  "checking cgroup driver",
+		}
+	}
+
+	return nil
+}
+
 func systemdUnitExecStartPath(conn executor.Interface, unitName string) (string, error) {
 	out, _, _, err := conn.Exec(fmt.Sprintf(systemdShowExecStartCMD, unitName))
 	if err != nil {
@@ -852,6 +1170,8 @@ func detectCCMMigrationStatus(s *state.State) (*state.CCMStatus, error) {
 		ccmLabelValue = openstackCCMAppLabelValue
 	case s.Cluster.CloudProvider.Vsphere != nil:
 		ccmLabelValue = "vsphere-cloud-controller-manager"
+	case s.Cluster.CloudProvider.Nutanix != nil:
+		ccmLabelValue = "nutanix-cloud-controller-manager"
 	default:
 		status.ExternalCCMDeployed = false
 
@@ -0,0 +1,87 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"fmt"
+
+	"github.com/Masterminds/semver/v3"
+
+	"k8c.io/kubeone/pkg/addons"
+	"k8c.io/kubeone/pkg/certificate"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/removedapis"
+	"k8c.io/kubeone/pkg/state"
+)
+
+// checkRemovedAPIs scans the cluster and the addons for the APIs removed by
+// the upgrade. The addons using the removed APIs would fail to apply after the
+// upgrade, so they fail the upgrade unless it's forced. The objects in the
+// cluster are still served in the new API versions, so they're only reported
+// for their clients to be migrated.
+func checkRemovedAPIs(s *state.State) error {
+	if s.DynamicClient == nil {
+		return fail.NoKubeClient()
+	}
+
+	from := oldestControlPlaneVersion(s)
+	if from == nil {
+		return nil
+	}
+
+	to, err := semver.NewVersion(s.Cluster.Versions.Kubernetes)
+	if err != nil {
+		return fail.ConfigValidation(err)
+	}
+
+	s.Logger.Infof("Scanning for the APIs removed up to Kubernetes %d.%d...", to.Major(), to.Minor())
+
+	clusterFindings, err := removedapis.ScanCluster(s.Context, s.DynamicClient, from, to)
+	if err != nil {
+		return err
+	}
+
+	for _, finding := range clusterFindings {
+		s.Logger.Warnf("Removed API used in the cluster: %s", finding)
+	}
+
+	// the addons are rendered with the certificates signed by the cluster CA
+	if err = s.RunTaskOnLeader(certificate.DownloadKubePKI); err != nil {
+		return err
+	}
+
+	addonFindings, err := addons.RemovedAPIs(s, from)
+	if err != nil {
+		return err
+	}
+
+	if len(addonFindings) == 0 {
+		return nil
+	}
+
+	for _, finding := range addonFindings {
+		s.Logger.Errorf("Removed API used by the addons: %s", finding)
+	}
+
+	if s.ForceUpgrade {
+		s.Logger.Warnln("Upgrade forced, the addons using the removed APIs will fail to apply.")
+
+		return nil
+	}
+
+	return fail.ConfigValidation(fmt.Errorf("%d addon object(s) use APIs removed in Kubernetes %d.%d, migrate them or use --force-upgrade", len(addonFindings), to.Major(), to.Minor()))
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/clusterstatus/apiserverstatus"
+	"k8c.io/kubeone/pkg/etcdutil"
+	"k8c.io/kubeone/pkg/executor"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/state"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	dynclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// electLeaderForReplacement elects the first control plane host with a
+// healthy kube-apiserver as the leader. The host being replaced can be
+// unreachable, so the hosts are not probed the usual way.
+func electLeaderForReplacement(s *state.State) error {
+	for i := range s.Cluster.ControlPlane.Hosts {
+		s.Cluster.ControlPlane.Hosts[i].IsLeader = false
+	}
+
+	for i := range s.Cluster.ControlPlane.Hosts {
+		apiserverStatus, _ := apiserverstatus.Get(s, s.Cluster.ControlPlane.Hosts[i])
+		if apiserverStatus != nil && apiserverStatus.Health {
+			s.Cluster.ControlPlane.Hosts[i].IsLeader = true
+			s.Logger.Infof("Elected leader %q...", s.Cluster.ControlPlane.Hosts[i].PublicAddress)
+
+			return nil
+		}
+	}
+
+	return fail.RuntimeError{
+		Op:  "electing leader",
+		Err: errors.New("no control plane host with a healthy kube-apiserver found"),
+	}
+}
+
+// removeEtcdMember removes the etcd member of the replaced control plane
+// node, if the remaining members keep the quorum
+func removeEtcdMember(s *state.State, nodeName string) error {
+	leader, err := s.Cluster.Leader()
+	if err != nil {
+		return err
+	}
+
+	etcdcfg, err := etcdutil.NewClientConfig(s, leader)
+	if err != nil {
+		return err
+	}
+
+	etcdcli, err := clientv3.New(*etcdcfg)
+	if err != nil {
+		return fail.Etcd(err, "initializing new clientv3")
+	}
+	defer etcdcli.Close()
+
+	etcdRing, err := etcdcli.MemberList(s.Context)
+	if err != nil {
+		return fail.Etcd(err, "getting members list")
+	}
+
+	var (
+		replaced *uint64
+		healthy  int
+	)
+
+	for _, member := range etcdRing.Members {
+		if member.Name == nodeName {
+			id := member.ID
+			replaced = &id
+
+			continue
+		}
+
+		if etcdMemberHealthy(s.Context, etcdcli, member.ClientURLs) {
+			healthy++
+		}
+	}
+
+	if replaced == nil {
+		s.Logger.Infof("etcd member %q not found, it's already removed", nodeName)
+
+		return nil
+	}
+
+	remaining := len(etcdRing.Members) - 1
+	if remaining == 0 {
+		return fail.Etcd(errors.New("the only etcd member can't be removed"), "removing %q etcd member", nodeName)
+	}
+
+	if quorum := remaining/2 + 1; healthy < quorum {
+		return fail.Etcd(
+			errors.Errorf("only %d of the remaining %d members are healthy, %d are needed for the quorum", healthy, remaining, quorum),
+			"removing %q etcd member", nodeName)
+	}
+
+	s.Logger.Infof("Removing etcd member %q...", nodeName)
+
+	_, err = etcdcli.MemberRemove(s.Context, *replaced)
+
+	return fail.Etcd(err, "removing %q etcd member", nodeName)
+}
+
+func etcdMemberHealthy(ctx context.Context, etcdcli *clientv3.Client, clientURLs []string) bool {
+	for _, endpoint := range clientURLs {
+		endpointURL, err := url.Parse(endpoint)
+		if err != nil {
+			continue
+		}
+
+		status, err := etcdcli.Status(ctx, endpointURL.Host)
+		if err == nil && len(status.Errors) == 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// deleteControlPlaneNode deletes the Node object of the replaced control
+// plane node
+func deleteControlPlaneNode(s *state.State, nodeName string) error {
+	if s.DynamicClient == nil {
+		return fail.NoKubeClient()
+	}
+
+	node := corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: nodeName}}
+
+	s.Logger.Infof("Deleting Node %q...", nodeName)
+
+	err := s.DynamicClient.Delete(s.Context, &node)
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+
+	return fail.KubeClient(err, "deleting %q Node", nodeName)
+}
+
+// waitForControlPlaneHosts waits until all control plane hosts, including the
+// replacement host, are reachable over SSH
+func waitForControlPlaneHosts(s *state.State, timeout time.Duration) error {
+	s.Logger.Infoln("Waiting for the replacement host to become reachable...")
+
+	var lastErr error
+
+	err := wait.PollUntilContextTimeout(s.Context, 15*time.Second, timeout, true, func(context.Context) (bool, error) {
+		lastErr = s.RunTaskOnControlPlane(func(s *state.State, _ *kubeoneapi.HostConfig, _ executor.Interface) error {
+			_, _, err := s.Runner.RunRaw("true")
+
+			return fail.SSH(err, "connecting to control plane host")
+		}, state.RunParallel)
+
+		return lastErr == nil, nil
+	})
+	if err != nil && lastErr != nil {
+		err = lastErr
+	}
+
+	return fail.Runtime(err, "waiting for the replacement host")
+}
+
+// verifyControlPlaneNodeName ensures the replaced node is a control plane
+// node, if its Node object still exists
+func verifyControlPlaneNodeName(s *state.State, nodeName string) error {
+	if s.DynamicClient == nil {
+		return fail.NoKubeClient()
+	}
+
+	node := corev1.Node{}
+	err := s.DynamicClient.Get(s.Context, dynclient.ObjectKey{Name: nodeName}, &node)
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fail.KubeClient(err, "getting %q Node", nodeName)
+	}
+
+	if _, ok := node.Labels[labelControlPlaneNode]; !ok {
+		return fail.RuntimeError{
+			Op:  "verifying replaced node",
+			Err: errors.Errorf("node %q is not a control plane node", nodeName),
+		}
+	}
+
+	return nil
+}
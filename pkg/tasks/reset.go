@@ -17,7 +17,10 @@ limitations under the License.
 package tasks
 
 import (
+	"k8c.io/kubeone/pkg/addons"
 	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/bmc"
+	"k8c.io/kubeone/pkg/certificate"
 	"k8c.io/kubeone/pkg/clientutil"
 	"k8c.io/kubeone/pkg/executor"
 	"k8c.io/kubeone/pkg/fail"
@@ -97,6 +100,26 @@ func destroyWorkers(s *state.State) error {
 	return nil
 }
 
+// applyPreResetAddons applies the PreReset addons. The cluster is probed
+// first, as the addons are rendered using the state of the live cluster.
+func applyPreResetAddons(s *state.State) error {
+	if err := WithProbes(WithHostnameOS(nil)).Run(s); err != nil {
+		return err
+	}
+
+	if s.DynamicClient == nil {
+		if err := kubeconfig.BuildKubernetesClientset(s); err != nil {
+			return err
+		}
+	}
+
+	if err := s.RunTaskOnLeader(certificate.DownloadKubePKI); err != nil {
+		return err
+	}
+
+	return addons.EnsureAddonsPhase(s, kubeoneapi.AddonPhasePreReset)
+}
+
 func resetAllNodes(s *state.State) error {
 	s.Logger.Infoln("Resettings all the nodes...")
 
@@ -126,6 +149,30 @@ func removeBinariesAllNodes(s *state.State) error {
 	return s.RunTaskOnAllNodes(removeBinaries, state.RunParallel)
 }
 
+// reprovisionHosts configures hosts with a BMC to boot once from PXE or the
+// virtual media and power cycles them, so they're re-imaged by the
+// provisioning infrastructure
+func reprovisionHosts(s *state.State) error {
+	var hosts []kubeoneapi.HostConfig
+	hosts = append(hosts, s.Cluster.ControlPlane.Hosts...)
+	hosts = append(hosts, s.Cluster.StaticWorkers.Hosts...)
+
+	for _, host := range hosts {
+		if host.BMC == nil {
+			s.Logger.Warnf("Skipping re-provisioning host %q because BMC is not configured", host.PublicAddress)
+
+			continue
+		}
+
+		s.Logger.Infof("Re-provisioning host %q from %s using %s...", host.PublicAddress, host.BMC.BootDevice, host.BMC.Protocol)
+		if err := bmc.Reprovision(s.Context, *host.BMC); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func removeBinaries(s *state.State, node *kubeoneapi.HostConfig, _ executor.Interface) error {
 	s.Logger.Infoln("Removing Kubernetes binaries")
 	var err error
@@ -0,0 +1,172 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"path/filepath"
+	"time"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/executor"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/scripts"
+	"k8c.io/kubeone/pkg/state"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeletconfigv1beta1 "k8s.io/kubelet/config/v1beta1"
+	dynclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	kubeletConfigMapKey       = "kubelet"
+	kubeletConfigExistsCMD    = `sudo test -f ` + kubeletConfigFile
+	seccompProfilesUploadPath = "cfg/seccomp/"
+)
+
+var kubeletConfigMapObjectKey = dynclient.ObjectKey{
+	Namespace: metav1.NamespaceSystem,
+	Name:      "kubelet-config",
+}
+
+// ensureSeccompDefault distributes the custom seccomp profiles to all control
+// plane and static worker nodes, removing profiles that are no longer listed,
+// and reconfigures kubelets on already provisioned nodes if the SeccompDefault
+// feature has been enabled or disabled since the last apply.
+func ensureSeccompDefault(s *state.State) error {
+	if err := ensureKubeletConfigMapSeccompDefault(s); err != nil {
+		return err
+	}
+
+	if seccompDefaultEnabled(s.Cluster) {
+		for _, profilePath := range s.Cluster.Features.SeccompDefault.ProfilePaths {
+			if err := s.Configuration.AddFilePath(seccompProfilesUploadPath+filepath.Base(profilePath), profilePath, s.ManifestFilePath); err != nil {
+				return err
+			}
+		}
+	}
+
+	s.Logger.Infoln("Reconciling seccomp profiles...")
+
+	return s.RunTaskOnAllNodes(ensureSeccompDefaultOnNode, state.RunParallel)
+}
+
+func seccompDefaultEnabled(cluster *kubeoneapi.KubeOneCluster) bool {
+	return cluster.Features.SeccompDefault != nil && cluster.Features.SeccompDefault.Enable
+}
+
+// ensureKubeletConfigMapSeccompDefault updates the kubelet configuration used
+// by kubeadm when joining new nodes
+func ensureKubeletConfigMapSeccompDefault(s *state.State) error {
+	if s.DynamicClient == nil {
+		return fail.NoKubeClient()
+	}
+
+	cm := corev1.ConfigMap{}
+	if err := s.DynamicClient.Get(s.Context, kubeletConfigMapObjectKey, &cm); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+
+		return fail.KubeClient(err, "getting %T %s", cm, kubeletConfigMapObjectKey)
+	}
+
+	kubeletConfig, err := unmarshalKubeletConfig([]byte(cm.Data[kubeletConfigMapKey]))
+	if err != nil {
+		return err
+	}
+
+	if !setKubeletSeccompDefault(kubeletConfig, seccompDefaultEnabled(s.Cluster)) {
+		return nil
+	}
+
+	buf, err := marshalKubeletConfig(kubeletConfig)
+	if err != nil {
+		return err
+	}
+	cm.Data[kubeletConfigMapKey] = string(buf)
+
+	return fail.KubeClient(s.DynamicClient.Update(s.Context, &cm), "updating %T %s", cm, kubeletConfigMapObjectKey)
+}
+
+func ensureSeccompDefaultOnNode(s *state.State, node *kubeoneapi.HostConfig, conn executor.Interface) error {
+	if err := s.Configuration.UploadTo(conn, s.WorkDir); err != nil {
+		return err
+	}
+
+	cmd, err := scripts.SaveSeccompProfiles(s.WorkDir)
+	if err != nil {
+		return err
+	}
+	if _, _, err = s.Runner.RunRaw(cmd); err != nil {
+		return fail.SSH(err, "saving seccomp profiles")
+	}
+
+	// nodes that are not provisioned yet get the kubelet configuration from
+	// the kubelet-config ConfigMap when joining the cluster
+	_, _, exitcode, err := conn.Exec(kubeletConfigExistsCMD)
+	if err != nil && exitcode <= 0 {
+		return err
+	}
+	if exitcode != 0 {
+		return nil
+	}
+
+	changed := false
+	err = updateRemoteFile(s, kubeletConfigFile, func(content []byte) ([]byte, error) {
+		kubeletConfig, uErr := unmarshalKubeletConfig(content)
+		if uErr != nil {
+			return nil, uErr
+		}
+
+		changed = setKubeletSeccompDefault(kubeletConfig, seccompDefaultEnabled(s.Cluster))
+		if !changed {
+			return content, nil
+		}
+
+		return marshalKubeletConfig(kubeletConfig)
+	})
+	if err != nil || !changed {
+		return err
+	}
+
+	logger := s.Logger.WithField("node", node.PublicAddress)
+	logger.Info("Restarting Kubelet to apply the seccompDefault setting...")
+
+	if _, _, err = s.Runner.RunRaw(scripts.RestartKubelet()); err != nil {
+		return fail.SSH(err, "restarting kubelet")
+	}
+
+	return waitForKubeletReady(conn, 2*time.Minute)
+}
+
+// setKubeletSeccompDefault sets the kubelet seccompDefault setting to the
+// desired value and returns true if it has been changed
+func setKubeletSeccompDefault(kubeletConfig *kubeletconfigv1beta1.KubeletConfiguration, desired bool) bool {
+	current := kubeletConfig.SeccompDefault != nil && *kubeletConfig.SeccompDefault
+	if current == desired {
+		return false
+	}
+
+	kubeletConfig.SeccompDefault = nil
+	if desired {
+		kubeletConfig.SeccompDefault = &desired
+	}
+
+	return true
+}
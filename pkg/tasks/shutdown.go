@@ -0,0 +1,286 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/clusterstatus/apiserverstatus"
+	"k8c.io/kubeone/pkg/clusterstatus/etcdstatus"
+	"k8c.io/kubeone/pkg/executor"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/scripts"
+	"k8c.io/kubeone/pkg/state"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	dynclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// stoppedNodeAnnotation marks the nodes cordoned by 'kubeone stop', which
+	// are uncordoned by 'kubeone start'
+	stoppedNodeAnnotation = "kubeone.io/cordoned-by-stop"
+
+	// containerStopTimeout is how many seconds each container is given to
+	// stop gracefully
+	containerStopTimeout = 30
+
+	// controlPlaneStartTimeout is how long to wait for kube-apiserver and etcd
+	// on all control plane nodes to become healthy after they're started
+	controlPlaneStartTimeout = 10 * time.Minute
+
+	// nodesReadyTimeout is how long to wait for all nodes to become ready
+	// after the cluster is started
+	nodesReadyTimeout = 5 * time.Minute
+)
+
+// cordonWorkersForStop cordons all worker nodes, including the nodes managed
+// by machine-controller, so that no workloads are scheduled on them while
+// the cluster is stopped. The nodes cordoned before are left as they are.
+func cordonWorkersForStop(s *state.State) error {
+	if s.DynamicClient == nil {
+		return fail.NoKubeClient()
+	}
+
+	selector := labels.NewSelector()
+	notControlPlane, err := labels.NewRequirement(labelControlPlaneNode, selection.DoesNotExist, nil)
+	if err != nil {
+		return fail.Runtime(err, "building node selector")
+	}
+	selector = selector.Add(*notControlPlane)
+
+	nodes := corev1.NodeList{}
+	if err = s.DynamicClient.List(s.Context, &nodes, &dynclient.ListOptions{LabelSelector: selector}); err != nil {
+		return fail.KubeClient(err, "getting %T", nodes)
+	}
+
+	for _, node := range nodes.Items {
+		if node.Spec.Unschedulable {
+			continue
+		}
+
+		s.Logger.Infof("Cordoning node %q...", node.Name)
+
+		key := dynclient.ObjectKey{Name: node.Name}
+		err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			n := corev1.Node{}
+			if err := s.DynamicClient.Get(s.Context, key, &n); err != nil {
+				return err
+			}
+
+			if n.Annotations == nil {
+				n.Annotations = map[string]string{}
+			}
+			n.Annotations[stoppedNodeAnnotation] = ""
+			n.Spec.Unschedulable = true
+
+			return s.DynamicClient.Update(s.Context, &n)
+		})
+		if err != nil {
+			return fail.KubeClient(err, "cordoning node %q", node.Name)
+		}
+	}
+
+	return nil
+}
+
+// uncordonWorkersAfterStart uncordons the nodes cordoned by
+// cordonWorkersForStop
+func uncordonWorkersAfterStart(s *state.State) error {
+	if s.DynamicClient == nil {
+		return fail.NoKubeClient()
+	}
+
+	nodes := corev1.NodeList{}
+	if err := s.DynamicClient.List(s.Context, &nodes); err != nil {
+		return fail.KubeClient(err, "getting %T", nodes)
+	}
+
+	for _, node := range nodes.Items {
+		if _, stopped := node.Annotations[stoppedNodeAnnotation]; !stopped {
+			continue
+		}
+
+		s.Logger.Infof("Uncordoning node %q...", node.Name)
+
+		key := dynclient.ObjectKey{Name: node.Name}
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			n := corev1.Node{}
+			if err := s.DynamicClient.Get(s.Context, key, &n); err != nil {
+				return err
+			}
+
+			delete(n.Annotations, stoppedNodeAnnotation)
+			n.Spec.Unschedulable = false
+
+			return s.DynamicClient.Update(s.Context, &n)
+		})
+		if err != nil {
+			return fail.KubeClient(err, "uncordoning node %q", node.Name)
+		}
+	}
+
+	return nil
+}
+
+// stopStaticWorkers stops kubelet and the workloads on all static worker
+// nodes
+func stopStaticWorkers(s *state.State) error {
+	return s.RunTaskOnStaticWorkers(stopNode, state.RunParallel)
+}
+
+// stopControlPlane stops kubelet, the control plane components and etcd on
+// the control plane nodes one at a time, the leader last
+func stopControlPlane(s *state.State) error {
+	if err := s.RunTaskOnFollowers(stopNode, state.RunSequentially); err != nil {
+		return err
+	}
+
+	return s.RunTaskOnLeader(stopNode)
+}
+
+func stopNode(s *state.State, node *kubeoneapi.HostConfig, _ executor.Interface) error {
+	s.Logger.WithField("node", node.PublicAddress).Infoln("Stopping kubelet and containers...")
+
+	cmd, err := scripts.StopNode(containerStopTimeout)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = s.Runner.RunRaw(cmd)
+
+	return fail.SSH(err, "stopping node")
+}
+
+// startControlPlane starts kubelet on all control plane nodes at once, since
+// etcd needs the quorum of its members to start
+func startControlPlane(s *state.State) error {
+	return s.RunTaskOnControlPlane(startNode, state.RunParallel)
+}
+
+// startStaticWorkers starts kubelet on all static worker nodes
+func startStaticWorkers(s *state.State) error {
+	return s.RunTaskOnStaticWorkers(startNode, state.RunParallel)
+}
+
+func startNode(s *state.State, node *kubeoneapi.HostConfig, _ executor.Interface) error {
+	s.Logger.WithField("node", node.PublicAddress).Infoln("Starting kubelet...")
+
+	_, _, err := s.Runner.RunRaw(scripts.StartNode())
+
+	return fail.SSH(err, "starting node")
+}
+
+// waitForControlPlaneStarted waits for kube-apiserver and etcd on all control
+// plane nodes to become healthy, and elects the first control plane node as
+// the leader, as the probes would for a running cluster
+func waitForControlPlaneStarted(s *state.State) error {
+	s.Logger.Infoln("Waiting for the control plane to become healthy...")
+
+	var lastErr error
+
+	err := wait.PollUntilContextTimeout(s.Context, 10*time.Second, controlPlaneStartTimeout, true, func(context.Context) (bool, error) {
+		for _, host := range s.Cluster.ControlPlane.Hosts {
+			apiserverStatus, err := apiserverstatus.Get(s, host)
+			if err != nil {
+				lastErr = err
+
+				return false, nil
+			}
+			if !apiserverStatus.Health {
+				lastErr = fmt.Errorf("kube-apiserver on %q is not healthy", host.Hostname)
+
+				return false, nil
+			}
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		if lastErr != nil {
+			err = lastErr
+		}
+
+		return fail.Runtime(err, "waiting for kube-apiserver to become healthy")
+	}
+
+	if len(s.Cluster.ControlPlane.Hosts) == 0 {
+		return fail.Runtime(errors.New("no control plane hosts"), "starting control plane")
+	}
+
+	for i := range s.Cluster.ControlPlane.Hosts {
+		s.Cluster.ControlPlane.Hosts[i].IsLeader = i == 0
+	}
+
+	etcdRing, err := etcdstatus.MemberList(s)
+	if err != nil {
+		return err
+	}
+
+	return waitForEtcdHealthy(s, etcdRing)
+}
+
+// waitForNodesReady waits for the control plane and static worker nodes to
+// become ready
+func waitForNodesReady(s *state.State) error {
+	if s.DynamicClient == nil {
+		return fail.NoKubeClient()
+	}
+
+	s.Logger.Infoln("Waiting for the nodes to become ready...")
+
+	hosts := append([]kubeoneapi.HostConfig{}, s.Cluster.ControlPlane.Hosts...)
+	hosts = append(hosts, s.Cluster.StaticWorkers.Hosts...)
+
+	var notReady string
+
+	err := wait.PollUntilContextTimeout(s.Context, 5*time.Second, nodesReadyTimeout, true, func(ctx context.Context) (bool, error) {
+		for _, host := range hosts {
+			node := corev1.Node{}
+			if err := s.DynamicClient.Get(ctx, dynclient.ObjectKey{Name: host.Hostname}, &node); err != nil {
+				notReady = host.Hostname
+
+				return false, nil
+			}
+
+			ready := false
+			for _, cond := range node.Status.Conditions {
+				if cond.Type == corev1.NodeReady {
+					ready = cond.Status == corev1.ConditionTrue
+				}
+			}
+			if !ready {
+				notReady = host.Hostname
+
+				return false, nil
+			}
+		}
+
+		return true, nil
+	})
+
+	return fail.KubeClient(err, "waiting for node %q to become ready", notReady)
+}
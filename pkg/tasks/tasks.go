@@ -17,7 +17,11 @@ limitations under the License.
 package tasks
 
 import (
+	"fmt"
+	"time"
+
 	"k8c.io/kubeone/pkg/addons"
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
 	"k8c.io/kubeone/pkg/certificate"
 	"k8c.io/kubeone/pkg/clusterstatus"
 	"k8c.io/kubeone/pkg/credentials"
@@ -25,6 +29,7 @@ import (
 	"k8c.io/kubeone/pkg/features"
 	"k8c.io/kubeone/pkg/kubeconfig"
 	"k8c.io/kubeone/pkg/localhelm"
+	"k8c.io/kubeone/pkg/progress"
 	"k8c.io/kubeone/pkg/state"
 	"k8c.io/kubeone/pkg/templates/externalccm"
 	"k8c.io/kubeone/pkg/templates/machinecontroller"
@@ -38,7 +43,10 @@ func (t Tasks) Run(s *state.State) error {
 		if step.Predicate != nil && !step.Predicate(s) {
 			continue
 		}
-		if err := step.Run(s); err != nil {
+		progress.TaskStarted(s, step.Operation)
+		err := step.Run(s)
+		progress.TaskFinished(s, step.Operation, err)
+		if err != nil {
 			return fail.Runtime(err, step.Operation)
 		}
 	}
@@ -121,7 +129,14 @@ func WithFullInstall(t Tasks) Tasks {
 			Fn:        installPrerequisites,
 			Operation: "installing prerequisites",
 		},
+		ciliumEBPFPrerequisitesTask(),
+		{
+			Fn:        writeResolvConf,
+			Operation: "generating resolv.conf for kubelet",
+			Predicate: func(s *state.State) bool { return s.Cluster.ClusterNetwork.DNS != nil },
+		},
 	}...).
+		append(WithTrustedCAs(nil)...).
 		append(kubernetesConfigFiles()...).
 		append(Tasks{
 			{
@@ -129,6 +144,11 @@ func WithFullInstall(t Tasks) Tasks {
 				Operation: "kubeadm preflight checks",
 			},
 			{Fn: prePullImages, Operation: "pre-pull images"},
+			{
+				Fn:        installExternalCAs,
+				Operation: "installing external certificate authorities",
+				Predicate: func(s *state.State) bool { return s.Cluster.CertificateAuthority != nil },
+			},
 			{
 				Fn: func(s *state.State) error {
 					s.Logger.Infoln("Configuring certs and etcd on control plane node...")
@@ -161,6 +181,11 @@ func WithFullInstall(t Tasks) Tasks {
 				},
 				Operation: "provisioning certificates on the followers",
 			},
+			{
+				Fn:        ensureKubeVIPBootstrap,
+				Operation: "deploying kube-vip on the leader",
+				Predicate: func(s *state.State) bool { return s.Cluster.KubeVIPEnabled() },
+			},
 			{Fn: initKubernetesLeader, Operation: "initializing kubernetes on leader"},
 			{Fn: kubeconfig.BuildKubernetesClientset, Operation: "building kubernetes clientset"},
 			{
@@ -169,6 +194,7 @@ func WithFullInstall(t Tasks) Tasks {
 				},
 				Operation: "approving leader's kubelet CSR",
 			},
+			addonsPhaseTask(kubeoneapi.AddonPhasePreKubeadm),
 			{Fn: repairClusterIfNeeded, Operation: "repairing cluster"},
 			{Fn: joinControlplaneNode, Operation: "joining followers control plane nodes"},
 			{Fn: restartKubeAPIServer, Operation: "restarting unhealthy kube-apiserver"},
@@ -208,9 +234,42 @@ func WithFullInstall(t Tasks) Tasks {
 		)
 }
 
+// WithTrustedCAs installs, updates or removes the additional trusted CAs
+// bundle on all control plane and static worker nodes
+func WithTrustedCAs(t Tasks) Tasks {
+	return t.append(
+		Task{Fn: ensureTrustedCAs, Operation: "reconciling additional trusted CAs"},
+	)
+}
+
 func WithResources(t Tasks) Tasks {
 	return t.append(
 		Tasks{
+			{
+				Fn:        ensureKernelConfig,
+				Operation: "configuring sysctls and kernel modules",
+			},
+			{
+				Fn:        ensureCNIEncryptionKernelSupport,
+				Operation: "verifying kernel support for the CNI encryption",
+				Predicate: func(s *state.State) bool {
+					feature, _ := cniEncryption(s.Cluster)
+
+					return feature != ""
+				},
+			},
+			ciliumEBPFPrerequisitesTask(),
+			{
+				Fn:        ensureEgressIPs,
+				Operation: "configuring egress gateway IPs",
+			},
+			{
+				Fn:        ensureNvidiaContainerToolkit,
+				Operation: "ensuring nvidia-container-toolkit",
+				Predicate: func(s *state.State) bool {
+					return s.Cluster.Features.NvidiaGPU != nil && s.Cluster.Features.NvidiaGPU.Enable
+				},
+			},
 			{
 				Fn: saveCABundle,
 				Predicate: func(s *state.State) bool {
@@ -221,6 +280,11 @@ func WithResources(t Tasks) Tasks {
 				Fn:        determinePauseImage,
 				Operation: "determining the pause image",
 			},
+			{
+				Fn:        ensureKubeVIP,
+				Operation: "deploying kube-vip",
+				Predicate: func(s *state.State) bool { return s.Cluster.KubeVIPEnabled() },
+			},
 			{
 				Fn:        patchStaticPods,
 				Operation: "patching static pods",
@@ -249,10 +313,25 @@ func WithResources(t Tasks) Tasks {
 				Fn:        features.Activate,
 				Operation: "activating features",
 			},
+			{
+				Fn:        ensureSeccompDefault,
+				Operation: "reconciling seccomp profiles",
+			},
+			{
+				Fn:        ensureClusterDNS,
+				Operation: "reconciling resolv.conf for kubelet",
+			},
 			{
 				Fn:        patchCoreDNS,
 				Operation: "patching CoreDNS",
 			},
+			{
+				Fn:        ensureKubeProxyConfig,
+				Operation: "reconfiguring kube-proxy",
+				Predicate: func(s *state.State) bool {
+					return s.Cluster.ClusterNetwork.KubeProxy == nil || !s.Cluster.ClusterNetwork.KubeProxy.SkipInstallation
+				},
+			},
 			{
 				Fn:          credentials.Ensure,
 				Operation:   "ensuring credentials secret",
@@ -264,6 +343,12 @@ func WithResources(t Tasks) Tasks {
 				Operation:   "applying addons",
 				Description: "ensure embedded addons",
 			},
+			{
+				Fn:          machinecontroller.EnsureClusterAutoscalerNodeGroups,
+				Operation:   "annotating cluster-autoscaler node groups",
+				Description: "ensure cluster-autoscaler node groups",
+				Predicate:   func(s *state.State) bool { return s.Cluster.ClusterAutoscalerEnabled() },
+			},
 			{
 				Fn:        localhelm.Deploy,
 				Operation: "releasing core helm charts",
@@ -294,6 +379,7 @@ func WithResources(t Tasks) Tasks {
 					return s.Cluster.CABundle != "" && s.Cluster.CloudProvider.Vsphere != nil && s.Cluster.CloudProvider.External && !s.Cluster.CloudProvider.DisableBundledCSIDrivers
 				},
 			},
+			addonsPhaseTask(kubeoneapi.AddonPhasePreKubeadm),
 			{
 				Fn:        joinStaticWorkerNodes,
 				Operation: "joining static worker nodes to the cluster",
@@ -302,6 +388,7 @@ func WithResources(t Tasks) Tasks {
 				Fn:        labelNodes,
 				Operation: "labeling nodes",
 			},
+			addonsPhaseTask(kubeoneapi.AddonPhasePostJoin),
 			{
 				Fn:        machinecontroller.WaitReady,
 				Operation: "waiting for machine-controller",
@@ -316,13 +403,41 @@ func WithResources(t Tasks) Tasks {
 }
 
 func WithUpgrade(t Tasks) Tasks {
-	return WithHostnameOSAndProbes(t).
+	return WithTrustedCAs(WithHostnameOSAndProbes(t)).
 		append(kubernetesConfigFiles()...). // this, in the upgrade process where config rails are handled
 		append(Tasks{
 			{Fn: kubeconfig.BuildKubernetesClientset, Operation: "building kubernetes clientset"},
 			{Fn: runPreflightChecks, Operation: "checking preflight safetynet", Retries: 1},
+			{
+				Fn:          checkRemovedAPIs,
+				Operation:   "checking removed APIs",
+				Description: "scan the cluster and the addons for the APIs removed in the new Kubernetes version",
+			},
+			addonsPhaseTask(kubeoneapi.AddonPhasePreKubeadm),
+			{
+				Fn:          backupControlPlaneForRollback,
+				Operation:   "backing up control plane",
+				Description: "back up etcd and the control plane manifests for 'kubeone rollback'",
+			},
+			{
+				Fn:          pauseMachineControllers,
+				Operation:   "pausing machine-controller",
+				Description: "pause machine-controller and operating-system-manager until the control plane is upgraded",
+				Predicate:   func(s *state.State) bool { return len(pausedControllers(s)) > 0 },
+			},
 			{Fn: upgradeLeader, Operation: "upgrading leader control plane"},
+			{
+				Fn:          verifyCanaryUpgrade,
+				Operation:   "verifying canary control plane upgrade",
+				Description: "verify the upgraded leader control plane before upgrading the remaining nodes",
+				Predicate:   func(s *state.State) bool { return s.Cluster.CanaryUpgradeEnabled() },
+			},
 			{Fn: upgradeFollower, Operation: "upgrading follower control plane"},
+			{
+				Fn:        resumeMachineControllers,
+				Operation: "resuming machine-controller",
+				Predicate: func(s *state.State) bool { return len(pausedControllers(s)) > 0 },
+			},
 			{
 				Fn: func(s *state.State) error {
 					s.Logger.Info("Downloading PKI...")
@@ -342,17 +457,114 @@ func WithUpgrade(t Tasks) Tasks {
 				Description: "upgrade MachineDeployments",
 				Predicate:   func(s *state.State) bool { return s.UpgradeMachineDeployments },
 			},
+			addonsPhaseTask(kubeoneapi.AddonPhasePostUpgrade),
+			Task{
+				Fn:          defragmentEtcd,
+				Operation:   "defragmenting etcd",
+				Description: "defragment the etcd members one at a time",
+				Predicate:   func(s *state.State) bool { return s.Cluster.EtcdDefragmentation().AfterUpgrade },
+			},
 		)
 }
 
+// WithContainerdUpgrade upgrades containerd to the pinned version on the
+// nodes running an older version
+func WithContainerdUpgrade(t Tasks) Tasks {
+	return t.append(Tasks{
+		{
+			Fn:        kubeconfig.BuildKubernetesClientset,
+			Operation: "building kubernetes clientset",
+			Predicate: containerdUpgradeNeeded,
+		},
+		{
+			Fn:        upgradeContainerd,
+			Operation: "upgrading containerd",
+			Predicate: containerdUpgradeNeeded,
+		},
+	}...)
+}
+
+// WithOperatingSystemUpgrade upgrades the operating system release of the
+// control plane and static worker nodes, and re-provisions the machines of
+// the MachineDeployments with the images of the new release
+func WithOperatingSystemUpgrade(t Tasks) Tasks {
+	return t.append(Tasks{
+		{
+			Fn:        kubeconfig.BuildKubernetesClientset,
+			Operation: "building kubernetes clientset",
+		},
+		{
+			Fn:        upgradeOperatingSystem,
+			Operation: "upgrading operating system",
+			Predicate: operatingSystemUpgradeNeeded,
+		},
+		{
+			Fn:        upgradeMachineDeploymentsImage,
+			Operation: "re-provisioning MachineDeployments",
+			Predicate: machineDeploymentsImageUpgradeNeeded,
+		},
+	}...)
+}
+
 func WithReset(t Tasks) Tasks {
 	return t.append(Tasks{
+		{
+			Fn:        applyPreResetAddons,
+			Operation: "applying PreReset addons",
+			Predicate: func(s *state.State) bool { return s.Cluster.Addons.PhaseEnabled(kubeoneapi.AddonPhasePreReset) },
+		},
 		{Fn: destroyWorkers, Operation: "destroying workers"},
 		{Fn: resetAllNodes, Operation: "resetting all nodes"},
 		{Fn: removeBinariesAllNodes, Operation: "removing kubernetes binaries from nodes"},
+		{
+			Fn:        reprovisionHosts,
+			Operation: "re-provisioning hosts",
+			Predicate: func(s *state.State) bool { return s.ReprovisionHosts },
+		},
 	}...)
 }
 
+// addonsPhaseTask applies the user addons of the given lifecycle phase
+func addonsPhaseTask(phase kubeoneapi.AddonPhase) Task {
+	return Task{
+		Fn: func(s *state.State) error {
+			// the addons are rendered with the certificates signed by the
+			// cluster CA, which is not downloaded yet before kubeadm runs
+			if err := s.RunTaskOnLeader(certificate.DownloadKubePKI); err != nil {
+				return err
+			}
+
+			return addons.EnsureAddonsPhase(s, phase)
+		},
+		Operation:   fmt.Sprintf("applying %s addons", phase),
+		Description: fmt.Sprintf("ensure %s addons", phase),
+		Predicate:   func(s *state.State) bool { return s.Cluster.Addons.PhaseEnabled(phase) },
+	}
+}
+
+// WithUpgradeRollback rolls the control plane back to the backup taken
+// before the last upgrade
+func WithUpgradeRollback(t Tasks) Tasks {
+	return WithHostnameOS(t).
+		append(Tasks{
+			{Fn: readUpgradeBackupVersion, Operation: "reading control plane backup"},
+			{Fn: checkStaticWorkersForRollback, Operation: "checking static worker nodes"},
+			{Fn: stopControlPlaneForEtcdRestore, Operation: "stopping etcd and kube-apiserver"},
+			{Fn: rollbackControlPlane, Operation: "restoring etcd and control plane manifests"},
+			{Fn: downgradeControlPlaneBinaries, Operation: "installing Kubernetes binaries"},
+		}...)
+}
+
+func WithEtcdRestore(t Tasks) Tasks {
+	return WithHostnameOS(t).
+		append(Tasks{
+			{Fn: resolveEtcdSnapshot, Operation: "resolving etcd snapshot"},
+			{Fn: stopControlPlaneForEtcdRestore, Operation: "stopping etcd and kube-apiserver"},
+			{Fn: restoreEtcdSnapshot, Operation: "restoring etcd snapshot"},
+			{Fn: startControlPlaneAfterEtcdRestore, Operation: "starting etcd and kube-apiserver"},
+		}...)
+}
+
 func WithContainerDMigration(t Tasks) Tasks {
 	return WithHostnameOS(t).
 		append(Tasks{
@@ -394,6 +606,19 @@ func WithClusterStatus(t Tasks) Tasks {
 		}...)
 }
 
+// WithConfigurationDrift reports the files managed by KubeOne that were
+// changed out of band since the last apply
+func WithConfigurationDrift(t Tasks) Tasks {
+	return WithHostnameOS(t).
+		append(Task{Fn: printConfigurationDrift, Operation: "detecting configuration drift"})
+}
+
+// WithManagedFilesRecord records the hashes of the files managed by KubeOne
+// after they're written, for the configuration drift detection
+func WithManagedFilesRecord(t Tasks) Tasks {
+	return t.append(Task{Fn: recordManagedFilesHashes, Operation: "recording hashes of managed files"})
+}
+
 func kubernetesConfigFiles() Tasks {
 	return Tasks{
 		{Fn: generateKubeadm, Operation: "generating kubeadm config files"},
@@ -571,3 +796,181 @@ func WithCCMCSIMigration(t Tasks) Tasks {
 			},
 		)
 }
+
+// WithCNIMigration migrates the cluster from the deployed CNI plugin to the
+// configured CNI plugin, one node at a time
+func WithCNIMigration(t Tasks) Tasks {
+	return t.append(Tasks{
+		{Fn: cniMigrationValidateConfig, Operation: "validating config", Retries: 1},
+		{Fn: cniMigrationMarkNodes, Operation: "marking nodes for CNI migration"},
+		{Fn: cniMigrationReplaceAddon, Operation: "replacing CNI addon"},
+		{Fn: cniMigrationMigrateNodes, Operation: "migrating nodes to the new CNI plugin", Retries: 1},
+		{
+			Fn:        cniMigrationRolloutMachineDeployments,
+			Operation: "rolling out MachineDeployments",
+			Predicate: func(s *state.State) bool { return s.Cluster.MachineController.Deploy },
+		},
+		{Fn: cniMigrationVerify, Operation: "verifying pod network", Retries: 1},
+	}...)
+}
+
+// WithCNIKeyRotation rotates the keys used by the CNI plugin to encrypt the
+// pod traffic between the nodes
+func WithCNIKeyRotation(t Tasks) Tasks {
+	return t.append(Tasks{
+		{Fn: cniKeyRotationValidateConfig, Operation: "validating config", Retries: 1},
+		{
+			Fn:          rotateCiliumIPsecKey,
+			Operation:   "rotating Cilium IPsec key",
+			Description: "rotate the Cilium IPsec key and wait for all nodes to switch to it",
+			Retries:     1,
+		},
+	}...)
+}
+
+// WithEtcdDefragmentation defragments the etcd members one at a time
+func WithEtcdDefragmentation(t Tasks) Tasks {
+	return t.append(Task{
+		Fn:          defragmentEtcd,
+		Operation:   "defragmenting etcd",
+		Description: "defragment the etcd members one at a time",
+	})
+}
+
+// WithClusterStop stops the cluster in order: the worker nodes are
+// cordoned, the workloads and kubelet are stopped on the static worker
+// nodes, and the control plane nodes are stopped one at a time
+func WithClusterStop(t Tasks) Tasks {
+	return t.append(Tasks{
+		{Fn: cordonWorkersForStop, Operation: "cordoning worker nodes"},
+		{Fn: stopStaticWorkers, Operation: "stopping static worker nodes"},
+		{Fn: stopControlPlane, Operation: "stopping control plane nodes"},
+	}...)
+}
+
+// WithClusterStart starts the cluster stopped by WithClusterStop in order:
+// the control plane nodes first, then the static worker nodes once the
+// control plane is healthy, and finally uncordons the worker nodes
+func WithClusterStart(t Tasks) Tasks {
+	return WithHostnameOS(t).
+		append(Tasks{
+			{Fn: startControlPlane, Operation: "starting control plane nodes"},
+			{Fn: waitForControlPlaneStarted, Operation: "waiting for control plane"},
+			{Fn: kubeconfig.BuildKubernetesClientset, Operation: "building kubernetes clientset"},
+			{Fn: startStaticWorkers, Operation: "starting static worker nodes"},
+			{Fn: waitForNodesReady, Operation: "waiting for nodes"},
+			{Fn: uncordonWorkersAfterStart, Operation: "uncordoning worker nodes"},
+		}...)
+}
+
+// WithHibernate scales the MachineDeployments to zero, stops the cluster and
+// stops the instances of the control plane and static worker nodes through
+// the API of the cloud provider
+func WithHibernate(t Tasks) Tasks {
+	return t.append(Task{
+		Fn:          scaleDownMachineDeployments,
+		Operation:   "scaling down MachineDeployments",
+		Description: "scale all MachineDeployments to zero replicas",
+		Retries:     1,
+	}).
+		append(WithClusterStop(nil)...).
+		append(Task{
+			Fn:          stopInstances,
+			Operation:   "stopping instances",
+			Description: "stop the instances of the control plane and static worker nodes",
+			Retries:     3,
+		})
+}
+
+// WithResume starts the instances stopped by WithHibernate, starts the
+// cluster and restores the replicas of the MachineDeployments
+func WithResume(t Tasks) Tasks {
+	return t.append(Tasks{
+		{Fn: startInstances, Operation: "starting instances", Retries: 3},
+		{Fn: waitForSSH, Operation: "waiting for SSH"},
+	}...).
+		append(WithClusterStart(nil)...).
+		append(Task{Fn: scaleUpMachineDeployments, Operation: "scaling up MachineDeployments"})
+}
+
+// WithControlPlaneNodeReplacement removes the etcd member and the Node
+// object of the replaced control plane node, waits for the replacement host
+// to become reachable, and joins it to the cluster
+func WithControlPlaneNodeReplacement(t Tasks, nodeName string, waitTimeout time.Duration) Tasks {
+	return t.append(Tasks{
+		{Fn: electLeaderForReplacement, Operation: "electing leader"},
+		{Fn: kubeconfig.BuildKubernetesClientset, Operation: "building kubernetes clientset"},
+		{
+			Fn:        func(s *state.State) error { return verifyControlPlaneNodeName(s, nodeName) },
+			Operation: "verifying replaced node",
+			Retries:   1,
+		},
+		{
+			Fn:        func(s *state.State) error { return removeEtcdMember(s, nodeName) },
+			Operation: "removing etcd member",
+			Retries:   3,
+		},
+		{
+			Fn:        func(s *state.State) error { return deleteControlPlaneNode(s, nodeName) },
+			Operation: "deleting Node",
+		},
+		{
+			Fn:        func(s *state.State) error { return waitForControlPlaneHosts(s, waitTimeout) },
+			Operation: "waiting for replacement host",
+			Retries:   1,
+		},
+	}...).
+		append(WithFullInstall(nil)...)
+}
+
+// WithCARotation rotates the cluster CA, and renews the certificates signed
+// by it on all nodes, while the cluster stays available. Both CAs are trusted
+// while the certificates are renewed, and the old CA is removed last.
+func WithCARotation(t Tasks) Tasks {
+	return t.append(Tasks{
+		{Fn: prepareCARotation, Operation: "preparing CA rotation"},
+		{
+			Fn:        func(s *state.State) error { return applyCARotationPhase(s, caRotationTrust) },
+			Operation: "trusting new CA",
+		},
+		{Fn: restartKubeSystemWorkloads, Operation: "restarting kube-system workloads"},
+		{
+			Fn:        func(s *state.State) error { return rolloutMachineDeploymentsForCARotation(s, caRotationTrust) },
+			Operation: "rolling out MachineDeployments",
+			Predicate: func(s *state.State) bool { return s.Cluster.MachineController.Deploy },
+			Retries:   1,
+		},
+		{
+			Fn:        func(s *state.State) error { return applyCARotationPhase(s, caRotationSign) },
+			Operation: "renewing certificates with new CA",
+		},
+		{Fn: rebootstrapKubelets, Operation: "bootstrapping kubelet certificates", Retries: 1},
+		{
+			Fn:        func(s *state.State) error { return rolloutMachineDeploymentsForCARotation(s, caRotationSign) },
+			Operation: "rolling out MachineDeployments",
+			Predicate: func(s *state.State) bool { return s.Cluster.MachineController.Deploy },
+			Retries:   1,
+		},
+		{
+			Fn:        func(s *state.State) error { return applyCARotationPhase(s, caRotationFinalize) },
+			Operation: "removing old CA",
+		},
+		{Fn: cleanupCARotation, Operation: "cleaning up CA rotation"},
+	}...)
+}
+
+// WithAddonsDiff prints the changes applying the addons would make to the
+// cluster, without changing anything
+func WithAddonsDiff(t Tasks) Tasks {
+	return t.append(Tasks{
+		{
+			Fn: func(s *state.State) error {
+				s.Logger.Info("Downloading PKI...")
+
+				return s.RunTaskOnLeader(certificate.DownloadKubePKI)
+			},
+			Operation: "downloading Kubernetes PKI from the leader",
+		},
+		{Fn: addons.Diff, Operation: "diffing addons"},
+	}...)
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"k8c.io/kubeone/pkg/clusterstatus/apiserverstatus"
+	"k8c.io/kubeone/pkg/clusterstatus/etcdstatus"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/state"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	dynclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// canaryHealthTimeout is how long to wait for the API server and etcd to
+	// become healthy after the canary node is upgraded
+	canaryHealthTimeout = 5 * time.Minute
+	// canarySchedulingTimeout is how long to wait for the test pod to run on
+	// the canary node
+	canarySchedulingTimeout = 3 * time.Minute
+)
+
+// verifyCanaryUpgrade verifies the health of the cluster after the leader
+// control plane node is upgraded, and waits for the soak time or the
+// confirmation before the remaining nodes are upgraded
+func verifyCanaryUpgrade(s *state.State) error {
+	if err := verifyCanaryNode(s); err != nil {
+		return err
+	}
+
+	canary := s.Cluster.Upgrades.Canary
+	if canary.SoakTime != nil {
+		s.Logger.Infof("Canary control plane upgrade verified, waiting %s before upgrading the remaining nodes...", canary.SoakTime.Duration)
+
+		select {
+		case <-s.Context.Done():
+			return fail.Runtime(s.Context.Err(), "waiting for the canary upgrade soak time")
+		case <-time.After(canary.SoakTime.Duration):
+		}
+
+		// the cluster must stay healthy for the whole soak time
+		return verifyCanaryNode(s)
+	}
+
+	if s.Confirm == nil {
+		return nil
+	}
+
+	s.Logger.Infoln("Canary control plane upgrade verified, continue upgrading the remaining nodes?")
+	confirm, err := s.Confirm()
+	if err != nil {
+		return err
+	}
+	if !confirm {
+		return fail.Runtime(errors.New("canceled by the user"), "upgrading the remaining nodes after the canary upgrade")
+	}
+
+	return nil
+}
+
+// verifyCanaryNode verifies that the API server and etcd are healthy on all
+// control plane nodes, and that the pods can be scheduled on the upgraded
+// leader
+func verifyCanaryNode(s *state.State) error {
+	leader, err := s.Cluster.Leader()
+	if err != nil {
+		return err
+	}
+
+	s.Logger.Infoln("Verifying API server and etcd health...")
+	var unhealthy error
+	err = wait.PollUntilContextTimeout(s.Context, 5*time.Second, canaryHealthTimeout, true, func(context.Context) (bool, error) {
+		unhealthy = controlPlaneHealth(s)
+
+		return unhealthy == nil, nil
+	})
+	if err != nil {
+		if unhealthy != nil {
+			err = unhealthy
+		}
+
+		return fail.Runtime(err, "verifying canary control plane %q", leader.Hostname)
+	}
+
+	s.Logger.Infoln("Verifying pods can be scheduled on the canary control plane...")
+
+	return verifyCanaryScheduling(s, leader.Hostname)
+}
+
+func controlPlaneHealth(s *state.State) error {
+	etcdRing, err := etcdstatus.MemberList(s)
+	if err != nil {
+		return err
+	}
+
+	for _, host := range s.Cluster.ControlPlane.Hosts {
+		apiserverStatus, err := apiserverstatus.Get(s, host)
+		if err != nil {
+			return err
+		}
+		if !apiserverStatus.Health {
+			return fmt.Errorf("API server on %q is not healthy", host.Hostname)
+		}
+
+		etcdStatus, err := etcdstatus.Get(s, host, etcdRing)
+		if err != nil {
+			return err
+		}
+		if !etcdStatus.Health || !etcdStatus.Member {
+			return fmt.Errorf("etcd on %q is not healthy", host.Hostname)
+		}
+	}
+
+	return nil
+}
+
+// verifyCanaryScheduling runs a pause pod on the node and removes it
+func verifyCanaryScheduling(s *state.State, nodeName string) error {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "kubeone-canary-",
+			Namespace:    metav1.NamespaceSystem,
+		},
+		Spec: corev1.PodSpec{
+			Affinity: &corev1.Affinity{
+				NodeAffinity: &corev1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+						NodeSelectorTerms: []corev1.NodeSelectorTerm{
+							{
+								MatchFields: []corev1.NodeSelectorRequirement{
+									{
+										Key:      metav1.ObjectNameField,
+										Operator: corev1.NodeSelectorOpIn,
+										Values:   []string{nodeName},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Tolerations: []corev1.Toleration{
+				{Operator: corev1.TolerationOpExists},
+			},
+			Containers: []corev1.Container{
+				{
+					Name:  "pause",
+					Image: s.PauseImage,
+				},
+			},
+			TerminationGracePeriodSeconds: new(int64),
+		},
+	}
+
+	if err := s.DynamicClient.Create(s.Context, pod); err != nil {
+		return fail.KubeClient(err, "creating canary test pod")
+	}
+	defer func() {
+		if err := s.DynamicClient.Delete(context.Background(), pod); dynclient.IgnoreNotFound(err) != nil {
+			s.Logger.Warnf("failed to delete canary test pod %s/%s: %v", pod.Namespace, pod.Name, err)
+		}
+	}()
+
+	key := dynclient.ObjectKeyFromObject(pod)
+	err := wait.PollUntilContextTimeout(s.Context, 5*time.Second, canarySchedulingTimeout, true, func(ctx context.Context) (bool, error) {
+		if err := s.DynamicClient.Get(ctx, key, pod); err != nil {
+			return false, nil
+		}
+
+		return pod.Status.Phase == corev1.PodRunning, nil
+	})
+
+	return fail.KubeClient(err, "waiting for canary test pod %s to run on %q", key, nodeName)
+}
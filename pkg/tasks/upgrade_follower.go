@@ -37,7 +37,7 @@ func upgradeFollowerExecutor(s *state.State, node *kubeoneapi.HostConfig, conn e
 		return err
 	}
 
-	drainer := nodeutils.NewDrainer(s.RESTConfig, logger)
+	drainer := nodeutils.NewDrainer(s.RESTConfig, logger, s.Cluster.DrainConfig())
 
 	logger.Infoln("Cordon the follower control plane node...")
 	if err := drainer.Cordon(s.Context, node.Hostname, true); err != nil {
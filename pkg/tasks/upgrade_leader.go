@@ -37,7 +37,7 @@ func upgradeLeaderExecutor(s *state.State, node *kubeoneapi.HostConfig, conn exe
 		return err
 	}
 
-	drainer := nodeutils.NewDrainer(s.RESTConfig, logger)
+	drainer := nodeutils.NewDrainer(s.RESTConfig, logger, s.Cluster.DrainConfig())
 
 	logger.Infoln("Cordoning leader control plane...")
 	if err := drainer.Cordon(s.Context, node.Hostname, true); err != nil {
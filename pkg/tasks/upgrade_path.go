@@ -0,0 +1,117 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/state"
+)
+
+// stableReleaseURLFmt points to the latest patch release of a minor version
+const stableReleaseURLFmt = "https://dl.k8s.io/release/stable-%d.%d.txt"
+
+// UpgradeSteps returns the Kubernetes versions the cluster is upgraded to,
+// one minor version at a time, ending with the target version. The versions
+// of the intermediate minor versions are taken from the intermediate
+// versions, falling back to the latest patch release.
+func UpgradeSteps(s *state.State) ([]string, error) {
+	target, err := semver.NewVersion(s.Cluster.Versions.Kubernetes)
+	if err != nil {
+		return nil, fail.ConfigValidation(err)
+	}
+
+	current := oldestControlPlaneVersion(s)
+	if current == nil || current.Major() != target.Major() || target.Minor() <= current.Minor()+1 {
+		return []string{s.Cluster.Versions.Kubernetes}, nil
+	}
+
+	intermediate := map[uint64]string{}
+	if s.Cluster.Upgrades != nil {
+		for _, version := range s.Cluster.Upgrades.IntermediateVersions {
+			v, verErr := semver.NewVersion(version)
+			if verErr != nil {
+				return nil, fail.ConfigValidation(verErr)
+			}
+			intermediate[v.Minor()] = version
+		}
+	}
+
+	steps := []string{}
+	for minor := current.Minor() + 1; minor < target.Minor(); minor++ {
+		version, ok := intermediate[minor]
+		if !ok {
+			if version, err = latestPatchRelease(s.Context, target.Major(), minor); err != nil {
+				return nil, err
+			}
+		}
+		steps = append(steps, version)
+	}
+
+	return append(steps, s.Cluster.Versions.Kubernetes), nil
+}
+
+// oldestControlPlaneVersion returns the lowest kubelet version of the control
+// plane nodes, or nil if it's unknown
+func oldestControlPlaneVersion(s *state.State) *semver.Version {
+	var oldest *semver.Version
+	for _, host := range s.LiveCluster.ControlPlane {
+		if host.Kubelet.Version != nil && (oldest == nil || host.Kubelet.Version.LessThan(oldest)) {
+			oldest = host.Kubelet.Version
+		}
+	}
+
+	return oldest
+}
+
+func latestPatchRelease(ctx context.Context, major, minor uint64) (string, error) {
+	url := fmt.Sprintf(stableReleaseURLFmt, major, minor)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", fail.Runtime(err, "creating request for %q", url)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fail.Connection(err, url)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fail.Runtime(fmt.Errorf("unexpected status %s", resp.Status), "getting latest Kubernetes %d.%d release, set it in upgrades.intermediateVersions", major, minor)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fail.Runtime(err, "reading %q", url)
+	}
+
+	version, err := semver.NewVersion(strings.TrimSpace(string(body)))
+	if err != nil {
+		return "", fail.Runtime(err, "parsing latest Kubernetes %d.%d release", major, minor)
+	}
+
+	return version.String(), nil
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/state"
+)
+
+func TestUpgradeSteps(t *testing.T) {
+	tests := []struct {
+		name                 string
+		current              []string
+		target               string
+		intermediateVersions []string
+		want                 []string
+	}{
+		{
+			name:    "patch upgrade",
+			current: []string{"1.27.3", "1.27.3"},
+			target:  "1.27.8",
+			want:    []string{"1.27.8"},
+		},
+		{
+			name:    "minor upgrade",
+			current: []string{"1.27.8"},
+			target:  "1.28.4",
+			want:    []string{"1.28.4"},
+		},
+		{
+			name:                 "multi-minor upgrade",
+			current:              []string{"1.25.16"},
+			target:               "1.28.4",
+			intermediateVersions: []string{"1.26.11", "1.27.8"},
+			want:                 []string{"1.26.11", "1.27.8", "1.28.4"},
+		},
+		{
+			name:                 "multi-minor upgrade from the oldest control plane node",
+			current:              []string{"1.27.8", "1.26.11"},
+			target:               "1.28.4",
+			intermediateVersions: []string{"1.26.11", "1.27.8"},
+			want:                 []string{"1.27.8", "1.28.4"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			s := &state.State{
+				Context: context.Background(),
+				Cluster: &kubeoneapi.KubeOneCluster{
+					Versions: kubeoneapi.VersionConfig{Kubernetes: tt.target},
+					Upgrades: &kubeoneapi.UpgradesConfig{IntermediateVersions: tt.intermediateVersions},
+				},
+				LiveCluster: &state.Cluster{},
+			}
+			for _, version := range tt.current {
+				s.LiveCluster.ControlPlane = append(s.LiveCluster.ControlPlane, state.Host{
+					Kubelet: state.ComponentStatus{Version: semver.MustParse(version)},
+				})
+			}
+
+			got, err := UpgradeSteps(s)
+			if err != nil {
+				t.Fatalf("UpgradeSteps() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UpgradeSteps() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/executor"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/scripts"
+	"k8c.io/kubeone/pkg/state"
+	"k8c.io/kubeone/pkg/templates/images"
+)
+
+// backupControlPlaneForRollback takes the etcd snapshot on the leader, copies
+// it to the other control plane nodes, and archives the static pod manifests,
+// the kubelet configuration and the kubeadm configuration next to it on each
+// control plane node. The backup replaces the backup of the previous upgrade
+// only once it's complete, and it's used by `kubeone rollback`.
+func backupControlPlaneForRollback(s *state.State) error {
+	s.Logger.Infoln("Backing up the control plane for the rollback...")
+
+	params := scripts.UpgradeBackupParams{
+		EtcdImage: s.Images.Get(images.EtcdBackupsEtcdctl),
+	}
+
+	snapshot, err := os.CreateTemp("", "kubeone-etcd-snapshot-*.db")
+	if err != nil {
+		return fail.Runtime(err, "creating temporary etcd snapshot file")
+	}
+	defer os.Remove(snapshot.Name())
+	defer snapshot.Close()
+
+	err = s.RunTaskOnLeader(func(s *state.State, _ *kubeoneapi.HostConfig, conn executor.Interface) error {
+		cmd, err := scripts.UpgradeBackupEtcdSnapshot(params)
+		if err != nil {
+			return err
+		}
+
+		if _, _, err = s.Runner.RunRaw(cmd); err != nil {
+			return fail.SSH(err, "taking etcd snapshot")
+		}
+
+		var stderr strings.Builder
+		if _, err = conn.POpen("sudo cat "+scripts.UpgradeBackupSnapshot, nil, snapshot, &stderr); err != nil {
+			return fail.SSH(fmt.Errorf("%w: %s", err, stderr.String()), "downloading etcd snapshot")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = s.RunTaskOnFollowers(func(s *state.State, _ *kubeoneapi.HostConfig, conn executor.Interface) error {
+		// the snapshot is uploaded to one follower at a time, from the start
+		if _, err := snapshot.Seek(0, io.SeekStart); err != nil {
+			return fail.Runtime(err, "reading etcd snapshot")
+		}
+
+		cmd := fmt.Sprintf("sudo rm -rf %[1]s.new && sudo mkdir -p %[1]s.new && sudo tee %[2]s >/dev/null", scripts.UpgradeBackupDir, scripts.UpgradeBackupSnapshot)
+
+		var stderr strings.Builder
+		if _, err := conn.POpen(cmd, snapshot, io.Discard, &stderr); err != nil {
+			return fail.SSH(fmt.Errorf("%w: %s", err, stderr.String()), "uploading etcd snapshot")
+		}
+
+		return nil
+	}, state.RunSequentially)
+	if err != nil {
+		return err
+	}
+
+	return s.RunTaskOnControlPlane(func(s *state.State, _ *kubeoneapi.HostConfig, conn executor.Interface) error {
+		// the version is read from the node, as it changes between the
+		// upgrades through the intermediate versions
+		kubelet, err := systemdUnitInfo("kubelet", conn, withComponentVersion(kubeletVersionCmdGenerator))
+		if err != nil {
+			return fail.SSH(err, "getting kubelet version")
+		}
+		if kubelet.Version == nil {
+			return fail.RuntimeError{
+				Op:  "backing up control plane",
+				Err: fmt.Errorf("kubelet is not installed"),
+			}
+		}
+
+		nodeParams := params
+		nodeParams.KubernetesVersion = kubelet.Version.String()
+
+		cmd, err := scripts.UpgradeBackup(nodeParams)
+		if err != nil {
+			return err
+		}
+
+		_, _, err = s.Runner.RunRaw(cmd)
+
+		return fail.SSH(err, "backing up control plane")
+	}, state.RunParallel)
+}
+
+// readUpgradeBackupVersion sets the Kubernetes version to the version of the
+// backup on the leader, so that the binaries of that version are installed
+// by the rollback
+func readUpgradeBackupVersion(s *state.State) error {
+	return s.RunTaskOnLeaderWithMutator(func(s *state.State, _ *kubeoneapi.HostConfig, _ executor.Interface) error {
+		stdout, _, err := s.Runner.RunRaw(scripts.UpgradeBackupVersion())
+		if err != nil {
+			return fail.RuntimeError{
+				Op:  "reading control plane backup",
+				Err: fmt.Errorf("no backup taken before the upgrade found in %s: %w", scripts.UpgradeBackupDir, err),
+			}
+		}
+
+		version, err := semver.NewVersion(strings.TrimSpace(stdout))
+		if err != nil {
+			return fail.Runtime(err, "parsing Kubernetes version of the control plane backup")
+		}
+
+		s.Logger.Infof("Rolling back the control plane to Kubernetes %s...", version)
+		s.Cluster.Versions.Kubernetes = version.String()
+
+		return nil
+	}, func(original *state.State, tmp *state.State) {
+		original.Cluster.Versions.Kubernetes = tmp.Cluster.Versions.Kubernetes
+	})
+}
+
+// rollbackControlPlane restores the etcd snapshot and the static pod
+// manifests from the backup on all control plane nodes. All etcd members
+// are restored from the same snapshot taken on the leader.
+func rollbackControlPlane(s *state.State) error {
+	initialCluster := []string{}
+	for _, host := range s.Cluster.ControlPlane.Hosts {
+		initialCluster = append(initialCluster, fmt.Sprintf("%s=%s", host.Hostname, etcdPeerURL(s.Cluster, host)))
+	}
+
+	return s.RunTaskOnControlPlane(func(s *state.State, node *kubeoneapi.HostConfig, _ executor.Interface) error {
+		cmd, err := scripts.UpgradeRollback(scripts.UpgradeBackupParams{
+			Name:           node.Hostname,
+			PeerURL:        etcdPeerURL(s.Cluster, *node),
+			InitialCluster: strings.Join(initialCluster, ","),
+			EtcdImage:      s.Images.Get(images.EtcdBackupsEtcdctl),
+		})
+		if err != nil {
+			return err
+		}
+
+		_, _, err = s.Runner.RunRaw(cmd)
+
+		return fail.SSH(err, "rolling back control plane")
+	}, state.RunParallel)
+}
+
+// downgradeControlPlaneBinaries installs the kubeadm, kubelet and kubectl
+// binaries of the backup version, allowing the package downgrades
+func downgradeControlPlaneBinaries(s *state.State) error {
+	s.ForceInstall = true
+
+	return s.RunTaskOnControlPlane(func(s *state.State, node *kubeoneapi.HostConfig, _ executor.Interface) error {
+		s.Logger.WithField("node", node.PublicAddress).Infof("Installing Kubernetes %s binaries...", s.Cluster.Versions.Kubernetes)
+
+		return installKubeadm(s, *node)
+	}, state.RunSequentially)
+}
+
+// checkStaticWorkersForRollback fails the rollback if the static worker
+// nodes are already upgraded, as their kubelet would be newer than the
+// rolled back control plane
+func checkStaticWorkersForRollback(s *state.State) error {
+	target, err := semver.NewVersion(s.Cluster.Versions.Kubernetes)
+	if err != nil {
+		return fail.ConfigValidation(err)
+	}
+
+	return s.RunTaskOnStaticWorkers(func(s *state.State, node *kubeoneapi.HostConfig, conn executor.Interface) error {
+		kubelet, err := systemdUnitInfo("kubelet", conn, withComponentVersion(kubeletVersionCmdGenerator))
+		if err != nil {
+			return fail.SSH(err, "getting kubelet version")
+		}
+
+		if version := kubelet.Version; version != nil && version.GreaterThan(target) {
+			return fail.RuntimeError{
+				Op:  "checking static worker nodes",
+				Err: fmt.Errorf("static worker node %q is already upgraded to %s, the control plane can't be rolled back to %s", node.Hostname, version, target),
+			}
+		}
+
+		return nil
+	}, state.RunParallel)
+}
@@ -26,8 +26,29 @@ import (
 )
 
 func upgradeStaticWorkers(s *state.State) error {
-	// we upgrade seqentially to minimize cluster disruption
-	return s.RunTaskOnStaticWorkers(upgradeStaticWorkersExecutor, state.RunSequentially)
+	hosts := s.Cluster.StaticWorkers.Hosts
+
+	batchSize := s.Cluster.StaticWorkersUpgradeBatchSize(len(hosts))
+	if batchSize == 1 {
+		// we upgrade seqentially to minimize cluster disruption
+		return s.RunTaskOnStaticWorkers(upgradeStaticWorkersExecutor, state.RunSequentially)
+	}
+
+	// the next batch is started only when the whole batch is upgraded, so
+	// that no more than batchSize nodes are unavailable at the same time
+	for start := 0; start < len(hosts); start += batchSize {
+		end := start + batchSize
+		if end > len(hosts) {
+			end = len(hosts)
+		}
+
+		s.Logger.Infof("Upgrading static worker nodes %d-%d of %d...", start+1, end, len(hosts))
+		if err := s.RunTaskOnNodes(hosts[start:end], upgradeStaticWorkersExecutor, state.RunParallel, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func upgradeStaticWorkersExecutor(s *state.State, node *kubeoneapi.HostConfig, conn executor.Interface) error {
@@ -39,7 +60,7 @@ func upgradeStaticWorkersExecutor(s *state.State, node *kubeoneapi.HostConfig, c
 		return err
 	}
 
-	drainer := nodeutils.NewDrainer(s.RESTConfig, logger)
+	drainer := nodeutils.NewDrainer(s.RESTConfig, logger, s.Cluster.DrainConfig())
 
 	logger.Infoln("Cordoning static worker node...")
 	if err := drainer.Cordon(s.Context, node.Hostname, true); err != nil {
@@ -101,6 +101,17 @@ func determineOS(s *state.State) error {
 	}, state.RunParallel)
 }
 
+// operatingSystemRelease reads the release version of the operating system
+// from /etc/os-release
+func operatingSystemRelease(conn executor.Interface) (string, error) {
+	buf, err := fs.ReadFile(executorfs.New(conn), "/etc/os-release")
+	if err != nil {
+		return "", err
+	}
+
+	return osrelease.Parse(string(buf)).VersionID, nil
+}
+
 func labelNode(client dynclient.Client, host *kubeoneapi.HostConfig) error {
 	retErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		node := corev1.Node{
@@ -0,0 +1,140 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package calico
+
+import (
+	"time"
+
+	"k8c.io/kubeone/pkg/clientutil"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/state"
+	"k8c.io/kubeone/pkg/templates/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// addonLabel is applied by the addons applier to all objects of the addon
+	addonLabel = "kubeone.io/addon"
+
+	// routeReflectorClusterIDAnnotation is the Kubernetes Node annotation
+	// backing the routeReflectorClusterID of the Calico Node resource
+	routeReflectorClusterIDAnnotation = "projectcalico.org/RouteReflectorClusterID"
+)
+
+var (
+	bgpCRDs = []string{
+		"bgpconfigurations.crd.projectcalico.org",
+		"bgppeers.crd.projectcalico.org",
+	}
+
+	bgpGVKs = []schema.GroupVersionKind{
+		{Group: "crd.projectcalico.org", Version: "v1", Kind: "BGPConfiguration"},
+		{Group: "crd.projectcalico.org", Version: "v1", Kind: "BGPPeer"},
+	}
+)
+
+// WaitForBGPCRDs waits for the Calico BGP CRDs to become established
+func WaitForBGPCRDs(s *state.State) error {
+	s.Logger.Infoln("Waiting for Calico BGP CRDs to become established...")
+
+	condFn := clientutil.CRDsReadyCondition(s.Context, s.DynamicClient, bgpCRDs)
+	err := wait.PollUntilContextTimeout(s.Context, 5*time.Second, 3*time.Minute, false, condFn.WithContext())
+
+	return fail.KubeClient(err, "waiting for Calico BGP CRDs to became ready")
+}
+
+// EnsureRouteReflectors annotates the nodes selected by the route reflectors
+// node selector with the route reflector cluster ID, and removes the
+// annotation from the other nodes. With the Kubernetes datastore, Calico
+// reads the routeReflectorClusterID of its Node resources from this
+// annotation.
+func EnsureRouteReflectors(s *state.State) error {
+	var (
+		selector  labels.Selector
+		clusterID string
+	)
+	if bgp := s.Cluster.ClusterNetwork.BGP; bgp != nil && bgp.RouteReflectors != nil {
+		selector = labels.SelectorFromSet(bgp.RouteReflectors.NodeSelector)
+		clusterID = bgp.RouteReflectors.ClusterID
+	}
+
+	nodes := corev1.NodeList{}
+	if err := s.DynamicClient.List(s.Context, &nodes); err != nil {
+		return fail.KubeClient(err, "listing nodes")
+	}
+
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+
+		desired := ""
+		if selector != nil && selector.Matches(labels.Set(node.Labels)) {
+			desired = clusterID
+		}
+		if node.Annotations[routeReflectorClusterIDAnnotation] == desired {
+			continue
+		}
+
+		oldNode := node.DeepCopy()
+		if desired == "" {
+			s.Logger.Infof("Removing Calico route reflector cluster ID from node %q...", node.Name)
+			delete(node.Annotations, routeReflectorClusterIDAnnotation)
+		} else {
+			s.Logger.Infof("Setting Calico route reflector cluster ID of node %q...", node.Name)
+			if node.Annotations == nil {
+				node.Annotations = map[string]string{}
+			}
+			node.Annotations[routeReflectorClusterIDAnnotation] = desired
+		}
+
+		if err := s.DynamicClient.Patch(s.Context, node, client.MergeFrom(oldNode)); err != nil {
+			return fail.KubeClient(err, "patching node %q", node.Name)
+		}
+	}
+
+	return nil
+}
+
+// DeleteBGPResources deletes the Calico BGP resources deployed by the cni-bgp
+// addon and the route reflector cluster IDs of the nodes, after the BGP
+// configuration is removed. The resources are selected by the addon label,
+// as the peers can't be rendered without the BGP configuration.
+func DeleteBGPResources(s *state.State) error {
+	for _, gvk := range bgpGVKs {
+		obj := &metav1unstructured.Unstructured{}
+		obj.SetGroupVersionKind(gvk)
+
+		err := s.DynamicClient.DeleteAllOf(s.Context, obj, client.MatchingLabels{
+			addonLabel: resources.AddonCNIBGP,
+		})
+		if meta.IsNoMatchError(err) {
+			// Calico is not deployed yet
+			continue
+		}
+		if err != nil {
+			return fail.KubeClient(err, "deleting calico %s resources", gvk.Kind)
+		}
+	}
+
+	return EnsureRouteReflectors(s)
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package calico
+
+import (
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/state"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultIPv4IPPool = "default-ipv4-ippool"
+	defaultIPv6IPPool = "default-ipv6-ippool"
+
+	encapsulationAlways      = "Always"
+	encapsulationCrossSubnet = "CrossSubnet"
+	encapsulationNever       = "Never"
+)
+
+var ipPoolGVK = schema.GroupVersionKind{
+	Group:   "crd.projectcalico.org",
+	Version: "v1",
+	Kind:    "IPPool",
+}
+
+// EnsureIPPoolsEncapsulation updates the encapsulation of the default IP pools
+// to match the configured encapsulation mode. calico-node configures the
+// encapsulation only when creating the default IP pools, so changes of the
+// encapsulation mode would otherwise be ignored.
+func EnsureIPPoolsEncapsulation(s *state.State) error {
+	ipipMode, vxlanMode := encapsulationModes(s.Cluster.ClusterNetwork.CNI.Calico)
+
+	for _, name := range []string{defaultIPv4IPPool, defaultIPv6IPPool} {
+		pool := &metav1unstructured.Unstructured{}
+		pool.SetGroupVersionKind(ipPoolGVK)
+		key := client.ObjectKey{Name: name}
+
+		err := s.DynamicClient.Get(s.Context, key, pool)
+		if meta.IsNoMatchError(err) || k8serrors.IsNotFound(err) {
+			// Calico is not deployed yet or the IP pool doesn't exist, so
+			// calico-node will create it with the configured encapsulation
+			continue
+		}
+		if err != nil {
+			return fail.KubeClient(err, "getting calico %s IPPool", name)
+		}
+
+		poolIPIPMode := ipipMode
+		if name == defaultIPv6IPPool {
+			// IPIP encapsulation doesn't support IPv6
+			poolIPIPMode = encapsulationNever
+		}
+
+		currentIPIPMode, _, _ := metav1unstructured.NestedString(pool.Object, "spec", "ipipMode")
+		currentVXLANMode, _, _ := metav1unstructured.NestedString(pool.Object, "spec", "vxlanMode")
+		if currentIPIPMode == poolIPIPMode && currentVXLANMode == vxlanMode {
+			continue
+		}
+
+		if err = metav1unstructured.SetNestedField(pool.Object, poolIPIPMode, "spec", "ipipMode"); err != nil {
+			return fail.Runtime(err, "setting calico %s IPPool ipipMode", name)
+		}
+		if err = metav1unstructured.SetNestedField(pool.Object, vxlanMode, "spec", "vxlanMode"); err != nil {
+			return fail.Runtime(err, "setting calico %s IPPool vxlanMode", name)
+		}
+
+		s.Logger.Infof("Updating encapsulation of the calico %s IPPool...", name)
+		if err = s.DynamicClient.Update(s.Context, pool); err != nil {
+			return fail.KubeClient(err, "updating calico %s IPPool", name)
+		}
+	}
+
+	return nil
+}
+
+// encapsulationModes returns the ipipMode and vxlanMode of the IP pools for
+// the given Calico spec
+func encapsulationModes(calico *kubeoneapi.CalicoSpec) (string, string) {
+	mode := encapsulationAlways
+	if calico.CrossSubnet {
+		mode = encapsulationCrossSubnet
+	}
+
+	switch calico.EncapsulationMode {
+	case kubeoneapi.CalicoEncapsulationModeIPIP:
+		return mode, encapsulationNever
+	case kubeoneapi.CalicoEncapsulationModeNone:
+		return encapsulationNever, encapsulationNever
+	default:
+		return encapsulationNever, mode
+	}
+}
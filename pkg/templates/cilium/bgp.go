@@ -0,0 +1,83 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cilium
+
+import (
+	"time"
+
+	"k8c.io/kubeone/pkg/clientutil"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/state"
+	"k8c.io/kubeone/pkg/templates/resources"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// addonLabel is applied by the addons applier to all objects of the addon
+const addonLabel = "kubeone.io/addon"
+
+var (
+	bgpCRDs = []string{
+		"ciliumbgppeeringpolicies.cilium.io",
+		"ciliumloadbalancerippools.cilium.io",
+	}
+
+	bgpGVKs = []schema.GroupVersionKind{
+		{Group: "cilium.io", Version: "v2alpha1", Kind: "CiliumBGPPeeringPolicy"},
+		{Group: "cilium.io", Version: "v2alpha1", Kind: "CiliumLoadBalancerIPPool"},
+	}
+)
+
+// WaitForBGPCRDs waits for the CiliumBGPPeeringPolicy and
+// CiliumLoadBalancerIPPool CRDs, which are created by the Cilium operator,
+// to become established
+func WaitForBGPCRDs(s *state.State) error {
+	s.Logger.Infoln("Waiting for Cilium BGP CRDs to become established...")
+
+	condFn := clientutil.CRDsReadyCondition(s.Context, s.DynamicClient, bgpCRDs)
+	err := wait.PollUntilContextTimeout(s.Context, 5*time.Second, 3*time.Minute, false, condFn.WithContext())
+
+	return fail.KubeClient(err, "waiting for Cilium BGP CRDs to became ready")
+}
+
+// DeleteBGPResources deletes the Cilium BGP resources deployed by the cni-bgp
+// addon after the BGP configuration is removed. The resources are selected
+// by the addon label, as they can't be rendered without the BGP
+// configuration.
+func DeleteBGPResources(s *state.State) error {
+	for _, gvk := range bgpGVKs {
+		obj := &metav1unstructured.Unstructured{}
+		obj.SetGroupVersionKind(gvk)
+
+		err := s.DynamicClient.DeleteAllOf(s.Context, obj, client.MatchingLabels{
+			addonLabel: resources.AddonCNIBGP,
+		})
+		if meta.IsNoMatchError(err) {
+			// the CRD doesn't exist, so there is nothing to delete
+			continue
+		}
+		if err != nil {
+			return fail.KubeClient(err, "deleting cilium %s resources", gvk.Kind)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cilium
+
+import (
+	"time"
+
+	"k8c.io/kubeone/pkg/clientutil"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/state"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const egressGatewayPolicyCRD = "ciliumegressgatewaypolicies.cilium.io"
+
+// WaitForEgressGatewayCRD waits for the CiliumEgressGatewayPolicy CRD, which
+// is created by the Cilium operator, to become established
+func WaitForEgressGatewayCRD(s *state.State) error {
+	s.Logger.Infoln("Waiting for Cilium egress gateway CRD to become established...")
+
+	condFn := clientutil.CRDsReadyCondition(s.Context, s.DynamicClient, []string{egressGatewayPolicyCRD})
+	err := wait.PollUntilContextTimeout(s.Context, 5*time.Second, 3*time.Minute, false, condFn.WithContext())
+
+	return fail.KubeClient(err, "waiting for Cilium egress gateway CRD to became ready")
+}
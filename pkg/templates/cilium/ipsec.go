@@ -0,0 +1,222 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cilium
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/state"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	ipsecSecretName = "cilium-ipsec-keys"
+
+	// ipsecKeyID is the SPI of the generated IPsec key
+	ipsecKeyID = 3
+
+	// ipsecMaxKeyID is the highest SPI supported by Cilium, the key IDs
+	// wrap around to 1 after it
+	ipsecMaxKeyID = 15
+)
+
+var ciliumNodeListGVK = schema.GroupVersionKind{
+	Group:   "cilium.io",
+	Version: "v2",
+	Kind:    "CiliumNodeList",
+}
+
+// EnsureIPsecSecret ensures the cilium-ipsec-keys Secret with the IPsec key
+// used by the Cilium agents exists. The existing key is never overwritten.
+func EnsureIPsecSecret(s *state.State) error {
+	keys, err := genIPsecKeys(strconv.Itoa(ipsecKeyID))
+	if err != nil {
+		return err
+	}
+
+	sec := ipsecSecret(keys)
+	key := client.ObjectKeyFromObject(sec)
+	secCopy := sec.DeepCopy()
+
+	err = s.DynamicClient.Get(s.Context, key, secCopy)
+	if k8serrors.IsNotFound(err) {
+		err = s.DynamicClient.Create(s.Context, sec)
+
+		return fail.KubeClient(err, "creating %T %s", sec, key)
+	}
+
+	return fail.KubeClient(err, "getting %T %s", sec, key)
+}
+
+// IPsecKeyID returns the ID of the IPsec key stored in the cilium-ipsec-keys
+// Secret
+func IPsecKeyID(s *state.State) (int, error) {
+	sec, err := getIPsecSecret(s)
+	if err != nil {
+		return 0, err
+	}
+
+	keyID, _, err := parseIPsecKeyID(string(sec.Data["keys"]))
+
+	return keyID, err
+}
+
+// RotateIPsecKey replaces the IPsec key stored in the cilium-ipsec-keys Secret
+// with a new key using the next key ID, and returns the new key ID. The
+// Cilium agents pick up the new key from the mounted Secret, while keeping the
+// previous key for the traffic from the nodes that didn't switch yet.
+func RotateIPsecKey(s *state.State) (int, error) {
+	sec, err := getIPsecSecret(s)
+	if err != nil {
+		return 0, err
+	}
+
+	keyID, suffix, err := parseIPsecKeyID(string(sec.Data["keys"]))
+	if err != nil {
+		return 0, err
+	}
+
+	newKeyID := nextIPsecKeyID(keyID)
+	keys, err := genIPsecKeys(strconv.Itoa(newKeyID) + suffix)
+	if err != nil {
+		return 0, err
+	}
+
+	sec.Data = map[string][]byte{
+		"keys": []byte(keys),
+	}
+	if err = s.DynamicClient.Update(s.Context, sec); err != nil {
+		return 0, fail.KubeClient(err, "updating %T %s", sec, client.ObjectKeyFromObject(sec))
+	}
+
+	return newKeyID, nil
+}
+
+// WaitForIPsecKey waits until the Cilium agents on all nodes switch to the
+// IPsec key with the given ID, as reported in the CiliumNode objects
+func WaitForIPsecKey(s *state.State, keyID int) error {
+	switched := map[string]bool{}
+
+	err := wait.PollUntilContextTimeout(s.Context, 5*time.Second, 10*time.Minute, true, func(ctx context.Context) (bool, error) {
+		ciliumNodes := &metav1unstructured.UnstructuredList{}
+		ciliumNodes.SetGroupVersionKind(ciliumNodeListGVK)
+		if err := s.DynamicClient.List(ctx, ciliumNodes); err != nil {
+			return false, fail.KubeClient(err, "listing CiliumNodes")
+		}
+
+		var pending []string
+		for _, ciliumNode := range ciliumNodes.Items {
+			nodeKeyID, _, _ := metav1unstructured.NestedInt64(ciliumNode.Object, "spec", "encryption", "key")
+			if int(nodeKeyID) != keyID {
+				pending = append(pending, ciliumNode.GetName())
+
+				continue
+			}
+			if !switched[ciliumNode.GetName()] {
+				s.Logger.Infof("Node %q switched to the IPsec key %d", ciliumNode.GetName(), keyID)
+				switched[ciliumNode.GetName()] = true
+			}
+		}
+
+		if len(pending) > 0 {
+			sort.Strings(pending)
+			s.Logger.Debugf("Waiting for nodes %s to switch to the IPsec key %d...", strings.Join(pending, ", "), keyID)
+		}
+
+		return len(pending) == 0, nil
+	})
+
+	return fail.KubeClient(err, "waiting for Cilium agents to switch to the IPsec key %d", keyID)
+}
+
+func getIPsecSecret(s *state.State) (*corev1.Secret, error) {
+	sec := &corev1.Secret{}
+	key := client.ObjectKey{Name: ipsecSecretName, Namespace: metav1.NamespaceSystem}
+
+	if err := s.DynamicClient.Get(s.Context, key, sec); err != nil {
+		return nil, fail.KubeClient(err, "getting %T %s", sec, key)
+	}
+
+	return sec, nil
+}
+
+// parseIPsecKeyID returns the ID of the IPsec key and its suffix ("+" for the
+// per-tunnel keys) from the content of the cilium-ipsec-keys Secret
+func parseIPsecKeyID(keys string) (int, string, error) {
+	fields := strings.Fields(keys)
+	if len(fields) == 0 {
+		return 0, "", fail.Runtime(errors.New("no IPsec key found"), "parsing %s Secret", ipsecSecretName)
+	}
+
+	id, suffix := fields[0], ""
+	if strings.HasSuffix(id, "+") {
+		id, suffix = strings.TrimSuffix(id, "+"), "+"
+	}
+
+	keyID, err := strconv.Atoi(id)
+	if err != nil || keyID < 1 || keyID > ipsecMaxKeyID {
+		return 0, "", fail.Runtime(errors.Errorf("invalid IPsec key ID %q", fields[0]), "parsing %s Secret", ipsecSecretName)
+	}
+
+	return keyID, suffix, nil
+}
+
+// nextIPsecKeyID returns the ID of the key following the given key ID
+func nextIPsecKeyID(keyID int) int {
+	return keyID%ipsecMaxKeyID + 1
+}
+
+// genIPsecKeys generates the IPsec key with the given key ID in the format
+// expected by Cilium, using AES-GCM with a 128-bit ICV
+func genIPsecKeys(keyID string) (string, error) {
+	// 16 bytes of the AES-128 key and 4 bytes of the salt
+	pk := make([]byte, 20)
+	if _, err := rand.Reader.Read(pk); err != nil {
+		return "", fail.Runtime(err, "reading random bytes")
+	}
+
+	return fmt.Sprintf("%s rfc4106(gcm(aes)) %s 128", keyID, hex.EncodeToString(pk)), nil
+}
+
+func ipsecSecret(keys string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ipsecSecretName,
+			Namespace: metav1.NamespaceSystem,
+		},
+		StringData: map[string]string{
+			"keys": keys,
+		},
+	}
+}
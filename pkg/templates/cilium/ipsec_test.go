@@ -0,0 +1,85 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cilium
+
+import (
+	"testing"
+)
+
+func TestParseIPsecKeyID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		keys           string
+		expectedKeyID  int
+		expectedSuffix string
+		expectedError  bool
+	}{
+		{
+			name:          "generated key",
+			keys:          "3 rfc4106(gcm(aes)) 0123456789abcdef0123456789abcdef01234567 128",
+			expectedKeyID: 3,
+		},
+		{
+			name:           "per-tunnel key",
+			keys:           "15+ rfc4106(gcm(aes)) 0123456789abcdef0123456789abcdef01234567 128",
+			expectedKeyID:  15,
+			expectedSuffix: "+",
+		},
+		{
+			name:          "empty secret",
+			keys:          "",
+			expectedError: true,
+		},
+		{
+			name:          "key ID out of range",
+			keys:          "16 rfc4106(gcm(aes)) 0123456789abcdef0123456789abcdef01234567 128",
+			expectedError: true,
+		},
+		{
+			name:          "invalid key ID",
+			keys:          "rfc4106(gcm(aes)) 0123456789abcdef0123456789abcdef01234567 128",
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			keyID, suffix, err := parseIPsecKeyID(tc.keys)
+			if (err != nil) != tc.expectedError {
+				t.Fatalf("expected error %v, but got %v", tc.expectedError, err)
+			}
+			if keyID != tc.expectedKeyID || suffix != tc.expectedSuffix {
+				t.Errorf("parseIPsecKeyID() = %d, %q, want %d, %q", keyID, suffix, tc.expectedKeyID, tc.expectedSuffix)
+			}
+		})
+	}
+}
+
+func TestNextIPsecKeyID(t *testing.T) {
+	t.Parallel()
+
+	for keyID, expected := range map[int]int{1: 2, 3: 4, 14: 15, 15: 1} {
+		if got := nextIPsecKeyID(keyID); got != expected {
+			t.Errorf("nextIPsecKeyID(%d) = %d, want %d", keyID, got, expected)
+		}
+	}
+}
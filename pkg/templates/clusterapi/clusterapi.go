@@ -0,0 +1,307 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterapi
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/templates"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	clusterAPIVersion      = "cluster.x-k8s.io/v1beta1"
+	controlPlaneAPIVersion = "controlplane.cluster.x-k8s.io/v1beta1"
+	bootstrapAPIVersion    = "bootstrap.cluster.x-k8s.io/v1beta1"
+
+	// clusterNameLabel is the label used by Cluster API to link objects to
+	// the Cluster
+	clusterNameLabel = "cluster.x-k8s.io/cluster-name"
+
+	// deploymentNameLabel is the label used to select the Machines of a
+	// MachineDeployment
+	deploymentNameLabel = "cluster.x-k8s.io/deployment-name"
+)
+
+// infrastructureProvider describes the Cluster API infrastructure provider
+// resources matching the KubeOne cloud provider
+type infrastructureProvider struct {
+	apiVersion          string
+	clusterKind         string
+	machineTemplateKind string
+}
+
+var infrastructureProviders = map[string]infrastructureProvider{
+	"aws":                 {apiVersion: "infrastructure.cluster.x-k8s.io/v1beta2", clusterKind: "AWSCluster", machineTemplateKind: "AWSMachineTemplate"},
+	"azure":               {apiVersion: "infrastructure.cluster.x-k8s.io/v1beta1", clusterKind: "AzureCluster", machineTemplateKind: "AzureMachineTemplate"},
+	"digitalocean":        {apiVersion: "infrastructure.cluster.x-k8s.io/v1beta1", clusterKind: "DOCluster", machineTemplateKind: "DOMachineTemplate"},
+	"equinixmetal":        {apiVersion: "infrastructure.cluster.x-k8s.io/v1beta1", clusterKind: "PacketCluster", machineTemplateKind: "PacketMachineTemplate"},
+	"gce":                 {apiVersion: "infrastructure.cluster.x-k8s.io/v1beta1", clusterKind: "GCPCluster", machineTemplateKind: "GCPMachineTemplate"},
+	"hetzner":             {apiVersion: "infrastructure.cluster.x-k8s.io/v1beta1", clusterKind: "HetznerCluster", machineTemplateKind: "HCloudMachineTemplate"},
+	"kubevirt":            {apiVersion: "infrastructure.cluster.x-k8s.io/v1alpha1", clusterKind: "KubevirtCluster", machineTemplateKind: "KubevirtMachineTemplate"},
+	"nutanix":             {apiVersion: "infrastructure.cluster.x-k8s.io/v1beta1", clusterKind: "NutanixCluster", machineTemplateKind: "NutanixMachineTemplate"},
+	"oci":                 {apiVersion: "infrastructure.cluster.x-k8s.io/v1beta2", clusterKind: "OCICluster", machineTemplateKind: "OCIMachineTemplate"},
+	"openstack":           {apiVersion: "infrastructure.cluster.x-k8s.io/v1alpha7", clusterKind: "OpenStackCluster", machineTemplateKind: "OpenStackMachineTemplate"},
+	"vmwareCloudDirector": {apiVersion: "infrastructure.cluster.x-k8s.io/v1beta2", clusterKind: "VCDCluster", machineTemplateKind: "VCDMachineTemplate"},
+	"vsphere":             {apiVersion: "infrastructure.cluster.x-k8s.io/v1beta1", clusterKind: "VSphereCluster", machineTemplateKind: "VSphereMachineTemplate"},
+}
+
+// Manifest translates the KubeOneCluster into the Cluster API Cluster,
+// KubeadmControlPlane, MachineDeployment and KubeadmConfigTemplate objects.
+//
+// The infrastructure provider objects (e.g. AWSCluster and
+// AWSMachineTemplate) are only referenced, because their specs can't be
+// derived from the KubeOneCluster and have to be created separately.
+func Manifest(cluster *kubeoneapi.KubeOneCluster, namespace string) (string, error) {
+	providerName := cluster.CloudProvider.CloudProviderName()
+	infra, ok := infrastructureProviders[providerName]
+	if !ok {
+		return "", fail.NewConfigError("converting to cluster-api", "cloud provider %q has no cluster-api infrastructure provider", providerName)
+	}
+
+	version := cluster.Versions.Kubernetes
+	if !strings.HasPrefix(version, "v") {
+		version = "v" + version
+	}
+
+	objs := []runtime.Object{
+		clusterObject(cluster, namespace, infra),
+		kubeadmControlPlane(cluster, namespace, version, infra),
+	}
+
+	for _, workerset := range cluster.DynamicWorkers {
+		objs = append(objs,
+			machineDeployment(cluster, workerset, namespace, version, infra),
+			kubeadmConfigTemplate(cluster, workerset, namespace),
+		)
+	}
+
+	return templates.KubernetesToYAML(objs)
+}
+
+func clusterObject(cluster *kubeoneapi.KubeOneCluster, namespace string, infra infrastructureProvider) runtime.Object {
+	clusterNetwork := map[string]interface{}{}
+
+	if pods := subnets(cluster.ClusterNetwork.PodSubnet, cluster.ClusterNetwork.PodSubnetIPv6); len(pods) > 0 {
+		clusterNetwork["pods"] = map[string]interface{}{"cidrBlocks": pods}
+	}
+	if services := subnets(cluster.ClusterNetwork.ServiceSubnet, cluster.ClusterNetwork.ServiceSubnetIPv6); len(services) > 0 {
+		clusterNetwork["services"] = map[string]interface{}{"cidrBlocks": services}
+	}
+	if cluster.ClusterNetwork.ServiceDomainName != "" {
+		clusterNetwork["serviceDomain"] = cluster.ClusterNetwork.ServiceDomainName
+	}
+
+	spec := map[string]interface{}{
+		"clusterNetwork":    clusterNetwork,
+		"controlPlaneRef":   objectReference(controlPlaneAPIVersion, "KubeadmControlPlane", controlPlaneName(cluster)),
+		"infrastructureRef": objectReference(infra.apiVersion, infra.clusterKind, cluster.Name),
+	}
+
+	if cluster.APIEndpoint.Host != "" {
+		spec["controlPlaneEndpoint"] = map[string]interface{}{
+			"host": cluster.APIEndpoint.Host,
+			"port": int64(cluster.APIEndpoint.Port),
+		}
+	}
+
+	return newObject(clusterAPIVersion, "Cluster", cluster.Name, namespace, cluster.Name, spec)
+}
+
+func kubeadmControlPlane(cluster *kubeoneapi.KubeOneCluster, namespace, version string, infra infrastructureProvider) runtime.Object {
+	apiServer := map[string]interface{}{}
+	if len(cluster.APIEndpoint.AlternativeNames) > 0 {
+		apiServer["certSANs"] = stringSlice(cluster.APIEndpoint.AlternativeNames)
+	}
+
+	controlPlaneRegistration := nodeRegistration(cluster, nil, nil)
+
+	spec := map[string]interface{}{
+		"replicas": int64(len(cluster.ControlPlane.Hosts)),
+		"version":  version,
+		"machineTemplate": map[string]interface{}{
+			"infrastructureRef": objectReference(infra.apiVersion, infra.machineTemplateKind, controlPlaneName(cluster)),
+		},
+		"kubeadmConfigSpec": map[string]interface{}{
+			"clusterConfiguration": map[string]interface{}{
+				"clusterName": cluster.Name,
+				"apiServer":   apiServer,
+			},
+			"initConfiguration": map[string]interface{}{
+				"nodeRegistration": controlPlaneRegistration,
+			},
+			"joinConfiguration": map[string]interface{}{
+				"nodeRegistration": controlPlaneRegistration,
+			},
+		},
+	}
+
+	return newObject(controlPlaneAPIVersion, "KubeadmControlPlane", controlPlaneName(cluster), namespace, cluster.Name, spec)
+}
+
+func machineDeployment(cluster *kubeoneapi.KubeOneCluster, workerset kubeoneapi.DynamicWorkerConfig, namespace, version string, infra infrastructureProvider) runtime.Object {
+	selector := map[string]interface{}{
+		clusterNameLabel:    cluster.Name,
+		deploymentNameLabel: workerset.Name,
+	}
+
+	spec := map[string]interface{}{
+		"clusterName": cluster.Name,
+		"selector": map[string]interface{}{
+			"matchLabels": selector,
+		},
+		"template": map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"labels": selector,
+			},
+			"spec": map[string]interface{}{
+				"clusterName": cluster.Name,
+				"version":     version,
+				"bootstrap": map[string]interface{}{
+					"configRef": objectReference(bootstrapAPIVersion, "KubeadmConfigTemplate", workerset.Name),
+				},
+				"infrastructureRef": objectReference(infra.apiVersion, infra.machineTemplateKind, workerset.Name),
+			},
+		},
+	}
+
+	if workerset.Replicas != nil {
+		spec["replicas"] = int64(*workerset.Replicas)
+	}
+
+	obj := newObject(clusterAPIVersion, "MachineDeployment", workerset.Name, namespace, cluster.Name, spec)
+	if len(workerset.Config.Annotations) > 0 {
+		obj.SetAnnotations(workerset.Config.Annotations)
+	}
+
+	return obj
+}
+
+func kubeadmConfigTemplate(cluster *kubeoneapi.KubeOneCluster, workerset kubeoneapi.DynamicWorkerConfig, namespace string) runtime.Object {
+	templateSpec := map[string]interface{}{
+		"joinConfiguration": map[string]interface{}{
+			"nodeRegistration": nodeRegistration(cluster, workerset.Config.Labels, workerset.Config.Taints),
+		},
+	}
+
+	if len(workerset.Config.SSHPublicKeys) > 0 {
+		templateSpec["users"] = []interface{}{
+			map[string]interface{}{
+				"name":              "kubeone",
+				"sshAuthorizedKeys": stringSlice(workerset.Config.SSHPublicKeys),
+			},
+		}
+	}
+
+	spec := map[string]interface{}{
+		"template": map[string]interface{}{
+			"spec": templateSpec,
+		},
+	}
+
+	return newObject(bootstrapAPIVersion, "KubeadmConfigTemplate", workerset.Name, namespace, cluster.Name, spec)
+}
+
+func nodeRegistration(cluster *kubeoneapi.KubeOneCluster, labels map[string]string, taints []corev1.Taint) map[string]interface{} {
+	kubeletExtraArgs := map[string]interface{}{}
+
+	if cluster.CloudProvider.External {
+		kubeletExtraArgs["cloud-provider"] = "external"
+	}
+
+	if len(labels) > 0 {
+		var nodeLabels []string
+		for k, v := range labels {
+			nodeLabels = append(nodeLabels, fmt.Sprintf("%s=%s", k, v))
+		}
+		sort.Strings(nodeLabels)
+		kubeletExtraArgs["node-labels"] = strings.Join(nodeLabels, ",")
+	}
+
+	registration := map[string]interface{}{}
+	if len(kubeletExtraArgs) > 0 {
+		registration["kubeletExtraArgs"] = kubeletExtraArgs
+	}
+
+	if len(taints) > 0 {
+		taintList := []interface{}{}
+		for _, taint := range taints {
+			taintList = append(taintList, map[string]interface{}{
+				"key":    taint.Key,
+				"value":  taint.Value,
+				"effect": string(taint.Effect),
+			})
+		}
+		registration["taints"] = taintList
+	}
+
+	return registration
+}
+
+func newObject(apiVersion, kind, name, namespace, clusterName string, spec map[string]interface{}) *metav1unstructured.Unstructured {
+	obj := &metav1unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"spec": spec,
+		},
+	}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetName(name)
+	obj.SetNamespace(namespace)
+	obj.SetLabels(map[string]string{clusterNameLabel: clusterName})
+
+	return obj
+}
+
+func objectReference(apiVersion, kind, name string) map[string]interface{} {
+	return map[string]interface{}{
+		"apiVersion": apiVersion,
+		"kind":       kind,
+		"name":       name,
+	}
+}
+
+func controlPlaneName(cluster *kubeoneapi.KubeOneCluster) string {
+	return cluster.Name + "-control-plane"
+}
+
+func subnets(subnets ...string) []interface{} {
+	var result []interface{}
+	for _, subnet := range subnets {
+		if subnet != "" {
+			result = append(result, subnet)
+		}
+	}
+
+	return result
+}
+
+func stringSlice(s []string) []interface{} {
+	result := make([]interface{}, 0, len(s))
+	for _, v := range s {
+		result = append(result, v)
+	}
+
+	return result
+}
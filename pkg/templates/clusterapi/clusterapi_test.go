@@ -0,0 +1,105 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterapi
+
+import (
+	"flag"
+	"testing"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/testhelper"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+var (
+	updateFlag = flag.Bool("update", false, "update testdata files")
+)
+
+func TestManifest(t *testing.T) {
+	replicas := 3
+
+	tests := []struct {
+		name    string
+		cluster *kubeoneapi.KubeOneCluster
+		wantErr bool
+	}{
+		{
+			name: "aws",
+			cluster: &kubeoneapi.KubeOneCluster{
+				Name: "test",
+				APIEndpoint: kubeoneapi.APIEndpoint{
+					Host:             "lb.example.com",
+					Port:             6443,
+					AlternativeNames: []string{"api.example.com"},
+				},
+				CloudProvider: kubeoneapi.CloudProviderSpec{
+					AWS:      &kubeoneapi.AWSSpec{},
+					External: true,
+				},
+				ClusterNetwork: kubeoneapi.ClusterNetworkConfig{
+					PodSubnet:         "10.244.0.0/16",
+					ServiceSubnet:     "10.96.0.0/12",
+					ServiceDomainName: "cluster.local",
+				},
+				ControlPlane: kubeoneapi.ControlPlaneConfig{
+					Hosts: []kubeoneapi.HostConfig{{}, {}, {}},
+				},
+				Versions: kubeoneapi.VersionConfig{
+					Kubernetes: "1.27.5",
+				},
+				DynamicWorkers: []kubeoneapi.DynamicWorkerConfig{
+					{
+						Name:     "test-pool1",
+						Replicas: &replicas,
+						Config: kubeoneapi.ProviderSpec{
+							Labels:        map[string]string{"role": "worker", "pool": "pool1"},
+							Taints:        []corev1.Taint{{Key: "dedicated", Value: "pool1", Effect: corev1.TaintEffectNoSchedule}},
+							SSHPublicKeys: []string{"ssh-ed25519 AAAA test"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "no infrastructure provider",
+			cluster: &kubeoneapi.KubeOneCluster{
+				Name: "test",
+				CloudProvider: kubeoneapi.CloudProviderSpec{
+					None: &kubeoneapi.NoneSpec{},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Manifest(tt.cluster, "default")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Manifest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			testhelper.DiffOutput(t, testhelper.FSGoldenName(t), got, *updateFlag)
+		})
+	}
+}
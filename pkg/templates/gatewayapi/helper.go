@@ -0,0 +1,49 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gatewayapi
+
+import (
+	"time"
+
+	"k8c.io/kubeone/pkg/clientutil"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/state"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// WaitForCRDs waits for the Gateway API CRDs to become established, so that the
+// GatewayClass of the gateway controller can be created
+func WaitForCRDs(s *state.State) error {
+	s.Logger.Infoln("Waiting for Gateway API CRDs to become established...")
+
+	condFn := clientutil.CRDsReadyCondition(s.Context, s.DynamicClient, CRDNames())
+	err := wait.PollUntilContextTimeout(s.Context, 5*time.Second, 3*time.Minute, false, condFn.WithContext())
+
+	return fail.KubeClient(err, "waiting for Gateway API CRDs to became ready")
+}
+
+// CRDNames returns the names of the Gateway API CRDs deployed by the gateway-api addon
+func CRDNames() []string {
+	return []string{
+		"gatewayclasses.gateway.networking.k8s.io",
+		"gateways.gateway.networking.k8s.io",
+		"httproutes.gateway.networking.k8s.io",
+		"referencegrants.gateway.networking.k8s.io",
+		"tlsroutes.gateway.networking.k8s.io",
+	}
+}
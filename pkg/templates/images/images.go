@@ -53,6 +53,10 @@ const (
 	CalicoNode
 	Flannel
 
+	// Calico CNI (also uses the Calico images above)
+	CalicoTypha
+	CalicoTyphaAutoscaler
+
 	// Cilium CNI
 	Cilium
 	CiliumOperator
@@ -73,6 +77,7 @@ const (
 
 	// Addons
 	ClusterAutoscaler
+	NvidiaDevicePlugin
 
 	// AWS CCM
 	AwsCCM
@@ -89,7 +94,6 @@ const (
 	AwsEbsCSIProvisioner
 	AwsEbsCSIResizer
 	AwsEbsCSISnapshotter
-	AwsEbsCSISnapshotController
 
 	// AzureFile CSI
 	AzureFileCSI
@@ -99,7 +103,6 @@ const (
 	AzureFileCSIProvisioner
 	AzureFileCSIResizer
 	AzureFileCSISnapshotter
-	AzureFileCSISnapshotterController
 
 	// AzureDisk CSI
 	AzureDiskCSI
@@ -109,7 +112,6 @@ const (
 	AzureDiskCSIProvisioner
 	AzureDiskCSIResizer
 	AzureDiskCSISnapshotter
-	AzureDiskCSISnapshotterController
 
 	// Nutanix CSI
 	NutanixCSILivenessProbe
@@ -118,9 +120,22 @@ const (
 	NutanixCSIRegistrar
 	NutanixCSIResizer
 	NutanixCSISnapshotter
-	NutanixCSISnapshotController
 	NutanixCSISnapshotValidationWebhook
 
+	// KubeVirt CSI
+	KubevirtCSI
+	KubevirtCSIAttacher
+	KubevirtCSILivenessProbe
+	KubevirtCSINodeDriverRegistrar
+	KubevirtCSIProvisioner
+
+	// OCI CSI
+	OCICSI
+	OCICSIAttacher
+	OCICSINodeDriverRegistrar
+	OCICSIProvisioner
+	OCICSIResizer
+
 	// DigitalOcean CSI
 	DigitalOceanCSI
 	DigitalOceanCSIAlpine
@@ -128,7 +143,6 @@ const (
 	DigitalOceanCSINodeDriverRegistar
 	DigitalOceanCSIProvisioner
 	DigitalOceanCSIResizer
-	DigitalOceanCSISnapshotController
 	DigitalOceanCSISnapshotValidationWebhook
 	DigitalOceanCSISnapshotter
 
@@ -140,7 +154,6 @@ const (
 	OpenstackCSIProvisioner
 	OpenstackCSIResizer
 	OpenstackCSISnapshotter
-	OpenstackCSISnapshotController
 	OpenstackCSISnapshotWebhook
 
 	// Hetzner CSI
@@ -157,6 +170,9 @@ const (
 	OpenstackCCM
 	EquinixMetalCCM
 	VsphereCCM
+	NutanixCCM
+	OCICCM
+	KubevirtCCM
 
 	// CSI Vault Secret Provider
 	CSIVaultSecretProvider // hashicorp/vault-csi-provider:1.1.0
@@ -182,7 +198,6 @@ const (
 	VsphereCSIProvisioner
 	VsphereCSIResizer
 	VsphereCSISnapshotter
-	VsphereCSISnapshotController
 	VsphereCSISnapshotValidationWebhook
 
 	// GCP Compute Persistent Disk CSI
@@ -191,7 +206,6 @@ const (
 	GCPComputeCSIAttacher
 	GCPComputeCSIResizer
 	GCPComputeCSISnapshotter
-	GCPComputeCSISnapshotController
 	GCPComputeCSISnapshotValidationWebhook
 	GCPComputeCSINodeDriverRegistrar
 
@@ -199,6 +213,34 @@ const (
 	CalicoVXLANCNI
 	CalicoVXLANController
 	CalicoVXLANNode
+
+	// etcd backups
+	EtcdBackupsEtcdctl
+	EtcdBackupsRestic
+
+	// MetalLB
+	MetalLBController
+	MetalLBSpeaker
+	MetalLBBGPRoutes
+
+	// Istio
+	IstioPilot
+	IstioInstallCNI
+	IstioZtunnel
+
+	// kube-vip
+	KubeVIP
+
+	// CSI snapshot controller
+	CSISnapshotController
+
+	// Local storage
+	LocalPathProvisioner
+	LocalPathProvisionerHelper
+	TopoLVM
+
+	// ingress-nginx
+	IngressNginxController
 )
 
 func FindResource(name string) (Resource, error) {
@@ -240,7 +282,6 @@ func optionalResources() map[Resource]map[string]string {
 		AwsEbsCSINodeDriverRegistrar: {"*": "public.ecr.aws/eks-distro/kubernetes-csi/node-driver-registrar:v2.8.0-eks-1-27-9"},
 		AwsEbsCSIProvisioner:         {"*": "public.ecr.aws/eks-distro/kubernetes-csi/external-provisioner:v3.5.0-eks-1-27-9"},
 		AwsEbsCSIResizer:             {"*": "public.ecr.aws/eks-distro/kubernetes-csi/external-resizer:v1.8.0-eks-1-27-9"},
-		AwsEbsCSISnapshotController:  {"*": "registry.k8s.io/sig-storage/snapshot-controller:v6.2.1"},
 		AwsEbsCSISnapshotter:         {"*": "public.ecr.aws/eks-distro/kubernetes-csi/external-snapshotter/csi-snapshotter:v6.2.2-eks-1-27-9"},
 
 		// Azure CCM
@@ -258,24 +299,22 @@ func optionalResources() map[Resource]map[string]string {
 		},
 
 		// AzureFile CSI driver
-		AzureFileCSI:                      {"*": "mcr.microsoft.com/oss/kubernetes-csi/azurefile-csi:v1.27.1"},
-		AzureFileCSIAttacher:              {"*": "mcr.microsoft.com/oss/kubernetes-csi/csi-attacher:v4.0.0"},
-		AzureFileCSILivenessProbe:         {"*": "mcr.microsoft.com/oss/kubernetes-csi/livenessprobe:v2.8.0"},
-		AzureFileCSINodeDriverRegistar:    {"*": "mcr.microsoft.com/oss/kubernetes-csi/csi-node-driver-registrar:v2.6.2"},
-		AzureFileCSIProvisioner:           {"*": "mcr.microsoft.com/oss/kubernetes-csi/csi-provisioner:v3.3.0"},
-		AzureFileCSIResizer:               {"*": "mcr.microsoft.com/oss/kubernetes-csi/csi-resizer:v1.6.0"},
-		AzureFileCSISnapshotter:           {"*": "mcr.microsoft.com/oss/kubernetes-csi/csi-snapshotter:v5.0.1"},
-		AzureFileCSISnapshotterController: {"*": "mcr.microsoft.com/oss/kubernetes-csi/snapshot-controller:v5.0.1"},
+		AzureFileCSI:                   {"*": "mcr.microsoft.com/oss/kubernetes-csi/azurefile-csi:v1.27.1"},
+		AzureFileCSIAttacher:           {"*": "mcr.microsoft.com/oss/kubernetes-csi/csi-attacher:v4.0.0"},
+		AzureFileCSILivenessProbe:      {"*": "mcr.microsoft.com/oss/kubernetes-csi/livenessprobe:v2.8.0"},
+		AzureFileCSINodeDriverRegistar: {"*": "mcr.microsoft.com/oss/kubernetes-csi/csi-node-driver-registrar:v2.6.2"},
+		AzureFileCSIProvisioner:        {"*": "mcr.microsoft.com/oss/kubernetes-csi/csi-provisioner:v3.3.0"},
+		AzureFileCSIResizer:            {"*": "mcr.microsoft.com/oss/kubernetes-csi/csi-resizer:v1.6.0"},
+		AzureFileCSISnapshotter:        {"*": "mcr.microsoft.com/oss/kubernetes-csi/csi-snapshotter:v5.0.1"},
 
 		// AzureDisk CSI driver
-		AzureDiskCSI:                      {"*": "mcr.microsoft.com/oss/kubernetes-csi/azuredisk-csi:v1.27.1"},
-		AzureDiskCSIAttacher:              {"*": "mcr.microsoft.com/oss/kubernetes-csi/csi-attacher:v4.0.0"},
-		AzureDiskCSILivenessProbe:         {"*": "mcr.microsoft.com/oss/kubernetes-csi/livenessprobe:v2.8.0"},
-		AzureDiskCSINodeDriverRegistar:    {"*": "mcr.microsoft.com/oss/kubernetes-csi/csi-node-driver-registrar:v2.6.2"},
-		AzureDiskCSIProvisioner:           {"*": "mcr.microsoft.com/oss/kubernetes-csi/csi-provisioner:v3.3.0"},
-		AzureDiskCSIResizer:               {"*": "mcr.microsoft.com/oss/kubernetes-csi/csi-resizer:v1.6.0"},
-		AzureDiskCSISnapshotter:           {"*": "mcr.microsoft.com/oss/kubernetes-csi/csi-snapshotter:v5.0.1"},
-		AzureDiskCSISnapshotterController: {"*": "mcr.microsoft.com/oss/kubernetes-csi/snapshot-controller:v5.0.1"},
+		AzureDiskCSI:                   {"*": "mcr.microsoft.com/oss/kubernetes-csi/azuredisk-csi:v1.27.1"},
+		AzureDiskCSIAttacher:           {"*": "mcr.microsoft.com/oss/kubernetes-csi/csi-attacher:v4.0.0"},
+		AzureDiskCSILivenessProbe:      {"*": "mcr.microsoft.com/oss/kubernetes-csi/livenessprobe:v2.8.0"},
+		AzureDiskCSINodeDriverRegistar: {"*": "mcr.microsoft.com/oss/kubernetes-csi/csi-node-driver-registrar:v2.6.2"},
+		AzureDiskCSIProvisioner:        {"*": "mcr.microsoft.com/oss/kubernetes-csi/csi-provisioner:v3.3.0"},
+		AzureDiskCSIResizer:            {"*": "mcr.microsoft.com/oss/kubernetes-csi/csi-resizer:v1.6.0"},
+		AzureDiskCSISnapshotter:        {"*": "mcr.microsoft.com/oss/kubernetes-csi/csi-snapshotter:v5.0.1"},
 
 		// DigitalOcean CCM
 		DigitaloceanCCM: {"*": "docker.io/digitalocean/digitalocean-cloud-controller-manager:v0.1.43"},
@@ -286,12 +325,11 @@ func optionalResources() map[Resource]map[string]string {
 		DigitalOceanCSINodeDriverRegistar:        {"*": "registry.k8s.io/sig-storage/csi-node-driver-registrar:v2.8.0"},
 		DigitalOceanCSIProvisioner:               {"*": "registry.k8s.io/sig-storage/csi-provisioner:v3.5.0"},
 		DigitalOceanCSIResizer:                   {"*": "registry.k8s.io/sig-storage/csi-resizer:v1.8.0"},
-		DigitalOceanCSISnapshotController:        {"*": "registry.k8s.io/sig-storage/snapshot-controller:v6.2.1"},
 		DigitalOceanCSISnapshotValidationWebhook: {"*": "registry.k8s.io/sig-storage/snapshot-validation-webhook:v6.2.1"},
 		DigitalOceanCSISnapshotter:               {"*": "registry.k8s.io/sig-storage/csi-snapshotter:v6.2.1"},
 
 		// Hetzner CCM
-		HetznerCCM: {"*": "docker.io/hetznercloud/hcloud-cloud-controller-manager:v1.17.1"},
+		HetznerCCM: {"*": "docker.io/hetznercloud/hcloud-cloud-controller-manager:v1.19.0"},
 
 		// Hetzner CSI
 		HetznerCSI:                   {"*": "docker.io/hetznercloud/hcloud-csi-driver:v2.3.2"},
@@ -346,9 +384,8 @@ func optionalResources() map[Resource]map[string]string {
 			"1.26.x":    "registry.k8s.io/sig-storage/csi-resizer:v1.6.0",
 			">= 1.27.0": "registry.k8s.io/sig-storage/csi-resizer:v1.7.0",
 		},
-		OpenstackCSISnapshotter:        {"*": "registry.k8s.io/sig-storage/csi-snapshotter:v6.2.2"},
-		OpenstackCSISnapshotController: {"*": "registry.k8s.io/sig-storage/snapshot-controller:v6.2.2"},
-		OpenstackCSISnapshotWebhook:    {"*": "registry.k8s.io/sig-storage/snapshot-validation-webhook:v6.2.2"},
+		OpenstackCSISnapshotter:     {"*": "registry.k8s.io/sig-storage/csi-snapshotter:v6.2.2"},
+		OpenstackCSISnapshotWebhook: {"*": "registry.k8s.io/sig-storage/snapshot-validation-webhook:v6.2.2"},
 
 		// Equinix Metal CCM
 		EquinixMetalCCM: {"*": "ghcr.io/equinix/cloud-provider-equinix-metal:v3.6.2"},
@@ -376,9 +413,11 @@ func optionalResources() map[Resource]map[string]string {
 		VsphereCSIProvisioner:               {"*": "registry.k8s.io/sig-storage/csi-provisioner:v3.4.0"},
 		VsphereCSIResizer:                   {"*": "registry.k8s.io/sig-storage/csi-resizer:v1.7.0"},
 		VsphereCSISnapshotter:               {"*": "registry.k8s.io/sig-storage/csi-snapshotter:v6.2.1"},
-		VsphereCSISnapshotController:        {"*": "registry.k8s.io/sig-storage/snapshot-controller:v6.2.1"},
 		VsphereCSISnapshotValidationWebhook: {"*": "registry.k8s.io/sig-storage/snapshot-validation-webhook:v6.2.1"},
 
+		// Nutanix CCM
+		NutanixCCM: {"*": "ghcr.io/nutanix-cloud-native/cloud-provider-nutanix/controller:v0.3.1"},
+
 		// Nutanix CSI
 		NutanixCSI:                          {"*": "quay.io/karbon/ntnx-csi:v2.6.3"},
 		NutanixCSILivenessProbe:             {"*": "registry.k8s.io/sig-storage/livenessprobe:v2.10.0"},
@@ -386,16 +425,34 @@ func optionalResources() map[Resource]map[string]string {
 		NutanixCSIRegistrar:                 {"*": "registry.k8s.io/sig-storage/csi-node-driver-registrar:v2.8.0"},
 		NutanixCSIResizer:                   {"*": "registry.k8s.io/sig-storage/csi-resizer:v1.8.0"},
 		NutanixCSISnapshotter:               {"*": "registry.k8s.io/sig-storage/csi-snapshotter:v3.0.3"},
-		NutanixCSISnapshotController:        {"*": "registry.k8s.io/sig-storage/snapshot-controller:v6.2.1"},
 		NutanixCSISnapshotValidationWebhook: {"*": "registry.k8s.io/sig-storage/snapshot-validation-webhook:v6.2.1"},
 
+		// KubeVirt CCM
+		KubevirtCCM: {"*": "quay.io/kubevirt/kubevirt-cloud-controller-manager:v0.5.1"},
+
+		// KubeVirt CSI
+		KubevirtCSI:                    {"*": "quay.io/kubevirt/kubevirt-csi-driver:v0.2.0"},
+		KubevirtCSIAttacher:            {"*": "registry.k8s.io/sig-storage/csi-attacher:v4.3.0"},
+		KubevirtCSILivenessProbe:       {"*": "registry.k8s.io/sig-storage/livenessprobe:v2.10.0"},
+		KubevirtCSINodeDriverRegistrar: {"*": "registry.k8s.io/sig-storage/csi-node-driver-registrar:v2.8.0"},
+		KubevirtCSIProvisioner:         {"*": "registry.k8s.io/sig-storage/csi-provisioner:v3.5.0"},
+
+		// OCI CCM
+		OCICCM: {"*": "ghcr.io/oracle/cloud-provider-oci:v1.27.2"},
+
+		// OCI CSI
+		OCICSI:                    {"*": "ghcr.io/oracle/cloud-provider-oci:v1.27.2"},
+		OCICSIAttacher:            {"*": "registry.k8s.io/sig-storage/csi-attacher:v4.3.0"},
+		OCICSINodeDriverRegistrar: {"*": "registry.k8s.io/sig-storage/csi-node-driver-registrar:v2.8.0"},
+		OCICSIProvisioner:         {"*": "registry.k8s.io/sig-storage/csi-provisioner:v3.5.0"},
+		OCICSIResizer:             {"*": "registry.k8s.io/sig-storage/csi-resizer:v1.8.0"},
+
 		// GCP Compute Persistent Disk CSI
 		GCPComputeCSIDriver:                    {"*": "registry.k8s.io/cloud-provider-gcp/gcp-compute-persistent-disk-csi-driver:v1.10.1"},
 		GCPComputeCSIProvisioner:               {"*": "registry.k8s.io/sig-storage/csi-provisioner:v3.4.0"},
 		GCPComputeCSIAttacher:                  {"*": "registry.k8s.io/sig-storage/csi-attacher:v4.2.0"},
 		GCPComputeCSIResizer:                   {"*": "registry.k8s.io/sig-storage/csi-resizer:v1.7.0"},
 		GCPComputeCSISnapshotter:               {"*": "registry.k8s.io/sig-storage/csi-snapshotter:v6.1.0"},
-		GCPComputeCSISnapshotController:        {"*": "registry.k8s.io/sig-storage/snapshot-controller:v6.1.0"},
 		GCPComputeCSISnapshotValidationWebhook: {"*": "registry.k8s.io/sig-storage/snapshot-validation-webhook:v6.1.0"},
 		GCPComputeCSINodeDriverRegistrar:       {"*": "registry.k8s.io/sig-storage/csi-node-driver-registrar:v2.7.0"},
 
@@ -407,6 +464,10 @@ func optionalResources() map[Resource]map[string]string {
 		Cilium:         {"*": "quay.io/cilium/cilium:v1.14.3@sha256:e5ca22526e01469f8d10c14e2339a82a13ad70d9a359b879024715540eef4ace"},
 		CiliumOperator: {"*": "quay.io/cilium/operator-generic:v1.14.3@sha256:c9613277b72103ed36e9c0d16b9a17cafd507461d59340e432e3e9c23468b5e2"},
 
+		// Calico CNI
+		CalicoTypha:           {"*": "quay.io/calico/typha:v3.26.3"},
+		CalicoTyphaAutoscaler: {"*": "registry.k8s.io/cpa/cluster-proportional-autoscaler:v1.8.9"},
+
 		// Calico VXLAN
 		CalicoVXLANCNI:        {"*": "quay.io/calico/cni:v3.26.3"},
 		CalicoVXLANController: {"*": "quay.io/calico/kube-controllers:v3.26.3"},
@@ -426,6 +487,13 @@ func optionalResources() map[Resource]map[string]string {
 			">= 1.28.0": "registry.k8s.io/autoscaling/cluster-autoscaler:v1.28.0",
 		},
 
+		// NVIDIA device plugin addon
+		NvidiaDevicePlugin: {"*": "nvcr.io/nvidia/k8s-device-plugin:v0.14.3"},
+
+		// etcd backups
+		EtcdBackupsEtcdctl: {"*": "gcr.io/etcd-development/etcd:v3.5.11"},
+		EtcdBackupsRestic:  {"*": "docker.io/restic/restic:0.16.0"},
+
 		// CSI Vault Secret Provider
 		CSIVaultSecretProvider: {"*": "docker.io/hashicorp/vault-csi-provider:1.1.0"},
 
@@ -434,9 +502,59 @@ func optionalResources() map[Resource]map[string]string {
 		SecretStoreCSIDriver:              {"*": "registry.k8s.io/csi-secrets-store/driver:v1.2.1"},
 		SecretStoreCSIDriverLivenessProbe: {"*": "registry.k8s.io/sig-storage/livenessprobe:v2.7.0"},
 		SecretStoreCSIDriverCRDs:          {"*": "registry.k8s.io/csi-secrets-store/driver-crds:v1.2.1"},
+
+		// MetalLB
+		MetalLBController: {"*": "quay.io/metallb/controller:v0.13.12"},
+		MetalLBSpeaker:    {"*": "quay.io/metallb/speaker:v0.13.12"},
+		MetalLBBGPRoutes:  {"*": "docker.io/alpine:3"},
+
+		// Istio
+		IstioPilot:      {"*": "docker.io/istio/pilot:1.20.0"},
+		IstioInstallCNI: {"*": "docker.io/istio/install-cni:1.20.0"},
+		IstioZtunnel:    {"*": "docker.io/istio/ztunnel:1.20.0"},
+
+		// kube-vip
+		KubeVIP: {"*": "ghcr.io/kube-vip/kube-vip:v0.6.4"},
+
+		// CSI snapshot controller, shared by the CSI drivers supporting
+		// snapshots
+		CSISnapshotController: {"*": "registry.k8s.io/sig-storage/snapshot-controller:v6.3.3"},
+
+		// Local storage, TopoLVM image is bundled with the CSI sidecars
+		LocalPathProvisioner:       {"*": "docker.io/rancher/local-path-provisioner:v0.0.26"},
+		LocalPathProvisionerHelper: {"*": "docker.io/library/busybox:1.36"},
+		TopoLVM:                    {"*": "ghcr.io/topolvm/topolvm-with-sidecar:0.24.0"},
+
+		// ingress-nginx
+		IngressNginxController: {"*": "registry.k8s.io/ingress-nginx/controller:v1.9.4"},
+	}
+}
+
+// amd64OnlyResources is a set of images that are published only for the
+// amd64 architecture. Workloads using those images must not be scheduled on
+// nodes with other architectures (e.g. arm64).
+func amd64OnlyResources() map[Resource]bool {
+	return map[Resource]bool{
+		NutanixCSI:             true,
+		VMwareCloudDirectorCSI: true,
+		VsphereCSIDriver:       true,
+		VsphereCSISyncer:       true,
 	}
 }
 
+// AMD64OnlyResources returns images that are published only for the amd64
+// architecture
+func AMD64OnlyResources() []Resource {
+	var list []Resource
+	for res := range amd64OnlyResources() {
+		list = append(list, res)
+	}
+
+	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
+
+	return list
+}
+
 func allResources() map[Resource]map[string]string {
 	ret := map[Resource]map[string]string{}
 	for k, v := range baseResources() {
@@ -546,6 +664,27 @@ func WithTag(tag string) GetOpt {
 	}
 }
 
+// WithRepository replaces the registry and the path of the image, keeping its
+// tag and digest
+func WithRepository(repository string) GetOpt {
+	return func(ref string) string {
+		named, _ := reference.ParseNormalizedNamed(ref)
+
+		ret := repository
+		if tagged, ok := named.(reference.Tagged); ok {
+			ret += ":" + tagged.Tag()
+		}
+		if digested, ok := named.(reference.Digested); ok {
+			ret += "@" + digested.Digest().String()
+		}
+		if ret == repository {
+			ret += ":latest"
+		}
+
+		return ret
+	}
+}
+
 func (r *Resolver) Get(res Resource, opts ...GetOpt) string {
 	named := res.namedReference(r.kubernetesVersionGetter)
 	if named == nil {
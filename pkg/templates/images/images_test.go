@@ -0,0 +1,105 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package images
+
+import (
+	"testing"
+)
+
+const testDigest = "sha256:e5ca22526e01469f8d10c14e2339a82a13ad70d9a359b879024715540eef4ace"
+
+func TestWithRepository(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		repository string
+		ref        string
+		want       string
+	}{
+		{
+			name:       "tagged image",
+			repository: "my.registry.io/ccm/aws",
+			ref:        "registry.k8s.io/provider-aws/cloud-controller-manager:v1.28.1",
+			want:       "my.registry.io/ccm/aws:v1.28.1",
+		},
+		{
+			name:       "tagged image with digest",
+			repository: "my.registry.io/ccm/aws",
+			ref:        "registry.k8s.io/provider-aws/cloud-controller-manager:v1.28.1@" + testDigest,
+			want:       "my.registry.io/ccm/aws:v1.28.1@" + testDigest,
+		},
+		{
+			name:       "image with digest only",
+			repository: "my.registry.io/ccm/aws",
+			ref:        "registry.k8s.io/provider-aws/cloud-controller-manager@" + testDigest,
+			want:       "my.registry.io/ccm/aws@" + testDigest,
+		},
+		{
+			name:       "image without tag",
+			repository: "my.registry.io/ccm/aws",
+			ref:        "registry.k8s.io/provider-aws/cloud-controller-manager",
+			want:       "my.registry.io/ccm/aws:latest",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := WithRepository(tt.repository)(tt.ref); got != tt.want {
+				t.Errorf("WithRepository(%q)(%q) = %q, want %q", tt.repository, tt.ref, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithTag(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		tag  string
+		ref  string
+		want string
+	}{
+		{
+			name: "tagged image",
+			tag:  "v1.29.0",
+			ref:  "registry.k8s.io/provider-aws/cloud-controller-manager:v1.28.1",
+			want: "registry.k8s.io/provider-aws/cloud-controller-manager:v1.29.0",
+		},
+		{
+			name: "digest doesn't match the new tag",
+			tag:  "v1.29.0",
+			ref:  "registry.k8s.io/provider-aws/cloud-controller-manager:v1.28.1@" + testDigest,
+			want: "registry.k8s.io/provider-aws/cloud-controller-manager:v1.29.0",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := WithTag(tt.tag)(tt.ref); got != tt.want {
+				t.Errorf("WithTag(%q)(%q) = %q, want %q", tt.tag, tt.ref, got, tt.want)
+			}
+		})
+	}
+}
@@ -12,118 +12,140 @@ func _() {
 	_ = x[CalicoController-2]
 	_ = x[CalicoNode-3]
 	_ = x[Flannel-4]
-	_ = x[Cilium-5]
-	_ = x[CiliumOperator-6]
-	_ = x[HubbleRelay-7]
-	_ = x[HubbleUI-8]
-	_ = x[HubbleUIBackend-9]
-	_ = x[CiliumCertGen-10]
-	_ = x[WeaveNetCNIKube-11]
-	_ = x[WeaveNetCNINPC-12]
-	_ = x[DNSNodeCache-13]
-	_ = x[MachineController-14]
-	_ = x[MetricsServer-15]
-	_ = x[OperatingSystemManager-16]
-	_ = x[ClusterAutoscaler-17]
-	_ = x[AwsCCM-18]
-	_ = x[AzureCCM-19]
-	_ = x[AzureCNM-20]
-	_ = x[AwsEbsCSI-21]
-	_ = x[AwsEbsCSIAttacher-22]
-	_ = x[AwsEbsCSILivenessProbe-23]
-	_ = x[AwsEbsCSINodeDriverRegistrar-24]
-	_ = x[AwsEbsCSIProvisioner-25]
-	_ = x[AwsEbsCSIResizer-26]
-	_ = x[AwsEbsCSISnapshotter-27]
-	_ = x[AwsEbsCSISnapshotController-28]
-	_ = x[AzureFileCSI-29]
-	_ = x[AzureFileCSIAttacher-30]
-	_ = x[AzureFileCSILivenessProbe-31]
-	_ = x[AzureFileCSINodeDriverRegistar-32]
-	_ = x[AzureFileCSIProvisioner-33]
-	_ = x[AzureFileCSIResizer-34]
-	_ = x[AzureFileCSISnapshotter-35]
-	_ = x[AzureFileCSISnapshotterController-36]
-	_ = x[AzureDiskCSI-37]
-	_ = x[AzureDiskCSIAttacher-38]
-	_ = x[AzureDiskCSILivenessProbe-39]
-	_ = x[AzureDiskCSINodeDriverRegistar-40]
-	_ = x[AzureDiskCSIProvisioner-41]
-	_ = x[AzureDiskCSIResizer-42]
-	_ = x[AzureDiskCSISnapshotter-43]
-	_ = x[AzureDiskCSISnapshotterController-44]
+	_ = x[CalicoTypha-5]
+	_ = x[CalicoTyphaAutoscaler-6]
+	_ = x[Cilium-7]
+	_ = x[CiliumOperator-8]
+	_ = x[HubbleRelay-9]
+	_ = x[HubbleUI-10]
+	_ = x[HubbleUIBackend-11]
+	_ = x[CiliumCertGen-12]
+	_ = x[WeaveNetCNIKube-13]
+	_ = x[WeaveNetCNINPC-14]
+	_ = x[DNSNodeCache-15]
+	_ = x[MachineController-16]
+	_ = x[MetricsServer-17]
+	_ = x[OperatingSystemManager-18]
+	_ = x[ClusterAutoscaler-19]
+	_ = x[NvidiaDevicePlugin-20]
+	_ = x[AwsCCM-21]
+	_ = x[AzureCCM-22]
+	_ = x[AzureCNM-23]
+	_ = x[AwsEbsCSI-24]
+	_ = x[AwsEbsCSIAttacher-25]
+	_ = x[AwsEbsCSILivenessProbe-26]
+	_ = x[AwsEbsCSINodeDriverRegistrar-27]
+	_ = x[AwsEbsCSIProvisioner-28]
+	_ = x[AwsEbsCSIResizer-29]
+	_ = x[AwsEbsCSISnapshotter-30]
+	_ = x[AzureFileCSI-31]
+	_ = x[AzureFileCSIAttacher-32]
+	_ = x[AzureFileCSILivenessProbe-33]
+	_ = x[AzureFileCSINodeDriverRegistar-34]
+	_ = x[AzureFileCSIProvisioner-35]
+	_ = x[AzureFileCSIResizer-36]
+	_ = x[AzureFileCSISnapshotter-37]
+	_ = x[AzureDiskCSI-38]
+	_ = x[AzureDiskCSIAttacher-39]
+	_ = x[AzureDiskCSILivenessProbe-40]
+	_ = x[AzureDiskCSINodeDriverRegistar-41]
+	_ = x[AzureDiskCSIProvisioner-42]
+	_ = x[AzureDiskCSIResizer-43]
+	_ = x[AzureDiskCSISnapshotter-44]
 	_ = x[NutanixCSILivenessProbe-45]
 	_ = x[NutanixCSI-46]
 	_ = x[NutanixCSIProvisioner-47]
 	_ = x[NutanixCSIRegistrar-48]
 	_ = x[NutanixCSIResizer-49]
 	_ = x[NutanixCSISnapshotter-50]
-	_ = x[NutanixCSISnapshotController-51]
-	_ = x[NutanixCSISnapshotValidationWebhook-52]
-	_ = x[DigitalOceanCSI-53]
-	_ = x[DigitalOceanCSIAlpine-54]
-	_ = x[DigitalOceanCSIAttacher-55]
-	_ = x[DigitalOceanCSINodeDriverRegistar-56]
-	_ = x[DigitalOceanCSIProvisioner-57]
-	_ = x[DigitalOceanCSIResizer-58]
-	_ = x[DigitalOceanCSISnapshotController-59]
-	_ = x[DigitalOceanCSISnapshotValidationWebhook-60]
-	_ = x[DigitalOceanCSISnapshotter-61]
-	_ = x[OpenstackCSI-62]
-	_ = x[OpenstackCSINodeDriverRegistar-63]
-	_ = x[OpenstackCSILivenessProbe-64]
-	_ = x[OpenstackCSIAttacher-65]
-	_ = x[OpenstackCSIProvisioner-66]
-	_ = x[OpenstackCSIResizer-67]
-	_ = x[OpenstackCSISnapshotter-68]
-	_ = x[OpenstackCSISnapshotController-69]
-	_ = x[OpenstackCSISnapshotWebhook-70]
-	_ = x[HetznerCSI-71]
-	_ = x[HetznerCSIAttacher-72]
-	_ = x[HetznerCSIResizer-73]
-	_ = x[HetznerCSIProvisioner-74]
-	_ = x[HetznerCSILivenessProbe-75]
-	_ = x[HetznerCSINodeDriverRegistar-76]
-	_ = x[DigitaloceanCCM-77]
-	_ = x[HetznerCCM-78]
-	_ = x[OpenstackCCM-79]
-	_ = x[EquinixMetalCCM-80]
-	_ = x[VsphereCCM-81]
-	_ = x[CSIVaultSecretProvider-82]
-	_ = x[SecretStoreCSIDriverNodeRegistrar-83]
-	_ = x[SecretStoreCSIDriver-84]
-	_ = x[SecretStoreCSIDriverLivenessProbe-85]
-	_ = x[SecretStoreCSIDriverCRDs-86]
-	_ = x[VMwareCloudDirectorCSI-87]
-	_ = x[VMwareCloudDirectorCSIAttacher-88]
-	_ = x[VMwareCloudDirectorCSIProvisioner-89]
-	_ = x[VMwareCloudDirectorCSINodeDriverRegistrar-90]
-	_ = x[VsphereCSIDriver-91]
-	_ = x[VsphereCSISyncer-92]
-	_ = x[VsphereCSIAttacher-93]
-	_ = x[VsphereCSILivenessProbe-94]
-	_ = x[VsphereCSINodeDriverRegistar-95]
-	_ = x[VsphereCSIProvisioner-96]
-	_ = x[VsphereCSIResizer-97]
-	_ = x[VsphereCSISnapshotter-98]
-	_ = x[VsphereCSISnapshotController-99]
-	_ = x[VsphereCSISnapshotValidationWebhook-100]
-	_ = x[GCPComputeCSIDriver-101]
-	_ = x[GCPComputeCSIProvisioner-102]
-	_ = x[GCPComputeCSIAttacher-103]
-	_ = x[GCPComputeCSIResizer-104]
-	_ = x[GCPComputeCSISnapshotter-105]
-	_ = x[GCPComputeCSISnapshotController-106]
-	_ = x[GCPComputeCSISnapshotValidationWebhook-107]
-	_ = x[GCPComputeCSINodeDriverRegistrar-108]
-	_ = x[CalicoVXLANCNI-109]
-	_ = x[CalicoVXLANController-110]
-	_ = x[CalicoVXLANNode-111]
+	_ = x[NutanixCSISnapshotValidationWebhook-51]
+	_ = x[KubevirtCSI-52]
+	_ = x[KubevirtCSIAttacher-53]
+	_ = x[KubevirtCSILivenessProbe-54]
+	_ = x[KubevirtCSINodeDriverRegistrar-55]
+	_ = x[KubevirtCSIProvisioner-56]
+	_ = x[OCICSI-57]
+	_ = x[OCICSIAttacher-58]
+	_ = x[OCICSINodeDriverRegistrar-59]
+	_ = x[OCICSIProvisioner-60]
+	_ = x[OCICSIResizer-61]
+	_ = x[DigitalOceanCSI-62]
+	_ = x[DigitalOceanCSIAlpine-63]
+	_ = x[DigitalOceanCSIAttacher-64]
+	_ = x[DigitalOceanCSINodeDriverRegistar-65]
+	_ = x[DigitalOceanCSIProvisioner-66]
+	_ = x[DigitalOceanCSIResizer-67]
+	_ = x[DigitalOceanCSISnapshotValidationWebhook-68]
+	_ = x[DigitalOceanCSISnapshotter-69]
+	_ = x[OpenstackCSI-70]
+	_ = x[OpenstackCSINodeDriverRegistar-71]
+	_ = x[OpenstackCSILivenessProbe-72]
+	_ = x[OpenstackCSIAttacher-73]
+	_ = x[OpenstackCSIProvisioner-74]
+	_ = x[OpenstackCSIResizer-75]
+	_ = x[OpenstackCSISnapshotter-76]
+	_ = x[OpenstackCSISnapshotWebhook-77]
+	_ = x[HetznerCSI-78]
+	_ = x[HetznerCSIAttacher-79]
+	_ = x[HetznerCSIResizer-80]
+	_ = x[HetznerCSIProvisioner-81]
+	_ = x[HetznerCSILivenessProbe-82]
+	_ = x[HetznerCSINodeDriverRegistar-83]
+	_ = x[DigitaloceanCCM-84]
+	_ = x[HetznerCCM-85]
+	_ = x[OpenstackCCM-86]
+	_ = x[EquinixMetalCCM-87]
+	_ = x[VsphereCCM-88]
+	_ = x[NutanixCCM-89]
+	_ = x[OCICCM-90]
+	_ = x[KubevirtCCM-91]
+	_ = x[CSIVaultSecretProvider-92]
+	_ = x[SecretStoreCSIDriverNodeRegistrar-93]
+	_ = x[SecretStoreCSIDriver-94]
+	_ = x[SecretStoreCSIDriverLivenessProbe-95]
+	_ = x[SecretStoreCSIDriverCRDs-96]
+	_ = x[VMwareCloudDirectorCSI-97]
+	_ = x[VMwareCloudDirectorCSIAttacher-98]
+	_ = x[VMwareCloudDirectorCSIProvisioner-99]
+	_ = x[VMwareCloudDirectorCSINodeDriverRegistrar-100]
+	_ = x[VsphereCSIDriver-101]
+	_ = x[VsphereCSISyncer-102]
+	_ = x[VsphereCSIAttacher-103]
+	_ = x[VsphereCSILivenessProbe-104]
+	_ = x[VsphereCSINodeDriverRegistar-105]
+	_ = x[VsphereCSIProvisioner-106]
+	_ = x[VsphereCSIResizer-107]
+	_ = x[VsphereCSISnapshotter-108]
+	_ = x[VsphereCSISnapshotValidationWebhook-109]
+	_ = x[GCPComputeCSIDriver-110]
+	_ = x[GCPComputeCSIProvisioner-111]
+	_ = x[GCPComputeCSIAttacher-112]
+	_ = x[GCPComputeCSIResizer-113]
+	_ = x[GCPComputeCSISnapshotter-114]
+	_ = x[GCPComputeCSISnapshotValidationWebhook-115]
+	_ = x[GCPComputeCSINodeDriverRegistrar-116]
+	_ = x[CalicoVXLANCNI-117]
+	_ = x[CalicoVXLANController-118]
+	_ = x[CalicoVXLANNode-119]
+	_ = x[EtcdBackupsEtcdctl-120]
+	_ = x[EtcdBackupsRestic-121]
+	_ = x[MetalLBController-122]
+	_ = x[MetalLBSpeaker-123]
+	_ = x[MetalLBBGPRoutes-124]
+	_ = x[IstioPilot-125]
+	_ = x[IstioInstallCNI-126]
+	_ = x[IstioZtunnel-127]
+	_ = x[KubeVIP-128]
+	_ = x[CSISnapshotController-129]
+	_ = x[LocalPathProvisioner-130]
+	_ = x[LocalPathProvisionerHelper-131]
+	_ = x[TopoLVM-132]
+	_ = x[IngressNginxController-133]
 }
 
-const _Resource_name = "CalicoCNICalicoControllerCalicoNodeFlannelCiliumCiliumOperatorHubbleRelayHubbleUIHubbleUIBackendCiliumCertGenWeaveNetCNIKubeWeaveNetCNINPCDNSNodeCacheMachineControllerMetricsServerOperatingSystemManagerClusterAutoscalerAwsCCMAzureCCMAzureCNMAwsEbsCSIAwsEbsCSIAttacherAwsEbsCSILivenessProbeAwsEbsCSINodeDriverRegistrarAwsEbsCSIProvisionerAwsEbsCSIResizerAwsEbsCSISnapshotterAwsEbsCSISnapshotControllerAzureFileCSIAzureFileCSIAttacherAzureFileCSILivenessProbeAzureFileCSINodeDriverRegistarAzureFileCSIProvisionerAzureFileCSIResizerAzureFileCSISnapshotterAzureFileCSISnapshotterControllerAzureDiskCSIAzureDiskCSIAttacherAzureDiskCSILivenessProbeAzureDiskCSINodeDriverRegistarAzureDiskCSIProvisionerAzureDiskCSIResizerAzureDiskCSISnapshotterAzureDiskCSISnapshotterControllerNutanixCSILivenessProbeNutanixCSINutanixCSIProvisionerNutanixCSIRegistrarNutanixCSIResizerNutanixCSISnapshotterNutanixCSISnapshotControllerNutanixCSISnapshotValidationWebhookDigitalOceanCSIDigitalOceanCSIAlpineDigitalOceanCSIAttacherDigitalOceanCSINodeDriverRegistarDigitalOceanCSIProvisionerDigitalOceanCSIResizerDigitalOceanCSISnapshotControllerDigitalOceanCSISnapshotValidationWebhookDigitalOceanCSISnapshotterOpenstackCSIOpenstackCSINodeDriverRegistarOpenstackCSILivenessProbeOpenstackCSIAttacherOpenstackCSIProvisionerOpenstackCSIResizerOpenstackCSISnapshotterOpenstackCSISnapshotControllerOpenstackCSISnapshotWebhookHetznerCSIHetznerCSIAttacherHetznerCSIResizerHetznerCSIProvisionerHetznerCSILivenessProbeHetznerCSINodeDriverRegistarDigitaloceanCCMHetznerCCMOpenstackCCMEquinixMetalCCMVsphereCCMCSIVaultSecretProviderSecretStoreCSIDriverNodeRegistrarSecretStoreCSIDriverSecretStoreCSIDriverLivenessProbeSecretStoreCSIDriverCRDsVMwareCloudDirectorCSIVMwareCloudDirectorCSIAttacherVMwareCloudDirectorCSIProvisionerVMwareCloudDirectorCSINodeDriverRegistrarVsphereCSIDriverVsphereCSISyncerVsphereCSIAttacherVsphereCSILivenessProbeVsphereCSINodeDriverRegistarVsphereCSIProvisionerVsphereCSIResizerVsphereCSISnapshotterVsphereCSISnapshotControllerVsphereCSISnapshotValidationWebhookGCPComputeCSIDriverGCPComputeCSIProvisionerGCPComputeCSIAttacherGCPComputeCSIResizerGCPComputeCSISnapshotterGCPComputeCSISnapshotControllerGCPComputeCSISnapshotValidationWebhookGCPComputeCSINodeDriverRegistrarCalicoVXLANCNICalicoVXLANControllerCalicoVXLANNode"
+const _Resource_name = "CalicoCNICalicoControllerCalicoNodeFlannelCalicoTyphaCalicoTyphaAutoscalerCiliumCiliumOperatorHubbleRelayHubbleUIHubbleUIBackendCiliumCertGenWeaveNetCNIKubeWeaveNetCNINPCDNSNodeCacheMachineControllerMetricsServerOperatingSystemManagerClusterAutoscalerNvidiaDevicePluginAwsCCMAzureCCMAzureCNMAwsEbsCSIAwsEbsCSIAttacherAwsEbsCSILivenessProbeAwsEbsCSINodeDriverRegistrarAwsEbsCSIProvisionerAwsEbsCSIResizerAwsEbsCSISnapshotterAzureFileCSIAzureFileCSIAttacherAzureFileCSILivenessProbeAzureFileCSINodeDriverRegistarAzureFileCSIProvisionerAzureFileCSIResizerAzureFileCSISnapshotterAzureDiskCSIAzureDiskCSIAttacherAzureDiskCSILivenessProbeAzureDiskCSINodeDriverRegistarAzureDiskCSIProvisionerAzureDiskCSIResizerAzureDiskCSISnapshotterNutanixCSILivenessProbeNutanixCSINutanixCSIProvisionerNutanixCSIRegistrarNutanixCSIResizerNutanixCSISnapshotterNutanixCSISnapshotValidationWebhookKubevirtCSIKubevirtCSIAttacherKubevirtCSILivenessProbeKubevirtCSINodeDriverRegistrarKubevirtCSIProvisionerOCICSIOCICSIAttacherOCICSINodeDriverRegistrarOCICSIProvisionerOCICSIResizerDigitalOceanCSIDigitalOceanCSIAlpineDigitalOceanCSIAttacherDigitalOceanCSINodeDriverRegistarDigitalOceanCSIProvisionerDigitalOceanCSIResizerDigitalOceanCSISnapshotValidationWebhookDigitalOceanCSISnapshotterOpenstackCSIOpenstackCSINodeDriverRegistarOpenstackCSILivenessProbeOpenstackCSIAttacherOpenstackCSIProvisionerOpenstackCSIResizerOpenstackCSISnapshotterOpenstackCSISnapshotWebhookHetznerCSIHetznerCSIAttacherHetznerCSIResizerHetznerCSIProvisionerHetznerCSILivenessProbeHetznerCSINodeDriverRegistarDigitaloceanCCMHetznerCCMOpenstackCCMEquinixMetalCCMVsphereCCMNutanixCCMOCICCMKubevirtCCMCSIVaultSecretProviderSecretStoreCSIDriverNodeRegistrarSecretStoreCSIDriverSecretStoreCSIDriverLivenessProbeSecretStoreCSIDriverCRDsVMwareCloudDirectorCSIVMwareCloudDirectorCSIAttacherVMwareCloudDirectorCSIProvisionerVMwareCloudDirectorCSINodeDriverRegistrarVsphereCSIDriverVsphereCSISyncerVsphereCSIAttacherVsphereCSILivenessProbeVsphereCSINodeDriverRegistarVsphereCSIProvisionerVsphereCSIResizerVsphereCSISnapshotterVsphereCSISnapshotValidationWebhookGCPComputeCSIDriverGCPComputeCSIProvisionerGCPComputeCSIAttacherGCPComputeCSIResizerGCPComputeCSISnapshotterGCPComputeCSISnapshotValidationWebhookGCPComputeCSINodeDriverRegistrarCalicoVXLANCNICalicoVXLANControllerCalicoVXLANNodeEtcdBackupsEtcdctlEtcdBackupsResticMetalLBControllerMetalLBSpeakerMetalLBBGPRoutesIstioPilotIstioInstallCNIIstioZtunnelKubeVIPCSISnapshotControllerLocalPathProvisionerLocalPathProvisionerHelperTopoLVMIngressNginxController"
 
-var _Resource_index = [...]uint16{0, 9, 25, 35, 42, 48, 62, 73, 81, 96, 109, 124, 138, 150, 167, 180, 202, 219, 225, 233, 241, 250, 267, 289, 317, 337, 353, 373, 400, 412, 432, 457, 487, 510, 529, 552, 585, 597, 617, 642, 672, 695, 714, 737, 770, 793, 803, 824, 843, 860, 881, 909, 944, 959, 980, 1003, 1036, 1062, 1084, 1117, 1157, 1183, 1195, 1225, 1250, 1270, 1293, 1312, 1335, 1365, 1392, 1402, 1420, 1437, 1458, 1481, 1509, 1524, 1534, 1546, 1561, 1571, 1593, 1626, 1646, 1679, 1703, 1725, 1755, 1788, 1829, 1845, 1861, 1879, 1902, 1930, 1951, 1968, 1989, 2017, 2052, 2071, 2095, 2116, 2136, 2160, 2191, 2229, 2261, 2275, 2296, 2311}
+var _Resource_index = [...]uint16{0, 9, 25, 35, 42, 53, 74, 80, 94, 105, 113, 128, 141, 156, 170, 182, 199, 212, 234, 251, 269, 275, 283, 291, 300, 317, 339, 367, 387, 403, 423, 435, 455, 480, 510, 533, 552, 575, 587, 607, 632, 662, 685, 704, 727, 750, 760, 781, 800, 817, 838, 873, 884, 903, 927, 957, 979, 985, 999, 1024, 1041, 1054, 1069, 1090, 1113, 1146, 1172, 1194, 1234, 1260, 1272, 1302, 1327, 1347, 1370, 1389, 1412, 1439, 1449, 1467, 1484, 1505, 1528, 1556, 1571, 1581, 1593, 1608, 1618, 1628, 1634, 1645, 1667, 1700, 1720, 1753, 1777, 1799, 1829, 1862, 1903, 1919, 1935, 1953, 1976, 2004, 2025, 2042, 2063, 2098, 2117, 2141, 2162, 2182, 2206, 2244, 2276, 2290, 2311, 2326, 2344, 2361, 2378, 2392, 2408, 2418, 2433, 2445, 2452, 2473, 2493, 2519, 2526, 2548}
 
 func (i Resource) String() string {
 	i -= 1
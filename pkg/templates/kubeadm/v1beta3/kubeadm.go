@@ -18,6 +18,7 @@ package v1beta3
 
 import (
 	"fmt"
+	"net"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -32,6 +33,7 @@ import (
 	"k8c.io/kubeone/pkg/fail"
 	"k8c.io/kubeone/pkg/features"
 	"k8c.io/kubeone/pkg/kubeflags"
+	"k8c.io/kubeone/pkg/scripts"
 	"k8c.io/kubeone/pkg/semverutil"
 	"k8c.io/kubeone/pkg/state"
 	"k8c.io/kubeone/pkg/templates/kubeadm/kubeadmargs"
@@ -96,20 +98,17 @@ func NewConfig(s *state.State, host kubeoneapi.HostConfig) ([]runtime.Object, er
 		"DirAvailable--etc-kubernetes-manifests",
 		"ImagePull",
 	}
+	if cluster.Features.SwapEnabled() {
+		nodeRegistration.IgnorePreflightErrors = append(nodeRegistration.IgnorePreflightErrors, "Swap")
+	}
 
 	bootstrapToken, err := bootstraptokenv1.NewBootstrapTokenString(s.JoinToken)
 	if err != nil {
 		return nil, fail.Runtime(err, "generating kubeadm bootstrap token")
 	}
 
-	controlPlaneEndpoint := fmt.Sprintf("%s:%d", cluster.APIEndpoint.Host, cluster.APIEndpoint.Port)
-
-	var advertiseAddress string
-	if s.Cluster.ClusterNetwork.IPFamily.IsIPv6Primary() {
-		advertiseAddress = host.IPv6Addresses[0]
-	} else {
-		advertiseAddress = newNodeIP(host)
-	}
+	controlPlaneEndpoint := net.JoinHostPort(cluster.APIEndpoint.Host, strconv.Itoa(cluster.APIEndpoint.Port))
+	advertiseAddress := cluster.ClusterNetwork.NodeIP(host)
 
 	initConfig := &kubeadmv1beta3.InitConfiguration{
 		TypeMeta: metav1.TypeMeta{
@@ -155,24 +154,26 @@ func NewConfig(s *state.State, host kubeoneapi.HostConfig) ([]runtime.Object, er
 		},
 	}
 
-	certSANS := certificate.GetCertificateSANs(cluster.APIEndpoint.Host, cluster.APIEndpoint.AlternativeNames)
+	if cluster.KubeadmPatchesEnabled() {
+		initConfig.Patches = &kubeadmv1beta3.Patches{Directory: scripts.KubeadmPatchesDir}
+		joinConfig.Patches = &kubeadmv1beta3.Patches{Directory: scripts.KubeadmPatchesDir}
+	}
+
+	alternativeNames := append([]string{}, cluster.APIEndpoint.AlternativeNames...)
+	for _, endpoint := range cluster.APIEndpoint.AdditionalEndpoints {
+		alternativeNames = append(alternativeNames, endpoint.Host)
+	}
+
+	certSANS := certificate.GetCertificateSANs(cluster.APIEndpoint.Host, alternativeNames)
 	clusterConfig := &kubeadmv1beta3.ClusterConfiguration{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "kubeadm.k8s.io/v1beta3",
 			Kind:       "ClusterConfiguration",
 		},
 		Networking: kubeadmv1beta3.Networking{
-			PodSubnet: join(
-				cluster.ClusterNetwork.IPFamily,
-				cluster.ClusterNetwork.PodSubnet,
-				cluster.ClusterNetwork.PodSubnetIPv6,
-			),
-			ServiceSubnet: join(
-				cluster.ClusterNetwork.IPFamily,
-				cluster.ClusterNetwork.ServiceSubnet,
-				cluster.ClusterNetwork.ServiceSubnetIPv6,
-			),
-			DNSDomain: cluster.ClusterNetwork.ServiceDomainName,
+			PodSubnet:     strings.Join(cluster.ClusterNetwork.PodSubnets(), ","),
+			ServiceSubnet: strings.Join(cluster.ClusterNetwork.ServiceSubnets(), ","),
+			DNSDomain:     cluster.ClusterNetwork.ServiceDomainName,
 		},
 		KubernetesVersion:    cluster.Versions.Kubernetes,
 		ControlPlaneEndpoint: controlPlaneEndpoint,
@@ -224,16 +225,11 @@ func NewConfig(s *state.State, host kubeoneapi.HostConfig) ([]runtime.Object, er
 		},
 	}
 
-	if cluster.AssetConfiguration.Pause.ImageRepository != "" {
-		nodeRegistration.KubeletExtraArgs["pod-infra-container-image"] = cluster.AssetConfiguration.Pause.ImageRepository + "/pause:" + cluster.AssetConfiguration.Pause.ImageTag
-	} else {
-		sandboxImage, serr := cluster.Versions.SandboxImage(cluster.RegistryConfiguration.ImageRegistry)
-		if serr != nil {
-			return nil, serr
-		}
-
-		nodeRegistration.KubeletExtraArgs["pod-infra-container-image"] = sandboxImage
+	sandboxImage, err := cluster.SandboxImage()
+	if err != nil {
+		return nil, err
 	}
+	nodeRegistration.KubeletExtraArgs["pod-infra-container-image"] = sandboxImage
 
 	if s.ShouldEnableInTreeCloudProvider() {
 		renderedCloudConfig := "/etc/kubernetes/cloud-config"
@@ -263,6 +259,9 @@ func NewConfig(s *state.State, host kubeoneapi.HostConfig) ([]runtime.Object, er
 			clusterConfig.ControllerManager.ExtraArgs["configure-cloud-routes"] = "false"
 		case cluster.CloudProvider.AWS != nil:
 			clusterConfig.ControllerManager.ExtraArgs["configure-cloud-routes"] = "false"
+		case cluster.CloudProvider.GCE != nil && cluster.CloudProvider.GCE.SharedVPC != nil:
+			// routes can't be created in the Shared VPC host project with the service project credentials
+			clusterConfig.ControllerManager.ExtraArgs["configure-cloud-routes"] = "false"
 		}
 	}
 
@@ -373,6 +372,12 @@ func NewConfig(s *state.State, host kubeoneapi.HostConfig) ([]runtime.Object, er
 	clusterConfig.APIServer.ExtraArgs = args.APIServer.ExtraArgs
 	clusterConfig.FeatureGates = args.FeatureGates
 
+	if cpc := cluster.ControlPlaneComponents; cpc != nil {
+		applyControlPlaneComponentConfig(&clusterConfig.APIServer.ControlPlaneComponent, cpc.APIServer)
+		applyControlPlaneComponentConfig(&clusterConfig.ControllerManager, cpc.ControllerManager)
+		applyControlPlaneComponentConfig(&clusterConfig.Scheduler, cpc.Scheduler)
+	}
+
 	initConfig.NodeRegistration = nodeRegistration
 	joinConfig.NodeRegistration = nodeRegistration
 
@@ -389,8 +394,50 @@ func NewConfig(s *state.State, host kubeoneapi.HostConfig) ([]runtime.Object, er
 	return []runtime.Object{initConfig, joinConfig, clusterConfig, kubeletConfig, kubeproxyConfig}, nil
 }
 
+// applyControlPlaneComponentConfig merges the user provided flags, feature gates and extra volumes into the
+// kubeadm control plane component configuration. User provided flags take precedence over the ones set by KubeOne.
+func applyControlPlaneComponentConfig(component *kubeadmv1beta3.ControlPlaneComponent, config *kubeoneapi.ControlPlaneComponentConfig) {
+	if config == nil {
+		return
+	}
+
+	if component.ExtraArgs == nil {
+		component.ExtraArgs = map[string]string{}
+	}
+
+	for k, v := range config.Flags {
+		component.ExtraArgs[k] = v
+	}
+
+	if len(config.FeatureGates) > 0 {
+		featureGatesFlag := kubeoneapi.MarshalFeatureGates(config.FeatureGates)
+		if fg, ok := component.ExtraArgs["feature-gates"]; ok && len(fg) > 0 {
+			component.ExtraArgs["feature-gates"] = fmt.Sprintf("%s,%s", fg, featureGatesFlag)
+		} else {
+			component.ExtraArgs["feature-gates"] = featureGatesFlag
+		}
+	}
+
+	for _, vol := range config.ExtraVolumes {
+		component.ExtraVolumes = append(component.ExtraVolumes, kubeadmv1beta3.HostPathMount{
+			Name:      vol.Name,
+			HostPath:  vol.HostPath,
+			MountPath: vol.MountPath,
+			ReadOnly:  vol.ReadOnly,
+			PathType:  vol.PathType,
+		})
+	}
+}
+
 func addControllerManagerNetworkArgs(m map[string]string, clusterNetwork kubeoneapi.ClusterNetworkConfig) {
-	if clusterNetwork.CNI.Cilium != nil {
+	if clusterNetwork.AllocateNodeCIDRs != nil && !*clusterNetwork.AllocateNodeCIDRs {
+		m["allocate-node-cidrs"] = "false"
+
+		return
+	}
+
+	// cilium allocates the pod CIDRs of the nodes, unless the kubernetes IPAM mode is used
+	if clusterNetwork.CNI.Cilium != nil && clusterNetwork.CNI.Cilium.IPAM != kubeoneapi.CiliumIPAMModeKubernetes {
 		return
 	}
 
@@ -413,21 +460,6 @@ func addControllerManagerNetworkArgs(m map[string]string, clusterNetwork kubeone
 	}
 }
 
-func join(ipFamily kubeoneapi.IPFamily, ipv4Subnet, ipv6Subnet string) string {
-	switch ipFamily {
-	case kubeoneapi.IPFamilyIPv4:
-		return ipv4Subnet
-	case kubeoneapi.IPFamilyIPv6:
-		return ipv6Subnet
-	case kubeoneapi.IPFamilyIPv4IPv6:
-		return strings.Join([]string{ipv4Subnet, ipv6Subnet}, ",")
-	case kubeoneapi.IPFamilyIPv6IPv4:
-		return strings.Join([]string{ipv6Subnet, ipv4Subnet}, ",")
-	default:
-		return "unknown IP family"
-	}
-}
-
 // NewConfig returns all required configs to init a cluster via a set of v13 configs
 func NewConfigWorker(s *state.State, host kubeoneapi.HostConfig) ([]runtime.Object, error) {
 	cluster := s.Cluster
@@ -436,8 +468,11 @@ func NewConfigWorker(s *state.State, host kubeoneapi.HostConfig) ([]runtime.Obje
 	nodeRegistration.IgnorePreflightErrors = []string{
 		"DirAvailable--etc-kubernetes-manifests",
 	}
+	if cluster.Features.SwapEnabled() {
+		nodeRegistration.IgnorePreflightErrors = append(nodeRegistration.IgnorePreflightErrors, "Swap")
+	}
 
-	controlPlaneEndpoint := fmt.Sprintf("%s:%d", cluster.APIEndpoint.Host, cluster.APIEndpoint.Port)
+	controlPlaneEndpoint := net.JoinHostPort(cluster.APIEndpoint.Host, strconv.Itoa(cluster.APIEndpoint.Port))
 
 	joinConfig := &kubeadmv1beta3.JoinConfiguration{
 		TypeMeta: metav1.TypeMeta{
@@ -453,16 +488,11 @@ func NewConfigWorker(s *state.State, host kubeoneapi.HostConfig) ([]runtime.Obje
 		},
 	}
 
-	if cluster.AssetConfiguration.Pause.ImageRepository != "" {
-		nodeRegistration.KubeletExtraArgs["pod-infra-container-image"] = cluster.AssetConfiguration.Pause.ImageRepository + "/pause:" + cluster.AssetConfiguration.Pause.ImageTag
-	} else {
-		sandboxImage, serr := cluster.Versions.SandboxImage(s.Cluster.RegistryConfiguration.ImageRegistry)
-		if serr != nil {
-			return nil, serr
-		}
-
-		nodeRegistration.KubeletExtraArgs["pod-infra-container-image"] = sandboxImage
+	sandboxImage, err := cluster.SandboxImage()
+	if err != nil {
+		return nil, err
 	}
+	nodeRegistration.KubeletExtraArgs["pod-infra-container-image"] = sandboxImage
 
 	if s.ShouldEnableInTreeCloudProvider() {
 		renderedCloudConfig := "/etc/kubernetes/cloud-config"
@@ -508,7 +538,7 @@ func newNodeRegistration(s *state.State, host kubeoneapi.HostConfig) kubeadmv1be
 			}
 		}
 	} else {
-		kubeletCLIFlags["node-ip"] = newNodeIP(host)
+		kubeletCLIFlags["node-ip"] = s.Cluster.ClusterNetwork.NodeIP(host)
 	}
 
 	if m := host.Kubelet.SystemReserved; m != nil {
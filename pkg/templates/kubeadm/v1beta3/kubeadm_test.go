@@ -21,6 +21,11 @@ import (
 	"testing"
 
 	"github.com/Masterminds/semver/v3"
+
+	kubeadmv1beta3 "k8c.io/kubeone/pkg/apis/kubeadm/v1beta3"
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+
+	corev1 "k8s.io/api/core/v1"
 )
 
 func TestEtcdVersionCorruptCheckExtraArgs(t *testing.T) {
@@ -137,3 +142,149 @@ func TestEtcdVersionCorruptCheckExtraArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestApplyControlPlaneComponentConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		component kubeadmv1beta3.ControlPlaneComponent
+		config    *kubeoneapi.ControlPlaneComponentConfig
+		expected  kubeadmv1beta3.ControlPlaneComponent
+	}{
+		{
+			name: "nil config",
+			component: kubeadmv1beta3.ControlPlaneComponent{
+				ExtraArgs: map[string]string{"profiling": "false"},
+			},
+			config: nil,
+			expected: kubeadmv1beta3.ControlPlaneComponent{
+				ExtraArgs: map[string]string{"profiling": "false"},
+			},
+		},
+		{
+			name: "user flags override kubeone flags",
+			component: kubeadmv1beta3.ControlPlaneComponent{
+				ExtraArgs: map[string]string{"profiling": "false", "bind-address": "0.0.0.0"},
+			},
+			config: &kubeoneapi.ControlPlaneComponentConfig{
+				Flags: map[string]string{"bind-address": "127.0.0.1"},
+			},
+			expected: kubeadmv1beta3.ControlPlaneComponent{
+				ExtraArgs: map[string]string{"profiling": "false", "bind-address": "127.0.0.1"},
+			},
+		},
+		{
+			name: "feature gates are merged",
+			component: kubeadmv1beta3.ControlPlaneComponent{
+				ExtraArgs: map[string]string{"feature-gates": "CSIMigrationvSphere=true"},
+			},
+			config: &kubeoneapi.ControlPlaneComponentConfig{
+				FeatureGates: map[string]bool{"TestFeatureGate": true},
+			},
+			expected: kubeadmv1beta3.ControlPlaneComponent{
+				ExtraArgs: map[string]string{"feature-gates": "CSIMigrationvSphere=true,TestFeatureGate=true"},
+			},
+		},
+		{
+			name:      "extra volumes are appended",
+			component: kubeadmv1beta3.ControlPlaneComponent{},
+			config: &kubeoneapi.ControlPlaneComponentConfig{
+				ExtraVolumes: []kubeoneapi.HostPathMount{
+					{
+						Name:      "audit-webhook",
+						HostPath:  "/etc/kubernetes/audit-webhook.yaml",
+						MountPath: "/etc/kubernetes/audit-webhook.yaml",
+						ReadOnly:  true,
+						PathType:  corev1.HostPathFile,
+					},
+				},
+			},
+			expected: kubeadmv1beta3.ControlPlaneComponent{
+				ExtraArgs: map[string]string{},
+				ExtraVolumes: []kubeadmv1beta3.HostPathMount{
+					{
+						Name:      "audit-webhook",
+						HostPath:  "/etc/kubernetes/audit-webhook.yaml",
+						MountPath: "/etc/kubernetes/audit-webhook.yaml",
+						ReadOnly:  true,
+						PathType:  corev1.HostPathFile,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			component := tt.component
+			applyControlPlaneComponentConfig(&component, tt.config)
+			if !reflect.DeepEqual(component, tt.expected) {
+				t.Errorf("got %+v, but expected %+v", component, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAddControllerManagerNetworkArgs(t *testing.T) {
+	maskIPv4 := 26
+	maskIPv6 := 80
+	allocate := true
+	noAllocate := false
+
+	tests := []struct {
+		name           string
+		clusterNetwork kubeoneapi.ClusterNetworkConfig
+		expected       map[string]string
+	}{
+		{
+			name: "ipv4 with canal",
+			clusterNetwork: kubeoneapi.ClusterNetworkConfig{
+				CNI:                  &kubeoneapi.CNI{Canal: &kubeoneapi.CanalSpec{}},
+				IPFamily:             kubeoneapi.IPFamilyIPv4,
+				NodeCIDRMaskSizeIPv4: &maskIPv4,
+				AllocateNodeCIDRs:    &allocate,
+			},
+			expected: map[string]string{"node-cidr-mask-size-ipv4": "26"},
+		},
+		{
+			name: "dualstack with canal",
+			clusterNetwork: kubeoneapi.ClusterNetworkConfig{
+				CNI:                  &kubeoneapi.CNI{Canal: &kubeoneapi.CanalSpec{}},
+				IPFamily:             kubeoneapi.IPFamilyIPv4IPv6,
+				NodeCIDRMaskSizeIPv4: &maskIPv4,
+				NodeCIDRMaskSizeIPv6: &maskIPv6,
+				AllocateNodeCIDRs:    &allocate,
+			},
+			expected: map[string]string{"node-cidr-mask-size-ipv4": "26", "node-cidr-mask-size-ipv6": "80"},
+		},
+		{
+			name: "cilium with cluster-pool ipam",
+			clusterNetwork: kubeoneapi.ClusterNetworkConfig{
+				CNI:                  &kubeoneapi.CNI{Cilium: &kubeoneapi.CiliumSpec{IPAM: kubeoneapi.CiliumIPAMModeClusterPool}},
+				IPFamily:             kubeoneapi.IPFamilyIPv4,
+				NodeCIDRMaskSizeIPv4: &maskIPv4,
+				AllocateNodeCIDRs:    &allocate,
+			},
+			expected: map[string]string{},
+		},
+		{
+			name: "node cidr allocation disabled",
+			clusterNetwork: kubeoneapi.ClusterNetworkConfig{
+				CNI:                  &kubeoneapi.CNI{Calico: &kubeoneapi.CalicoSpec{}},
+				IPFamily:             kubeoneapi.IPFamilyIPv4,
+				NodeCIDRMaskSizeIPv4: &maskIPv4,
+				AllocateNodeCIDRs:    &noAllocate,
+			},
+			expected: map[string]string{"allocate-node-cidrs": "false"},
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			args := map[string]string{}
+			addControllerManagerNetworkArgs(args, tc.clusterNetwork)
+			if !reflect.DeepEqual(args, tc.expected) {
+				t.Errorf("expected %v, but got %v", tc.expected, args)
+			}
+		})
+	}
+}
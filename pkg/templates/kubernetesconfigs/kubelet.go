@@ -65,7 +65,7 @@ func NewKubeletConfiguration(cluster *kubeoneapi.KubeOneCluster, featureGates ma
 				Enabled: &bfalse,
 			},
 		},
-		CgroupDriver:         "systemd",
+		CgroupDriver:         string(cluster.Cgroups.CgroupDriver()),
 		ContainerLogMaxFiles: &cluster.LoggingConfig.ContainerLogMaxFiles,
 		ContainerLogMaxSize:  cluster.LoggingConfig.ContainerLogMaxSize,
 		FeatureGates:         featureGates,
@@ -79,5 +79,27 @@ func NewKubeletConfiguration(cluster *kubeoneapi.KubeOneCluster, featureGates ma
 		kubeletConfig.ClusterDNS = []string{resources.NodeLocalDNSVirtualIP}
 	}
 
+	if cluster.ClusterNetwork.DNS != nil {
+		resolvConf := resources.KubeletResolvConf
+		kubeletConfig.ResolverConfig = &resolvConf
+	}
+
+	if cluster.Features.SeccompDefault != nil && cluster.Features.SeccompDefault.Enable {
+		btrue := true
+		kubeletConfig.SeccompDefault = &btrue
+	}
+
+	if cluster.Features.SwapEnabled() {
+		kubeletConfig.FailSwapOn = &bfalse
+		kubeletConfig.MemorySwap.SwapBehavior = string(cluster.Features.NodeSwap.SwapBehavior)
+
+		swapFeatureGates := map[string]bool{}
+		for gate, enabled := range featureGates {
+			swapFeatureGates[gate] = enabled
+		}
+		swapFeatureGates["NodeSwap"] = true
+		kubeletConfig.FeatureGates = swapFeatureGates
+	}
+
 	return dropFields(kubeletConfig, []string{"logging"}, []string{"containerRuntimeEndpoint"})
 }
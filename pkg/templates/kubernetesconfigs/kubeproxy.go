@@ -17,7 +17,13 @@ limitations under the License.
 package kubernetesconfigs
 
 import (
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+
 	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/semverutil"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -25,18 +31,30 @@ import (
 	kubeproxyv1alpha1 "k8s.io/kube-proxy/config/v1alpha1"
 )
 
+// lt131Constraint matches Kubernetes versions where the nftables kube-proxy
+// mode is behind the NFTablesProxyMode feature gate which is disabled by default
+var lt131Constraint = semverutil.MustParseConstraint("< 1.31")
+
 func NewKubeProxyConfiguration(cluster *kubeoneapi.KubeOneCluster) (runtime.Object, error) {
 	kubeProxyConfig := &kubeproxyv1alpha1.KubeProxyConfiguration{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "KubeProxyConfiguration",
 			APIVersion: "kubeproxy.config.k8s.io/v1alpha1",
 		},
-		ClusterCIDR: cluster.ClusterNetwork.PodSubnet,
+		ClusterCIDR: strings.Join(cluster.ClusterNetwork.PodSubnets(), ","),
 		ClientConnection: componentbasev1alpha1.ClientConnectionConfiguration{
 			Kubeconfig: "/var/lib/kube-proxy/kubeconfig.conf",
 		},
 	}
 
+	if cluster.ClusterNetwork.IPFamily == kubeoneapi.IPFamilyIPv6 {
+		kubeProxyConfig.BindAddress = "::"
+	}
+
+	// nftables configuration is not a part of the kube-proxy API version that we
+	// use, so it's set directly on the unstructured object
+	var nftables map[string]interface{}
+
 	if kbPrx := cluster.ClusterNetwork.KubeProxy; kbPrx != nil {
 		switch {
 		case kbPrx.IPVS != nil:
@@ -48,11 +66,47 @@ func NewKubeProxyConfiguration(cluster *kubeoneapi.KubeOneCluster) (runtime.Obje
 				TCPTimeout:    kbPrx.IPVS.TCPTimeout,
 				TCPFinTimeout: kbPrx.IPVS.TCPFinTimeout,
 				UDPTimeout:    kbPrx.IPVS.UDPTimeout,
+				SyncPeriod:    kbPrx.IPVS.SyncPeriod,
+				MinSyncPeriod: kbPrx.IPVS.MinSyncPeriod,
 			}
 		case kbPrx.IPTables != nil:
 			kubeProxyConfig.Mode = kubeproxyv1alpha1.ProxyMode("iptables")
+			kubeProxyConfig.IPTables = kubeproxyv1alpha1.KubeProxyIPTablesConfiguration{
+				MasqueradeAll: kbPrx.IPTables.MasqueradeAll,
+				SyncPeriod:    kbPrx.IPTables.SyncPeriod,
+				MinSyncPeriod: kbPrx.IPTables.MinSyncPeriod,
+			}
+		case kbPrx.NFTables != nil:
+			kubeProxyConfig.Mode = kubeproxyv1alpha1.ProxyMode("nftables")
+
+			kubeVer, err := semver.NewVersion(cluster.Versions.Kubernetes)
+			if err != nil {
+				return nil, fail.Config(err, "parsing kubernetes semver")
+			}
+			if lt131Constraint.Check(kubeVer) {
+				kubeProxyConfig.FeatureGates = map[string]bool{"NFTablesProxyMode": true}
+			}
+
+			nftables = map[string]interface{}{
+				"masqueradeAll": kbPrx.NFTables.MasqueradeAll,
+			}
+			if kbPrx.NFTables.SyncPeriod.Duration > 0 {
+				nftables["syncPeriod"] = kbPrx.NFTables.SyncPeriod.Duration.String()
+			}
+			if kbPrx.NFTables.MinSyncPeriod.Duration > 0 {
+				nftables["minSyncPeriod"] = kbPrx.NFTables.MinSyncPeriod.Duration.String()
+			}
 		}
 	}
 
-	return dropFields(kubeProxyConfig, []string{"detectLocal"}, []string{"winkernel"}, []string{"iptables", "localhostNodePorts"}, []string{"logging"})
+	obj, err := dropFields(kubeProxyConfig, []string{"detectLocal"}, []string{"winkernel"}, []string{"iptables", "localhostNodePorts"}, []string{"logging"})
+	if err != nil {
+		return nil, err
+	}
+
+	if nftables != nil {
+		return setField(obj, nftables, "nftables")
+	}
+
+	return obj, nil
 }
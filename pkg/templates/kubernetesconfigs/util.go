@@ -18,6 +18,7 @@ package kubernetesconfigs
 
 import (
 	"encoding/json"
+	"fmt"
 
 	metav1unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -42,3 +43,16 @@ func dropFields(obj runtime.Object, fields ...[]string) (runtime.Object, error)
 
 	return &uObj, nil
 }
+
+func setField(obj runtime.Object, value interface{}, fields ...string) (runtime.Object, error) {
+	uObj, ok := obj.(*metav1unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expected %T, got %T", uObj, obj)
+	}
+
+	if err := metav1unstructured.SetNestedField(uObj.Object, value, fields...); err != nil {
+		return nil, err
+	}
+
+	return uObj, nil
+}
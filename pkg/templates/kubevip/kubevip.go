@@ -0,0 +1,128 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubevip
+
+import (
+	"net"
+	"strconv"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/templates"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// ManifestPath is the path of the kube-vip static pod manifest
+	ManifestPath = "/etc/kubernetes/manifests/kube-vip.yaml"
+
+	// AdminKubeconfig is used by kube-vip for the leader election
+	AdminKubeconfig = "/etc/kubernetes/admin.conf"
+
+	// SuperAdminKubeconfig is used by kube-vip for the leader election while
+	// the cluster is being initialized, as the admin.conf of Kubernetes 1.29+
+	// gets its permissions only once kubeadm init finishes
+	SuperAdminKubeconfig = "/etc/kubernetes/super-admin.conf"
+
+	leaseName = "plndr-cp-lock"
+)
+
+// Manifest returns the kube-vip static pod manifest announcing the API
+// endpoint host as the virtual IP address using ARP (NDP for IPv6). kube-vip
+// uses the given kubeconfig on the host for the leader election.
+func Manifest(cluster *kubeoneapi.KubeOneCluster, image, kubeconfig string) (string, error) {
+	vip := cluster.APIEndpoint.Host
+	vipCIDR := "32"
+	if ip := net.ParseIP(vip); ip != nil && ip.To4() == nil {
+		vipCIDR = "128"
+	}
+
+	env := []corev1.EnvVar{
+		{Name: "address", Value: vip},
+		{Name: "port", Value: strconv.Itoa(cluster.APIEndpoint.Port)},
+		{Name: "vip_arp", Value: "true"},
+		{Name: "vip_cidr", Value: vipCIDR},
+		{Name: "cp_enable", Value: "true"},
+		{Name: "cp_namespace", Value: metav1.NamespaceSystem},
+		{Name: "svc_enable", Value: "false"},
+		{Name: "vip_leaderelection", Value: "true"},
+		{Name: "vip_leasename", Value: leaseName},
+		{Name: "vip_leaseduration", Value: "5"},
+		{Name: "vip_renewdeadline", Value: "3"},
+		{Name: "vip_retryperiod", Value: "1"},
+	}
+	if cluster.Features.KubeVIP.Interface != "" {
+		env = append(env, corev1.EnvVar{Name: "vip_interface", Value: cluster.Features.KubeVIP.Interface})
+	}
+
+	pod := &corev1.Pod{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Pod",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "kube-vip",
+			Namespace: metav1.NamespaceSystem,
+		},
+		Spec: corev1.PodSpec{
+			HostNetwork: true,
+			// kube-vip reaches the local kube-apiserver using the kubernetes
+			// name, as the virtual IP address in the kubeconfig isn't assigned
+			// before the leader is elected
+			HostAliases: []corev1.HostAlias{
+				{
+					IP:        "127.0.0.1",
+					Hostnames: []string{"kubernetes"},
+				},
+			},
+			Containers: []corev1.Container{
+				{
+					Name:            "kube-vip",
+					Image:           image,
+					ImagePullPolicy: corev1.PullIfNotPresent,
+					Args:            []string{"manager"},
+					Env:             env,
+					SecurityContext: &corev1.SecurityContext{
+						Capabilities: &corev1.Capabilities{
+							Add: []corev1.Capability{"NET_ADMIN", "NET_RAW"},
+						},
+					},
+					VolumeMounts: []corev1.VolumeMount{
+						{
+							Name:      "kubeconfig",
+							MountPath: AdminKubeconfig,
+						},
+					},
+				},
+			},
+			Volumes: []corev1.Volume{
+				{
+					Name: "kubeconfig",
+					VolumeSource: corev1.VolumeSource{
+						HostPath: &corev1.HostPathVolumeSource{
+							Path: kubeconfig,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return templates.KubernetesToYAML([]runtime.Object{pod})
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubevip
+
+import (
+	"flag"
+	"testing"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/testhelper"
+)
+
+var (
+	updateFlag = flag.Bool("update", false, "update testdata files")
+)
+
+func TestManifest(t *testing.T) {
+	tests := []struct {
+		name       string
+		host       string
+		kubeVIP    *kubeoneapi.KubeVIP
+		kubeconfig string
+	}{
+		{
+			name:       "ipv4",
+			host:       "10.0.0.100",
+			kubeVIP:    &kubeoneapi.KubeVIP{Enable: true},
+			kubeconfig: AdminKubeconfig,
+		},
+		{
+			name:       "ipv6 with interface",
+			host:       "fd00::100",
+			kubeVIP:    &kubeoneapi.KubeVIP{Enable: true, Interface: "eth1"},
+			kubeconfig: SuperAdminKubeconfig,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &kubeoneapi.KubeOneCluster{
+				APIEndpoint: kubeoneapi.APIEndpoint{
+					Host: tt.host,
+					Port: 6443,
+				},
+				Features: kubeoneapi.Features{
+					KubeVIP: tt.kubeVIP,
+				},
+			}
+
+			got, err := Manifest(cluster, "ghcr.io/kube-vip/kube-vip:v0.6.4", tt.kubeconfig)
+			if err != nil {
+				t.Fatalf("Manifest() error = %v", err)
+			}
+
+			testhelper.DiffOutput(t, testhelper.FSGoldenName(t), got, *updateFlag)
+		})
+	}
+}
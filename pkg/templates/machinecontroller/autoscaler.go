@@ -0,0 +1,116 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinecontroller
+
+import (
+	"strconv"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/state"
+
+	clusterv1alpha1 "github.com/kubermatic/machine-controller/pkg/apis/cluster/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	dynclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// autoscalerMinSizeAnnotation and autoscalerMaxSizeAnnotation mark the
+	// MachineDeployments scaled by the Cluster-API provider of
+	// cluster-autoscaler
+	autoscalerMinSizeAnnotation = "cluster.k8s.io/cluster-api-autoscaler-node-group-min-size"
+	autoscalerMaxSizeAnnotation = "cluster.k8s.io/cluster-api-autoscaler-node-group-max-size"
+)
+
+// clusterAutoscalerAnnotations returns the cluster-autoscaler annotations of
+// the MachineDeployment, or nil if it's not a node group of the
+// cluster-autoscaler managed by KubeOne
+func clusterAutoscalerAnnotations(cluster *kubeoneapi.KubeOneCluster, machineDeploymentName string) map[string]string {
+	if !cluster.ClusterAutoscalerEnabled() {
+		return nil
+	}
+
+	for _, ng := range cluster.Features.ClusterAutoscaler.NodeGroups {
+		if ng.MachineDeployment == machineDeploymentName {
+			return map[string]string{
+				autoscalerMinSizeAnnotation: strconv.Itoa(ng.MinReplicas),
+				autoscalerMaxSizeAnnotation: strconv.Itoa(ng.MaxReplicas),
+			}
+		}
+	}
+
+	return nil
+}
+
+// EnsureClusterAutoscalerNodeGroups annotates the MachineDeployments from the
+// cluster-autoscaler node groups with their minimum and maximum replicas, and
+// removes the annotations from all other MachineDeployments
+func EnsureClusterAutoscalerNodeGroups(s *state.State) error {
+	if s.DynamicClient == nil {
+		return fail.NoKubeClient()
+	}
+
+	machineDeployments := clusterv1alpha1.MachineDeploymentList{}
+	if err := s.DynamicClient.List(s.Context, &machineDeployments, dynclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+		return fail.KubeClient(err, "getting %T", machineDeployments)
+	}
+
+	existing := map[string]bool{}
+	for _, md := range machineDeployments.Items {
+		existing[md.Name] = true
+
+		annotations := clusterAutoscalerAnnotations(s.Cluster, md.Name)
+		if md.Annotations[autoscalerMinSizeAnnotation] == annotations[autoscalerMinSizeAnnotation] &&
+			md.Annotations[autoscalerMaxSizeAnnotation] == annotations[autoscalerMaxSizeAnnotation] {
+			continue
+		}
+
+		mdKey := dynclient.ObjectKey{Name: md.Name, Namespace: md.Namespace}
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			machineDeployment := clusterv1alpha1.MachineDeployment{}
+			if err := s.DynamicClient.Get(s.Context, mdKey, &machineDeployment); err != nil {
+				return err
+			}
+
+			if machineDeployment.Annotations == nil {
+				machineDeployment.Annotations = map[string]string{}
+			}
+			for _, key := range []string{autoscalerMinSizeAnnotation, autoscalerMaxSizeAnnotation} {
+				if value, ok := annotations[key]; ok {
+					machineDeployment.Annotations[key] = value
+				} else {
+					delete(machineDeployment.Annotations, key)
+				}
+			}
+
+			return s.DynamicClient.Update(s.Context, &machineDeployment)
+		})
+		if err != nil {
+			return fail.KubeClient(err, "updating %T %s", md, mdKey)
+		}
+	}
+
+	for _, ng := range s.Cluster.Features.ClusterAutoscaler.NodeGroups {
+		if !existing[ng.MachineDeployment] {
+			s.Logger.Warnf("MachineDeployment %q of the cluster-autoscaler node group does not exist", ng.MachineDeployment)
+		}
+	}
+
+	return nil
+}
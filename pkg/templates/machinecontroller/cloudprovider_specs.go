@@ -95,6 +95,29 @@ type HetznerSpec struct {
 	Labels     map[string]string `json:"labels,omitempty"`
 }
 
+// KubevirtSpec holds cloudprovider spec for KubeVirt
+type KubevirtSpec struct {
+	ClusterName    string                 `json:"clusterName,omitempty"`
+	VirtualMachine KubevirtVirtualMachine `json:"virtualMachine"`
+}
+
+type KubevirtVirtualMachine struct {
+	Template  KubevirtVirtualMachineTemplate `json:"template"`
+	DNSPolicy string                         `json:"dnsPolicy,omitempty"`
+}
+
+type KubevirtVirtualMachineTemplate struct {
+	CPUs        string              `json:"cpus"`
+	Memory      string              `json:"memory"`
+	PrimaryDisk KubevirtPrimaryDisk `json:"primaryDisk"`
+}
+
+type KubevirtPrimaryDisk struct {
+	OSImage          string `json:"osImage"`
+	Size             string `json:"size"`
+	StorageClassName string `json:"storageClassName,omitempty"`
+}
+
 // NutanixSpec holds cloudprovider spec for Nutanix
 type NutanixSpec struct {
 	ClusterName string  `json:"clusterName"`
@@ -33,11 +33,11 @@ import (
 
 	clustercommon "github.com/kubermatic/machine-controller/pkg/apis/cluster/common"
 	clusterv1alpha1 "github.com/kubermatic/machine-controller/pkg/apis/cluster/v1alpha1"
+	evictiontypes "github.com/kubermatic/machine-controller/pkg/node/eviction/types"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // CreateMachineDeployments creates MachineDeployments that create appropriate
@@ -90,7 +90,12 @@ func GenerateMachineDeploymentsManifest(s *state.State) (string, error) {
 }
 
 func createMachineDeployment(cluster *kubeoneapi.KubeOneCluster, workerset kubeoneapi.DynamicWorkerConfig) (*clusterv1alpha1.MachineDeployment, error) {
-	cloudProviderSpec, err := machineSpec(cluster, workerset, cluster.CloudProvider)
+	provider, err := cluster.CloudProvider.WorkerCloudProvider(workerset)
+	if err != nil {
+		return nil, err
+	}
+
+	cloudProviderSpec, err := machineSpec(cluster, workerset, provider)
 	if err != nil {
 		return nil, err
 	}
@@ -116,31 +121,30 @@ func createMachineDeployment(cluster *kubeoneapi.KubeOneCluster, workerset kubeo
 		Taints                   bool `json:"taints,omitempty"`
 	}{
 		ProviderSpec:  workerset.Config,
-		CloudProvider: cluster.CloudProvider.MachineControllerCloudProvider(),
+		CloudProvider: provider.MachineControllerCloudProvider(),
 	})
 	if err != nil {
 		return nil, fail.Runtime(err, "marshalling reduced providerSpec")
 	}
 
 	replicas := int32(*workerset.Replicas)
-	maxSurge := intstr.FromInt(1)
-	maxUnavailable := intstr.FromInt(0)
 	minReadySeconds := int32(0)
 	workersetNameLabels := map[string]string{
 		"workerset": workerset.Name,
 	}
 
-	if workerset.Config.Network != nil {
-		// we have static network config
-		maxSurge = intstr.FromInt(0)
-		maxUnavailable = intstr.FromInt(1)
+	nodeLabels := labels.Merge(workerset.Config.Labels, workersetNameLabels)
+	if provider.CloudProviderName() != cluster.CloudProvider.CloudProviderName() {
+		// Nodes on other providers are labeled, so that the cloud provider addons of the cluster cloud provider are
+		// not scheduled on them
+		nodeLabels = labels.Merge(nodeLabels, map[string]string{kubeoneapi.WorkerCloudProviderLabel: provider.CloudProviderName()})
 	}
 
 	machineAnnotations := getKubeletConfigurationAnnotations(cluster)
 
 	return &clusterv1alpha1.MachineDeployment{
 		ObjectMeta: metav1.ObjectMeta{
-			Annotations: labels.Merge(workerset.Config.Annotations, machineAnnotations),
+			Annotations: labels.Merge(labels.Merge(workerset.Config.Annotations, machineAnnotations), clusterAutoscalerAnnotations(cluster, workerset.Name)),
 			Namespace:   metav1.NamespaceSystem,
 			Name:        workerset.Name,
 		},
@@ -150,13 +154,7 @@ func createMachineDeployment(cluster *kubeoneapi.KubeOneCluster, workerset kubeo
 			Selector: metav1.LabelSelector{
 				MatchLabels: workersetNameLabels,
 			},
-			Strategy: &clusterv1alpha1.MachineDeploymentStrategy{
-				Type: clustercommon.RollingUpdateMachineDeploymentStrategyType,
-				RollingUpdate: &clusterv1alpha1.MachineRollingUpdateDeployment{
-					MaxSurge:       &maxSurge,
-					MaxUnavailable: &maxUnavailable,
-				},
-			},
+			Strategy:        machineDeploymentStrategy(workerset),
 			MinReadySeconds: &minReadySeconds,
 			Template: clusterv1alpha1.MachineTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
@@ -166,8 +164,8 @@ func createMachineDeployment(cluster *kubeoneapi.KubeOneCluster, workerset kubeo
 				},
 				Spec: clusterv1alpha1.MachineSpec{
 					ObjectMeta: metav1.ObjectMeta{
-						Annotations: workerset.Config.NodeAnnotations,
-						Labels:      labels.Merge(workerset.Config.Labels, workersetNameLabels),
+						Annotations: nodeAnnotations(workerset),
+						Labels:      nodeLabels,
 					},
 					Versions: clusterv1alpha1.MachineVersionInfo{
 						Kubelet: cluster.Versions.Kubernetes,
@@ -182,6 +180,48 @@ func createMachineDeployment(cluster *kubeoneapi.KubeOneCluster, workerset kubeo
 	}, nil
 }
 
+// machineDeploymentStrategy returns the strategy used by machine-controller
+// to replace the machines of the MachineDeployment
+func machineDeploymentStrategy(workerset kubeoneapi.DynamicWorkerConfig) *clusterv1alpha1.MachineDeploymentStrategy {
+	maxSurge, maxUnavailable := workerset.RollingUpdate()
+
+	return &clusterv1alpha1.MachineDeploymentStrategy{
+		Type: clustercommon.RollingUpdateMachineDeploymentStrategyType,
+		RollingUpdate: &clusterv1alpha1.MachineRollingUpdateDeployment{
+			MaxSurge:       &maxSurge,
+			MaxUnavailable: &maxUnavailable,
+		},
+	}
+}
+
+// ApplyUpgradeStrategy sets the upgrade strategy of the workerset on the
+// existing MachineDeployment. The MachineDeployments without an upgrade
+// strategy in the manifest are left as they are.
+func ApplyUpgradeStrategy(md *clusterv1alpha1.MachineDeployment, workerset kubeoneapi.DynamicWorkerConfig) {
+	if workerset.UpgradeStrategy == nil {
+		return
+	}
+
+	md.Spec.Strategy = machineDeploymentStrategy(workerset)
+
+	if workerset.SkipEviction() {
+		md.Spec.Template.Spec.Annotations = labels.Merge(md.Spec.Template.Spec.Annotations, map[string]string{evictiontypes.SkipEvictionAnnotationKey: "true"})
+	} else {
+		delete(md.Spec.Template.Spec.Annotations, evictiontypes.SkipEvictionAnnotationKey)
+	}
+}
+
+// nodeAnnotations returns the annotations machine-controller sets on the
+// nodes of the MachineDeployment. machine-controller deletes the nodes
+// annotated with the skip-eviction annotation without draining them.
+func nodeAnnotations(workerset kubeoneapi.DynamicWorkerConfig) map[string]string {
+	if !workerset.SkipEviction() {
+		return workerset.Config.NodeAnnotations
+	}
+
+	return labels.Merge(workerset.Config.NodeAnnotations, map[string]string{evictiontypes.SkipEvictionAnnotationKey: "true"})
+}
+
 func getKubeletConfigurationAnnotations(cluster *kubeoneapi.KubeOneCluster) map[string]string {
 	annotations := make(map[string]string)
 
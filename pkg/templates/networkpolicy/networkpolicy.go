@@ -0,0 +1,78 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkpolicy
+
+import (
+	"sort"
+
+	"k8c.io/kubeone/pkg/fail"
+	"k8c.io/kubeone/pkg/state"
+	"k8c.io/kubeone/pkg/templates/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// addonLabel is applied by the addons applier to all objects of the addon
+const addonLabel = "kubeone.io/addon"
+
+// EnsureNamespaces creates the namespaces the default-deny NetworkPolicies
+// are deployed to, if they don't exist. The namespaces are not managed by
+// the addon, so they are not deleted if they are removed from the config.
+func EnsureNamespaces(s *state.State) error {
+	for _, name := range s.Cluster.Features.DefaultDenyNetworkPolicy.Namespaces {
+		ns := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+		}
+
+		err := s.DynamicClient.Create(s.Context, ns)
+		if err != nil && !k8serrors.IsAlreadyExists(err) {
+			return fail.KubeClient(err, "creating %q namespace", name)
+		}
+	}
+
+	return nil
+}
+
+// DeployedNamespaces returns the namespaces the default-deny NetworkPolicies
+// are currently deployed to
+func DeployedNamespaces(s *state.State) ([]string, error) {
+	policies := networkingv1.NetworkPolicyList{}
+	err := s.DynamicClient.List(s.Context, &policies, client.MatchingLabels{
+		addonLabel: resources.AddonDefaultDenyNetworkPolicy,
+	})
+	if err != nil {
+		return nil, fail.KubeClient(err, "listing default-deny NetworkPolicies")
+	}
+
+	visited := map[string]bool{}
+	namespaces := []string{}
+	for _, policy := range policies.Items {
+		if !visited[policy.Namespace] {
+			namespaces = append(namespaces, policy.Namespace)
+			visited[policy.Namespace] = true
+		}
+	}
+	sort.Strings(namespaces)
+
+	return namespaces, nil
+}
@@ -24,14 +24,22 @@ import (
 
 // Names of the internal addons
 const (
+	AddonBackupsEtcd            = "backups-etcd"
 	AddonCCMAws                 = "ccm-aws"
 	AddonCCMAzure               = "ccm-azure"
 	AddonCCMDigitalOcean        = "ccm-digitalocean"
 	AddonCCMEquinixMetal        = "ccm-equinixmetal"
 	AddonCCMHetzner             = "ccm-hetzner"
+	AddonCCMKubevirt            = "ccm-kubevirt"
+	AddonCCMNutanix             = "ccm-nutanix"
+	AddonCCMOCI                 = "ccm-oci"
 	AddonCCMOpenStack           = "ccm-openstack"
 	AddonCCMPacket              = "ccm-packet" // TODO: Remove after deprecation period.
 	AddonCCMVsphere             = "ccm-vsphere"
+	AddonCiliumEgressGateway    = "cilium-egress-gateway"
+	AddonClusterAutoscaler      = "cluster-autoscaler"
+	AddonCNIBGP                 = "cni-bgp"
+	AddonCNICalico              = "cni-calico"
 	AddonCNICanal               = "cni-canal"
 	AddonCNICilium              = "cni-cilium"
 	AddonCNIWeavenet            = "cni-weavenet"
@@ -42,16 +50,29 @@ const (
 	AddonCSIDigitalOcean        = "csi-digitalocean"
 	AddonCSIGCPComputePD        = "csi-gcp-compute-persistent"
 	AddonCSIHetzner             = "csi-hetzner"
+	AddonCSIKubevirt            = "csi-kubevirt"
 	AddonCSINutanix             = "csi-nutanix"
+	AddonCSIOCI                 = "csi-oci"
 	AddonCSIOpenStackCinder     = "csi-openstack-cinder"
+	AddonCSISnapshotController  = "csi-snapshot-controller"
 	AddonCSIVMwareCloudDirector = "csi-vmware-cloud-director"
 	AddonCSIVsphere             = "csi-vsphere"
 	// AddonCSIVsphereKubeSystem represents the CSI driver deployed to Kube-System Namespace.
-	AddonCSIVsphereKubeSystem   = "csi-vsphere-ks"
-	AddonMachineController      = "machinecontroller"
-	AddonMetricsServer          = "metrics-server"
-	AddonNodeLocalDNS           = "nodelocaldns"
-	AddonOperatingSystemManager = "operating-system-manager"
+	AddonCSIVsphereKubeSystem     = "csi-vsphere-ks"
+	AddonDefaultDenyNetworkPolicy = "default-deny-network-policy"
+	AddonEtcdDefrag               = "etcd-defrag"
+	AddonGatewayAPI               = "gateway-api"
+	AddonIngressNginx             = "ingress-nginx"
+	AddonIstioAmbient             = "istio-ambient"
+	AddonLocalStorage             = "local-storage"
+	AddonMachineController        = "machinecontroller"
+	AddonMetalLB                  = "metallb"
+	AddonMetalLBConfig            = "metallb-config"
+	AddonMetricsServer            = "metrics-server"
+	AddonNodeLocalDNS             = "nodelocaldns"
+	AddonNvidiaDevicePlugin       = "nvidia-device-plugin"
+	AddonOperatingSystemManager   = "operating-system-manager"
+	AddonUnattendedUpgrades       = "unattended-upgrades"
 )
 
 func CloudAddons() []string {
@@ -61,6 +82,9 @@ func CloudAddons() []string {
 		AddonCCMDigitalOcean,
 		AddonCCMEquinixMetal,
 		AddonCCMHetzner,
+		AddonCCMKubevirt,
+		AddonCCMNutanix,
+		AddonCCMOCI,
 		AddonCCMOpenStack,
 		AddonCCMPacket,
 		AddonCCMVsphere,
@@ -70,7 +94,9 @@ func CloudAddons() []string {
 		AddonCSIDigitalOcean,
 		AddonCSIGCPComputePD,
 		AddonCSIHetzner,
+		AddonCSIKubevirt,
 		AddonCSINutanix,
+		AddonCSIOCI,
 		AddonCSIOpenStackCinder,
 		AddonCSIVMwareCloudDirector,
 		AddonCSIVsphere,
@@ -79,6 +105,13 @@ func CloudAddons() []string {
 
 const (
 	NodeLocalDNSVirtualIP = "169.254.20.10"
+
+	// KubeletResolvConf is the resolv.conf file generated by KubeOne and used
+	// by kubelet if clusterNetwork.dns is configured
+	KubeletResolvConf = "/etc/kubeone/resolv.conf"
+
+	// NvidiaGPUNodeLabel designates nodes with NVIDIA GPUs
+	NvidiaGPUNodeLabel = "nvidia.com/gpu.present"
 )
 
 const (
@@ -94,6 +127,9 @@ const (
 	MetricsServerName      = "metrics-server"
 	MetricsServerNamespace = metav1.NamespaceSystem
 
+	IngressNginxNamespace     = "ingress-nginx"
+	IngressNginxAdmissionName = "ingress-nginx-controller-admission"
+
 	VsphereCSINamespace        = "vmware-system-csi"
 	VsphereCSIWebhookName      = "vsphere-webhook-svc"
 	VsphereCSIWebhookNamespace = "vmware-system-csi"
@@ -122,6 +158,7 @@ func All() map[string]string {
 		"OperatingSystemManagerWebhookName": OperatingSystemManagerWebhookName,
 		"KubeletImageRepository":            KubeletImageRepository,
 		"NodeLocalDNSVirtualIP":             NodeLocalDNSVirtualIP,
+		"NvidiaGPUNodeLabel":                NvidiaGPUNodeLabel,
 		"CABundleSSLCertFilePath":           cabundle.SSLCertFilePath,
 	}
 }
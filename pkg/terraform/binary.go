@@ -0,0 +1,161 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"os"
+	"os/exec"
+	"regexp"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+
+	"k8c.io/kubeone/pkg/fail"
+)
+
+// BinaryEnvVar is the environment variable used to override the Terraform
+// (or OpenTofu) binary. It can be either a name looked up in the PATH or a path.
+const BinaryEnvVar = "KUBEONE_TERRAFORM_BINARY"
+
+// Flavor is the implementation of the Terraform CLI
+type Flavor string
+
+const (
+	FlavorTerraform Flavor = "Terraform"
+	FlavorOpenTofu  Flavor = "OpenTofu"
+)
+
+var (
+	// defaultBinaries are looked up in the PATH, in order, if BinaryEnvVar is
+	// not set
+	defaultBinaries = []string{"terraform", "tofu"}
+
+	// minimumVersions are the oldest versions supported by the Terraform
+	// integration and the example Terraform configs
+	minimumVersions = map[Flavor]*semver.Version{
+		FlavorTerraform: semver.MustParse("1.0.0"),
+		FlavorOpenTofu:  semver.MustParse("1.6.0"),
+	}
+
+	versionRegexp = regexp.MustCompile(`^(Terraform|OpenTofu) v(\S+)`)
+)
+
+// Binary is the Terraform or OpenTofu binary used to read the Terraform output
+type Binary struct {
+	Path    string
+	Flavor  Flavor
+	Version *semver.Version
+}
+
+// LookupBinary returns the path of the binary configured with BinaryEnvVar,
+// or the first of terraform and tofu found in the PATH
+func LookupBinary() (string, error) {
+	if bin := os.Getenv(BinaryEnvVar); bin != "" {
+		path, err := exec.LookPath(bin)
+		if err != nil {
+			return "", fail.Runtime(err, "looking up %s from %s", bin, BinaryEnvVar)
+		}
+
+		return path, nil
+	}
+
+	for _, bin := range defaultBinaries {
+		if path, err := exec.LookPath(bin); err == nil {
+			return path, nil
+		}
+	}
+
+	return "", fail.NewRuntimeError("looking up terraform binary", "neither of %s found in the PATH, install Terraform or OpenTofu or set %s", strings.Join(defaultBinaries, ", "), BinaryEnvVar)
+}
+
+// Detect looks up the Terraform or OpenTofu binary and validates its version
+func Detect(ctx context.Context) (*Binary, error) {
+	path, err := LookupBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := exec.CommandContext(ctx, path, "version").Output()
+	if err != nil {
+		return nil, fail.Runtime(err, "running %s version", path)
+	}
+
+	flavor, version, err := parseVersion(string(out))
+	if err != nil {
+		return nil, err
+	}
+
+	if minimum := minimumVersions[flavor]; version.LessThan(minimum) {
+		return nil, fail.NewRuntimeError("checking terraform version", "%s %s is not supported, at least %s is required", flavor, version, minimum)
+	}
+
+	return &Binary{
+		Path:    path,
+		Flavor:  flavor,
+		Version: version,
+	}, nil
+}
+
+// Output returns the Terraform output in the JSON format from the given
+// directory
+func (b *Binary) Output(ctx context.Context, dir string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, b.Path, "output", "-json")
+	cmd.Dir = dir
+
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fail.Runtime(err, "reading %s output", strings.ToLower(string(b.Flavor)))
+	}
+
+	return out, nil
+}
+
+// Run runs the command with the given arguments in the given directory,
+// streaming its output to stdout and stderr
+func (b *Binary) Run(ctx context.Context, dir string, stdout, stderr io.Writer, args ...string) error {
+	cmd := exec.CommandContext(ctx, b.Path, args...)
+	cmd.Dir = dir
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	if err := cmd.Run(); err != nil {
+		return fail.Runtime(err, "running %s %s", strings.ToLower(string(b.Flavor)), strings.Join(args, " "))
+	}
+
+	return nil
+}
+
+// parseVersion parses the output of the `version` command, which starts with
+// e.g. "Terraform v1.5.7" or "OpenTofu v1.6.0"
+func parseVersion(out string) (Flavor, *semver.Version, error) {
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	if scanner.Scan() {
+		if match := versionRegexp.FindStringSubmatch(strings.TrimSpace(scanner.Text())); match != nil {
+			version, err := semver.NewVersion(match[2])
+			if err != nil {
+				return "", nil, fail.Runtime(err, "parsing %s version", match[1])
+			}
+
+			return Flavor(match[1]), version, nil
+		}
+	}
+
+	return "", nil, fail.NewRuntimeError("parsing terraform version", "unexpected version output %q", strings.TrimSpace(out))
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"testing"
+)
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		name            string
+		output          string
+		expectedFlavor  Flavor
+		expectedVersion string
+		expectedError   bool
+	}{
+		{
+			name:            "terraform",
+			output:          "Terraform v1.5.7\non linux_amd64\n",
+			expectedFlavor:  FlavorTerraform,
+			expectedVersion: "1.5.7",
+		},
+		{
+			name:            "outdated terraform",
+			output:          "Terraform v1.3.9\non linux_amd64\n\nYour version of Terraform is out of date! The latest version\nis 1.5.7. You can update by downloading from https://www.terraform.io/downloads.html\n",
+			expectedFlavor:  FlavorTerraform,
+			expectedVersion: "1.3.9",
+		},
+		{
+			name:            "opentofu",
+			output:          "OpenTofu v1.6.0\non linux_amd64\n",
+			expectedFlavor:  FlavorOpenTofu,
+			expectedVersion: "1.6.0",
+		},
+		{
+			name:            "opentofu pre-release",
+			output:          "OpenTofu v1.6.0-rc1\non darwin_arm64\n",
+			expectedFlavor:  FlavorOpenTofu,
+			expectedVersion: "1.6.0-rc1",
+		},
+		{
+			name:          "unknown binary",
+			output:        "Usage: foo [options]\n",
+			expectedError: true,
+		},
+		{
+			name:          "empty output",
+			output:        "",
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			flavor, version, err := parseVersion(tt.output)
+			if (err != nil) != tt.expectedError {
+				t.Fatalf("parseVersion() error = %v, expectedError %v", err, tt.expectedError)
+			}
+			if tt.expectedError {
+				return
+			}
+
+			if flavor != tt.expectedFlavor {
+				t.Errorf("parseVersion() flavor = %v, expected %v", flavor, tt.expectedFlavor)
+			}
+			if version.String() != tt.expectedVersion {
+				t.Errorf("parseVersion() version = %v, expected %v", version, tt.expectedVersion)
+			}
+		})
+	}
+}
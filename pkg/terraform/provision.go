@@ -0,0 +1,70 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	"k8c.io/kubeone/pkg/fail"
+)
+
+const (
+	// VarsFileName is the name of the variables file generated from the
+	// KubeOneCluster manifest. Terraform loads *.auto.tfvars.json files
+	// automatically, after terraform.tfvars, so the generated variables take
+	// precedence over the ones set there.
+	VarsFileName = "kubeone.auto.tfvars.json"
+
+	// PlanFileName is the name of the plan file created by `kubeone provision`
+	PlanFileName = "kubeone.tfplan"
+)
+
+// ProvisionVars returns the variables for the example Terraform configs
+// generated from the KubeOneCluster manifest. Overrides are set as-is, after
+// the generated variables.
+func ProvisionVars(cluster *kubeoneapi.KubeOneCluster, overrides map[string]string) map[string]interface{} {
+	vars := map[string]interface{}{
+		"cluster_name": cluster.Name,
+	}
+
+	if len(cluster.APIEndpoint.AlternativeNames) > 0 {
+		vars["apiserver_alternative_names"] = cluster.APIEndpoint.AlternativeNames
+	}
+
+	for k, v := range overrides {
+		vars[k] = v
+	}
+
+	return vars
+}
+
+// WriteVarsFile writes the variables to VarsFileName in the given directory
+func WriteVarsFile(dir string, vars map[string]interface{}) error {
+	buf, err := json.MarshalIndent(vars, "", "  ")
+	if err != nil {
+		return fail.Runtime(err, "marshalling terraform variables")
+	}
+
+	if err = os.WriteFile(filepath.Join(dir, VarsFileName), append(buf, '\n'), 0600); err != nil {
+		return fail.Runtime(err, "writing terraform variables")
+	}
+
+	return nil
+}
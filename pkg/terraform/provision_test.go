@@ -0,0 +1,76 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"reflect"
+	"testing"
+
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+)
+
+func TestProvisionVars(t *testing.T) {
+	tests := []struct {
+		name      string
+		cluster   *kubeoneapi.KubeOneCluster
+		overrides map[string]string
+		expected  map[string]interface{}
+	}{
+		{
+			name:    "cluster name only",
+			cluster: &kubeoneapi.KubeOneCluster{Name: "test"},
+			expected: map[string]interface{}{
+				"cluster_name": "test",
+			},
+		},
+		{
+			name: "alternative names",
+			cluster: &kubeoneapi.KubeOneCluster{
+				Name: "test",
+				APIEndpoint: kubeoneapi.APIEndpoint{
+					AlternativeNames: []string{"api.example.com"},
+				},
+			},
+			expected: map[string]interface{}{
+				"cluster_name":                "test",
+				"apiserver_alternative_names": []string{"api.example.com"},
+			},
+		},
+		{
+			name:    "overrides",
+			cluster: &kubeoneapi.KubeOneCluster{Name: "test"},
+			overrides: map[string]string{
+				"cluster_name":           "override",
+				"control_plane_vm_count": "1",
+			},
+			expected: map[string]interface{}{
+				"cluster_name":           "override",
+				"control_plane_vm_count": "1",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got := ProvisionVars(tt.cluster, tt.overrides)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("ProvisionVars() = %v, expected %v", got, tt.expected)
+			}
+		})
+	}
+}
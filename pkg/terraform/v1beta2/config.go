@@ -38,6 +38,7 @@ type Config struct {
 		Type      json.RawMessage `json:"type"`
 
 		Value struct {
+			Version                   string   `json:"version"`
 			Endpoint                  string   `json:"endpoint"`
 			APIServerAlternativeNames []string `json:"apiserver_alternative_names"`
 		} `json:"value"`
@@ -75,13 +76,18 @@ type Config struct {
 }
 
 type controlPlane struct {
-	ClusterName    string  `json:"cluster_name"`
-	CloudProvider  *string `json:"cloud_provider"`
-	LeaderIP       string  `json:"leader_ip"`
-	Untaint        bool    `json:"untaint"`
-	NetworkID      string  `json:"network_id"`
-	VAppName       string  `json:"vapp_name"`
-	StorageProfile string  `json:"storage_profile"`
+	ClusterName           string   `json:"cluster_name"`
+	CloudProvider         *string  `json:"cloud_provider"`
+	LeaderIP              string   `json:"leader_ip"`
+	Untaint               bool     `json:"untaint"`
+	NetworkID             string   `json:"network_id"`
+	VAppName              string   `json:"vapp_name"`
+	StorageProfile        string   `json:"storage_profile"`
+	CompartmentID         string   `json:"compartment_id"`
+	VCNID                 string   `json:"vcn_id"`
+	VPCIPRange            string   `json:"vpc_ip_range"`
+	LoadBalancerSubnetIDs []string `json:"lb_subnet_ids"`
+	InfraNamespace        string   `json:"infra_namespace"`
 	hostsSpec
 }
 
@@ -102,6 +108,7 @@ type hostsSpec struct {
 	BastionHostKey    []byte            `json:"bastion_host_key"`
 	Kubelet           kubeletSpec       `json:"kubelet,omitempty"`
 	Labels            map[string]string `json:"labels"`
+	Zones             []string          `json:"zones"`
 }
 
 type kubeletSpec struct {
@@ -206,9 +213,16 @@ func NewConfigFromJSON(buf []byte) (*Config, error) {
 		return nil, fail.Runtime(err, "marshal terraform output")
 	}
 
+	if _, err = schemaVersion(strictBuf); err != nil {
+		return nil, err
+	}
+
 	output := &Config{}
+	if err = decodeStrict(strictBuf, output); err != nil {
+		return nil, err
+	}
 
-	return output, fail.Runtime(unmarshalStrict(strictBuf, output), "reading terraform output")
+	return output, output.validate()
 }
 
 // Apply adds the terraform configuration options to the given cluster config.
@@ -268,6 +282,16 @@ func (output *Config) Apply(cluster *kubeonev1beta2.KubeOneCluster) error {
 		cluster.CloudProvider.Hetzner.NetworkID = cp.NetworkID
 	}
 
+	if cluster.CloudProvider.DigitalOcean != nil {
+		// NetworkID and VPCIPRange describe the VPC of the DigitalOcean droplets
+		if len(cp.NetworkID) > 0 {
+			cluster.CloudProvider.DigitalOcean.VPCID = cp.NetworkID
+		}
+		if len(cp.VPCIPRange) > 0 {
+			cluster.CloudProvider.DigitalOcean.VPCIPRange = cp.VPCIPRange
+		}
+	}
+
 	if cluster.CloudProvider.VMwareCloudDirector != nil {
 		// VAppName is used only for VMware Cloud Director.
 		if len(cp.VAppName) > 0 {
@@ -282,6 +306,26 @@ func (output *Config) Apply(cluster *kubeonev1beta2.KubeOneCluster) error {
 		}
 	}
 
+	if cluster.CloudProvider.OCI != nil {
+		// CompartmentID, VCNID and LoadBalancerSubnetIDs are used only for OCI
+		if len(cp.CompartmentID) > 0 {
+			cluster.CloudProvider.OCI.CompartmentID = cp.CompartmentID
+		}
+		if len(cp.VCNID) > 0 {
+			cluster.CloudProvider.OCI.VCNID = cp.VCNID
+		}
+		if len(cp.LoadBalancerSubnetIDs) > 0 {
+			cluster.CloudProvider.OCI.LoadBalancerSubnetIDs = cp.LoadBalancerSubnetIDs
+		}
+	}
+
+	if cluster.CloudProvider.Kubevirt != nil {
+		// InfraNamespace is used only for KubeVirt
+		if len(cp.InfraNamespace) > 0 {
+			cluster.CloudProvider.Kubevirt.InfraNamespace = cp.InfraNamespace
+		}
+	}
+
 	// Walk through all configured workersets from terraform and apply their config
 	// by either merging it into an existing workerSet or creating a new one
 	for workersetName, workersetValue := range output.KubeOneWorkers.Value {
@@ -322,6 +366,8 @@ func (output *Config) Apply(cluster *kubeonev1beta2.KubeOneCluster) error {
 			err = updateGCEWorkerset(existingWorkerSet, workersetValue.Config.CloudProviderSpec)
 		case cluster.CloudProvider.Hetzner != nil:
 			err = updateHetznerWorkerset(existingWorkerSet, workersetValue.Config.CloudProviderSpec)
+		case cluster.CloudProvider.Kubevirt != nil:
+			err = updateKubevirtWorkerset(existingWorkerSet, workersetValue.Config.CloudProviderSpec)
 		case cluster.CloudProvider.Nutanix != nil:
 			err = updateNutanixWorkerset(existingWorkerSet, workersetValue.Config.CloudProviderSpec)
 		case cluster.CloudProvider.Openstack != nil:
@@ -369,6 +415,10 @@ func newHostConfig(publicIP, privateIP string, ipv6addr []string, idx int, spec
 		Labels:               spec.Labels,
 	}
 
+	if idx < len(spec.Zones) {
+		hostConfig.Zone = spec.Zones[idx]
+	}
+
 	if idx < len(spec.SSHHostKeys) {
 		if pubKey := spec.SSHHostKeys[idx]; pubKey != nil {
 			hostConfig.SSHHostPublicKey = pubKey
@@ -0,0 +1,367 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+
+	"k8c.io/kubeone/pkg/fail"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	// SchemaVersionV1 is the current version of the terraform output schema,
+	// set in the kubeone_api.value.version output
+	SchemaVersionV1 = "v1"
+
+	// schemaVersionLegacy is the version of terraform outputs created before
+	// the schema was versioned
+	schemaVersionLegacy = ""
+)
+
+var (
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// schemaVersion returns the schema version of the terraform output, without
+// decoding the rest of it, so that outputs created for newer KubeOne versions
+// are rejected with a clear error instead of an unknown field error
+func schemaVersion(buf []byte) (string, error) {
+	versionedOutput := struct {
+		KubeOneAPI struct {
+			Value struct {
+				Version string `json:"version"`
+			} `json:"value"`
+		} `json:"kubeone_api"`
+	}{}
+
+	if err := json.Unmarshal(buf, &versionedOutput); err != nil {
+		return "", fail.Config(err, "reading terraform output schema version")
+	}
+
+	version := versionedOutput.KubeOneAPI.Value.Version
+	switch version {
+	case schemaVersionLegacy, SchemaVersionV1:
+		return version, nil
+	}
+
+	return "", fail.NewConfigError("reading terraform output schema version", "terraform output schema version %q is not supported, supported versions: %s", version, SchemaVersionV1)
+}
+
+// decodeStrict decodes the terraform output, disallowing unknown fields. If
+// decoding fails, the output is checked against the Config schema to report
+// the path of the offending field and the expected type.
+func decodeStrict(buf []byte, output *Config) error {
+	err := unmarshalStrict(buf, output)
+	if err == nil {
+		return nil
+	}
+
+	var generic interface{}
+	if json.Unmarshal(buf, &generic) == nil {
+		if errs := checkSchema(nil, generic, reflect.TypeOf(output).Elem()); len(errs) > 0 {
+			return fail.ConfigValidation(errs.ToAggregate())
+		}
+	}
+
+	return fail.Runtime(err, "reading terraform output")
+}
+
+// checkSchema walks the decoded JSON value alongside the Go type it's going
+// to be decoded to, following the encoding/json rules, and reports unknown
+// fields and type mismatches. Types with custom unmarshalling are not
+// inspected.
+func checkSchema(fldPath *field.Path, value interface{}, typ reflect.Type) field.ErrorList {
+	if value == nil {
+		return nil
+	}
+
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	if reflect.PointerTo(typ).Implements(jsonUnmarshalerType) {
+		return nil
+	}
+
+	if reflect.PointerTo(typ).Implements(textUnmarshalerType) {
+		if _, ok := value.(string); !ok {
+			return field.ErrorList{typeInvalid(fldPath, value, "string")}
+		}
+
+		return nil
+	}
+
+	allErrs := field.ErrorList{}
+
+	switch typ.Kind() {
+	case reflect.Interface:
+	case reflect.Bool:
+		if _, ok := value.(bool); !ok {
+			allErrs = append(allErrs, typeInvalid(fldPath, value, "bool"))
+		}
+	case reflect.String:
+		if _, ok := value.(string); !ok {
+			allErrs = append(allErrs, typeInvalid(fldPath, value, "string"))
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if n, ok := value.(float64); !ok || n != math.Trunc(n) {
+			allErrs = append(allErrs, typeInvalid(fldPath, value, "integer"))
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if n, ok := value.(float64); !ok || n != math.Trunc(n) || n < 0 {
+			allErrs = append(allErrs, typeInvalid(fldPath, value, "non-negative integer"))
+		}
+	case reflect.Float32, reflect.Float64:
+		if _, ok := value.(float64); !ok {
+			allErrs = append(allErrs, typeInvalid(fldPath, value, "number"))
+		}
+	case reflect.Slice, reflect.Array:
+		if typ.Kind() == reflect.Slice && typ.Elem().Kind() == reflect.Uint8 {
+			// []byte is encoded as a base64 string
+			if _, ok := value.(string); !ok {
+				allErrs = append(allErrs, typeInvalid(fldPath, value, "base64 encoded string"))
+			}
+
+			break
+		}
+
+		items, ok := value.([]interface{})
+		if !ok {
+			allErrs = append(allErrs, typeInvalid(fldPath, value, "list"))
+
+			break
+		}
+		for i, item := range items {
+			allErrs = append(allErrs, checkSchema(fldPath.Index(i), item, typ.Elem())...)
+		}
+	case reflect.Map:
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			allErrs = append(allErrs, typeInvalid(fldPath, value, "map"))
+
+			break
+		}
+		for _, key := range sortedKeys(obj) {
+			allErrs = append(allErrs, checkSchema(fldPath.Key(key), obj[key], typ.Elem())...)
+		}
+	case reflect.Struct:
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			allErrs = append(allErrs, typeInvalid(fldPath, value, "object"))
+
+			break
+		}
+
+		fields := jsonFields(typ)
+		for _, key := range sortedKeys(obj) {
+			fieldType, found := lookupJSONField(fields, key)
+			if !found {
+				allErrs = append(allErrs, field.Forbidden(childPath(fldPath, key), "unknown field"))
+
+				continue
+			}
+			allErrs = append(allErrs, checkSchema(childPath(fldPath, key), obj[key], fieldType)...)
+		}
+	}
+
+	return allErrs
+}
+
+// jsonFields returns the JSON field names of the struct type, including the
+// fields of embedded structs without a JSON name
+func jsonFields(typ reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+
+	for i := 0; i < typ.NumField(); i++ {
+		structField := typ.Field(i)
+
+		name, _, _ := strings.Cut(structField.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+
+		fieldType := structField.Type
+		for fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+
+		if structField.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			for embeddedName, embeddedType := range jsonFields(fieldType) {
+				if _, exists := fields[embeddedName]; !exists {
+					fields[embeddedName] = embeddedType
+				}
+			}
+
+			continue
+		}
+
+		if !structField.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = structField.Name
+		}
+		fields[name] = structField.Type
+	}
+
+	return fields
+}
+
+// lookupJSONField finds the field by its JSON name, preferring an exact match
+// but accepting a case-insensitive match like encoding/json does
+func lookupJSONField(fields map[string]reflect.Type, key string) (reflect.Type, bool) {
+	if fieldType, ok := fields[key]; ok {
+		return fieldType, true
+	}
+
+	for name, fieldType := range fields {
+		if strings.EqualFold(name, key) {
+			return fieldType, true
+		}
+	}
+
+	return nil, false
+}
+
+func childPath(fldPath *field.Path, name string) *field.Path {
+	if fldPath == nil {
+		return field.NewPath(name)
+	}
+
+	return fldPath.Child(name)
+}
+
+func typeInvalid(fldPath *field.Path, value interface{}, expected string) *field.Error {
+	return field.TypeInvalid(fldPath, value, fmt.Sprintf("expected %s, got %s", expected, jsonTypeName(value)))
+}
+
+func jsonTypeName(value interface{}) string {
+	switch value.(type) {
+	case bool:
+		return "bool"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "list"
+	case map[string]interface{}:
+		return "object"
+	}
+
+	return "null"
+}
+
+func sortedKeys(obj map[string]interface{}) []string {
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// validate validates the decoded terraform output against the rules of its
+// schema version. Outputs using the legacy, unversioned schema are accepted
+// as they are to stay compatible with the existing terraform configs.
+func (output *Config) validate() error {
+	if output.KubeOneAPI.Value.Version == schemaVersionLegacy {
+		return nil
+	}
+
+	allErrs := field.ErrorList{}
+
+	apiPath := field.NewPath("kubeone_api", "value")
+	if output.KubeOneAPI.Value.Endpoint == "" {
+		allErrs = append(allErrs, field.Required(apiPath.Child("endpoint"), "kube-apiserver endpoint is required"))
+	}
+
+	cp := output.KubeOneHosts.Value.ControlPlane
+	cpPath := field.NewPath("kubeone_hosts", "value", "control_plane")
+	if len(cp.PublicAddress) == 0 && len(cp.PrivateAddress) == 0 {
+		allErrs = append(allErrs, field.Required(cpPath.Child("private_address"), "at least one control plane host is required"))
+	}
+	allErrs = append(allErrs, cp.hostsSpec.validate(cpPath)...)
+
+	for _, groupName := range sortedGroupNames(output.KubeOneStaticWorkers.Value) {
+		group := output.KubeOneStaticWorkers.Value[groupName]
+		allErrs = append(allErrs, group.validate(field.NewPath("kubeone_static_workers", "value").Key(groupName))...)
+	}
+
+	if len(allErrs) > 0 {
+		return fail.ConfigValidation(allErrs.ToAggregate())
+	}
+
+	return nil
+}
+
+func (hs *hostsSpec) validate(fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	hostsCount := len(hs.PublicAddress)
+	if hostsCount == 0 {
+		hostsCount = len(hs.PrivateAddress)
+	}
+
+	perHostLists := []struct {
+		name   string
+		length int
+	}{
+		{name: "private_address", length: len(hs.PrivateAddress)},
+		{name: "ipv6_addresses", length: len(hs.IPv6Addresses)},
+		{name: "hostnames", length: len(hs.Hostnames)},
+		{name: "zones", length: len(hs.Zones)},
+		{name: "ssh_hosts_keys", length: len(hs.SSHHostKeys)},
+	}
+
+	for _, list := range perHostLists {
+		if list.length > 0 && list.length != hostsCount {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(list.name), list.length, fmt.Sprintf("must have one element per host (%d)", hostsCount)))
+		}
+	}
+
+	if hs.SSHPort < 0 || hs.SSHPort > math.MaxUint16 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ssh_port"), hs.SSHPort, "must be a valid port number"))
+	}
+
+	if hs.BastionPort < 0 || hs.BastionPort > math.MaxUint16 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("bastion_port"), hs.BastionPort, "must be a valid port number"))
+	}
+
+	return allErrs
+}
+
+func sortedGroupNames(groups map[string]hostsSpec) []string {
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewConfigFromJSON(t *testing.T) {
+	tests := []struct {
+		name        string
+		output      string
+		expectedErr string
+	}{
+		{
+			name: "legacy output without version",
+			output: `{
+				"kubeone_api": {"value": {"endpoint": ""}},
+				"kubeone_hosts": {"value": {"control_plane": {"private_address": ["10.0.0.1", "10.0.0.2"], "hostnames": ["cp-0"]}}}
+			}`,
+		},
+		{
+			name: "valid v1 output",
+			output: `{
+				"kubeone_api": {"sensitive": false, "type": ["object", {}], "value": {"version": "v1", "endpoint": "lb.example.com"}},
+				"kubeone_hosts": {"value": {"control_plane": {"private_address": ["10.0.0.1", "10.0.0.2"], "hostnames": ["cp-0", "cp-1"], "ssh_port": 22}}},
+				"kubeone_static_workers": {"value": {"workers1": {"private_address": ["10.0.0.3"]}}},
+				"unrelated_output": {"value": "ignored"}
+			}`,
+		},
+		{
+			name:        "unsupported version",
+			output:      `{"kubeone_api": {"value": {"version": "v2", "endpoint": "lb.example.com", "new_field": true}}}`,
+			expectedErr: `terraform output schema version "v2" is not supported`,
+		},
+		{
+			name:        "wrong type",
+			output:      `{"kubeone_hosts": {"value": {"control_plane": {"private_address": ["10.0.0.1"], "ssh_port": "22"}}}}`,
+			expectedErr: `kubeone_hosts.value.control_plane.ssh_port: Invalid value: "22": expected integer, got string`,
+		},
+		{
+			name:        "wrong type in static workers group",
+			output:      `{"kubeone_static_workers": {"value": {"workers1": {"private_address": "10.0.0.3"}}}}`,
+			expectedErr: `kubeone_static_workers.value[workers1].private_address: Invalid value: "10.0.0.3": expected list, got string`,
+		},
+		{
+			name:        "unknown field",
+			output:      `{"kubeone_hosts": {"value": {"control_plane": {"private_address": ["10.0.0.1"], "ssh_usr": "root"}}}}`,
+			expectedErr: `kubeone_hosts.value.control_plane.ssh_usr: Forbidden: unknown field`,
+		},
+		{
+			name:        "v1 output without endpoint",
+			output:      `{"kubeone_api": {"value": {"version": "v1"}}, "kubeone_hosts": {"value": {"control_plane": {"private_address": ["10.0.0.1"]}}}}`,
+			expectedErr: `kubeone_api.value.endpoint: Required value`,
+		},
+		{
+			name: "v1 output with mismatched hostnames",
+			output: `{
+				"kubeone_api": {"value": {"version": "v1", "endpoint": "lb.example.com"}},
+				"kubeone_hosts": {"value": {"control_plane": {"private_address": ["10.0.0.1", "10.0.0.2"], "hostnames": ["cp-0"]}}}
+			}`,
+			expectedErr: `kubeone_hosts.value.control_plane.hostnames: Invalid value: 1: must have one element per host (2)`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewConfigFromJSON([]byte(tt.output))
+			switch {
+			case tt.expectedErr == "" && err != nil:
+				t.Fatalf("NewConfigFromJSON() unexpected error = %v", err)
+			case tt.expectedErr != "" && err == nil:
+				t.Fatalf("NewConfigFromJSON() expected error containing %q", tt.expectedErr)
+			case tt.expectedErr != "" && !strings.Contains(err.Error(), tt.expectedErr):
+				t.Fatalf("NewConfigFromJSON() error = %v, expected to contain %q", err, tt.expectedErr)
+			}
+		})
+	}
+}
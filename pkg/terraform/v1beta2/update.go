@@ -189,6 +189,27 @@ func updateHetznerWorkerset(existingWorkerSet *kubeonev1beta2.DynamicWorkerConfi
 	return nil
 }
 
+func updateKubevirtWorkerset(existingWorkerSet *kubeonev1beta2.DynamicWorkerConfig, cfg json.RawMessage) error {
+	var kubevirtConfig machinecontroller.KubevirtSpec
+
+	if err := unmarshalStrict(cfg, &kubevirtConfig); err != nil {
+		return fail.Config(err, "unmarshalling DynamicWorkerConfig KubeVirt spec")
+	}
+
+	flags := []cloudProviderFlags{
+		{key: "clusterName", value: kubevirtConfig.ClusterName},
+		{key: "virtualMachine", value: kubevirtConfig.VirtualMachine},
+	}
+
+	for _, flag := range flags {
+		if err := setWorkersetFlag(existingWorkerSet, flag.key, flag.value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func updateNutanixWorkerset(existingWorkerSet *kubeonev1beta2.DynamicWorkerConfig, cfg json.RawMessage) error {
 	var nutanixConfig machinecontroller.NutanixSpec
 
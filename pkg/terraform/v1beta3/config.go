@@ -0,0 +1,85 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta3
+
+import (
+	kubeoneapi "k8c.io/kubeone/pkg/apis/kubeone"
+	kubeonescheme "k8c.io/kubeone/pkg/apis/kubeone/scheme"
+	kubeonev1beta2 "k8c.io/kubeone/pkg/apis/kubeone/v1beta2"
+	kubeonev1beta3 "k8c.io/kubeone/pkg/apis/kubeone/v1beta3"
+	"k8c.io/kubeone/pkg/fail"
+	terraformv1beta2 "k8c.io/kubeone/pkg/terraform/v1beta2"
+)
+
+// Config represents configuration in the terraform output format. The
+// terraform output format didn't change between the v1beta2 and v1beta3 APIs,
+// so the v1beta2 implementation is reused.
+type Config struct {
+	*terraformv1beta2.Config
+}
+
+// NewConfigFromJSON creates a new config object from json
+func NewConfigFromJSON(buf []byte) (*Config, error) {
+	output, err := terraformv1beta2.NewConfigFromJSON(buf)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Config{Config: output}, nil
+}
+
+// Apply adds the terraform configuration options to the given cluster config.
+//
+// The cluster is converted to v1beta2, the terraform output is applied to it,
+// and the fields managed by the terraform output are copied back. All other
+// fields are left untouched, so the fields existing only in v1beta3 are not
+// lost in the conversion.
+func (output *Config) Apply(cluster *kubeonev1beta3.KubeOneCluster) error {
+	internalCluster := &kubeoneapi.KubeOneCluster{}
+	if err := kubeonescheme.Scheme.Convert(cluster, internalCluster, nil); err != nil {
+		return fail.Config(err, "converting v1beta3 to internal object")
+	}
+
+	v1beta2Cluster := &kubeonev1beta2.KubeOneCluster{}
+	if err := kubeonescheme.Scheme.Convert(internalCluster, v1beta2Cluster, nil); err != nil {
+		return fail.Config(err, "converting internal to v1beta2 object")
+	}
+
+	if err := output.Config.Apply(v1beta2Cluster); err != nil {
+		return err
+	}
+
+	internalCluster = &kubeoneapi.KubeOneCluster{}
+	if err := kubeonescheme.Scheme.Convert(v1beta2Cluster, internalCluster, nil); err != nil {
+		return fail.Config(err, "converting v1beta2 to internal object")
+	}
+
+	appliedCluster := &kubeonev1beta3.KubeOneCluster{}
+	if err := kubeonescheme.Scheme.Convert(internalCluster, appliedCluster, nil); err != nil {
+		return fail.Config(err, "converting internal to v1beta3 object")
+	}
+
+	cluster.Name = appliedCluster.Name
+	cluster.APIEndpoint = appliedCluster.APIEndpoint
+	cluster.CloudProvider = appliedCluster.CloudProvider
+	cluster.ControlPlane.Hosts = appliedCluster.ControlPlane.Hosts
+	cluster.StaticWorkers.Hosts = appliedCluster.StaticWorkers.Hosts
+	cluster.DynamicWorkers = appliedCluster.DynamicWorkers
+	cluster.Proxy = appliedCluster.Proxy
+
+	return nil
+}
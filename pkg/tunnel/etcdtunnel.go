@@ -34,6 +34,6 @@ func NewGRPCDialer(tunneler executor.Adapter, target kubeoneapi.HostConfig) (grp
 	}
 
 	return grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
-		return tunnel.TunnelTo(ctx, "tcp4", addr)
+		return tunnel.TunnelTo(ctx, "tcp", addr)
 	}), nil
 }
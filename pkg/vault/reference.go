@@ -0,0 +1,101 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vault
+
+import (
+	"context"
+	"strings"
+
+	"k8c.io/kubeone/pkg/fail"
+)
+
+// Scheme is the prefix of the references to the secrets stored in Vault, in
+// the vault://<path>[#<key>] format, e.g.
+// vault://secret/data/kubeone/ssh#private_key
+const Scheme = "vault://"
+
+// IsReference returns whether the string is a reference to a secret stored in
+// Vault
+func IsReference(s string) bool {
+	return strings.HasPrefix(s, Scheme)
+}
+
+// ParseReference returns the path of the secret and the key, if any, of the
+// reference in the vault://<path>[#<key>] format
+func ParseReference(ref string) (string, string, error) {
+	if !IsReference(ref) {
+		return "", "", fail.NewConfigError("vault reference", "%q doesn't start with %s", ref, Scheme)
+	}
+
+	path, key, _ := strings.Cut(strings.TrimPrefix(ref, Scheme), "#")
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return "", "", fail.NewConfigError("vault reference", "%q has no path", ref)
+	}
+
+	return path, key, nil
+}
+
+// ReadSecret reads the secret referenced in the vault://<path> format using
+// the default client
+func ReadSecret(ctx context.Context, ref string) (map[string]string, error) {
+	path, key, err := ParseReference(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	if key != "" {
+		return nil, fail.NewConfigError("vault reference", "%q must refer to the whole secret, without the key", ref)
+	}
+
+	client, err := Default(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.ReadKV(ctx, path)
+}
+
+// ReadValue reads the key of the secret referenced in the
+// vault://<path>#<key> format using the default client
+func ReadValue(ctx context.Context, ref string) (string, error) {
+	path, key, err := ParseReference(ref)
+	if err != nil {
+		return "", err
+	}
+
+	if key == "" {
+		return "", fail.NewConfigError("vault reference", "%q must refer to a key of the secret, in the %s<path>#<key> format", ref, Scheme)
+	}
+
+	client, err := Default(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	secret, err := client.ReadKV(ctx, path)
+	if err != nil {
+		return "", err
+	}
+
+	value, found := secret[key]
+	if !found {
+		return "", fail.NewConfigError("vault reference", "the %q secret has no %q key", path, key)
+	}
+
+	return value, nil
+}
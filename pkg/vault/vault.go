@@ -0,0 +1,391 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package vault implements a minimal client of the HashiCorp Vault HTTP API,
+// used to read the credentials and the secrets referenced by KubeOne. The
+// client is configured using the same environment variables as the Vault CLI,
+// and can authenticate using a token, the AppRole auth method or the
+// Kubernetes auth method.
+package vault
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"k8c.io/kubeone/pkg/fail"
+)
+
+const (
+	// AddressEnv is the environment variable with the address of the Vault
+	// server, e.g. https://vault.example.com:8200
+	AddressEnv = "VAULT_ADDR"
+
+	// TokenEnv is the environment variable with the Vault token
+	TokenEnv = "VAULT_TOKEN" //nolint:gosec
+
+	// NamespaceEnv is the environment variable with the Vault Enterprise
+	// namespace
+	NamespaceEnv = "VAULT_NAMESPACE"
+
+	// CACertEnv is the environment variable with the path to the PEM-encoded
+	// CA certificate used to verify the Vault server certificate
+	CACertEnv = "VAULT_CACERT"
+
+	// RoleIDEnv is the environment variable with the role ID used to log in
+	// using the AppRole auth method
+	RoleIDEnv = "VAULT_ROLE_ID"
+
+	// SecretIDEnv is the environment variable with the secret ID used to log
+	// in using the AppRole auth method
+	SecretIDEnv = "VAULT_SECRET_ID" //nolint:gosec
+
+	// SecretIDFileEnv is the environment variable with the path to the file
+	// with the secret ID used to log in using the AppRole auth method, e.g.
+	// written by the Vault Agent. It's used if VAULT_SECRET_ID is not set.
+	SecretIDFileEnv = "VAULT_SECRET_ID_FILE" //nolint:gosec
+
+	// AppRoleMountEnv is the environment variable with the mount path of the
+	// AppRole auth method. Default value is "approle".
+	AppRoleMountEnv = "VAULT_APPROLE_MOUNT"
+
+	// KubernetesRoleEnv is the environment variable with the role used to log
+	// in using the Kubernetes auth method, e.g. when KubeOne runs in a pod
+	KubernetesRoleEnv = "VAULT_KUBERNETES_ROLE"
+
+	// KubernetesTokenFileEnv is the environment variable with the path to the
+	// service account token used to log in using the Kubernetes auth method.
+	// Default value is the token mounted in the pods.
+	KubernetesTokenFileEnv = "VAULT_KUBERNETES_TOKEN_FILE" //nolint:gosec
+
+	// KubernetesMountEnv is the environment variable with the mount path of
+	// the Kubernetes auth method. Default value is "kubernetes".
+	KubernetesMountEnv = "VAULT_KUBERNETES_MOUNT"
+
+	defaultAppRoleMount        = "approle"
+	defaultKubernetesMount     = "kubernetes"
+	defaultKubernetesTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token" //nolint:gosec
+
+	requestTimeout = 30 * time.Second
+)
+
+// Client reads secrets from HashiCorp Vault
+type Client struct {
+	address    string
+	token      string
+	namespace  string
+	httpClient *http.Client
+
+	lock    sync.Mutex
+	secrets map[string]map[string]string
+}
+
+var defaultClient struct {
+	lock   sync.Mutex
+	client *Client
+}
+
+// Default returns the client configured using the environment variables,
+// which is shared by the whole process, so that it logs in only once and
+// reads each secret only once
+func Default(ctx context.Context) (*Client, error) {
+	defaultClient.lock.Lock()
+	defer defaultClient.lock.Unlock()
+
+	if defaultClient.client != nil {
+		return defaultClient.client, nil
+	}
+
+	client, err := NewFromEnvironment(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defaultClient.client = client
+
+	return client, nil
+}
+
+// NewFromEnvironment returns the client configured using the environment
+// variables. The client authenticates using, in order of precedence:
+//   - the token in VAULT_TOKEN,
+//   - the AppRole auth method, if VAULT_ROLE_ID is set,
+//   - the Kubernetes auth method, if VAULT_KUBERNETES_ROLE is set,
+//   - the token stored by `vault login` in ~/.vault-token.
+func NewFromEnvironment(ctx context.Context) (*Client, error) {
+	address := strings.TrimSuffix(os.Getenv(AddressEnv), "/")
+	if address == "" {
+		return nil, fail.NewConfigError("vault", "the %s environment variable is not set", AddressEnv)
+	}
+
+	if _, err := url.Parse(address); err != nil {
+		return nil, fail.Config(err, "parsing "+AddressEnv)
+	}
+
+	httpClient := &http.Client{Timeout: requestTimeout}
+
+	if caCertFile := os.Getenv(CACertEnv); caCertFile != "" {
+		caCert, err := os.ReadFile(caCertFile)
+		if err != nil {
+			return nil, fail.Config(err, "reading "+CACertEnv)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fail.NewConfigError("vault", "no PEM-encoded certificates found in %s", caCertFile)
+		}
+
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = &tls.Config{
+			RootCAs:    pool,
+			MinVersion: tls.VersionTLS12,
+		}
+		httpClient.Transport = transport
+	}
+
+	c := &Client{
+		address:    address,
+		namespace:  os.Getenv(NamespaceEnv),
+		httpClient: httpClient,
+		secrets:    map[string]map[string]string{},
+	}
+
+	if err := c.login(ctx); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Client) login(ctx context.Context) error {
+	if c.token = os.Getenv(TokenEnv); c.token != "" {
+		return nil
+	}
+
+	if roleID := os.Getenv(RoleIDEnv); roleID != "" {
+		secretID, err := secretFromEnvironment(SecretIDEnv, SecretIDFileEnv, "")
+		if err != nil {
+			return err
+		}
+
+		return c.loginWith(ctx, defaults(os.Getenv(AppRoleMountEnv), defaultAppRoleMount), map[string]string{
+			"role_id":   roleID,
+			"secret_id": secretID,
+		})
+	}
+
+	if role := os.Getenv(KubernetesRoleEnv); role != "" {
+		jwt, err := secretFromEnvironment("", KubernetesTokenFileEnv, defaultKubernetesTokenFile)
+		if err != nil {
+			return err
+		}
+
+		return c.loginWith(ctx, defaults(os.Getenv(KubernetesMountEnv), defaultKubernetesMount), map[string]string{
+			"role": role,
+			"jwt":  jwt,
+		})
+	}
+
+	if c.token = tokenFromHelper(); c.token != "" {
+		return nil
+	}
+
+	return fail.NewConfigError("vault", "no authentication configured, set %s, %s or %s", TokenEnv, RoleIDEnv, KubernetesRoleEnv)
+}
+
+func (c *Client) loginWith(ctx context.Context, mount string, body map[string]string) error {
+	resp, err := c.request(ctx, http.MethodPost, fmt.Sprintf("auth/%s/login", strings.Trim(mount, "/")), body)
+	if err != nil {
+		return err
+	}
+
+	if resp.Auth == nil || resp.Auth.ClientToken == "" {
+		return fail.NewRuntimeError("vault login", "no token returned by the %q auth method", mount)
+	}
+
+	c.token = resp.Auth.ClientToken
+
+	return nil
+}
+
+// secretFromEnvironment returns the value of the environment variable, or
+// the content of the file set in the file environment variable
+func secretFromEnvironment(env, fileEnv, defaultFile string) (string, error) {
+	if env != "" {
+		if value := os.Getenv(env); value != "" {
+			return value, nil
+		}
+	}
+
+	file := defaults(os.Getenv(fileEnv), defaultFile)
+	if file == "" {
+		return "", fail.NewConfigError("vault", "neither %s nor %s is set", env, fileEnv)
+	}
+
+	value, err := os.ReadFile(file)
+	if err != nil {
+		return "", fail.Config(err, "reading "+fileEnv)
+	}
+
+	return strings.TrimSpace(string(value)), nil
+}
+
+// tokenFromHelper returns the token stored by the default token helper of
+// the Vault CLI, if any
+func tokenFromHelper() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+
+	token, err := os.ReadFile(filepath.Join(home, ".vault-token"))
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(string(token))
+}
+
+type response struct {
+	Data map[string]interface{} `json:"data"`
+	Auth *struct {
+		ClientToken string `json:"client_token"`
+	} `json:"auth"`
+	Errors []string `json:"errors"`
+}
+
+// ReadKV reads the secret from the KV secrets engine at the given path, which
+// includes the mount path of the secrets engine, and the data/ segment for the
+// version 2 of the KV secrets engine, e.g. secret/data/kubeone/ca. The values
+// of the secret must be strings. The secrets are cached by the client.
+func (c *Client) ReadKV(ctx context.Context, path string) (map[string]string, error) {
+	path = strings.Trim(path, "/")
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if secret, found := c.secrets[path]; found {
+		return secret, nil
+	}
+
+	resp, err := c.request(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	data := resp.Data
+
+	// the version 2 of the KV secrets engine wraps the secret together with
+	// its metadata
+	if nested, ok := data["data"].(map[string]interface{}); ok {
+		if _, versioned := data["metadata"]; versioned {
+			data = nested
+		}
+	}
+
+	secret := map[string]string{}
+	for key, value := range data {
+		str, ok := value.(string)
+		if !ok {
+			return nil, fail.NewRuntimeError("reading vault secret", "the %q key of the %q secret is not a string", key, path)
+		}
+		secret[key] = str
+	}
+
+	if c.secrets == nil {
+		c.secrets = map[string]map[string]string{}
+	}
+	c.secrets[path] = secret
+
+	return secret, nil
+}
+
+func (c *Client) request(ctx context.Context, method, path string, body interface{}) (*response, error) {
+	var reqBody io.Reader
+	if body != nil {
+		buf, err := json.Marshal(body)
+		if err != nil {
+			return nil, fail.Runtime(err, "encoding vault request")
+		}
+		reqBody = bytes.NewReader(buf)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s/v1/%s", c.address, path), reqBody)
+	if err != nil {
+		return nil, fail.Config(err, "creating vault request")
+	}
+
+	if c.token != "" {
+		req.Header.Set("X-Vault-Token", c.token)
+	}
+	req.Header.Set("X-Vault-Request", "true")
+	if c.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", c.namespace)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fail.Runtime(err, "requesting vault %q", path)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fail.Runtime(err, "requesting vault %q", path)
+	}
+
+	result := &response{}
+	if len(respBody) > 0 {
+		if err = json.Unmarshal(respBody, result); err != nil {
+			return nil, fail.Runtime(err, "decoding vault response for %q", path)
+		}
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, fail.Runtime(errors.New("not found"), "requesting vault %q", path)
+	case resp.StatusCode != http.StatusOK:
+		msg := resp.Status
+		if len(result.Errors) > 0 {
+			msg = fmt.Sprintf("%s: %s", msg, strings.Join(result.Errors, ", "))
+		}
+
+		return nil, fail.NewRuntimeError("requesting vault", "%q: %s", path, msg)
+	}
+
+	return result, nil
+}
+
+func defaults(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+
+	return value
+}
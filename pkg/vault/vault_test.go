@@ -0,0 +1,264 @@
+/*
+Copyright 2023 The KubeOne Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestReadKV(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "s.token" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+
+			return
+		}
+
+		switch r.URL.Path {
+		case "/v1/secret/data/ca":
+			_, _ = w.Write([]byte(`{"data":{"data":{"tls.crt":"cert","tls.key":"key"},"metadata":{"version":1}}}`))
+		case "/v1/kv/ca":
+			_, _ = w.Write([]byte(`{"data":{"tls.crt":"cert","tls.key":"key"}}`))
+		case "/v1/kv/data":
+			_, _ = w.Write([]byte(`{"data":{"data":"value"}}`))
+		case "/v1/kv/number":
+			_, _ = w.Write([]byte(`{"data":{"port":22}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[]}`))
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name    string
+		token   string
+		path    string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name:  "kv version 2",
+			token: "s.token",
+			path:  "secret/data/ca",
+			want:  map[string]string{"tls.crt": "cert", "tls.key": "key"},
+		},
+		{
+			name:  "kv version 1",
+			token: "s.token",
+			path:  "/kv/ca/",
+			want:  map[string]string{"tls.crt": "cert", "tls.key": "key"},
+		},
+		{
+			name:  "kv version 1 with the data key",
+			token: "s.token",
+			path:  "kv/data",
+			want:  map[string]string{"data": "value"},
+		},
+		{
+			name:    "not a string",
+			token:   "s.token",
+			path:    "kv/number",
+			wantErr: true,
+		},
+		{
+			name:    "not found",
+			token:   "s.token",
+			path:    "kv/missing",
+			wantErr: true,
+		},
+		{
+			name:    "permission denied",
+			token:   "s.invalid",
+			path:    "kv/ca",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				address:    server.URL,
+				token:      tt.token,
+				httpClient: server.Client(),
+			}
+
+			got, err := c.ReadKV(context.Background(), tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReadKV() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReadKV() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewFromEnvironment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]string{}
+		if r.Method == http.MethodPost {
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+
+				return
+			}
+		}
+
+		switch {
+		case r.URL.Path == "/v1/auth/approle/login" && body["role_id"] == "role" && body["secret_id"] == "secret":
+			_, _ = w.Write([]byte(`{"auth":{"client_token":"s.approle"}}`))
+		case r.URL.Path == "/v1/auth/k8s/login" && body["role"] == "kubeone" && body["jwt"] == "jwt":
+			_, _ = w.Write([]byte(`{"auth":{"client_token":"s.kubernetes"}}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"errors":["invalid credentials"]}`))
+		}
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	writeFile := func(name, content string) string {
+		fname := filepath.Join(dir, name)
+		if err := os.WriteFile(fname, []byte(content), 0o600); err != nil {
+			t.Fatalf("writing %s: %v", name, err)
+		}
+
+		return fname
+	}
+	secretIDFile := writeFile("secret-id", "secret\n")
+	jwtFile := writeFile("token", "jwt")
+
+	tests := []struct {
+		name      string
+		env       map[string]string
+		wantToken string
+		wantErr   bool
+	}{
+		{
+			name:      "token",
+			env:       map[string]string{TokenEnv: "s.token", RoleIDEnv: "role"},
+			wantToken: "s.token",
+		},
+		{
+			name:      "approle",
+			env:       map[string]string{RoleIDEnv: "role", SecretIDEnv: "secret"},
+			wantToken: "s.approle",
+		},
+		{
+			name:      "approle with secret ID file",
+			env:       map[string]string{RoleIDEnv: "role", SecretIDFileEnv: secretIDFile},
+			wantToken: "s.approle",
+		},
+		{
+			name:    "approle without secret ID",
+			env:     map[string]string{RoleIDEnv: "role"},
+			wantErr: true,
+		},
+		{
+			name:    "approle with invalid secret ID",
+			env:     map[string]string{RoleIDEnv: "role", SecretIDEnv: "invalid"},
+			wantErr: true,
+		},
+		{
+			name: "kubernetes",
+			env: map[string]string{
+				KubernetesRoleEnv:      "kubeone",
+				KubernetesTokenFileEnv: jwtFile,
+				KubernetesMountEnv:     "k8s",
+			},
+			wantToken: "s.kubernetes",
+		},
+		{
+			name:    "no authentication",
+			env:     map[string]string{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// isolate from the token stored by the Vault CLI
+			t.Setenv("HOME", t.TempDir())
+			for _, env := range []string{TokenEnv, RoleIDEnv, SecretIDEnv, SecretIDFileEnv, AppRoleMountEnv, KubernetesRoleEnv, KubernetesTokenFileEnv, KubernetesMountEnv, NamespaceEnv, CACertEnv} {
+				t.Setenv(env, tt.env[env])
+			}
+			t.Setenv(AddressEnv, server.URL)
+
+			c, err := NewFromEnvironment(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewFromEnvironment() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if c.token != tt.wantToken {
+				t.Errorf("token = %q, want %q", c.token, tt.wantToken)
+			}
+		})
+	}
+}
+
+func TestParseReference(t *testing.T) {
+	tests := []struct {
+		ref      string
+		wantPath string
+		wantKey  string
+		wantErr  bool
+	}{
+		{
+			ref:      "vault://secret/data/kubeone",
+			wantPath: "secret/data/kubeone",
+		},
+		{
+			ref:      "vault:///secret/data/kubeone/ssh/#private_key",
+			wantPath: "secret/data/kubeone/ssh",
+			wantKey:  "private_key",
+		},
+		{
+			ref:     "vault://#key",
+			wantErr: true,
+		},
+		{
+			ref:     "/home/me/.ssh/id_rsa",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.ref, func(t *testing.T) {
+			path, key, err := ParseReference(tt.ref)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseReference() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if path != tt.wantPath || key != tt.wantKey {
+				t.Errorf("ParseReference() = %q, %q, want %q, %q", path, key, tt.wantPath, tt.wantKey)
+			}
+		})
+	}
+}
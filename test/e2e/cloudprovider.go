@@ -36,7 +36,7 @@ import (
 
 func supportsStorageTests(provider string) bool {
 	switch provider {
-	case "aws", "azure", "digitalocean", "gce", "hetzner", "nutanix", "openstack", "vsphere":
+	case "aws", "azure", "digitalocean", "gce", "hetzner", "nutanix", "openstack", "vcd", "vsphere":
 		return true
 	default:
 		return false
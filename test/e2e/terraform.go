@@ -20,8 +20,10 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sync"
 	"syscall"
 
+	"k8c.io/kubeone/pkg/terraform"
 	"k8c.io/kubeone/test/testexec"
 )
 
@@ -32,6 +34,25 @@ var (
 	}
 )
 
+var (
+	terraformBinaryOnce sync.Once
+	terraformBinary     *terraform.Binary
+)
+
+// detectTerraformBinary detects the Terraform or OpenTofu binary only once,
+// it's shared by all tests
+func detectTerraformBinary() *terraform.Binary {
+	terraformBinaryOnce.Do(func() {
+		bin, err := terraform.Detect(context.Background())
+		if err != nil {
+			panic(err)
+		}
+		terraformBinary = bin
+	})
+
+	return terraformBinary
+}
+
 type terraformBin struct {
 	path    string
 	vars    []string
@@ -95,7 +116,7 @@ func (tf *terraformBin) run(args ...string) error {
 }
 
 func (tf *terraformBin) build(args ...string) *testexec.Exec {
-	return testexec.NewExec("terraform",
+	return testexec.NewExec(detectTerraformBinary().Path,
 		testexec.WithArgs(args...),
 		testexec.WithEnv(append(os.Environ(), defaultTFEnvironment...)),
 		testexec.InDir(tf.path),
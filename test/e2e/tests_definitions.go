@@ -315,6 +315,24 @@ var (
 				outputDir:  "/logs/artifacts/logs",
 			},
 		},
+		"aws_arm64": {
+			name: "aws_arm64",
+			labels: map[string]string{
+				"preset-goproxy":         "true",
+				"preset-aws-e2e-kubeone": "true",
+			},
+			environ: map[string]string{
+				"PROVIDER": "aws",
+			},
+			terraform: terraformBin{
+				path:    "../../examples/terraform/aws",
+				varFile: "testdata/aws_arm64.tfvars",
+			},
+			protokol: protokolBin{
+				namespaces: []string{"kube-system"},
+				outputDir:  "/logs/artifacts/logs",
+			},
+		},
 		"aws_long_timeout_default": {
 			name: "aws_long_timeout_default",
 			labels: map[string]string{
@@ -1014,6 +1032,46 @@ var (
 				outputDir:  "/logs/artifacts/logs",
 			},
 		},
+		"hetzner_private_network": {
+			name: "hetzner_private_network",
+			labels: map[string]string{
+				"preset-goproxy": "true",
+				"preset-hetzner": "true",
+			},
+			environ: map[string]string{
+				"PROVIDER": "hetzner",
+			},
+			terraform: terraformBin{
+				path: "../../examples/terraform/hetzner-private-network",
+				vars: []string{
+					"disable_kubeapi_loadbalancer=true",
+				},
+			},
+			protokol: protokolBin{
+				namespaces: []string{"kube-system"},
+				outputDir:  "/logs/artifacts/logs",
+			},
+		},
+		"libvirt_default": {
+			name: "libvirt_default",
+			labels: map[string]string{
+				"preset-goproxy": "true",
+				"preset-libvirt": "true",
+			},
+			environ: map[string]string{
+				"PROVIDER": "libvirt",
+			},
+			terraform: terraformBin{
+				path: "../../examples/terraform/libvirt",
+				vars: []string{
+					"control_plane_vm_count=1",
+				},
+			},
+			protokol: protokolBin{
+				namespaces: []string{"kube-system"},
+				outputDir:  "/logs/artifacts/logs",
+			},
+		},
 		"openstack_default": {
 			name: "openstack_default",
 			labels: map[string]string{
@@ -1405,6 +1463,10 @@ var (
 			Name:                 "conformance_containerd",
 			ManifestTemplatePath: "testdata/containerd_simple.yaml",
 		},
+		"install_containerd_static_workers": &scenarioInstall{
+			Name:                 "install_containerd_static_workers",
+			ManifestTemplatePath: "testdata/containerd_static_workers.yaml",
+		},
 
 		// docker external
 		"install_docker_external": &scenarioInstall{
@@ -172,6 +172,15 @@ func TestAwsAmznInstallContainerdV1_26_10(t *testing.T) {
 	scenario.Run(ctx, t)
 }
 
+func TestAwsArm64InstallContainerdV1_26_10(t *testing.T) {
+	ctx := NewSignalContext(t.Logf)
+	infra := Infrastructures["aws_arm64"]
+	scenario := Scenarios["install_containerd"]
+	scenario.SetInfra(infra)
+	scenario.SetVersions("v1.26.10")
+	scenario.Run(ctx, t)
+}
+
 func TestAwsCentosInstallContainerdV1_26_10(t *testing.T) {
 	ctx := NewSignalContext(t.Logf)
 	infra := Infrastructures["aws_centos"]
@@ -379,6 +388,15 @@ func TestGceDefaultInstallContainerdV1_28_3(t *testing.T) {
 	scenario.Run(ctx, t)
 }
 
+func TestLibvirtDefaultInstallContainerdStaticWorkersV1_28_3(t *testing.T) {
+	ctx := NewSignalContext(t.Logf)
+	infra := Infrastructures["libvirt_default"]
+	scenario := Scenarios["install_containerd_static_workers"]
+	scenario.SetInfra(infra)
+	scenario.SetVersions("v1.28.3")
+	scenario.Run(ctx, t)
+}
+
 func TestAzureDefaultStableUpgradeContainerdFromV1_27_7_ToV1_28_3(t *testing.T) {
 	ctx := NewSignalContext(t.Logf)
 	infra := Infrastructures["azure_default_stable"]
@@ -3394,6 +3412,15 @@ func TestHetznerRockylinuxInstallContainerdExternalV1_28_3(t *testing.T) {
 	scenario.Run(ctx, t)
 }
 
+func TestHetznerPrivateNetworkInstallContainerdExternalV1_28_3(t *testing.T) {
+	ctx := NewSignalContext(t.Logf)
+	infra := Infrastructures["hetzner_private_network"]
+	scenario := Scenarios["install_containerd_external"]
+	scenario.SetInfra(infra)
+	scenario.SetVersions("v1.28.3")
+	scenario.Run(ctx, t)
+}
+
 func TestOpenstackDefaultInstallContainerdExternalV1_28_3(t *testing.T) {
 	ctx := NewSignalContext(t.Logf)
 	infra := Infrastructures["openstack_default"]
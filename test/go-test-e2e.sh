@@ -120,6 +120,9 @@ cloudConfig: |
   regional = true
 EOL
     ;;
+  "libvirt")
+    export TF_VAR_libvirt_uri=${LIBVIRT_E2E_URI:-"qemu:///system"}
+    ;;
   "openstack")
     export OS_AUTH_URL=${OS_AUTH_URL}
     export OS_DOMAIN_NAME=${OS_DOMAIN}